@@ -20,6 +20,7 @@ import { CreateCommentModal } from './CreateCommentModal'
 import { DetachChangesetsModal } from './DetachChangesetsModal'
 import { MergeChangesetsModal } from './MergeChangesetsModal'
 import { PublishChangesetsModal } from './PublishChangesetsModal'
+import { RebaseChangesetsModal } from './RebaseChangesetsModal'
 import { ReenqueueChangesetsModal } from './ReenqueueChangesetsModal'
 
 /**
@@ -132,6 +133,25 @@ const AVAILABLE_ACTIONS: Record<BulkOperationType, ChangesetListAction> = {
             )
         },
     },
+    [BulkOperationType.REBASE]: {
+        type: 'rebase',
+        experimental: true,
+        buttonLabel: 'Rebase changesets',
+        dropdownTitle: 'Rebase changesets',
+        dropdownDescription:
+            'Apply the changes of all selected changesets to the current head of their base branch and push them again, for example when they are conflicted.',
+        onTrigger: (batchChangeID, changesetIDs, onDone, onCancel) => {
+            eventLogger.log('batch_change_details:bulk_action_rebase:clicked')
+            return (
+                <RebaseChangesetsModal
+                    batchChangeID={batchChangeID}
+                    changesetIDs={changesetIDs}
+                    afterCreate={onDone}
+                    onCancel={onCancel}
+                />
+            )
+        },
+    },
     [BulkOperationType.REENQUEUE]: {
         type: 'retry',
         buttonLabel: 'Retry changesets',
@@ -4,6 +4,7 @@ import {
     mdiSourceBranch,
     mdiSourcePull,
     mdiSourceMerge,
+    mdiSourceBranchRemove,
     mdiDelete,
     mdiAlertCircle,
     mdiAutorenew,
@@ -57,6 +58,8 @@ export const ChangesetStatusCell: React.FunctionComponent<React.PropsWithChildre
             return <ChangesetStatusReadOnly className={className} role={role} />
         case ChangesetState.DELETED:
             return <ChangesetStatusDeleted className={className} role={role} />
+        case ChangesetState.CONFLICTED:
+            return <ChangesetStatusConflicted className={className} role={role} />
     }
 }
 
@@ -125,6 +128,18 @@ export const ChangesetStatusDraft: React.FunctionComponent<React.PropsWithChildr
         {label}
     </div>
 )
+export const ChangesetStatusConflicted: React.FunctionComponent<React.PropsWithChildren<ChangesetStatusIconProps>> = ({
+    label = <StatusLabel status="Conflicted" />,
+    className,
+    ...props
+}) => (
+    <Tooltip content="The changes of this changeset no longer apply cleanly to its base branch. Rebase the changeset to push them onto the current base branch.">
+        <div className={classNames(iconClassNames, className)} {...props}>
+            <Icon className="text-warning" svgPath={mdiSourceBranchRemove} inline={false} aria-hidden={true} />
+            {label}
+        </div>
+    </Tooltip>
+)
 export const ChangesetStatusDeleted: React.FunctionComponent<React.PropsWithChildren<ChangesetStatusIconProps>> = ({
     label = <StatusLabel status="Deleted" />,
     className,
@@ -0,0 +1,66 @@
+import React, { useCallback, useState } from 'react'
+
+import { asError, isErrorLike } from '@sourcegraph/common'
+import { Button, Modal, H3, Text, ErrorAlert } from '@sourcegraph/wildcard'
+
+import { LoaderButton } from '../../../../components/LoaderButton'
+import { Scalars } from '../../../../graphql-operations'
+import { rebaseChangesets as _rebaseChangesets } from '../backend'
+
+export interface RebaseChangesetsModalProps {
+    onCancel: () => void
+    afterCreate: () => void
+    batchChangeID: Scalars['ID']
+    changesetIDs: Scalars['ID'][]
+
+    /** For testing only. */
+    rebaseChangesets?: typeof _rebaseChangesets
+}
+
+export const RebaseChangesetsModal: React.FunctionComponent<
+    React.PropsWithChildren<RebaseChangesetsModalProps>
+> = ({ onCancel, afterCreate, batchChangeID, changesetIDs, rebaseChangesets = _rebaseChangesets }) => {
+    const [isLoading, setIsLoading] = useState<boolean | Error>(false)
+
+    const onSubmit = useCallback<React.FormEventHandler>(async () => {
+        setIsLoading(true)
+        try {
+            await rebaseChangesets(batchChangeID, changesetIDs)
+            afterCreate()
+        } catch (error) {
+            setIsLoading(asError(error))
+        }
+    }, [changesetIDs, rebaseChangesets, batchChangeID, afterCreate])
+
+    return (
+        <Modal onDismiss={onCancel} aria-labelledby={LABEL_ID}>
+            <H3 id={LABEL_ID}>Rebase changesets</H3>
+            <Text className="mb-4">
+                Are you sure you want to apply the changes of all the selected changesets to the current head of their
+                base branch and push them again?
+            </Text>
+            {isErrorLike(isLoading) && <ErrorAlert error={isLoading} />}
+            <div className="d-flex justify-content-end">
+                <Button
+                    disabled={isLoading === true}
+                    className="mr-2"
+                    onClick={onCancel}
+                    outline={true}
+                    variant="secondary"
+                >
+                    Cancel
+                </Button>
+                <LoaderButton
+                    onClick={onSubmit}
+                    disabled={isLoading === true}
+                    variant="primary"
+                    loading={isLoading === true}
+                    alwaysShowLabel={true}
+                    label="Rebase"
+                />
+            </div>
+        </Modal>
+    )
+}
+
+const LABEL_ID = 'rebase-changesets-modal-title'
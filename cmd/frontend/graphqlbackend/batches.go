@@ -44,6 +44,26 @@ type CloseBatchChangeArgs struct {
 	CloseChangesets bool
 }
 
+type UpdateBatchChangeScheduleArgs struct {
+	BatchChange graphql.ID
+	Schedule    *string
+	Paused      *bool
+}
+
+type SetBatchChangeAutoMergePolicyArgs struct {
+	BatchChange            graphql.ID
+	RequiredApprovals      int32
+	RequirePassingChecks   bool
+	MergeMethod            *string
+	MaintenanceWindowDays  *[]string
+	MaintenanceWindowStart *string
+	MaintenanceWindowEnd   *string
+}
+
+type DeleteBatchChangeAutoMergePolicyArgs struct {
+	BatchChange graphql.ID
+}
+
 type MoveBatchChangeArgs struct {
 	BatchChange  graphql.ID
 	NewName      *string
@@ -233,6 +253,10 @@ type PublishChangesetsArgs struct {
 	Draft bool
 }
 
+type RebaseChangesetsArgs struct {
+	BulkOperationBaseArgs
+}
+
 type ResolveWorkspacesForBatchSpecArgs struct {
 	BatchSpec string
 }
@@ -270,6 +294,9 @@ type BatchChangesResolver interface {
 	ApplyBatchChange(ctx context.Context, args *ApplyBatchChangeArgs) (BatchChangeResolver, error)
 	CloseBatchChange(ctx context.Context, args *CloseBatchChangeArgs) (BatchChangeResolver, error)
 	MoveBatchChange(ctx context.Context, args *MoveBatchChangeArgs) (BatchChangeResolver, error)
+	UpdateBatchChangeSchedule(ctx context.Context, args *UpdateBatchChangeScheduleArgs) (BatchChangeResolver, error)
+	SetBatchChangeAutoMergePolicy(ctx context.Context, args *SetBatchChangeAutoMergePolicyArgs) (BatchChangeResolver, error)
+	DeleteBatchChangeAutoMergePolicy(ctx context.Context, args *DeleteBatchChangeAutoMergePolicyArgs) (BatchChangeResolver, error)
 	DeleteBatchChange(ctx context.Context, args *DeleteBatchChangeArgs) (*EmptyResponse, error)
 	CreateBatchChangesCredential(ctx context.Context, args *CreateBatchChangesCredentialArgs) (BatchChangesCredentialResolver, error)
 	DeleteBatchChangesCredential(ctx context.Context, args *DeleteBatchChangesCredentialArgs) (*EmptyResponse, error)
@@ -284,6 +311,7 @@ type BatchChangesResolver interface {
 	MergeChangesets(ctx context.Context, args *MergeChangesetsArgs) (BulkOperationResolver, error)
 	CloseChangesets(ctx context.Context, args *CloseChangesetsArgs) (BulkOperationResolver, error)
 	PublishChangesets(ctx context.Context, args *PublishChangesetsArgs) (BulkOperationResolver, error)
+	RebaseChangesets(ctx context.Context, args *RebaseChangesetsArgs) (BulkOperationResolver, error)
 
 	// Queries
 	BatchChange(ctx context.Context, args *BatchChangeArgs) (BatchChangeResolver, error)
@@ -304,6 +332,9 @@ type BatchChangesResolver interface {
 
 	MaxUnlicensedChangesets(ctx context.Context) int32
 
+	ChangesetCountSnapshots(ctx context.Context, args *ChangesetCountSnapshotsArgs) ([]ChangesetCountSnapshotGroupResolver, error)
+	DryRunApplyBatchChange(ctx context.Context, args *ApplyBatchChangeArgs) (ApplyDryRunReportResolver, error)
+
 	NodeResolvers() map[string]NodeByIDFunc
 }
 
@@ -580,6 +611,15 @@ type ChangesetCountsArgs struct {
 	IncludeArchived bool
 }
 
+type ChangesetCountSnapshotsArgs struct {
+	BatchChange *graphql.ID
+	From        *gqlutil.DateTime
+	To          *gqlutil.DateTime
+	// GroupBy is a value of type btypes.ChangesetCountSnapshotGrouping.
+	GroupBy         string
+	RepoMetadataKey *string
+}
+
 type ListChangesetsArgs struct {
 	First int32
 	After *string
@@ -638,6 +678,11 @@ type ListRecentlyCompletedWorkspacesArgs struct {
 	After *string
 }
 
+type ListBatchChangeScheduledRunsArgs struct {
+	First int32
+	After *string
+}
+
 type ListRecentlyErroredWorkspacesArgs struct {
 	First int32
 	After *string
@@ -669,6 +714,35 @@ type BatchChangeResolver interface {
 	CurrentSpec(ctx context.Context) (BatchSpecResolver, error)
 	BulkOperations(ctx context.Context, args *ListBatchChangeBulkOperationArgs) (BulkOperationConnectionResolver, error)
 	BatchSpecs(ctx context.Context, args *ListBatchSpecArgs) (BatchSpecConnectionResolver, error)
+	Schedule() *string
+	SchedulePaused() bool
+	NextScheduledRunAt() *gqlutil.DateTime
+	ScheduledRuns(ctx context.Context, args *ListBatchChangeScheduledRunsArgs) (BatchChangeScheduledRunConnectionResolver, error)
+	AutoMergePolicy(ctx context.Context) (BatchChangeAutoMergePolicyResolver, error)
+}
+
+type BatchChangeAutoMergePolicyResolver interface {
+	RequiredApprovals() int32
+	RequirePassingChecks() bool
+	MergeMethod() string
+	MaintenanceWindowDays() []string
+	MaintenanceWindowStart() *string
+	MaintenanceWindowEnd() *string
+	UpdatedAt() gqlutil.DateTime
+}
+
+type BatchChangeScheduledRunConnectionResolver interface {
+	TotalCount(ctx context.Context) (int32, error)
+	PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error)
+	Nodes(ctx context.Context) ([]BatchChangeScheduledRunResolver, error)
+}
+
+type BatchChangeScheduledRunResolver interface {
+	State() string
+	BatchSpec(ctx context.Context) (BatchSpecResolver, error)
+	FailureMessage() *string
+	CreatedAt() gqlutil.DateTime
+	FinishedAt() *gqlutil.DateTime
 }
 
 type BatchChangesConnectionResolver interface {
@@ -739,6 +813,7 @@ type ChangesetsStatsResolver interface {
 	Processing() int32
 	Deleted() int32
 	Archived() int32
+	Conflicted() int32
 	IsCompleted() bool
 	PercentComplete() int32
 }
@@ -855,6 +930,44 @@ type ChangesetCountsResolver interface {
 	OpenPending() int32
 }
 
+type ApplyDryRunReportResolver interface {
+	Operations() []ApplyDryRunOperationCountResolver
+	CodeHosts() []ApplyDryRunCodeHostResolver
+	Changesets() []ApplyDryRunChangesetResolver
+	UnchangedCount() int32
+	DownloadURL() string
+}
+
+type ApplyDryRunOperationCountResolver interface {
+	Operation() string
+	Count() int32
+}
+
+type ApplyDryRunCodeHostResolver interface {
+	ExternalServiceKind() string
+	ExternalServiceURL() string
+	Operations() []ApplyDryRunOperationCountResolver
+}
+
+type ApplyDryRunChangesetResolver interface {
+	RepositoryName() string
+	Changeset() *graphql.ID
+	Operations() []string
+	Branch() *string
+	Fork() bool
+	ForkNamespace() *string
+	Title() *string
+	PreviousTitle() *string
+	BodyChanged() bool
+	BaseRefChanged() bool
+	DiffChanged() bool
+}
+
+type ChangesetCountSnapshotGroupResolver interface {
+	Group() string
+	Counts() []ChangesetCountsResolver
+}
+
 type BatchSpecWorkspaceResolutionResolver interface {
 	State() string
 	StartedAt() *gqlutil.DateTime
@@ -706,6 +706,9 @@ func newSchemaResolver(db database.DB, gitserverClient gitserver.Client, enterpr
 		"SavedSearch": func(ctx context.Context, id graphql.ID) (Node, error) {
 			return r.savedSearchByID(ctx, id)
 		},
+		"SearchHistoryEntry": func(ctx context.Context, id graphql.ID) (Node, error) {
+			return searchHistoryEntryByID(ctx, db, id)
+		},
 		"Site": func(ctx context.Context, id graphql.ID) (Node, error) {
 			return r.siteByGQLID(ctx, id)
 		},
@@ -211,6 +211,11 @@ func (r *NodeResolver) ToSavedSearch() (*savedSearchResolver, bool) {
 	return n, ok
 }
 
+func (r *NodeResolver) ToSearchHistoryEntry() (*searchHistoryEntryResolver, bool) {
+	n, ok := r.Node.(*searchHistoryEntryResolver)
+	return n, ok
+}
+
 func (r *NodeResolver) ToSearchContext() (SearchContextResolver, bool) {
 	n, ok := r.Node.(SearchContextResolver)
 	return n, ok
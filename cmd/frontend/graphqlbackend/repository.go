@@ -520,6 +520,10 @@ func (r *RepositoryResolver) PreviewGitObjectFilter(ctx context.Context, args *r
 	return EnterpriseResolvers.codeIntelResolver.PreviewGitObjectFilter(ctx, r.ID(), args)
 }
 
+func (r *RepositoryResolver) CodeIntelligenceStorageQuotaOverview(ctx context.Context) (resolverstubs.CodeIntelligenceStorageQuotaOverviewResolver, error) {
+	return EnterpriseResolvers.codeIntelResolver.CodeIntelligenceStorageQuotaOverview(ctx, r.ID())
+}
+
 type AuthorizedUserArgs struct {
 	RepositoryID graphql.ID
 	Permission   string
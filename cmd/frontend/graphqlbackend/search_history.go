@@ -0,0 +1,221 @@
+package graphqlbackend
+
+import (
+	"context"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+)
+
+type searchHistoryEntryResolver struct {
+	db    database.DB
+	entry *database.SearchHistoryEntry
+}
+
+func marshalSearchHistoryEntryID(id int32) graphql.ID {
+	return relay.MarshalID("SearchHistoryEntry", id)
+}
+
+func unmarshalSearchHistoryEntryID(id graphql.ID) (entryID int32, err error) {
+	err = relay.UnmarshalSpec(id, &entryID)
+	return
+}
+
+// searchHistoryEntryByID returns the search history entry with the given ID.
+//
+// 🚨 SECURITY: Search history is private, only the user who ran a search can
+// access its entry.
+func searchHistoryEntryByID(ctx context.Context, db database.DB, id graphql.ID) (*searchHistoryEntryResolver, error) {
+	entryID, err := unmarshalSearchHistoryEntryID(id)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := db.SearchHistory().GetByID(ctx, entryID)
+	if err != nil {
+		return nil, err
+	}
+	if err := auth.CheckSameUser(ctx, entry.UserID); err != nil {
+		return nil, err
+	}
+	return &searchHistoryEntryResolver{db: db, entry: entry}, nil
+}
+
+func (r *searchHistoryEntryResolver) ID() graphql.ID {
+	return marshalSearchHistoryEntryID(r.entry.ID)
+}
+
+func (r *searchHistoryEntryResolver) Query() string { return r.entry.Query }
+
+func (r *searchHistoryEntryResolver) PatternType() string { return r.entry.PatternType }
+
+func (r *searchHistoryEntryResolver) ResultCount() int32 { return int32(r.entry.ResultCount) }
+
+func (r *searchHistoryEntryResolver) DurationMilliseconds() int32 {
+	return int32(r.entry.Duration.Milliseconds())
+}
+
+func (r *searchHistoryEntryResolver) AlertTitle() *string {
+	if r.entry.AlertTitle == "" {
+		return nil
+	}
+	return &r.entry.AlertTitle
+}
+
+func (r *searchHistoryEntryResolver) RunCount() int32 { return int32(r.entry.RunCount) }
+
+func (r *searchHistoryEntryResolver) Pinned() bool { return r.entry.Pinned }
+
+func (r *searchHistoryEntryResolver) CreatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.entry.CreatedAt}
+}
+
+func (r *searchHistoryEntryResolver) LastRunAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.entry.LastRunAt}
+}
+
+func (r *searchHistoryEntryResolver) User(ctx context.Context) (*UserResolver, error) {
+	return UserByIDInt32(ctx, r.db, r.entry.UserID)
+}
+
+type searchHistoryArgs struct {
+	graphqlutil.ConnectionResolverArgs
+	Query  *string
+	Pinned *bool
+}
+
+// SearchHistory returns the searches run by the user, most recently run first.
+func (r *UserResolver) SearchHistory(ctx context.Context, args *searchHistoryArgs) (*graphqlutil.ConnectionResolver[*searchHistoryEntryResolver], error) {
+	// 🚨 SECURITY: Search history is private, only the user themselves can
+	// list it.
+	if err := auth.CheckSameUser(ctx, r.user.ID); err != nil {
+		return nil, err
+	}
+
+	opts := database.SearchHistoryListOptions{UserID: r.user.ID, Pinned: args.Pinned}
+	if args.Query != nil {
+		opts.Query = *args.Query
+	}
+	connectionStore := &searchHistoryConnectionStore{db: r.db, opts: opts}
+	return graphqlutil.NewConnectionResolver[*searchHistoryEntryResolver](
+		connectionStore,
+		&args.ConnectionResolverArgs,
+		&graphqlutil.ConnectionResolverOptions{
+			OrderBy: database.SearchHistoryOrderBy,
+		},
+	)
+}
+
+type searchHistoryConnectionStore struct {
+	db   database.DB
+	opts database.SearchHistoryListOptions
+}
+
+// searchHistoryCursor is the position of an entry in the search history.
+type searchHistoryCursor struct {
+	LastRunAt time.Time `json:"lastRunAt"`
+	ID        int32     `json:"id"`
+}
+
+func (s *searchHistoryConnectionStore) MarshalCursor(node *searchHistoryEntryResolver, _ database.OrderBy) (*string, error) {
+	cursor := string(relay.MarshalID("SearchHistoryCursor", searchHistoryCursor{
+		LastRunAt: node.entry.LastRunAt,
+		ID:        node.entry.ID,
+	}))
+	return &cursor, nil
+}
+
+func (s *searchHistoryConnectionStore) UnmarshalCursor(cursor string, _ database.OrderBy) (*string, error) {
+	var c searchHistoryCursor
+	if err := relay.UnmarshalSpec(graphql.ID(cursor), &c); err != nil {
+		return nil, err
+	}
+	after := database.SearchHistoryCursor(c.LastRunAt, c.ID)
+	return &after, nil
+}
+
+func (s *searchHistoryConnectionStore) ComputeTotal(ctx context.Context) (*int32, error) {
+	// Existing search history is hidden while recording is disabled.
+	if !conf.SearchHistoryEnabled() {
+		var total int32
+		return &total, nil
+	}
+	count, err := s.db.SearchHistory().Count(ctx, s.opts)
+	if err != nil {
+		return nil, err
+	}
+	total := int32(count)
+	return &total, nil
+}
+
+func (s *searchHistoryConnectionStore) ComputeNodes(ctx context.Context, args *database.PaginationArgs) ([]*searchHistoryEntryResolver, error) {
+	if !conf.SearchHistoryEnabled() {
+		return nil, nil
+	}
+	entries, err := s.db.SearchHistory().List(ctx, s.opts, args)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*searchHistoryEntryResolver, 0, len(entries))
+	for _, entry := range entries {
+		resolvers = append(resolvers, &searchHistoryEntryResolver{db: s.db, entry: entry})
+	}
+	return resolvers, nil
+}
+
+func (r *schemaResolver) SetSearchHistoryEntryPinned(ctx context.Context, args *struct {
+	ID     graphql.ID
+	Pinned bool
+}) (*searchHistoryEntryResolver, error) {
+	// 🚨 SECURITY: searchHistoryEntryByID ensures that only the user who ran
+	// the search can pin it.
+	entry, err := searchHistoryEntryByID(ctx, r.db, args.ID)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := r.db.SearchHistory().SetPinned(ctx, entry.entry.ID, args.Pinned)
+	if err != nil {
+		return nil, err
+	}
+	return &searchHistoryEntryResolver{db: r.db, entry: updated}, nil
+}
+
+func (r *schemaResolver) DeleteSearchHistoryEntry(ctx context.Context, args *struct {
+	ID graphql.ID
+}) (*EmptyResponse, error) {
+	// 🚨 SECURITY: searchHistoryEntryByID ensures that only the user who ran
+	// the search can delete it.
+	entry, err := searchHistoryEntryByID(ctx, r.db, args.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.db.SearchHistory().Delete(ctx, entry.entry.ID); err != nil {
+		return nil, err
+	}
+	return &EmptyResponse{}, nil
+}
+
+func (r *schemaResolver) ClearSearchHistory(ctx context.Context, args *struct {
+	User graphql.ID
+}) (*EmptyResponse, error) {
+	userID, err := UnmarshalUserID(args.User)
+	if err != nil {
+		return nil, err
+	}
+	// 🚨 SECURITY: Only the user and site admins can clear the search history
+	// of a user.
+	if err := auth.CheckSiteAdminOrSameUser(ctx, r.db, userID); err != nil {
+		return nil, err
+	}
+	if err := r.db.SearchHistory().DeleteAllForUser(ctx, userID); err != nil {
+		return nil, err
+	}
+	return &EmptyResponse{}, nil
+}
@@ -0,0 +1,142 @@
+package graphqlbackend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockassert "github.com/derision-test/go-mockgen/testutil/assert"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestSearchHistory(t *testing.T) {
+	lastRunAt := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	entry := &database.SearchHistoryEntry{
+		ID:          7,
+		UserID:      1,
+		Query:       "repo:foo bar",
+		PatternType: "standard",
+		ResultCount: 3,
+		Duration:    250 * time.Millisecond,
+		AlertTitle:  "Some results excluded",
+		RunCount:    2,
+		CreatedAt:   lastRunAt.Add(-time.Hour),
+		LastRunAt:   lastRunAt,
+	}
+
+	users := database.NewMockUserStore()
+	users.GetByIDFunc.SetDefaultHook(func(_ context.Context, id int32) (*types.User, error) {
+		return &types.User{ID: id, Username: "alice"}, nil
+	})
+	history := database.NewMockSearchHistoryStore()
+	history.ListFunc.SetDefaultReturn([]*database.SearchHistoryEntry{entry}, nil)
+	history.CountFunc.SetDefaultReturn(1, nil)
+	history.GetByIDFunc.SetDefaultReturn(entry, nil)
+	history.SetPinnedFunc.SetDefaultHook(func(_ context.Context, _ int32, pinned bool) (*database.SearchHistoryEntry, error) {
+		pinnedEntry := *entry
+		pinnedEntry.Pinned = pinned
+		return &pinnedEntry, nil
+	})
+
+	db := database.NewMockDB()
+	db.UsersFunc.SetDefaultReturn(users)
+	db.SearchHistoryFunc.SetDefaultReturn(history)
+
+	t.Run("list", func(t *testing.T) {
+		RunTest(t, &Test{
+			Schema:  mustParseGraphQLSchema(t, db),
+			Context: actor.WithActor(context.Background(), actor.FromUser(1)),
+			Query: `
+			query SearchHistory($user: ID!) {
+				node(id: $user) {
+					... on User {
+						searchHistory(first: 10, query: "foo") {
+							totalCount
+							nodes {
+								query
+								patternType
+								resultCount
+								durationMilliseconds
+								alertTitle
+								runCount
+								pinned
+								lastRunAt
+							}
+						}
+					}
+				}
+			}`,
+			Variables: map[string]any{"user": string(MarshalUserID(1))},
+			ExpectedResult: `{
+				"node": {
+					"searchHistory": {
+						"totalCount": 1,
+						"nodes": [{
+							"query": "repo:foo bar",
+							"patternType": "standard",
+							"resultCount": 3,
+							"durationMilliseconds": 250,
+							"alertTitle": "Some results excluded",
+							"runCount": 2,
+							"pinned": false,
+							"lastRunAt": "2023-06-01T12:00:00Z"
+						}]
+					}
+				}
+			}`,
+		})
+
+		opts := history.ListFunc.History()[0].Arg1
+		if opts.UserID != 1 || opts.Query != "foo" {
+			t.Errorf("unexpected list options %+v", opts)
+		}
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		RunTest(t, &Test{
+			Schema:  mustParseGraphQLSchema(t, db),
+			Context: actor.WithActor(context.Background(), actor.FromUser(2)),
+			Query: `
+			mutation {
+				setSearchHistoryEntryPinned(id: "U2VhcmNoSGlzdG9yeUVudHJ5Ojc=", pinned: true) {
+					pinned
+				}
+			}`,
+			ExpectedResult: `null`,
+			ExpectedErrors: []*gqlerrors.QueryError{
+				{
+					Path:          []any{"setSearchHistoryEntryPinned"},
+					Message:       "must be authenticated as user with id 1",
+					ResolverError: &auth.InsufficientAuthorizationError{Message: "must be authenticated as user with id 1"},
+				},
+			},
+		})
+		mockassert.NotCalled(t, history.SetPinnedFunc)
+	})
+
+	t.Run("pin", func(t *testing.T) {
+		RunTest(t, &Test{
+			Schema:  mustParseGraphQLSchema(t, db),
+			Context: actor.WithActor(context.Background(), actor.FromUser(1)),
+			Query: `
+			mutation {
+				setSearchHistoryEntryPinned(id: "U2VhcmNoSGlzdG9yeUVudHJ5Ojc=", pinned: true) {
+					query
+					pinned
+				}
+			}`,
+			ExpectedResult: `{
+				"setSearchHistoryEntryPinned": {
+					"query": "repo:foo bar",
+					"pinned": true
+				}
+			}`,
+		})
+		mockassert.CalledOnce(t, history.SetPinnedFunc)
+	})
+}
@@ -163,6 +163,8 @@ func matchesToResolvers(db database.DB, matches []result.Match) []SearchResultRe
 			})
 		case *result.OwnerMatch:
 			// todo(own): add OwnerSearchResultResolver
+		case *result.DiagnosticMatch:
+			// Diagnostic matches are only exposed through the streaming API.
 		}
 	}
 	return resolvers
@@ -293,8 +295,8 @@ loop:
 	for _, r := range sr.Matches {
 		r := r // shadow so it doesn't change in the goroutine
 		switch m := r.(type) {
-		case *result.RepoMatch, *result.OwnerMatch:
-			// We don't care about repo or owner results here.
+		case *result.RepoMatch, *result.OwnerMatch, *result.DiagnosticMatch:
+			// We don't care about repo, owner, or diagnostic results here.
 			continue
 		case *result.CommitMatch:
 			// Diff searches are cheap, because we implicitly have author date info.
@@ -38,6 +38,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/search/job/jobutil"
 	"github.com/sourcegraph/sourcegraph/internal/search/searchcontexts"
+	"github.com/sourcegraph/sourcegraph/internal/search/suggest"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -61,12 +62,15 @@ type Handlers struct {
 	BatchesChangesFileGetHandler    http.Handler
 	BatchesChangesFileExistsHandler http.Handler
 	BatchesChangesFileUploadHandler http.Handler
+	BatchesCountsExportHandler      http.Handler
+	BatchesDryRunApplyHandler       http.Handler
 
 	// SCIM
 	SCIMHandler http.Handler
 
 	// Code intel
-	NewCodeIntelUploadHandler enterprise.NewCodeIntelUploadHandler
+	NewCodeIntelUploadHandler  enterprise.NewCodeIntelUploadHandler
+	CodeIntelSBOMExportHandler http.Handler
 
 	// Compute
 	NewComputeStreamHandler enterprise.NewComputeStreamHandler
@@ -80,6 +84,9 @@ type Handlers struct {
 	// Completions stream
 	NewChatCompletionsStreamHandler enterprise.NewChatCompletionsStreamHandler
 	NewCodeCompletionsHandler       enterprise.NewCodeCompletionsHandler
+
+	// Search suggestions
+	OwnerSuggester suggest.OwnerSuggester
 }
 
 // NewHandler returns a new API handler that uses the provided API
@@ -151,9 +158,12 @@ func NewHandler(
 	m.Get(apirouter.BatchesFileGet).Handler(trace.Route(handlers.BatchesChangesFileGetHandler))
 	m.Get(apirouter.BatchesFileExists).Handler(trace.Route(handlers.BatchesChangesFileExistsHandler))
 	m.Get(apirouter.BatchesFileUpload).Handler(trace.Route(handlers.BatchesChangesFileUploadHandler))
+	m.Get(apirouter.BatchesCountsExport).Handler(trace.Route(handlers.BatchesCountsExportHandler))
+	m.Get(apirouter.BatchesDryRunApply).Handler(trace.Route(handlers.BatchesDryRunApplyHandler))
 	m.Get(apirouter.LSIFUpload).Handler(trace.Route(lsifDeprecationHandler))
 	m.Get(apirouter.SCIPUpload).Handler(trace.Route(handlers.NewCodeIntelUploadHandler(true)))
 	m.Get(apirouter.SCIPUploadExists).Handler(trace.Route(noopHandler))
+	m.Get(apirouter.CodeIntelSBOM).Handler(trace.Route(handlers.CodeIntelSBOMExportHandler))
 	m.Get(apirouter.ComputeStream).Handler(trace.Route(handlers.NewComputeStreamHandler()))
 	m.Get(apirouter.ChatCompletionsStream).Handler(trace.Route(handlers.NewChatCompletionsStreamHandler()))
 	m.Get(apirouter.CodeCompletions).Handler(trace.Route(handlers.NewCodeCompletionsHandler()))
@@ -171,6 +181,7 @@ func NewHandler(
 	m.Get(apirouter.GraphQL).Handler(trace.Route(handler(serveGraphQL(logger, schema, rateLimiter, false))))
 
 	m.Get(apirouter.SearchStream).Handler(trace.Route(frontendsearch.StreamHandler(db, enterpriseJobs)))
+	m.Get(apirouter.SearchSuggest).Handler(trace.Route(frontendsearch.SuggestHandler(db, enterpriseJobs, handlers.OwnerSuggester)))
 
 	// Return the minimum src-cli version that's compatible with this instance
 	m.Get(apirouter.SrcCli).Handler(trace.Route(newSrcCliVersionHandler(logger)))
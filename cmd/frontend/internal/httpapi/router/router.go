@@ -13,8 +13,10 @@ const (
 	LSIFUpload       = "lsif.upload"
 	SCIPUpload       = "scip.upload"
 	SCIPUploadExists = "scip.upload.exists"
+	CodeIntelSBOM    = "codeintel.sbom"
 
 	SearchStream          = "search.stream"
+	SearchSuggest         = "search.suggest"
 	ComputeStream         = "compute.stream"
 	GitBlameStream        = "git.blame.stream"
 	ChatCompletionsStream = "completions.stream"
@@ -41,6 +43,9 @@ const (
 	BatchesFileExists = "batches.file.exists"
 	BatchesFileUpload = "batches.file.upload"
 
+	BatchesCountsExport = "batches.counts.export"
+	BatchesDryRunApply  = "batches.dry-run-apply"
+
 	CodeInsightsDataExport = "insights.data.export"
 
 	ExternalURL            = "internal.app-url"
@@ -77,10 +82,14 @@ func New(base *mux.Router) *mux.Router {
 	base.Path("/files/batch-changes/{spec}/{file}").Methods("GET").Name(BatchesFileGet)
 	base.Path("/files/batch-changes/{spec}/{file}").Methods("HEAD").Name(BatchesFileExists)
 	base.Path("/files/batch-changes/{spec}").Methods("POST").Name(BatchesFileUpload)
+	base.Path("/batch-changes/changeset-count-snapshots/export").Methods("GET").Name(BatchesCountsExport)
+	base.Path("/batch-changes/dry-run-apply").Methods("GET").Name(BatchesDryRunApply)
 	base.Path("/lsif/upload").Methods("POST").Name(LSIFUpload)
 	base.Path("/scip/upload").Methods("POST").Name(SCIPUpload)
 	base.Path("/scip/upload").Methods("HEAD").Name(SCIPUploadExists)
+	base.Path("/codeintel/sbom").Methods("GET").Name(CodeIntelSBOM)
 	base.Path("/search/stream").Methods("GET").Name(SearchStream)
+	base.Path("/search/suggest").Methods("GET").Name(SearchSuggest)
 	base.Path("/compute/stream").Methods("GET", "POST").Name(ComputeStream)
 	base.Path("/blame/" + routevar.Repo + routevar.RepoRevSuffix + "/stream/{Path:.*}").Methods("GET").Name(GitBlameStream)
 	base.Path("/src-cli/versions/{rest:.*}").Methods("GET", "POST").Name(SrcCliVersionCache)
@@ -19,6 +19,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 
 	searchlogs "github.com/sourcegraph/sourcegraph/cmd/frontend/internal/search/logs"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
@@ -174,9 +175,51 @@ func (h *streamHandler) serveHTTP(r *http.Request, tr *trace.Trace, eventWriter
 		eventWriter.Alert(alert)
 	}
 	logSearch(ctx, h.logger, alert, err, time.Since(start), latency, inputs.OriginalQuery, progress)
+	if err == nil {
+		h.recordSearchHistory(ctx, r, inputs, alert, time.Since(start), progress)
+	}
 	return err
 }
 
+// searchHistoryTimeout bounds how long recording a search in the search
+// history may take.
+const searchHistoryTimeout = 5 * time.Second
+
+// recordSearchHistory adds a completed search to the search history of the
+// current user. Only searches run from the browser are recorded, so that
+// scripted searches do not crowd out the searches a user ran themselves.
+//
+// The entry is recorded in the background, so that completing the search
+// doesn't wait on the database.
+func (h *streamHandler) recordSearchHistory(ctx context.Context, r *http.Request, inputs *search.Inputs, alert *search.Alert, duration time.Duration, progress *streamclient.ProgressAggregator) {
+	a := actor.FromContext(ctx)
+	if !a.IsAuthenticated() || a.IsInternal() || !conf.SearchHistoryEnabled() || GuessSource(r) != trace.SourceBrowser {
+		return
+	}
+
+	run := database.SearchHistoryRun{
+		UserID:      a.UID,
+		Query:       inputs.OriginalQuery,
+		PatternType: inputs.PatternType.String(),
+		SearchMode:  int(inputs.SearchMode),
+		ResultCount: progress.MatchCount,
+		Duration:    duration,
+	}
+	if alert != nil {
+		run.AlertTitle = alert.Title
+	}
+
+	go func() {
+		// The request context is canceled once the search completes.
+		ctx, cancel := context.WithTimeout(actor.WithActor(context.Background(), a), searchHistoryTimeout)
+		defer cancel()
+
+		if _, err := h.db.SearchHistory().Record(ctx, run); err != nil {
+			h.logger.Warn("failed to record search history", log.Int32("userID", a.UID), log.Error(err))
+		}
+	}()
+}
+
 func logSearch(ctx context.Context, logger log.Logger, alert *search.Alert, err error, duration time.Duration, latency *time.Duration, originalQuery string, progress *streamclient.ProgressAggregator) {
 	if honey.Enabled() {
 		status := client.DetermineStatusForLogs(alert, progress.Stats, err)
@@ -267,6 +310,8 @@ func fromMatch(match result.Match, repoCache map[api.RepoID]*types.SearchedRepo,
 		return fromCommit(v, repoCache)
 	case *result.OwnerMatch:
 		return fromOwner(v)
+	case *result.DiagnosticMatch:
+		return fromDiagnosticMatch(v, repoCache)
 	default:
 		panic(fmt.Sprintf("unknown match type %T", v))
 	}
@@ -489,6 +534,42 @@ func fromCommit(commit *result.CommitMatch, repoCache map[api.RepoID]*types.Sear
 	return commitEvent
 }
 
+func fromDiagnosticMatch(dm *result.DiagnosticMatch, repoCache map[api.RepoID]*types.SearchedRepo) *streamhttp.EventDiagnosticMatch {
+	diagnostics := make([]streamhttp.Diagnostic, 0, len(dm.Diagnostics))
+	for _, d := range dm.Diagnostics {
+		diagnostics = append(diagnostics, streamhttp.Diagnostic{
+			Severity: d.Severity,
+			Code:     d.Code,
+			Message:  d.Message,
+			Source:   d.Source,
+			Range: streamhttp.Range{
+				Start: fromLocation(d.Range.Start),
+				End:   fromLocation(d.Range.End),
+			},
+		})
+	}
+
+	diagnosticEvent := &streamhttp.EventDiagnosticMatch{
+		Type:         streamhttp.DiagnosticMatchType,
+		Path:         dm.Path,
+		RepositoryID: int32(dm.Repo.ID),
+		Repository:   string(dm.Repo.Name),
+		Commit:       string(dm.CommitID),
+		Diagnostics:  diagnostics,
+	}
+
+	if dm.InputRev != nil {
+		diagnosticEvent.Branches = []string{*dm.InputRev}
+	}
+
+	if r, ok := repoCache[dm.Repo.ID]; ok {
+		diagnosticEvent.RepoStars = r.Stars
+		diagnosticEvent.RepoLastFetched = r.LastFetched
+	}
+
+	return diagnosticEvent
+}
+
 func fromOwner(owner *result.OwnerMatch) streamhttp.EventMatch {
 	switch v := owner.ResolvedOwner.(type) {
 	case *result.OwnerPerson:
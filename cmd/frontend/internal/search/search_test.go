@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/sourcegraph/sourcegraph/internal/actor"
 	api2 "github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/search"
@@ -284,3 +285,54 @@ func mkRepoMatch(id int) *result.RepoMatch {
 		Name: api2.RepoName(fmt.Sprintf("repo%d", id)),
 	}
 }
+
+func TestServeStream_searchHistory(t *testing.T) {
+	settings.MockCurrentUserFinal = &schema.Settings{}
+	t.Cleanup(func() { settings.MockCurrentUserFinal = nil })
+
+	mock := client.NewMockSearchClient()
+	mock.PlanFunc.SetDefaultReturn(&search.Inputs{OriginalQuery: "repo:foo bar", PatternType: query.SearchTypeStandard}, nil)
+	mock.ExecuteFunc.SetDefaultReturn(&search.Alert{Title: "No results"}, nil)
+
+	history := database.NewMockSearchHistoryStore()
+	db := database.NewMockDB()
+	db.ReposFunc.SetDefaultReturn(database.NewMockRepoStore())
+	db.SearchHistoryFunc.SetDefaultReturn(history)
+
+	h := &streamHandler{
+		logger:              logtest.Scoped(t),
+		db:                  db,
+		flushTickerInternal: 1 * time.Millisecond,
+		pingTickerInterval:  1 * time.Millisecond,
+		searchClient:        mock,
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.ServeHTTP(w, r.WithContext(actor.WithActor(r.Context(), actor.FromUser(42))))
+	}))
+	defer ts.Close()
+
+	runSearch := func(userAgent string) {
+		req, err := http.NewRequest("GET", ts.URL+"?q=test", nil)
+		require.NoError(t, err)
+		req.Header.Set("User-Agent", userAgent)
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		_, err = io.ReadAll(res.Body)
+		res.Body.Close()
+		require.NoError(t, err)
+	}
+
+	// Searches from API clients are not recorded.
+	runSearch("src-cli")
+	require.Empty(t, history.RecordFunc.History())
+
+	runSearch("Mozilla/5.0")
+	// Searches are recorded in the background.
+	require.Eventually(t, func() bool { return len(history.RecordFunc.History()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	calls := history.RecordFunc.History()
+	run := calls[0].Arg1
+	require.Equal(t, int32(42), run.UserID)
+	require.Equal(t, "repo:foo bar", run.Query)
+	require.Equal(t, "standard", run.PatternType)
+	require.Equal(t, "No results", run.AlertTitle)
+}
@@ -0,0 +1,99 @@
+package search
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/sourcegraph/log"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/search/client"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/jobutil"
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
+	"github.com/sourcegraph/sourcegraph/internal/search/suggest"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// SuggestHandler is an http handler which streams back completions for a
+// partial search query. It is used by the web app, editor extensions and
+// src-cli.
+//
+// The request takes the query in the "q" parameter and the byte offset of
+// the cursor in the "pos" parameter, which defaults to the end of the
+// query. Suggestions are streamed in batches as "suggestions" events,
+// followed by a final "done" event.
+//
+// Owners are only suggested if owners is not nil.
+func SuggestHandler(db database.DB, enterpriseJobs jobutil.EnterpriseJobs, owners suggest.OwnerSuggester) http.Handler {
+	logger := log.Scoped("searchSuggestHandler", "")
+	return &suggestHandler{
+		logger:  logger,
+		service: suggest.NewService(logger, db, client.New(logger, db, enterpriseJobs), owners),
+	}
+}
+
+type suggestHandler struct {
+	logger  log.Logger
+	service *suggest.Service
+}
+
+func (h *suggestHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tr, ctx := trace.New(r.Context(), "search.ServeSuggest", "")
+	defer tr.Finish()
+
+	req, err := parseSuggestURLQuery(r.URL.Query())
+	if err != nil {
+		tr.SetError(err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	tr.SetAttributes(
+		attribute.String("query", req.Query),
+		attribute.Int("position", req.Position),
+	)
+
+	streamWriter, err := streamhttp.NewWriter(w)
+	if err != nil {
+		tr.SetError(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer streamWriter.Event("done", map[string]any{})
+
+	err = h.service.Suggest(ctx, *req, func(suggestions []suggest.Suggestion) error {
+		return streamWriter.Event("suggestions", suggestions)
+	})
+	if err != nil {
+		tr.SetError(err)
+		_ = streamWriter.Event("error", streamhttp.EventError{Message: err.Error()})
+	}
+}
+
+func parseSuggestURLQuery(q url.Values) (*suggest.Request, error) {
+	req := suggest.Request{
+		Query:    q.Get("q"),
+		Position: -1,
+	}
+
+	if pos := q.Get("pos"); pos != "" {
+		var err error
+		if req.Position, err = strconv.Atoi(pos); err != nil {
+			return nil, errors.Errorf("pos must be an integer, got %q: %w", pos, err)
+		}
+	}
+
+	if limit := q.Get("limit"); limit != "" {
+		var err error
+		if req.Limit, err = strconv.Atoi(limit); err != nil {
+			return nil, errors.Errorf("limit must be an integer, got %q: %w", limit, err)
+		}
+		if req.Limit < 1 || req.Limit > suggest.MaxLimit {
+			return nil, errors.Errorf("limit must be between 1 and %d, got %d", suggest.MaxLimit, req.Limit)
+		}
+	}
+
+	return &req, nil
+}
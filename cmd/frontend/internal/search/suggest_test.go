@@ -0,0 +1,37 @@
+package search
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/suggest"
+)
+
+func TestParseSuggestURLQuery(t *testing.T) {
+	got, err := parseSuggestURLQuery(url.Values{"q": {"repo:foo"}, "pos": {"3"}, "limit": {"5"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &suggest.Request{Query: "repo:foo", Position: 3, Limit: 5}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected request (-want +got):\n%s", diff)
+	}
+
+	got, err = parseSuggestURLQuery(url.Values{"q": {"repo:foo"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Position != -1 {
+		t.Errorf("expected position to default to end of query, got %d", got.Position)
+	}
+
+	if _, err := parseSuggestURLQuery(url.Values{"q": {"foo"}, "pos": {"x"}}); err == nil {
+		t.Error("expected error for invalid position")
+	}
+
+	if _, err := parseSuggestURLQuery(url.Values{"q": {"foo"}, "limit": {"100000"}}); err == nil {
+		t.Error("expected error for limit above maximum")
+	}
+}
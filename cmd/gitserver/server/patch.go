@@ -349,6 +349,51 @@ func (s *Server) createCommitFromPatch(ctx context.Context, req protocol.CreateC
 	return http.StatusOK, resp
 }
 
+func (s *Server) handleDeleteRef(w http.ResponseWriter, r *http.Request) {
+	var req protocol.DeleteRefRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := validateDeletableRef(req.Ref); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := s.deleteRef(r.Context(), req); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// validateDeletableRef returns an error if the given ref may not be deleted.
+// Only the refs that gitserver creates to check changesets for conflicts can
+// be deleted, all other refs are managed by fetching from the code host.
+func validateDeletableRef(ref string) error {
+	name := strings.TrimPrefix(ref, protocol.ConflictCheckRefPrefix)
+	if name == ref || name == "" || strings.Contains(name, "..") {
+		return errors.Newf("deleting ref %q is not allowed", ref)
+	}
+	return nil
+}
+
+// deleteRef deletes the ref of the request. It is not an error if the ref
+// doesn't exist. The caller must validate the ref with validateDeletableRef.
+func (s *Server) deleteRef(ctx context.Context, req protocol.DeleteRefRequest) error {
+	dir := s.dir(req.Repo)
+	if !repoCloned(dir) {
+		return &gitdomain.RepoNotExistError{Repo: req.Repo}
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "update-ref", "-d", "--", req.Ref)
+	dir.Set(cmd)
+	if out, err := runCommandCombinedOutput(ctx, s.RecordingCommandFactory.Wrap(ctx, s.Logger, cmd)); err != nil {
+		return errors.Wrapf(err, "deleting ref (output: %q)", out)
+	}
+	return nil
+}
+
 func stylizeCommitMessage(message string) string {
 	if styleMessage(message) {
 		return fmt.Sprintf("%q", message)
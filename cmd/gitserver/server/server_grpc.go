@@ -66,6 +66,23 @@ func (gs *GRPCServer) CreateCommitFromPatchBinary(ctx context.Context, req *prot
 
 }
 
+func (gs *GRPCServer) DeleteRef(ctx context.Context, req *proto.DeleteRefRequest) (*proto.DeleteRefResponse, error) {
+	r := protocol.DeleteRefRequest{
+		Repo: api.RepoName(req.GetRepo()),
+		Ref:  req.GetRef(),
+	}
+
+	if err := validateDeletableRef(r.Ref); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := gs.Server.deleteRef(ctx, r); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.DeleteRefResponse{}, nil
+}
+
 func (gs *GRPCServer) Exec(req *proto.ExecRequest, ss proto.GitserverService_ExecServer) error {
 	internalReq := protocol.ExecRequest{
 		Repo:           api.RepoName(req.GetRepo()),
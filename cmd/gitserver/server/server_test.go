@@ -1528,6 +1528,29 @@ func TestHostnameMatch(t *testing.T) {
 	}
 }
 
+func TestValidateDeletableRef(t *testing.T) {
+	testCases := []struct {
+		ref     string
+		allowed bool
+	}{
+		{ref: "refs/sourcegraph/batch-changes/conflict-checks/1", allowed: true},
+		{ref: "refs/sourcegraph/batch-changes/conflict-checks/", allowed: false},
+		{ref: "refs/heads/main", allowed: false},
+		{ref: "refs/sourcegraph/batch-changes/conflict-checks/../../../heads/main", allowed: false},
+		{ref: "HEAD", allowed: false},
+		{ref: "", allowed: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.ref, func(t *testing.T) {
+			err := validateDeletableRef(tc.ref)
+			if have := err == nil; have != tc.allowed {
+				t.Fatalf("want allowed=%v, got error %v", tc.allowed, err)
+			}
+		})
+	}
+}
+
 func TestSyncRepoState(t *testing.T) {
 	logger := logtest.Scoped(t)
 	ctx, cancel := context.WithCancel(context.Background())
@@ -0,0 +1,25 @@
+package repolanguages
+
+import (
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/env"
+)
+
+type config struct {
+	env.BaseConfig
+
+	Interval       time.Duration
+	RefreshAfter   time.Duration
+	BatchSize      int
+	ComputeTimeout time.Duration
+}
+
+var ConfigInst = &config{}
+
+func (c *config) Load() {
+	c.Interval = c.GetInterval("REPO_LANGUAGES_UPDATER_INTERVAL", "1m", "How frequently to update the language statistics of a batch of repositories.")
+	c.RefreshAfter = c.GetInterval("REPO_LANGUAGES_REFRESH_AFTER", "24h", "How long to wait before checking whether the default branch of a repository changed.")
+	c.BatchSize = c.GetInt("REPO_LANGUAGES_BATCH_SIZE", "50", "The maximum number of repositories to update per interval.")
+	c.ComputeTimeout = c.GetInterval("REPO_LANGUAGES_COMPUTE_TIMEOUT", "3m", "The maximum time to spend computing the language statistics of a single repository.")
+}
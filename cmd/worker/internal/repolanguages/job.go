@@ -0,0 +1,52 @@
+package repolanguages
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
+	workerdb "github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/db"
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type updaterJob struct{}
+
+var _ job.Job = &updaterJob{}
+
+func NewUpdater() job.Job {
+	return &updaterJob{}
+}
+
+func (j *updaterJob) Description() string {
+	return "repolanguages.Updater keeps the language statistics of the default branch of each repository up to date for the repo:has.language() search predicate."
+}
+
+func (j *updaterJob) Config() []env.Config {
+	return []env.Config{ConfigInst}
+}
+
+func (j *updaterJob) Routines(_ context.Context, observationCtx *observation.Context) ([]goroutine.BackgroundRoutine, error) {
+	db, err := workerdb.InitDB(observationCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	gitserverClient := gitserver.NewClient()
+	return []goroutine.BackgroundRoutine{
+		goroutine.NewPeriodicGoroutine(
+			context.Background(),
+			&updater{
+				store:     db.RepoLanguages(),
+				gitserver: gitserverClient,
+				inventory: gitserverInventory(observationCtx.Logger, gitserverClient),
+				logger:    observationCtx.Logger,
+				config:    ConfigInst,
+			},
+			goroutine.WithName("search.repo-languages-updater"),
+			goroutine.WithDescription("computes the language statistics of the default branch of each repository"),
+			goroutine.WithInterval(ConfigInst.Interval),
+		),
+	}, nil
+}
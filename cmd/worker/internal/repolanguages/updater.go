@@ -0,0 +1,123 @@
+package repolanguages
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/inventory"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// inventoryFunc computes the language inventory of repo at commit.
+type inventoryFunc func(ctx context.Context, repo api.RepoName, commit api.CommitID) (*inventory.Inventory, error)
+
+// gitserverInventory computes inventories the same way the frontend does for
+// the repository language statistics shown in the UI.
+func gitserverInventory(logger log.Logger, gs gitserver.Client) inventoryFunc {
+	return func(ctx context.Context, repo api.RepoName, commit api.CommitID) (*inventory.Inventory, error) {
+		invCtx, err := backend.InventoryContext(logger, repo, gs, commit, false)
+		if err != nil {
+			return nil, err
+		}
+
+		root, err := gs.Stat(ctx, authz.DefaultSubRepoPermsChecker, repo, commit, "")
+		if err != nil {
+			return nil, err
+		}
+
+		inv, err := invCtx.Entries(ctx, root)
+		if err != nil {
+			return nil, err
+		}
+		return &inv, nil
+	}
+}
+
+// updater recomputes the language statistics of repositories whose
+// statistics are missing or haven't been checked for a while.
+type updater struct {
+	store     database.RepoLanguagesStore
+	gitserver gitserver.Client
+	inventory inventoryFunc
+	logger    log.Logger
+	config    *config
+	now       func() time.Time
+}
+
+var (
+	_ goroutine.Handler      = &updater{}
+	_ goroutine.ErrorHandler = &updater{}
+)
+
+func (u *updater) Handle(ctx context.Context) error {
+	now := time.Now
+	if u.now != nil {
+		now = u.now
+	}
+
+	stale, err := u.store.ListStale(ctx, now().Add(-u.config.RefreshAfter), u.config.BatchSize)
+	if err != nil {
+		return errors.Wrap(err, "listing repositories with stale language statistics")
+	}
+
+	var errs error
+	for _, repo := range stale {
+		if err := u.update(ctx, repo); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			errs = errors.Append(errs, errors.Wrapf(err, "updating language statistics of %q", repo.RepoName))
+
+			// Record the attempt, so that repositories that keep failing
+			// don't stay at the front of the queue and starve the others.
+			if err := u.store.Touch(ctx, repo.RepoID); err != nil {
+				errs = errors.Append(errs, errors.Wrapf(err, "recording failed update of %q", repo.RepoName))
+			}
+		}
+	}
+	return errs
+}
+
+func (u *updater) HandleError(err error) {
+	u.logger.Error("error updating repository language statistics", log.Error(err))
+}
+
+func (u *updater) update(ctx context.Context, repo database.RepoLanguageStats) error {
+	_, commit, err := u.gitserver.GetDefaultBranch(ctx, repo.RepoName, true)
+	if err != nil {
+		return err
+	}
+	if commit == "" {
+		// Empty repository, nothing to compute.
+		return u.store.Update(ctx, repo.RepoID, "", nil)
+	}
+	if commit == repo.CommitID {
+		return u.store.Touch(ctx, repo.RepoID)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, u.config.ComputeTimeout)
+	defer cancel()
+
+	inv, err := u.inventory(ctx, repo.RepoName, commit)
+	if err != nil {
+		return err
+	}
+
+	languages := make([]database.RepoLanguage, 0, len(inv.Languages))
+	for _, l := range inv.Languages {
+		languages = append(languages, database.RepoLanguage{
+			Language:   l.Name,
+			TotalBytes: l.TotalBytes,
+			TotalLines: l.TotalLines,
+		})
+	}
+	return u.store.Update(ctx, repo.RepoID, commit, languages)
+}
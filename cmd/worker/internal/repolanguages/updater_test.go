@@ -0,0 +1,87 @@
+package repolanguages
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockassert "github.com/derision-test/go-mockgen/testutil/assert"
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/inventory"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestUpdater_Handle(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2023, 6, 28, 12, 0, 0, 0, time.UTC)
+
+	store := database.NewMockRepoLanguagesStore()
+	store.ListStaleFunc.SetDefaultReturn([]database.RepoLanguageStats{
+		{RepoID: 1, RepoName: "github.com/sourcegraph/unchanged", CommitID: "aaaa"},
+		{RepoID: 2, RepoName: "github.com/sourcegraph/changed", CommitID: "bbbb"},
+		{RepoID: 3, RepoName: "github.com/sourcegraph/empty"},
+		{RepoID: 4, RepoName: "github.com/sourcegraph/broken"},
+	}, nil)
+
+	gs := gitserver.NewMockClient()
+	gs.GetDefaultBranchFunc.SetDefaultHook(func(_ context.Context, repo api.RepoName, _ bool) (string, api.CommitID, error) {
+		switch repo {
+		case "github.com/sourcegraph/unchanged":
+			return "main", "aaaa", nil
+		case "github.com/sourcegraph/changed":
+			return "main", "cccc", nil
+		case "github.com/sourcegraph/empty":
+			return "", "", nil
+		}
+		return "", "", errors.New("repo not found")
+	})
+
+	var computed []api.RepoName
+	u := &updater{
+		store:     store,
+		gitserver: gs,
+		inventory: func(_ context.Context, repo api.RepoName, commit api.CommitID) (*inventory.Inventory, error) {
+			computed = append(computed, repo)
+			return &inventory.Inventory{Languages: []inventory.Lang{
+				{Name: "Go", TotalBytes: 300, TotalLines: 30},
+				{Name: "Markdown", TotalBytes: 100, TotalLines: 10},
+			}}, nil
+		},
+		logger: logtest.Scoped(t),
+		config: &config{RefreshAfter: 24 * time.Hour, BatchSize: 10, ComputeTimeout: time.Minute},
+		now:    func() time.Time { return now },
+	}
+
+	err := u.Handle(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "github.com/sourcegraph/broken")
+
+	mockassert.CalledOnceWith(t, store.ListStaleFunc, mockassert.Values(mockassert.Skip, now.Add(-24*time.Hour), 10))
+
+	// Only the repository whose default branch moved is recomputed.
+	assert.Equal(t, []api.RepoName{"github.com/sourcegraph/changed"}, computed)
+	// The broken repository is touched too, so that it moves to the back of
+	// the queue.
+	require.Len(t, store.TouchFunc.History(), 2)
+	assert.Equal(t, api.RepoID(1), store.TouchFunc.History()[0].Arg1)
+	assert.Equal(t, api.RepoID(4), store.TouchFunc.History()[1].Arg1)
+
+	require.Len(t, store.UpdateFunc.History(), 2)
+	changed := store.UpdateFunc.History()[0]
+	assert.Equal(t, api.RepoID(2), changed.Arg1)
+	assert.Equal(t, api.CommitID("cccc"), changed.Arg2)
+	assert.Equal(t, []database.RepoLanguage{
+		{Language: "Go", TotalBytes: 300, TotalLines: 30},
+		{Language: "Markdown", TotalBytes: 100, TotalLines: 10},
+	}, changed.Arg3)
+
+	empty := store.UpdateFunc.History()[1]
+	assert.Equal(t, api.RepoID(3), empty.Arg1)
+	assert.Empty(t, empty.Arg3)
+}
@@ -0,0 +1,37 @@
+package searchhistory
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+)
+
+type handler struct {
+	store  database.SearchHistoryStore
+	logger log.Logger
+}
+
+var (
+	_ goroutine.Handler      = &handler{}
+	_ goroutine.ErrorHandler = &handler{}
+)
+
+func (h *handler) Handle(ctx context.Context) error {
+	retention := conf.SearchHistoryRetention()
+	deleted, err := h.store.DeleteExpired(ctx, time.Now().Add(-retention), conf.SearchHistoryMaxEntriesPerUser())
+	if err != nil {
+		return err
+	}
+
+	h.logger.Debug("deleted expired search history entries", log.Int("count", deleted), log.Duration("retention", retention))
+	return nil
+}
+
+func (h *handler) HandleError(err error) {
+	h.logger.Error("error deleting expired search history entries", log.Error(err))
+}
@@ -0,0 +1,49 @@
+package searchhistory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockassert "github.com/derision-test/go-mockgen/testutil/assert"
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestHandler(t *testing.T) {
+	t.Run("store error", func(t *testing.T) {
+		want := errors.New("error")
+		store := database.NewMockSearchHistoryStore()
+		store.DeleteExpiredFunc.SetDefaultReturn(0, want)
+
+		h := &handler{store: store, logger: logtest.Scoped(t)}
+
+		err := h.Handle(context.Background())
+		assert.ErrorIs(t, err, want)
+		mockassert.CalledOnce(t, store.DeleteExpiredFunc)
+	})
+
+	t.Run("configured retention", func(t *testing.T) {
+		conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+			SearchHistory: &schema.SearchHistory{Retention: "48h", MaxEntriesPerUser: 10},
+		}})
+		t.Cleanup(func() { conf.Mock(nil) })
+
+		store := database.NewMockSearchHistoryStore()
+		h := &handler{store: store, logger: logtest.Scoped(t)}
+
+		before := time.Now()
+		err := h.Handle(context.Background())
+		assert.Nil(t, err)
+
+		mockassert.CalledOnce(t, store.DeleteExpiredFunc)
+		call := store.DeleteExpiredFunc.History()[0]
+		assert.WithinDuration(t, before.Add(-48*time.Hour), call.Arg1, time.Minute)
+		assert.Equal(t, 10, call.Arg2)
+	})
+}
@@ -0,0 +1,52 @@
+package searchhistory
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
+	workerdb "github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/db"
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// janitor is a worker responsible for deleting search history entries which
+// exceed the retention configured in the site configuration.
+type janitor struct{}
+
+var _ job.Job = &janitor{}
+
+func NewJanitor() job.Job {
+	return &janitor{}
+}
+
+func (j *janitor) Description() string {
+	return "searchhistory.Janitor deletes search history entries which exceed the configured retention."
+}
+
+func (j *janitor) Config() []env.Config {
+	return nil
+}
+
+func (j *janitor) Routines(_ context.Context, observationCtx *observation.Context) ([]goroutine.BackgroundRoutine, error) {
+	db, err := workerdb.InitDB(observationCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return []goroutine.BackgroundRoutine{
+		// Retention values under an hour aren't supported, so there's no
+		// point running this more frequently than that.
+		goroutine.NewPeriodicGoroutine(
+			context.Background(),
+			&handler{
+				store:  db.SearchHistory(),
+				logger: observationCtx.Logger,
+			},
+			goroutine.WithName("search.search-history-janitor"),
+			goroutine.WithDescription("deletes expired search history entries"),
+			goroutine.WithInterval(1*time.Hour),
+		),
+	}, nil
+}
@@ -0,0 +1,195 @@
+// Command search-ranking-eval compares the ranking of search results with and
+// without a ranking feature flag against a set of relevance judgments.
+//
+// Every query in the judgments file is run twice against the streaming search
+// API of a Sourcegraph instance, once with the feature flag forced off
+// (control) and once with it forced on (treatment). The results of both runs
+// are scored with NDCG@k and mean reciprocal rank.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+var (
+	endpoint      string
+	accessToken   string
+	judgmentsPath string
+	featureFlag   string
+	k             int
+	timeout       time.Duration
+)
+
+func init() {
+	flag.StringVar(&endpoint, "endpoint", envOr("SRC_ENDPOINT", "http://127.0.0.1:3080"), "The Sourcegraph instance to query")
+	flag.StringVar(&accessToken, "token", os.Getenv("SRC_ACCESS_TOKEN"), "An access token for the Sourcegraph instance")
+	flag.StringVar(&judgmentsPath, "judgments", "./judgments.json", "The file containing relevance judgments")
+	flag.StringVar(&featureFlag, "flag", "search-document-ranks", "The feature flag to evaluate")
+	flag.IntVar(&k, "k", 10, "The rank cutoff for NDCG")
+	flag.DurationVar(&timeout, "timeout", time.Minute, "The timeout of a single search")
+}
+
+// judgment lists the documents relevant to a query. Documents are identified
+// as "repository/-/path" and graded from 1 (somewhat relevant) upwards.
+type judgment struct {
+	Query    string             `json:"query"`
+	Relevant map[string]float64 `json:"relevant"`
+}
+
+func main() {
+	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+
+	if err := mainErr(context.Background()); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func mainErr(ctx context.Context) error {
+	contents, err := os.ReadFile(judgmentsPath)
+	if err != nil {
+		return err
+	}
+	var judgments []judgment
+	if err := json.Unmarshal(contents, &judgments); err != nil {
+		return errors.Wrap(err, "failed to parse judgments")
+	}
+	if len(judgments) == 0 {
+		return errors.New("no judgments to evaluate")
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "query\tndcg@%d control\tndcg@%d treatment\trr control\trr treatment\n", k, k)
+
+	var control, treatment metrics
+	for _, j := range judgments {
+		c, err := evaluate(ctx, j, false)
+		if err != nil {
+			return err
+		}
+		t, err := evaluate(ctx, j, true)
+		if err != nil {
+			return err
+		}
+		control.add(c)
+		treatment.add(t)
+		fmt.Fprintf(w, "%s\t%.3f\t%.3f\t%.3f\t%.3f\n", j.Query, c.ndcg, t.ndcg, c.rr, t.rr)
+	}
+
+	n := float64(len(judgments))
+	fmt.Fprintf(w, "MEAN\t%.3f\t%.3f\t%.3f\t%.3f\n", control.ndcg/n, treatment.ndcg/n, control.rr/n, treatment.rr/n)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Printf("\nΔ ndcg@%d: %+.3f\nΔ mrr:     %+.3f\n", k, (treatment.ndcg-control.ndcg)/n, (treatment.rr-control.rr)/n)
+	return nil
+}
+
+type metrics struct {
+	ndcg float64
+	rr   float64
+}
+
+func (m *metrics) add(other metrics) {
+	m.ndcg += other.ndcg
+	m.rr += other.rr
+}
+
+func evaluate(ctx context.Context, j judgment, enabled bool) (metrics, error) {
+	documents, err := search(ctx, j.Query, enabled)
+	if err != nil {
+		return metrics{}, errors.Wrapf(err, "query %q", j.Query)
+	}
+
+	return metrics{
+		ndcg: ndcg(documents, j.Relevant, k),
+		rr:   reciprocalRank(documents, j.Relevant),
+	}, nil
+}
+
+// search runs query and returns the matched documents in the order they were
+// streamed.
+func search(ctx context.Context, query string, enabled bool) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := streamhttp.NewRequest(endpoint+"/.api", query)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if accessToken != "" {
+		req.Header.Set("Authorization", "token "+accessToken)
+	}
+	override := featureFlag
+	if !enabled {
+		override = "-" + featureFlag
+	}
+	req.Header.Set("X-Sourcegraph-Override-Feature", override)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Newf("unexpected status %s", resp.Status)
+	}
+
+	var (
+		documents []string
+		seen      = map[string]struct{}{}
+		searchErr error
+	)
+	add := func(repo, path string) {
+		doc := repo + "/-/" + path
+		if _, ok := seen[doc]; !ok {
+			seen[doc] = struct{}{}
+			documents = append(documents, doc)
+		}
+	}
+
+	decoder := streamhttp.FrontendStreamDecoder{
+		OnMatches: func(matches []streamhttp.EventMatch) {
+			for _, m := range matches {
+				switch v := m.(type) {
+				case *streamhttp.EventContentMatch:
+					add(v.Repository, v.Path)
+				case *streamhttp.EventPathMatch:
+					add(v.Repository, v.Path)
+				case *streamhttp.EventSymbolMatch:
+					add(v.Repository, v.Path)
+				}
+			}
+		},
+		OnError: func(e *streamhttp.EventError) {
+			searchErr = errors.New(e.Message)
+		},
+	}
+	if err := decoder.ReadAll(resp.Body); err != nil {
+		return nil, err
+	}
+
+	return documents, searchErr
+}
+
+func envOr(name, fallback string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return fallback
+}
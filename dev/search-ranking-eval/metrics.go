@@ -0,0 +1,50 @@
+package main
+
+import (
+	"math"
+	"sort"
+)
+
+// ndcg returns the normalized discounted cumulative gain of the first k
+// documents, given graded relevance judgments. Documents without a judgment
+// are not relevant.
+func ndcg(documents []string, relevant map[string]float64, k int) float64 {
+	ideal := make([]float64, 0, len(relevant))
+	for _, grade := range relevant {
+		ideal = append(ideal, grade)
+	}
+	sort.Sort(sort.Reverse(sort.Float64Slice(ideal)))
+
+	idcg := dcg(ideal, k)
+	if idcg == 0 {
+		return 0
+	}
+
+	grades := make([]float64, 0, len(documents))
+	for _, doc := range documents {
+		grades = append(grades, relevant[doc])
+	}
+	return dcg(grades, k) / idcg
+}
+
+func dcg(grades []float64, k int) float64 {
+	sum := 0.0
+	for i, grade := range grades {
+		if i >= k {
+			break
+		}
+		sum += (math.Pow(2, grade) - 1) / math.Log2(float64(i+2))
+	}
+	return sum
+}
+
+// reciprocalRank returns 1/n, where n is the position of the first relevant
+// document, or 0 if no relevant document was returned.
+func reciprocalRank(documents []string, relevant map[string]float64) float64 {
+	for i, doc := range documents {
+		if relevant[doc] > 0 {
+			return 1 / float64(i+1)
+		}
+	}
+	return 0
+}
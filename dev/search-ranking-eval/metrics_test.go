@@ -0,0 +1,38 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMetrics(t *testing.T) {
+	relevant := map[string]float64{
+		"a/-/main.go": 2,
+		"b/-/lib.go":  1,
+	}
+
+	for _, tc := range []struct {
+		name      string
+		documents []string
+		ndcg      float64
+		rr        float64
+	}{
+		{"ideal", []string{"a/-/main.go", "b/-/lib.go", "c/-/other.go"}, 1, 1},
+		{"swapped", []string{"b/-/lib.go", "a/-/main.go"}, (1 + 3/math.Log2(3)) / (3 + 1/math.Log2(3)), 1},
+		{"late", []string{"c/-/other.go", "a/-/main.go"}, (3 / math.Log2(3)) / (3 + 1/math.Log2(3)), 0.5},
+		{"none", []string{"c/-/other.go"}, 0, 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := ndcg(tc.documents, relevant, 10); math.Abs(got-tc.ndcg) > 1e-9 {
+				t.Errorf("unexpected ndcg, want %v got %v", tc.ndcg, got)
+			}
+			if got := reciprocalRank(tc.documents, relevant); got != tc.rr {
+				t.Errorf("unexpected reciprocal rank, want %v got %v", tc.rr, got)
+			}
+		})
+	}
+
+	if got := ndcg([]string{"a/-/main.go", "b/-/lib.go"}, relevant, 1); got != 1 {
+		t.Errorf("unexpected ndcg@1, want 1 got %v", got)
+	}
+}
@@ -32,6 +32,19 @@ func Post(
 	workingDirectory string,
 	workspaceFilesPath string,
 ) error {
+	step := executionInput.Steps[stepIdx]
+	if step.MatrixGroup != 0 {
+		// Combinations of a matrix whose condition is false were skipped
+		// by the pre step, which has already written their result.
+		skipped, err := stepSkipped(workingDirectory, stepIdx)
+		if err != nil {
+			return err
+		}
+		if skipped {
+			return nil
+		}
+	}
+
 	// Sometimes the files belong to different users. Mark the repository directory as safe.
 	if _, err := runner.Git(ctx, "", "config", "--global", "--add", "safe.directory", "/job/repository"); err != nil {
 		return errors.Wrap(err, "failed to mark repository directory as safe")
@@ -92,10 +105,10 @@ func Post(
 		},
 		PreviousStep: previousResult,
 		Step:         stepResult,
+		Matrix:       step.MatrixValues,
 	}
 
 	// Render and evaluate outputs.
-	step := executionInput.Steps[stepIdx]
 	if err = batcheslib.SetOutputs(step.Outputs, outputs, &stepContext); err != nil {
 		return errors.Wrap(err, "setting outputs")
 	}
@@ -121,6 +134,13 @@ func Post(
 		return errors.Wrap(err, "failed to write step result file")
 	}
 
+	// The diff of a matrix combination includes the changes of the
+	// combinations that ran in parallel with it, so only the result of the
+	// last combination can be cached.
+	if batcheslib.MatrixGroupContinues(executionInput.Steps, stepIdx) {
+		return os.RemoveAll(util.FilesMountPath(workingDirectory, stepIdx))
+	}
+
 	// Build and write the cache key
 	key := cache.KeyForWorkspace(
 		&executionInput.BatchChangeAttributes,
@@ -157,6 +177,23 @@ func Post(
 	return cleanupWorkspace(workingDirectory, stepIdx, workspaceFilesPath)
 }
 
+// stepSkipped reports whether the result of the step with the given index
+// has already been written with the skipped flag set.
+func stepSkipped(workingDirectory string, stepIdx int) (bool, error) {
+	b, err := os.ReadFile(filepath.Join(workingDirectory, util.StepJSONFile(stepIdx)))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "failed to read step result file")
+	}
+	var result execution.AfterStepResult
+	if err = json.Unmarshal(b, &result); err != nil {
+		return false, errors.Wrap(err, "failed to unmarshal step result file")
+	}
+	return result.Skipped, nil
+}
+
 type fileMetadataRetriever struct {
 	workingDirectory string
 }
@@ -210,6 +210,62 @@ func TestPost(t *testing.T) {
 				assert.True(t, os.IsNotExist(err))
 			},
 		},
+		{
+			name: "Matrix combination",
+			mockFunc: func(runner *fakeCmdRunner) {
+				runner.On("Git", mock.Anything, "", []string{"config", "--global", "--add", "safe.directory", "/job/repository"}).
+					Return("", nil)
+				runner.On("Git", mock.Anything, "repository", []string{"add", "--all"}).
+					Return("", nil)
+				runner.On("Git", mock.Anything, "repository", []string{"diff", "--cached", "--no-prefix", "--binary"}).
+					Return("git diff", nil)
+			},
+			step: 0,
+			executionInput: batcheslib.WorkspacesExecutionInput{
+				Steps: []batcheslib.Step{
+					{Run: "echo hello world", MatrixValues: map[string]any{"node": "16"}, MatrixGroup: 1},
+					{Run: "echo hello world", MatrixValues: map[string]any{"node": "18"}, MatrixGroup: 1},
+				},
+			},
+			previousResult: execution.AfterStepResult{},
+			stdoutLogs:     "hello world",
+			stderrLogs:     "error",
+			assertFunc: func(t *testing.T, logEntries []batcheslib.LogEvent, dir string, runner *fakeCmdRunner) {
+				// Only the last combination is cached.
+				require.Len(t, logEntries, 1)
+				assert.Equal(t, batcheslib.LogEventOperationTaskStep, logEntries[0].Operation)
+
+				_, err := os.Stat(filepath.Join(dir, "step0.json"))
+				require.NoError(t, err)
+
+				// The other combinations still need the workspace files.
+				_, err = os.Stat(filepath.Join(dir, "workspaceFiles"))
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Skipped matrix combination",
+			setupFunc: func(t *testing.T, dir string, workspaceFileDir string, executionInput batcheslib.WorkspacesExecutionInput) {
+				err := os.WriteFile(filepath.Join(dir, "step0.json"), []byte(`{"version":2,"skipped":true}`), os.ModePerm)
+				require.NoError(t, err)
+			},
+			step: 0,
+			executionInput: batcheslib.WorkspacesExecutionInput{
+				Steps: []batcheslib.Step{
+					{Run: "echo hello world", MatrixValues: map[string]any{"node": "16"}, MatrixGroup: 1},
+					{Run: "echo hello world", MatrixValues: map[string]any{"node": "18"}, MatrixGroup: 1},
+				},
+			},
+			previousResult: execution.AfterStepResult{},
+			assertFunc: func(t *testing.T, logEntries []batcheslib.LogEvent, dir string, runner *fakeCmdRunner) {
+				require.Len(t, logEntries, 0)
+				runner.AssertNotCalled(t, "Git", mock.Anything, mock.Anything, mock.Anything)
+
+				b, err := os.ReadFile(filepath.Join(dir, "step0.json"))
+				require.NoError(t, err)
+				assert.JSONEq(t, `{"version":2,"skipped":true}`, string(b))
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -42,6 +42,7 @@ func Pre(
 	if err != nil {
 		return err
 	}
+	stepContext.Matrix = step.MatrixValues
 
 	// Configures copying of the files to be used by the step.
 	var fileMountsPreamble string
@@ -79,6 +80,12 @@ func Pre(
 			return errors.Wrap(err, "failed to write step result file")
 		}
 
+		// The run steps of a matrix are started together after all of their
+		// pre steps, so they check for the step script instead.
+		if step.MatrixGroup != 0 {
+			return nil
+		}
+
 		// Determine the next step to run.
 		next := nextStep(stepIdx, executionInput.SkippedSteps)
 		// Write the skip file.
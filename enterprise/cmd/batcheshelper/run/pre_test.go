@@ -71,6 +71,27 @@ func TestPre(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "Matrix combination skipped",
+			step: 0,
+			executionInput: batcheslib.WorkspacesExecutionInput{
+				Steps: []batcheslib.Step{
+					{Run: "echo hello", If: `${{ eq matrix.os "linux" }}`, MatrixValues: map[string]any{"os": "windows"}, MatrixGroup: 1},
+					{Run: "echo hello", If: `${{ eq matrix.os "linux" }}`, MatrixValues: map[string]any{"os": "linux"}, MatrixGroup: 1},
+				},
+			},
+			previousResult: execution.AfterStepResult{},
+			assertFunc: func(t *testing.T, logEntries []batcheslib.LogEvent, dir string) {
+				require.Len(t, logEntries, 1)
+				assert.Equal(t, batcheslib.LogEventOperationTaskStepSkipped, logEntries[0].Operation)
+
+				// The other combinations still run, so there is no skip file.
+				dirEntries, err := os.ReadDir(dir)
+				require.NoError(t, err)
+				require.Len(t, dirEntries, 1)
+				assert.Equal(t, "step0.json", dirEntries[0].Name())
+			},
+		},
 		{
 			name: "Simple step",
 			step: 0,
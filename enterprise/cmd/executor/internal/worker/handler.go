@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/sourcegraph/log"
 
@@ -155,13 +156,27 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, job types.Job)
 	// Run all the things.
 	logger.Info("Running commands")
 	skipKey := ""
-	for i, spec := range commands {
+	for i := 0; i < len(commands); i++ {
+		spec := commands[i]
 		if len(skipKey) > 0 && skipKey != spec.CommandSpecs[0].Key {
 			continue
 		} else if len(skipKey) > 0 {
 			// We have a match, so reset the skip key.
 			skipKey = ""
 		}
+		if spec.ParallelGroup != "" {
+			end := parallelGroupEnd(commands, i)
+			if err := runParallel(ctx, commands[i:end], func(ctx context.Context, spec runner.Spec) error {
+				if err := runtimeRunner.Run(ctx, spec); err != nil {
+					return errors.Wrapf(err, "running command %q", spec.CommandSpecs[0].Key)
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			i = end - 1
+			continue
+		}
 		if err := runtimeRunner.Run(ctx, spec); err != nil {
 			return errors.Wrapf(err, "running command %q", spec.CommandSpecs[0].Key)
 		}
@@ -181,6 +196,27 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, job types.Job)
 	return nil
 }
 
+// parallelGroupEnd returns the index after the last of the consecutive specs
+// that share the parallel group of the spec at index start.
+func parallelGroupEnd(specs []runner.Spec, start int) int {
+	end := start + 1
+	for end < len(specs) && specs[end].ParallelGroup == specs[start].ParallelGroup {
+		end++
+	}
+	return end
+}
+
+// runParallel runs the given specs concurrently and waits for all of them to
+// complete. The context passed to run is canceled once any of them fails.
+func runParallel(ctx context.Context, specs []runner.Spec, run func(context.Context, runner.Spec) error) error {
+	g, ctx := errgroup.WithContext(ctx)
+	for _, spec := range specs {
+		spec := spec
+		g.Go(func() error { return run(ctx, spec) })
+	}
+	return g.Wait()
+}
+
 func createHoneyEvent(_ context.Context, job types.Job, err error, duration time.Duration) honey.Event {
 	fields := map[string]any{
 		"duration_ms":    duration.Milliseconds(),
@@ -249,7 +285,7 @@ func (h *handler) handle(ctx context.Context, logger log.Logger, commandLogger c
 		}
 	}()
 
-	// Invoke each docker step sequentially
+	dockerStepCommands := make([]runner.Spec, len(job.DockerSteps))
 	for i, dockerStep := range job.DockerSteps {
 		var key string
 		if dockerStep.Key != "" {
@@ -257,7 +293,7 @@ func (h *handler) handle(ctx context.Context, logger log.Logger, commandLogger c
 		} else {
 			key = fmt.Sprintf("step.docker.%d", i)
 		}
-		dockerStepCommand := runner.Spec{
+		dockerStepCommands[i] = runner.Spec{
 			CommandSpecs: []command.Spec{
 				{
 					Key:       key,
@@ -266,16 +302,27 @@ func (h *handler) handle(ctx context.Context, logger log.Logger, commandLogger c
 					Operation: h.operations.Exec,
 				},
 			},
-			Image:      dockerStep.Image,
-			ScriptPath: ws.ScriptFilenames()[i],
-			Job:        job,
+			Image:         dockerStep.Image,
+			ScriptPath:    ws.ScriptFilenames()[i],
+			ParallelGroup: dockerStep.ParallelGroup,
+			Job:           job,
 		}
+	}
 
-		logger.Info(fmt.Sprintf("Running docker step #%d", i))
+	// Invoke each docker step sequentially, except for the steps of a parallel group
+	for i := 0; i < len(dockerStepCommands); i++ {
+		end := i + 1
+		if dockerStepCommands[i].ParallelGroup != "" {
+			end = parallelGroupEnd(dockerStepCommands, i)
+			logger.Info(fmt.Sprintf("Running docker steps #%d to #%d in parallel", i, end-1))
+		} else {
+			logger.Info(fmt.Sprintf("Running docker step #%d", i))
+		}
 
-		if err = jobRunner.Run(ctx, dockerStepCommand); err != nil {
+		if err = runParallel(ctx, dockerStepCommands[i:end], jobRunner.Run); err != nil {
 			return errors.Wrap(err, "failed to perform docker step")
 		}
+		i = end - 1
 	}
 
 	// Invoke each src-cli step sequentially
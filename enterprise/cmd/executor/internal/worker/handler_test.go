@@ -2,7 +2,9 @@ package worker
 
 import (
 	"context"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/sourcegraph/log/logtest"
 	"github.com/stretchr/testify/assert"
@@ -505,6 +507,55 @@ func TestHandler_Handle(t *testing.T) {
 				assert.Equal(t, []string{"echo", "hello"}, jobRunner.RunFunc.History()[0].Arg1.CommandSpecs[0].Command)
 			},
 		},
+		{
+			name:    "Success with parallel steps",
+			options: Options{},
+			job: types.Job{
+				ID:             42,
+				RepositoryName: "my-repo",
+				Commit:         "cool-commit",
+				DockerSteps: []types.DockerStep{
+					{Key: "first", Image: "my-image", ParallelGroup: "group"},
+					{Key: "second", Image: "my-image", ParallelGroup: "group"},
+					{Key: "after", Image: "my-image"},
+				},
+			},
+			mockFunc: func(jobRuntime *MockRuntime, logStore *MockExecutionLogEntryStore, jobRunner *MockRunner, jobWorkspace *MockWorkspace) {
+				jobRuntime.PrepareWorkspaceFunc.PushReturn(jobWorkspace, nil)
+				jobRuntime.NewRunnerFunc.PushReturn(jobRunner, nil)
+				jobRuntime.NewRunnerSpecsFunc.PushReturn([]runner.Spec{
+					{CommandSpecs: []command.Spec{{Key: "first"}}, Image: "my-image", ParallelGroup: "group"},
+					{CommandSpecs: []command.Spec{{Key: "second"}}, Image: "my-image", ParallelGroup: "group"},
+					{CommandSpecs: []command.Spec{{Key: "after"}}, Image: "my-image"},
+				}, nil)
+
+				// The steps of the group can only complete if they run at
+				// the same time.
+				var wg sync.WaitGroup
+				wg.Add(2)
+				jobRunner.RunFunc.SetDefaultHook(func(ctx context.Context, spec runner.Spec) error {
+					if spec.ParallelGroup == "" {
+						return nil
+					}
+					wg.Done()
+					done := make(chan struct{})
+					go func() {
+						wg.Wait()
+						close(done)
+					}()
+					select {
+					case <-done:
+						return nil
+					case <-time.After(10 * time.Second):
+						return errors.New("steps did not run in parallel")
+					}
+				})
+			},
+			assertMockFunc: func(t *testing.T, jobRuntime *MockRuntime, logStore *MockExecutionLogEntryStore, jobRunner *MockRunner, jobWorkspace *MockWorkspace) {
+				require.Len(t, jobRunner.RunFunc.History(), 3)
+				assert.Equal(t, "after", jobRunner.RunFunc.History()[2].Arg1.CommandSpecs[0].Key)
+			},
+		},
 		{
 			name:    "failed to setup workspace",
 			options: Options{},
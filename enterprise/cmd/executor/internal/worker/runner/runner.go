@@ -32,10 +32,11 @@ type Runner interface {
 // is the host, in a virtual machine, or in a docker container. If an image is
 // supplied, then the command will be run in a one-shot docker container.
 type Spec struct {
-	Job          types.Job
-	CommandSpecs []command.Spec
-	Image        string
-	ScriptPath   string
+	Job           types.Job
+	CommandSpecs  []command.Spec
+	Image         string
+	ScriptPath    string
+	ParallelGroup string
 }
 
 // Options are the options that can be passed to the runner.
@@ -61,8 +61,9 @@ func (r *dockerRuntime) NewRunnerSpecs(ws workspace.Workspace, job types.Job) ([
 					Operation: r.operations.Exec,
 				},
 			},
-			Image:      step.Image,
-			ScriptPath: ws.ScriptFilenames()[i],
+			Image:         step.Image,
+			ScriptPath:    ws.ScriptFilenames()[i],
+			ParallelGroup: step.ParallelGroup,
 		}
 	}
 
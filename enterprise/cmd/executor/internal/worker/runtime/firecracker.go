@@ -72,8 +72,9 @@ func (r *firecrackerRuntime) NewRunnerSpecs(ws workspace.Workspace, job types.Jo
 					Operation: r.operations.Exec,
 				},
 			},
-			Image:      step.Image,
-			ScriptPath: ws.ScriptFilenames()[i],
+			Image:         step.Image,
+			ScriptPath:    ws.ScriptFilenames()[i],
+			ParallelGroup: step.ParallelGroup,
 		}
 	}
 
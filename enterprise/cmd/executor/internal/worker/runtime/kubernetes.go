@@ -98,7 +98,8 @@ func (r *kubernetesRuntime) NewRunnerSpecs(ws workspace.Workspace, job types.Job
 						Operation: r.operations.Exec,
 					},
 				},
-				Image: step.Image,
+				Image:         step.Image,
+				ParallelGroup: step.ParallelGroup,
 			}
 		}
 		return runnerSpecs, nil
@@ -0,0 +1,174 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	sglog "github.com/sourcegraph/log"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/enterprise"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// exportDateFormat is the format of the dates in the export and of the from
+// and to query parameters.
+const exportDateFormat = "2006-01-02"
+
+// ChangesetCountSnapshotsExportHandler exports the daily changeset count
+// snapshots of batch changes as CSV.
+type ChangesetCountSnapshotsExportHandler struct {
+	logger     sglog.Logger
+	db         database.DB
+	store      ChangesetCountSnapshotsStore
+	operations *Operations
+}
+
+type ChangesetCountSnapshotsStore interface {
+	ListChangesetCountSnapshots(context.Context, store.ListChangesetCountSnapshotsOpts) ([]*btypes.ChangesetCountSnapshot, error)
+}
+
+// NewChangesetCountSnapshotsExportHandler creates a new
+// ChangesetCountSnapshotsExportHandler.
+func NewChangesetCountSnapshotsExportHandler(db database.DB, store ChangesetCountSnapshotsStore, operations *Operations) *ChangesetCountSnapshotsExportHandler {
+	return &ChangesetCountSnapshotsExportHandler{
+		logger:     sglog.Scoped("ChangesetCountSnapshotsExportHandler", "Batch Changes changeset count snapshots CSV export handler"),
+		db:         db,
+		store:      store,
+		operations: operations,
+	}
+}
+
+var errNotAuthenticated = errors.New("not authenticated")
+
+// Export writes the snapshots matching the query parameters as CSV. The
+// parameters are the same as the arguments of the changesetCountSnapshots
+// GraphQL query, with from and to given as dates (YYYY-MM-DD).
+func (h *ChangesetCountSnapshotsExportHandler) Export() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		snapshots, statusCode, err := h.export(r)
+		if err != nil {
+			http.Error(w, err.Error(), statusCode)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"changeset-count-snapshots-%s.csv\"", time.Now().UTC().Format(exportDateFormat)))
+		w.WriteHeader(statusCode)
+
+		if err := writeChangesetCountSnapshotsCSV(w, snapshots); err != nil {
+			h.logger.Error("failed to write payload to client", sglog.Error(err))
+		}
+	})
+}
+
+func (h *ChangesetCountSnapshotsExportHandler) export(r *http.Request) (_ []*btypes.ChangesetCountSnapshot, statusCode int, err error) {
+	ctx, _, endObservation := h.operations.exportChangesetCountSnapshots.With(r.Context(), &err, observation.Args{})
+	defer func() {
+		endObservation(1, observation.Args{Attrs: []attribute.KeyValue{
+			attribute.Int("statusCode", statusCode),
+		}})
+	}()
+
+	if !actor.FromContext(ctx).IsAuthenticated() {
+		return nil, http.StatusUnauthorized, errNotAuthenticated
+	}
+	if err := enterprise.BatchChangesEnabledForUser(ctx, h.db); err != nil {
+		return nil, http.StatusForbidden, err
+	}
+
+	opts, err := parseChangesetCountSnapshotsOpts(r)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	snapshots, err := h.store.ListChangesetCountSnapshots(ctx, opts)
+	if err != nil {
+		return nil, http.StatusInternalServerError, errors.Wrap(err, "listing changeset count snapshots")
+	}
+	return snapshots, http.StatusOK, nil
+}
+
+func parseChangesetCountSnapshotsOpts(r *http.Request) (opts store.ListChangesetCountSnapshotsOpts, err error) {
+	q := r.URL.Query()
+
+	opts.GroupBy = btypes.ChangesetCountSnapshotGroupingBatchChange
+	if groupBy := q.Get("groupBy"); groupBy != "" {
+		opts.GroupBy = btypes.ChangesetCountSnapshotGrouping(groupBy)
+		if !opts.GroupBy.Valid() {
+			return opts, errors.Newf("invalid groupBy %q", groupBy)
+		}
+	}
+	opts.RepoMetadataKey = q.Get("repoMetadataKey")
+	if opts.GroupBy == btypes.ChangesetCountSnapshotGroupingRepoMetadata && opts.RepoMetadataKey == "" {
+		return opts, errors.New("repoMetadataKey is required when grouping by repository metadata")
+	}
+
+	if id := q.Get("batchChange"); id != "" {
+		if err := relay.UnmarshalSpec(graphql.ID(id), &opts.BatchChangeID); err != nil {
+			return opts, errors.Wrap(err, "invalid batchChange")
+		}
+	}
+
+	for param, t := range map[string]*time.Time{"from": &opts.From, "to": &opts.To} {
+		if v := q.Get(param); v != "" {
+			if *t, err = time.Parse(exportDateFormat, v); err != nil {
+				return opts, errors.Wrapf(err, "invalid %s", param)
+			}
+		}
+	}
+
+	return opts, nil
+}
+
+var changesetCountSnapshotsCSVHeader = []string{
+	"date",
+	"group",
+	"total",
+	"merged",
+	"closed",
+	"draft",
+	"open",
+	"open_approved",
+	"open_changes_requested",
+	"open_pending",
+}
+
+func writeChangesetCountSnapshotsCSV(w http.ResponseWriter, snapshots []*btypes.ChangesetCountSnapshot) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(changesetCountSnapshotsCSVHeader); err != nil {
+		return err
+	}
+
+	itoa := func(i int32) string { return strconv.Itoa(int(i)) }
+	for _, s := range snapshots {
+		if err := cw.Write([]string{
+			s.Date.UTC().Format(exportDateFormat),
+			s.Group,
+			itoa(s.Total),
+			itoa(s.Merged),
+			itoa(s.Closed),
+			itoa(s.Draft),
+			itoa(s.Open),
+			itoa(s.OpenApproved),
+			itoa(s.OpenChangesRequested),
+			itoa(s.OpenPending),
+		}); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,86 @@
+package httpapi
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/graph-gophers/graphql-go/relay"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+)
+
+func TestParseChangesetCountSnapshotsOpts(t *testing.T) {
+	batchChangeID := string(relay.MarshalID("BatchChange", int64(42)))
+
+	tests := []struct {
+		name        string
+		query       string
+		expected    store.ListChangesetCountSnapshotsOpts
+		expectedErr bool
+	}{
+		{
+			name:     "defaults",
+			expected: store.ListChangesetCountSnapshotsOpts{GroupBy: btypes.ChangesetCountSnapshotGroupingBatchChange},
+		},
+		{
+			name:  "all parameters",
+			query: "?batchChange=" + batchChangeID + "&from=2023-06-01&to=2023-06-30&groupBy=REPO_METADATA&repoMetadataKey=team",
+			expected: store.ListChangesetCountSnapshotsOpts{
+				BatchChangeID:   42,
+				From:            time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+				To:              time.Date(2023, 6, 30, 0, 0, 0, 0, time.UTC),
+				GroupBy:         btypes.ChangesetCountSnapshotGroupingRepoMetadata,
+				RepoMetadataKey: "team",
+			},
+		},
+		{
+			name:        "invalid grouping",
+			query:       "?groupBy=AUTHOR",
+			expectedErr: true,
+		},
+		{
+			name:        "repository metadata without key",
+			query:       "?groupBy=REPO_METADATA",
+			expectedErr: true,
+		},
+		{
+			name:        "invalid date",
+			query:       "?from=yesterday",
+			expectedErr: true,
+		},
+		{
+			name:        "invalid batch change",
+			query:       "?batchChange=foo",
+			expectedErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts, err := parseChangesetCountSnapshotsOpts(httptest.NewRequest("GET", "/batch-changes/changeset-count-snapshots/export"+test.query, nil))
+			if test.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, opts)
+		})
+	}
+}
+
+func TestWriteChangesetCountSnapshotsCSV(t *testing.T) {
+	w := httptest.NewRecorder()
+	err := writeChangesetCountSnapshotsCSV(w, []*btypes.ChangesetCountSnapshot{
+		{Group: "alice/burndown", Date: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC), Total: 5, Merged: 1, Closed: 1, Open: 3, OpenApproved: 2, OpenPending: 1},
+		{Group: "team, with comma", Date: time.Date(2023, 6, 2, 0, 0, 0, 0, time.UTC), Total: 1, Draft: 1},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, `date,group,total,merged,closed,draft,open,open_approved,open_changes_requested,open_pending
+2023-06-01,alice/burndown,5,1,1,0,3,2,0,1
+2023-06-02,"team, with comma",1,0,0,1,0,0,0,0
+`, w.Body.String())
+}
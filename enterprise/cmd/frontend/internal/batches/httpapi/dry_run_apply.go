@@ -0,0 +1,125 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	sglog "github.com/sourcegraph/log"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/enterprise"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/service"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// DryRunApplyHandler downloads the report of a dry run of applying a batch
+// spec as JSON.
+type DryRunApplyHandler struct {
+	logger     sglog.Logger
+	db         database.DB
+	service    DryRunApplier
+	operations *Operations
+}
+
+type DryRunApplier interface {
+	DryRunApplyBatchChange(context.Context, service.ApplyBatchChangeOpts) (*service.ApplyDryRunReport, error)
+}
+
+// NewDryRunApplyHandler creates a new DryRunApplyHandler.
+func NewDryRunApplyHandler(db database.DB, svc DryRunApplier, operations *Operations) *DryRunApplyHandler {
+	return &DryRunApplyHandler{
+		logger:     sglog.Scoped("DryRunApplyHandler", "Batch Changes dry-run apply report handler"),
+		db:         db,
+		service:    svc,
+		operations: operations,
+	}
+}
+
+// Get computes the dry run for the batch spec given in the batchSpec query
+// parameter and writes the report. The optional ensureBatchChange parameter
+// has the same meaning as in the applyBatchChange mutation.
+func (h *DryRunApplyHandler) Get() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report, statusCode, err := h.get(r)
+		if err != nil {
+			http.Error(w, err.Error(), statusCode)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"dry-run-apply-%s.json\"", report.Name))
+		w.WriteHeader(statusCode)
+
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			h.logger.Error("failed to write payload to client", sglog.Error(err))
+		}
+	})
+}
+
+func (h *DryRunApplyHandler) get(r *http.Request) (_ *service.ApplyDryRunReport, statusCode int, err error) {
+	ctx, _, endObservation := h.operations.dryRunApply.With(r.Context(), &err, observation.Args{})
+	defer func() {
+		endObservation(1, observation.Args{Attrs: []attribute.KeyValue{
+			attribute.Int("statusCode", statusCode),
+		}})
+	}()
+
+	if !actor.FromContext(ctx).IsAuthenticated() {
+		return nil, http.StatusUnauthorized, errNotAuthenticated
+	}
+	if err := enterprise.BatchChangesEnabledForUser(ctx, h.db); err != nil {
+		return nil, http.StatusForbidden, err
+	}
+
+	opts, err := parseDryRunApplyOpts(r)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	// 🚨 SECURITY: DryRunApplyBatchChange checks whether the user has
+	// permission to apply the batch spec.
+	report, err := h.service.DryRunApplyBatchChange(ctx, opts)
+	if err != nil {
+		switch {
+		case errors.Is(err, store.ErrNoResults):
+			return nil, http.StatusNotFound, errors.New("batch spec does not exist")
+		case errcode.IsUnauthorized(err):
+			return nil, http.StatusForbidden, err
+		case errors.Is(err, service.ErrEnsureBatchChangeFailed), errors.Is(err, service.ErrApplyClosedBatchChange):
+			return nil, http.StatusConflict, err
+		default:
+			return nil, http.StatusInternalServerError, errors.Wrap(err, "computing dry run")
+		}
+	}
+	return report, http.StatusOK, nil
+}
+
+func parseDryRunApplyOpts(r *http.Request) (opts service.ApplyBatchChangeOpts, err error) {
+	q := r.URL.Query()
+
+	if err := relay.UnmarshalSpec(graphql.ID(q.Get("batchSpec")), &opts.BatchSpecRandID); err != nil {
+		return opts, errors.Wrap(err, "invalid batchSpec")
+	}
+	if opts.BatchSpecRandID == "" {
+		return opts, errors.New("batchSpec is required")
+	}
+
+	if id := q.Get("ensureBatchChange"); id != "" {
+		if err := relay.UnmarshalSpec(graphql.ID(id), &opts.EnsureBatchChangeID); err != nil {
+			return opts, errors.Wrap(err, "invalid ensureBatchChange")
+		}
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,55 @@
+package httpapi
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/graph-gophers/graphql-go/relay"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/service"
+)
+
+func TestParseDryRunApplyOpts(t *testing.T) {
+	batchSpecID := string(relay.MarshalID("BatchSpec", "abc123"))
+	batchChangeID := string(relay.MarshalID("BatchChange", int64(42)))
+
+	tests := []struct {
+		name        string
+		query       string
+		expected    service.ApplyBatchChangeOpts
+		expectedErr bool
+	}{
+		{
+			name:     "batch spec",
+			query:    "?batchSpec=" + batchSpecID,
+			expected: service.ApplyBatchChangeOpts{BatchSpecRandID: "abc123"},
+		},
+		{
+			name:     "ensure batch change",
+			query:    "?batchSpec=" + batchSpecID + "&ensureBatchChange=" + batchChangeID,
+			expected: service.ApplyBatchChangeOpts{BatchSpecRandID: "abc123", EnsureBatchChangeID: 42},
+		},
+		{
+			name:        "missing batch spec",
+			expectedErr: true,
+		},
+		{
+			name:        "invalid ensure batch change",
+			query:       "?batchSpec=" + batchSpecID + "&ensureBatchChange=foo",
+			expectedErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts, err := parseDryRunApplyOpts(httptest.NewRequest("GET", "/batch-changes/dry-run-apply"+test.query, nil))
+			if test.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, opts)
+		})
+	}
+}
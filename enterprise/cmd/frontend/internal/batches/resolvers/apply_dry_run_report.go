@@ -0,0 +1,153 @@
+package resolvers
+
+import (
+	"net/url"
+	"sort"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/service"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+type applyDryRunReportResolver struct {
+	report *service.ApplyDryRunReport
+	args   *graphqlbackend.ApplyBatchChangeArgs
+}
+
+var _ graphqlbackend.ApplyDryRunReportResolver = &applyDryRunReportResolver{}
+
+func (r *applyDryRunReportResolver) Operations() []graphqlbackend.ApplyDryRunOperationCountResolver {
+	return newApplyDryRunOperationCountResolvers(r.report.Operations)
+}
+
+func (r *applyDryRunReportResolver) CodeHosts() []graphqlbackend.ApplyDryRunCodeHostResolver {
+	ids := make([]string, 0, len(r.report.CodeHosts))
+	for id := range r.report.CodeHosts {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	resolvers := make([]graphqlbackend.ApplyDryRunCodeHostResolver, 0, len(ids))
+	for _, id := range ids {
+		resolvers = append(resolvers, &applyDryRunCodeHostResolver{externalServiceID: id, codeHost: r.report.CodeHosts[id]})
+	}
+	return resolvers
+}
+
+func (r *applyDryRunReportResolver) Changesets() []graphqlbackend.ApplyDryRunChangesetResolver {
+	resolvers := make([]graphqlbackend.ApplyDryRunChangesetResolver, 0, len(r.report.Changesets))
+	for _, c := range r.report.Changesets {
+		resolvers = append(resolvers, &applyDryRunChangesetResolver{changeset: c})
+	}
+	return resolvers
+}
+
+func (r *applyDryRunReportResolver) UnchangedCount() int32 {
+	return int32(r.report.Unchanged)
+}
+
+func (r *applyDryRunReportResolver) DownloadURL() string {
+	q := url.Values{"batchSpec": []string{string(r.args.BatchSpec)}}
+	if r.args.EnsureBatchChange != nil {
+		q.Set("ensureBatchChange", string(*r.args.EnsureBatchChange))
+	}
+	return "/.api/batch-changes/dry-run-apply?" + q.Encode()
+}
+
+type applyDryRunOperationCountResolver struct {
+	operation btypes.ReconcilerOperation
+	count     int
+}
+
+var _ graphqlbackend.ApplyDryRunOperationCountResolver = &applyDryRunOperationCountResolver{}
+
+// newApplyDryRunOperationCountResolvers returns resolvers for the given
+// operation counts, sorted by operation.
+func newApplyDryRunOperationCountResolvers(counts map[btypes.ReconcilerOperation]int) []graphqlbackend.ApplyDryRunOperationCountResolver {
+	ops := make([]btypes.ReconcilerOperation, 0, len(counts))
+	for op := range counts {
+		ops = append(ops, op)
+	}
+	sort.Slice(ops, func(i, j int) bool { return ops[i] < ops[j] })
+
+	resolvers := make([]graphqlbackend.ApplyDryRunOperationCountResolver, 0, len(ops))
+	for _, op := range ops {
+		resolvers = append(resolvers, &applyDryRunOperationCountResolver{operation: op, count: counts[op]})
+	}
+	return resolvers
+}
+
+func (r *applyDryRunOperationCountResolver) Operation() string { return string(r.operation) }
+func (r *applyDryRunOperationCountResolver) Count() int32      { return int32(r.count) }
+
+type applyDryRunCodeHostResolver struct {
+	externalServiceID string
+	codeHost          *service.ApplyDryRunCodeHost
+}
+
+var _ graphqlbackend.ApplyDryRunCodeHostResolver = &applyDryRunCodeHostResolver{}
+
+func (r *applyDryRunCodeHostResolver) ExternalServiceKind() string {
+	return extsvc.TypeToKind(r.codeHost.ExternalServiceType)
+}
+
+func (r *applyDryRunCodeHostResolver) ExternalServiceURL() string {
+	return r.externalServiceID
+}
+
+func (r *applyDryRunCodeHostResolver) Operations() []graphqlbackend.ApplyDryRunOperationCountResolver {
+	return newApplyDryRunOperationCountResolvers(r.codeHost.Operations)
+}
+
+type applyDryRunChangesetResolver struct {
+	changeset *service.ApplyDryRunChangeset
+}
+
+var _ graphqlbackend.ApplyDryRunChangesetResolver = &applyDryRunChangesetResolver{}
+
+func (r *applyDryRunChangesetResolver) RepositoryName() string {
+	return r.changeset.Repository
+}
+
+func (r *applyDryRunChangesetResolver) Changeset() *graphql.ID {
+	if r.changeset.Changeset == "" {
+		return nil
+	}
+	return &r.changeset.Changeset
+}
+
+func (r *applyDryRunChangesetResolver) Operations() []string {
+	ops := make([]string, 0, len(r.changeset.Operations))
+	for _, op := range r.changeset.Operations {
+		ops = append(ops, string(op))
+	}
+	return ops
+}
+
+func (r *applyDryRunChangesetResolver) Branch() *string {
+	return pointers.NonZeroPtr(r.changeset.Branch)
+}
+
+func (r *applyDryRunChangesetResolver) Fork() bool {
+	return r.changeset.Fork
+}
+
+func (r *applyDryRunChangesetResolver) ForkNamespace() *string {
+	return pointers.NonZeroPtr(r.changeset.ForkNamespace)
+}
+
+func (r *applyDryRunChangesetResolver) Title() *string {
+	return pointers.NonZeroPtr(r.changeset.Title)
+}
+
+func (r *applyDryRunChangesetResolver) PreviousTitle() *string {
+	return pointers.NonZeroPtr(r.changeset.PreviousTitle)
+}
+
+func (r *applyDryRunChangesetResolver) BodyChanged() bool    { return r.changeset.BodyChanged }
+func (r *applyDryRunChangesetResolver) BaseRefChanged() bool { return r.changeset.BaseRefChanged }
+func (r *applyDryRunChangesetResolver) DiffChanged() bool    { return r.changeset.DiffChanged }
@@ -359,3 +359,57 @@ func (r *batchChangeResolver) BatchSpecs(
 
 	return &batchSpecConnectionResolver{store: r.store, logger: r.logger, opts: opts}, nil
 }
+
+func (r *batchChangeResolver) Schedule() *string {
+	if r.batchChange.Schedule == "" {
+		return nil
+	}
+	return &r.batchChange.Schedule
+}
+
+func (r *batchChangeResolver) SchedulePaused() bool {
+	return r.batchChange.SchedulePaused
+}
+
+func (r *batchChangeResolver) NextScheduledRunAt() *gqlutil.DateTime {
+	if !r.batchChange.Scheduled() {
+		return nil
+	}
+	return gqlutil.FromTime(r.batchChange.NextScheduledRunAt)
+}
+
+func (r *batchChangeResolver) ScheduledRuns(
+	ctx context.Context,
+	args *graphqlbackend.ListBatchChangeScheduledRunsArgs,
+) (graphqlbackend.BatchChangeScheduledRunConnectionResolver, error) {
+	if err := validateFirstParamDefaults(args.First); err != nil {
+		return nil, err
+	}
+	opts := store.ListBatchChangeScheduledRunsOpts{
+		BatchChangeID: r.batchChange.ID,
+		LimitOpts: store.LimitOpts{
+			Limit: int(args.First),
+		},
+	}
+	if args.After != nil {
+		id, err := strconv.Atoi(*args.After)
+		if err != nil {
+			return nil, err
+		}
+		opts.Cursor = int64(id)
+	}
+
+	return &batchChangeScheduledRunConnectionResolver{store: r.store, logger: r.logger, opts: opts}, nil
+}
+
+func (r *batchChangeResolver) AutoMergePolicy(ctx context.Context) (graphqlbackend.BatchChangeAutoMergePolicyResolver, error) {
+	policy, err := r.store.GetBatchChangeAutoMergePolicy(ctx, r.batchChange.ID)
+	if err != nil {
+		if err == store.ErrNoResults {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &batchChangeAutoMergePolicyResolver{policy: policy}, nil
+}
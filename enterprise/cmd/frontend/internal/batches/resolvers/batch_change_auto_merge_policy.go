@@ -0,0 +1,50 @@
+package resolvers
+
+import (
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+)
+
+type batchChangeAutoMergePolicyResolver struct {
+	policy *btypes.BatchChangeAutoMergePolicy
+}
+
+var _ graphqlbackend.BatchChangeAutoMergePolicyResolver = &batchChangeAutoMergePolicyResolver{}
+
+func (r *batchChangeAutoMergePolicyResolver) RequiredApprovals() int32 {
+	return r.policy.RequiredApprovals
+}
+
+func (r *batchChangeAutoMergePolicyResolver) RequirePassingChecks() bool {
+	return r.policy.RequirePassingChecks
+}
+
+func (r *batchChangeAutoMergePolicyResolver) MergeMethod() string {
+	return string(r.policy.MergeMethod)
+}
+
+func (r *batchChangeAutoMergePolicyResolver) MaintenanceWindowDays() []string {
+	if r.policy.MaintenanceWindowDays == nil {
+		return []string{}
+	}
+	return r.policy.MaintenanceWindowDays
+}
+
+func (r *batchChangeAutoMergePolicyResolver) MaintenanceWindowStart() *string {
+	if r.policy.MaintenanceWindowStart == "" {
+		return nil
+	}
+	return &r.policy.MaintenanceWindowStart
+}
+
+func (r *batchChangeAutoMergePolicyResolver) MaintenanceWindowEnd() *string {
+	if r.policy.MaintenanceWindowEnd == "" {
+		return nil
+	}
+	return &r.policy.MaintenanceWindowEnd
+}
+
+func (r *batchChangeAutoMergePolicyResolver) UpdatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.policy.UpdatedAt}
+}
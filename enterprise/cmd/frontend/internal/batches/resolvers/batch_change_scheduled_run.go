@@ -0,0 +1,55 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+)
+
+type batchChangeScheduledRunResolver struct {
+	store  *store.Store
+	logger log.Logger
+	run    *btypes.BatchChangeScheduledRun
+}
+
+var _ graphqlbackend.BatchChangeScheduledRunResolver = &batchChangeScheduledRunResolver{}
+
+func (r *batchChangeScheduledRunResolver) State() string {
+	return string(r.run.State)
+}
+
+func (r *batchChangeScheduledRunResolver) BatchSpec(ctx context.Context) (graphqlbackend.BatchSpecResolver, error) {
+	if r.run.BatchSpecID == 0 {
+		return nil, nil
+	}
+
+	batchSpec, err := r.store.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: r.run.BatchSpecID})
+	if err != nil {
+		if err == store.ErrNoResults {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &batchSpecResolver{store: r.store, logger: r.logger, batchSpec: batchSpec}, nil
+}
+
+func (r *batchChangeScheduledRunResolver) FailureMessage() *string {
+	if r.run.FailureMessage == "" {
+		return nil
+	}
+	return &r.run.FailureMessage
+}
+
+func (r *batchChangeScheduledRunResolver) CreatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.run.CreatedAt}
+}
+
+func (r *batchChangeScheduledRunResolver) FinishedAt() *gqlutil.DateTime {
+	return gqlutil.FromTime(r.run.FinishedAt)
+}
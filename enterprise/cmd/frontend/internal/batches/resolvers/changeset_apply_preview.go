@@ -289,7 +289,12 @@ func (r *visibleChangesetApplyPreviewResolver) computePlan(ctx context.Context)
 				}
 			}
 		}
-		r.plan, r.planErr = reconciler.DeterminePlan(previousSpec, currentSpec, r.mapping.Changeset, wantedChangeset)
+		pendingPrerequisites, err := reconciler.HasPendingPrerequisites(ctx, r.store, wantedChangeset, currentSpec)
+		if err != nil {
+			r.planErr = err
+			return
+		}
+		r.plan, r.planErr = reconciler.DeterminePlan(previousSpec, currentSpec, r.mapping.Changeset, wantedChangeset, pendingPrerequisites)
 	})
 	return r.plan, r.planErr
 }
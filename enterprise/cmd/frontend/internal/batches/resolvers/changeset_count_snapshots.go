@@ -0,0 +1,53 @@
+package resolvers
+
+import (
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/state"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+)
+
+type changesetCountSnapshotGroupResolver struct {
+	group  string
+	counts []*state.ChangesetCounts
+}
+
+var _ graphqlbackend.ChangesetCountSnapshotGroupResolver = &changesetCountSnapshotGroupResolver{}
+
+func (r *changesetCountSnapshotGroupResolver) Group() string { return r.group }
+
+func (r *changesetCountSnapshotGroupResolver) Counts() []graphqlbackend.ChangesetCountsResolver {
+	resolvers := make([]graphqlbackend.ChangesetCountsResolver, 0, len(r.counts))
+	for _, c := range r.counts {
+		resolvers = append(resolvers, &changesetCountsResolver{counts: c})
+	}
+	return resolvers
+}
+
+// groupChangesetCountSnapshots turns the snapshots, which are sorted by group
+// and date, into one resolver per group.
+func groupChangesetCountSnapshots(snapshots []*btypes.ChangesetCountSnapshot) []graphqlbackend.ChangesetCountSnapshotGroupResolver {
+	var groups []*changesetCountSnapshotGroupResolver
+	for _, s := range snapshots {
+		if len(groups) == 0 || groups[len(groups)-1].group != s.Group {
+			groups = append(groups, &changesetCountSnapshotGroupResolver{group: s.Group})
+		}
+		g := groups[len(groups)-1]
+		g.counts = append(g.counts, &state.ChangesetCounts{
+			Time:                 s.Date,
+			Total:                s.Total,
+			Merged:               s.Merged,
+			Closed:               s.Closed,
+			Draft:                s.Draft,
+			Open:                 s.Open,
+			OpenApproved:         s.OpenApproved,
+			OpenChangesRequested: s.OpenChangesRequested,
+			OpenPending:          s.OpenPending,
+		})
+	}
+
+	resolvers := make([]graphqlbackend.ChangesetCountSnapshotGroupResolver, 0, len(groups))
+	for _, g := range groups {
+		resolvers = append(resolvers, g)
+	}
+	return resolvers
+}
@@ -44,6 +44,9 @@ func (r *changesetsStatsResolver) Deleted() int32 {
 func (r *changesetsStatsResolver) Archived() int32 {
 	return r.stats.Archived
 }
+func (r *changesetsStatsResolver) Conflicted() int32 {
+	return r.stats.Conflicted
+}
 func (r *changesetsStatsResolver) Total() int32 {
 	return r.stats.Total
 }
@@ -468,6 +468,49 @@ func (r *Resolver) ApplyBatchChange(ctx context.Context, args *graphqlbackend.Ap
 	return &batchChangeResolver{store: r.store, gitserverClient: r.gitserverClient, batchChange: batchChange, logger: r.logger}, nil
 }
 
+func (r *Resolver) DryRunApplyBatchChange(ctx context.Context, args *graphqlbackend.ApplyBatchChangeArgs) (_ graphqlbackend.ApplyDryRunReportResolver, err error) {
+	tr, ctx := trace.New(ctx, "Resolver.DryRunApplyBatchChange", fmt.Sprintf("BatchSpec %s", args.BatchSpec))
+	defer tr.FinishWithErr(&err)
+
+	if err := enterprise.BatchChangesEnabledForUser(ctx, r.store.DatabaseDB()); err != nil {
+		return nil, err
+	}
+
+	var opts service.ApplyBatchChangeOpts
+	if opts.BatchSpecRandID, err = unmarshalBatchSpecID(args.BatchSpec); err != nil {
+		return nil, err
+	}
+	if opts.BatchSpecRandID == "" {
+		return nil, ErrIDIsZero{}
+	}
+
+	if args.EnsureBatchChange != nil {
+		opts.EnsureBatchChangeID, err = unmarshalBatchChangeID(*args.EnsureBatchChange)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := addPublicationStatesToOptions(args.PublicationStates, &opts.PublicationStates); err != nil {
+		return nil, err
+	}
+
+	svc := service.New(r.store)
+	// 🚨 SECURITY: DryRunApplyBatchChange checks whether the user has
+	// permission to apply the batch spec.
+	report, err := svc.DryRunApplyBatchChange(ctx, opts)
+	if err != nil {
+		if err == service.ErrEnsureBatchChangeFailed {
+			return nil, ErrEnsureBatchChangeFailed{}
+		} else if err == service.ErrApplyClosedBatchChange {
+			return nil, ErrApplyClosedBatchChange{}
+		}
+		return nil, err
+	}
+
+	return &applyDryRunReportResolver{report: report, args: args}, nil
+}
+
 func addPublicationStatesToOptions(in *[]graphqlbackend.ChangesetSpecPublicationStateInput, opts *service.UiPublicationStates) error {
 	var errs error
 
@@ -717,6 +760,125 @@ func (r *Resolver) MoveBatchChange(ctx context.Context, args *graphqlbackend.Mov
 	return &batchChangeResolver{store: r.store, gitserverClient: r.gitserverClient, batchChange: batchChange, logger: r.logger}, nil
 }
 
+func (r *Resolver) UpdateBatchChangeSchedule(ctx context.Context, args *graphqlbackend.UpdateBatchChangeScheduleArgs) (_ graphqlbackend.BatchChangeResolver, err error) {
+	tr, ctx := trace.New(ctx, "Resolver.UpdateBatchChangeSchedule", fmt.Sprintf("BatchChange %s", args.BatchChange))
+	defer tr.FinishWithErr(&err)
+
+	if err := enterprise.BatchChangesEnabledForUser(ctx, r.store.DatabaseDB()); err != nil {
+		return nil, err
+	}
+
+	if err := rbac.CheckCurrentUserHasPermission(ctx, r.store.DatabaseDB(), rbac.BatchChangesWritePermission); err != nil {
+		return nil, err
+	}
+
+	// Scheduled runs apply batch specs, so they require the same license as
+	// applying them manually. The changeset limit is enforced on every run.
+	if _, err := checkLicense(); err != nil {
+		return nil, ErrBatchChangesUnlicensed{err}
+	}
+
+	batchChangeID, err := unmarshalBatchChangeID(args.BatchChange)
+	if err != nil {
+		return nil, err
+	}
+
+	if batchChangeID == 0 {
+		return nil, ErrIDIsZero{}
+	}
+
+	svc := service.New(r.store)
+	// 🚨 SECURITY: UpdateBatchChangeSchedule checks whether the current user is authorized.
+	batchChange, err := svc.UpdateBatchChangeSchedule(ctx, service.UpdateBatchChangeScheduleOpts{
+		BatchChangeID: batchChangeID,
+		Schedule:      args.Schedule,
+		Paused:        args.Paused,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &batchChangeResolver{store: r.store, gitserverClient: r.gitserverClient, batchChange: batchChange, logger: r.logger}, nil
+}
+
+func (r *Resolver) SetBatchChangeAutoMergePolicy(ctx context.Context, args *graphqlbackend.SetBatchChangeAutoMergePolicyArgs) (_ graphqlbackend.BatchChangeResolver, err error) {
+	tr, ctx := trace.New(ctx, "Resolver.SetBatchChangeAutoMergePolicy", fmt.Sprintf("BatchChange %s", args.BatchChange))
+	defer tr.FinishWithErr(&err)
+
+	if err := enterprise.BatchChangesEnabledForUser(ctx, r.store.DatabaseDB()); err != nil {
+		return nil, err
+	}
+
+	if err := rbac.CheckCurrentUserHasPermission(ctx, r.store.DatabaseDB(), rbac.BatchChangesWritePermission); err != nil {
+		return nil, err
+	}
+
+	batchChangeID, err := unmarshalBatchChangeID(args.BatchChange)
+	if err != nil {
+		return nil, err
+	}
+
+	if batchChangeID == 0 {
+		return nil, ErrIDIsZero{}
+	}
+
+	opts := service.SetBatchChangeAutoMergePolicyOpts{
+		BatchChangeID:        batchChangeID,
+		RequiredApprovals:    args.RequiredApprovals,
+		RequirePassingChecks: args.RequirePassingChecks,
+	}
+	if args.MergeMethod != nil {
+		opts.MergeMethod = btypes.AutoMergeMethod(*args.MergeMethod)
+	}
+	if args.MaintenanceWindowDays != nil {
+		opts.MaintenanceWindowDays = *args.MaintenanceWindowDays
+	}
+	if args.MaintenanceWindowStart != nil {
+		opts.MaintenanceWindowStart = *args.MaintenanceWindowStart
+	}
+	if args.MaintenanceWindowEnd != nil {
+		opts.MaintenanceWindowEnd = *args.MaintenanceWindowEnd
+	}
+
+	svc := service.New(r.store)
+	// 🚨 SECURITY: SetBatchChangeAutoMergePolicy checks whether the current user is authorized.
+	if _, err := svc.SetBatchChangeAutoMergePolicy(ctx, opts); err != nil {
+		return nil, err
+	}
+
+	return r.batchChangeByID(ctx, args.BatchChange)
+}
+
+func (r *Resolver) DeleteBatchChangeAutoMergePolicy(ctx context.Context, args *graphqlbackend.DeleteBatchChangeAutoMergePolicyArgs) (_ graphqlbackend.BatchChangeResolver, err error) {
+	tr, ctx := trace.New(ctx, "Resolver.DeleteBatchChangeAutoMergePolicy", fmt.Sprintf("BatchChange %s", args.BatchChange))
+	defer tr.FinishWithErr(&err)
+
+	if err := enterprise.BatchChangesEnabledForUser(ctx, r.store.DatabaseDB()); err != nil {
+		return nil, err
+	}
+
+	if err := rbac.CheckCurrentUserHasPermission(ctx, r.store.DatabaseDB(), rbac.BatchChangesWritePermission); err != nil {
+		return nil, err
+	}
+
+	batchChangeID, err := unmarshalBatchChangeID(args.BatchChange)
+	if err != nil {
+		return nil, err
+	}
+
+	if batchChangeID == 0 {
+		return nil, ErrIDIsZero{}
+	}
+
+	svc := service.New(r.store)
+	// 🚨 SECURITY: DeleteBatchChangeAutoMergePolicy checks whether the current user is authorized.
+	if err := svc.DeleteBatchChangeAutoMergePolicy(ctx, batchChangeID); err != nil {
+		return nil, err
+	}
+
+	return r.batchChangeByID(ctx, args.BatchChange)
+}
+
 func (r *Resolver) DeleteBatchChange(ctx context.Context, args *graphqlbackend.DeleteBatchChangeArgs) (_ *graphqlbackend.EmptyResponse, err error) {
 	tr, ctx := trace.New(ctx, "Resolver.DeleteBatchChange", fmt.Sprintf("BatchChange: %q", args.BatchChange))
 	defer tr.FinishWithErr(&err)
@@ -930,7 +1092,7 @@ func listChangesetOptsFromArgs(args *graphqlbackend.ListChangesetsArgs, batchCha
 			return opts, false, errors.New("invalid combination of state and onlyClosable")
 		}
 
-		opts.States = []btypes.ChangesetState{btypes.ChangesetStateOpen, btypes.ChangesetStateDraft}
+		opts.States = []btypes.ChangesetState{btypes.ChangesetStateOpen, btypes.ChangesetStateDraft, btypes.ChangesetStateConflicted}
 	}
 
 	if args.State != nil {
@@ -1538,6 +1700,47 @@ func (r *Resolver) PublishChangesets(ctx context.Context, args *graphqlbackend.P
 	return r.bulkOperationByIDString(ctx, bulkGroupID)
 }
 
+func (r *Resolver) RebaseChangesets(ctx context.Context, args *graphqlbackend.RebaseChangesetsArgs) (_ graphqlbackend.BulkOperationResolver, err error) {
+	tr, ctx := trace.New(ctx, "Resolver.RebaseChangesets", fmt.Sprintf("BatchChange: %q, len(Changesets): %d", args.BatchChange, len(args.Changesets)))
+	defer tr.FinishWithErr(&err)
+	if err := enterprise.BatchChangesEnabledForUser(ctx, r.store.DatabaseDB()); err != nil {
+		return nil, err
+	}
+
+	if err := rbac.CheckCurrentUserHasPermission(ctx, r.store.DatabaseDB(), rbac.BatchChangesWritePermission); err != nil {
+		return nil, err
+	}
+
+	batchChangeID, changesetIDs, err := unmarshalBulkOperationBaseArgs(args.BulkOperationBaseArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// 🚨 SECURITY: CreateChangesetJobs checks whether current user is authorized.
+	svc := service.New(r.store)
+	published := btypes.ChangesetPublicationStatePublished
+	bulkGroupID, err := svc.CreateChangesetJobs(
+		ctx,
+		batchChangeID,
+		changesetIDs,
+		btypes.ChangesetJobTypeRebase,
+		&btypes.ChangesetJobRebasePayload{},
+		store.ListChangesetsOpts{
+			PublicationState: &published,
+			ReconcilerStates: []btypes.ReconcilerState{btypes.ReconcilerStateCompleted},
+			ExternalStates:   []btypes.ChangesetExternalState{btypes.ChangesetExternalStateOpen, btypes.ChangesetExternalStateDraft},
+			// Only changesets created by the batch change have a diff that can
+			// be applied again.
+			OwnedByBatchChangeID: batchChangeID,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.bulkOperationByIDString(ctx, bulkGroupID)
+}
+
 func (r *Resolver) BatchSpecs(ctx context.Context, args *graphqlbackend.ListBatchSpecArgs) (_ graphqlbackend.BatchSpecConnectionResolver, err error) {
 	tr, ctx := trace.New(ctx, "Resolver.BatchSpecs", fmt.Sprintf("First: %d, After: %v", args.First, args.After))
 	defer tr.FinishWithErr(&err)
@@ -2064,6 +2267,44 @@ func (r *Resolver) MaxUnlicensedChangesets(ctx context.Context) int32 {
 	}
 }
 
+func (r *Resolver) ChangesetCountSnapshots(ctx context.Context, args *graphqlbackend.ChangesetCountSnapshotsArgs) ([]graphqlbackend.ChangesetCountSnapshotGroupResolver, error) {
+	if err := enterprise.BatchChangesEnabledForUser(ctx, r.store.DatabaseDB()); err != nil {
+		return nil, err
+	}
+
+	opts := store.ListChangesetCountSnapshotsOpts{
+		GroupBy: btypes.ChangesetCountSnapshotGrouping(args.GroupBy),
+	}
+	if !opts.GroupBy.Valid() {
+		return nil, errors.Newf("invalid grouping %q", args.GroupBy)
+	}
+	if args.BatchChange != nil {
+		batchChangeID, err := unmarshalBatchChangeID(*args.BatchChange)
+		if err != nil {
+			return nil, err
+		}
+		if batchChangeID == 0 {
+			return nil, ErrIDIsZero{}
+		}
+		opts.BatchChangeID = batchChangeID
+	}
+	if args.From != nil {
+		opts.From = args.From.Time
+	}
+	if args.To != nil {
+		opts.To = args.To.Time
+	}
+	if args.RepoMetadataKey != nil {
+		opts.RepoMetadataKey = *args.RepoMetadataKey
+	}
+
+	snapshots, err := r.store.ListChangesetCountSnapshots(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return groupChangesetCountSnapshots(snapshots), nil
+}
+
 func parseBatchChangeStates(ss *[]string) ([]btypes.BatchChangeState, error) {
 	states := []btypes.BatchChangeState{}
 	if ss == nil || len(*ss) == 0 {
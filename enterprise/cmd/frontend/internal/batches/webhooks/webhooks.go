@@ -177,6 +177,11 @@ func (h webhook) upsertChangesetEvent(
 		return err
 	}
 
+	// Changesets depending on this one can be published once it is merged.
+	if err := tx.EnqueueDependentChangesets(ctx, cs); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -13,6 +13,8 @@ import (
 	codenavgraphql "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/transport/graphql"
 	policiesgraphql "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/policies/transport/graphql"
 	rankinggraphql "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/ranking/transport/graphql"
+	sbomgraphql "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sbom/transport/graphql"
+	sbomhttp "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sbom/transport/http"
 	sentinelgraphql "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/transport/graphql"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/shared/lsifuploadstore"
 	sharedresolvers "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/shared/resolvers"
@@ -112,6 +114,7 @@ func Init(
 	sentinelRootResolver := sentinelgraphql.NewRootResolver(
 		scopedContext("sentinel"),
 		codeIntelServices.SentinelService,
+		siteAdminChecker,
 		uploadLoaderFactory,
 		indexLoaderFactory,
 		locationResolverFactory,
@@ -124,6 +127,13 @@ func Init(
 		siteAdminChecker,
 	)
 
+	sbomRootResolver := sbomgraphql.NewRootResolver(
+		scopedContext("sbom"),
+		codeIntelServices.SBOMService,
+		repoStore,
+		codeIntelServices.GitserverClient,
+	)
+
 	enterpriseServices.CodeIntelResolver = graphqlbackend.NewCodeIntelResolver(resolvers.NewCodeIntelResolver(
 		autoindexingRootResolver,
 		codenavRootResolver,
@@ -131,8 +141,10 @@ func Init(
 		uploadRootResolver,
 		sentinelRootResolver,
 		rankingRootResolver,
+		sbomRootResolver,
 	))
 	enterpriseServices.NewCodeIntelUploadHandler = newUploadHandler
+	enterpriseServices.CodeIntelSBOMExportHandler = sbomhttp.GetHandler(codeIntelServices.SBOMService, db, codeIntelServices.GitserverClient)
 	enterpriseServices.RankingService = codeIntelServices.RankingService
 	return nil
 }
@@ -229,60 +229,90 @@ func transformRecord(ctx context.Context, logger log.Logger, s BatchesStore, job
 			}
 		}
 
-		for i := startStep; i < len(batchSpec.Spec.Steps); i++ {
-			// Skip statically skipped steps.
-			if _, skip := skipped[i]; skip {
-				continue
-			}
+		runDir := srcRepoDir
+		if workspace.Path != "" {
+			runDir = path.Join(runDir, workspace.Path)
+		}
 
-			step := batchSpec.Spec.Steps[i]
+		runDirToScriptDir, err := filepath.Rel("/"+runDir, "/")
+		if err != nil {
+			return apiclient.Job{}, err
+		}
 
-			runDir := srcRepoDir
-			if workspace.Path != "" {
-				runDir = path.Join(runDir, workspace.Path)
+		steps := batchSpec.Spec.Steps
+		for i := startStep; i < len(steps); i++ {
+			// The combinations of a matrix step are run in parallel: first all
+			// pre steps, then all run steps at the same time, then all post
+			// steps.
+			group := []int{i}
+			for batcheslib.MatrixGroupContinues(steps, i) {
+				i++
+				group = append(group, i)
 			}
 
-			runDirToScriptDir, err := filepath.Rel("/"+runDir, "/")
-			if err != nil {
-				return apiclient.Job{}, err
-			}
+			var preSteps, runSteps, postSteps []apiclient.DockerStep
+			for _, j := range group {
+				// Skip statically skipped steps.
+				if _, skip := skipped[j]; skip {
+					continue
+				}
+
+				step := steps[j]
+
+				preSteps = append(preSteps, apiclient.DockerStep{
+					Key:   executorutil.FormatPreKey(j),
+					Image: helperImage,
+					Env:   secretEnvVars,
+					Dir:   ".",
+					Commands: []string{
+						// TODO: This doesn't handle skipped steps right, it assumes
+						// there are outputs from i-1 present at all times.
+						shellquote.Join("batcheshelper", "pre", strconv.Itoa(j)),
+					},
+				})
 
-			dockerSteps = append(dockerSteps, apiclient.DockerStep{
-				Key:   executorutil.FormatPreKey(i),
-				Image: helperImage,
-				Env:   secretEnvVars,
-				Dir:   ".",
-				Commands: []string{
-					// TODO: This doesn't handle skipped steps right, it assumes
-					// there are outputs from i-1 present at all times.
-					shellquote.Join("batcheshelper", "pre", strconv.Itoa(i)),
-				},
-			})
-
-			dockerSteps = append(dockerSteps, apiclient.DockerStep{
-				Key:   executorutil.FormatRunKey(i),
-				Image: step.Container,
-				Dir:   runDir,
-				// Invoke the script file but also write stdout and stderr to separate files, which will then be
-				// consumed by the post step to build the AfterStepResult.
-				Commands: []string{
+				commands := []string{
 					// Hide commands from stderr.
 					"{ set +x; } 2>/dev/null",
 					"{ set -eo pipefail; } 2>/dev/null",
-					fmt.Sprintf(`(exec "%s/step%d.sh" | tee %s/stdout%d.log) 3>&1 1>&2 2>&3 | tee %s/stderr%d.log`, runDirToScriptDir, i, runDirToScriptDir, i, runDirToScriptDir, i),
-				},
-			})
-
-			// This step gets the diff, reads stdout and stderr, renders the outputs and builds the AfterStepResult.
-			dockerSteps = append(dockerSteps, apiclient.DockerStep{
-				Key:   executorutil.FormatPostKey(i),
-				Image: helperImage,
-				Env:   secretEnvVars,
-				Dir:   ".",
-				Commands: []string{
-					shellquote.Join("batcheshelper", "post", strconv.Itoa(i)),
-				},
-			})
+				}
+				var parallelGroup string
+				if step.MatrixGroup != 0 {
+					parallelGroup = fmt.Sprintf("matrix-%d", step.MatrixGroup)
+					// The pre step doesn't write a script for combinations
+					// whose condition is false.
+					commands = append(commands, fmt.Sprintf(`[ -f "%s/step%d.sh" ] || exit 0`, runDirToScriptDir, j))
+				}
+				runSteps = append(runSteps, apiclient.DockerStep{
+					Key:   executorutil.FormatRunKey(j),
+					Image: step.Container,
+					Dir:   runDir,
+					// Invoke the script file but also write stdout and stderr to separate files, which will then be
+					// consumed by the post step to build the AfterStepResult.
+					Commands: append(commands,
+						fmt.Sprintf(`(exec "%s/step%d.sh" | tee %s/stdout%d.log) 3>&1 1>&2 2>&3 | tee %s/stderr%d.log`, runDirToScriptDir, j, runDirToScriptDir, j, runDirToScriptDir, j),
+					),
+					ParallelGroup: parallelGroup,
+				})
+
+				// This step gets the diff, reads stdout and stderr, renders the outputs and builds the AfterStepResult.
+				postSteps = append(postSteps, apiclient.DockerStep{
+					Key:   executorutil.FormatPostKey(j),
+					Image: helperImage,
+					Env:   secretEnvVars,
+					Dir:   ".",
+					Commands: []string{
+						shellquote.Join("batcheshelper", "post", strconv.Itoa(j)),
+					},
+				})
+			}
+
+			if len(preSteps) == 0 {
+				continue
+			}
+			dockerSteps = append(dockerSteps, preSteps...)
+			dockerSteps = append(dockerSteps, runSteps...)
+			dockerSteps = append(dockerSteps, postSteps...)
 
 			aj.DockerSteps = dockerSteps
 		}
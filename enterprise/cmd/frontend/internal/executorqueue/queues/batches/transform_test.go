@@ -3,6 +3,7 @@ package batches
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -396,3 +397,75 @@ steps:
 		mockassert.CalledN(t, sal.CreateFunc, 5)
 	})
 }
+
+func TestTransformRecord_MatrixSteps(t *testing.T) {
+	db := database.NewMockDB()
+	repos := database.NewMockRepoStore()
+	repos.GetFunc.SetDefaultHook(func(ctx context.Context, id api.RepoID) (*types.Repo, error) {
+		return &types.Repo{ID: id, Name: "github.com/sourcegraph/sourcegraph"}, nil
+	})
+	db.ReposFunc.SetDefaultReturn(repos)
+	db.ExecutorSecretsFunc.SetDefaultReturn(database.NewMockExecutorSecretStore())
+	db.ExecutorSecretAccessLogsFunc.SetDefaultReturn(database.NewMockExecutorSecretAccessLogStore())
+
+	conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{ExternalURL: "https://test.io"}})
+	t.Cleanup(func() {
+		conf.Mock(nil)
+	})
+
+	spec, err := batcheslib.ParseBatchSpec([]byte(`
+name: test
+steps:
+  - run: echo lol >> readme.md
+    container: alpine:3
+  - run: npm test
+    container: node:${{ matrix.node }}
+    matrix:
+      node: ["16", "18"]
+  - run: echo more lol >> readme.md
+    container: alpine:3
+changesetTemplate:
+  title: Test
+  body: Test
+  branch: test
+  commit:
+    message: Test
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store := NewMockBatchesStore()
+	store.GetBatchSpecFunc.SetDefaultReturn(&btypes.BatchSpec{RandID: "abc", UserID: 123, NamespaceUserID: 123, Spec: spec}, nil)
+	store.GetBatchSpecWorkspaceFunc.SetDefaultReturn(&btypes.BatchSpecWorkspace{RepoID: 5678, Branch: "refs/heads/base-branch", Commit: "d34db33f"}, nil)
+	store.DatabaseDBFunc.SetDefaultReturn(db)
+
+	job, err := transformRecord(context.Background(), logtest.Scoped(t), store, &btypes.BatchSpecWorkspaceExecutionJob{ID: 42, UserID: 123, Version: 2}, "0.0.0-dev")
+	if err != nil {
+		t.Fatalf("unexpected error transforming record: %s", err)
+	}
+
+	helper := fmt.Sprintf("%s:%s", conf.ExecutorsBatcheshelperImage(), conf.ExecutorsBatcheshelperImageTag())
+	type step struct{ Key, Image, ParallelGroup string }
+	var have []step
+	for _, s := range job.DockerSteps {
+		have = append(have, step{s.Key, s.Image, s.ParallelGroup})
+	}
+	want := []step{
+		{"step.0.pre", helper, ""},
+		{"step.0.run", "alpine:3", ""},
+		{"step.0.post", helper, ""},
+		{"step.1.pre", helper, ""},
+		{"step.2.pre", helper, ""},
+		{"step.1.run", "node:16", "matrix-2"},
+		{"step.2.run", "node:18", "matrix-2"},
+		{"step.1.post", helper, ""},
+		{"step.2.post", helper, ""},
+		{"step.3.pre", helper, ""},
+		{"step.3.run", "alpine:3", ""},
+		{"step.3.post", helper, ""},
+	}
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Errorf("unexpected docker steps (-want +got):\n%s", diff)
+	}
+}
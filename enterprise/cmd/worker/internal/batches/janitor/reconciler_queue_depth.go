@@ -0,0 +1,48 @@
+package janitor
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+const reconcilerQueueDepthInterval = 30 * time.Second
+
+// NewReconcilerQueueDepthReporter creates a new goroutine.PeriodicGoroutine
+// that reports the number of changesets queued for the reconciler per code
+// host, split into changesets that can be processed right away and changesets
+// that have been deferred, for example because of code host rate limits.
+func NewReconcilerQueueDepthReporter(ctx context.Context, observationCtx *observation.Context, s *store.Store) goroutine.BackgroundRoutine {
+	queueDepth := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "src_batch_changes_reconciler_queue_depth",
+		Help: "The number of changesets queued for the reconciler per code host.",
+	}, []string{"codehost", "deferred"})
+	observationCtx.Registerer.MustRegister(queueDepth)
+
+	return goroutine.NewPeriodicGoroutine(
+		ctx,
+		goroutine.HandlerFunc(func(ctx context.Context) error {
+			depths, err := s.ListReconcilerQueueDepths(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Reset the gauges, so that code hosts without queued changesets
+			// are no longer reported.
+			queueDepth.Reset()
+			for _, d := range depths {
+				queueDepth.WithLabelValues(d.ExternalServiceID, "false").Set(float64(d.Queued))
+				queueDepth.WithLabelValues(d.ExternalServiceID, "true").Set(float64(d.Deferred))
+			}
+			return nil
+		}),
+		goroutine.WithName("batchchanges.reconciler-queue-depth"),
+		goroutine.WithDescription("reports the reconciler queue depth per code host"),
+		goroutine.WithInterval(reconcilerQueueDepthInterval),
+	)
+}
@@ -86,6 +86,7 @@ func (j *janitorJob) Routines(_ context.Context, observationCtx *observation.Con
 		janitor.NewSpecExpirer(workCtx, bstore),
 		janitor.NewCacheEntryCleaner(workCtx, bstore),
 		janitor.NewChangesetDetachedCleaner(workCtx, bstore),
+		janitor.NewReconcilerQueueDepthReporter(workCtx, observationCtx, bstore),
 	}
 
 	return routines, nil
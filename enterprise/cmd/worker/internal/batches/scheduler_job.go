@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/burndown"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/recurring"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/scheduler"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/env"
@@ -35,6 +37,8 @@ func (j *schedulerJob) Routines(_ context.Context, observationCtx *observation.C
 
 	routines := []goroutine.BackgroundRoutine{
 		scheduler.NewScheduler(workCtx, bstore),
+		recurring.NewRunner(workCtx, bstore),
+		burndown.NewSnapshotter(workCtx, bstore),
 	}
 
 	return routines, nil
@@ -37,6 +37,6 @@ func NewReconcilerWorker(
 		Metrics:           workerutil.NewMetrics(observationCtx, "batch_changes_reconciler"),
 	}
 
-	worker := dbworker.NewWorker[*btypes.Changeset](ctx, workerStore, r.HandlerFunc(), options)
+	worker := dbworker.NewWorker[*btypes.Changeset](ctx, workerStore, r, options)
 	return worker
 }
@@ -6,9 +6,12 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/worker/shared/init/codeintel"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/shared/lsifuploadstore"
 	"github.com/sourcegraph/sourcegraph/internal/env"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 type sentinelCVEScannerJob struct{}
@@ -25,6 +28,8 @@ func (j *sentinelCVEScannerJob) Config() []env.Config {
 	return []env.Config{
 		sentinel.DownloaderConfigInst,
 		sentinel.MatcherConfigInst,
+		sentinel.ReachabilityConfigInst,
+		sentinelUploadStoreConfigInst,
 	}
 }
 
@@ -34,5 +39,34 @@ func (j *sentinelCVEScannerJob) Routines(_ context.Context, observationCtx *obse
 		return nil, err
 	}
 
-	return sentinel.CVEScannerJob(observationCtx, services.SentinelService), nil
+	// Advisory archives are read from the precise code intel upload bucket
+	var uploadStore uploadstore.Store
+	if sentinel.DownloaderConfigInst.AdvisoryArchiveKey != "" {
+		uploadStore, err = lsifuploadstore.New(context.Background(), observationCtx, sentinelUploadStoreConfigInst.LSIFUploadStoreConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sentinel.CVEScannerJob(observationCtx, services.SentinelService, uploadStore), nil
+}
+
+type sentinelUploadStoreConfig struct {
+	env.BaseConfig
+
+	LSIFUploadStoreConfig *lsifuploadstore.Config
+}
+
+var sentinelUploadStoreConfigInst = &sentinelUploadStoreConfig{}
+
+func (c *sentinelUploadStoreConfig) Load() {
+	c.LSIFUploadStoreConfig = &lsifuploadstore.Config{}
+	c.LSIFUploadStoreConfig.Load()
+}
+
+func (c *sentinelUploadStoreConfig) Validate() error {
+	var errs error
+	errs = errors.Append(errs, c.BaseConfig.Validate())
+	errs = errors.Append(errs, c.LSIFUploadStoreConfig.Validate())
+	return errs
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
 	"github.com/sourcegraph/sourcegraph/internal/featureflag"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/search/ranking"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
@@ -96,7 +97,7 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, record *bgrepo.
 		IndexedRevision:   lastSuccessfulJobRevision,
 	}
 
-	ranks, err := getDocumentRanks(ctx, string(repo.Name))
+	ranks, err := ranking.GetDocumentRanks(ctx, string(repo.Name))
 	if err != nil {
 		return err
 	}
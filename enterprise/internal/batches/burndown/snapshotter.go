@@ -0,0 +1,27 @@
+package burndown
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+)
+
+// snapshotInterval is how often the changeset count snapshot of the current
+// day is refreshed. The last refresh of a day becomes the snapshot of that day.
+const snapshotInterval = time.Hour
+
+// NewSnapshotter returns a background routine that periodically records the
+// daily changeset count snapshots of all open batch changes.
+func NewSnapshotter(ctx context.Context, bstore *store.Store) goroutine.BackgroundRoutine {
+	return goroutine.NewPeriodicGoroutine(
+		ctx,
+		goroutine.HandlerFunc(func(ctx context.Context) error {
+			return bstore.CreateChangesetCountSnapshots(ctx, bstore.Clock()())
+		}),
+		goroutine.WithName("batchchanges.changeset-count-snapshotter"),
+		goroutine.WithDescription("records daily snapshots of the changeset counts of batch changes"),
+		goroutine.WithInterval(snapshotInterval),
+	)
+}
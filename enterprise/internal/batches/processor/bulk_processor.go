@@ -102,6 +102,8 @@ func (b *bulkProcessor) Process(ctx context.Context, job *btypes.ChangesetJob) (
 		return b.closeChangeset(ctx)
 	case btypes.ChangesetJobTypePublish:
 		return nil, b.publishChangeset(ctx, job)
+	case btypes.ChangesetJobTypeRebase:
+		return nil, b.rebaseChangeset(ctx)
 
 	default:
 		return nil, &unknownJobTypeErr{jobType: string(job.JobType)}
@@ -200,6 +202,11 @@ func (b *bulkProcessor) mergeChangeset(ctx context.Context, job *btypes.Changese
 		return nil, errcode.MakeNonRetryable(err)
 	}
 
+	if err := b.tx.EnqueueDependentChangesets(ctx, cs.Changeset); err != nil {
+		b.logger.Error("EnqueueDependentChangesets", log.Error(err))
+		return nil, errcode.MakeNonRetryable(err)
+	}
+
 	afterDone = func(s *store.Store) { b.enqueueWebhook(ctx, s, webhooks.ChangesetClose) }
 	return afterDone, nil
 }
@@ -289,6 +296,31 @@ func (b *bulkProcessor) publishChangeset(ctx context.Context, job *btypes.Change
 	return nil
 }
 
+func (b *bulkProcessor) rebaseChangeset(ctx context.Context) error {
+	// Only the diff of changesets created by a batch change can be applied
+	// again.
+	if b.ch.CurrentSpecID == 0 {
+		return errcode.MakeNonRetryable(errors.New("cannot rebase an imported changeset"))
+	}
+
+	if b.ch.ExternalState != btypes.ChangesetExternalStateOpen && b.ch.ExternalState != btypes.ChangesetExternalStateDraft {
+		return errcode.MakeNonRetryable(errors.Newf("cannot rebase a changeset in state %s", b.ch.ExternalState))
+	}
+
+	enqueued, err := b.tx.EnqueueChangesetToRebase(ctx, b.ch)
+	if err != nil {
+		b.logger.Error("EnqueueChangesetToRebase", log.Error(err))
+		return errcode.MakeNonRetryable(err)
+	}
+	// The changeset is queued or failed, so the reconciler needs to finish
+	// first.
+	if !enqueued {
+		return changesetIsProcessingErr
+	}
+
+	return nil
+}
+
 func (b *bulkProcessor) enqueueWebhook(ctx context.Context, store *store.Store, eventType string) {
 	webhooks.EnqueueChangeset(ctx, b.logger, store, eventType, bgql.MarshalChangesetID(b.ch.ID))
 }
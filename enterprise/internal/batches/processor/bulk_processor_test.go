@@ -232,6 +232,54 @@ func TestBulkProcessor(t *testing.T) {
 		}
 	})
 
+	t.Run("Rebase job", func(t *testing.T) {
+		fake := &stesting.FakeChangesetSource{}
+		bp := &bulkProcessor{
+			tx:      bstore,
+			sourcer: stesting.NewFakeSourcer(nil, fake),
+			logger:  logtest.Scoped(t),
+		}
+		conflictedChangeset := bt.CreateChangeset(t, ctx, bstore, bt.TestChangesetOpts{
+			Repo:                repo.ID,
+			BatchChanges:        []types.BatchChangeAssoc{{BatchChangeID: batchChange.ID}},
+			Metadata:            &github.PullRequest{},
+			ExternalServiceType: extsvc.TypeGitHub,
+			ExternalState:       btypes.ChangesetExternalStateOpen,
+			PublicationState:    btypes.ChangesetPublicationStatePublished,
+			ReconcilerState:     btypes.ReconcilerStateCompleted,
+			CurrentSpec:         changesetSpec.ID,
+			Conflicted:          true,
+		})
+		job := &types.ChangesetJob{
+			JobType:     types.ChangesetJobTypeRebase,
+			ChangesetID: conflictedChangeset.ID,
+			UserID:      user.ID,
+			Payload:     &btypes.ChangesetJobRebasePayload{},
+		}
+		afterDone, err := bp.Process(ctx, job)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if afterDone != nil {
+			t.Fatal("unexpected non-nil afterDone")
+		}
+		conflictedChangeset, err = bstore.GetChangesetByID(ctx, conflictedChangeset.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !conflictedChangeset.Rebasing {
+			t.Fatal("changeset not marked as rebasing")
+		}
+		if have, want := conflictedChangeset.ReconcilerState, btypes.ReconcilerStateQueued; have != want {
+			t.Fatalf("unexpected reconciler state, have=%q want=%q", have, want)
+		}
+
+		// The changeset is queued now, so the job has to be retried.
+		if _, err := bp.Process(ctx, job); err != changesetIsProcessingErr {
+			t.Fatalf("unexpected error, have=%v want=%v", err, changesetIsProcessingErr)
+		}
+	})
+
 	t.Run("Merge job", func(t *testing.T) {
 		fake := &stesting.FakeChangesetSource{}
 		bp := &bulkProcessor{
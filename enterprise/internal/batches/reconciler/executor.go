@@ -88,6 +88,16 @@ func (e *executor) Run(ctx context.Context, plan *Plan) (afterDone func(store *s
 		triggerUpdateWebhook = true
 	}
 
+	// A merge requested by an auto-merge policy is only carried out if it's
+	// the only operation. Otherwise, the syncer requests it again once the
+	// changeset satisfies the policy.
+	e.ch.Merging = false
+
+	// A rebase is carried out by the push below, so the flag is reset once
+	// the plan was executed successfully.
+	rebase := e.ch.Rebasing
+	e.ch.Rebasing = false
+
 	for _, op := range plan.Ops.ExecutionOrder() {
 		switch op {
 		case btypes.ReconcilerOperationSync:
@@ -97,7 +107,7 @@ func (e *executor) Run(ctx context.Context, plan *Plan) (afterDone func(store *s
 			err = e.importChangeset(ctx)
 
 		case btypes.ReconcilerOperationPush:
-			afterDone, err = e.pushChangesetPatch(ctx, triggerUpdateWebhook)
+			afterDone, err = e.pushChangesetPatch(ctx, triggerUpdateWebhook, rebase)
 
 		case btypes.ReconcilerOperationPublish:
 			afterDone, err = e.publishChangeset(ctx, false)
@@ -117,6 +127,9 @@ func (e *executor) Run(ctx context.Context, plan *Plan) (afterDone func(store *s
 		case btypes.ReconcilerOperationClose:
 			afterDone, err = e.closeChangeset(ctx)
 
+		case btypes.ReconcilerOperationMerge:
+			afterDone, err = e.mergeChangeset(ctx)
+
 		case btypes.ReconcilerOperationSleep:
 			e.sleep()
 
@@ -152,14 +165,19 @@ func (e *executor) Run(ctx context.Context, plan *Plan) (afterDone func(store *s
 
 	e.ch.PreviousFailureMessage = nil
 
-	return afterDone, e.tx.UpdateChangeset(ctx, e.ch)
+	if err := e.tx.UpdateChangeset(ctx, e.ch); err != nil {
+		return afterDone, err
+	}
+
+	// Changesets depending on this one can be published once it is merged.
+	return afterDone, e.tx.EnqueueDependentChangesets(ctx, e.ch)
 }
 
 var errCannotPushToArchivedRepo = errcode.MakeNonRetryable(errors.New("cannot push to an archived repo"))
 
 // pushChangesetPatch creates the commits for the changeset on its codehost. If the option
 // triggerUpdateWebhook is set, it will also enqueue an update webhook for the changeset.
-func (e *executor) pushChangesetPatch(ctx context.Context, triggerUpdateWebhook bool) (afterDone func(store *store.Store), err error) {
+func (e *executor) pushChangesetPatch(ctx context.Context, triggerUpdateWebhook, rebase bool) (afterDone func(store *store.Store), err error) {
 	if triggerUpdateWebhook {
 		afterDone = func(store *store.Store) { e.enqueueWebhook(ctx, store, webhooks.ChangesetUpdateError) }
 	}
@@ -201,6 +219,18 @@ func (e *executor) pushChangesetPatch(ctx context.Context, triggerUpdateWebhook
 		return afterDone, err
 	}
 	opts := css.BuildCommitOpts(e.targetRepo, e.ch, e.spec, pushConf)
+	if rebase {
+		// Apply the diff to the current head of the base branch instead of the
+		// commit the spec was computed against. `--3way` lets git resolve
+		// changes that don't apply verbatim anymore, as long as they don't
+		// actually conflict.
+		base, err := e.client.ResolveRevision(ctx, e.targetRepo.Name, e.spec.BaseRef, gitserver.ResolveRevisionOptions{})
+		if err != nil {
+			return afterDone, errors.Wrap(err, "resolving base branch")
+		}
+		opts.BaseCommit = base
+		opts.GitApplyArgs = append(opts.GitApplyArgs, "--3way")
+	}
 	resp, err := e.pushCommit(ctx, opts)
 	var pce pushCommitError
 	if errors.As(err, &pce) {
@@ -262,6 +292,7 @@ func (e *executor) publishChangeset(ctx context.Context, asDraft bool) (afterDon
 		HeadRef:    e.spec.HeadRef,
 		RemoteRepo: remoteRepo,
 		TargetRepo: e.targetRepo,
+		Spec:       e.spec,
 		Changeset:  e.ch,
 	}
 
@@ -312,6 +343,16 @@ func (e *executor) publishChangeset(ctx context.Context, asDraft bool) (afterDon
 	// Set the changeset to published.
 	e.ch.PublicationState = btypes.ChangesetPublicationStatePublished
 
+	if err := e.setChangesetTopic(ctx, css, cs); err != nil {
+		return afterDonePublish, err
+	}
+
+	// Newly opened changesets get their code owners requested as reviewers
+	// or assigned, if the spec asks for it.
+	if !exists {
+		e.notifyCodeOwners(ctx, css, cs)
+	}
+
 	// Enqueue the appropriate webhook.
 	if exists && outdated {
 		afterDone = func(store *store.Store) { e.enqueueWebhook(ctx, store, webhooks.ChangesetUpdate) }
@@ -398,6 +439,7 @@ func (e *executor) updateChangeset(ctx context.Context) (afterDone func(store *s
 		HeadRef:    e.spec.HeadRef,
 		RemoteRepo: remoteRepo,
 		TargetRepo: e.targetRepo,
+		Spec:       e.spec,
 		Changeset:  e.ch,
 	}
 
@@ -411,6 +453,12 @@ func (e *executor) updateChangeset(ctx context.Context) (afterDone func(store *s
 		}
 	}
 
+	// Updating a Gerrit change can replace it with a new change, which
+	// needs to be filed under the batch change's topic again.
+	if err := e.setChangesetTopic(ctx, css, &cs); err != nil {
+		return afterDone, err
+	}
+
 	afterDone = func(store *store.Store) { e.enqueueWebhook(ctx, store, webhooks.ChangesetUpdate) }
 	return afterDone, nil
 }
@@ -511,6 +559,94 @@ func (e *executor) closeChangeset(ctx context.Context) (afterDone func(store *st
 	return afterDone, nil
 }
 
+// mergeChangeset merges the changeset on its code host, as requested by the
+// auto-merge policy of its owning batch change. Merges rejected by the code
+// host are recorded as changeset events instead of failing the changeset, so
+// that the syncer can try again once the changeset has been updated.
+func (e *executor) mergeChangeset(ctx context.Context) (afterDone func(store *store.Store), err error) {
+	if e.ch.ExternalState != btypes.ChangesetExternalStateOpen {
+		// no-op
+		return nil, nil
+	}
+
+	policy, err := e.tx.GetBatchChangeAutoMergePolicy(ctx, e.ch.OwnedByBatchChangeID)
+	if err != nil {
+		if err == store.ErrNoResults {
+			// The policy was removed after the changeset had been enqueued.
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "loading auto-merge policy")
+	}
+
+	css, err := e.changesetSource(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteRepo, err := e.remoteRepo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cs := &sources.Changeset{
+		Changeset:  e.ch,
+		RemoteRepo: remoteRepo,
+		TargetRepo: e.targetRepo,
+	}
+
+	if err := css.MergeChangeset(ctx, cs, policy.MergeMethod == btypes.AutoMergeMethodSquash); err != nil {
+		// Changesets that aren't mergeable, for example because of conflicts
+		// or branch protection rules, return a non-retryable error.
+		if errcode.IsNonRetryable(err) {
+			return nil, e.recordAutoMergeFailure(ctx, policy, err)
+		}
+		return nil, errors.Wrap(err, "merging changeset")
+	}
+
+	events, err := e.ch.Events()
+	if err != nil {
+		log15.Error("Events", "err", err)
+		return nil, errcode.MakeNonRetryable(err)
+	}
+	state.SetDerivedState(ctx, e.tx.Repos(), e.client, e.ch, events)
+
+	// Code hosts can accept a merge without merging right away, for example
+	// when the changeset is added to a merge queue.
+	event := webhooks.ChangesetUpdate
+	if e.ch.ExternalState == btypes.ChangesetExternalStateMerged {
+		event = webhooks.ChangesetClose
+	}
+	afterDone = func(store *store.Store) { e.enqueueWebhook(ctx, store, event) }
+	return afterDone, nil
+}
+
+// recordAutoMergeFailure records that the code host rejected a merge
+// requested by the given auto-merge policy.
+func (e *executor) recordAutoMergeFailure(ctx context.Context, policy *btypes.BatchChangeAutoMergePolicy, mergeErr error) error {
+	now := e.tx.Clock()()
+
+	var approvals int
+	if events, err := e.ch.Events(); err == nil {
+		approvals = state.CountApprovals(events)
+	}
+
+	return e.tx.UpsertChangesetEvents(ctx, &btypes.ChangesetEvent{
+		ChangesetID: e.ch.ID,
+		Kind:        btypes.ChangesetEventKindAutoMergeFailed,
+		Key:         now.UTC().Format(time.RFC3339Nano),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		Metadata: &btypes.ChangesetAutoMergeEvent{
+			BatchChangeID: policy.BatchChangeID,
+			MergeMethod:   policy.MergeMethod,
+			Approvals:     approvals,
+			CheckState:    e.ch.ExternalCheckState,
+			Message:       mergeErr.Error(),
+			CreatedAt:     now,
+		},
+	})
+}
+
 // undraftChangeset marks the given changeset on its code host as ready for review.
 func (e *executor) undraftChangeset(ctx context.Context) (afterDone func(store *store.Store), err error) {
 	afterDone = func(store *store.Store) { e.enqueueWebhook(ctx, store, webhooks.ChangesetUpdateError) }
@@ -0,0 +1,123 @@
+package reconciler
+
+import (
+	"context"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources"
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/own"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/lib/batches/git"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// notifyCodeOwners requests reviews from and/or assigns the code owners of
+// the files touched by the changeset spec, depending on what the spec asks
+// for. Failures are logged rather than returned: a changeset that has been
+// published successfully shouldn't be retried because its owners couldn't be
+// resolved.
+func (e *executor) notifyCodeOwners(ctx context.Context, css sources.ChangesetSource, cs *sources.Changeset) {
+	if !e.spec.RequestOwnerReviews && !e.spec.AssignOwners {
+		return
+	}
+
+	logger := e.logger.With(log.Int64("changeset", e.ch.ID))
+
+	users, err := e.codeOwners(ctx)
+	if err != nil {
+		logger.Warn("resolving code owners", log.Error(err))
+		return
+	}
+	if len(users) == 0 {
+		return
+	}
+
+	if e.spec.RequestOwnerReviews {
+		if rcss, ok := css.(sources.ReviewableChangesetSource); ok {
+			if err := rcss.RequestReviews(ctx, cs, users); err != nil {
+				logger.Warn("requesting reviews from code owners", log.Error(err))
+			}
+		} else {
+			logger.Debug("code host does not support requesting reviews")
+		}
+	}
+
+	if e.spec.AssignOwners {
+		if acss, ok := css.(sources.AssignableChangesetSource); ok {
+			if err := acss.AssignChangeset(ctx, cs, users); err != nil {
+				logger.Warn("assigning code owners", log.Error(err))
+			}
+		} else {
+			logger.Debug("code host does not support assigning changesets")
+		}
+	}
+}
+
+// codeOwners returns the code host users owning the files touched by the
+// changeset spec's diff, according to the ownership rules of the target
+// repository at the spec's base revision.
+func (e *executor) codeOwners(ctx context.Context) ([]sources.CodeHostUser, error) {
+	changes, err := git.ChangesInDiff(e.spec.Diff)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing diff")
+	}
+
+	db := e.tx.DatabaseDB()
+	rs, err := own.NewService(e.client, db).RulesetForRepo(ctx, e.targetRepo.Name, e.targetRepo.ID, api.CommitID(e.spec.BaseRev))
+	if err != nil {
+		return nil, errors.Wrap(err, "loading ownership rules")
+	}
+	if rs == nil {
+		return nil, nil
+	}
+
+	repoContext := &own.RepoContext{
+		Name:         e.targetRepo.Name,
+		CodeHostKind: e.targetRepo.ExternalRepo.ServiceType,
+	}
+
+	type ownerKey struct{ handle, email string }
+	seen := map[ownerKey]struct{}{}
+	var refs []own.Reference
+	for _, paths := range [][]string{changes.Modified, changes.Added, changes.Deleted, changes.Renamed} {
+		for _, path := range paths {
+			for _, o := range rs.Match(path).GetOwner() {
+				key := ownerKey{o.GetHandle(), o.GetEmail()}
+				if _, ok := seen[key]; ok {
+					continue
+				}
+				seen[key] = struct{}{}
+				refs = append(refs, own.Reference{
+					RepoContext: repoContext,
+					Handle:      o.GetHandle(),
+					Email:       o.GetEmail(),
+				})
+			}
+		}
+	}
+	if len(refs) == 0 {
+		return nil, nil
+	}
+
+	accounts, unresolved, err := own.CodeHostAccounts(ctx, edb.NewEnterpriseDB(db), e.targetRepo.ExternalRepo.ServiceType, e.targetRepo.ExternalRepo.ServiceID, refs...)
+	if err != nil {
+		return nil, errors.Wrap(err, "resolving code host accounts")
+	}
+
+	users := make([]sources.CodeHostUser, 0, len(accounts)+len(unresolved))
+	for _, account := range accounts {
+		users = append(users, sources.CodeHostUser{AccountID: account.AccountID, Username: account.Login})
+	}
+	// Owners that can't be mapped to a Sourcegraph user may still be referred
+	// to by their code host handle, which is what CODEOWNERS files usually
+	// contain.
+	for _, ref := range unresolved {
+		if ref.Handle != "" {
+			users = append(users, sources.CodeHostUser{Username: ref.Handle})
+		}
+	}
+
+	return users, nil
+}
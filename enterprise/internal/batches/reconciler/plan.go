@@ -24,6 +24,7 @@ var operationPrecedence = map[btypes.ReconcilerOperation]int{
 	btypes.ReconcilerOperationUpdate:       4,
 	btypes.ReconcilerOperationSleep:        5,
 	btypes.ReconcilerOperationSync:         6,
+	btypes.ReconcilerOperationMerge:        7,
 }
 
 type Operations []btypes.ReconcilerOperation
@@ -117,7 +118,9 @@ func (p *Plan) SetOp(op btypes.ReconcilerOperation) { p.Ops = Operations{op} }
 // It consumes the current and the previous changeset spec, if they exist. If
 // the current ChangesetSpec is not applied to a batch change, it returns an
 // error.
-func DeterminePlan(previousSpec, currentSpec *btypes.ChangesetSpec, currentChangeset, wantedChangeset *btypes.Changeset) (*Plan, error) {
+// If pendingPrerequisites is true, the changesets the current spec depends on
+// are not merged yet and an unpublished changeset is not published.
+func DeterminePlan(previousSpec, currentSpec *btypes.ChangesetSpec, currentChangeset, wantedChangeset *btypes.Changeset, pendingPrerequisites bool) (*Plan, error) {
 	pl := &Plan{
 		Changeset:     wantedChangeset,
 		ChangesetSpec: currentSpec,
@@ -190,6 +193,12 @@ func DeterminePlan(previousSpec, currentSpec *btypes.ChangesetSpec, currentChang
 
 	switch wantedChangeset.PublicationState {
 	case btypes.ChangesetPublicationStateUnpublished:
+		// Dependent changesets are held back until all of their prerequisites
+		// are merged. The changeset is enqueued again once that happens.
+		if pendingPrerequisites {
+			break
+		}
+
 		calc := calculatePublicationState(currentSpec.Published, wantedChangeset.UiPublicationState)
 		if calc.IsPublished() {
 			pl.SetOp(btypes.ReconcilerOperationPublish)
@@ -254,6 +263,21 @@ func DeterminePlan(previousSpec, currentSpec *btypes.ChangesetSpec, currentChang
 			}
 		}
 
+		// A rebase pushes the diff of the current spec onto the head of the
+		// base branch, which the executor takes care of with any push.
+		if wantedChangeset.Rebasing && !pl.Ops.Contains(btypes.ReconcilerOperationPush) {
+			pl.AddOp(btypes.ReconcilerOperationPush)
+			pl.AddOp(btypes.ReconcilerOperationSleep)
+			pl.AddOp(btypes.ReconcilerOperationSync)
+		}
+
+		// Merges requested by an auto-merge policy are only carried out if
+		// nothing else needs to be done: new commits have to be reviewed and
+		// checked again before the changeset is merged.
+		if wantedChangeset.Merging && pl.Ops.IsNone() {
+			pl.AddOp(btypes.ReconcilerOperationMerge)
+		}
+
 	default:
 		return pl, errors.Errorf("unknown changeset publication state: %s", wantedChangeset.PublicationState)
 	}
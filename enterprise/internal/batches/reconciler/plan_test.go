@@ -13,11 +13,12 @@ func TestDetermineReconcilerPlan(t *testing.T) {
 	t.Parallel()
 
 	tcs := []struct {
-		name           string
-		previousSpec   *bt.TestSpecOpts
-		currentSpec    *bt.TestSpecOpts
-		changeset      bt.TestChangesetOpts
-		wantOperations Operations
+		name                 string
+		previousSpec         *bt.TestSpecOpts
+		currentSpec          *bt.TestSpecOpts
+		changeset            bt.TestChangesetOpts
+		pendingPrerequisites bool
+		wantOperations       Operations
 	}{
 		{
 			name:        "publish true",
@@ -30,6 +31,15 @@ func TestDetermineReconcilerPlan(t *testing.T) {
 				btypes.ReconcilerOperationPublish,
 			},
 		},
+		{
+			name:        "publish true with pending prerequisites",
+			currentSpec: &bt.TestSpecOpts{Published: true},
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStateUnpublished,
+			},
+			pendingPrerequisites: true,
+			wantOperations:       Operations{},
+		},
 		{
 			name:        "publish as draft",
 			currentSpec: &bt.TestSpecOpts{Published: "draft"},
@@ -329,6 +339,77 @@ func TestDetermineReconcilerPlan(t *testing.T) {
 			// should be a noop
 			wantOperations: Operations{},
 		},
+		{
+			name:         "merging",
+			previousSpec: &bt.TestSpecOpts{Published: true},
+			currentSpec:  &bt.TestSpecOpts{Published: true},
+			changeset: bt.TestChangesetOpts{
+				PublicationState:   btypes.ChangesetPublicationStatePublished,
+				ExternalState:      btypes.ChangesetExternalStateOpen,
+				OwnedByBatchChange: 1234,
+				BatchChanges:       []btypes.BatchChangeAssoc{{BatchChangeID: 1234}},
+				// Important bit:
+				Merging: true,
+			},
+			wantOperations: Operations{
+				btypes.ReconcilerOperationMerge,
+			},
+		},
+		{
+			name:         "merging changeset with changed commit",
+			previousSpec: &bt.TestSpecOpts{Published: true, CommitDiff: []byte("testDiff")},
+			currentSpec:  &bt.TestSpecOpts{Published: true, CommitDiff: []byte("newTestDiff")},
+			changeset: bt.TestChangesetOpts{
+				PublicationState:   btypes.ChangesetPublicationStatePublished,
+				ExternalState:      btypes.ChangesetExternalStateOpen,
+				OwnedByBatchChange: 1234,
+				BatchChanges:       []btypes.BatchChangeAssoc{{BatchChangeID: 1234}},
+				Merging:            true,
+			},
+			// The merge is skipped until the new commit has been checked.
+			wantOperations: Operations{
+				btypes.ReconcilerOperationPush,
+				btypes.ReconcilerOperationSleep,
+				btypes.ReconcilerOperationSync,
+			},
+		},
+		{
+			name:         "rebasing",
+			previousSpec: &bt.TestSpecOpts{Published: true},
+			currentSpec:  &bt.TestSpecOpts{Published: true},
+			changeset: bt.TestChangesetOpts{
+				PublicationState:   btypes.ChangesetPublicationStatePublished,
+				ExternalState:      btypes.ChangesetExternalStateOpen,
+				OwnedByBatchChange: 1234,
+				BatchChanges:       []btypes.BatchChangeAssoc{{BatchChangeID: 1234}},
+				Conflicted:         true,
+				// Important bit:
+				Rebasing: true,
+			},
+			wantOperations: Operations{
+				btypes.ReconcilerOperationPush,
+				btypes.ReconcilerOperationSleep,
+				btypes.ReconcilerOperationSync,
+			},
+		},
+		{
+			name:         "rebasing changeset with changed title",
+			previousSpec: &bt.TestSpecOpts{Published: true, Title: "Before"},
+			currentSpec:  &bt.TestSpecOpts{Published: true, Title: "After"},
+			changeset: bt.TestChangesetOpts{
+				PublicationState:   btypes.ChangesetPublicationStatePublished,
+				ExternalState:      btypes.ChangesetExternalStateOpen,
+				OwnedByBatchChange: 1234,
+				BatchChanges:       []btypes.BatchChangeAssoc{{BatchChangeID: 1234}},
+				Rebasing:           true,
+			},
+			wantOperations: Operations{
+				btypes.ReconcilerOperationPush,
+				btypes.ReconcilerOperationUpdate,
+				btypes.ReconcilerOperationSleep,
+				btypes.ReconcilerOperationSync,
+			},
+		},
 		{
 			name:         "detaching",
 			previousSpec: &bt.TestSpecOpts{Published: true},
@@ -450,7 +531,7 @@ func TestDetermineReconcilerPlan(t *testing.T) {
 
 			cs := bt.BuildChangeset(tc.changeset)
 
-			plan, err := DeterminePlan(previousSpec, currentSpec, nil, cs)
+			plan, err := DeterminePlan(previousSpec, currentSpec, nil, cs, tc.pendingPrerequisites)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -0,0 +1,105 @@
+package reconciler
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+)
+
+// rateLimitedRetryDelay is how long changesets whose operations were rejected
+// by the rate limit of their code host are deferred for, if the rate limit
+// monitors don't know when the budget is reset.
+const rateLimitedRetryDelay = time.Minute
+
+// PreDequeue implements workerutil.WithPreDequeue. Changesets that use a
+// credential whose rate limit budget is exhausted are not dequeued, so that the
+// worker can process the changesets of other credentials and code hosts in the
+// meantime.
+func (r *Reconciler) PreDequeue(_ context.Context, _ log.Logger) (bool, any, error) {
+	rateLimited := r.rateLimitedCredentials()
+	if len(rateLimited) == 0 {
+		return true, nil, nil
+	}
+
+	conds := make([]*sqlf.Query, 0, len(rateLimited))
+	for _, cred := range rateLimited {
+		if cred.UserID == 0 {
+			conds = append(conds, sqlf.Sprintf(siteCredentialCondition, cred.ExternalServiceID, database.UserCredentialDomainBatches))
+		} else {
+			conds = append(conds, sqlf.Sprintf(userCredentialCondition, cred.ExternalServiceID, cred.UserID))
+		}
+	}
+	return true, []*sqlf.Query{sqlf.Sprintf(rateLimitedCredentialsCondition, sqlf.Join(conds, " OR "))}, nil
+}
+
+// rateLimitedCredentialsCondition excludes the changesets that use one of the
+// credentials matched by the joined conditions. Changesets owned by a batch
+// change use the credential of the user who last applied it, if they have one
+// for the code host, and the site credential otherwise.
+const rateLimitedCredentialsCondition = `
+NOT EXISTS (
+	SELECT 1
+	FROM repo
+	LEFT JOIN batch_changes ON batch_changes.id = changesets.owned_by_batch_change_id
+	WHERE repo.id = changesets.repo_id AND (%s)
+)
+`
+
+const userCredentialCondition = `(repo.external_service_id = %s AND batch_changes.last_applier_id = %s)`
+
+const siteCredentialCondition = `(
+	repo.external_service_id = %s AND NOT EXISTS (
+		SELECT 1 FROM user_credentials
+		WHERE
+			user_credentials.domain = %s AND
+			user_credentials.user_id = batch_changes.last_applier_id AND
+			user_credentials.external_service_type = repo.external_service_type AND
+			user_credentials.external_service_id = repo.external_service_id
+	)
+)`
+
+// rateLimitedCredentials returns the credentials used by the changesets
+// handled so far whose rate limit budget is exhausted, either according to
+// the monitors of this process or as recorded by any replica.
+func (r *Reconciler) rateLimitedCredentials() []sources.RateLimitCredential {
+	r.credentialsMu.Lock()
+	var rateLimited []sources.RateLimitCredential
+	for cred := range r.credentials {
+		if r.rateLimits.Wait(cred, false) > 0 {
+			rateLimited = append(rateLimited, cred)
+		}
+	}
+	r.credentialsMu.Unlock()
+
+	sort.Slice(rateLimited, func(i, j int) bool {
+		if rateLimited[i].ExternalServiceID != rateLimited[j].ExternalServiceID {
+			return rateLimited[i].ExternalServiceID < rateLimited[j].ExternalServiceID
+		}
+		return rateLimited[i].UserID < rateLimited[j].UserID
+	})
+	return rateLimited
+}
+
+func (r *Reconciler) addCredential(cred sources.RateLimitCredential) {
+	r.credentialsMu.Lock()
+	defer r.credentialsMu.Unlock()
+
+	if r.credentials == nil {
+		r.credentials = make(map[sources.RateLimitCredential]struct{})
+	}
+	r.credentials[cred] = struct{}{}
+}
+
+// deferChangeset puts the changeset back into the queue, to be processed again
+// once the rate limit budget of its credential has been reset.
+func (r *Reconciler) deferChangeset(ctx context.Context, logger log.Logger, ch *btypes.Changeset, wait time.Duration) error {
+	logger.Info("Deferring changeset until rate limit is reset", log.Int64("changeset", ch.ID), log.Duration("wait", wait))
+	return r.store.DeferChangeset(ctx, ch, r.store.Clock()().Add(wait))
+}
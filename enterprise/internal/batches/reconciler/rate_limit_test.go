@@ -0,0 +1,98 @@
+package reconciler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/internal/redispool"
+)
+
+func TestReconcilerPreDequeue(t *testing.T) {
+	logger := logtest.Scoped(t)
+	ctx := context.Background()
+
+	site := sources.RateLimitCredential{ExternalServiceType: extsvc.TypeGitHub, ExternalServiceID: "https://github.com/", AuthHash: "site"}
+	user := sources.RateLimitCredential{ExternalServiceType: extsvc.TypeGitHub, ExternalServiceID: "https://github.com/", UserID: 3, AuthHash: "user"}
+	gitlab := sources.RateLimitCredential{ExternalServiceType: extsvc.TypeGitLab, ExternalServiceID: "https://gitlab.com/", AuthHash: "site"}
+
+	exhaust := func(monitor *ratelimit.Monitor) {
+		monitor.Update(http.Header{
+			"X-Ratelimit-Limit":     []string{"5000"},
+			"X-Ratelimit-Remaining": []string{"0"},
+			"X-Ratelimit-Reset":     []string{strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10)},
+		})
+	}
+
+	registry := ratelimit.NewMonitorRegistry()
+	siteMonitor := registry.GetOrSet("https://api.github.com/", "site", "graphql", &ratelimit.Monitor{HeaderPrefix: "X-"})
+	userMonitor := registry.GetOrSet("https://api.github.com/", "user", "rest", &ratelimit.Monitor{HeaderPrefix: "X-"})
+
+	r := &Reconciler{rateLimits: sources.NewRateLimitBudget(registry, nil)}
+	r.addCredential(site)
+	r.addCredential(user)
+	r.addCredential(gitlab)
+
+	queryArgs := func(t *testing.T, conds any) []any {
+		t.Helper()
+		require.IsType(t, []*sqlf.Query{}, conds)
+		queries := conds.([]*sqlf.Query)
+		require.Len(t, queries, 1)
+		return queries[0].Args()
+	}
+
+	t.Run("budget left", func(t *testing.T) {
+		dequeueable, conds, err := r.PreDequeue(ctx, logger)
+		require.NoError(t, err)
+		assert.True(t, dequeueable)
+		assert.Nil(t, conds)
+	})
+
+	t.Run("site credential budget exhausted", func(t *testing.T) {
+		exhaust(siteMonitor)
+
+		dequeueable, conds, err := r.PreDequeue(ctx, logger)
+		require.NoError(t, err)
+		assert.True(t, dequeueable)
+		assert.Equal(t, []any{"https://github.com/", database.UserCredentialDomainBatches}, queryArgs(t, conds))
+	})
+
+	t.Run("user credential budget exhausted", func(t *testing.T) {
+		exhaust(userMonitor)
+
+		dequeueable, conds, err := r.PreDequeue(ctx, logger)
+		require.NoError(t, err)
+		assert.True(t, dequeueable)
+		assert.Equal(t, []any{
+			"https://github.com/", database.UserCredentialDomainBatches,
+			"https://github.com/", int32(3),
+		}, queryArgs(t, conds))
+	})
+
+	t.Run("budget exhausted on another replica", func(t *testing.T) {
+		kv := redispool.MemoryKeyValue()
+		replica := &Reconciler{rateLimits: sources.NewRateLimitBudget(registry, kv)}
+		replica.rateLimits.Wait(user, false)
+
+		// This replica hasn't made any requests with the credential itself.
+		other := &Reconciler{rateLimits: sources.NewRateLimitBudget(ratelimit.NewMonitorRegistry(), kv)}
+		other.addCredential(user)
+		other.addCredential(gitlab)
+
+		dequeueable, conds, err := other.PreDequeue(ctx, logger)
+		require.NoError(t, err)
+		assert.True(t, dequeueable)
+		assert.Equal(t, []any{"https://github.com/", int32(3)}, queryArgs(t, conds))
+	})
+}
@@ -3,15 +3,20 @@ package reconciler
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/sourcegraph/log"
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/metrics"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/internal/redispool"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 // Reconciler processes changesets and reconciles their current state — in
@@ -22,6 +27,15 @@ type Reconciler struct {
 	sourcer sources.Sourcer
 	store   *store.Store
 
+	// rateLimits is used to defer changesets while the rate limit budget of
+	// the credential they use on their code host is exhausted.
+	rateLimits *sources.RateLimitBudget
+
+	// credentialsMu protects credentials, the credentials used by the
+	// changesets handled so far.
+	credentialsMu sync.Mutex
+	credentials   map[sources.RateLimitCredential]struct{}
+
 	// This is used to disable a time.Sleep for operationSleep so that the
 	// tests don't run slower.
 	noSleepBeforeSync bool
@@ -29,39 +43,85 @@ type Reconciler struct {
 
 func New(client gitserver.Client, sourcer sources.Sourcer, store *store.Store) *Reconciler {
 	return &Reconciler{
-		client:  client,
-		sourcer: sourcer,
-		store:   store,
+		client:     client,
+		sourcer:    sourcer,
+		store:      store,
+		rateLimits: sources.NewRateLimitBudget(ratelimit.DefaultMonitorRegistry, redispool.Store),
 	}
 }
 
+var (
+	_ workerutil.Handler[*btypes.Changeset] = &Reconciler{}
+	_ workerutil.WithPreDequeue             = &Reconciler{}
+)
+
 // HandlerFunc returns a dbworker.HandlerFunc that can be passed to a
 // workerutil.Worker to process queued changesets.
 func (r *Reconciler) HandlerFunc() workerutil.HandlerFunc[*btypes.Changeset] {
-	return func(ctx context.Context, logger log.Logger, job *btypes.Changeset) (err error) {
-		tx, err := r.store.Transact(ctx)
-		if err != nil {
-			return err
-		}
+	return r.Handle
+}
 
-		ctx = metrics.ContextWithTask(ctx, "Batches.Reconciler")
-		afterDone, err := r.process(ctx, logger, tx, job)
-
-		defer func() {
-			err = tx.Done(err)
-			// If afterDone is provided, it is enqueuing a new webhook. We call afterDone
-			// regardless of whether or not the transaction succeeds because the webhook
-			// should represent the interaction with the code host, not the database
-			// transaction. The worst case is that the transaction actually did fail and
-			// thus the changeset in the webhook payload is out-of-date. But we will still
-			// have enqueued the appropriate webhook.
-			if afterDone != nil {
-				afterDone(r.store)
+// Handle processes a queued changeset. Changesets whose credential has an
+// exhausted rate limit budget on their code host are deferred until the budget
+// has been reset, instead of failing on the code host.
+func (r *Reconciler) Handle(ctx context.Context, logger log.Logger, job *btypes.Changeset) error {
+	repo, err := r.store.Repos().Get(ctx, job.RepoID)
+	if err != nil {
+		return errors.Wrap(err, "loading repository")
+	}
+
+	cred, err := sources.ChangesetRateLimitCredential(ctx, r.store, job, repo)
+	if err != nil {
+		// Without a credential there is no budget to consult. Processing the
+		// changeset reports the missing credential.
+		return r.handle(ctx, logger, job)
+	}
+	r.addCredential(cred)
+
+	if wait := r.rateLimits.Wait(cred, false); wait > 0 {
+		return r.deferChangeset(ctx, logger, job, wait)
+	}
+
+	if err := r.handle(ctx, logger, job); err != nil {
+		// The code host rejected the operations because they exceeded the rate
+		// limit. They are retried once the budget has been reset, without
+		// counting as a failure.
+		if errcode.IsRateLimited(err) {
+			wait := r.rateLimits.Wait(cred, false)
+			if wait <= 0 {
+				wait = rateLimitedRetryDelay
 			}
-		}()
+			logger.Warn("Reconciler operations failed with exceeded rate limit", log.Int64("changeset", job.ID), log.Error(err))
+			return r.deferChangeset(ctx, logger, job, wait)
+		}
+		return err
+	}
+	return nil
+}
 
+func (r *Reconciler) handle(ctx context.Context, logger log.Logger, job *btypes.Changeset) (err error) {
+	tx, err := r.store.Transact(ctx)
+	if err != nil {
 		return err
 	}
+
+	ctx = metrics.ContextWithTask(ctx, "Batches.Reconciler")
+	afterDone, err := r.process(ctx, logger, tx, job)
+
+	defer func() {
+		err = tx.Done(err)
+		// If afterDone is provided, it is enqueuing a new webhook. We call afterDone
+		// regardless of whether or not the transaction succeeds because the webhook
+		// should represent the interaction with the code host, not the database
+		// transaction. The worst case is that the transaction actually did fail and
+		// thus the changeset in the webhook payload is out-of-date. But we will still
+		// have enqueued the appropriate webhook.
+		if afterDone != nil {
+			afterDone(r.store)
+		}
+	}()
+
+	return err
 }
 
 // process is the main entry point of the reconciler and processes changesets
@@ -90,9 +150,14 @@ func (r *Reconciler) process(ctx context.Context, logger log.Logger, tx *store.S
 		return nil, nil
 	}
 
+	pendingPrerequisites, err := HasPendingPrerequisites(ctx, tx, ch, curr)
+	if err != nil {
+		return nil, err
+	}
+
 	// Pass nil since there is no "current" changeset. The changeset has already been updated in the DB to the wanted
 	// state. Current changeset is only (at the moment) used for previewing.
-	plan, err := DeterminePlan(prev, curr, nil, ch)
+	plan, err := DeterminePlan(prev, curr, nil, ch, pendingPrerequisites)
 	if err != nil {
 		return nil, err
 	}
@@ -125,3 +190,18 @@ func loadChangesetSpecs(ctx context.Context, tx *store.Store, ch *btypes.Changes
 	}
 	return
 }
+
+// HasPendingPrerequisites returns whether the given unpublished changeset
+// depends on changesets that are not merged yet, according to its current
+// changeset spec.
+func HasPendingPrerequisites(ctx context.Context, tx *store.Store, ch *btypes.Changeset, curr *btypes.ChangesetSpec) (bool, error) {
+	if curr == nil || len(curr.DependsOn) == 0 || !ch.Unpublished() {
+		return false, nil
+	}
+
+	pending, err := tx.CountPendingPrerequisites(ctx, curr)
+	if err != nil {
+		return false, errors.Wrap(err, "counting pending prerequisites")
+	}
+	return pending > 0, nil
+}
@@ -0,0 +1,41 @@
+package reconciler
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// batchChangeHashtag is added to all changesets that are grouped under the
+// topic of their batch change, so that they can be found on the code host.
+const batchChangeHashtag = "batch-changes"
+
+// setChangesetTopic groups the changeset with the other changesets of its
+// batch change, if the code host supports topics.
+func (e *executor) setChangesetTopic(ctx context.Context, css sources.ChangesetSource, cs *sources.Changeset) error {
+	tcss, ok := css.(sources.TopicChangesetSource)
+	if !ok || e.ch.OwnedByBatchChangeID == 0 {
+		return nil
+	}
+
+	batchChange, err := loadBatchChange(ctx, e.tx, e.ch.OwnedByBatchChangeID)
+	if err != nil {
+		return errors.Wrap(err, "failed to load batch change")
+	}
+
+	ns, err := database.NamespacesWith(e.tx).GetByID(ctx, batchChange.NamespaceOrgID, batchChange.NamespaceUserID)
+	if err != nil {
+		return errors.Wrap(err, "retrieving namespace")
+	}
+
+	topic, hashtags := batchChangeTopic(ns.Name, batchChange.Name)
+	return errors.Wrap(tcss.SetTopic(ctx, cs, topic, hashtags), "setting changeset topic")
+}
+
+// batchChangeTopic returns the topic and hashtags shared by all changesets of
+// the given batch change.
+func batchChangeTopic(namespace, name string) (string, []string) {
+	return namespace + "/" + name, []string{batchChangeHashtag, name}
+}
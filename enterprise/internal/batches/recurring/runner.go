@@ -0,0 +1,102 @@
+package recurring
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/service"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/licensing"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// runnerInterval is how often the runner checks for due batch changes and
+// advances scheduled runs in progress.
+const runnerInterval = time.Minute
+
+// maxRunsStartedPerTick bounds the number of scheduled runs that are started
+// in a single tick. Remaining due batch changes are picked up on the next one.
+const maxRunsStartedPerTick = 50
+
+// NewRunner returns a background routine that periodically starts scheduled
+// runs of the batch changes whose schedule is due, and advances the runs in
+// progress through workspace resolution, execution, and apply.
+func NewRunner(ctx context.Context, bstore *store.Store) goroutine.BackgroundRoutine {
+	r := &runner{
+		store:  bstore,
+		svc:    service.New(bstore),
+		logger: log.Scoped("batches.recurring", "runs batch changes on a schedule"),
+	}
+
+	return goroutine.NewPeriodicGoroutine(
+		ctx,
+		r,
+		goroutine.WithName("batchchanges.scheduled-runner"),
+		goroutine.WithDescription("re-executes and applies batch changes on a schedule"),
+		goroutine.WithInterval(runnerInterval),
+	)
+}
+
+type runner struct {
+	store  *store.Store
+	svc    *service.Service
+	logger log.Logger
+}
+
+var _ goroutine.Handler = &runner{}
+
+func (r *runner) Handle(ctx context.Context) error {
+	// Scheduled runs apply batch specs without a user in the loop, so they
+	// are subject to the same license limits as a manual apply.
+	feature := &licensing.FeatureBatchChanges{}
+	if err := licensing.Check(feature); err != nil {
+		r.logger.Debug("skipping scheduled runs", log.Error(err))
+		return nil
+	}
+	opts := service.ProcessScheduledRunOpts{}
+	if !feature.Unrestricted {
+		opts.MaxChangesets = feature.MaxNumChangesets
+	}
+
+	var errs error
+
+	// Advance the runs in progress first, so that a batch change isn't
+	// considered busy for longer than it needs to.
+	runs, _, err := r.store.ListBatchChangeScheduledRuns(ctx, store.ListBatchChangeScheduledRunsOpts{
+		States: []btypes.BatchChangeScheduledRunState{
+			btypes.BatchChangeScheduledRunStateResolving,
+			btypes.BatchChangeScheduledRunStateExecuting,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "listing scheduled runs in progress")
+	}
+	for _, run := range runs {
+		if err := r.svc.ProcessScheduledRun(ctx, run, opts); err != nil {
+			r.logger.Warn("processing scheduled run", log.Int64("runID", run.ID), log.Error(err))
+			errs = errors.Append(errs, err)
+		}
+	}
+
+	due, err := r.store.ListBatchChangesDueForScheduledRun(ctx, r.store.Clock()(), maxRunsStartedPerTick)
+	if err != nil {
+		return errors.Append(errs, errors.Wrap(err, "listing batch changes due for a scheduled run"))
+	}
+	for _, batchChange := range due {
+		run, err := r.svc.StartScheduledRun(ctx, batchChange)
+		if err != nil {
+			r.logger.Warn("starting scheduled run", log.Int64("batchChangeID", batchChange.ID), log.Error(err))
+			errs = errors.Append(errs, err)
+			continue
+		}
+		if run.State == btypes.BatchChangeScheduledRunStateFailed {
+			r.logger.Info("scheduled run failed to start", log.Int64("batchChangeID", batchChange.ID), log.String("reason", run.FailureMessage))
+		}
+	}
+
+	return errs
+}
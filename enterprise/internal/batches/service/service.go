@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -93,8 +94,14 @@ type operations struct {
 	validateAuthenticator                *observation.Operation
 	createChangesetJobs                  *observation.Operation
 	applyBatchChange                     *observation.Operation
+	dryRunApplyBatchChange               *observation.Operation
 	reconcileBatchChange                 *observation.Operation
 	validateChangesetSpecs               *observation.Operation
+	updateBatchChangeSchedule            *observation.Operation
+	startScheduledRun                    *observation.Operation
+	processScheduledRun                  *observation.Operation
+	setBatchChangeAutoMergePolicy        *observation.Operation
+	deleteBatchChangeAutoMergePolicy     *observation.Operation
 }
 
 var (
@@ -143,8 +150,14 @@ func newOperations(observationCtx *observation.Context) *operations {
 			validateAuthenticator:                op("ValidateAuthenticator"),
 			createChangesetJobs:                  op("CreateChangesetJobs"),
 			applyBatchChange:                     op("ApplyBatchChange"),
+			dryRunApplyBatchChange:               op("DryRunApplyBatchChange"),
 			reconcileBatchChange:                 op("ReconcileBatchChange"),
 			validateChangesetSpecs:               op("ValidateChangesetSpecs"),
+			updateBatchChangeSchedule:            op("UpdateBatchChangeSchedule"),
+			startScheduledRun:                    op("StartScheduledRun"),
+			processScheduledRun:                  op("ProcessScheduledRun"),
+			setBatchChangeAutoMergePolicy:        op("SetBatchChangeAutoMergePolicy"),
+			deleteBatchChangeAutoMergePolicy:     op("DeleteBatchChangeAutoMergePolicy"),
 		}
 	})
 
@@ -1382,7 +1395,8 @@ func (s *Service) CreateChangesetJobs(ctx context.Context, batchChangeID int64,
 }
 
 // ValidateChangesetSpecs checks whether the given BachSpec has ChangesetSpecs
-// that would publish to the same branch in the same repository.
+// that would publish to the same branch in the same repository, or whose
+// dependencies form a cycle.
 // If the return value is nil, then the BatchSpec is valid.
 func (s *Service) ValidateChangesetSpecs(ctx context.Context, batchSpecID int64) error {
 	// We don't use `err` here to distinguish between errors we want to trace
@@ -1392,6 +1406,14 @@ func (s *Service) ValidateChangesetSpecs(ctx context.Context, batchSpecID int64)
 	ctx, _, endObservation := s.operations.validateChangesetSpecs.With(ctx, &nonValidationErr, observation.Args{})
 	defer endObservation(1, observation.Args{})
 
+	deps, nonValidationErr := s.store.ListChangesetSpecDependencies(ctx, batchSpecID)
+	if nonValidationErr != nil {
+		return nonValidationErr
+	}
+	if cycle := findDependencyCycle(deps); len(cycle) != 0 {
+		return changesetSpecDependencyCycleErr(cycle)
+	}
+
 	conflicts, nonValidationErr := s.store.ListChangesetSpecsWithConflictingHeadRef(ctx, batchSpecID)
 	if nonValidationErr != nil {
 		return nonValidationErr
@@ -1426,6 +1448,66 @@ func (s *Service) ValidateChangesetSpecs(ctx context.Context, batchSpecID int64)
 	return errs
 }
 
+// findDependencyCycle returns the repository names forming a cycle in the
+// given dependencies between changeset specs, or nil if there is none. The
+// first and the last element of a cycle are the same repository.
+func findDependencyCycle(deps map[string][]string) []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(deps))
+
+	var path []string
+	var visit func(repo string) []string
+	visit = func(repo string) []string {
+		switch state[repo] {
+		case visited:
+			return nil
+		case visiting:
+			for i, r := range path {
+				if r == repo {
+					return append(append([]string{}, path[i:]...), repo)
+				}
+			}
+		}
+
+		state[repo] = visiting
+		path = append(path, repo)
+		for _, prerequisite := range deps[repo] {
+			if cycle := visit(prerequisite); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		state[repo] = visited
+		return nil
+	}
+
+	repos := make([]string, 0, len(deps))
+	for repo := range deps {
+		repos = append(repos, repo)
+	}
+	sort.Strings(repos)
+
+	for _, repo := range repos {
+		if cycle := visit(repo); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
+// changesetSpecDependencyCycleErr is returned if the dependencies between the
+// changeset specs of a batch spec form a cycle, in which case none of the
+// changesets in the cycle could ever be published.
+type changesetSpecDependencyCycleErr []string
+
+func (e changesetSpecDependencyCycleErr) Error() string {
+	return fmt.Sprintf("Validating changeset specs resulted in an error:\n* the dependencies between changesets form a cycle: %s\n", strings.Join(e, " -> "))
+}
+
 type changesetSpecHeadRefConflict struct {
 	repo    *types.Repo
 	count   int
@@ -1686,6 +1768,7 @@ func (s *Service) GetAvailableBulkOperations(ctx context.Context, opts GetAvaila
 		btypes.ChangesetJobTypeDetach:    0,
 		btypes.ChangesetJobTypeMerge:     0,
 		btypes.ChangesetJobTypePublish:   0,
+		btypes.ChangesetJobTypeRebase:    0,
 		btypes.ChangesetJobTypeReenqueue: 0,
 	}
 
@@ -1743,6 +1826,11 @@ func (s *Service) GetAvailableBulkOperations(ctx context.Context, opts GetAvaila
 			bulkOperationsCounter[btypes.ChangesetJobTypeMerge] += 1
 		}
 
+		// REBASE
+		if !isChangesetArchived && !isChangesetJobFailed && !changeset.IsImported() && (isChangesetOpen || isChangesetDraft) {
+			bulkOperationsCounter[btypes.ChangesetJobTypeRebase] += 1
+		}
+
 		// COMMENT
 		if isChangesetCommentable {
 			bulkOperationsCounter[btypes.ChangesetJobTypeComment] += 1
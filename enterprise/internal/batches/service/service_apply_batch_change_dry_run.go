@@ -0,0 +1,310 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+
+	bgql "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/graphql"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/reconciler"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/rewirer"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ApplyDryRunReport describes the operations the reconciler would perform on
+// the code hosts if a batch spec was applied.
+type ApplyDryRunReport struct {
+	// BatchChange is the ID of the batch change the batch spec would be
+	// applied to. It is empty if applying the batch spec creates a new batch
+	// change.
+	BatchChange graphql.ID `json:"batchChange,omitempty"`
+	Name        string     `json:"name"`
+
+	// Changesets are the changesets with at least one planned operation.
+	Changesets []*ApplyDryRunChangeset `json:"changesets"`
+	// Unchanged is the number of changesets without planned operations.
+	Unchanged int `json:"unchanged"`
+
+	// Operations counts the planned operations by type.
+	Operations map[btypes.ReconcilerOperation]int `json:"operations"`
+	// CodeHosts counts the planned operations by code host, keyed by the
+	// external service ID of the code host.
+	CodeHosts map[string]*ApplyDryRunCodeHost `json:"codeHosts"`
+}
+
+// ApplyDryRunCodeHost counts the operations planned on a single code host by
+// type.
+type ApplyDryRunCodeHost struct {
+	ExternalServiceType string                             `json:"externalServiceType"`
+	Operations          map[btypes.ReconcilerOperation]int `json:"operations"`
+}
+
+// ApplyDryRunChangeset describes the operations planned for a single
+// changeset.
+type ApplyDryRunChangeset struct {
+	Repository          string `json:"repository"`
+	ExternalServiceType string `json:"externalServiceType"`
+	ExternalServiceID   string `json:"externalServiceID"`
+
+	// Changeset is the ID of the changeset. It is empty if the changeset
+	// doesn't exist yet.
+	Changeset  graphql.ID `json:"changeset,omitempty"`
+	ExternalID string     `json:"externalID,omitempty"`
+
+	// Operations are the planned operations, in the order they are executed.
+	Operations []btypes.ReconcilerOperation `json:"operations"`
+
+	// Branch is the branch that is pushed, if the changeset is pushed.
+	Branch string `json:"branch,omitempty"`
+	// Fork is true if the branch is pushed to a fork of the repository. The
+	// fork is created if it doesn't exist.
+	Fork bool `json:"fork,omitempty"`
+	// ForkNamespace is the namespace of the fork. It is empty if the fork is
+	// created in the namespace of the credential used to push.
+	ForkNamespace string `json:"forkNamespace,omitempty"`
+
+	Title string `json:"title,omitempty"`
+	// PreviousTitle is set if the title of the changeset changes.
+	PreviousTitle  string `json:"previousTitle,omitempty"`
+	BodyChanged    bool   `json:"bodyChanged,omitempty"`
+	BaseRefChanged bool   `json:"baseRefChanged,omitempty"`
+	DiffChanged    bool   `json:"diffChanged,omitempty"`
+}
+
+func (r *ApplyDryRunReport) add(c *ApplyDryRunChangeset) {
+	r.Changesets = append(r.Changesets, c)
+
+	codeHost, ok := r.CodeHosts[c.ExternalServiceID]
+	if !ok {
+		codeHost = &ApplyDryRunCodeHost{
+			ExternalServiceType: c.ExternalServiceType,
+			Operations:          map[btypes.ReconcilerOperation]int{},
+		}
+		r.CodeHosts[c.ExternalServiceID] = codeHost
+	}
+	for _, op := range c.Operations {
+		r.Operations[op]++
+		codeHost.Operations[op]++
+	}
+}
+
+// DryRunApplyBatchChange computes the operations the reconciler would perform
+// on the code hosts if the batch spec was applied with the given options. It
+// runs the rewirer and the planner for every changeset, the same way
+// ApplyBatchChange and the reconciler do, but doesn't write to the database or
+// enqueue any changesets.
+func (s *Service) DryRunApplyBatchChange(
+	ctx context.Context,
+	opts ApplyBatchChangeOpts,
+) (report *ApplyDryRunReport, err error) {
+	ctx, _, endObservation := s.operations.dryRunApplyBatchChange.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	batchSpec, err := s.store.GetBatchSpec(ctx, store.GetBatchSpecOpts{
+		RandID: opts.BatchSpecRandID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 🚨 SECURITY: Only site-admins or the creator of batchSpec can apply it,
+	// so the same goes for the dry run.
+	if err := s.checkViewerCanAdminister(ctx, batchSpec.NamespaceOrgID, batchSpec.UserID, false); err != nil {
+		return nil, err
+	}
+
+	if err := s.ValidateChangesetSpecs(ctx, batchSpec.ID); err != nil {
+		return nil, err
+	}
+
+	batchChange, previousSpecID, err := s.ReconcileBatchChange(ctx, batchSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	if batchChange.ID != 0 && opts.FailIfBatchChangeExists {
+		return nil, ErrMatchingBatchChangeExists
+	}
+
+	if opts.EnsureBatchChangeID != 0 && batchChange.ID != opts.EnsureBatchChangeID {
+		return nil, ErrEnsureBatchChangeFailed
+	}
+
+	if batchChange.Closed() {
+		return nil, ErrApplyClosedBatchChange
+	}
+
+	report = &ApplyDryRunReport{
+		Name:       batchChange.Name,
+		Operations: map[btypes.ReconcilerOperation]int{},
+		CodeHosts:  map[string]*ApplyDryRunCodeHost{},
+	}
+	if batchChange.ID != 0 {
+		report.BatchChange = bgql.MarshalBatchChangeID(batchChange.ID)
+	}
+
+	// Applying the batch spec that is already applied is a no-op.
+	if previousSpecID == batchSpec.ID {
+		return report, nil
+	}
+
+	mappings, err := s.store.GetRewirerMappings(ctx, store.GetRewirerMappingsOpts{
+		BatchSpecID:   batchSpec.ID,
+		BatchChangeID: batchChange.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := opts.PublicationStates.prepareAndValidate(mappings); err != nil {
+		return nil, err
+	}
+
+	// Rewire the mappings one by one, so we know which changeset belongs to
+	// which mapping. The entities are cloned so that the changesets in the
+	// mappings keep their current state, which the planner compares against.
+	wanted := make([]*btypes.Changeset, len(mappings))
+	var specIDs []int64
+	for i, m := range mappings {
+		clone := &btypes.RewirerMapping{
+			ChangesetSpecID: m.ChangesetSpecID,
+			ChangesetID:     m.ChangesetID,
+			RepoID:          m.RepoID,
+			ChangesetSpec:   m.ChangesetSpec,
+			Repo:            m.Repo,
+		}
+		if m.Changeset != nil {
+			clone.Changeset = m.Changeset.Clone()
+		}
+
+		newChangesets, updatedChangesets, err := rewirer.New(btypes.RewirerMappings{clone}, batchChange.ID).Rewire()
+		if err != nil {
+			return nil, err
+		}
+		changesets := append(newChangesets, updatedChangesets...)
+		if len(changesets) == 0 {
+			// The changeset isn't touched by the apply.
+			continue
+		}
+
+		ch := changesets[0]
+		if state := opts.PublicationStates.get(ch.CurrentSpecID); state != nil {
+			ch.UiPublicationState = state
+		}
+		wanted[i] = ch
+
+		if ch.PreviousSpecID != 0 {
+			specIDs = append(specIDs, ch.PreviousSpecID)
+		}
+		if ch.CurrentSpecID != 0 && m.ChangesetSpec == nil {
+			specIDs = append(specIDs, ch.CurrentSpecID)
+		}
+	}
+
+	// Load the specs the changesets were previously created from in one go,
+	// instead of once per changeset.
+	specsByID := map[int64]*btypes.ChangesetSpec{}
+	if len(specIDs) > 0 {
+		specs, _, err := s.store.ListChangesetSpecs(ctx, store.ListChangesetSpecsOpts{IDs: specIDs})
+		if err != nil {
+			return nil, err
+		}
+		for _, spec := range specs {
+			specsByID[spec.ID] = spec
+		}
+	}
+
+	for i, m := range mappings {
+		ch := wanted[i]
+		if ch == nil {
+			report.Unchanged++
+			continue
+		}
+
+		var previousSpec, currentSpec *btypes.ChangesetSpec
+		if ch.PreviousSpecID != 0 {
+			if previousSpec = specsByID[ch.PreviousSpecID]; previousSpec == nil {
+				return nil, errors.Newf("changeset spec %d not found", ch.PreviousSpecID)
+			}
+		}
+		if ch.CurrentSpecID != 0 {
+			if currentSpec = m.ChangesetSpec; currentSpec == nil {
+				if currentSpec = specsByID[ch.CurrentSpecID]; currentSpec == nil {
+					return nil, errors.Newf("changeset spec %d not found", ch.CurrentSpecID)
+				}
+			}
+		}
+
+		pendingPrerequisites, err := reconciler.HasPendingPrerequisites(ctx, s.store, ch, currentSpec)
+		if err != nil {
+			return nil, err
+		}
+		plan, err := reconciler.DeterminePlan(previousSpec, currentSpec, m.Changeset, ch, pendingPrerequisites)
+		if err != nil {
+			return nil, err
+		}
+
+		ops := plan.Ops.ExecutionOrder()
+		if len(ops) == 0 {
+			report.Unchanged++
+			continue
+		}
+
+		report.add(newApplyDryRunChangeset(m, ch, ops, previousSpec, currentSpec, plan.Delta))
+	}
+
+	return report, nil
+}
+
+func newApplyDryRunChangeset(
+	m *btypes.RewirerMapping,
+	ch *btypes.Changeset,
+	ops []btypes.ReconcilerOperation,
+	previousSpec, currentSpec *btypes.ChangesetSpec,
+	delta *reconciler.ChangesetSpecDelta,
+) *ApplyDryRunChangeset {
+	c := &ApplyDryRunChangeset{
+		Repository:          string(m.Repo.Name),
+		ExternalServiceType: m.Repo.ExternalRepo.ServiceType,
+		ExternalServiceID:   m.Repo.ExternalRepo.ServiceID,
+		ExternalID:          ch.ExternalID,
+		Operations:          ops,
+	}
+	if ch.ID != 0 {
+		c.Changeset = bgql.MarshalChangesetID(ch.ID)
+	}
+
+	if currentSpec != nil {
+		c.Title = currentSpec.Title
+		if reconciler.Operations(ops).Contains(btypes.ReconcilerOperationPush) {
+			c.Branch = strings.TrimPrefix(currentSpec.HeadRef, "refs/heads/")
+
+			// Changesets that have been pushed to a fork before keep using
+			// it, the same way the reconciler picks the remote to push to.
+			if m.Changeset != nil && m.Changeset.ExternalForkNamespace != "" {
+				c.Fork = true
+				c.ForkNamespace = m.Changeset.ExternalForkNamespace
+			} else if currentSpec.IsFork() {
+				c.Fork = true
+				if ns := currentSpec.GetForkNamespace(); ns != nil {
+					c.ForkNamespace = *ns
+				}
+			}
+		}
+	}
+
+	if delta != nil {
+		if delta.TitleChanged && previousSpec != nil {
+			c.PreviousTitle = previousSpec.Title
+		}
+		c.BodyChanged = delta.BodyChanged
+		c.BaseRefChanged = delta.BaseRefChanged
+		c.DiffChanged = delta.DiffChanged
+	}
+
+	return c
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	bstore "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	bt "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/timeutil"
+)
+
+func TestServiceDryRunApplyBatchChange(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	ctx := actor.WithInternalActor(context.Background())
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+
+	admin := bt.CreateTestUser(t, db, true)
+	adminCtx := actor.WithActor(context.Background(), actor.FromUser(admin.ID))
+
+	repos, _ := bt.CreateTestRepos(t, ctx, db, 3)
+
+	now := timeutil.Now()
+	clock := func() time.Time { return now }
+	store := bstore.NewWithClock(db, &observation.TestContext, nil, clock)
+	svc := New(store)
+
+	t.Run("new batch change", func(t *testing.T) {
+		bt.TruncateTables(t, db, "changeset_events", "changesets", "batch_changes", "batch_specs", "changeset_specs")
+		batchSpec := bt.CreateBatchSpec(t, ctx, store, "dry-run", admin.ID, 0)
+
+		bt.CreateChangesetSpec(t, ctx, store, bt.TestSpecOpts{
+			User:       admin.ID,
+			Repo:       repos[0].ID,
+			BatchSpec:  batchSpec.ID,
+			ExternalID: "1234",
+			Typ:        btypes.ChangesetSpecTypeExisting,
+		})
+		bt.CreateChangesetSpec(t, ctx, store, bt.TestSpecOpts{
+			User:      admin.ID,
+			Repo:      repos[1].ID,
+			BatchSpec: batchSpec.ID,
+			HeadRef:   "refs/heads/my-branch",
+			Title:     "Fix the thing",
+			Published: true,
+			Typ:       btypes.ChangesetSpecTypeBranch,
+		})
+		// Unpublished changesets are created, but not pushed.
+		bt.CreateChangesetSpec(t, ctx, store, bt.TestSpecOpts{
+			User:      admin.ID,
+			Repo:      repos[2].ID,
+			BatchSpec: batchSpec.ID,
+			HeadRef:   "refs/heads/my-branch",
+			Published: false,
+			Typ:       btypes.ChangesetSpecTypeBranch,
+		})
+
+		report, err := svc.DryRunApplyBatchChange(adminCtx, ApplyBatchChangeOpts{
+			BatchSpecRandID: batchSpec.RandID,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := &ApplyDryRunReport{
+			Name: "dry-run",
+			Changesets: []*ApplyDryRunChangeset{
+				{
+					Repository:          string(repos[0].Name),
+					ExternalServiceType: repos[0].ExternalRepo.ServiceType,
+					ExternalServiceID:   repos[0].ExternalRepo.ServiceID,
+					ExternalID:          "1234",
+					Operations:          []btypes.ReconcilerOperation{btypes.ReconcilerOperationImport},
+				},
+				{
+					Repository:          string(repos[1].Name),
+					ExternalServiceType: repos[1].ExternalRepo.ServiceType,
+					ExternalServiceID:   repos[1].ExternalRepo.ServiceID,
+					Operations:          []btypes.ReconcilerOperation{btypes.ReconcilerOperationPush, btypes.ReconcilerOperationPublish},
+					Branch:              "my-branch",
+					Title:               "Fix the thing",
+				},
+			},
+			Unchanged: 1,
+			Operations: map[btypes.ReconcilerOperation]int{
+				btypes.ReconcilerOperationImport:  1,
+				btypes.ReconcilerOperationPush:    1,
+				btypes.ReconcilerOperationPublish: 1,
+			},
+			CodeHosts: map[string]*ApplyDryRunCodeHost{
+				repos[0].ExternalRepo.ServiceID: {
+					ExternalServiceType: repos[0].ExternalRepo.ServiceType,
+					Operations: map[btypes.ReconcilerOperation]int{
+						btypes.ReconcilerOperationImport:  1,
+						btypes.ReconcilerOperationPush:    1,
+						btypes.ReconcilerOperationPublish: 1,
+					},
+				},
+			},
+		}
+		if diff := cmp.Diff(want, report); diff != "" {
+			t.Fatalf("wrong report (-want +got):\n%s", diff)
+		}
+
+		// Nothing has been written to the database.
+		if count, err := store.CountBatchChanges(ctx, bstore.CountBatchChangesOpts{}); err != nil {
+			t.Fatal(err)
+		} else if count != 0 {
+			t.Fatalf("dry run created %d batch changes", count)
+		}
+		if count, err := store.CountChangesets(ctx, bstore.CountChangesetsOpts{}); err != nil {
+			t.Fatal(err)
+		} else if count != 0 {
+			t.Fatalf("dry run created %d changesets", count)
+		}
+	})
+
+	t.Run("applying to closed batch change", func(t *testing.T) {
+		bt.TruncateTables(t, db, "changeset_events", "changesets", "batch_changes", "batch_specs", "changeset_specs")
+		batchSpec := bt.CreateBatchSpec(t, ctx, store, "closed-batch-change", admin.ID, 0)
+		batchChange := bt.CreateBatchChange(t, ctx, store, "closed-batch-change", admin.ID, batchSpec.ID)
+
+		batchChange.ClosedAt = time.Now()
+		if err := store.UpdateBatchChange(ctx, batchChange); err != nil {
+			t.Fatalf("failed to update batch change: %s", err)
+		}
+
+		_, err := svc.DryRunApplyBatchChange(adminCtx, ApplyBatchChangeOpts{
+			BatchSpecRandID: batchSpec.RandID,
+		})
+		if err != ErrApplyClosedBatchChange {
+			t.Fatalf("DryRunApplyBatchChange returned unexpected error: %s", err)
+		}
+	})
+}
@@ -610,6 +610,7 @@ func TestServiceApplyBatchChange(t *testing.T) {
 				spec2,
 				nil,
 				changesets[0],
+				false,
 			)
 			if err != nil {
 				t.Fatal(err)
@@ -650,6 +651,7 @@ func TestServiceApplyBatchChange(t *testing.T) {
 				spec3,
 				nil,
 				changesets[0],
+				false,
 			)
 			if err != nil {
 				t.Fatal(err)
@@ -692,6 +694,7 @@ func TestServiceApplyBatchChange(t *testing.T) {
 				spec4,
 				nil,
 				changesets[0],
+				false,
 			)
 			if err != nil {
 				t.Fatal(err)
@@ -816,6 +819,7 @@ func TestServiceApplyBatchChange(t *testing.T) {
 				newSpec2,
 				nil,
 				c2,
+				false,
 			)
 			if err != nil {
 				t.Fatal(err)
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type SetBatchChangeAutoMergePolicyOpts struct {
+	BatchChangeID int64
+
+	RequiredApprovals    int32
+	RequirePassingChecks bool
+	MergeMethod          btypes.AutoMergeMethod
+
+	MaintenanceWindowDays  []string
+	MaintenanceWindowStart string
+	MaintenanceWindowEnd   string
+}
+
+// SetBatchChangeAutoMergePolicy creates or replaces the auto-merge policy of
+// the given batch change.
+func (s *Service) SetBatchChangeAutoMergePolicy(ctx context.Context, opts SetBatchChangeAutoMergePolicyOpts) (policy *btypes.BatchChangeAutoMergePolicy, err error) {
+	ctx, _, endObservation := s.operations.setBatchChangeAutoMergePolicy.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(opts.BatchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	batchChange, err := s.store.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: opts.BatchChangeID})
+	if err != nil {
+		return nil, errors.Wrap(err, "getting batch change")
+	}
+
+	// 🚨 SECURITY: Auto-merge policies merge changesets with the credentials
+	// used to publish them, so only users who can administer the batch change
+	// may change its policy.
+	if err := s.checkViewerCanAdminister(ctx, batchChange.NamespaceOrgID, batchChange.CreatorID, false); err != nil {
+		return nil, err
+	}
+
+	if opts.RequiredApprovals < 0 {
+		return nil, errors.New("the number of required approvals cannot be negative")
+	}
+	if opts.MergeMethod == "" {
+		opts.MergeMethod = btypes.AutoMergeMethodMerge
+	}
+	if !opts.MergeMethod.Valid() {
+		return nil, errors.Newf("invalid merge method %q", opts.MergeMethod)
+	}
+
+	policy = &btypes.BatchChangeAutoMergePolicy{
+		BatchChangeID:          batchChange.ID,
+		RequiredApprovals:      opts.RequiredApprovals,
+		RequirePassingChecks:   opts.RequirePassingChecks,
+		MergeMethod:            opts.MergeMethod,
+		MaintenanceWindowDays:  opts.MaintenanceWindowDays,
+		MaintenanceWindowStart: opts.MaintenanceWindowStart,
+		MaintenanceWindowEnd:   opts.MaintenanceWindowEnd,
+	}
+	if _, err := policy.MaintenanceWindow(); err != nil {
+		return nil, errors.Wrap(err, "invalid maintenance window")
+	}
+
+	if err := s.store.UpsertBatchChangeAutoMergePolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// DeleteBatchChangeAutoMergePolicy removes the auto-merge policy of the given
+// batch change. Changesets that have already been enqueued to be merged are
+// left open by the reconciler.
+func (s *Service) DeleteBatchChangeAutoMergePolicy(ctx context.Context, batchChangeID int64) (err error) {
+	ctx, _, endObservation := s.operations.deleteBatchChangeAutoMergePolicy.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(batchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	batchChange, err := s.store.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: batchChangeID})
+	if err != nil {
+		return errors.Wrap(err, "getting batch change")
+	}
+
+	if err := s.checkViewerCanAdminister(ctx, batchChange.NamespaceOrgID, batchChange.CreatorID, false); err != nil {
+		return err
+	}
+
+	return s.store.DeleteBatchChangeAutoMergePolicy(ctx, batchChange.ID)
+}
@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	sgactor "github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ErrScheduleDraftBatchChange is returned by UpdateBatchChangeSchedule when a
+// schedule is set on a batch change that has never been applied.
+var ErrScheduleDraftBatchChange = errors.New("cannot schedule a batch change that hasn't been applied yet")
+
+// ErrScheduleClosedBatchChange is returned by UpdateBatchChangeSchedule when a
+// schedule is set on a closed batch change.
+var ErrScheduleClosedBatchChange = errors.New("cannot schedule a closed batch change")
+
+type UpdateBatchChangeScheduleOpts struct {
+	BatchChangeID int64
+
+	// Schedule is the new cron expression of the batch change. An empty
+	// string removes the schedule. If nil, the schedule is left unchanged.
+	Schedule *string
+	// Paused pauses or resumes the schedule. If nil, it is left unchanged.
+	Paused *bool
+}
+
+// UpdateBatchChangeSchedule sets, removes, pauses, or resumes the schedule of
+// the given batch change.
+func (s *Service) UpdateBatchChangeSchedule(ctx context.Context, opts UpdateBatchChangeScheduleOpts) (batchChange *btypes.BatchChange, err error) {
+	ctx, _, endObservation := s.operations.updateBatchChangeSchedule.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(opts.BatchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	batchChange, err = s.store.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: opts.BatchChangeID})
+	if err != nil {
+		return nil, errors.Wrap(err, "getting batch change")
+	}
+
+	// 🚨 SECURITY: Scheduled runs execute and apply batch specs on behalf of
+	// the last applier, so only users who can administer the batch change may
+	// change its schedule.
+	if err := s.checkViewerCanAdminister(ctx, batchChange.NamespaceOrgID, batchChange.CreatorID, false); err != nil {
+		return nil, err
+	}
+
+	if opts.Schedule != nil {
+		batchChange.Schedule = *opts.Schedule
+		if batchChange.Schedule == "" {
+			batchChange.SchedulePaused = false
+		}
+	}
+	if opts.Paused != nil && batchChange.Schedule != "" {
+		batchChange.SchedulePaused = *opts.Paused
+	}
+
+	batchChange.NextScheduledRunAt = time.Time{}
+	if batchChange.Schedule != "" {
+		if batchChange.IsDraft() {
+			return nil, ErrScheduleDraftBatchChange
+		}
+		if batchChange.Closed() {
+			return nil, ErrScheduleClosedBatchChange
+		}
+
+		expr, err := btypes.ParseBatchChangeSchedule(batchChange.Schedule)
+		if err != nil {
+			return nil, err
+		}
+		// Runs missed while the schedule was paused are not made up for.
+		batchChange.NextScheduledRunAt = expr.Next(s.clock())
+	}
+
+	if err := s.store.UpdateBatchChangeSchedule(ctx, batchChange); err != nil {
+		return nil, err
+	}
+
+	return batchChange, nil
+}
+
+// StartScheduledRun creates a new scheduled run of the given batch change and
+// advances its schedule. The run creates a new batch spec from the raw spec of
+// the batch change on behalf of the user who last applied it, which enqueues
+// the workspace resolution. Failures to create the batch spec are recorded on
+// the returned run instead of being returned.
+func (s *Service) StartScheduledRun(ctx context.Context, batchChange *btypes.BatchChange) (run *btypes.BatchChangeScheduledRun, err error) {
+	ctx, _, endObservation := s.operations.startScheduledRun.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(batchChange.ID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	run = &btypes.BatchChangeScheduledRun{
+		BatchChangeID: batchChange.ID,
+		State:         btypes.BatchChangeScheduledRunStateResolving,
+	}
+
+	expr, err := btypes.ParseBatchChangeSchedule(batchChange.Schedule)
+	if err != nil {
+		// Pause schedules that can't be parsed anymore, rather than failing
+		// on every tick.
+		batchChange.SchedulePaused = true
+		if err := s.store.UpdateBatchChangeSchedule(ctx, batchChange); err != nil {
+			return nil, err
+		}
+		return run, s.failScheduledRun(ctx, run, err.Error())
+	}
+
+	batchChange.NextScheduledRunAt = expr.Next(s.clock())
+	if err := s.store.UpdateBatchChangeSchedule(ctx, batchChange); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.CreateBatchChangeScheduledRun(ctx, run); err != nil {
+		return nil, err
+	}
+
+	if batchChange.LastApplierID == 0 {
+		return run, s.failScheduledRun(ctx, run, "the user who last applied the batch change no longer exists")
+	}
+
+	currentSpec, err := s.store.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: batchChange.BatchSpecID})
+	if err != nil {
+		return nil, errors.Wrap(err, "getting current batch spec")
+	}
+
+	spec, err := s.CreateBatchSpecFromRaw(scheduledRunActor(ctx, batchChange), CreateBatchSpecFromRawOpts{
+		RawSpec:          currentSpec.RawSpec,
+		NamespaceUserID:  batchChange.NamespaceUserID,
+		NamespaceOrgID:   batchChange.NamespaceOrgID,
+		AllowIgnored:     currentSpec.AllowIgnored,
+		AllowUnsupported: currentSpec.AllowUnsupported,
+		BatchChange:      batchChange.ID,
+	})
+	if err != nil {
+		return run, s.failScheduledRun(ctx, run, fmt.Sprintf("creating batch spec: %s", err))
+	}
+
+	run.BatchSpecID = spec.ID
+	return run, s.store.UpdateBatchChangeScheduledRun(ctx, run)
+}
+
+type ProcessScheduledRunOpts struct {
+	// MaxChangesets is the maximum number of changesets a batch change may
+	// have when the run is applied. Zero means there is no limit.
+	MaxChangesets int
+}
+
+// ProcessScheduledRun advances the given unfinished scheduled run: once the
+// workspaces of its batch spec are resolved, the batch spec is executed, and
+// once the execution has finished, the batch spec is applied. Runs whose
+// current step hasn't finished yet are left unchanged.
+func (s *Service) ProcessScheduledRun(ctx context.Context, run *btypes.BatchChangeScheduledRun, opts ProcessScheduledRunOpts) (err error) {
+	ctx, _, endObservation := s.operations.processScheduledRun.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("runID", int(run.ID)),
+		attribute.String("state", string(run.State)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if run.State.Finished() {
+		return nil
+	}
+
+	batchChange, err := s.store.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: run.BatchChangeID})
+	if err != nil {
+		return errors.Wrap(err, "getting batch change")
+	}
+	if batchChange.Closed() {
+		return s.failScheduledRun(ctx, run, "the batch change was closed")
+	}
+	if run.BatchSpecID == 0 {
+		return s.failScheduledRun(ctx, run, "the batch spec of the run was deleted")
+	}
+
+	batchSpec, err := s.store.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: run.BatchSpecID})
+	if err != nil {
+		return errors.Wrap(err, "getting batch spec")
+	}
+
+	actorCtx := scheduledRunActor(ctx, batchChange)
+
+	switch run.State {
+	case btypes.BatchChangeScheduledRunStateResolving:
+		resolutionJob, err := s.store.GetBatchSpecResolutionJob(ctx, store.GetBatchSpecResolutionJobOpts{BatchSpecID: batchSpec.ID})
+		if err != nil {
+			return errors.Wrap(err, "getting resolution job")
+		}
+
+		switch resolutionJob.State {
+		case btypes.BatchSpecResolutionJobStateFailed:
+			return s.failScheduledRun(ctx, run, ErrBatchSpecResolutionErrored{resolutionJob.FailureMessage}.Error())
+		case btypes.BatchSpecResolutionJobStateCompleted:
+			// Continue below the switch statement.
+		default:
+			// Errored jobs are retried by the resolution worker.
+			return nil
+		}
+
+		// Workspaces whose inputs haven't changed since a previous run reuse
+		// the cached execution results.
+		if _, err := s.ExecuteBatchSpec(actorCtx, ExecuteBatchSpecOpts{BatchSpecRandID: batchSpec.RandID}); err != nil {
+			return s.failScheduledRun(ctx, run, fmt.Sprintf("executing batch spec: %s", err))
+		}
+
+		run.State = btypes.BatchChangeScheduledRunStateExecuting
+		return s.store.UpdateBatchChangeScheduledRun(ctx, run)
+
+	case btypes.BatchChangeScheduledRunStateExecuting:
+		stats, err := s.LoadBatchSpecStats(ctx, batchSpec)
+		if err != nil {
+			return err
+		}
+
+		// If every workspace had a cached result, or was skipped, there is
+		// nothing to execute and the batch spec can be applied right away.
+		if stats.ResolutionDone && stats.Executions > 0 {
+			switch state := btypes.ComputeBatchSpecState(batchSpec, stats); state {
+			case btypes.BatchSpecStateCompleted:
+				// Continue below the switch statement.
+			case btypes.BatchSpecStateFailed, btypes.BatchSpecStateCanceled:
+				return s.failScheduledRun(ctx, run, fmt.Sprintf("execution %s in %d of %d workspaces", state, stats.Failed+stats.Canceled, stats.Executions))
+			default:
+				return nil
+			}
+		}
+
+		if opts.MaxChangesets > 0 {
+			count, err := s.store.CountChangesetSpecs(ctx, store.CountChangesetSpecsOpts{BatchSpecID: batchSpec.ID})
+			if err != nil {
+				return err
+			}
+			if count > opts.MaxChangesets {
+				return s.failScheduledRun(ctx, run, fmt.Sprintf("maximum number of changesets per batch change (%d) exceeded", opts.MaxChangesets))
+			}
+		}
+
+		if _, err := s.ApplyBatchChange(actorCtx, ApplyBatchChangeOpts{
+			BatchSpecRandID:     batchSpec.RandID,
+			EnsureBatchChangeID: batchChange.ID,
+		}); err != nil {
+			return s.failScheduledRun(ctx, run, fmt.Sprintf("applying batch spec: %s", err))
+		}
+
+		run.State = btypes.BatchChangeScheduledRunStateCompleted
+		run.FinishedAt = s.clock()
+		return s.store.UpdateBatchChangeScheduledRun(ctx, run)
+	}
+
+	return nil
+}
+
+func (s *Service) failScheduledRun(ctx context.Context, run *btypes.BatchChangeScheduledRun, message string) error {
+	run.State = btypes.BatchChangeScheduledRunStateFailed
+	run.FailureMessage = message
+	run.FinishedAt = s.clock()
+
+	if run.ID == 0 {
+		return s.store.CreateBatchChangeScheduledRun(ctx, run)
+	}
+	return s.store.UpdateBatchChangeScheduledRun(ctx, run)
+}
+
+// scheduledRunActor returns a context acting as the user who last applied the
+// given batch change. Scheduled runs are subject to the same permission checks
+// as if that user had re-applied the batch change themselves.
+func scheduledRunActor(ctx context.Context, batchChange *btypes.BatchChange) context.Context {
+	return sgactor.WithActor(ctx, sgactor.FromUser(batchChange.LastApplierID))
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/graph-gophers/graphql-go/relay"
+	"github.com/keegancsmith/sqlf"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -2258,6 +2259,28 @@ changesetTemplate:
 		}
 	})
 
+	t.Run("ValidateChangesetSpecs with dependency cycle", func(t *testing.T) {
+		batchSpec := bt.CreateBatchSpec(t, ctx, s, "matching-batch-spec", admin.ID, 0)
+		for _, opts := range []bt.TestSpecOpts{
+			{HeadRef: "refs/heads/lib", Typ: btypes.ChangesetSpecTypeBranch, Repo: rs[0].ID, BatchSpec: batchSpec.ID, DependsOn: []string{string(rs[1].Name)}},
+			{HeadRef: "refs/heads/lib", Typ: btypes.ChangesetSpecTypeBranch, Repo: rs[1].ID, BatchSpec: batchSpec.ID, DependsOn: []string{string(rs[0].Name)}},
+			{HeadRef: "refs/heads/lib", Typ: btypes.ChangesetSpecTypeBranch, Repo: rs[2].ID, BatchSpec: batchSpec.ID},
+		} {
+			bt.CreateChangesetSpec(t, ctx, s, opts)
+		}
+		err := svc.ValidateChangesetSpecs(ctx, batchSpec.ID)
+		if err == nil {
+			t.Fatal("expected error, but got none")
+		}
+
+		want := `Validating changeset specs resulted in an error:
+* the dependencies between changesets form a cycle: repo-1-1 -> repo-1-2 -> repo-1-1
+`
+		if diff := cmp.Diff(want, err.Error()); diff != "" {
+			t.Fatalf("wrong error message: %s", diff)
+		}
+	})
+
 	t.Run("ComputeBatchSpecState", func(t *testing.T) {
 		t.Run("success", func(t *testing.T) {
 			spec := testBatchSpec(admin.ID)
@@ -2913,7 +2936,7 @@ changesetTemplate:
 				t.Fatal(err)
 			}
 
-			expectedBulkOperations := []string{"CLOSE", "COMMENT", "PUBLISH"}
+			expectedBulkOperations := []string{"CLOSE", "COMMENT", "PUBLISH", "REBASE"}
 			if !assert.ElementsMatch(t, expectedBulkOperations, bulkOperations) {
 				t.Errorf("wrong bulk operation type returned. want=%q, have=%q", expectedBulkOperations, bulkOperations)
 			}
@@ -2938,7 +2961,7 @@ changesetTemplate:
 				t.Fatal(err)
 			}
 
-			expectedBulkOperations := []string{"CLOSE", "COMMENT", "MERGE", "PUBLISH"}
+			expectedBulkOperations := []string{"CLOSE", "COMMENT", "MERGE", "PUBLISH", "REBASE"}
 			if !assert.ElementsMatch(t, expectedBulkOperations, bulkOperations) {
 				t.Errorf("wrong bulk operation type returned. want=%q, have=%q", expectedBulkOperations, bulkOperations)
 			}
@@ -3196,6 +3219,151 @@ changesetTemplate:
 			}
 		})
 	})
+
+	t.Run("ScheduledRuns", func(t *testing.T) {
+		rawSpec := "name: scheduled-batch-change\ndescription: Runs every day\n"
+
+		spec, err := btypes.NewBatchSpecFromRaw(rawSpec)
+		require.NoError(t, err)
+		spec.UserID = user.ID
+		spec.NamespaceUserID = user.ID
+		require.NoError(t, s.CreateBatchSpec(ctx, spec))
+
+		batchChange := testBatchChange(user.ID, spec)
+		batchChange.Name = "scheduled-batch-change"
+		require.NoError(t, s.CreateBatchChange(ctx, batchChange))
+
+		t.Run("UpdateBatchChangeSchedule", func(t *testing.T) {
+			_, err := svc.UpdateBatchChangeSchedule(user2Ctx, UpdateBatchChangeScheduleOpts{
+				BatchChangeID: batchChange.ID,
+				Schedule:      pointers.Ptr("@daily"),
+			})
+			assertAuthError(t, err)
+
+			_, err = svc.UpdateBatchChangeSchedule(userCtx, UpdateBatchChangeScheduleOpts{
+				BatchChangeID: batchChange.ID,
+				Schedule:      pointers.Ptr("* * * * *"),
+			})
+			assert.Error(t, err)
+
+			have, err := svc.UpdateBatchChangeSchedule(userCtx, UpdateBatchChangeScheduleOpts{
+				BatchChangeID: batchChange.ID,
+				Schedule:      pointers.Ptr("@daily"),
+				Paused:        pointers.Ptr(true),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, "@daily", have.Schedule)
+			assert.True(t, have.SchedulePaused)
+			assert.True(t, have.NextScheduledRunAt.After(now))
+
+			have, err = svc.UpdateBatchChangeSchedule(userCtx, UpdateBatchChangeScheduleOpts{
+				BatchChangeID: batchChange.ID,
+				Paused:        pointers.Ptr(false),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, "@daily", have.Schedule)
+			assert.False(t, have.SchedulePaused)
+
+			batchChange = have
+		})
+
+		var run *btypes.BatchChangeScheduledRun
+
+		t.Run("StartScheduledRun", func(t *testing.T) {
+			previousRunAt := batchChange.NextScheduledRunAt
+
+			run, err = svc.StartScheduledRun(ctx, batchChange)
+			require.NoError(t, err)
+			assert.Equal(t, btypes.BatchChangeScheduledRunStateResolving, run.State)
+			assert.NotZero(t, run.BatchSpecID)
+			assert.True(t, batchChange.NextScheduledRunAt.After(previousRunAt) || batchChange.NextScheduledRunAt.Equal(previousRunAt))
+
+			runSpec, err := s.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: run.BatchSpecID})
+			require.NoError(t, err)
+			assert.Equal(t, rawSpec, runSpec.RawSpec)
+			assert.Equal(t, user.ID, runSpec.UserID)
+			assert.Equal(t, batchChange.ID, runSpec.BatchChangeID)
+		})
+
+		t.Run("ProcessScheduledRun", func(t *testing.T) {
+			// The resolution hasn't finished yet.
+			require.NoError(t, svc.ProcessScheduledRun(ctx, run, ProcessScheduledRunOpts{}))
+			assert.Equal(t, btypes.BatchChangeScheduledRunStateResolving, run.State)
+
+			job, err := s.GetBatchSpecResolutionJob(ctx, store.GetBatchSpecResolutionJobOpts{BatchSpecID: run.BatchSpecID})
+			require.NoError(t, err)
+			require.NoError(t, s.Exec(ctx, sqlf.Sprintf("UPDATE batch_spec_resolution_jobs SET state = %s WHERE id = %s", btypes.BatchSpecResolutionJobStateCompleted, job.ID)))
+
+			require.NoError(t, svc.ProcessScheduledRun(ctx, run, ProcessScheduledRunOpts{}))
+			assert.Equal(t, btypes.BatchChangeScheduledRunStateExecuting, run.State)
+
+			// No workspaces were resolved, so there is nothing to execute.
+			require.NoError(t, svc.ProcessScheduledRun(ctx, run, ProcessScheduledRunOpts{}))
+			assert.Equal(t, btypes.BatchChangeScheduledRunStateCompleted, run.State)
+			assert.Empty(t, run.FailureMessage)
+
+			have, err := s.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: batchChange.ID})
+			require.NoError(t, err)
+			assert.Equal(t, run.BatchSpecID, have.BatchSpecID)
+			assert.Equal(t, "@daily", have.Schedule)
+		})
+
+		t.Run("closed batch change", func(t *testing.T) {
+			run, err := svc.StartScheduledRun(ctx, batchChange)
+			require.NoError(t, err)
+
+			_, err = svc.CloseBatchChange(userCtx, batchChange.ID, false)
+			require.NoError(t, err)
+
+			require.NoError(t, svc.ProcessScheduledRun(ctx, run, ProcessScheduledRunOpts{}))
+			assert.Equal(t, btypes.BatchChangeScheduledRunStateFailed, run.State)
+			assert.Equal(t, "the batch change was closed", run.FailureMessage)
+		})
+	})
+
+	t.Run("AutoMergePolicy", func(t *testing.T) {
+		spec := testBatchSpec(user.ID)
+		require.NoError(t, s.CreateBatchSpec(ctx, spec))
+
+		batchChange := testBatchChange(user.ID, spec)
+		batchChange.Name = "auto-merge-batch-change"
+		require.NoError(t, s.CreateBatchChange(ctx, batchChange))
+
+		_, err := svc.SetBatchChangeAutoMergePolicy(user2Ctx, SetBatchChangeAutoMergePolicyOpts{
+			BatchChangeID:     batchChange.ID,
+			RequiredApprovals: 1,
+		})
+		assertAuthError(t, err)
+
+		_, err = svc.SetBatchChangeAutoMergePolicy(userCtx, SetBatchChangeAutoMergePolicyOpts{
+			BatchChangeID:     batchChange.ID,
+			RequiredApprovals: -1,
+		})
+		assert.Error(t, err)
+
+		_, err = svc.SetBatchChangeAutoMergePolicy(userCtx, SetBatchChangeAutoMergePolicyOpts{
+			BatchChangeID:          batchChange.ID,
+			MaintenanceWindowDays:  []string{"saturday"},
+			MaintenanceWindowStart: "25:00",
+			MaintenanceWindowEnd:   "26:00",
+		})
+		assert.Error(t, err)
+
+		have, err := svc.SetBatchChangeAutoMergePolicy(userCtx, SetBatchChangeAutoMergePolicyOpts{
+			BatchChangeID:        batchChange.ID,
+			RequiredApprovals:    2,
+			RequirePassingChecks: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), have.RequiredApprovals)
+		assert.Equal(t, btypes.AutoMergeMethodMerge, have.MergeMethod)
+
+		assertAuthError(t, svc.DeleteBatchChangeAutoMergePolicy(user2Ctx, batchChange.ID))
+		require.NoError(t, svc.DeleteBatchChangeAutoMergePolicy(userCtx, batchChange.ID))
+
+		_, err = s.GetBatchChangeAutoMergePolicy(ctx, batchChange.ID)
+		assert.Equal(t, store.ErrNoResults, err)
+	})
 }
 
 func createJob(t *testing.T, s *store.Store, job *btypes.BatchSpecWorkspaceExecutionJob) {
@@ -3412,3 +3580,48 @@ func assertNoAuthError(t *testing.T, err error) {
 		t.Fatalf("got auth error")
 	}
 }
+
+func TestFindDependencyCycle(t *testing.T) {
+	tests := []struct {
+		name string
+		deps map[string][]string
+		want []string
+	}{
+		{
+			name: "no dependencies",
+			deps: map[string][]string{},
+		},
+		{
+			name: "chain",
+			deps: map[string][]string{
+				"consumer": {"lib"},
+				"lib":      {"base"},
+			},
+		},
+		{
+			name: "diamond",
+			deps: map[string][]string{
+				"app":   {"left", "right"},
+				"left":  {"base"},
+				"right": {"base"},
+			},
+		},
+		{
+			name: "cycle",
+			deps: map[string][]string{
+				"consumer": {"lib"},
+				"lib":      {"other"},
+				"other":    {"lib"},
+			},
+			want: []string{"lib", "other", "lib"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, findDependencyCycle(tt.deps)); diff != "" {
+				t.Fatalf("wrong cycle (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
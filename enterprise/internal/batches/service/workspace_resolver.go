@@ -344,6 +344,9 @@ func (wr *workspaceResolver) resolveRepositoriesMatchingQuery(ctx context.Contex
 			case *streamhttp.EventSymbolMatch:
 				repoIDs = append(repoIDs, api.RepoID(m.RepositoryID))
 				addRepoFilePatch(api.RepoID(m.RepositoryID), m.Path)
+			case *streamhttp.EventDiagnosticMatch:
+				repoIDs = append(repoIDs, api.RepoID(m.RepositoryID))
+				addRepoFilePatch(api.RepoID(m.RepositoryID), m.Path)
 			}
 		}
 	}); err != nil {
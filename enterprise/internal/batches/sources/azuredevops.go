@@ -262,6 +262,26 @@ func (s AzureDevOpsSource) CreateComment(ctx context.Context, cs *Changeset, com
 	return err
 }
 
+// RequestReviews adds the given users, identified by their Azure DevOps
+// identity ID, as reviewers of the pull request.
+func (s AzureDevOpsSource) RequestReviews(ctx context.Context, cs *Changeset, reviewers []CodeHostUser) error {
+	repo := cs.TargetRepo.Metadata.(*azuredevops.Repository)
+	args, err := s.createCommonPullRequestArgs(*repo, *cs)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range reviewers {
+		if r.AccountID == "" {
+			continue
+		}
+		if _, err := s.client.AddPullRequestReviewer(ctx, args, r.AccountID); err != nil {
+			return errors.Wrapf(err, "adding reviewer %s", r.AccountID)
+		}
+	}
+	return nil
+}
+
 // MergeChangeset merges a Changeset on the code host, if in a mergeable state.
 // If squash is true, and the code host supports squash merges, the source
 // must attempt a squash merge. Otherwise, it is expected to perform a regular
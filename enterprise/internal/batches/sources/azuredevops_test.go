@@ -571,6 +571,49 @@ func TestAzureDevOpsSource_CreateComment(t *testing.T) {
 	})
 }
 
+func TestAzureDevOpsSource_RequestReviews(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("error adding reviewer", func(t *testing.T) {
+		cs, _ := mockAzureDevOpsChangeset()
+		s, client := mockAzureDevOpsSource()
+
+		pr := mockAzureDevOpsPullRequest(&testRepository)
+		want := errors.New("error")
+		client.AddPullRequestReviewerFunc.SetDefaultReturn(azuredevops.Reviewer{}, want)
+
+		annotateChangesetWithPullRequest(cs, pr)
+		err := s.RequestReviews(ctx, cs, []CodeHostUser{{AccountID: "reviewer-1"}})
+		assert.NotNil(t, err)
+		assert.ErrorIs(t, err, want)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		cs, _ := mockAzureDevOpsChangeset()
+		s, client := mockAzureDevOpsSource()
+
+		pr := mockAzureDevOpsPullRequest(&testRepository)
+		client.AddPullRequestReviewerFunc.SetDefaultHook(func(ctx context.Context, r azuredevops.PullRequestCommonArgs, reviewerID string) (azuredevops.Reviewer, error) {
+			assert.Equal(t, testCommonPullRequestArgs, r)
+			return azuredevops.Reviewer{ID: reviewerID}, nil
+		})
+
+		annotateChangesetWithPullRequest(cs, pr)
+		err := s.RequestReviews(ctx, cs, []CodeHostUser{
+			{AccountID: "reviewer-1"},
+			// Users without an ID can't be added.
+			{Username: "reviewer-2"},
+			{AccountID: "reviewer-3"},
+		})
+		assert.Nil(t, err)
+
+		history := client.AddPullRequestReviewerFunc.History()
+		assert.Len(t, history, 2)
+		assert.Equal(t, "reviewer-1", history[0].Arg2)
+		assert.Equal(t, "reviewer-3", history[1].Arg2)
+	})
+}
+
 func TestAzureDevOpsSource_MergeChangeset(t *testing.T) {
 	ctx := context.Background()
 
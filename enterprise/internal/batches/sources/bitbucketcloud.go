@@ -221,6 +221,47 @@ func (s BitbucketCloudSource) MergeChangeset(ctx context.Context, cs *Changeset,
 	return s.setChangesetMetadata(ctx, repo, updated, cs)
 }
 
+// RequestReviews adds the given users, identified by their account UUID, as
+// reviewers of the pull request. The author of the pull request is skipped,
+// since Bitbucket Cloud doesn't allow authors to review their own pull
+// requests.
+func (s BitbucketCloudSource) RequestReviews(ctx context.Context, cs *Changeset, reviewers []CodeHostUser) error {
+	targetRepo := cs.TargetRepo.Metadata.(*bitbucketcloud.Repo)
+	pr := cs.Metadata.(*bbcs.AnnotatedPullRequest)
+
+	existing := map[string]struct{}{pr.Author.UUID: {}}
+	for _, r := range pr.Reviewers {
+		existing[r.UUID] = struct{}{}
+	}
+
+	// The update endpoint replaces all reviewers, so we have to send the
+	// existing ones along with the new ones.
+	all := append([]bitbucketcloud.Account{}, pr.Reviewers...)
+	for _, r := range reviewers {
+		if _, ok := existing[r.AccountID]; ok || r.AccountID == "" {
+			continue
+		}
+		existing[r.AccountID] = struct{}{}
+		all = append(all, bitbucketcloud.Account{UUID: r.AccountID})
+	}
+	if len(all) == len(pr.Reviewers) {
+		return nil
+	}
+
+	opts := s.changesetToPullRequestInput(cs)
+	opts.Reviewers = all
+	if conf.Get().BatchChangesAutoDeleteBranch {
+		opts.CloseSourceBranch = true
+	}
+
+	updated, err := s.client.UpdatePullRequest(ctx, targetRepo, pr.ID, opts)
+	if err != nil {
+		return errors.Wrap(err, "updating pull request")
+	}
+
+	return s.setChangesetMetadata(ctx, targetRepo, updated, cs)
+}
+
 // GetFork returns a repo pointing to a fork of the target repo, ensuring that the fork
 // exists and creating it if it doesn't. If namespace is not provided, the fork will be in
 // the currently authenticated user's namespace. If name is not provided, the fork will be
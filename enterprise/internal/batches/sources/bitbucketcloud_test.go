@@ -442,6 +442,49 @@ func TestBitbucketCloudSource_UpdateChangeset(t *testing.T) {
 	})
 }
 
+func TestBitbucketCloudSource_RequestReviews(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no new reviewers", func(t *testing.T) {
+		cs, _, bbRepo := mockBitbucketCloudChangeset()
+		s, client := mockBitbucketCloudSource()
+
+		pr := mockBitbucketCloudPullRequest(bbRepo)
+		pr.Author = bitbucketcloud.Account{UUID: "{author}"}
+		pr.Reviewers = []bitbucketcloud.Account{{UUID: "{reviewer}"}}
+
+		annotateBitbucketCloudChangesetWithPullRequest(cs, pr)
+		err := s.RequestReviews(ctx, cs, []CodeHostUser{
+			{AccountID: "{author}"},
+			{AccountID: "{reviewer}"},
+			{Username: "no-uuid"},
+		})
+		assert.Nil(t, err)
+		assert.Empty(t, client.UpdatePullRequestFunc.History())
+	})
+
+	t.Run("success", func(t *testing.T) {
+		cs, _, bbRepo := mockBitbucketCloudChangeset()
+		s, client := mockBitbucketCloudSource()
+		mockAnnotatePullRequestSuccess(client)
+
+		pr := mockBitbucketCloudPullRequest(bbRepo)
+		pr.Reviewers = []bitbucketcloud.Account{{UUID: "{reviewer}"}}
+		client.UpdatePullRequestFunc.SetDefaultHook(func(ctx context.Context, r *bitbucketcloud.Repo, i int64, pri bitbucketcloud.PullRequestInput) (*bitbucketcloud.PullRequest, error) {
+			assert.Same(t, bbRepo, r)
+			assert.EqualValues(t, 420, i)
+			assert.Equal(t, []bitbucketcloud.Account{{UUID: "{reviewer}"}, {UUID: "{owner}"}}, pri.Reviewers)
+
+			return pr, nil
+		})
+
+		annotateBitbucketCloudChangesetWithPullRequest(cs, pr)
+		err := s.RequestReviews(ctx, cs, []CodeHostUser{{AccountID: "{owner}"}, {AccountID: "{reviewer}"}})
+		assert.Nil(t, err)
+		assertBitbucketCloudChangesetMatchesPullRequest(t, cs, pr)
+	})
+}
+
 func TestBitbucketCloudSource_CreateComment(t *testing.T) {
 	ctx := context.Background()
 
@@ -308,6 +308,60 @@ func (s BitbucketServerSource) MergeChangeset(ctx context.Context, c *Changeset,
 	return c.Changeset.SetMetadata(merged)
 }
 
+// RequestReviews adds the given users, identified by their username, as
+// reviewers of the pull request. The author of the pull request is skipped,
+// since Bitbucket Server doesn't allow authors to review their own pull
+// requests.
+func (s BitbucketServerSource) RequestReviews(ctx context.Context, c *Changeset, reviewers []CodeHostUser) error {
+	var updated *bitbucketserver.PullRequest
+	_, err := s.callAndRetryIfOutdated(ctx, c, func(ctx context.Context, pr *bitbucketserver.PullRequest) error {
+		existing := make(map[string]struct{}, len(pr.Reviewers)+1)
+		if pr.Author.User != nil {
+			existing[pr.Author.User.Name] = struct{}{}
+		}
+		for _, r := range pr.Reviewers {
+			if r.User != nil {
+				existing[r.User.Name] = struct{}{}
+			}
+		}
+
+		// The update endpoint replaces all reviewers, so we have to send the
+		// existing ones along with the new ones.
+		all := append([]bitbucketserver.Reviewer{}, pr.Reviewers...)
+		for _, r := range reviewers {
+			if _, ok := existing[r.Username]; ok || r.Username == "" {
+				continue
+			}
+			existing[r.Username] = struct{}{}
+			all = append(all, bitbucketserver.Reviewer{User: &bitbucketserver.User{Name: r.Username}})
+		}
+		if len(all) == len(pr.Reviewers) {
+			updated = pr
+			return nil
+		}
+
+		update := &bitbucketserver.UpdatePullRequestInput{
+			PullRequestID: strconv.Itoa(pr.ID),
+			Title:         pr.Title,
+			Description:   pr.Description,
+			Version:       pr.Version,
+			Reviewers:     all,
+		}
+		update.ToRef.ID = pr.ToRef.ID
+		update.ToRef.Repository.Slug = pr.ToRef.Repository.Slug
+		update.ToRef.Repository.Project.Key = pr.ToRef.Repository.Project.Key
+
+		var err error
+		updated, err = s.client.UpdatePullRequest(ctx, update)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.Changeset.SetMetadata(updated)
+}
+
 type bitbucketClientFunc func(context.Context, *bitbucketserver.PullRequest) error
 
 func (s BitbucketServerSource) callAndRetryIfOutdated(ctx context.Context, c *Changeset, fn bitbucketClientFunc) (*bitbucketserver.PullRequest, error) {
@@ -49,6 +49,45 @@ type DraftChangesetSource interface {
 	UndraftChangeset(context.Context, *Changeset) error
 }
 
+// A ReviewableChangesetSource can request reviews of changesets.
+type ReviewableChangesetSource interface {
+	ChangesetSource
+
+	// RequestReviews requests a review of the Changeset from the given users.
+	// Users that cannot be identified on the code host are skipped, and may be
+	// reported in the returned error.
+	RequestReviews(ctx context.Context, cs *Changeset, reviewers []CodeHostUser) error
+}
+
+// An AssignableChangesetSource can assign users to changesets.
+type AssignableChangesetSource interface {
+	ChangesetSource
+
+	// AssignChangeset assigns the given users to the Changeset. Users that
+	// cannot be identified on the code host are skipped, and may be reported in
+	// the returned error.
+	AssignChangeset(ctx context.Context, cs *Changeset, assignees []CodeHostUser) error
+}
+
+// A TopicChangesetSource can group the changesets of a batch change under a
+// shared topic on the code host.
+type TopicChangesetSource interface {
+	ChangesetSource
+
+	// SetTopic files the Changeset under the given topic and tags it with the
+	// given hashtags.
+	SetTopic(ctx context.Context, cs *Changeset, topic string, hashtags []string) error
+}
+
+// A CodeHostUser identifies a user on the code host of a changeset. Code hosts
+// differ in which of the fields they need to refer to a user.
+type CodeHostUser struct {
+	// AccountID is the ID of the user on the code host, if known.
+	AccountID string
+	// Username is the handle of the user on the code host, if known.
+	Username string
+}
+
 type ForkableChangesetSource interface {
 	ChangesetSource
 
@@ -129,6 +168,11 @@ type Changeset struct {
 	// opened.
 	TargetRepo *types.Repo
 
+	// Spec is the changeset spec the changeset is published or updated from.
+	// It is only set when publishing or updating a changeset, for sources that
+	// need more than the attributes above, such as the patch itself.
+	Spec *btypes.ChangesetSpec
+
 	*btypes.Changeset
 }
 
@@ -0,0 +1,362 @@
+package sources
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/grafana/regexp"
+	"github.com/sourcegraph/go-diff/diff"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/emailpatch"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/api/internalapi"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/timeutil"
+	"github.com/sourcegraph/sourcegraph/internal/txemail/txtypes"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// emailSource is the source the emails sent by EmailPatchSource are counted
+// under.
+const emailSource = "batch_changes_patches"
+
+const defaultSubjectPrefix = "PATCH"
+
+// EmailPatchSource sends changesets as patch series over email, for projects
+// that take contributions through a mailing list instead of pull requests.
+//
+// Mailing lists don't have an API that could be queried for the state of a
+// patch series, so the state is tracked in Sourcegraph instead: closing,
+// merging and reopening a changeset only records the new state.
+type EmailPatchSource struct {
+	// config is the site configuration entry matching the repository of the
+	// changeset. It is only needed to send the first version of a series;
+	// everything else is recorded in the changeset metadata.
+	config *schema.BatchChangeEmailPatches
+
+	send  func(ctx context.Context, source string, message txtypes.Message) error
+	clock func() time.Time
+}
+
+var _ ChangesetSource = &EmailPatchSource{}
+
+// NewEmailPatchSource returns an EmailPatchSource that sends patches according
+// to the given configuration, which may be nil for changesets that have already
+// been sent.
+func NewEmailPatchSource(config *schema.BatchChangeEmailPatches) *EmailPatchSource {
+	return &EmailPatchSource{
+		config: config,
+		send:   internalapi.Client.SendEmail,
+		clock:  timeutil.Now,
+	}
+}
+
+// emailPatchesConfig returns the entry of the batchChanges.emailPatches site
+// configuration that matches the given repository, or nil if the changesets of
+// the repository are opened on its code host.
+func emailPatchesConfig(repo *types.Repo) *schema.BatchChangeEmailPatches {
+	for _, c := range conf.Get().BatchChangesEmailPatches {
+		re, err := regexp.Compile(c.Repository)
+		if err != nil {
+			// The site configuration validation rejects invalid patterns.
+			continue
+		}
+		if re.MatchString(string(repo.Name)) {
+			return c
+		}
+	}
+	return nil
+}
+
+// GitserverPushConfig returns no push configuration: the commit of the
+// changeset is only created on gitserver, and sent from there as a patch.
+func (s EmailPatchSource) GitserverPushConfig(_ *types.Repo) (*protocol.PushConfig, error) {
+	return nil, nil
+}
+
+// WithAuthenticator returns the source unchanged, since patches are sent using
+// the SMTP server of the Sourcegraph instance and not a code host credential.
+func (s EmailPatchSource) WithAuthenticator(_ auth.Authenticator) (ChangesetSource, error) {
+	return s, nil
+}
+
+// ValidateAuthenticator always succeeds, since no authenticator is used.
+func (s EmailPatchSource) ValidateAuthenticator(_ context.Context) error {
+	return nil
+}
+
+// LoadChangeset loads the given Changeset from its metadata, since there is no
+// remote state to load. If the Changeset has not been sent as a patch series,
+// a ChangesetNotFoundError is returned.
+func (s EmailPatchSource) LoadChangeset(_ context.Context, cs *Changeset) error {
+	series, ok := cs.Metadata.(*emailpatch.Series)
+	if !ok {
+		return ChangesetNotFoundError{Changeset: cs}
+	}
+	return errors.Wrap(cs.SetMetadata(series), "setting changeset metadata")
+}
+
+// CreateChangeset sends the patch of the Changeset to the configured
+// recipients as the first version of a new patch series.
+func (s EmailPatchSource) CreateChangeset(ctx context.Context, cs *Changeset) (bool, error) {
+	if _, ok := cs.Metadata.(*emailpatch.Series); ok {
+		return true, nil
+	}
+	if s.config == nil {
+		return false, errors.New("repository is not configured in batchChanges.emailPatches")
+	}
+	if cs.Spec == nil {
+		return false, errors.New("changeset has no spec to send the patch of")
+	}
+
+	prefix := s.config.SubjectPrefix
+	if prefix == "" {
+		prefix = defaultSubjectPrefix
+	}
+
+	now := s.clock()
+	series := &emailpatch.Series{
+		MessageID:     messageID(cs.Changeset, cs.Spec, 1),
+		Version:       1,
+		SubjectPrefix: prefix,
+		To:            s.config.To,
+		Cc:            s.config.Cc,
+		State:         emailpatch.StateOpen,
+		CreatedAt:     now,
+	}
+	if err := s.sendPatch(ctx, cs, series, series.MessageID); err != nil {
+		return false, err
+	}
+
+	return false, errors.Wrap(cs.SetMetadata(series), "setting changeset metadata")
+}
+
+// UpdateChangeset sends the patch of the Changeset again as the next version
+// of the patch series, in reply to its first version. If the diff hasn't
+// changed, no new version is sent and only the metadata is updated.
+func (s EmailPatchSource) UpdateChangeset(ctx context.Context, cs *Changeset) error {
+	series, err := loadSeries(cs)
+	if err != nil {
+		return err
+	}
+	if cs.Spec == nil {
+		return errors.New("changeset has no spec to send the patch of")
+	}
+
+	updated := *series
+	if series.DiffHash == diffHash(cs.Spec) {
+		updated.Title = cs.Title
+		updated.Body = cs.Body
+		updated.BaseRef = cs.BaseRef
+		updated.HeadRef = cs.HeadRef
+		updated.UpdatedAt = s.clock()
+		return errors.Wrap(cs.SetMetadata(&updated), "setting changeset metadata")
+	}
+
+	updated.Version++
+	if err := s.sendPatch(ctx, cs, &updated, messageID(cs.Changeset, cs.Spec, updated.Version)); err != nil {
+		return err
+	}
+	// The series is still identified by the subject of its first version.
+	updated.Subject = series.Subject
+
+	return errors.Wrap(cs.SetMetadata(&updated), "setting changeset metadata")
+}
+
+// CloseChangeset records that the patch series has been rejected or withdrawn.
+func (s EmailPatchSource) CloseChangeset(_ context.Context, cs *Changeset) error {
+	return s.setState(cs, emailpatch.StateClosed)
+}
+
+// ReopenChangeset records that the patch series is under review again.
+func (s EmailPatchSource) ReopenChangeset(_ context.Context, cs *Changeset) error {
+	return s.setState(cs, emailpatch.StateOpen)
+}
+
+// MergeChangeset records that the patch series has been applied upstream.
+// Whether the patches are squashed is up to the maintainers applying them.
+func (s EmailPatchSource) MergeChangeset(_ context.Context, cs *Changeset, _ bool) error {
+	return s.setState(cs, emailpatch.StateMerged)
+}
+
+// CreateComment sends the comment in reply to the patch series.
+func (s EmailPatchSource) CreateComment(ctx context.Context, cs *Changeset, comment string) error {
+	series, err := loadSeries(cs)
+	if err != nil {
+		return err
+	}
+
+	id := fmt.Sprintf("batch-changes.%d.comment.%d@%s", cs.ID, s.clock().UnixNano(), messageIDDomain())
+	return s.sendEmail(ctx, series, id, "Re: "+series.Subject, comment)
+}
+
+// BuildCommitOpts builds the CreateCommitFromPatchRequest needed to create the
+// commit on gitserver. The commit is not pushed to the code host.
+func (s EmailPatchSource) BuildCommitOpts(repo *types.Repo, _ *btypes.Changeset, spec *btypes.ChangesetSpec, pushOpts *protocol.PushConfig) protocol.CreateCommitFromPatchRequest {
+	return BuildCommitOptsCommon(repo, spec, pushOpts)
+}
+
+func (s EmailPatchSource) setState(cs *Changeset, state emailpatch.State) error {
+	series, err := loadSeries(cs)
+	if err != nil {
+		return err
+	}
+
+	updated := *series
+	updated.State = state
+	updated.UpdatedAt = s.clock()
+	return errors.Wrap(cs.SetMetadata(&updated), "setting changeset metadata")
+}
+
+// sendPatch updates the given series with the attributes of the Changeset and
+// sends its patch as the current version of the series.
+func (s EmailPatchSource) sendPatch(ctx context.Context, cs *Changeset, series *emailpatch.Series, id string) error {
+	subject, body, err := formatPatch(series.SubjectPrefix, series.Version, cs.Spec, cs.Body)
+	if err != nil {
+		return errors.Wrap(err, "formatting patch")
+	}
+
+	series.Subject = subject
+	series.Title = cs.Title
+	series.Body = cs.Body
+	series.BaseRef = cs.BaseRef
+	series.HeadRef = cs.HeadRef
+	series.AuthorName = cs.Spec.CommitAuthorName
+	series.AuthorEmail = cs.Spec.CommitAuthorEmail
+	series.DiffHash = diffHash(cs.Spec)
+	series.UpdatedAt = s.clock()
+
+	return s.sendEmail(ctx, series, id, subject, body)
+}
+
+// sendEmail sends an email with the given Message-ID to the recipients of the
+// series. All emails but the first one of a series are sent in reply to it, so
+// that they are threaded together.
+func (s EmailPatchSource) sendEmail(ctx context.Context, series *emailpatch.Series, id, subject, body string) error {
+	// The Message-ID header is sent verbatim, whereas the In-Reply-To and
+	// References headers are wrapped in angle brackets when the email is sent.
+	header := "<" + id + ">"
+	msg := txtypes.Message{
+		To:        series.To,
+		Cc:        series.Cc,
+		MessageID: &header,
+		Template: txtypes.Templates{
+			Subject: "{{.Subject}}",
+			// Mailing lists expect patches as plain text, so no HTML body is
+			// rendered.
+			Text: "{{.Body}}",
+		},
+		Data: struct{ Subject, Body string }{subject, body},
+	}
+	if series.AuthorEmail != "" {
+		msg.ReplyTo = &series.AuthorEmail
+	}
+	if id != series.MessageID {
+		msg.InReplyTo = &series.MessageID
+		msg.References = []string{series.MessageID}
+	}
+
+	return errors.Wrap(s.send(ctx, emailSource, msg), "sending email")
+}
+
+func loadSeries(cs *Changeset) (*emailpatch.Series, error) {
+	series, ok := cs.Metadata.(*emailpatch.Series)
+	if !ok {
+		return nil, ChangesetNotFoundError{Changeset: cs}
+	}
+	return series, nil
+}
+
+// diffHash returns the hash of the diff of the changeset spec, which is used to
+// tell whether a new version of the series needs to be sent.
+func diffHash(spec *btypes.ChangesetSpec) string {
+	sum := sha256.Sum256(spec.Diff)
+	return hex.EncodeToString(sum[:])
+}
+
+// messageID returns the Message-ID of the given version of the patch series
+// of a changeset. It is deterministic, so that retrying to send an email
+// doesn't start a new thread on the mailing list.
+func messageID(cs *btypes.Changeset, spec *btypes.ChangesetSpec, version int) string {
+	return fmt.Sprintf("batch-changes.%d.%d.v%d@%s", cs.ID, spec.ID, version, messageIDDomain())
+}
+
+func messageIDDomain() string {
+	if addr, err := mail.ParseAddress(conf.Get().EmailAddress); err == nil {
+		if _, domain, ok := strings.Cut(addr.Address, "@"); ok {
+			return domain
+		}
+	}
+	return "sourcegraph"
+}
+
+// formatPatch formats the patch of the changeset spec for the given version of
+// a series like `git format-patch` does, so that it can be applied with
+// `git am`. The description of the changeset is added below the `---` line,
+// where it is ignored when applying the patch. It returns the subject and the
+// body of the email.
+func formatPatch(prefix string, version int, spec *btypes.ChangesetSpec, description string) (string, string, error) {
+	fileDiffs, err := diff.ParseMultiFileDiff(spec.Diff)
+	if err != nil {
+		return "", "", errors.Wrap(err, "parsing diff")
+	}
+	// Changeset spec diffs don't have the a/ and b/ prefixes that `git am`
+	// expects by default.
+	for _, fd := range fileDiffs {
+		origName, newName := fd.OrigName, fd.NewName
+		if origName == "/dev/null" {
+			origName = newName
+		}
+		if newName == "/dev/null" {
+			newName = origName
+		}
+		for i, line := range fd.Extended {
+			if strings.HasPrefix(line, "diff --git ") {
+				fd.Extended[i] = fmt.Sprintf("diff --git a/%s b/%s", origName, newName)
+			}
+		}
+		if fd.OrigName != "/dev/null" {
+			fd.OrigName = "a/" + fd.OrigName
+		}
+		if fd.NewName != "/dev/null" {
+			fd.NewName = "b/" + fd.NewName
+		}
+	}
+	patch, err := diff.PrintMultiFileDiff(fileDiffs)
+	if err != nil {
+		return "", "", errors.Wrap(err, "printing diff")
+	}
+
+	if version > 1 {
+		prefix = fmt.Sprintf("%s v%d", prefix, version)
+	}
+	summary, message, _ := strings.Cut(strings.TrimSpace(spec.CommitMessage), "\n")
+	subject := fmt.Sprintf("[%s] %s", prefix, strings.TrimSpace(summary))
+
+	var b strings.Builder
+	// The emails are sent from the address of the Sourcegraph instance, so the
+	// author is given in the body for `git am` to pick up.
+	if spec.CommitAuthorEmail != "" {
+		author := mail.Address{Name: spec.CommitAuthorName, Address: spec.CommitAuthorEmail}
+		fmt.Fprintf(&b, "From: %s\n\n", author.String())
+	}
+	if message = strings.TrimSpace(message); message != "" {
+		fmt.Fprintf(&b, "%s\n\n", message)
+	}
+	b.WriteString("---\n")
+	if description = strings.TrimSpace(description); description != "" {
+		fmt.Fprintf(&b, "%s\n\n", description)
+	}
+	b.Write(patch)
+
+	return subject, b.String(), nil
+}
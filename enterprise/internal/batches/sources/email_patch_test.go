@@ -0,0 +1,273 @@
+package sources
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/emailpatch"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/txemail/txtypes"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+const testEmailPatchDiff = `diff --git README.md README.md
+index 851b23a..140f333 100644
+--- README.md
++++ README.md
+@@ -1 +1 @@
+-# Hello
++# Hello World
+`
+
+func TestEmailPatchSource_CreateChangeset(t *testing.T) {
+	ctx := context.Background()
+	mockEmailPatchesConf(t)
+
+	t.Run("no config", func(t *testing.T) {
+		cs := mockEmailPatchChangeset()
+		s, _ := mockEmailPatchSource(nil)
+
+		_, err := s.CreateChangeset(ctx, cs)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("send error", func(t *testing.T) {
+		cs := mockEmailPatchChangeset()
+		s, _ := mockEmailPatchSource(testEmailPatchesConfig)
+		want := errors.New("error")
+		s.send = func(context.Context, string, txtypes.Message) error { return want }
+
+		_, err := s.CreateChangeset(ctx, cs)
+		assert.ErrorIs(t, err, want)
+		assert.Nil(t, cs.Metadata)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		cs := mockEmailPatchChangeset()
+		s, sent := mockEmailPatchSource(testEmailPatchesConfig)
+
+		exists, err := s.CreateChangeset(ctx, cs)
+		assert.Nil(t, err)
+		assert.False(t, exists)
+
+		assert.Len(t, *sent, 1)
+		msg := (*sent)[0]
+		assert.Equal(t, []string{"dev@lists.example.com"}, msg.To)
+		assert.Equal(t, []string{"maintainer@example.com"}, msg.Cc)
+		assert.Equal(t, "<batch-changes.1.2.v1@sourcegraph.example.com>", *msg.MessageID)
+		assert.Nil(t, msg.InReplyTo)
+		assert.Equal(t, "alice@example.com", *msg.ReplyTo)
+		assert.Empty(t, msg.Template.HTML)
+
+		series, ok := cs.Metadata.(*emailpatch.Series)
+		assert.True(t, ok)
+		assert.Equal(t, "batch-changes.1.2.v1@sourcegraph.example.com", series.MessageID)
+		assert.Equal(t, cs.ExternalID, series.MessageID)
+		assert.Equal(t, emailpatch.ExternalServiceType, cs.ExternalServiceType)
+		assert.Equal(t, "[RFC PATCH] Update README", series.Subject)
+		assert.Equal(t, 1, series.Version)
+		assert.Equal(t, emailpatch.StateOpen, series.State)
+		assert.Equal(t, "refs/heads/update-readme", series.HeadRef)
+	})
+
+	t.Run("already sent", func(t *testing.T) {
+		cs := mockEmailPatchChangeset()
+		s, sent := mockEmailPatchSource(testEmailPatchesConfig)
+		assert.Nil(t, cs.SetMetadata(&emailpatch.Series{MessageID: "id@example.com"}))
+
+		exists, err := s.CreateChangeset(ctx, cs)
+		assert.Nil(t, err)
+		assert.True(t, exists)
+		assert.Len(t, *sent, 0)
+	})
+}
+
+func TestEmailPatchSource_UpdateChangeset(t *testing.T) {
+	ctx := context.Background()
+	mockEmailPatchesConf(t)
+
+	t.Run("not sent", func(t *testing.T) {
+		cs := mockEmailPatchChangeset()
+		s, _ := mockEmailPatchSource(testEmailPatchesConfig)
+
+		err := s.UpdateChangeset(ctx, cs)
+		target := ChangesetNotFoundError{}
+		assert.ErrorAs(t, err, &target)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		cs := mockEmailPatchChangeset()
+		s, sent := mockEmailPatchSource(testEmailPatchesConfig)
+		_, err := s.CreateChangeset(ctx, cs)
+		assert.Nil(t, err)
+
+		// Later versions are sent without the site configuration.
+		s.config = nil
+		cs.Spec.Diff = []byte(strings.Replace(testEmailPatchDiff, "Hello World", "Hello, World", 1))
+		err = s.UpdateChangeset(ctx, cs)
+		assert.Nil(t, err)
+
+		assert.Len(t, *sent, 2)
+		msg := (*sent)[1]
+		assert.Equal(t, "<batch-changes.1.2.v2@sourcegraph.example.com>", *msg.MessageID)
+		assert.Equal(t, "batch-changes.1.2.v1@sourcegraph.example.com", *msg.InReplyTo)
+		assert.Equal(t, []string{"batch-changes.1.2.v1@sourcegraph.example.com"}, msg.References)
+		assert.Equal(t, []string{"dev@lists.example.com"}, msg.To)
+
+		series := cs.Metadata.(*emailpatch.Series)
+		assert.Equal(t, 2, series.Version)
+		assert.Equal(t, "batch-changes.1.2.v1@sourcegraph.example.com", series.MessageID)
+		assert.Equal(t, "[RFC PATCH] Update README", series.Subject)
+	})
+
+	t.Run("diff unchanged", func(t *testing.T) {
+		cs := mockEmailPatchChangeset()
+		s, sent := mockEmailPatchSource(testEmailPatchesConfig)
+		_, err := s.CreateChangeset(ctx, cs)
+		assert.Nil(t, err)
+
+		cs.Title = "Update the README"
+		err = s.UpdateChangeset(ctx, cs)
+		assert.Nil(t, err)
+
+		assert.Len(t, *sent, 1)
+		series := cs.Metadata.(*emailpatch.Series)
+		assert.Equal(t, 1, series.Version)
+		assert.Equal(t, "Update the README", series.Title)
+		assert.Equal(t, "[RFC PATCH] Update README", series.Subject)
+	})
+}
+
+func TestEmailPatchSource_States(t *testing.T) {
+	ctx := context.Background()
+	mockEmailPatchesConf(t)
+
+	cs := mockEmailPatchChangeset()
+	s, sent := mockEmailPatchSource(testEmailPatchesConfig)
+	_, err := s.CreateChangeset(ctx, cs)
+	assert.Nil(t, err)
+
+	assert.Nil(t, s.MergeChangeset(ctx, cs, true))
+	assert.Equal(t, emailpatch.StateMerged, cs.Metadata.(*emailpatch.Series).State)
+
+	assert.Nil(t, s.ReopenChangeset(ctx, cs))
+	assert.Equal(t, emailpatch.StateOpen, cs.Metadata.(*emailpatch.Series).State)
+
+	assert.Nil(t, s.CloseChangeset(ctx, cs))
+	assert.Equal(t, emailpatch.StateClosed, cs.Metadata.(*emailpatch.Series).State)
+
+	// Changing the state only records it, nothing is sent.
+	assert.Len(t, *sent, 1)
+}
+
+func TestEmailPatchSource_CreateComment(t *testing.T) {
+	ctx := context.Background()
+	mockEmailPatchesConf(t)
+
+	cs := mockEmailPatchChangeset()
+	s, sent := mockEmailPatchSource(testEmailPatchesConfig)
+	_, err := s.CreateChangeset(ctx, cs)
+	assert.Nil(t, err)
+
+	assert.Nil(t, s.CreateComment(ctx, cs, "Ping?"))
+	assert.Len(t, *sent, 2)
+	msg := (*sent)[1]
+	assert.Equal(t, "batch-changes.1.2.v1@sourcegraph.example.com", *msg.InReplyTo)
+	assert.Equal(t, struct{ Subject, Body string }{"Re: [RFC PATCH] Update README", "Ping?"}, msg.Data)
+}
+
+func TestEmailPatchesConfig(t *testing.T) {
+	mockEmailPatchesConf(t)
+
+	assert.Equal(t, testEmailPatchesConfig, emailPatchesConfig(&types.Repo{Name: "git.example.com/linux/kernel"}))
+	assert.Nil(t, emailPatchesConfig(&types.Repo{Name: "github.com/sourcegraph/sourcegraph"}))
+}
+
+func TestFormatPatch(t *testing.T) {
+	spec := &btypes.ChangesetSpec{
+		Diff:              []byte(testEmailPatchDiff),
+		CommitMessage:     "Update README\n\nThe README now greets the whole world.",
+		CommitAuthorName:  "Alice",
+		CommitAuthorEmail: "alice@example.com",
+	}
+
+	subject, body, err := formatPatch("PATCH", 1, spec, "Created by a batch change.")
+	assert.Nil(t, err)
+	assert.Equal(t, "[PATCH] Update README", subject)
+	assert.Equal(t, `From: "Alice" <alice@example.com>
+
+The README now greets the whole world.
+
+---
+Created by a batch change.
+
+diff --git a/README.md b/README.md
+index 851b23a..140f333 100644
+--- a/README.md
++++ b/README.md
+@@ -1,1 +1,1 @@
+-# Hello
++# Hello World
+`, body)
+
+	subject, _, err = formatPatch("PATCH", 3, spec, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "[PATCH v3] Update README", subject)
+}
+
+var testEmailPatchesConfig = &schema.BatchChangeEmailPatches{
+	Repository:    "^git\\.example\\.com/linux/",
+	To:            []string{"dev@lists.example.com"},
+	Cc:            []string{"maintainer@example.com"},
+	SubjectPrefix: "RFC PATCH",
+}
+
+func mockEmailPatchesConf(t *testing.T) {
+	t.Helper()
+
+	conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+		EmailAddress:             "noreply@sourcegraph.example.com",
+		BatchChangesEmailPatches: []*schema.BatchChangeEmailPatches{testEmailPatchesConfig},
+	}})
+	t.Cleanup(func() { conf.Mock(nil) })
+}
+
+func mockEmailPatchChangeset() *Changeset {
+	repo := &types.Repo{Name: "git.example.com/linux/kernel"}
+	return &Changeset{
+		Title:      "Update README",
+		Body:       "Created by a batch change.",
+		BaseRef:    "refs/heads/main",
+		HeadRef:    "refs/heads/update-readme",
+		RemoteRepo: repo,
+		TargetRepo: repo,
+		Spec: &btypes.ChangesetSpec{
+			ID:                2,
+			Diff:              []byte(testEmailPatchDiff),
+			CommitMessage:     "Update README",
+			CommitAuthorName:  "Alice",
+			CommitAuthorEmail: "alice@example.com",
+		},
+		Changeset: &btypes.Changeset{ID: 1},
+	}
+}
+
+func mockEmailPatchSource(config *schema.BatchChangeEmailPatches) (*EmailPatchSource, *[]txtypes.Message) {
+	var sent []txtypes.Message
+	s := &EmailPatchSource{
+		config: config,
+		send: func(_ context.Context, _ string, msg txtypes.Message) error {
+			sent = append(sent, msg)
+			return nil
+		},
+		clock: func() time.Time { return time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC) },
+	}
+	return s, &sent
+}
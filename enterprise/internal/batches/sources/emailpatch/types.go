@@ -0,0 +1,56 @@
+package emailpatch
+
+import "time"
+
+// ExternalServiceType is the external service type of changesets that have
+// been sent as patches over email. Their state is not synced from the code
+// host of their repository, but tracked in Sourcegraph.
+const ExternalServiceType = "emailpatch"
+
+// State is the state of a patch series. Mailing lists don't have a concept of
+// state, so it only changes when a user closes, merges or reopens the
+// changeset.
+type State string
+
+const (
+	StateOpen   State = "OPEN"
+	StateMerged State = "MERGED"
+	StateClosed State = "CLOSED"
+)
+
+// Series is the metadata of a changeset that has been sent as a patch series.
+// This type is used as the primary metadata type for changesets sent over
+// email.
+type Series struct {
+	// MessageID is the Message-ID of the first email of the series, which
+	// identifies the series. Later revisions and comments are sent as replies
+	// to it.
+	MessageID string `json:"messageID"`
+	// Version is the revision of the series, starting at 1. It is increased
+	// every time the changeset is updated and the patches are sent again.
+	Version int `json:"version"`
+	// Subject is the subject of the first email of the series.
+	Subject string `json:"subject"`
+	// SubjectPrefix is the prefix in brackets of the subjects of the series,
+	// without the version.
+	SubjectPrefix string `json:"subjectPrefix"`
+
+	Title      string `json:"title"`
+	Body       string `json:"body"`
+	BaseRef    string `json:"baseRef"`
+	HeadRef    string `json:"headRef"`
+	AuthorName string `json:"authorName"`
+	// AuthorEmail is the email address of the commit author, which is also
+	// the address patches are sent on behalf of.
+	AuthorEmail string `json:"authorEmail"`
+	// DiffHash is the SHA-256 hash of the diff of the latest version sent.
+	// A new version is only sent when the diff changes.
+	DiffHash string `json:"diffHash,omitempty"`
+
+	To []string `json:"to"`
+	Cc []string `json:"cc,omitempty"`
+
+	State     State     `json:"state"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
@@ -264,6 +264,25 @@ func (s GerritSource) MergeChangeset(ctx context.Context, cs *Changeset, _ bool)
 	return errors.Wrap(s.setChangesetMetadata(ctx, updated, cs), "setting Gerrit changeset metadata")
 }
 
+// SetTopic files the Changeset under the given topic and adds the given
+// hashtags to it. Hashtags the change already has are kept.
+func (s GerritSource) SetTopic(ctx context.Context, cs *Changeset, topic string, hashtags []string) error {
+	if err := s.client.SetTopic(ctx, cs.ExternalID, gerrit.SetTopicPayload{Topic: topic}); err != nil {
+		if errcode.IsNotFound(err) {
+			return ChangesetNotFoundError{Changeset: cs}
+		}
+		return errors.Wrap(err, "setting change topic")
+	}
+
+	if len(hashtags) > 0 {
+		if err := s.client.SetHashtags(ctx, cs.ExternalID, gerrit.SetHashtagsPayload{Add: hashtags}); err != nil {
+			return errors.Wrap(err, "setting change hashtags")
+		}
+	}
+
+	return s.LoadChangeset(ctx, cs)
+}
+
 func (s GerritSource) BuildCommitOpts(repo *types.Repo, changeset *btypes.Changeset, spec *btypes.ChangesetSpec, pushOpts *protocol.PushConfig) protocol.CreateCommitFromPatchRequest {
 	opts := BuildCommitOptsCommon(repo, spec, pushOpts)
 	pushRef := strings.Replace(gitdomain.EnsureRefPrefix(spec.BaseRef), "refs/heads", "refs/for", 1) //Magical Gerrit ref for pushing changes.
@@ -295,11 +314,58 @@ func (s GerritSource) annotateChange(ctx context.Context, change *gerrit.Change)
 	if err != nil {
 		return nil, err
 	}
-	return &gerritbatches.AnnotatedChange{
+	ac := &gerritbatches.AnnotatedChange{
 		Change:      change,
 		Reviewers:   *reviewers,
 		CodeHostURL: *s.client.GetURL(),
-	}, nil
+	}
+	if change.Topic != "" {
+		if ac.Topic, err = s.loadTopic(ctx, change.Topic); err != nil {
+			return nil, errors.Wrap(err, "loading topic")
+		}
+	}
+	return ac, nil
+}
+
+// loadTopic loads the state of all changes of the given topic, so that
+// activity on any changeset of a batch change is reflected on all of them.
+func (s GerritSource) loadTopic(ctx context.Context, name string) (*gerritbatches.Topic, error) {
+	changes, err := s.client.GetTopicChanges(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	topic := &gerritbatches.Topic{Name: name}
+	for _, c := range changes {
+		topic.Changes = append(topic.Changes, gerritbatches.TopicChange{
+			ChangeID:       c.ChangeID,
+			Project:        c.Project,
+			Branch:         c.Branch,
+			Status:         c.Status,
+			WorkInProgress: c.WorkInProgress,
+		})
+		if c.Updated.After(topic.UpdatedAt) {
+			topic.UpdatedAt = c.Updated
+		}
+	}
+	topic.Merged = topicMerged(changes)
+	return topic, nil
+}
+
+// topicMerged returns whether all given changes of a topic that haven't been
+// abandoned are merged.
+func topicMerged(changes []gerrit.Change) bool {
+	merged := false
+	for _, c := range changes {
+		switch c.Status {
+		case gerrit.ChangeStatusMerged:
+			merged = true
+		case gerrit.ChangeStatusAbandoned:
+		default:
+			return false
+		}
+	}
+	return merged
 }
 
 // GenerateGerritChangeID deterministically generates a Gerrit Change ID from a Changeset object.
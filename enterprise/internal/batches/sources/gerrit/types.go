@@ -2,6 +2,7 @@ package gerrit
 
 import (
 	"net/url"
+	"time"
 
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
 )
@@ -14,4 +15,28 @@ type AnnotatedChange struct {
 	Change      *gerrit.Change    `json:"change"`
 	Reviewers   []gerrit.Reviewer `json:"reviewers"`
 	CodeHostURL url.URL           `json:"codeHostURL"`
+	// Topic is the state of the topic the change belongs to, if any.
+	Topic *Topic `json:"topic,omitempty"`
+}
+
+// Topic describes the changes that share a Gerrit topic. All changesets of a
+// batch change on a Gerrit code host share a topic.
+type Topic struct {
+	Name    string        `json:"name"`
+	Changes []TopicChange `json:"changes"`
+	// UpdatedAt is the most recent time any change of the topic was updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Merged is whether all changes of the topic that haven't been abandoned
+	// are merged. Gerrit submits the changes of a topic together, so until
+	// then the topic is still being rolled out.
+	Merged bool `json:"merged"`
+}
+
+// TopicChange is a change of a Gerrit topic.
+type TopicChange struct {
+	ChangeID       string              `json:"changeID"`
+	Project        string              `json:"project"`
+	Branch         string              `json:"branch"`
+	Status         gerrit.ChangeStatus `json:"status"`
+	WorkInProgress bool                `json:"workInProgress"`
 }
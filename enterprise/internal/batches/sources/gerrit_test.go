@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"testing"
+	"time"
 
 	gerritbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gerrit"
 	"github.com/stretchr/testify/assert"
@@ -798,6 +799,81 @@ func TestGerritSource_MergeChangeset(t *testing.T) {
 	})
 }
 
+func TestGerritSource_SetTopic(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("change not found", func(t *testing.T) {
+		cs, id, _ := mockGerritChangeset()
+		cs.ExternalID = id
+		s, client := mockGerritSource()
+		client.SetTopicFunc.SetDefaultReturn(&notFoundError{})
+
+		err := s.SetTopic(ctx, cs, "alice/my-batch-change", []string{"batch-changes"})
+		assert.NotNil(t, err)
+		target := ChangesetNotFoundError{}
+		assert.ErrorAs(t, err, &target)
+		assert.Same(t, target.Changeset, cs)
+	})
+
+	t.Run("error setting hashtags", func(t *testing.T) {
+		cs, id, _ := mockGerritChangeset()
+		cs.ExternalID = id
+		s, client := mockGerritSource()
+		want := errors.New("error")
+		client.SetTopicFunc.SetDefaultReturn(nil)
+		client.SetHashtagsFunc.SetDefaultReturn(want)
+
+		err := s.SetTopic(ctx, cs, "alice/my-batch-change", []string{"batch-changes"})
+		assert.NotNil(t, err)
+		assert.ErrorIs(t, err, want)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		cs, id, _ := mockGerritChangeset()
+		cs.ExternalID = id
+		s, client := mockGerritSource()
+
+		change := mockGerritChange(&testProject, id)
+		change.Topic = "alice/my-batch-change"
+		change.Updated = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		other := mockGerritChange(&testProject, "I0000000000000000000000000000000000000000")
+		other.Topic = change.Topic
+		other.Status = gerrit.ChangeStatusMerged
+		other.Updated = change.Updated.Add(time.Hour)
+
+		client.SetTopicFunc.SetDefaultHook(func(ctx context.Context, changeID string, input gerrit.SetTopicPayload) error {
+			assert.Equal(t, id, changeID)
+			assert.Equal(t, "alice/my-batch-change", input.Topic)
+			return nil
+		})
+		client.SetHashtagsFunc.SetDefaultHook(func(ctx context.Context, changeID string, input gerrit.SetHashtagsPayload) error {
+			assert.Equal(t, id, changeID)
+			assert.Equal(t, []string{"batch-changes", "my-batch-change"}, input.Add)
+			return nil
+		})
+		client.GetURLFunc.SetDefaultReturn(&url.URL{})
+		client.GetChangeFunc.SetDefaultReturn(change, nil)
+		client.GetChangeReviewsFunc.SetDefaultReturn(&[]gerrit.Reviewer{}, nil)
+		client.GetTopicChangesFunc.SetDefaultHook(func(ctx context.Context, topic string) ([]gerrit.Change, error) {
+			assert.Equal(t, change.Topic, topic)
+			return []gerrit.Change{*change, *other}, nil
+		})
+
+		err := s.SetTopic(ctx, cs, "alice/my-batch-change", []string{"batch-changes", "my-batch-change"})
+		assert.Nil(t, err)
+
+		meta, ok := cs.Metadata.(*gerritbatches.AnnotatedChange)
+		assert.True(t, ok)
+		assert.NotNil(t, meta.Topic)
+		assert.Equal(t, change.Topic, meta.Topic.Name)
+		assert.Len(t, meta.Topic.Changes, 2)
+		assert.Equal(t, gerrit.ChangeStatusMerged, meta.Topic.Changes[1].Status)
+		assert.False(t, meta.Topic.Merged)
+		assert.Equal(t, other.Updated, meta.Topic.UpdatedAt)
+		assert.Equal(t, other.Updated, cs.ExternalUpdatedAt)
+	})
+}
+
 func assertGerritChangesetMatchesPullRequest(t *testing.T, cs *Changeset, pr *gerrit.Change) {
 	t.Helper()
 
@@ -839,3 +915,24 @@ func mockGerritSource() (*GerritSource, *MockGerritClient) {
 
 	return s, client
 }
+
+func TestTopicMerged(t *testing.T) {
+	for name, tc := range map[string]struct {
+		statuses []gerrit.ChangeStatus
+		want     bool
+	}{
+		"no changes":           {want: false},
+		"all merged":           {statuses: []gerrit.ChangeStatus{gerrit.ChangeStatusMerged, gerrit.ChangeStatusMerged}, want: true},
+		"merged and abandoned": {statuses: []gerrit.ChangeStatus{gerrit.ChangeStatusMerged, gerrit.ChangeStatusAbandoned}, want: true},
+		"merged and open":      {statuses: []gerrit.ChangeStatus{gerrit.ChangeStatusMerged, gerrit.ChangeStatusNew}, want: false},
+		"all abandoned":        {statuses: []gerrit.ChangeStatus{gerrit.ChangeStatusAbandoned}, want: false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			changes := make([]gerrit.Change, 0, len(tc.statuses))
+			for _, status := range tc.statuses {
+				changes = append(changes, gerrit.Change{Status: status})
+			}
+			assert.Equal(t, tc.want, topicMerged(changes))
+		})
+	}
+}
@@ -371,6 +371,64 @@ func (s GitHubSource) MergeChangeset(ctx context.Context, c *Changeset, squash b
 	return c.Changeset.SetMetadata(pr)
 }
 
+// RequestReviews requests a review of the pull request from the given users.
+// Usernames of the form "org/team" are requested as team reviewers. The author
+// of the pull request is skipped, since GitHub doesn't allow authors to review
+// their own pull requests.
+func (s GitHubSource) RequestReviews(ctx context.Context, c *Changeset, reviewers []CodeHostUser) error {
+	pr, ok := c.Changeset.Metadata.(*github.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not a GitHub pull request")
+	}
+
+	var users, teams []string
+	for _, r := range reviewers {
+		// GitHub logins are case-insensitive.
+		if r.Username == "" || strings.EqualFold(r.Username, pr.Author.Login) {
+			continue
+		}
+		if _, team, ok := strings.Cut(r.Username, "/"); ok {
+			teams = append(teams, team)
+		} else {
+			users = append(users, r.Username)
+		}
+	}
+	if len(users) == 0 && len(teams) == 0 {
+		return nil
+	}
+
+	owner, repoName, err := github.SplitRepositoryNameWithOwner(c.TargetRepo.Metadata.(*github.Repository).NameWithOwner)
+	if err != nil {
+		return errors.Wrap(err, "getting owner and repo name to request reviews")
+	}
+	return s.client.RequestReviewers(ctx, owner, repoName, pr.Number, users, teams)
+}
+
+// AssignChangeset assigns the given users to the pull request.
+func (s GitHubSource) AssignChangeset(ctx context.Context, c *Changeset, assignees []CodeHostUser) error {
+	pr, ok := c.Changeset.Metadata.(*github.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not a GitHub pull request")
+	}
+
+	var users []string
+	for _, a := range assignees {
+		// Teams cannot be assigned.
+		if a.Username != "" && !strings.Contains(a.Username, "/") {
+			users = append(users, a.Username)
+		}
+	}
+	if len(users) == 0 {
+		return nil
+	}
+
+	owner, repoName, err := github.SplitRepositoryNameWithOwner(c.TargetRepo.Metadata.(*github.Repository).NameWithOwner)
+	if err != nil {
+		return errors.Wrap(err, "getting owner and repo name to assign users")
+	}
+	return s.client.AddAssignees(ctx, owner, repoName, pr.Number, users)
+}
+
 func (GitHubSource) IsPushResponseArchived(s string) bool {
 	return strings.Contains(s, "This repository was archived so it is read-only.")
 }
@@ -563,6 +563,90 @@ func (s *GitLabSource) MergeChangeset(ctx context.Context, c *Changeset, squash
 	return c.Changeset.SetMetadata(updated)
 }
 
+// RequestReviews sets the given users as the reviewers of the merge request.
+// Users without a known GitLab user ID are looked up by their username. The
+// users that can't be found are skipped and reported in the returned error.
+func (s *GitLabSource) RequestReviews(ctx context.Context, c *Changeset, reviewers []CodeHostUser) error {
+	ids, unresolved, err := s.gitLabUserIDs(ctx, reviewers)
+	if err != nil {
+		return err
+	}
+	if len(ids) > 0 {
+		if err := s.updateMergeRequestParticipants(ctx, c, gitlab.UpdateMergeRequestOpts{ReviewerIDs: ids}); err != nil {
+			return err
+		}
+	}
+	return unresolvedGitLabUsersError(unresolved)
+}
+
+// AssignChangeset sets the given users as the assignees of the merge request.
+// Users without a known GitLab user ID are looked up by their username. The
+// users that can't be found are skipped and reported in the returned error.
+func (s *GitLabSource) AssignChangeset(ctx context.Context, c *Changeset, assignees []CodeHostUser) error {
+	ids, unresolved, err := s.gitLabUserIDs(ctx, assignees)
+	if err != nil {
+		return err
+	}
+	if len(ids) > 0 {
+		if err := s.updateMergeRequestParticipants(ctx, c, gitlab.UpdateMergeRequestOpts{AssigneeIDs: ids}); err != nil {
+			return err
+		}
+	}
+	return unresolvedGitLabUsersError(unresolved)
+}
+
+func (s *GitLabSource) updateMergeRequestParticipants(ctx context.Context, c *Changeset, opts gitlab.UpdateMergeRequestOpts) error {
+	mr, ok := c.Changeset.Metadata.(*gitlab.MergeRequest)
+	if !ok {
+		return errors.New("Changeset is not a GitLab merge request")
+	}
+	project := c.TargetRepo.Metadata.(*gitlab.Project)
+
+	if _, err := s.client.UpdateMergeRequest(ctx, project, mr, opts); err != nil {
+		return errors.Wrap(err, "updating GitLab merge request")
+	}
+	return nil
+}
+
+// gitLabUserIDs returns the GitLab user IDs of the given users. Users without
+// a known user ID are looked up by their username, and the usernames that
+// don't belong to a GitLab user are returned as unresolved.
+func (s *GitLabSource) gitLabUserIDs(ctx context.Context, users []CodeHostUser) (ids []gitlab.ID, unresolved []string, err error) {
+	for _, u := range users {
+		if u.AccountID == "" {
+			username := strings.TrimPrefix(u.Username, "@")
+			if username == "" {
+				continue
+			}
+
+			found, _, err := s.client.ListUsers(ctx, "users?username="+url.QueryEscape(username))
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "looking up GitLab user %q", username)
+			}
+			if len(found) == 0 {
+				unresolved = append(unresolved, username)
+				continue
+			}
+			ids = append(ids, gitlab.ID(found[0].ID))
+			continue
+		}
+
+		id, err := strconv.ParseInt(u.AccountID, 10, 64)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "parsing GitLab user ID %q", u.AccountID)
+		}
+		ids = append(ids, gitlab.ID(id))
+	}
+	return ids, unresolved, nil
+}
+
+func unresolvedGitLabUsersError(usernames []string) error {
+	if len(usernames) == 0 {
+		return nil
+	}
+	return errors.Newf("no GitLab users found for usernames: %s", strings.Join(usernames, ", "))
+}
+
 func (*GitLabSource) IsPushResponseArchived(s string) bool {
 	return strings.Contains(s, "ERROR: You are not allowed to push code to this project")
 }
@@ -882,6 +882,56 @@ func TestGitLabSource_ChangesetSource(t *testing.T) {
 		}
 	})
 
+	t.Run("RequestReviews", func(t *testing.T) {
+		t.Run("unknown username", func(t *testing.T) {
+			p := newGitLabChangesetSourceTestProvider(t)
+			p.changeset.Changeset.Metadata = &gitlab.MergeRequest{}
+			gitlab.MockListUsers = func(_ *gitlab.Client, _ context.Context, urlStr string) ([]*gitlab.User, *string, error) {
+				if urlStr != "users?username=alice" {
+					t.Errorf("unexpected users URL: %q", urlStr)
+				}
+				return nil, nil, nil
+			}
+			gitlab.MockUpdateMergeRequest = func(*gitlab.Client, context.Context, *gitlab.Project, *gitlab.MergeRequest, gitlab.UpdateMergeRequestOpts) (*gitlab.MergeRequest, error) {
+				t.Error("unexpected call to UpdateMergeRequest")
+				return nil, nil
+			}
+
+			err := p.source.RequestReviews(p.ctx, p.changeset, []CodeHostUser{{Username: "alice"}})
+			if err == nil || !strings.Contains(err.Error(), "alice") {
+				t.Errorf("expected unresolved username to be reported, got %v", err)
+			}
+		})
+
+		t.Run("success", func(t *testing.T) {
+			mr := &gitlab.MergeRequest{IID: 2}
+
+			p := newGitLabChangesetSourceTestProvider(t)
+			p.changeset.Changeset.Metadata = mr
+			gitlab.MockListUsers = func(_ *gitlab.Client, _ context.Context, urlStr string) ([]*gitlab.User, *string, error) {
+				if urlStr == "users?username=alice" {
+					return []*gitlab.User{{ID: 2, Username: "alice"}}, nil, nil
+				}
+				return nil, nil, nil
+			}
+			gitlab.MockUpdateMergeRequest = func(client *gitlab.Client, ctx context.Context, project *gitlab.Project, mrIn *gitlab.MergeRequest, opts gitlab.UpdateMergeRequestOpts) (*gitlab.MergeRequest, error) {
+				p.testCommonParams(ctx, client, project)
+				if diff := cmp.Diff([]gitlab.ID{1, 2, 3}, opts.ReviewerIDs); diff != "" {
+					t.Errorf("unexpected reviewer IDs (-want +have):\n%s", diff)
+				}
+				if opts.Title != "" || opts.Description != "" {
+					t.Errorf("unexpected update of the merge request: %+v", opts)
+				}
+				return mr, nil
+			}
+
+			err := p.source.RequestReviews(p.ctx, p.changeset, []CodeHostUser{{AccountID: "1"}, {Username: "@alice"}, {AccountID: "3"}, {Username: "bob"}})
+			if err == nil || !strings.Contains(err.Error(), "bob") {
+				t.Errorf("expected unresolved username to be reported, got %v", err)
+			}
+		})
+	})
+
 	t.Run("CreateComment", func(t *testing.T) {
 		commentBody := "test-comment"
 		t.Run("invalid metadata", func(t *testing.T) {
@@ -1267,6 +1317,7 @@ func (p *gitLabChangesetSourceTestProvider) unmock() {
 	gitlab.MockGetOpenMergeRequestByRefs = nil
 	gitlab.MockUpdateMergeRequest = nil
 	gitlab.MockCreateMergeRequestNote = nil
+	gitlab.MockListUsers = nil
 
 	versions.MockGetVersions = nil
 }
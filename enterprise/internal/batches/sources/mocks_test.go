@@ -6075,6 +6075,9 @@ type MockAzureDevOpsClient struct {
 	// AbandonPullRequestFunc is an instance of a mock function object
 	// controlling the behavior of the method AbandonPullRequest.
 	AbandonPullRequestFunc *AzureDevOpsClientAbandonPullRequestFunc
+	// AddPullRequestReviewerFunc is an instance of a mock function object
+	// controlling the behavior of the method AddPullRequestReviewer.
+	AddPullRequestReviewerFunc *AzureDevOpsClientAddPullRequestReviewerFunc
 	// AuthenticatorFunc is an instance of a mock function object
 	// controlling the behavior of the method Authenticator.
 	AuthenticatorFunc *AzureDevOpsClientAuthenticatorFunc
@@ -6143,6 +6146,11 @@ func NewMockAzureDevOpsClient() *MockAzureDevOpsClient {
 				return
 			},
 		},
+		AddPullRequestReviewerFunc: &AzureDevOpsClientAddPullRequestReviewerFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, string) (r0 azuredevops.Reviewer, r1 error) {
+				return
+			},
+		},
 		AuthenticatorFunc: &AzureDevOpsClientAuthenticatorFunc{
 			defaultHook: func() (r0 auth.Authenticator) {
 				return
@@ -6245,6 +6253,11 @@ func NewStrictMockAzureDevOpsClient() *MockAzureDevOpsClient {
 				panic("unexpected invocation of MockAzureDevOpsClient.AbandonPullRequest")
 			},
 		},
+		AddPullRequestReviewerFunc: &AzureDevOpsClientAddPullRequestReviewerFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, string) (azuredevops.Reviewer, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.AddPullRequestReviewer")
+			},
+		},
 		AuthenticatorFunc: &AzureDevOpsClientAuthenticatorFunc{
 			defaultHook: func() auth.Authenticator {
 				panic("unexpected invocation of MockAzureDevOpsClient.Authenticator")
@@ -6346,6 +6359,9 @@ func NewMockAzureDevOpsClientFrom(i azuredevops.Client) *MockAzureDevOpsClient {
 		AbandonPullRequestFunc: &AzureDevOpsClientAbandonPullRequestFunc{
 			defaultHook: i.AbandonPullRequest,
 		},
+		AddPullRequestReviewerFunc: &AzureDevOpsClientAddPullRequestReviewerFunc{
+			defaultHook: i.AddPullRequestReviewer,
+		},
 		AuthenticatorFunc: &AzureDevOpsClientAuthenticatorFunc{
 			defaultHook: i.Authenticator,
 		},
@@ -6514,6 +6530,121 @@ func (c AzureDevOpsClientAbandonPullRequestFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// AzureDevOpsClientAddPullRequestReviewerFunc describes the behavior when
+// the AddPullRequestReviewer method of the parent MockAzureDevOpsClient
+// instance is invoked.
+type AzureDevOpsClientAddPullRequestReviewerFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs, string) (azuredevops.Reviewer, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs, string) (azuredevops.Reviewer, error)
+	history     []AzureDevOpsClientAddPullRequestReviewerFuncCall
+	mutex       sync.Mutex
+}
+
+// AddPullRequestReviewer delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) AddPullRequestReviewer(v0 context.Context, v1 azuredevops.PullRequestCommonArgs, v2 string) (azuredevops.Reviewer, error) {
+	r0, r1 := m.AddPullRequestReviewerFunc.nextHook()(v0, v1, v2)
+	m.AddPullRequestReviewerFunc.appendCall(AzureDevOpsClientAddPullRequestReviewerFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// AddPullRequestReviewer method of the parent MockAzureDevOpsClient
+// instance is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientAddPullRequestReviewerFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, string) (azuredevops.Reviewer, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// AddPullRequestReviewer method of the parent MockAzureDevOpsClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *AzureDevOpsClientAddPullRequestReviewerFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, string) (azuredevops.Reviewer, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientAddPullRequestReviewerFunc) SetDefaultReturn(r0 azuredevops.Reviewer, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs, string) (azuredevops.Reviewer, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientAddPullRequestReviewerFunc) PushReturn(r0 azuredevops.Reviewer, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs, string) (azuredevops.Reviewer, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientAddPullRequestReviewerFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs, string) (azuredevops.Reviewer, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientAddPullRequestReviewerFunc) appendCall(r0 AzureDevOpsClientAddPullRequestReviewerFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientAddPullRequestReviewerFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientAddPullRequestReviewerFunc) History() []AzureDevOpsClientAddPullRequestReviewerFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientAddPullRequestReviewerFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientAddPullRequestReviewerFuncCall is an object that
+// describes an invocation of method AddPullRequestReviewer on an instance
+// of MockAzureDevOpsClient.
+type AzureDevOpsClientAddPullRequestReviewerFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.Reviewer
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientAddPullRequestReviewerFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientAddPullRequestReviewerFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // AzureDevOpsClientAuthenticatorFunc describes the behavior when the
 // Authenticator method of the parent MockAzureDevOpsClient instance is
 // invoked.
@@ -8517,6 +8648,9 @@ type MockGerritClient struct {
 	// GetGroupFunc is an instance of a mock function object controlling the
 	// behavior of the method GetGroup.
 	GetGroupFunc *GerritClientGetGroupFunc
+	// GetTopicChangesFunc is an instance of a mock function object
+	// controlling the behavior of the method GetTopicChanges.
+	GetTopicChangesFunc *GerritClientGetTopicChangesFunc
 	// GetURLFunc is an instance of a mock function object controlling the
 	// behavior of the method GetURL.
 	GetURLFunc *GerritClientGetURLFunc
@@ -8532,9 +8666,15 @@ type MockGerritClient struct {
 	// SetCommitMessageFunc is an instance of a mock function object
 	// controlling the behavior of the method SetCommitMessage.
 	SetCommitMessageFunc *GerritClientSetCommitMessageFunc
+	// SetHashtagsFunc is an instance of a mock function object controlling
+	// the behavior of the method SetHashtags.
+	SetHashtagsFunc *GerritClientSetHashtagsFunc
 	// SetReadyForReviewFunc is an instance of a mock function object
 	// controlling the behavior of the method SetReadyForReview.
 	SetReadyForReviewFunc *GerritClientSetReadyForReviewFunc
+	// SetTopicFunc is an instance of a mock function object controlling the
+	// behavior of the method SetTopic.
+	SetTopicFunc *GerritClientSetTopicFunc
 	// SetWIPFunc is an instance of a mock function object controlling the
 	// behavior of the method SetWIP.
 	SetWIPFunc *GerritClientSetWIPFunc
@@ -8588,6 +8728,11 @@ func NewMockGerritClient() *MockGerritClient {
 				return
 			},
 		},
+		GetTopicChangesFunc: &GerritClientGetTopicChangesFunc{
+			defaultHook: func(context.Context, string) (r0 []gerrit.Change, r1 error) {
+				return
+			},
+		},
 		GetURLFunc: &GerritClientGetURLFunc{
 			defaultHook: func() (r0 *url.URL) {
 				return
@@ -8613,11 +8758,21 @@ func NewMockGerritClient() *MockGerritClient {
 				return
 			},
 		},
+		SetHashtagsFunc: &GerritClientSetHashtagsFunc{
+			defaultHook: func(context.Context, string, gerrit.SetHashtagsPayload) (r0 error) {
+				return
+			},
+		},
 		SetReadyForReviewFunc: &GerritClientSetReadyForReviewFunc{
 			defaultHook: func(context.Context, string) (r0 error) {
 				return
 			},
 		},
+		SetTopicFunc: &GerritClientSetTopicFunc{
+			defaultHook: func(context.Context, string, gerrit.SetTopicPayload) (r0 error) {
+				return
+			},
+		},
 		SetWIPFunc: &GerritClientSetWIPFunc{
 			defaultHook: func(context.Context, string) (r0 error) {
 				return
@@ -8680,6 +8835,11 @@ func NewStrictMockGerritClient() *MockGerritClient {
 				panic("unexpected invocation of MockGerritClient.GetGroup")
 			},
 		},
+		GetTopicChangesFunc: &GerritClientGetTopicChangesFunc{
+			defaultHook: func(context.Context, string) ([]gerrit.Change, error) {
+				panic("unexpected invocation of MockGerritClient.GetTopicChanges")
+			},
+		},
 		GetURLFunc: &GerritClientGetURLFunc{
 			defaultHook: func() *url.URL {
 				panic("unexpected invocation of MockGerritClient.GetURL")
@@ -8705,11 +8865,21 @@ func NewStrictMockGerritClient() *MockGerritClient {
 				panic("unexpected invocation of MockGerritClient.SetCommitMessage")
 			},
 		},
+		SetHashtagsFunc: &GerritClientSetHashtagsFunc{
+			defaultHook: func(context.Context, string, gerrit.SetHashtagsPayload) error {
+				panic("unexpected invocation of MockGerritClient.SetHashtags")
+			},
+		},
 		SetReadyForReviewFunc: &GerritClientSetReadyForReviewFunc{
 			defaultHook: func(context.Context, string) error {
 				panic("unexpected invocation of MockGerritClient.SetReadyForReview")
 			},
 		},
+		SetTopicFunc: &GerritClientSetTopicFunc{
+			defaultHook: func(context.Context, string, gerrit.SetTopicPayload) error {
+				panic("unexpected invocation of MockGerritClient.SetTopic")
+			},
+		},
 		SetWIPFunc: &GerritClientSetWIPFunc{
 			defaultHook: func(context.Context, string) error {
 				panic("unexpected invocation of MockGerritClient.SetWIP")
@@ -8759,6 +8929,9 @@ func NewMockGerritClientFrom(i gerrit.Client) *MockGerritClient {
 		GetGroupFunc: &GerritClientGetGroupFunc{
 			defaultHook: i.GetGroup,
 		},
+		GetTopicChangesFunc: &GerritClientGetTopicChangesFunc{
+			defaultHook: i.GetTopicChanges,
+		},
 		GetURLFunc: &GerritClientGetURLFunc{
 			defaultHook: i.GetURL,
 		},
@@ -8774,9 +8947,15 @@ func NewMockGerritClientFrom(i gerrit.Client) *MockGerritClient {
 		SetCommitMessageFunc: &GerritClientSetCommitMessageFunc{
 			defaultHook: i.SetCommitMessage,
 		},
+		SetHashtagsFunc: &GerritClientSetHashtagsFunc{
+			defaultHook: i.SetHashtags,
+		},
 		SetReadyForReviewFunc: &GerritClientSetReadyForReviewFunc{
 			defaultHook: i.SetReadyForReview,
 		},
+		SetTopicFunc: &GerritClientSetTopicFunc{
+			defaultHook: i.SetTopic,
+		},
 		SetWIPFunc: &GerritClientSetWIPFunc{
 			defaultHook: i.SetWIP,
 		},
@@ -9538,6 +9717,115 @@ func (c GerritClientGetGroupFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// GerritClientGetTopicChangesFunc describes the behavior when the
+// GetTopicChanges method of the parent MockGerritClient instance is
+// invoked.
+type GerritClientGetTopicChangesFunc struct {
+	defaultHook func(context.Context, string) ([]gerrit.Change, error)
+	hooks       []func(context.Context, string) ([]gerrit.Change, error)
+	history     []GerritClientGetTopicChangesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetTopicChanges delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGerritClient) GetTopicChanges(v0 context.Context, v1 string) ([]gerrit.Change, error) {
+	r0, r1 := m.GetTopicChangesFunc.nextHook()(v0, v1)
+	m.GetTopicChangesFunc.appendCall(GerritClientGetTopicChangesFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetTopicChanges
+// method of the parent MockGerritClient instance is invoked and the hook
+// queue is empty.
+func (f *GerritClientGetTopicChangesFunc) SetDefaultHook(hook func(context.Context, string) ([]gerrit.Change, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetTopicChanges method of the parent MockGerritClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GerritClientGetTopicChangesFunc) PushHook(hook func(context.Context, string) ([]gerrit.Change, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GerritClientGetTopicChangesFunc) SetDefaultReturn(r0 []gerrit.Change, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) ([]gerrit.Change, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GerritClientGetTopicChangesFunc) PushReturn(r0 []gerrit.Change, r1 error) {
+	f.PushHook(func(context.Context, string) ([]gerrit.Change, error) {
+		return r0, r1
+	})
+}
+
+func (f *GerritClientGetTopicChangesFunc) nextHook() func(context.Context, string) ([]gerrit.Change, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GerritClientGetTopicChangesFunc) appendCall(r0 GerritClientGetTopicChangesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GerritClientGetTopicChangesFuncCall objects
+// describing the invocations of this function.
+func (f *GerritClientGetTopicChangesFunc) History() []GerritClientGetTopicChangesFuncCall {
+	f.mutex.Lock()
+	history := make([]GerritClientGetTopicChangesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GerritClientGetTopicChangesFuncCall is an object that describes an
+// invocation of method GetTopicChanges on an instance of MockGerritClient.
+type GerritClientGetTopicChangesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []gerrit.Change
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GerritClientGetTopicChangesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GerritClientGetTopicChangesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // GerritClientGetURLFunc describes the behavior when the GetURL method of
 // the parent MockGerritClient instance is invoked.
 type GerritClientGetURLFunc struct {
@@ -10075,6 +10363,114 @@ func (c GerritClientSetCommitMessageFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// GerritClientSetHashtagsFunc describes the behavior when the SetHashtags
+// method of the parent MockGerritClient instance is invoked.
+type GerritClientSetHashtagsFunc struct {
+	defaultHook func(context.Context, string, gerrit.SetHashtagsPayload) error
+	hooks       []func(context.Context, string, gerrit.SetHashtagsPayload) error
+	history     []GerritClientSetHashtagsFuncCall
+	mutex       sync.Mutex
+}
+
+// SetHashtags delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGerritClient) SetHashtags(v0 context.Context, v1 string, v2 gerrit.SetHashtagsPayload) error {
+	r0 := m.SetHashtagsFunc.nextHook()(v0, v1, v2)
+	m.SetHashtagsFunc.appendCall(GerritClientSetHashtagsFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the SetHashtags method
+// of the parent MockGerritClient instance is invoked and the hook queue is
+// empty.
+func (f *GerritClientSetHashtagsFunc) SetDefaultHook(hook func(context.Context, string, gerrit.SetHashtagsPayload) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SetHashtags method of the parent MockGerritClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GerritClientSetHashtagsFunc) PushHook(hook func(context.Context, string, gerrit.SetHashtagsPayload) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GerritClientSetHashtagsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, gerrit.SetHashtagsPayload) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GerritClientSetHashtagsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, gerrit.SetHashtagsPayload) error {
+		return r0
+	})
+}
+
+func (f *GerritClientSetHashtagsFunc) nextHook() func(context.Context, string, gerrit.SetHashtagsPayload) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GerritClientSetHashtagsFunc) appendCall(r0 GerritClientSetHashtagsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GerritClientSetHashtagsFuncCall objects
+// describing the invocations of this function.
+func (f *GerritClientSetHashtagsFunc) History() []GerritClientSetHashtagsFuncCall {
+	f.mutex.Lock()
+	history := make([]GerritClientSetHashtagsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GerritClientSetHashtagsFuncCall is an object that describes an invocation
+// of method SetHashtags on an instance of MockGerritClient.
+type GerritClientSetHashtagsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 gerrit.SetHashtagsPayload
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GerritClientSetHashtagsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GerritClientSetHashtagsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // GerritClientSetReadyForReviewFunc describes the behavior when the
 // SetReadyForReview method of the parent MockGerritClient instance is
 // invoked.
@@ -10182,6 +10578,114 @@ func (c GerritClientSetReadyForReviewFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// GerritClientSetTopicFunc describes the behavior when the SetTopic method
+// of the parent MockGerritClient instance is invoked.
+type GerritClientSetTopicFunc struct {
+	defaultHook func(context.Context, string, gerrit.SetTopicPayload) error
+	hooks       []func(context.Context, string, gerrit.SetTopicPayload) error
+	history     []GerritClientSetTopicFuncCall
+	mutex       sync.Mutex
+}
+
+// SetTopic delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGerritClient) SetTopic(v0 context.Context, v1 string, v2 gerrit.SetTopicPayload) error {
+	r0 := m.SetTopicFunc.nextHook()(v0, v1, v2)
+	m.SetTopicFunc.appendCall(GerritClientSetTopicFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the SetTopic method of
+// the parent MockGerritClient instance is invoked and the hook queue is
+// empty.
+func (f *GerritClientSetTopicFunc) SetDefaultHook(hook func(context.Context, string, gerrit.SetTopicPayload) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SetTopic method of the parent MockGerritClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GerritClientSetTopicFunc) PushHook(hook func(context.Context, string, gerrit.SetTopicPayload) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GerritClientSetTopicFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, gerrit.SetTopicPayload) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GerritClientSetTopicFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, gerrit.SetTopicPayload) error {
+		return r0
+	})
+}
+
+func (f *GerritClientSetTopicFunc) nextHook() func(context.Context, string, gerrit.SetTopicPayload) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GerritClientSetTopicFunc) appendCall(r0 GerritClientSetTopicFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GerritClientSetTopicFuncCall objects
+// describing the invocations of this function.
+func (f *GerritClientSetTopicFunc) History() []GerritClientSetTopicFuncCall {
+	f.mutex.Lock()
+	history := make([]GerritClientSetTopicFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GerritClientSetTopicFuncCall is an object that describes an invocation of
+// method SetTopic on an instance of MockGerritClient.
+type GerritClientSetTopicFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 gerrit.SetTopicPayload
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GerritClientSetTopicFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GerritClientSetTopicFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // GerritClientSetWIPFunc describes the behavior when the SetWIP method of
 // the parent MockGerritClient instance is invoked.
 type GerritClientSetWIPFunc struct {
@@ -10660,6 +11164,9 @@ type MockGitserverClient struct {
 	// CreateCommitFromPatchFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateCommitFromPatch.
 	CreateCommitFromPatchFunc *GitserverClientCreateCommitFromPatchFunc
+	// DeleteRefFunc is an instance of a mock function object controlling
+	// the behavior of the method DeleteRef.
+	DeleteRefFunc *GitserverClientDeleteRefFunc
 	// DiffFunc is an instance of a mock function object controlling the
 	// behavior of the method Diff.
 	DiffFunc *GitserverClientDiffFunc
@@ -10855,6 +11362,11 @@ func NewMockGitserverClient() *MockGitserverClient {
 				return
 			},
 		},
+		DeleteRefFunc: &GitserverClientDeleteRefFunc{
+			defaultHook: func(context.Context, api.RepoName, string) (r0 error) {
+				return
+			},
+		},
 		DiffFunc: &GitserverClientDiffFunc{
 			defaultHook: func(context.Context, authz.SubRepoPermissionChecker, gitserver.DiffOptions) (r0 *gitserver.DiffFileIterator, r1 error) {
 				return
@@ -11127,6 +11639,11 @@ func NewStrictMockGitserverClient() *MockGitserverClient {
 				panic("unexpected invocation of MockGitserverClient.CreateCommitFromPatch")
 			},
 		},
+		DeleteRefFunc: &GitserverClientDeleteRefFunc{
+			defaultHook: func(context.Context, api.RepoName, string) error {
+				panic("unexpected invocation of MockGitserverClient.DeleteRef")
+			},
+		},
 		DiffFunc: &GitserverClientDiffFunc{
 			defaultHook: func(context.Context, authz.SubRepoPermissionChecker, gitserver.DiffOptions) (*gitserver.DiffFileIterator, error) {
 				panic("unexpected invocation of MockGitserverClient.Diff")
@@ -11370,6 +11887,9 @@ func NewMockGitserverClientFrom(i gitserver.Client) *MockGitserverClient {
 		CreateCommitFromPatchFunc: &GitserverClientCreateCommitFromPatchFunc{
 			defaultHook: i.CreateCommitFromPatch,
 		},
+		DeleteRefFunc: &GitserverClientDeleteRefFunc{
+			defaultHook: i.DeleteRef,
+		},
 		DiffFunc: &GitserverClientDiffFunc{
 			defaultHook: i.Diff,
 		},
@@ -13178,6 +13698,114 @@ func (c GitserverClientCreateCommitFromPatchFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// GitserverClientDeleteRefFunc describes the behavior when the DeleteRef
+// method of the parent MockGitserverClient instance is invoked.
+type GitserverClientDeleteRefFunc struct {
+	defaultHook func(context.Context, api.RepoName, string) error
+	hooks       []func(context.Context, api.RepoName, string) error
+	history     []GitserverClientDeleteRefFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteRef delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverClient) DeleteRef(v0 context.Context, v1 api.RepoName, v2 string) error {
+	r0 := m.DeleteRefFunc.nextHook()(v0, v1, v2)
+	m.DeleteRefFunc.appendCall(GitserverClientDeleteRefFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the DeleteRef method of
+// the parent MockGitserverClient instance is invoked and the hook queue is
+// empty.
+func (f *GitserverClientDeleteRefFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteRef method of the parent MockGitserverClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GitserverClientDeleteRefFunc) PushHook(hook func(context.Context, api.RepoName, string) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverClientDeleteRefFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverClientDeleteRefFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoName, string) error {
+		return r0
+	})
+}
+
+func (f *GitserverClientDeleteRefFunc) nextHook() func(context.Context, api.RepoName, string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverClientDeleteRefFunc) appendCall(r0 GitserverClientDeleteRefFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverClientDeleteRefFuncCall objects
+// describing the invocations of this function.
+func (f *GitserverClientDeleteRefFunc) History() []GitserverClientDeleteRefFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverClientDeleteRefFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverClientDeleteRefFuncCall is an object that describes an
+// invocation of method DeleteRef on an instance of MockGitserverClient.
+type GitserverClientDeleteRefFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverClientDeleteRefFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverClientDeleteRefFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // GitserverClientDiffFunc describes the behavior when the Diff method of
 // the parent MockGitserverClient instance is invoked.
 type GitserverClientDiffFunc struct {
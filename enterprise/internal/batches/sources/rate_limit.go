@@ -0,0 +1,172 @@
+package sources
+
+import (
+	"context"
+	"net/url"
+	"path"
+	"time"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/internal/redispool"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// backgroundRateLimitReserve is the number of requests in the rate limit
+// budget of a code host that background operations leave for interactive
+// operations.
+const backgroundRateLimitReserve = 250
+
+// ChangesetRateLimitCredential returns the credential whose rate limit budget
+// the operations on the code host for the given changeset use up. This is the
+// credential that Sourcer.ForChangeset authenticates the changeset source with.
+func ChangesetRateLimitCredential(ctx context.Context, tx SourcerStore, ch *btypes.Changeset, repo *types.Repo) (RateLimitCredential, error) {
+	au, userID, err := changesetCredential(ctx, tx, ch, repo)
+	if err != nil {
+		return RateLimitCredential{}, err
+	}
+	return RateLimitCredential{
+		ExternalServiceType: repo.ExternalRepo.ServiceType,
+		ExternalServiceID:   repo.ExternalRepo.ServiceID,
+		UserID:              userID,
+		AuthHash:            au.Hash(),
+	}, nil
+}
+
+// exhaustedRateLimitKeyPrefix is the prefix of the keys that record that the
+// rate limit budget of a credential on a code host is exhausted. The keys
+// expire once the budget is reset.
+const exhaustedRateLimitKeyPrefix = "batches:exhausted-rate-limit:"
+
+// RateLimitCredential identifies the credential whose rate limit budget on a
+// code host is consulted.
+type RateLimitCredential struct {
+	ExternalServiceType string
+	ExternalServiceID   string
+
+	// UserID is the ID of the user the credential belongs to, or 0 if it is the
+	// site credential of the code host.
+	UserID int32
+	// AuthHash is the hash of the authenticator of the credential, which the
+	// rate limit monitors of the code host clients are registered for.
+	AuthHash string
+}
+
+// RateLimitBudget reports whether the rate limit budget of a credential on a
+// code host allows operations with it, based on the rate limit monitors of the
+// code host clients. It is safe to use a nil *RateLimitBudget, which never
+// defers operations.
+//
+// The monitors only know about the requests made by the current process, so
+// exhausted budgets are also recorded in a key-value store shared by all
+// replicas.
+type RateLimitBudget struct {
+	registry *ratelimit.MonitorRegistry
+	kv       redispool.KeyValue
+	now      func() time.Time
+}
+
+// NewRateLimitBudget creates a RateLimitBudget that consults the rate limit
+// monitors in the given registry, and records exhausted budgets in the given
+// key-value store. If kv is nil, exhausted budgets are not shared.
+func NewRateLimitBudget(registry *ratelimit.MonitorRegistry, kv redispool.KeyValue) *RateLimitBudget {
+	return &RateLimitBudget{registry: registry, kv: kv, now: time.Now}
+}
+
+// Wait returns how long operations with the given credential should be
+// deferred until its rate limit budget allows them. Only the monitors of the
+// API resources that changeset sources use are consulted. Background
+// operations, such as periodic changeset syncs, are already deferred while
+// less than backgroundRateLimitReserve requests remain, so that interactive
+// operations take precedence.
+func (b *RateLimitBudget) Wait(cred RateLimitCredential, background bool) time.Duration {
+	if b == nil {
+		return 0
+	}
+
+	cost := 1
+	if background {
+		cost = backgroundRateLimitReserve
+	}
+	baseURL := rateLimitBaseURL(cred.ExternalServiceType, cred.ExternalServiceID)
+	var wait time.Duration
+	for _, resource := range rateLimitResources(cred.ExternalServiceType) {
+		if w := b.registry.WaitTime(baseURL, cred.AuthHash, resource, cost); w > wait {
+			wait = w
+		}
+	}
+	if wait > 0 && !background {
+		b.recordExhausted(cred, wait)
+	}
+
+	if recorded := b.recordedWait(cred); recorded > wait {
+		wait = recorded
+	}
+	return wait
+}
+
+// recordExhausted records that the rate limit budget of the given credential
+// is exhausted for the given duration. Failures are ignored, because the
+// budget is still enforced by the monitors of the current process.
+func (b *RateLimitBudget) recordExhausted(cred RateLimitCredential, wait time.Duration) {
+	if b.kv == nil {
+		return
+	}
+	// The key expires with second precision, so round up to not expire it
+	// before the budget is reset.
+	ttl := int((wait + time.Second - 1) / time.Second)
+	_ = b.kv.SetEx(exhaustedRateLimitKey(cred), ttl, b.now().Add(wait).Unix())
+}
+
+// recordedWait returns how long the rate limit budget of the given credential
+// has been recorded as exhausted for.
+func (b *RateLimitBudget) recordedWait(cred RateLimitCredential) time.Duration {
+	if b.kv == nil {
+		return 0
+	}
+	resetAt, err := b.kv.Get(exhaustedRateLimitKey(cred)).Int()
+	if err != nil {
+		return 0
+	}
+	if wait := time.Unix(int64(resetAt), 0).Sub(b.now()); wait > 0 {
+		return wait
+	}
+	return 0
+}
+
+func exhaustedRateLimitKey(cred RateLimitCredential) string {
+	return exhaustedRateLimitKeyPrefix + cred.ExternalServiceID + ":" + cred.AuthHash
+}
+
+// rateLimitResources returns the resources that the rate limit monitors of the
+// API clients used by the changeset sources of the given code host are
+// registered for.
+func rateLimitResources(externalServiceType string) []string {
+	if externalServiceType == extsvc.TypeGitHub {
+		// The GitHub source uses the GraphQL API, which falls back to the REST
+		// API for some operations.
+		return []string{"graphql", "rest"}
+	}
+	return []string{"rest"}
+}
+
+// rateLimitBaseURL returns the URL the rate limit monitors of the clients for
+// the given code host are registered for.
+func rateLimitBaseURL(externalServiceType, externalServiceID string) string {
+	u, err := url.Parse(externalServiceID)
+	if err != nil {
+		return externalServiceID
+	}
+
+	switch externalServiceType {
+	case extsvc.TypeGitHub:
+		apiURL, _ := github.APIRoot(u)
+		return apiURL.String()
+	case extsvc.TypeGitLab:
+		return u.ResolveReference(&url.URL{Path: path.Join(u.Path, "api/v4") + "/"}).String()
+	default:
+		return externalServiceID
+	}
+}
@@ -0,0 +1,187 @@
+package sources
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/internal/redispool"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestRateLimitBaseURL(t *testing.T) {
+	for _, tc := range []struct {
+		typ, id string
+		want    string
+	}{
+		{typ: extsvc.TypeGitHub, id: "https://github.com/", want: "https://api.github.com/"},
+		{typ: extsvc.TypeGitHub, id: "https://ghe.example.com/", want: "https://ghe.example.com/api/v3"},
+		{typ: extsvc.TypeGitLab, id: "https://gitlab.com/", want: "https://gitlab.com/api/v4/"},
+		{typ: extsvc.TypeBitbucketServer, id: "https://bbs.example.com/", want: "https://bbs.example.com/"},
+	} {
+		t.Run(tc.id, func(t *testing.T) {
+			assert.Equal(t, tc.want, rateLimitBaseURL(tc.typ, tc.id))
+		})
+	}
+}
+
+func TestRateLimitBudget(t *testing.T) {
+	monitor := func(remaining int) *ratelimit.Monitor {
+		m := &ratelimit.Monitor{HeaderPrefix: "X-"}
+		m.Update(http.Header{
+			"X-Ratelimit-Limit":     []string{"5000"},
+			"X-Ratelimit-Remaining": []string{strconv.Itoa(remaining)},
+			"X-Ratelimit-Reset":     []string{strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10)},
+		})
+		return m
+	}
+
+	github := RateLimitCredential{ExternalServiceType: extsvc.TypeGitHub, ExternalServiceID: "https://github.com/", AuthHash: "site"}
+	githubUser := RateLimitCredential{ExternalServiceType: extsvc.TypeGitHub, ExternalServiceID: "https://github.com/", UserID: 1, AuthHash: "user"}
+	gitlab := RateLimitCredential{ExternalServiceType: extsvc.TypeGitLab, ExternalServiceID: "https://gitlab.com/", AuthHash: "site"}
+
+	registry := ratelimit.NewMonitorRegistry()
+	registry.GetOrSet("https://api.github.com/", "site", "rest", monitor(5000))
+	registry.GetOrSet("https://api.github.com/", "site", "graphql", monitor(100))
+	registry.GetOrSet("https://api.github.com/", "user", "rest", monitor(5000))
+	registry.GetOrSet("https://api.github.com/", "user", "search", monitor(0))
+	registry.GetOrSet("https://gitlab.com/api/v4/", "site", "rest", monitor(0))
+	budget := NewRateLimitBudget(registry, nil)
+
+	t.Run("interactive operations use the reserve", func(t *testing.T) {
+		assert.Zero(t, budget.Wait(github, false))
+	})
+	t.Run("background operations leave the reserve", func(t *testing.T) {
+		assert.Greater(t, budget.Wait(github, true), 59*time.Minute)
+	})
+	t.Run("exhausted budget", func(t *testing.T) {
+		assert.Greater(t, budget.Wait(gitlab, false), 59*time.Minute)
+	})
+	t.Run("other resources are ignored", func(t *testing.T) {
+		assert.Zero(t, budget.Wait(githubUser, true))
+	})
+	t.Run("unknown credential", func(t *testing.T) {
+		cred := gitlab
+		cred.AuthHash = "other"
+		assert.Zero(t, budget.Wait(cred, false))
+	})
+	t.Run("exhausted budget is shared", func(t *testing.T) {
+		kv := redispool.MemoryKeyValue()
+		budget := NewRateLimitBudget(registry, kv)
+		other := NewRateLimitBudget(ratelimit.NewMonitorRegistry(), kv)
+
+		// Background operations don't record the budget as exhausted.
+		assert.Greater(t, budget.Wait(github, true), 59*time.Minute)
+		assert.Zero(t, other.Wait(github, false))
+		assert.True(t, kv.Get(exhaustedRateLimitKey(github)).IsNil())
+
+		assert.Greater(t, budget.Wait(gitlab, false), 59*time.Minute)
+		assert.Greater(t, other.Wait(gitlab, false), 59*time.Minute)
+
+		// Other credentials on the same code host are not affected.
+		userCred := gitlab
+		userCred.UserID = 1
+		userCred.AuthHash = "user"
+		assert.Zero(t, other.Wait(userCred, false))
+
+		// Once the budget has been reset, the credential is no longer
+		// exhausted.
+		other.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+		assert.Zero(t, other.Wait(gitlab, false))
+	})
+	t.Run("nil budget", func(t *testing.T) {
+		var budget *RateLimitBudget
+		assert.Zero(t, budget.Wait(gitlab, false))
+	})
+}
+
+func TestChangesetRateLimitCredential(t *testing.T) {
+	ctx := context.Background()
+
+	repo := &types.Repo{
+		ID: 1,
+		ExternalRepo: api.ExternalRepoSpec{
+			ID:          "external-id-123",
+			ServiceType: extsvc.TypeGitHub,
+			ServiceID:   "https://github.com/",
+		},
+	}
+	bc := &btypes.BatchChange{ID: 1, LastApplierID: 3}
+	siteToken := &auth.OAuthBearerToken{Token: "site"}
+	userToken := &auth.OAuthBearerToken{Token: "user"}
+
+	mockStore := func(userCred, siteCred bool) *MockSourcerStore {
+		credStore := database.NewMockUserCredentialsStore()
+		credStore.GetByScopeFunc.SetDefaultHook(func(ctx context.Context, opts database.UserCredentialScope) (*database.UserCredential, error) {
+			assert.EqualValues(t, bc.LastApplierID, opts.UserID)
+			if !userCred {
+				return nil, &errcode.Mock{IsNotFound: true}
+			}
+			cred := &database.UserCredential{Credential: database.NewEmptyCredential()}
+			cred.SetAuthenticator(ctx, userToken)
+			return cred, nil
+		})
+
+		tx := NewMockSourcerStore()
+		tx.GetBatchChangeFunc.SetDefaultReturn(bc, nil)
+		tx.UserCredentialsFunc.SetDefaultReturn(credStore)
+		tx.GetSiteCredentialFunc.SetDefaultHook(func(ctx context.Context, opts store.GetSiteCredentialOpts) (*btypes.SiteCredential, error) {
+			if !siteCred {
+				return nil, store.ErrNoResults
+			}
+			cred := &btypes.SiteCredential{Credential: database.NewEmptyCredential()}
+			cred.SetAuthenticator(ctx, siteToken)
+			return cred, nil
+		})
+		return tx
+	}
+
+	site := RateLimitCredential{
+		ExternalServiceType: extsvc.TypeGitHub,
+		ExternalServiceID:   "https://github.com/",
+		AuthHash:            siteToken.Hash(),
+	}
+	user := RateLimitCredential{
+		ExternalServiceType: extsvc.TypeGitHub,
+		ExternalServiceID:   "https://github.com/",
+		UserID:              bc.LastApplierID,
+		AuthHash:            userToken.Hash(),
+	}
+
+	for _, tc := range []struct {
+		name     string
+		ch       *btypes.Changeset
+		userCred bool
+		siteCred bool
+		want     RateLimitCredential
+		wantErr  error
+	}{
+		{name: "created with user credential", ch: &btypes.Changeset{OwnedByBatchChangeID: bc.ID}, userCred: true, siteCred: true, want: user},
+		{name: "created with site credential", ch: &btypes.Changeset{OwnedByBatchChangeID: bc.ID}, siteCred: true, want: site},
+		{name: "created without credential", ch: &btypes.Changeset{OwnedByBatchChangeID: bc.ID}, wantErr: ErrMissingCredentials},
+		{name: "imported with site credential", ch: &btypes.Changeset{}, userCred: true, siteCred: true, want: site},
+		{name: "imported without credential", ch: &btypes.Changeset{}, wantErr: ErrMissingCredentials},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			have, err := ChangesetRateLimitCredential(ctx, mockStore(tc.userCred, tc.siteCred), tc.ch, repo)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
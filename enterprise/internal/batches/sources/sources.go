@@ -7,6 +7,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/emailpatch"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	ghaauth "github.com/sourcegraph/sourcegraph/enterprise/internal/github_apps/auth"
@@ -135,6 +136,18 @@ func (s *sourcer) ForChangeset(ctx context.Context, tx SourcerStore, ch *btypes.
 		return nil, errors.Wrap(err, "loading changeset repo")
 	}
 
+	// Changesets of repositories that take contributions over a mailing list
+	// are sent as patches instead of being opened on the code host. Changesets
+	// that have already been published on the code host stay there.
+	if as != AuthenticationStrategyGitHubApp {
+		if ch.ExternalServiceType == emailpatch.ExternalServiceType {
+			return NewEmailPatchSource(emailPatchesConfig(repo)), nil
+		}
+		if cfg := emailPatchesConfig(repo); cfg != nil && ch.ExternalID == "" {
+			return NewEmailPatchSource(cfg), nil
+		}
+	}
+
 	// Consider all available external services for this repo.
 	extSvc, err := loadExternalService(ctx, tx.ExternalServices(), database.ExternalServicesListOptions{
 		IDs: repo.ExternalServiceIDs(),
@@ -162,16 +175,11 @@ func (s *sourcer) ForChangeset(ctx context.Context, tx SourcerStore, ch *btypes.
 		return withGitHubAppAuthenticator(ctx, tx, css, extSvc, owner)
 	}
 
-	if ch.OwnedByBatchChangeID != 0 {
-		batchChange, err := loadBatchChange(ctx, tx, ch.OwnedByBatchChangeID)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to load owning batch change")
-		}
-
-		return withAuthenticatorForUser(ctx, tx, css, batchChange.LastApplierID, repo)
+	cred, _, err := changesetCredential(ctx, tx, ch, repo)
+	if err != nil {
+		return nil, err
 	}
-
-	return withSiteAuthenticator(ctx, tx, css, repo)
+	return css.WithAuthenticator(cred)
 }
 
 func (s *sourcer) ForUser(ctx context.Context, tx SourcerStore, uid int32, repo *types.Repo) (ChangesetSource, error) {
@@ -382,6 +390,41 @@ func withAuthenticatorForUser(ctx context.Context, tx SourcerStore, css Changese
 	return withSiteAuthenticator(ctx, tx, css, repo)
 }
 
+// changesetCredential returns the credential that ForChangeset authenticates the
+// source of the given changeset with, unless AuthenticationStrategyGitHubApp is
+// used, along with the ID of the user it belongs to. Changesets owned by a batch
+// change use the credential of the user who last applied it, with a fallback to
+// the site credential, which is returned with user ID 0. If none of these
+// exist, ErrMissingCredentials is returned.
+func changesetCredential(ctx context.Context, tx SourcerStore, ch *btypes.Changeset, repo *types.Repo) (auth.Authenticator, int32, error) {
+	if ch.OwnedByBatchChangeID != 0 {
+		batchChange, err := loadBatchChange(ctx, tx, ch.OwnedByBatchChangeID)
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "failed to load owning batch change")
+		}
+
+		cred, err := loadUserCredential(ctx, tx, batchChange.LastApplierID, repo)
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "loading user credential")
+		}
+		if cred != nil {
+			return cred, batchChange.LastApplierID, nil
+		}
+	}
+
+	cred, err := loadSiteCredential(ctx, tx, store.GetSiteCredentialOpts{
+		ExternalServiceType: repo.ExternalRepo.ServiceType,
+		ExternalServiceID:   repo.ExternalRepo.ServiceID,
+	})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "loading site credential")
+	}
+	if cred == nil {
+		return nil, 0, ErrMissingCredentials
+	}
+	return cred, 0, nil
+}
+
 // withSiteAuthenticator uses the site credential of the code host of the passed-in repo.
 // If no credential is found, the original source is returned and uses the external service
 // config.
@@ -324,6 +324,64 @@ func reduceReviewStates(statesByAuthor map[string]btypes.ChangesetReviewState) b
 	return selectReviewState(states)
 }
 
+// CountApprovals returns the number of distinct authors whose latest review of
+// the changeset, according to the given events, is an approval. Reviews are
+// tracked the same way as in computeHistory.
+func CountApprovals(es []*btypes.ChangesetEvent) int {
+	events := make(ChangesetEvents, len(es))
+	copy(events, es)
+	sort.Sort(events)
+
+	lastReviewByAuthor := map[string]btypes.ChangesetReviewState{}
+	for _, e := range events {
+		author := e.ReviewAuthor()
+		if author == "" {
+			continue
+		}
+
+		switch e.Kind {
+		case btypes.ChangesetEventKindGitHubReviewed,
+			btypes.ChangesetEventKindBitbucketServerApproved,
+			btypes.ChangesetEventKindBitbucketServerReviewed,
+			btypes.ChangesetEventKindGitLabApproved,
+			btypes.ChangesetEventKindBitbucketCloudApproved,
+			btypes.ChangesetEventKindBitbucketCloudPullRequestApproved,
+			btypes.ChangesetEventKindAzureDevOpsPullRequestApproved:
+			s, err := e.ReviewState()
+			if err != nil {
+				continue
+			}
+
+			switch s {
+			case btypes.ChangesetReviewStateApproved, btypes.ChangesetReviewStateChangesRequested:
+				lastReviewByAuthor[author] = s
+			case btypes.ChangesetReviewStateDismissed:
+				delete(lastReviewByAuthor, author)
+			}
+
+		case btypes.ChangesetEventKindBitbucketServerUnapproved,
+			btypes.ChangesetEventKindBitbucketServerDismissed,
+			btypes.ChangesetEventKindGitLabUnapproved,
+			btypes.ChangesetEventKindBitbucketCloudPullRequestChangesRequestRemoved,
+			btypes.ChangesetEventKindBitbucketCloudPullRequestUnapproved:
+			delete(lastReviewByAuthor, author)
+
+		case btypes.ChangesetEventKindAzureDevOpsPullRequestRejected,
+			btypes.ChangesetEventKindAzureDevOpsPullRequestApprovedWithSuggestions,
+			btypes.ChangesetEventKindAzureDevOpsPullRequestWaitingForAuthor:
+			lastReviewByAuthor[author] = btypes.ChangesetReviewStateChangesRequested
+		}
+	}
+
+	approvals := 0
+	for _, s := range lastReviewByAuthor {
+		if s == btypes.ChangesetReviewStateApproved {
+			approvals++
+		}
+	}
+	return approvals
+}
+
 // initialExternalState infers from the changeset state and the list of events in which
 // ChangesetExternalState the changeset must have been when it has been created.
 func initialExternalState(ch *btypes.Changeset, ce ChangesetEvents) btypes.ChangesetExternalState {
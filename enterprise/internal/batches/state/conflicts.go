@@ -0,0 +1,157 @@
+package state
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/sourcegraph/go-diff/diff"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// setConflicted updates c.Conflicted based on whether the diff of the
+// changeset still applies cleanly to the head of its base branch.
+//
+// The check requires gitserver to try and apply the diff, so it's only done
+// again when either the base branch or the changeset moved since the revisions
+// recorded on the changeset by the last check.
+func setConflicted(ctx context.Context, client gitserver.Client, c *btypes.Changeset, repo api.RepoName) error {
+	// Code hosts that don't push to branches (like Gerrit) don't have a
+	// separate head we could diff against the base.
+	if c.Complete() || !c.Published() || c.SyncState.BaseRefOid == c.SyncState.HeadRefOid {
+		c.Conflicted = false
+		c.ConflictCheckBaseRev = ""
+		c.ConflictCheckHeadRev = ""
+		return nil
+	}
+
+	baseRef, err := c.BaseRef()
+	if err != nil {
+		return err
+	}
+	base, err := client.ResolveRevision(ctx, repo, baseRef, gitserver.ResolveRevisionOptions{})
+	if err != nil {
+		return err
+	}
+	head := api.CommitID(c.SyncState.HeadRefOid)
+
+	if c.ConflictCheckBaseRev == string(base) && c.ConflictCheckHeadRev == string(head) {
+		return nil
+	}
+
+	conflicted, err := computeConflicted(ctx, client, c.ID, repo, base, head)
+	if err != nil {
+		return err
+	}
+
+	c.Conflicted = conflicted
+	c.ConflictCheckBaseRev = string(base)
+	c.ConflictCheckHeadRev = string(head)
+	return nil
+}
+
+// computeConflicted returns whether the changes between the merge base of base
+// and head and head can no longer be applied to base. It asks gitserver to
+// create a commit from the diff without pushing it, so this is a dry-run of
+// what the reconciler would do to rebase the changeset. The ref of the commit
+// is deleted again afterwards, so that the commit can be garbage collected.
+func computeConflicted(ctx context.Context, client gitserver.Client, changesetID int64, repo api.RepoName, base, head api.CommitID) (bool, error) {
+	mergeBase, err := client.MergeBase(ctx, repo, base, head)
+	if err != nil {
+		return false, errors.Wrap(err, "computing merge base")
+	}
+
+	// If the base branch hasn't advanced since the changeset was branched off,
+	// the diff applies by definition.
+	if mergeBase == base {
+		return false, nil
+	}
+
+	patch, err := changesetPatch(ctx, client, repo, mergeBase, head)
+	if err != nil {
+		return false, err
+	}
+	if len(patch) == 0 {
+		return false, nil
+	}
+
+	// The ref only exists on gitserver, since we don't push the commit.
+	ref := protocol.ConflictCheckRefPrefix + strconv.FormatInt(changesetID, 10)
+	res, err := client.CreateCommitFromPatch(ctx, protocol.CreateCommitFromPatchRequest{
+		Repo:       repo,
+		BaseCommit: base,
+		Patch:      patch,
+		TargetRef:  ref,
+		CommitInfo: protocol.PatchCommitInfo{
+			Messages: []string{"Sourcegraph: check changeset for conflicts"},
+		},
+		// gitserver.Diff produces diffs without the `a/` and `b/` filename
+		// prefixes.
+		GitApplyArgs: []string{"-p0"},
+	})
+	if err != nil {
+		// Only a failing `git apply` means that the changeset is conflicted.
+		// Everything else is an error in gitserver.
+		if res != nil && res.Error != nil && strings.Contains(res.Error.Command, " apply ") {
+			return true, nil
+		}
+		return false, errors.Wrap(err, "applying changeset diff")
+	}
+
+	if err := client.DeleteRef(ctx, repo, ref); err != nil {
+		return false, errors.Wrap(err, "deleting conflict check ref")
+	}
+
+	return false, nil
+}
+
+// changesetPatch returns the diff between base and head in a format that can
+// be applied with `git apply -p0`. Binary files are skipped, since the diff
+// doesn't contain their content.
+func changesetPatch(ctx context.Context, client gitserver.Client, repo api.RepoName, base, head api.CommitID) ([]byte, error) {
+	iter, err := client.Diff(ctx, authz.DefaultSubRepoPermsChecker, gitserver.DiffOptions{
+		Repo:      repo,
+		Base:      string(base),
+		Head:      string(head),
+		RangeType: "..",
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var fileDiffs []*diff.FileDiff
+	for {
+		fd, err := iter.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if isBinaryFileDiff(fd) {
+			continue
+		}
+		fileDiffs = append(fileDiffs, fd)
+	}
+
+	if len(fileDiffs) == 0 {
+		return nil, nil
+	}
+	return diff.PrintMultiFileDiff(fileDiffs)
+}
+
+func isBinaryFileDiff(fd *diff.FileDiff) bool {
+	for _, h := range fd.Extended {
+		if strings.HasPrefix(h, "Binary files ") || strings.HasPrefix(h, "GIT binary patch") {
+			return true
+		}
+	}
+	return false
+}
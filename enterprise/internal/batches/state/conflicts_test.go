@@ -0,0 +1,221 @@
+package state
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestComputeConflicted(t *testing.T) {
+	ctx := context.Background()
+	repo := api.RepoName("github.com/sourcegraph/sourcegraph")
+	base := api.CommitID("base")
+	head := api.CommitID("head")
+
+	const testDiff = `diff --git README.md README.md
+index 671e50a..851b23a 100644
+--- README.md
++++ README.md
+@@ -1,2 +1,2 @@
+ # README
+-This is the readme
++This is the updated readme
+`
+
+	newClient := func(mergeBase api.CommitID, applyErr error) *gitserver.MockClient {
+		client := gitserver.NewMockClient()
+		client.MergeBaseFunc.SetDefaultReturn(mergeBase, nil)
+		client.DiffFunc.SetDefaultHook(func(context.Context, authz.SubRepoPermissionChecker, gitserver.DiffOptions) (*gitserver.DiffFileIterator, error) {
+			return gitserver.NewDiffFileIterator(io.NopCloser(strings.NewReader(testDiff))), nil
+		})
+		client.CreateCommitFromPatchFunc.SetDefaultHook(func(_ context.Context, req protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error) {
+			if req.BaseCommit != base {
+				t.Errorf("wrong base commit: have=%s want=%s", req.BaseCommit, base)
+			}
+			if req.Push != nil {
+				t.Error("conflict check pushed the commit")
+			}
+			if applyErr != nil {
+				return &protocol.CreateCommitFromPatchResponse{Error: applyErr.(*protocol.CreateCommitFromPatchError)}, applyErr
+			}
+			return &protocol.CreateCommitFromPatchResponse{Rev: req.TargetRef}, nil
+		})
+		return client
+	}
+
+	t.Run("base not advanced", func(t *testing.T) {
+		client := newClient(base, nil)
+
+		conflicted, err := computeConflicted(ctx, client, 1, repo, base, head)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if conflicted {
+			t.Fatal("changeset is conflicted")
+		}
+		if len(client.CreateCommitFromPatchFunc.History()) != 0 {
+			t.Fatal("diff was applied")
+		}
+	})
+
+	t.Run("diff applies", func(t *testing.T) {
+		client := newClient("merge-base", nil)
+
+		conflicted, err := computeConflicted(ctx, client, 1, repo, base, head)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if conflicted {
+			t.Fatal("changeset is conflicted")
+		}
+		if len(client.CreateCommitFromPatchFunc.History()) != 1 {
+			t.Fatal("diff was not applied")
+		}
+		deleted := client.DeleteRefFunc.History()
+		if len(deleted) != 1 || deleted[0].Arg2 != client.CreateCommitFromPatchFunc.History()[0].Arg1.TargetRef {
+			t.Fatalf("conflict check ref was not deleted: %v", deleted)
+		}
+	})
+
+	t.Run("diff does not apply", func(t *testing.T) {
+		client := newClient("merge-base", &protocol.CreateCommitFromPatchError{
+			Command:        "git apply --cached -p0",
+			CombinedOutput: "error: patch failed: README.md:1",
+		})
+
+		conflicted, err := computeConflicted(ctx, client, 1, repo, base, head)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !conflicted {
+			t.Fatal("changeset is not conflicted")
+		}
+	})
+
+	t.Run("gitserver error", func(t *testing.T) {
+		client := newClient("merge-base", &protocol.CreateCommitFromPatchError{
+			Command:       "git reset -q base",
+			InternalError: "gitserver: basing staging on base rev",
+		})
+
+		if _, err := computeConflicted(ctx, client, 1, repo, base, head); err == nil {
+			t.Fatal("no error returned")
+		}
+	})
+
+	t.Run("merge base error", func(t *testing.T) {
+		client := newClient("merge-base", nil)
+		client.MergeBaseFunc.SetDefaultReturn("", errors.New("unknown revision"))
+
+		if _, err := computeConflicted(ctx, client, 1, repo, base, head); err == nil {
+			t.Fatal("no error returned")
+		}
+	})
+}
+
+func TestSetConflicted(t *testing.T) {
+	ctx := context.Background()
+	repo := api.RepoName("github.com/sourcegraph/sourcegraph")
+
+	newChangeset := func() *btypes.Changeset {
+		return &btypes.Changeset{
+			ID:               1,
+			Metadata:         &github.PullRequest{BaseRefName: "main"},
+			PublicationState: btypes.ChangesetPublicationStatePublished,
+			ExternalState:    btypes.ChangesetExternalStateOpen,
+			SyncState: btypes.ChangesetSyncState{
+				BaseRefOid: "base",
+				HeadRefOid: "head",
+			},
+		}
+	}
+
+	newClient := func(base api.CommitID) *gitserver.MockClient {
+		client := gitserver.NewMockClient()
+		client.ResolveRevisionFunc.SetDefaultReturn(base, nil)
+		// The base branch hasn't advanced since the changeset was branched
+		// off, so no diff needs to be applied.
+		client.MergeBaseFunc.SetDefaultReturn(base, nil)
+		return client
+	}
+
+	t.Run("records the checked revisions", func(t *testing.T) {
+		c := newChangeset()
+		client := newClient("base-tip")
+
+		if err := setConflicted(ctx, client, c, repo); err != nil {
+			t.Fatal(err)
+		}
+		if c.ConflictCheckBaseRev != "base-tip" || c.ConflictCheckHeadRev != "head" {
+			t.Fatalf("wrong revisions recorded: base=%q head=%q", c.ConflictCheckBaseRev, c.ConflictCheckHeadRev)
+		}
+		if len(client.MergeBaseFunc.History()) != 1 {
+			t.Fatal("changeset was not checked for conflicts")
+		}
+	})
+
+	t.Run("skips unchanged revisions", func(t *testing.T) {
+		c := newChangeset()
+		c.Conflicted = true
+		c.ConflictCheckBaseRev = "base-tip"
+		c.ConflictCheckHeadRev = "head"
+		client := newClient("base-tip")
+
+		if err := setConflicted(ctx, client, c, repo); err != nil {
+			t.Fatal(err)
+		}
+		if len(client.MergeBaseFunc.History()) != 0 {
+			t.Fatal("changeset was checked for conflicts again")
+		}
+		if !c.Conflicted {
+			t.Fatal("result of the last check was not kept")
+		}
+	})
+
+	t.Run("base branch moved", func(t *testing.T) {
+		c := newChangeset()
+		c.Conflicted = true
+		c.ConflictCheckBaseRev = "base-tip"
+		c.ConflictCheckHeadRev = "head"
+		client := newClient("new-base-tip")
+
+		if err := setConflicted(ctx, client, c, repo); err != nil {
+			t.Fatal(err)
+		}
+		if len(client.MergeBaseFunc.History()) != 1 {
+			t.Fatal("changeset was not checked for conflicts")
+		}
+		if c.Conflicted {
+			t.Fatal("changeset is still conflicted")
+		}
+		if c.ConflictCheckBaseRev != "new-base-tip" {
+			t.Fatalf("wrong base revision recorded: %q", c.ConflictCheckBaseRev)
+		}
+	})
+
+	t.Run("changeset moved", func(t *testing.T) {
+		c := newChangeset()
+		c.ConflictCheckBaseRev = "base-tip"
+		c.ConflictCheckHeadRev = "old-head"
+		client := newClient("base-tip")
+
+		if err := setConflicted(ctx, client, c, repo); err != nil {
+			t.Fatal(err)
+		}
+		if len(client.MergeBaseFunc.History()) != 1 {
+			t.Fatal("changeset was not checked for conflicts")
+		}
+		if c.ConflictCheckHeadRev != "head" {
+			t.Fatalf("wrong head revision recorded: %q", c.ConflictCheckHeadRev)
+		}
+	})
+}
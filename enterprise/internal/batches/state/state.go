@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/azuredevops"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/emailpatch"
 	gerritbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gerrit"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	adobatches "github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
@@ -78,6 +79,7 @@ func SetDerivedState(ctx context.Context, repoStore database.RepoStore, client g
 	// work: the diffstat should still be correct, and this way we don't need to
 	// rely on gitserver having the head OID still available.
 	if c.SyncState.IsComplete && c.Complete() {
+		c.Conflicted = false
 		return
 	}
 
@@ -102,6 +104,10 @@ func SetDerivedState(ctx context.Context, repoStore database.RepoStore, client g
 			c.SetDiffStat(stat)
 		}
 	}
+
+	if err := setConflicted(ctx, client, c, repo.Name); err != nil {
+		logger.Warn("Computing conflicts", log.Error(err))
+	}
 }
 
 // computeCheckState computes the overall check state based on the current
@@ -128,6 +134,9 @@ func computeCheckState(c *btypes.Changeset, events ChangesetEvents) btypes.Chang
 		// Perforce doesn't have builds built-in, its better to be explicit by still
 		// including this case for clarity.
 		return btypes.ChangesetCheckStateUnknown
+	case *emailpatch.Series:
+		// Patches sent over email are not built.
+		return btypes.ChangesetCheckStateUnknown
 	}
 
 	return btypes.ChangesetCheckStateUnknown
@@ -650,6 +659,17 @@ func computeSingleChangesetExternalState(c *btypes.Changeset) (s btypes.Changese
 		default:
 			return "", errors.Errorf("unknown Gerrit Change state: %s", m.State)
 		}
+	case *emailpatch.Series:
+		switch m.State {
+		case emailpatch.StateClosed:
+			s = btypes.ChangesetExternalStateClosed
+		case emailpatch.StateMerged:
+			s = btypes.ChangesetExternalStateMerged
+		case emailpatch.StateOpen:
+			s = btypes.ChangesetExternalStateOpen
+		default:
+			return "", errors.Errorf("unknown email patch series state: %s", m.State)
+		}
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -760,6 +780,9 @@ func computeSingleChangesetReviewState(c *btypes.Changeset) (s btypes.ChangesetR
 		}
 	case *protocol.PerforceChangelist:
 		states[btypes.ChangesetReviewStatePending] = true
+	case *emailpatch.Series:
+		// Reviews happen as replies on the mailing list, which are not tracked.
+		states[btypes.ChangesetReviewStatePending] = true
 	default:
 		return "", errors.New("unknown changeset type")
 	}
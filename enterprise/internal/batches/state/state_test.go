@@ -8,11 +8,13 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 
 	azuredevops2 "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/azuredevops"
+	gerritbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gerrit"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
 
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
 	"github.com/sourcegraph/sourcegraph/internal/timeutil"
@@ -859,6 +861,49 @@ func TestComputeExternalState(t *testing.T) {
 			},
 			want: btypes.ChangesetExternalStateReadOnly,
 		},
+		{
+			name:      "gerrit - merged change without topic",
+			changeset: gerritChangeset(daysAgo(0), gerrit.ChangeStatusMerged, nil),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetExternalStateMerged,
+		},
+		{
+			name: "gerrit - merged change of a topic with open changes",
+			changeset: gerritChangeset(daysAgo(0), gerrit.ChangeStatusMerged, &gerritbatches.Topic{Changes: []gerritbatches.TopicChange{
+				{ChangeID: "I1", Status: gerrit.ChangeStatusMerged},
+				{ChangeID: "I2", Status: gerrit.ChangeStatusNew},
+			}}),
+			history: []changesetStatesAtTime{},
+			want:    btypes.ChangesetExternalStateMerged,
+		},
+		{
+			name: "gerrit - merged change of a merged topic",
+			changeset: gerritChangeset(daysAgo(0), gerrit.ChangeStatusMerged, &gerritbatches.Topic{Changes: []gerritbatches.TopicChange{
+				{ChangeID: "I1", Status: gerrit.ChangeStatusMerged},
+				{ChangeID: "I2", Status: gerrit.ChangeStatusMerged},
+				{ChangeID: "I3", Status: gerrit.ChangeStatusAbandoned},
+			}, Merged: true}),
+			history: []changesetStatesAtTime{},
+			want:    btypes.ChangesetExternalStateMerged,
+		},
+		{
+			name: "gerrit - abandoned change of an open topic",
+			changeset: gerritChangeset(daysAgo(0), gerrit.ChangeStatusAbandoned, &gerritbatches.Topic{Changes: []gerritbatches.TopicChange{
+				{ChangeID: "I1", Status: gerrit.ChangeStatusAbandoned},
+				{ChangeID: "I2", Status: gerrit.ChangeStatusNew},
+			}}),
+			history: []changesetStatesAtTime{},
+			want:    btypes.ChangesetExternalStateClosed,
+		},
+		{
+			name: "gerrit - open change of a topic with merged changes",
+			changeset: gerritChangeset(daysAgo(0), gerrit.ChangeStatusNew, &gerritbatches.Topic{Changes: []gerritbatches.TopicChange{
+				{ChangeID: "I1", Status: gerrit.ChangeStatusNew},
+				{ChangeID: "I2", Status: gerrit.ChangeStatusMerged},
+			}}),
+			history: []changesetStatesAtTime{},
+			want:    btypes.ChangesetExternalStateOpen,
+		},
 	}
 
 	for i, tc := range tests {
@@ -1003,6 +1048,17 @@ func githubChangeset(updatedAt time.Time, state string, reviewDecision string) *
 	}
 }
 
+func gerritChangeset(updatedAt time.Time, status gerrit.ChangeStatus, topic *gerritbatches.Topic) *btypes.Changeset {
+	return &btypes.Changeset{
+		ExternalServiceType: extsvc.TypeGerrit,
+		UpdatedAt:           updatedAt,
+		Metadata: &gerritbatches.AnnotatedChange{
+			Change: &gerrit.Change{ChangeID: "I1", Status: status},
+			Topic:  topic,
+		},
+	}
+}
+
 func gitLabChangeset(updatedAt time.Time, state gitlab.MergeRequestState, notes []*gitlab.Note) *btypes.Changeset {
 	return &btypes.Changeset{
 		ExternalServiceType: extsvc.TypeGitLab,
@@ -1014,6 +1070,57 @@ func gitLabChangeset(updatedAt time.Time, state gitlab.MergeRequestState, notes
 	}
 }
 
+func TestCountApprovals(t *testing.T) {
+	t.Parallel()
+
+	now := timeutil.Now()
+
+	for name, tc := range map[string]struct {
+		events []*btypes.ChangesetEvent
+		want   int
+	}{
+		"no events": {
+			want: 0,
+		},
+		"approvals by distinct authors": {
+			events: []*btypes.ChangesetEvent{
+				ghReview(1, now.Add(-2*time.Hour), "user1", "APPROVED"),
+				ghReview(1, now.Add(-1*time.Hour), "user2", "APPROVED"),
+				ghReview(1, now, "user1", "APPROVED"),
+			},
+			want: 2,
+		},
+		"changes requested after approval": {
+			events: []*btypes.ChangesetEvent{
+				ghReview(1, now.Add(-1*time.Hour), "user1", "APPROVED"),
+				ghReview(1, now, "user1", "CHANGES_REQUESTED"),
+			},
+			want: 0,
+		},
+		"comments don't replace approvals": {
+			events: []*btypes.ChangesetEvent{
+				ghReview(1, now.Add(-1*time.Hour), "user1", "APPROVED"),
+				ghReview(1, now, "user1", "COMMENTED"),
+			},
+			want: 1,
+		},
+		"dismissed approval": {
+			events: []*btypes.ChangesetEvent{
+				ghReview(1, now, "user1", "APPROVED"),
+				ghReview(1, now.Add(-1*time.Hour), "user2", "DISMISSED"),
+				ghReview(1, now.Add(1*time.Hour), "user1", "DISMISSED"),
+			},
+			want: 0,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if have := CountApprovals(tc.events); have != tc.want {
+				t.Errorf("unexpected approvals: have=%d want=%d", have, tc.want)
+			}
+		})
+	}
+}
+
 func setDeletedAt(c *btypes.Changeset, deletedAt time.Time) *btypes.Changeset {
 	c.ExternalDeletedAt = deletedAt
 	return c
@@ -0,0 +1,155 @@
+package store
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// batchChangeAutoMergePolicyInsertColumns is the list of
+// batch_change_auto_merge_policies columns that are modified in
+// UpsertBatchChangeAutoMergePolicy.
+var batchChangeAutoMergePolicyInsertColumns = SQLColumns{
+	"batch_change_id",
+	"required_approvals",
+	"require_passing_checks",
+	"merge_method",
+	"maintenance_window_days",
+	"maintenance_window_start",
+	"maintenance_window_end",
+	"created_at",
+	"updated_at",
+}
+
+// batchChangeAutoMergePolicyColumns are used by the auto-merge policy related
+// Store methods to query and create policies.
+var batchChangeAutoMergePolicyColumns = SQLColumns{
+	"batch_change_auto_merge_policies.batch_change_id",
+	"batch_change_auto_merge_policies.required_approvals",
+	"batch_change_auto_merge_policies.require_passing_checks",
+	"batch_change_auto_merge_policies.merge_method",
+	"batch_change_auto_merge_policies.maintenance_window_days",
+	"batch_change_auto_merge_policies.maintenance_window_start",
+	"batch_change_auto_merge_policies.maintenance_window_end",
+	"batch_change_auto_merge_policies.created_at",
+	"batch_change_auto_merge_policies.updated_at",
+}
+
+// UpsertBatchChangeAutoMergePolicy creates or replaces the auto-merge policy
+// of the batch change the given policy belongs to.
+func (s *Store) UpsertBatchChangeAutoMergePolicy(ctx context.Context, p *btypes.BatchChangeAutoMergePolicy) (err error) {
+	ctx, _, endObservation := s.operations.upsertBatchChangeAutoMergePolicy.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("BatchChangeID", int(p.BatchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if p.CreatedAt.IsZero() {
+		p.CreatedAt = s.now()
+	}
+	p.UpdatedAt = s.now()
+
+	if p.MergeMethod == "" {
+		p.MergeMethod = btypes.AutoMergeMethodMerge
+	}
+	if p.MaintenanceWindowDays == nil {
+		p.MaintenanceWindowDays = []string{}
+	}
+
+	q := sqlf.Sprintf(
+		upsertBatchChangeAutoMergePolicyQueryFmtstr,
+		sqlf.Join(batchChangeAutoMergePolicyInsertColumns.ToSqlf(), ", "),
+		p.BatchChangeID,
+		p.RequiredApprovals,
+		p.RequirePassingChecks,
+		p.MergeMethod,
+		pq.Array(p.MaintenanceWindowDays),
+		dbutil.NullStringColumn(p.MaintenanceWindowStart),
+		dbutil.NullStringColumn(p.MaintenanceWindowEnd),
+		p.CreatedAt,
+		p.UpdatedAt,
+		sqlf.Join(batchChangeAutoMergePolicyColumns.ToSqlf(), ", "),
+	)
+
+	return s.query(ctx, q, func(sc dbutil.Scanner) error { return scanBatchChangeAutoMergePolicy(p, sc) })
+}
+
+var upsertBatchChangeAutoMergePolicyQueryFmtstr = `
+INSERT INTO batch_change_auto_merge_policies (%s)
+VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s)
+ON CONFLICT (batch_change_id) DO UPDATE SET
+	required_approvals = excluded.required_approvals,
+	require_passing_checks = excluded.require_passing_checks,
+	merge_method = excluded.merge_method,
+	maintenance_window_days = excluded.maintenance_window_days,
+	maintenance_window_start = excluded.maintenance_window_start,
+	maintenance_window_end = excluded.maintenance_window_end,
+	updated_at = excluded.updated_at
+RETURNING %s
+`
+
+// GetBatchChangeAutoMergePolicy returns the auto-merge policy of the given
+// batch change. ErrNoResults is returned if the batch change has no policy.
+func (s *Store) GetBatchChangeAutoMergePolicy(ctx context.Context, batchChangeID int64) (p *btypes.BatchChangeAutoMergePolicy, err error) {
+	ctx, _, endObservation := s.operations.getBatchChangeAutoMergePolicy.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("BatchChangeID", int(batchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		getBatchChangeAutoMergePolicyQueryFmtstr,
+		sqlf.Join(batchChangeAutoMergePolicyColumns.ToSqlf(), ", "),
+		batchChangeID,
+	)
+
+	var policy btypes.BatchChangeAutoMergePolicy
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error { return scanBatchChangeAutoMergePolicy(&policy, sc) })
+	if err != nil {
+		return nil, err
+	}
+
+	if policy.BatchChangeID == 0 {
+		return nil, ErrNoResults
+	}
+
+	return &policy, nil
+}
+
+var getBatchChangeAutoMergePolicyQueryFmtstr = `
+SELECT %s FROM batch_change_auto_merge_policies
+WHERE batch_change_id = %s
+`
+
+// DeleteBatchChangeAutoMergePolicy deletes the auto-merge policy of the given
+// batch change, if it has one.
+func (s *Store) DeleteBatchChangeAutoMergePolicy(ctx context.Context, batchChangeID int64) (err error) {
+	ctx, _, endObservation := s.operations.deleteBatchChangeAutoMergePolicy.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("BatchChangeID", int(batchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return s.Store.Exec(ctx, sqlf.Sprintf(deleteBatchChangeAutoMergePolicyQueryFmtstr, batchChangeID))
+}
+
+var deleteBatchChangeAutoMergePolicyQueryFmtstr = `
+DELETE FROM batch_change_auto_merge_policies WHERE batch_change_id = %s
+`
+
+func scanBatchChangeAutoMergePolicy(p *btypes.BatchChangeAutoMergePolicy, s dbutil.Scanner) error {
+	return s.Scan(
+		&p.BatchChangeID,
+		&p.RequiredApprovals,
+		&p.RequirePassingChecks,
+		&p.MergeMethod,
+		pq.Array(&p.MaintenanceWindowDays),
+		&dbutil.NullString{S: &p.MaintenanceWindowStart},
+		&dbutil.NullString{S: &p.MaintenanceWindowEnd},
+		&p.CreatedAt,
+		&p.UpdatedAt,
+	)
+}
@@ -0,0 +1,70 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bt "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+)
+
+func testStoreBatchChangeAutoMergePolicies(t *testing.T, ctx context.Context, s *Store, clock bt.Clock) {
+	user := bt.CreateTestUser(t, s.DatabaseDB(), false)
+
+	bc := &btypes.BatchChange{
+		Name:            "auto-merge",
+		BatchSpecID:     4242,
+		NamespaceUserID: user.ID,
+		CreatorID:       user.ID,
+		LastApplierID:   user.ID,
+		LastAppliedAt:   clock.Now(),
+	}
+	require.NoError(t, s.CreateBatchChange(ctx, bc))
+
+	t.Run("GetBatchChangeAutoMergePolicy without policy", func(t *testing.T) {
+		_, err := s.GetBatchChangeAutoMergePolicy(ctx, bc.ID)
+		assert.Equal(t, ErrNoResults, err)
+	})
+
+	policy := &btypes.BatchChangeAutoMergePolicy{
+		BatchChangeID:        bc.ID,
+		RequiredApprovals:    2,
+		RequirePassingChecks: true,
+	}
+
+	t.Run("UpsertBatchChangeAutoMergePolicy", func(t *testing.T) {
+		require.NoError(t, s.UpsertBatchChangeAutoMergePolicy(ctx, policy))
+		assert.Equal(t, btypes.AutoMergeMethodMerge, policy.MergeMethod)
+		assert.Equal(t, []string{}, policy.MaintenanceWindowDays)
+		assert.Equal(t, clock.Now(), policy.CreatedAt)
+
+		clock.Add(time.Minute)
+		policy.MergeMethod = btypes.AutoMergeMethodSquash
+		policy.MaintenanceWindowDays = []string{"saturday", "sunday"}
+		policy.MaintenanceWindowStart = "01:00"
+		policy.MaintenanceWindowEnd = "05:00"
+		require.NoError(t, s.UpsertBatchChangeAutoMergePolicy(ctx, policy))
+		assert.Equal(t, clock.Now(), policy.UpdatedAt)
+		assert.NotEqual(t, policy.CreatedAt, policy.UpdatedAt)
+	})
+
+	t.Run("GetBatchChangeAutoMergePolicy", func(t *testing.T) {
+		have, err := s.GetBatchChangeAutoMergePolicy(ctx, bc.ID)
+		require.NoError(t, err)
+		assert.Equal(t, policy, have)
+	})
+
+	t.Run("DeleteBatchChangeAutoMergePolicy", func(t *testing.T) {
+		require.NoError(t, s.DeleteBatchChangeAutoMergePolicy(ctx, bc.ID))
+
+		_, err := s.GetBatchChangeAutoMergePolicy(ctx, bc.ID)
+		assert.Equal(t, ErrNoResults, err)
+
+		// Deleting a policy that doesn't exist is not an error.
+		require.NoError(t, s.DeleteBatchChangeAutoMergePolicy(ctx, bc.ID))
+	})
+}
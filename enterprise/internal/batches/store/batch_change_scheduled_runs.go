@@ -0,0 +1,221 @@
+package store
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"go.opentelemetry.io/otel/attribute"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// batchChangeScheduledRunInsertColumns is the list of
+// batch_change_scheduled_runs columns that are modified in
+// CreateBatchChangeScheduledRun and UpdateBatchChangeScheduledRun.
+var batchChangeScheduledRunInsertColumns = SQLColumns{
+	"batch_change_id",
+	"batch_spec_id",
+	"state",
+	"failure_message",
+	"finished_at",
+	"created_at",
+	"updated_at",
+}
+
+// batchChangeScheduledRunColumns are used by the scheduled run related Store
+// methods to query and create scheduled runs.
+var batchChangeScheduledRunColumns = SQLColumns{
+	"batch_change_scheduled_runs.id",
+	"batch_change_scheduled_runs.batch_change_id",
+	"batch_change_scheduled_runs.batch_spec_id",
+	"batch_change_scheduled_runs.state",
+	"batch_change_scheduled_runs.failure_message",
+	"batch_change_scheduled_runs.finished_at",
+	"batch_change_scheduled_runs.created_at",
+	"batch_change_scheduled_runs.updated_at",
+}
+
+// CreateBatchChangeScheduledRun creates the given scheduled run.
+func (s *Store) CreateBatchChangeScheduledRun(ctx context.Context, r *btypes.BatchChangeScheduledRun) (err error) {
+	ctx, _, endObservation := s.operations.createBatchChangeScheduledRun.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("BatchChangeID", int(r.BatchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if r.CreatedAt.IsZero() {
+		r.CreatedAt = s.now()
+	}
+
+	if r.UpdatedAt.IsZero() {
+		r.UpdatedAt = r.CreatedAt
+	}
+
+	if r.State == "" {
+		r.State = btypes.BatchChangeScheduledRunStateResolving
+	}
+
+	q := sqlf.Sprintf(
+		createBatchChangeScheduledRunQueryFmtstr,
+		sqlf.Join(batchChangeScheduledRunInsertColumns.ToSqlf(), ", "),
+		r.BatchChangeID,
+		dbutil.NullInt64Column(r.BatchSpecID),
+		r.State,
+		dbutil.NullStringColumn(r.FailureMessage),
+		dbutil.NullTimeColumn(r.FinishedAt),
+		r.CreatedAt,
+		r.UpdatedAt,
+		sqlf.Join(batchChangeScheduledRunColumns.ToSqlf(), ", "),
+	)
+
+	return s.query(ctx, q, func(sc dbutil.Scanner) error { return scanBatchChangeScheduledRun(r, sc) })
+}
+
+var createBatchChangeScheduledRunQueryFmtstr = `
+INSERT INTO batch_change_scheduled_runs (%s)
+VALUES (%s, %s, %s, %s, %s, %s, %s)
+RETURNING %s
+`
+
+// UpdateBatchChangeScheduledRun updates the given scheduled run.
+func (s *Store) UpdateBatchChangeScheduledRun(ctx context.Context, r *btypes.BatchChangeScheduledRun) (err error) {
+	ctx, _, endObservation := s.operations.updateBatchChangeScheduledRun.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("ID", int(r.ID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	r.UpdatedAt = s.now()
+
+	q := sqlf.Sprintf(
+		updateBatchChangeScheduledRunQueryFmtstr,
+		sqlf.Join(batchChangeScheduledRunInsertColumns.ToSqlf(), ", "),
+		r.BatchChangeID,
+		dbutil.NullInt64Column(r.BatchSpecID),
+		r.State,
+		dbutil.NullStringColumn(r.FailureMessage),
+		dbutil.NullTimeColumn(r.FinishedAt),
+		r.CreatedAt,
+		r.UpdatedAt,
+		r.ID,
+		sqlf.Join(batchChangeScheduledRunColumns.ToSqlf(), ", "),
+	)
+
+	return s.query(ctx, q, func(sc dbutil.Scanner) error { return scanBatchChangeScheduledRun(r, sc) })
+}
+
+var updateBatchChangeScheduledRunQueryFmtstr = `
+UPDATE batch_change_scheduled_runs
+SET (%s) = (%s, %s, %s, %s, %s, %s, %s)
+WHERE id = %s
+RETURNING %s
+`
+
+// ListBatchChangeScheduledRunsOpts captures the query options needed for
+// listing scheduled runs.
+type ListBatchChangeScheduledRunsOpts struct {
+	LimitOpts
+	Cursor int64
+
+	BatchChangeID int64
+	States        []btypes.BatchChangeScheduledRunState
+}
+
+// ListBatchChangeScheduledRuns lists scheduled runs with the given filters,
+// newest first.
+func (s *Store) ListBatchChangeScheduledRuns(ctx context.Context, opts ListBatchChangeScheduledRunsOpts) (rs []*btypes.BatchChangeScheduledRun, next int64, err error) {
+	ctx, _, endObservation := s.operations.listBatchChangeScheduledRuns.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("BatchChangeID", int(opts.BatchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		listBatchChangeScheduledRunsQueryFmtstr+opts.LimitOpts.ToDB(),
+		sqlf.Join(batchChangeScheduledRunColumns.ToSqlf(), ", "),
+		batchChangeScheduledRunsConds(opts.BatchChangeID, opts.States, opts.Cursor),
+	)
+
+	rs = make([]*btypes.BatchChangeScheduledRun, 0, opts.DBLimit())
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		var r btypes.BatchChangeScheduledRun
+		if err := scanBatchChangeScheduledRun(&r, sc); err != nil {
+			return err
+		}
+		rs = append(rs, &r)
+		return nil
+	})
+
+	if opts.Limit != 0 && len(rs) == opts.DBLimit() {
+		next = rs[len(rs)-1].ID
+		rs = rs[:len(rs)-1]
+	}
+
+	return rs, next, err
+}
+
+var listBatchChangeScheduledRunsQueryFmtstr = `
+SELECT %s FROM batch_change_scheduled_runs
+WHERE %s
+ORDER BY id DESC
+`
+
+// CountBatchChangeScheduledRunsOpts captures the query options needed for
+// counting scheduled runs.
+type CountBatchChangeScheduledRunsOpts struct {
+	BatchChangeID int64
+	States        []btypes.BatchChangeScheduledRunState
+}
+
+// CountBatchChangeScheduledRuns returns the number of scheduled runs matching
+// the given filters.
+func (s *Store) CountBatchChangeScheduledRuns(ctx context.Context, opts CountBatchChangeScheduledRunsOpts) (count int, err error) {
+	ctx, _, endObservation := s.operations.countBatchChangeScheduledRuns.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("BatchChangeID", int(opts.BatchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return s.queryCount(ctx, sqlf.Sprintf(
+		countBatchChangeScheduledRunsQueryFmtstr,
+		batchChangeScheduledRunsConds(opts.BatchChangeID, opts.States, 0),
+	))
+}
+
+var countBatchChangeScheduledRunsQueryFmtstr = `
+SELECT COUNT(*) FROM batch_change_scheduled_runs
+WHERE %s
+`
+
+func batchChangeScheduledRunsConds(batchChangeID int64, states []btypes.BatchChangeScheduledRunState, cursor int64) *sqlf.Query {
+	preds := []*sqlf.Query{sqlf.Sprintf("TRUE")}
+
+	if batchChangeID != 0 {
+		preds = append(preds, sqlf.Sprintf("batch_change_scheduled_runs.batch_change_id = %s", batchChangeID))
+	}
+
+	if len(states) > 0 {
+		stateQueries := make([]*sqlf.Query, 0, len(states))
+		for _, state := range states {
+			stateQueries = append(stateQueries, sqlf.Sprintf("%s", state))
+		}
+		preds = append(preds, sqlf.Sprintf("batch_change_scheduled_runs.state IN (%s)", sqlf.Join(stateQueries, ", ")))
+	}
+
+	if cursor != 0 {
+		preds = append(preds, sqlf.Sprintf("batch_change_scheduled_runs.id <= %s", cursor))
+	}
+
+	return sqlf.Join(preds, "\n AND ")
+}
+
+func scanBatchChangeScheduledRun(r *btypes.BatchChangeScheduledRun, s dbutil.Scanner) error {
+	return s.Scan(
+		&r.ID,
+		&r.BatchChangeID,
+		&dbutil.NullInt64{N: &r.BatchSpecID},
+		&r.State,
+		&dbutil.NullString{S: &r.FailureMessage},
+		&dbutil.NullTime{Time: &r.FinishedAt},
+		&r.CreatedAt,
+		&r.UpdatedAt,
+	)
+}
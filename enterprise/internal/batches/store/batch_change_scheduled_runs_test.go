@@ -0,0 +1,129 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bt "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+)
+
+func testStoreBatchChangeScheduledRuns(t *testing.T, ctx context.Context, s *Store, clock bt.Clock) {
+	user := bt.CreateTestUser(t, s.DatabaseDB(), false)
+
+	bcs := make([]*btypes.BatchChange, 0, 4)
+	for i := 0; i < cap(bcs); i++ {
+		bc := &btypes.BatchChange{
+			Name:            "scheduled-" + string(rune('a'+i)),
+			BatchSpecID:     4242 + int64(i),
+			NamespaceUserID: user.ID,
+			CreatorID:       user.ID,
+			LastApplierID:   user.ID,
+			LastAppliedAt:   clock.Now(),
+		}
+		require.NoError(t, s.CreateBatchChange(ctx, bc))
+		bcs = append(bcs, bc)
+	}
+
+	// 0: due
+	// 1: due, but paused
+	// 2: not due yet
+	// 3: due, but has a run in progress
+	for i, bc := range bcs {
+		bc.Schedule = "@daily"
+		bc.NextScheduledRunAt = clock.Now().Add(-time.Minute)
+		switch i {
+		case 1:
+			bc.SchedulePaused = true
+		case 2:
+			bc.NextScheduledRunAt = clock.Now().Add(time.Hour)
+		}
+	}
+
+	t.Run("UpdateBatchChangeSchedule", func(t *testing.T) {
+		for _, bc := range bcs {
+			want := bc.Clone()
+			require.NoError(t, s.UpdateBatchChangeSchedule(ctx, bc))
+
+			want.UpdatedAt = clock.Now()
+			assert.Equal(t, want, bc)
+
+			have, err := s.GetBatchChange(ctx, GetBatchChangeOpts{ID: bc.ID})
+			require.NoError(t, err)
+			assert.Equal(t, want, have)
+		}
+	})
+
+	runs := make([]*btypes.BatchChangeScheduledRun, 0, 3)
+
+	t.Run("CreateBatchChangeScheduledRun", func(t *testing.T) {
+		for _, bc := range []*btypes.BatchChange{bcs[0], bcs[3], bcs[3]} {
+			run := &btypes.BatchChangeScheduledRun{BatchChangeID: bc.ID}
+			require.NoError(t, s.CreateBatchChangeScheduledRun(ctx, run))
+
+			assert.NotZero(t, run.ID)
+			assert.Equal(t, btypes.BatchChangeScheduledRunStateResolving, run.State)
+			assert.Equal(t, clock.Now(), run.CreatedAt)
+			runs = append(runs, run)
+		}
+	})
+
+	t.Run("UpdateBatchChangeScheduledRun", func(t *testing.T) {
+		// The run of the first batch change, and the first run of the fourth,
+		// are finished.
+		for _, run := range runs[:2] {
+			run.BatchSpecID = 4343
+			run.State = btypes.BatchChangeScheduledRunStateFailed
+			run.FailureMessage = "oh no"
+			run.FinishedAt = clock.Now()
+
+			want := *run
+			require.NoError(t, s.UpdateBatchChangeScheduledRun(ctx, run))
+			assert.Equal(t, want, *run)
+		}
+	})
+
+	t.Run("ListBatchChangesDueForScheduledRun", func(t *testing.T) {
+		have, err := s.ListBatchChangesDueForScheduledRun(ctx, clock.Now(), 10)
+		require.NoError(t, err)
+		assert.Equal(t, []*btypes.BatchChange{bcs[0]}, have)
+
+		have, err = s.ListBatchChangesDueForScheduledRun(ctx, clock.Now().Add(2*time.Hour), 10)
+		require.NoError(t, err)
+		assert.Equal(t, []*btypes.BatchChange{bcs[0], bcs[2]}, have)
+	})
+
+	t.Run("ListBatchChangeScheduledRuns", func(t *testing.T) {
+		have, next, err := s.ListBatchChangeScheduledRuns(ctx, ListBatchChangeScheduledRunsOpts{BatchChangeID: bcs[3].ID})
+		require.NoError(t, err)
+		assert.Zero(t, next)
+		assert.Equal(t, []*btypes.BatchChangeScheduledRun{runs[2], runs[1]}, have)
+
+		have, next, err = s.ListBatchChangeScheduledRuns(ctx, ListBatchChangeScheduledRunsOpts{LimitOpts: LimitOpts{Limit: 1}})
+		require.NoError(t, err)
+		assert.Equal(t, runs[1].ID, next)
+		assert.Equal(t, []*btypes.BatchChangeScheduledRun{runs[2]}, have)
+
+		have, _, err = s.ListBatchChangeScheduledRuns(ctx, ListBatchChangeScheduledRunsOpts{
+			States: []btypes.BatchChangeScheduledRunState{btypes.BatchChangeScheduledRunStateResolving},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []*btypes.BatchChangeScheduledRun{runs[2]}, have)
+	})
+
+	t.Run("CountBatchChangeScheduledRuns", func(t *testing.T) {
+		count, err := s.CountBatchChangeScheduledRuns(ctx, CountBatchChangeScheduledRunsOpts{BatchChangeID: bcs[3].ID})
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+
+		count, err = s.CountBatchChangeScheduledRuns(ctx, CountBatchChangeScheduledRunsOpts{
+			States: []btypes.BatchChangeScheduledRunState{btypes.BatchChangeScheduledRunStateFailed},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+}
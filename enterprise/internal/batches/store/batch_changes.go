@@ -37,6 +37,9 @@ var batchChangeColumns = []*sqlf.Query{
 	sqlf.Sprintf("batch_changes.updated_at"),
 	sqlf.Sprintf("batch_changes.closed_at"),
 	sqlf.Sprintf("batch_changes.batch_spec_id"),
+	sqlf.Sprintf("batch_changes.schedule"),
+	sqlf.Sprintf("batch_changes.schedule_paused"),
+	sqlf.Sprintf("batch_changes.next_scheduled_run_at"),
 }
 
 // batchChangeInsertColumns is the list of batch changes columns that are
@@ -237,6 +240,87 @@ func (s *Store) updateBatchChangeQuery(c *btypes.BatchChange) *sqlf.Query {
 	)
 }
 
+// UpdateBatchChangeSchedule updates the schedule of the given batch change.
+// Unlike UpdateBatchChange, only the schedule columns are written, so that
+// applying a batch spec doesn't reset the schedule.
+func (s *Store) UpdateBatchChangeSchedule(ctx context.Context, c *btypes.BatchChange) (err error) {
+	ctx, _, endObservation := s.operations.updateBatchChangeSchedule.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("ID", int(c.ID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	c.UpdatedAt = s.now()
+
+	q := sqlf.Sprintf(
+		updateBatchChangeScheduleQueryFmtstr,
+		dbutil.NullStringColumn(c.Schedule),
+		c.SchedulePaused,
+		dbutil.NullTimeColumn(c.NextScheduledRunAt),
+		c.UpdatedAt,
+		c.ID,
+		sqlf.Join(batchChangeColumns, ", "),
+	)
+
+	return s.query(ctx, q, func(sc dbutil.Scanner) (err error) { return scanBatchChange(c, sc) })
+}
+
+var updateBatchChangeScheduleQueryFmtstr = `
+UPDATE batch_changes
+SET (schedule, schedule_paused, next_scheduled_run_at, updated_at) = (%s, %s, %s, %s)
+WHERE id = %s
+RETURNING %s
+`
+
+// ListBatchChangesDueForScheduledRun lists open batch changes with an active
+// schedule whose next run is due at the given time, and which don't have a
+// scheduled run in progress already.
+func (s *Store) ListBatchChangesDueForScheduledRun(ctx context.Context, now time.Time, limit int) (cs []*btypes.BatchChange, err error) {
+	ctx, _, endObservation := s.operations.listBatchChangesDueForScheduledRun.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		listBatchChangesDueForScheduledRunQueryFmtstr,
+		sqlf.Join(batchChangeColumns, ", "),
+		now,
+		btypes.BatchChangeScheduledRunStateResolving,
+		btypes.BatchChangeScheduledRunStateExecuting,
+		limit,
+	)
+
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		var c btypes.BatchChange
+		if err := scanBatchChange(&c, sc); err != nil {
+			return err
+		}
+		cs = append(cs, &c)
+		return nil
+	})
+
+	return cs, err
+}
+
+var listBatchChangesDueForScheduledRunQueryFmtstr = `
+SELECT %s FROM batch_changes
+LEFT JOIN users namespace_user ON batch_changes.namespace_user_id = namespace_user.id
+LEFT JOIN orgs namespace_org ON batch_changes.namespace_org_id = namespace_org.id
+WHERE
+	batch_changes.schedule IS NOT NULL AND
+	NOT batch_changes.schedule_paused AND
+	batch_changes.next_scheduled_run_at <= %s AND
+	batch_changes.closed_at IS NULL AND
+	batch_changes.last_applied_at IS NOT NULL AND
+	namespace_user.deleted_at IS NULL AND
+	namespace_org.deleted_at IS NULL AND
+	NOT EXISTS (
+		SELECT 1 FROM batch_change_scheduled_runs
+		WHERE
+			batch_change_scheduled_runs.batch_change_id = batch_changes.id AND
+			batch_change_scheduled_runs.state IN (%s, %s)
+	)
+ORDER BY batch_changes.next_scheduled_run_at ASC, batch_changes.id ASC
+LIMIT %s
+`
+
 // DeleteBatchChange deletes the batch change with the given ID.
 func (s *Store) DeleteBatchChange(ctx context.Context, id int64) (err error) {
 	ctx, _, endObservation := s.operations.deleteBatchChange.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
@@ -710,6 +794,9 @@ func scanBatchChange(c *btypes.BatchChange, s dbutil.Scanner) error {
 		&c.UpdatedAt,
 		&dbutil.NullTime{Time: &c.ClosedAt},
 		&c.BatchSpecID,
+		&dbutil.NullString{S: &c.Schedule},
+		&c.SchedulePaused,
+		&dbutil.NullTime{Time: &c.NextScheduledRunAt},
 	)
 }
 
@@ -0,0 +1,215 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"go.opentelemetry.io/otel/attribute"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// snapshotDateFormat is the format in which snapshot dates are passed to the
+// database, so that the date doesn't depend on the time zone of the session.
+const snapshotDateFormat = "2006-01-02"
+
+// CreateChangesetCountSnapshots records the number of published changesets of
+// every open batch change in each repository, by state, as the snapshot of the
+// day (in UTC) that t falls on. The snapshots previously recorded for that day
+// are replaced, so the last snapshot of a day reflects the state at its end.
+func (s *Store) CreateChangesetCountSnapshots(ctx context.Context, t time.Time) (err error) {
+	date := t.UTC().Format(snapshotDateFormat)
+	ctx, _, endObservation := s.operations.createChangesetCountSnapshots.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("date", date),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	tx, err := s.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	if err := tx.Exec(ctx, sqlf.Sprintf(deleteChangesetCountSnapshotsQueryFmtstr, date)); err != nil {
+		return err
+	}
+	return tx.Exec(ctx, sqlf.Sprintf(createChangesetCountSnapshotsQueryFmtstr, date))
+}
+
+var deleteChangesetCountSnapshotsQueryFmtstr = `
+DELETE FROM batch_changes_changeset_count_snapshots
+WHERE
+	snapshot_date = %s::date AND
+	batch_change_id IN (SELECT id FROM batch_changes WHERE closed_at IS NULL)
+`
+
+// The states are counted the same way as in state.CalcCounts, so that the
+// snapshots line up with the burndown chart of a batch change.
+var createChangesetCountSnapshotsQueryFmtstr = `
+INSERT INTO batch_changes_changeset_count_snapshots (
+	snapshot_date,
+	batch_change_id,
+	repo_id,
+	total,
+	merged,
+	closed,
+	draft,
+	open,
+	open_approved,
+	open_changes_requested,
+	open_pending
+)
+SELECT
+	%s::date,
+	batch_changes.id,
+	changesets.repo_id,
+	COUNT(*),
+	COUNT(*) FILTER (WHERE changesets.external_state = 'MERGED'),
+	COUNT(*) FILTER (WHERE changesets.external_state IN ('CLOSED', 'READONLY')),
+	COUNT(*) FILTER (WHERE changesets.external_state = 'DRAFT'),
+	COUNT(*) FILTER (WHERE changesets.external_state = 'OPEN'),
+	COUNT(*) FILTER (WHERE changesets.external_state = 'OPEN' AND changesets.external_review_state = 'APPROVED'),
+	COUNT(*) FILTER (WHERE changesets.external_state = 'OPEN' AND changesets.external_review_state = 'CHANGES_REQUESTED'),
+	COUNT(*) FILTER (WHERE changesets.external_state = 'OPEN' AND changesets.external_review_state = 'PENDING')
+FROM batch_changes
+JOIN changesets ON changesets.batch_change_ids ? batch_changes.id::text
+JOIN repo ON repo.id = changesets.repo_id
+WHERE
+	batch_changes.closed_at IS NULL AND
+	repo.deleted_at IS NULL AND
+	changesets.publication_state = 'PUBLISHED' AND
+	NOT COALESCE((changesets.batch_change_ids->(batch_changes.id::text)->>'isArchived')::bool, false) AND
+	NOT COALESCE((changesets.batch_change_ids->(batch_changes.id::text)->>'archive')::bool, false)
+GROUP BY batch_changes.id, changesets.repo_id
+`
+
+// ListChangesetCountSnapshotsOpts captures the query options needed for
+// listing changeset count snapshots.
+type ListChangesetCountSnapshotsOpts struct {
+	// BatchChangeID limits the snapshots to those of the given batch change.
+	BatchChangeID int64
+	// From and To limit the snapshots to those taken on the days between the
+	// two, inclusively. Zero values don't limit the range.
+	From time.Time
+	To   time.Time
+
+	GroupBy btypes.ChangesetCountSnapshotGrouping
+	// RepoMetadataKey is the key of the repository metadata whose value the
+	// snapshots are grouped by, if GroupBy is
+	// ChangesetCountSnapshotGroupingRepoMetadata.
+	RepoMetadataKey string
+}
+
+// ListChangesetCountSnapshots lists the changeset count snapshots matching the
+// given options, summed up per group and day. The snapshots of repositories
+// the user in ctx can't access are left out. Snapshots are sorted by group and
+// date.
+func (s *Store) ListChangesetCountSnapshots(ctx context.Context, opts ListChangesetCountSnapshotsOpts) (snapshots []*btypes.ChangesetCountSnapshot, err error) {
+	ctx, _, endObservation := s.operations.listChangesetCountSnapshots.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(opts.BatchChangeID)),
+		attribute.String("groupBy", string(opts.GroupBy)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	authzConds, err := database.AuthzQueryConds(ctx, database.NewDBWith(s.logger, s))
+	if err != nil {
+		return nil, errors.Wrap(err, "ListChangesetCountSnapshots generating authz query conds")
+	}
+
+	q, err := listChangesetCountSnapshotsQuery(opts, authzConds)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		var c btypes.ChangesetCountSnapshot
+		if err := sc.Scan(
+			&c.Group,
+			&c.Date,
+			&c.Total,
+			&c.Merged,
+			&c.Closed,
+			&c.Draft,
+			&c.Open,
+			&c.OpenApproved,
+			&c.OpenChangesRequested,
+			&c.OpenPending,
+		); err != nil {
+			return err
+		}
+		snapshots = append(snapshots, &c)
+		return nil
+	})
+	return snapshots, err
+}
+
+var listChangesetCountSnapshotsQueryFmtstr = `
+SELECT
+	%s AS grp,
+	batch_changes_changeset_count_snapshots.snapshot_date,
+	SUM(batch_changes_changeset_count_snapshots.total),
+	SUM(batch_changes_changeset_count_snapshots.merged),
+	SUM(batch_changes_changeset_count_snapshots.closed),
+	SUM(batch_changes_changeset_count_snapshots.draft),
+	SUM(batch_changes_changeset_count_snapshots.open),
+	SUM(batch_changes_changeset_count_snapshots.open_approved),
+	SUM(batch_changes_changeset_count_snapshots.open_changes_requested),
+	SUM(batch_changes_changeset_count_snapshots.open_pending)
+FROM batch_changes_changeset_count_snapshots
+JOIN batch_changes ON batch_changes.id = batch_changes_changeset_count_snapshots.batch_change_id
+LEFT JOIN users namespace_user ON namespace_user.id = batch_changes.namespace_user_id
+LEFT JOIN orgs namespace_org ON namespace_org.id = batch_changes.namespace_org_id
+JOIN repo ON repo.id = batch_changes_changeset_count_snapshots.repo_id
+%s
+WHERE %s
+GROUP BY 1, 2
+ORDER BY 1 ASC, 2 ASC
+`
+
+func listChangesetCountSnapshotsQuery(opts ListChangesetCountSnapshotsOpts, authzConds *sqlf.Query) (*sqlf.Query, error) {
+	preds := []*sqlf.Query{
+		sqlf.Sprintf("repo.deleted_at IS NULL"),
+		authzConds,
+	}
+	if opts.BatchChangeID != 0 {
+		preds = append(preds, sqlf.Sprintf("batch_changes_changeset_count_snapshots.batch_change_id = %s", opts.BatchChangeID))
+	}
+	if !opts.From.IsZero() {
+		preds = append(preds, sqlf.Sprintf("batch_changes_changeset_count_snapshots.snapshot_date >= %s::date", opts.From.UTC().Format(snapshotDateFormat)))
+	}
+	if !opts.To.IsZero() {
+		preds = append(preds, sqlf.Sprintf("batch_changes_changeset_count_snapshots.snapshot_date <= %s::date", opts.To.UTC().Format(snapshotDateFormat)))
+	}
+
+	group, joins := sqlf.Sprintf(""), sqlf.Sprintf("")
+	switch opts.GroupBy {
+	case btypes.ChangesetCountSnapshotGroupingBatchChange, "":
+		group = sqlf.Sprintf("COALESCE(namespace_user.username, namespace_org.name) || '/' || batch_changes.name")
+	case btypes.ChangesetCountSnapshotGroupingNamespace:
+		group = sqlf.Sprintf("COALESCE(namespace_user.username, namespace_org.name)")
+	case btypes.ChangesetCountSnapshotGroupingCodeHost:
+		group = sqlf.Sprintf("repo.external_service_id")
+	case btypes.ChangesetCountSnapshotGroupingRepoMetadata:
+		if opts.RepoMetadataKey == "" {
+			return nil, errors.New("grouping by repository metadata requires a key")
+		}
+		group = sqlf.Sprintf("COALESCE(repo_kvps.value, '')")
+		joins = sqlf.Sprintf("LEFT JOIN repo_kvps ON repo_kvps.repo_id = repo.id AND repo_kvps.key = %s", opts.RepoMetadataKey)
+	case btypes.ChangesetCountSnapshotGroupingOwnerTeam:
+		// A repository is owned by the teams assigned to its root directory.
+		// Repositories owned by several teams count towards each of them.
+		group = sqlf.Sprintf("COALESCE(teams.name::text, '')")
+		joins = sqlf.Sprintf(`LEFT JOIN repo_paths ON repo_paths.repo_id = repo.id AND repo_paths.absolute_path = ''
+LEFT JOIN assigned_teams ON assigned_teams.file_path_id = repo_paths.id
+LEFT JOIN teams ON teams.id = assigned_teams.owner_team_id`)
+	default:
+		return nil, errors.Newf("invalid grouping %q", opts.GroupBy)
+	}
+
+	return sqlf.Sprintf(listChangesetCountSnapshotsQueryFmtstr, group, joins, sqlf.Join(preds, "\n AND ")), nil
+}
@@ -0,0 +1,112 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bt "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+func testStoreChangesetCountSnapshots(t *testing.T, ctx context.Context, s *Store, clock bt.Clock) {
+	user := bt.CreateTestUser(t, s.DatabaseDB(), false)
+	repos, _ := bt.CreateTestRepos(t, ctx, s.DatabaseDB(), 2)
+
+	require.NoError(t, s.DatabaseDB().RepoKVPs().Create(ctx, repos[0].ID, database.KeyValuePair{Key: "team", Value: pointers.Ptr("frontend")}))
+
+	bcs := make([]*btypes.BatchChange, 0, 2)
+	for i := 0; i < cap(bcs); i++ {
+		bc := &btypes.BatchChange{
+			Name:            "burndown-" + string(rune('a'+i)),
+			BatchSpecID:     4242 + int64(i),
+			NamespaceUserID: user.ID,
+			CreatorID:       user.ID,
+			LastApplierID:   user.ID,
+			LastAppliedAt:   clock.Now(),
+		}
+		require.NoError(t, s.CreateBatchChange(ctx, bc))
+		bcs = append(bcs, bc)
+	}
+
+	for _, opts := range []bt.TestChangesetOpts{
+		{Repo: repos[0].ID, BatchChange: bcs[0].ID, PublicationState: btypes.ChangesetPublicationStatePublished, ExternalState: btypes.ChangesetExternalStateOpen, ExternalReviewState: btypes.ChangesetReviewStateApproved},
+		{Repo: repos[0].ID, BatchChange: bcs[0].ID, PublicationState: btypes.ChangesetPublicationStatePublished, ExternalState: btypes.ChangesetExternalStateMerged},
+		{Repo: repos[1].ID, BatchChange: bcs[0].ID, PublicationState: btypes.ChangesetPublicationStatePublished, ExternalState: btypes.ChangesetExternalStateClosed},
+		// Unpublished and archived changesets are not counted.
+		{Repo: repos[1].ID, BatchChange: bcs[0].ID, PublicationState: btypes.ChangesetPublicationStateUnpublished},
+		{Repo: repos[1].ID, BatchChange: bcs[0].ID, PublicationState: btypes.ChangesetPublicationStatePublished, ExternalState: btypes.ChangesetExternalStateOpen, IsArchived: true},
+		{Repo: repos[1].ID, BatchChange: bcs[1].ID, PublicationState: btypes.ChangesetPublicationStatePublished, ExternalState: btypes.ChangesetExternalStateDraft},
+	} {
+		bt.CreateChangeset(t, ctx, s, opts)
+	}
+
+	day := func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	today := day(clock.Now().UTC())
+
+	t.Run("CreateChangesetCountSnapshots", func(t *testing.T) {
+		// Taking the snapshot twice on the same day replaces the first one.
+		require.NoError(t, s.CreateChangesetCountSnapshots(ctx, clock.Now()))
+		require.NoError(t, s.CreateChangesetCountSnapshots(ctx, clock.Now()))
+
+		count, _, err := basestore.ScanFirstInt(s.Query(ctx, sqlf.Sprintf("SELECT COUNT(*) FROM batch_changes_changeset_count_snapshots")))
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+	})
+
+	t.Run("ListChangesetCountSnapshots", func(t *testing.T) {
+		t.Run("by batch change", func(t *testing.T) {
+			have, err := s.ListChangesetCountSnapshots(ctx, ListChangesetCountSnapshotsOpts{
+				GroupBy: btypes.ChangesetCountSnapshotGroupingBatchChange,
+			})
+			require.NoError(t, err)
+			require.Len(t, have, 2)
+			for _, c := range have {
+				c.Date = day(c.Date)
+			}
+
+			assert.Equal(t, []*btypes.ChangesetCountSnapshot{
+				{Group: user.Username + "/burndown-a", Date: today, Total: 3, Merged: 1, Closed: 1, Open: 1, OpenApproved: 1},
+				{Group: user.Username + "/burndown-b", Date: today, Total: 1, Draft: 1},
+			}, have)
+		})
+
+		t.Run("by repository metadata", func(t *testing.T) {
+			have, err := s.ListChangesetCountSnapshots(ctx, ListChangesetCountSnapshotsOpts{
+				BatchChangeID:   bcs[0].ID,
+				GroupBy:         btypes.ChangesetCountSnapshotGroupingRepoMetadata,
+				RepoMetadataKey: "team",
+			})
+			require.NoError(t, err)
+			require.Len(t, have, 2)
+			assert.Equal(t, "", have[0].Group)
+			assert.Equal(t, int32(1), have[0].Total)
+			assert.Equal(t, "frontend", have[1].Group)
+			assert.Equal(t, int32(2), have[1].Total)
+		})
+
+		t.Run("outside of the date range", func(t *testing.T) {
+			have, err := s.ListChangesetCountSnapshots(ctx, ListChangesetCountSnapshotsOpts{
+				From: today.AddDate(0, 0, 1),
+			})
+			require.NoError(t, err)
+			assert.Empty(t, have)
+		})
+
+		t.Run("repository metadata without key", func(t *testing.T) {
+			_, err := s.ListChangesetCountSnapshots(ctx, ListChangesetCountSnapshotsOpts{
+				GroupBy: btypes.ChangesetCountSnapshotGroupingRepoMetadata,
+			})
+			assert.Error(t, err)
+		})
+	})
+}
@@ -45,6 +45,9 @@ var changesetSpecInsertColumns = []string{
 	"commit_author_name",
 	"commit_author_email",
 	"type",
+	"depends_on",
+	"request_owner_reviews",
+	"assign_owners",
 }
 
 // changesetSpecColumns are used by the changeset spec related Store methods to
@@ -72,6 +75,9 @@ var changesetSpecColumns = SQLColumns{
 	"changeset_specs.commit_author_name",
 	"changeset_specs.commit_author_email",
 	"changeset_specs.type",
+	"changeset_specs.depends_on",
+	"changeset_specs.request_owner_reviews",
+	"changeset_specs.assign_owners",
 }
 
 var oneGigabyte = 1000000000
@@ -107,6 +113,10 @@ func (s *Store) CreateChangesetSpec(ctx context.Context, cs ...*btypes.Changeset
 				}
 			}
 
+			if c.DependsOn == nil {
+				c.DependsOn = []string{}
+			}
+
 			// We check if the resulting diff is greater than 1GB, since the limit
 			// for the diff column (which is bytea) is 1GB
 			if len(c.Diff) > oneGigabyte {
@@ -137,6 +147,9 @@ func (s *Store) CreateChangesetSpec(ctx context.Context, cs ...*btypes.Changeset
 				dbutil.NewNullString(c.CommitAuthorName),
 				dbutil.NewNullString(c.CommitAuthorEmail),
 				c.Type,
+				pq.Array(c.DependsOn),
+				c.RequestOwnerReviews,
+				c.AssignOwners,
 			); err != nil {
 				return err
 			}
@@ -439,6 +452,49 @@ func (s *Store) ListChangesetSpecsWithConflictingHeadRef(ctx context.Context, ba
 	return conflicts, err
 }
 
+// ListChangesetSpecDependencies returns the prerequisites declared by the
+// changeset specs of the given batch spec, keyed by the name of the repository
+// of the dependent changeset spec. Only changeset specs with prerequisites are
+// returned.
+func (s *Store) ListChangesetSpecDependencies(ctx context.Context, batchSpecID int64) (deps map[string][]string, err error) {
+	ctx, _, endObservation := s.operations.listChangesetSpecDependencies.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int64("batchSpecID", batchSpecID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(listChangesetSpecDependenciesQueryFmtstr, batchSpecID)
+
+	deps = make(map[string][]string)
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		var name string
+		var dependsOn []string
+		if err := sc.Scan(&name, pq.Array(&dependsOn)); err != nil {
+			return errors.Wrap(err, "scanning changeset spec dependencies")
+		}
+		deps[name] = append(deps[name], dependsOn...)
+		return nil
+	})
+
+	return deps, err
+}
+
+var listChangesetSpecDependenciesQueryFmtstr = `
+SELECT
+	repo.name,
+	changeset_specs.depends_on
+FROM
+	changeset_specs
+JOIN
+	repo ON repo.id = changeset_specs.repo_id
+WHERE
+	changeset_specs.batch_spec_id = %s
+AND
+	cardinality(changeset_specs.depends_on) > 0
+AND
+	repo.deleted_at IS NULL
+ORDER BY changeset_specs.id ASC
+`
+
 // DeleteUnattachedExpiredChangesetSpecs deletes each ChangesetSpec that has not been
 // attached to a BatchSpec within ChangesetSpecTTL.
 func (s *Store) DeleteUnattachedExpiredChangesetSpecs(ctx context.Context) (err error) {
@@ -563,6 +619,9 @@ func scanChangesetSpec(c *btypes.ChangesetSpec, s dbutil.Scanner) error {
 		&dbutil.NullString{S: &c.CommitAuthorName},
 		&dbutil.NullString{S: &c.CommitAuthorEmail},
 		&typ,
+		pq.Array(&c.DependsOn),
+		&c.RequestOwnerReviews,
+		&c.AssignOwners,
 	)
 	if err != nil {
 		return errors.Wrap(err, "scanning changeset spec")
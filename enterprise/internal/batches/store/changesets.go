@@ -23,6 +23,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/search"
 	bbcs "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/emailpatch"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
@@ -78,6 +79,11 @@ var changesetStringColumns = SQLColumns{
 	"syncer_error",
 	"detached_at",
 	"previous_failure_message",
+	"merging",
+	"conflicted",
+	"rebasing",
+	"conflict_check_base_rev",
+	"conflict_check_head_rev",
 }
 
 // ChangesetColumns are used by the changeset related Store methods and by
@@ -123,6 +129,11 @@ var ChangesetColumns = []*sqlf.Query{
 	sqlf.Sprintf("changesets.syncer_error"),
 	sqlf.Sprintf("changesets.detached_at"),
 	sqlf.Sprintf("changesets.previous_failure_message"),
+	sqlf.Sprintf("changesets.merging"),
+	sqlf.Sprintf("changesets.conflicted"),
+	sqlf.Sprintf("changesets.rebasing"),
+	sqlf.Sprintf("changesets.conflict_check_base_rev"),
+	sqlf.Sprintf("changesets.conflict_check_head_rev"),
 }
 
 // changesetInsertColumns is the list of changeset columns that are modified in
@@ -167,6 +178,11 @@ var changesetInsertColumns = []*sqlf.Query{
 	// indexable for searching.
 	sqlf.Sprintf("external_title"),
 	sqlf.Sprintf("previous_failure_message"),
+	sqlf.Sprintf("merging"),
+	sqlf.Sprintf("conflicted"),
+	sqlf.Sprintf("rebasing"),
+	sqlf.Sprintf("conflict_check_base_rev"),
+	sqlf.Sprintf("conflict_check_head_rev"),
 }
 
 // changesetCodeHostStateInsertColumns are the columns that Store.UpdateChangesetCodeHostState uses to update a changeset
@@ -186,6 +202,9 @@ var changesetCodeHostStateInsertColumns = []*sqlf.Query{
 	sqlf.Sprintf("diff_stat_deleted"),
 	sqlf.Sprintf("sync_state"),
 	sqlf.Sprintf("syncer_error"),
+	sqlf.Sprintf("conflicted"),
+	sqlf.Sprintf("conflict_check_base_rev"),
+	sqlf.Sprintf("conflict_check_head_rev"),
 	// We additionally store the result of changeset.Title() in a column, so
 	// the business logic for determining it is in one place and the field is
 	// indexable for searching.
@@ -230,6 +249,11 @@ var changesetInsertStringColumns = []string{
 	"syncer_error",
 	"external_title",
 	"previous_failure_message",
+	"merging",
+	"conflicted",
+	"rebasing",
+	"conflict_check_base_rev",
+	"conflict_check_head_rev",
 }
 
 // temporaryChangesetInsertColumns is the list of column names used by Store.UpdateChangesetsForApply to insert into
@@ -337,6 +361,11 @@ func (s *Store) CreateChangeset(ctx context.Context, cs ...*btypes.Changeset) (e
 				c.SyncErrorMessage,
 				dbutil.NullStringColumn(title),
 				c.PreviousFailureMessage,
+				c.Merging,
+				c.Conflicted,
+				c.Rebasing,
+				dbutil.NullStringColumn(c.ConflictCheckBaseRev),
+				dbutil.NullStringColumn(c.ConflictCheckHeadRev),
 			); err != nil {
 				return err
 			}
@@ -930,6 +959,11 @@ func (s *Store) changesetWriteQuery(q string, includeID bool, c *btypes.Changese
 		c.SyncErrorMessage,
 		dbutil.NullStringColumn(title),
 		c.PreviousFailureMessage,
+		c.Merging,
+		c.Conflicted,
+		c.Rebasing,
+		dbutil.NullStringColumn(c.ConflictCheckBaseRev),
+		dbutil.NullStringColumn(c.ConflictCheckHeadRev),
 	}
 
 	if includeID {
@@ -943,7 +977,7 @@ func (s *Store) changesetWriteQuery(q string, includeID bool, c *btypes.Changese
 
 var updateChangesetQueryFmtstr = `
 UPDATE changesets
-SET (%s) = (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
+SET (%s) = (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
 WHERE id = %s
 RETURNING
   %s
@@ -1185,6 +1219,9 @@ func updateChangesetCodeHostStateQuery(c *btypes.Changeset) (*sqlf.Query, error)
 		c.DiffStatDeleted,
 		syncState,
 		c.SyncErrorMessage,
+		c.Conflicted,
+		dbutil.NullStringColumn(c.ConflictCheckBaseRev),
+		dbutil.NullStringColumn(c.ConflictCheckHeadRev),
 		dbutil.NullStringColumn(title),
 		c.ID,
 		sqlf.Join(ChangesetColumns, ", "),
@@ -1195,7 +1232,7 @@ func updateChangesetCodeHostStateQuery(c *btypes.Changeset) (*sqlf.Query, error)
 
 var updateChangesetCodeHostStateQueryFmtstr = `
 UPDATE changesets
-SET (%s) = (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
+SET (%s) = (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
 WHERE id = %s
 RETURNING
   %s
@@ -1388,6 +1425,292 @@ updated_records AS (
 SELECT COUNT(id) FROM all_matching WHERE all_matching.reconciler_state = %s
 `
 
+// EnqueueChangesetToMerge sets the reconciler state of the given changeset to
+// 'queued' and the Merging boolean to true, so that the reconciler merges it.
+//
+// Changesets that are not fully processed yet are left untouched, so that
+// pending reconciler operations aren't interrupted. In that case, false is
+// returned.
+func (s *Store) EnqueueChangesetToMerge(ctx context.Context, cs *btypes.Changeset) (enqueued bool, err error) {
+	ctx, _, endObservation := s.operations.enqueueChangesetToMerge.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("ID", int(cs.ID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		enqueueChangesetToMergeFmtstr,
+		btypes.ReconcilerStateQueued.ToDB(),
+		s.now(),
+		cs.ID,
+		btypes.ReconcilerStateCompleted.ToDB(),
+		sqlf.Join(ChangesetColumns, ", "),
+	)
+
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		enqueued = true
+		return ScanChangeset(cs, sc)
+	})
+	return enqueued, err
+}
+
+const enqueueChangesetToMergeFmtstr = `
+UPDATE
+	changesets
+SET
+	reconciler_state = %s,
+	failure_message = NULL,
+	num_resets = 0,
+	num_failures = 0,
+	merging = TRUE,
+	updated_at = %s
+WHERE
+	id = %s
+	AND
+	reconciler_state = %s
+RETURNING
+	%s
+`
+
+// EnqueueChangesetToRebase sets the reconciler state of the given changeset to
+// 'queued' and the Rebasing boolean to true, so that the reconciler re-applies
+// its diff to the head of its base branch.
+//
+// Changesets that are not fully processed yet are left untouched, so that
+// pending reconciler operations aren't interrupted. In that case, false is
+// returned.
+func (s *Store) EnqueueChangesetToRebase(ctx context.Context, cs *btypes.Changeset) (enqueued bool, err error) {
+	ctx, _, endObservation := s.operations.enqueueChangesetToRebase.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("ID", int(cs.ID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		enqueueChangesetToRebaseFmtstr,
+		btypes.ReconcilerStateQueued.ToDB(),
+		s.now(),
+		cs.ID,
+		btypes.ReconcilerStateCompleted.ToDB(),
+		sqlf.Join(ChangesetColumns, ", "),
+	)
+
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		enqueued = true
+		return ScanChangeset(cs, sc)
+	})
+	return enqueued, err
+}
+
+const enqueueChangesetToRebaseFmtstr = `
+UPDATE
+	changesets
+SET
+	reconciler_state = %s,
+	failure_message = NULL,
+	num_resets = 0,
+	num_failures = 0,
+	rebasing = TRUE,
+	updated_at = %s
+WHERE
+	id = %s
+	AND
+	reconciler_state = %s
+RETURNING
+	%s
+`
+
+// DeferChangeset puts the given changeset, which is being processed by the
+// reconciler, back into the queue, so that it is processed again no earlier
+// than processAfter. Unlike a failure, deferring a changeset doesn't count
+// against its retries.
+func (s *Store) DeferChangeset(ctx context.Context, cs *btypes.Changeset, processAfter time.Time) (err error) {
+	ctx, _, endObservation := s.operations.deferChangeset.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("ID", int(cs.ID)),
+		attribute.Stringer("processAfter", processAfter),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		deferChangesetFmtstr,
+		btypes.ReconcilerStateQueued.ToDB(),
+		processAfter,
+		cs.ID,
+		btypes.ReconcilerStateProcessing.ToDB(),
+	)
+	if err := s.Exec(ctx, q); err != nil {
+		return err
+	}
+
+	cs.ReconcilerState = btypes.ReconcilerStateQueued
+	cs.ProcessAfter = processAfter
+	return nil
+}
+
+const deferChangesetFmtstr = `
+UPDATE
+	changesets
+SET
+	reconciler_state = %s,
+	started_at = NULL,
+	process_after = %s
+WHERE
+	id = %s
+	AND
+	reconciler_state = %s
+`
+
+// ListReconcilerQueueDepths returns the number of changesets queued for the
+// reconciler, per code host.
+func (s *Store) ListReconcilerQueueDepths(ctx context.Context) (depths []*btypes.CodeHostQueueDepth, err error) {
+	ctx, _, endObservation := s.operations.listReconcilerQueueDepths.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	now := s.now()
+	q := sqlf.Sprintf(
+		listReconcilerQueueDepthsFmtstr,
+		now,
+		now,
+		btypes.ReconcilerStateQueued.ToDB(),
+	)
+
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		var d btypes.CodeHostQueueDepth
+		if err := sc.Scan(
+			&d.ExternalServiceType,
+			&d.ExternalServiceID,
+			&d.Queued,
+			&d.Deferred,
+		); err != nil {
+			return err
+		}
+		depths = append(depths, &d)
+		return nil
+	})
+	return depths, err
+}
+
+const listReconcilerQueueDepthsFmtstr = `
+SELECT
+	repo.external_service_type,
+	repo.external_service_id,
+	COUNT(*) FILTER (WHERE changesets.process_after IS NULL OR changesets.process_after <= %s),
+	COUNT(*) FILTER (WHERE changesets.process_after > %s)
+FROM
+	reconciler_changesets changesets
+JOIN
+	repo ON repo.id = changesets.repo_id
+WHERE
+	changesets.reconciler_state = %s
+GROUP BY
+	repo.external_service_type,
+	repo.external_service_id
+ORDER BY
+	repo.external_service_id
+`
+
+// CountPendingPrerequisites returns the number of changesets that have to be
+// merged before a changeset with the given changeset spec can be published.
+//
+// The prerequisites are the changesets of the changeset specs in the same batch
+// spec whose repositories are listed in spec.DependsOn. Prerequisite changeset
+// specs that are not attached to a changeset yet, for example when the batch
+// spec is only being previewed, count as pending.
+func (s *Store) CountPendingPrerequisites(ctx context.Context, spec *btypes.ChangesetSpec) (count int, err error) {
+	ctx, _, endObservation := s.operations.countPendingPrerequisites.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("changesetSpecID", int(spec.ID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if spec.BatchSpecID == 0 || len(spec.DependsOn) == 0 {
+		return 0, nil
+	}
+
+	q := sqlf.Sprintf(
+		countPendingPrerequisitesFmtstr,
+		spec.BatchSpecID,
+		btypes.ChangesetSpecTypeBranch,
+		pq.Array(spec.DependsOn),
+		btypes.ChangesetExternalStateMerged,
+	)
+	count, _, err = basestore.ScanFirstInt(s.Store.Query(ctx, q))
+	return count, err
+}
+
+const countPendingPrerequisitesFmtstr = `
+SELECT
+	COUNT(*)
+FROM
+	changeset_specs
+JOIN
+	repo ON repo.id = changeset_specs.repo_id
+LEFT JOIN
+	changesets ON changesets.current_spec_id = changeset_specs.id
+WHERE
+	changeset_specs.batch_spec_id = %s
+	AND
+	changeset_specs.type = %s
+	AND
+	repo.name = ANY (%s)
+	AND
+	repo.deleted_at IS NULL
+	AND
+	changesets.external_state IS DISTINCT FROM %s
+`
+
+// EnqueueDependentChangesets enqueues the unpublished changesets that depend on
+// the given changeset, so that the reconciler publishes them if the given
+// changeset was their last pending prerequisite. It is a no-op if the given
+// changeset is not merged.
+func (s *Store) EnqueueDependentChangesets(ctx context.Context, cs *btypes.Changeset) (err error) {
+	ctx, _, endObservation := s.operations.enqueueDependentChangesets.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("ID", int(cs.ID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if cs.CurrentSpecID == 0 || cs.ExternalState != btypes.ChangesetExternalStateMerged {
+		return nil
+	}
+
+	q := sqlf.Sprintf(
+		enqueueDependentChangesetsFmtstr,
+		btypes.ReconcilerStateQueued.ToDB(),
+		s.now(),
+		btypes.ReconcilerStateCompleted.ToDB(),
+		btypes.ChangesetPublicationStateUnpublished,
+		cs.CurrentSpecID,
+	)
+	return s.Exec(ctx, q)
+}
+
+const enqueueDependentChangesetsFmtstr = `
+UPDATE
+	changesets
+SET
+	reconciler_state = %s,
+	failure_message = NULL,
+	num_resets = 0,
+	num_failures = 0,
+	updated_at = %s
+WHERE
+	changesets.reconciler_state = %s
+	AND
+	changesets.publication_state = %s
+	AND
+	changesets.current_spec_id IN (
+		SELECT
+			dependents.id
+		FROM
+			changeset_specs dependents
+		JOIN
+			changeset_specs prerequisite ON prerequisite.batch_spec_id = dependents.batch_spec_id
+		JOIN
+			repo ON repo.id = prerequisite.repo_id
+		WHERE
+			prerequisite.id = %s
+			AND
+			repo.name = ANY (dependents.depends_on)
+	)
+`
+
 // jsonBatchChangeChangesetSet represents a "join table" set as a JSONB object
 // where the keys are the ids and the values are json objects holding the properties.
 // It implements the sql.Scanner interface so it can be used as a scan destination,
@@ -1486,6 +1809,11 @@ func ScanChangeset(t *btypes.Changeset, s dbutil.Scanner) error {
 		&dbutil.NullString{S: &syncErrorMessage},
 		&dbutil.NullTime{Time: &t.DetachedAt},
 		&dbutil.NullString{S: &previousFailureMessage},
+		&t.Merging,
+		&t.Conflicted,
+		&t.Rebasing,
+		&dbutil.NullString{S: &t.ConflictCheckBaseRev},
+		&dbutil.NullString{S: &t.ConflictCheckHeadRev},
 	)
 	if err != nil {
 		return errors.Wrap(err, "scanning changeset")
@@ -1528,6 +1856,8 @@ func ScanChangeset(t *btypes.Changeset, s dbutil.Scanner) error {
 		t.Metadata = m
 	case extsvc.TypePerforce:
 		t.Metadata = new(protocol.PerforceChangelist)
+	case emailpatch.ExternalServiceType:
+		t.Metadata = new(emailpatch.Series)
 	case extsvc.TypeGerrit:
 		t.Metadata = new(gerrit.Change)
 	default:
@@ -1575,6 +1905,7 @@ func (s *Store) GetChangesetsStats(ctx context.Context, batchChangeID int64) (st
 			&stats.Open,
 			&stats.Deleted,
 			&stats.Archived,
+			&stats.Conflicted,
 		); err != nil {
 			return err
 		}
@@ -1599,7 +1930,8 @@ SELECT
 	COUNT(*) FILTER (WHERE NOT %s AND changesets.computed_state = 'MERGED') AS merged,
 	COUNT(*) FILTER (WHERE NOT %s AND changesets.computed_state = 'OPEN') AS open,
 	COUNT(*) FILTER (WHERE NOT %s AND changesets.computed_state = 'DELETED') AS deleted,
-	COUNT(*) FILTER (WHERE %s) AS archived
+	COUNT(*) FILTER (WHERE %s) AS archived,
+	COUNT(*) FILTER (WHERE NOT %s AND changesets.computed_state = 'CONFLICTED') AS conflicted
 FROM changesets
 INNER JOIN repo on repo.id = changesets.repo_id
 WHERE
@@ -1769,7 +2101,7 @@ func getChangesetsStatsQuery(batchChangeID int64) *sqlf.Query {
 		archived, archived,
 		archived, archived,
 		archived, archived,
-		archived,
+		archived, archived,
 		sqlf.Join(preds, " AND "),
 	)
 }
@@ -1782,18 +2114,21 @@ func getRepoChangesetsStatsQuery(repoID int64, authzConds *sqlf.Query) *sqlf.Que
 	)
 }
 
+// Conflicted changesets are counted by their external state in the repo and
+// global stats.
 const getRepoChangesetsStatsFmtstr = `
 SELECT
 	COUNT(*) AS total,
 	COUNT(*) FILTER (WHERE computed_state = 'UNPUBLISHED') AS unpublished,
-	COUNT(*) FILTER (WHERE computed_state = 'DRAFT') AS draft,
+	COUNT(*) FILTER (WHERE computed_state = 'DRAFT' OR (computed_state = 'CONFLICTED' AND external_state = 'DRAFT')) AS draft,
 	COUNT(*) FILTER (WHERE computed_state = 'CLOSED') AS closed,
 	COUNT(*) FILTER (WHERE computed_state = 'MERGED') AS merged,
-	COUNT(*) FILTER (WHERE computed_state = 'OPEN') AS open
+	COUNT(*) FILTER (WHERE computed_state = 'OPEN' OR (computed_state = 'CONFLICTED' AND external_state = 'OPEN')) AS open
 FROM (
 	SELECT
 		changesets.id,
-		changesets.computed_state
+		changesets.computed_state,
+		changesets.external_state
 	FROM
 		changesets
 		INNER JOIN repo ON changesets.repo_id = repo.id
@@ -1810,14 +2145,15 @@ const getGlobalChangesetsStatsFmtstr = `
 SELECT
 	COUNT(*) AS total,
 	COUNT(*) FILTER (WHERE computed_state = 'UNPUBLISHED') AS unpublished,
-	COUNT(*) FILTER (WHERE computed_state = 'DRAFT') AS draft,
+	COUNT(*) FILTER (WHERE computed_state = 'DRAFT' OR (computed_state = 'CONFLICTED' AND external_state = 'DRAFT')) AS draft,
 	COUNT(*) FILTER (WHERE computed_state = 'CLOSED') AS closed,
 	COUNT(*) FILTER (WHERE computed_state = 'MERGED') AS merged,
-	COUNT(*) FILTER (WHERE computed_state = 'OPEN') AS open
+	COUNT(*) FILTER (WHERE computed_state = 'OPEN' OR (computed_state = 'CONFLICTED' AND external_state = 'OPEN')) AS open
 FROM (
 	SELECT
 		changesets.id,
-		changesets.computed_state
+		changesets.computed_state,
+		changesets.external_state
 	FROM
 		changesets
 	INNER JOIN repo ON repo.id = changesets.repo_id
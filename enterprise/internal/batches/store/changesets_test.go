@@ -1650,6 +1650,157 @@ func testStoreChangesets(t *testing.T, ctx context.Context, s *Store, clock bt.C
 			t.Fatalf("found diff with unsigned commit: %s", diff)
 		}
 	})
+
+	t.Run("EnqueueChangesetToMerge", func(t *testing.T) {
+		c1 := bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{
+			Repo:            repo.ID,
+			ReconcilerState: btypes.ReconcilerStateCompleted,
+		})
+		c2 := bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{
+			Repo:            repo.ID,
+			ReconcilerState: btypes.ReconcilerStateProcessing,
+		})
+
+		enqueued, err := s.EnqueueChangesetToMerge(ctx, c1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !enqueued {
+			t.Fatal("changeset not enqueued")
+		}
+		if !c1.Merging || c1.ReconcilerState != btypes.ReconcilerStateQueued {
+			t.Fatalf("changeset not marked for merging: merging=%t state=%s", c1.Merging, c1.ReconcilerState)
+		}
+
+		// Changesets that are still being processed are not enqueued.
+		enqueued, err = s.EnqueueChangesetToMerge(ctx, c2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if enqueued {
+			t.Fatal("processing changeset enqueued")
+		}
+		have, err := s.GetChangesetByID(ctx, c2.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have.Merging {
+			t.Fatal("processing changeset marked for merging")
+		}
+	})
+
+	t.Run("EnqueueChangesetToRebase", func(t *testing.T) {
+		c1 := bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{
+			Repo:             repo.ID,
+			ReconcilerState:  btypes.ReconcilerStateCompleted,
+			PublicationState: btypes.ChangesetPublicationStatePublished,
+			ExternalState:    btypes.ChangesetExternalStateOpen,
+			Conflicted:       true,
+		})
+		if c1.State != btypes.ChangesetStateConflicted {
+			t.Fatalf("wrong computed state: have=%s want=%s", c1.State, btypes.ChangesetStateConflicted)
+		}
+		c2 := bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{
+			Repo:            repo.ID,
+			ReconcilerState: btypes.ReconcilerStateProcessing,
+		})
+
+		enqueued, err := s.EnqueueChangesetToRebase(ctx, c1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !enqueued {
+			t.Fatal("changeset not enqueued")
+		}
+		if !c1.Rebasing || c1.ReconcilerState != btypes.ReconcilerStateQueued {
+			t.Fatalf("changeset not marked for rebasing: rebasing=%t state=%s", c1.Rebasing, c1.ReconcilerState)
+		}
+
+		// Changesets that are still being processed are not enqueued.
+		enqueued, err = s.EnqueueChangesetToRebase(ctx, c2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if enqueued {
+			t.Fatal("processing changeset enqueued")
+		}
+	})
+
+	t.Run("Prerequisites", func(t *testing.T) {
+		batchSpec := bt.CreateBatchSpec(t, ctx, s, "prerequisites", user.ID, 0)
+		libSpec := bt.CreateChangesetSpec(t, ctx, s, bt.TestSpecOpts{
+			Repo:      otherRepo.ID,
+			BatchSpec: batchSpec.ID,
+			HeadRef:   "refs/heads/bump-lib",
+			Typ:       btypes.ChangesetSpecTypeBranch,
+		})
+		consumerSpec := bt.CreateChangesetSpec(t, ctx, s, bt.TestSpecOpts{
+			Repo:      repo.ID,
+			BatchSpec: batchSpec.ID,
+			HeadRef:   "refs/heads/bump-lib",
+			Typ:       btypes.ChangesetSpecTypeBranch,
+			DependsOn: []string{string(otherRepo.Name)},
+		})
+
+		// The prerequisite is pending as long as it has no changeset.
+		count, err := s.CountPendingPrerequisites(ctx, consumerSpec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 1 {
+			t.Fatalf("wrong number of pending prerequisites: have=%d want=1", count)
+		}
+
+		lib := bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{
+			Repo:             otherRepo.ID,
+			CurrentSpec:      libSpec.ID,
+			ReconcilerState:  btypes.ReconcilerStateCompleted,
+			PublicationState: btypes.ChangesetPublicationStatePublished,
+			ExternalState:    btypes.ChangesetExternalStateOpen,
+		})
+		consumer := bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{
+			Repo:             repo.ID,
+			CurrentSpec:      consumerSpec.ID,
+			ReconcilerState:  btypes.ReconcilerStateCompleted,
+			PublicationState: btypes.ChangesetPublicationStateUnpublished,
+		})
+
+		// Open prerequisites don't enqueue their dependents.
+		if err := s.EnqueueDependentChangesets(ctx, lib); err != nil {
+			t.Fatal(err)
+		}
+		reloaded, err := s.GetChangesetByID(ctx, consumer.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have, want := reloaded.ReconcilerState, btypes.ReconcilerStateCompleted; have != want {
+			t.Fatalf("wrong reconciler state: have=%s want=%s", have, want)
+		}
+
+		lib.ExternalState = btypes.ChangesetExternalStateMerged
+		if err := s.UpdateChangeset(ctx, lib); err != nil {
+			t.Fatal(err)
+		}
+
+		count, err = s.CountPendingPrerequisites(ctx, consumerSpec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 0 {
+			t.Fatalf("wrong number of pending prerequisites: have=%d want=0", count)
+		}
+
+		if err := s.EnqueueDependentChangesets(ctx, lib); err != nil {
+			t.Fatal(err)
+		}
+		reloaded, err = s.GetChangesetByID(ctx, consumer.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have, want := reloaded.ReconcilerState, btypes.ReconcilerStateQueued; have != want {
+			t.Fatalf("wrong reconciler state: have=%s want=%s", have, want)
+		}
+	})
 }
 
 func testStoreListChangesetSyncData(t *testing.T, ctx context.Context, s *Store, clock bt.Clock) {
@@ -2722,3 +2873,59 @@ func TestCleanDetachedChangesets(t *testing.T) {
 		})
 	}
 }
+
+func testStoreReconcilerQueue(t *testing.T, ctx context.Context, s *Store, clock bt.Clock) {
+	logger := logtest.Scoped(t)
+	user := bt.CreateTestUser(t, s.DatabaseDB(), false)
+
+	es := database.ExternalServicesWith(logger, s)
+	githubRepo := bt.TestRepo(t, es, extsvc.KindGitHub)
+	gitlabRepo := bt.TestRepo(t, es, extsvc.KindGitLab)
+	require.NoError(t, database.ReposWith(logger, s).Create(ctx, githubRepo, gitlabRepo))
+
+	batchSpec := bt.CreateBatchSpec(t, ctx, s, "reconciler-queue", user.ID, 0)
+	batchChange := bt.CreateBatchChange(t, ctx, s, "reconciler-queue", user.ID, batchSpec.ID)
+
+	createChangeset := func(repoID api.RepoID, state btypes.ReconcilerState) *btypes.Changeset {
+		return bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{
+			Repo:            repoID,
+			BatchChange:     batchChange.ID,
+			ReconcilerState: state,
+		})
+	}
+
+	createChangeset(githubRepo.ID, btypes.ReconcilerStateQueued)
+	createChangeset(githubRepo.ID, btypes.ReconcilerStateCompleted)
+	createChangeset(gitlabRepo.ID, btypes.ReconcilerStateQueued)
+	deferred := createChangeset(githubRepo.ID, btypes.ReconcilerStateProcessing)
+
+	t.Run("DeferChangeset", func(t *testing.T) {
+		processAfter := clock.Now().Add(time.Hour)
+		require.NoError(t, s.DeferChangeset(ctx, deferred, processAfter))
+
+		have, err := s.GetChangesetByID(ctx, deferred.ID)
+		require.NoError(t, err)
+		assert.Equal(t, btypes.ReconcilerStateQueued, have.ReconcilerState)
+		assert.True(t, processAfter.Equal(have.ProcessAfter), "wrong process_after: %s", have.ProcessAfter)
+		assert.Equal(t, int64(0), have.NumFailures)
+	})
+
+	t.Run("ListReconcilerQueueDepths", func(t *testing.T) {
+		have, err := s.ListReconcilerQueueDepths(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, []*btypes.CodeHostQueueDepth{
+			{
+				ExternalServiceType: githubRepo.ExternalRepo.ServiceType,
+				ExternalServiceID:   githubRepo.ExternalRepo.ServiceID,
+				Queued:              1,
+				Deferred:            1,
+			},
+			{
+				ExternalServiceType: gitlabRepo.ExternalRepo.ServiceType,
+				ExternalServiceID:   gitlabRepo.ExternalRepo.ServiceID,
+				Queued:              1,
+			},
+		}, have)
+	})
+}
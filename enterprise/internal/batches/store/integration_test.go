@@ -23,10 +23,14 @@ func TestIntegration(t *testing.T) {
 
 	t.Run("Store", func(t *testing.T) {
 		t.Run("BatchChanges", storeTest(db, nil, testStoreBatchChanges))
+		t.Run("BatchChangeAutoMergePolicies", storeTest(db, nil, testStoreBatchChangeAutoMergePolicies))
+		t.Run("BatchChangeScheduledRuns", storeTest(db, nil, testStoreBatchChangeScheduledRuns))
 		t.Run("BatchChangesDeletedNamespace", storeTest(db, nil, testBatchChangesDeletedNamespace))
 		t.Run("Changesets", storeTest(db, nil, testStoreChangesets))
+		t.Run("ChangesetCountSnapshots", storeTest(db, nil, testStoreChangesetCountSnapshots))
 		t.Run("ChangesetEvents", storeTest(db, nil, testStoreChangesetEvents))
 		t.Run("ChangesetScheduling", storeTest(db, nil, testStoreChangesetScheduling))
+		t.Run("ReconcilerQueue", storeTest(db, nil, testStoreReconcilerQueue))
 		t.Run("ListChangesetSyncData", storeTest(db, nil, testStoreListChangesetSyncData))
 		t.Run("ListChangesetsTextSearch", storeTest(db, nil, testStoreListChangesetsTextSearch))
 		t.Run("BatchSpecs", storeTest(db, nil, testStoreBatchSpecs))
@@ -186,6 +186,18 @@ type operations struct {
 	getRepoDiffStat        *observation.Operation
 	listBatchChanges       *observation.Operation
 
+	updateBatchChangeSchedule          *observation.Operation
+	listBatchChangesDueForScheduledRun *observation.Operation
+
+	createBatchChangeScheduledRun *observation.Operation
+	updateBatchChangeScheduledRun *observation.Operation
+	listBatchChangeScheduledRuns  *observation.Operation
+	countBatchChangeScheduledRuns *observation.Operation
+
+	upsertBatchChangeAutoMergePolicy *observation.Operation
+	getBatchChangeAutoMergePolicy    *observation.Operation
+	deleteBatchChangeAutoMergePolicy *observation.Operation
+
 	createBatchSpecExecution *observation.Operation
 	getBatchSpecExecution    *observation.Operation
 	cancelBatchSpecExecution *observation.Operation
@@ -213,6 +225,9 @@ type operations struct {
 	countBulkOperations     *observation.Operation
 	listBulkOperationErrors *observation.Operation
 
+	createChangesetCountSnapshots *observation.Operation
+	listChangesetCountSnapshots   *observation.Operation
+
 	getChangesetEvent     *observation.Operation
 	listChangesetEvents   *observation.Operation
 	countChangesetEvents  *observation.Operation
@@ -231,6 +246,7 @@ type operations struct {
 	deleteUnattachedExpiredChangesetSpecs    *observation.Operation
 	getRewirerMappings                       *observation.Operation
 	listChangesetSpecsWithConflictingHeadRef *observation.Operation
+	listChangesetSpecDependencies            *observation.Operation
 	deleteChangesetSpecs                     *observation.Operation
 
 	createChangeset                   *observation.Operation
@@ -248,6 +264,12 @@ type operations struct {
 	getChangesetExternalIDs           *observation.Operation
 	cancelQueuedBatchChangeChangesets *observation.Operation
 	enqueueChangesetsToClose          *observation.Operation
+	enqueueChangesetToMerge           *observation.Operation
+	enqueueChangesetToRebase          *observation.Operation
+	deferChangeset                    *observation.Operation
+	listReconcilerQueueDepths         *observation.Operation
+	countPendingPrerequisites         *observation.Operation
+	enqueueDependentChangesets        *observation.Operation
 	getChangesetsStats                *observation.Operation
 	getRepoChangesetsStats            *observation.Operation
 	getGlobalChangesetsStats          *observation.Operation
@@ -331,6 +353,18 @@ func newOperations(observationCtx *observation.Context) *operations {
 			getBatchChangeDiffStat: op("GetBatchChangeDiffStat"),
 			getRepoDiffStat:        op("GetRepoDiffStat"),
 
+			updateBatchChangeSchedule:          op("UpdateBatchChangeSchedule"),
+			listBatchChangesDueForScheduledRun: op("ListBatchChangesDueForScheduledRun"),
+
+			createBatchChangeScheduledRun: op("CreateBatchChangeScheduledRun"),
+			updateBatchChangeScheduledRun: op("UpdateBatchChangeScheduledRun"),
+			listBatchChangeScheduledRuns:  op("ListBatchChangeScheduledRuns"),
+			countBatchChangeScheduledRuns: op("CountBatchChangeScheduledRuns"),
+
+			upsertBatchChangeAutoMergePolicy: op("UpsertBatchChangeAutoMergePolicy"),
+			getBatchChangeAutoMergePolicy:    op("GetBatchChangeAutoMergePolicy"),
+			deleteBatchChangeAutoMergePolicy: op("DeleteBatchChangeAutoMergePolicy"),
+
 			createBatchSpecExecution: op("CreateBatchSpecExecution"),
 			getBatchSpecExecution:    op("GetBatchSpecExecution"),
 			cancelBatchSpecExecution: op("CancelBatchSpecExecution"),
@@ -358,6 +392,9 @@ func newOperations(observationCtx *observation.Context) *operations {
 			countBulkOperations:     op("CountBulkOperations"),
 			listBulkOperationErrors: op("ListBulkOperationErrors"),
 
+			createChangesetCountSnapshots: op("CreateChangesetCountSnapshots"),
+			listChangesetCountSnapshots:   op("ListChangesetCountSnapshots"),
+
 			getChangesetEvent:     op("GetChangesetEvent"),
 			listChangesetEvents:   op("ListChangesetEvents"),
 			countChangesetEvents:  op("CountChangesetEvents"),
@@ -377,6 +414,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 			deleteChangesetSpecs:                     op("DeleteChangesetSpecs"),
 			getRewirerMappings:                       op("GetRewirerMappings"),
 			listChangesetSpecsWithConflictingHeadRef: op("ListChangesetSpecsWithConflictingHeadRef"),
+			listChangesetSpecDependencies:            op("ListChangesetSpecDependencies"),
 
 			createChangeset:                   op("CreateChangeset"),
 			deleteChangeset:                   op("DeleteChangeset"),
@@ -393,6 +431,12 @@ func newOperations(observationCtx *observation.Context) *operations {
 			getChangesetExternalIDs:           op("GetChangesetExternalIDs"),
 			cancelQueuedBatchChangeChangesets: op("CancelQueuedBatchChangeChangesets"),
 			enqueueChangesetsToClose:          op("EnqueueChangesetsToClose"),
+			enqueueChangesetToMerge:           op("EnqueueChangesetToMerge"),
+			enqueueChangesetToRebase:          op("EnqueueChangesetToRebase"),
+			deferChangeset:                    op("DeferChangeset"),
+			listReconcilerQueueDepths:         op("ListReconcilerQueueDepths"),
+			countPendingPrerequisites:         op("CountPendingPrerequisites"),
+			enqueueDependentChangesets:        op("EnqueueDependentChangesets"),
 			getChangesetsStats:                op("GetChangesetsStats"),
 			getRepoChangesetsStats:            op("GetRepoChangesetsStats"),
 			getGlobalChangesetsStats:          op("GetGlobalChangesetsStats"),
@@ -0,0 +1,124 @@
+package syncer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sourcegraph/log"
+	"golang.org/x/time/rate"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/state"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+)
+
+// autoMergeLimiter limits how many changesets auto-merge policies merge per
+// hour on a single code host, as configured in the site configuration.
+type autoMergeLimiter struct {
+	mu      sync.Mutex
+	perHour int
+	limiter *rate.Limiter
+}
+
+// Allow reports whether another changeset may be merged now.
+func (l *autoMergeLimiter) Allow() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	perHour := conf.BatchChangesAutoMergeRateLimit()
+	if perHour <= 0 {
+		return false
+	}
+
+	// Rebuild the limiter when the site configuration changed.
+	if l.limiter == nil || l.perHour != perHour {
+		l.perHour = perHour
+		l.limiter = rate.NewLimiter(rate.Every(time.Hour/time.Duration(perHour)), perHour)
+	}
+
+	return l.limiter.Allow()
+}
+
+// enqueueAutoMerge evaluates the auto-merge policy of the batch change owning
+// the given freshly synced changeset and, if the changeset satisfies it,
+// enqueues the changeset to be merged by the reconciler.
+//
+// The policy is evaluated on every sync, not only when the review or check
+// state changed, so that merges held back by a closed maintenance window or
+// the rate limit happen on a later sync.
+func (s *changesetSyncer) enqueueAutoMerge(ctx context.Context, cs *btypes.Changeset) (err error) {
+	if cs.OwnedByBatchChangeID == 0 || cs.Merging {
+		return nil
+	}
+	if !cs.AttachedTo(cs.OwnedByBatchChangeID) || cs.ArchivedIn(cs.OwnedByBatchChangeID) {
+		return nil
+	}
+
+	policy, err := s.syncStore.GetBatchChangeAutoMergePolicy(ctx, cs.OwnedByBatchChangeID)
+	if err != nil {
+		if err == store.ErrNoResults {
+			return nil
+		}
+		return err
+	}
+
+	events, err := cs.Events()
+	if err != nil {
+		return err
+	}
+	approvals := state.CountApprovals(events)
+
+	now := s.syncStore.Clock()()
+	if ok, reason := policy.Evaluate(cs, approvals, now); !ok {
+		s.logger.Debug("changeset not auto-merged", log.Int64("id", cs.ID), log.String("reason", reason))
+		return nil
+	}
+
+	// Don't retry merges the code host rejected until the changeset has been
+	// updated since.
+	failures, _, err := s.syncStore.ListChangesetEvents(ctx, store.ListChangesetEventsOpts{
+		ChangesetIDs: []int64{cs.ID},
+		Kinds:        []btypes.ChangesetEventKind{btypes.ChangesetEventKindAutoMergeFailed},
+	})
+	if err != nil {
+		return err
+	}
+	for _, f := range failures {
+		if !f.CreatedAt.Before(cs.ExternalUpdatedAt) {
+			return nil
+		}
+	}
+
+	if !s.autoMergeLimiter.Allow() {
+		s.logger.Debug("changeset not auto-merged: rate limit exceeded", log.Int64("id", cs.ID))
+		return nil
+	}
+
+	tx, err := s.syncStore.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	enqueued, err := tx.EnqueueChangesetToMerge(ctx, cs)
+	if err != nil || !enqueued {
+		return err
+	}
+
+	return tx.UpsertChangesetEvents(ctx, &btypes.ChangesetEvent{
+		ChangesetID: cs.ID,
+		Kind:        btypes.ChangesetEventKindAutoMergeEnqueued,
+		Key:         now.UTC().Format(time.RFC3339Nano),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		Metadata: &btypes.ChangesetAutoMergeEvent{
+			BatchChangeID: policy.BatchChangeID,
+			MergeMethod:   policy.MergeMethod,
+			Approvals:     approvals,
+			CheckState:    cs.ExternalCheckState,
+			CreatedAt:     now,
+		},
+	})
+}
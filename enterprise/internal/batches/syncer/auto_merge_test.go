@@ -0,0 +1,42 @@
+package syncer
+
+import (
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestAutoMergeLimiter(t *testing.T) {
+	t.Cleanup(func() { conf.Mock(nil) })
+
+	var l autoMergeLimiter
+
+	conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+		BatchChangesAutoMergeRateLimit: pointers.Ptr(2),
+	}})
+	for i := 0; i < 2; i++ {
+		if !l.Allow() {
+			t.Fatalf("merge %d not allowed", i)
+		}
+	}
+	if l.Allow() {
+		t.Fatal("merge allowed after exceeding the rate limit")
+	}
+
+	// Changing the rate limit resets the limiter.
+	conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+		BatchChangesAutoMergeRateLimit: pointers.Ptr(3),
+	}})
+	if !l.Allow() {
+		t.Fatal("merge not allowed after changing the rate limit")
+	}
+
+	conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+		BatchChangesAutoMergeRateLimit: pointers.Ptr(0),
+	}})
+	if l.Allow() {
+		t.Fatal("merge allowed with auto-merging disabled")
+	}
+}
@@ -34,6 +34,10 @@ type MockSyncStore struct {
 	// GetBatchChangeFunc is an instance of a mock function object
 	// controlling the behavior of the method GetBatchChange.
 	GetBatchChangeFunc *SyncStoreGetBatchChangeFunc
+	// GetBatchChangeAutoMergePolicyFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// GetBatchChangeAutoMergePolicy.
+	GetBatchChangeAutoMergePolicyFunc *SyncStoreGetBatchChangeAutoMergePolicyFunc
 	// GetChangesetFunc is an instance of a mock function object controlling
 	// the behavior of the method GetChangeset.
 	GetChangesetFunc *SyncStoreGetChangesetFunc
@@ -46,6 +50,9 @@ type MockSyncStore struct {
 	// GitHubAppsStoreFunc is an instance of a mock function object
 	// controlling the behavior of the method GitHubAppsStore.
 	GitHubAppsStoreFunc *SyncStoreGitHubAppsStoreFunc
+	// ListChangesetEventsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListChangesetEvents.
+	ListChangesetEventsFunc *SyncStoreListChangesetEventsFunc
 	// ListChangesetSyncDataFunc is an instance of a mock function object
 	// controlling the behavior of the method ListChangesetSyncData.
 	ListChangesetSyncDataFunc *SyncStoreListChangesetSyncDataFunc
@@ -97,6 +104,11 @@ func NewMockSyncStore() *MockSyncStore {
 				return
 			},
 		},
+		GetBatchChangeAutoMergePolicyFunc: &SyncStoreGetBatchChangeAutoMergePolicyFunc{
+			defaultHook: func(context.Context, int64) (r0 *types.BatchChangeAutoMergePolicy, r1 error) {
+				return
+			},
+		},
 		GetChangesetFunc: &SyncStoreGetChangesetFunc{
 			defaultHook: func(context.Context, store.GetChangesetOpts) (r0 *types.Changeset, r1 error) {
 				return
@@ -117,6 +129,11 @@ func NewMockSyncStore() *MockSyncStore {
 				return
 			},
 		},
+		ListChangesetEventsFunc: &SyncStoreListChangesetEventsFunc{
+			defaultHook: func(context.Context, store.ListChangesetEventsOpts) (r0 []*types.ChangesetEvent, r1 int64, r2 error) {
+				return
+			},
+		},
 		ListChangesetSyncDataFunc: &SyncStoreListChangesetSyncDataFunc{
 			defaultHook: func(context.Context, store.ListChangesetSyncDataOpts) (r0 []*types.ChangesetSyncData, r1 error) {
 				return
@@ -184,6 +201,11 @@ func NewStrictMockSyncStore() *MockSyncStore {
 				panic("unexpected invocation of MockSyncStore.GetBatchChange")
 			},
 		},
+		GetBatchChangeAutoMergePolicyFunc: &SyncStoreGetBatchChangeAutoMergePolicyFunc{
+			defaultHook: func(context.Context, int64) (*types.BatchChangeAutoMergePolicy, error) {
+				panic("unexpected invocation of MockSyncStore.GetBatchChangeAutoMergePolicy")
+			},
+		},
 		GetChangesetFunc: &SyncStoreGetChangesetFunc{
 			defaultHook: func(context.Context, store.GetChangesetOpts) (*types.Changeset, error) {
 				panic("unexpected invocation of MockSyncStore.GetChangeset")
@@ -204,6 +226,11 @@ func NewStrictMockSyncStore() *MockSyncStore {
 				panic("unexpected invocation of MockSyncStore.GitHubAppsStore")
 			},
 		},
+		ListChangesetEventsFunc: &SyncStoreListChangesetEventsFunc{
+			defaultHook: func(context.Context, store.ListChangesetEventsOpts) ([]*types.ChangesetEvent, int64, error) {
+				panic("unexpected invocation of MockSyncStore.ListChangesetEvents")
+			},
+		},
 		ListChangesetSyncDataFunc: &SyncStoreListChangesetSyncDataFunc{
 			defaultHook: func(context.Context, store.ListChangesetSyncDataOpts) ([]*types.ChangesetSyncData, error) {
 				panic("unexpected invocation of MockSyncStore.ListChangesetSyncData")
@@ -263,6 +290,9 @@ func NewMockSyncStoreFrom(i SyncStore) *MockSyncStore {
 		GetBatchChangeFunc: &SyncStoreGetBatchChangeFunc{
 			defaultHook: i.GetBatchChange,
 		},
+		GetBatchChangeAutoMergePolicyFunc: &SyncStoreGetBatchChangeAutoMergePolicyFunc{
+			defaultHook: i.GetBatchChangeAutoMergePolicy,
+		},
 		GetChangesetFunc: &SyncStoreGetChangesetFunc{
 			defaultHook: i.GetChangeset,
 		},
@@ -275,6 +305,9 @@ func NewMockSyncStoreFrom(i SyncStore) *MockSyncStore {
 		GitHubAppsStoreFunc: &SyncStoreGitHubAppsStoreFunc{
 			defaultHook: i.GitHubAppsStore,
 		},
+		ListChangesetEventsFunc: &SyncStoreListChangesetEventsFunc{
+			defaultHook: i.ListChangesetEvents,
+		},
 		ListChangesetSyncDataFunc: &SyncStoreListChangesetSyncDataFunc{
 			defaultHook: i.ListChangesetSyncData,
 		},
@@ -705,6 +738,118 @@ func (c SyncStoreGetBatchChangeFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// SyncStoreGetBatchChangeAutoMergePolicyFunc describes the behavior when
+// the GetBatchChangeAutoMergePolicy method of the parent MockSyncStore
+// instance is invoked.
+type SyncStoreGetBatchChangeAutoMergePolicyFunc struct {
+	defaultHook func(context.Context, int64) (*types.BatchChangeAutoMergePolicy, error)
+	hooks       []func(context.Context, int64) (*types.BatchChangeAutoMergePolicy, error)
+	history     []SyncStoreGetBatchChangeAutoMergePolicyFuncCall
+	mutex       sync.Mutex
+}
+
+// GetBatchChangeAutoMergePolicy delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockSyncStore) GetBatchChangeAutoMergePolicy(v0 context.Context, v1 int64) (*types.BatchChangeAutoMergePolicy, error) {
+	r0, r1 := m.GetBatchChangeAutoMergePolicyFunc.nextHook()(v0, v1)
+	m.GetBatchChangeAutoMergePolicyFunc.appendCall(SyncStoreGetBatchChangeAutoMergePolicyFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetBatchChangeAutoMergePolicy method of the parent MockSyncStore instance
+// is invoked and the hook queue is empty.
+func (f *SyncStoreGetBatchChangeAutoMergePolicyFunc) SetDefaultHook(hook func(context.Context, int64) (*types.BatchChangeAutoMergePolicy, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetBatchChangeAutoMergePolicy method of the parent MockSyncStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SyncStoreGetBatchChangeAutoMergePolicyFunc) PushHook(hook func(context.Context, int64) (*types.BatchChangeAutoMergePolicy, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *SyncStoreGetBatchChangeAutoMergePolicyFunc) SetDefaultReturn(r0 *types.BatchChangeAutoMergePolicy, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (*types.BatchChangeAutoMergePolicy, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *SyncStoreGetBatchChangeAutoMergePolicyFunc) PushReturn(r0 *types.BatchChangeAutoMergePolicy, r1 error) {
+	f.PushHook(func(context.Context, int64) (*types.BatchChangeAutoMergePolicy, error) {
+		return r0, r1
+	})
+}
+
+func (f *SyncStoreGetBatchChangeAutoMergePolicyFunc) nextHook() func(context.Context, int64) (*types.BatchChangeAutoMergePolicy, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SyncStoreGetBatchChangeAutoMergePolicyFunc) appendCall(r0 SyncStoreGetBatchChangeAutoMergePolicyFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// SyncStoreGetBatchChangeAutoMergePolicyFuncCall objects describing the
+// invocations of this function.
+func (f *SyncStoreGetBatchChangeAutoMergePolicyFunc) History() []SyncStoreGetBatchChangeAutoMergePolicyFuncCall {
+	f.mutex.Lock()
+	history := make([]SyncStoreGetBatchChangeAutoMergePolicyFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SyncStoreGetBatchChangeAutoMergePolicyFuncCall is an object that
+// describes an invocation of method GetBatchChangeAutoMergePolicy on an
+// instance of MockSyncStore.
+type SyncStoreGetBatchChangeAutoMergePolicyFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *types.BatchChangeAutoMergePolicy
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c SyncStoreGetBatchChangeAutoMergePolicyFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SyncStoreGetBatchChangeAutoMergePolicyFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // SyncStoreGetChangesetFunc describes the behavior when the GetChangeset
 // method of the parent MockSyncStore instance is invoked.
 type SyncStoreGetChangesetFunc struct {
@@ -1130,6 +1275,118 @@ func (c SyncStoreGitHubAppsStoreFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// SyncStoreListChangesetEventsFunc describes the behavior when the
+// ListChangesetEvents method of the parent MockSyncStore instance is
+// invoked.
+type SyncStoreListChangesetEventsFunc struct {
+	defaultHook func(context.Context, store.ListChangesetEventsOpts) ([]*types.ChangesetEvent, int64, error)
+	hooks       []func(context.Context, store.ListChangesetEventsOpts) ([]*types.ChangesetEvent, int64, error)
+	history     []SyncStoreListChangesetEventsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListChangesetEvents delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockSyncStore) ListChangesetEvents(v0 context.Context, v1 store.ListChangesetEventsOpts) ([]*types.ChangesetEvent, int64, error) {
+	r0, r1, r2 := m.ListChangesetEventsFunc.nextHook()(v0, v1)
+	m.ListChangesetEventsFunc.appendCall(SyncStoreListChangesetEventsFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the ListChangesetEvents
+// method of the parent MockSyncStore instance is invoked and the hook queue
+// is empty.
+func (f *SyncStoreListChangesetEventsFunc) SetDefaultHook(hook func(context.Context, store.ListChangesetEventsOpts) ([]*types.ChangesetEvent, int64, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListChangesetEvents method of the parent MockSyncStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *SyncStoreListChangesetEventsFunc) PushHook(hook func(context.Context, store.ListChangesetEventsOpts) ([]*types.ChangesetEvent, int64, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *SyncStoreListChangesetEventsFunc) SetDefaultReturn(r0 []*types.ChangesetEvent, r1 int64, r2 error) {
+	f.SetDefaultHook(func(context.Context, store.ListChangesetEventsOpts) ([]*types.ChangesetEvent, int64, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *SyncStoreListChangesetEventsFunc) PushReturn(r0 []*types.ChangesetEvent, r1 int64, r2 error) {
+	f.PushHook(func(context.Context, store.ListChangesetEventsOpts) ([]*types.ChangesetEvent, int64, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *SyncStoreListChangesetEventsFunc) nextHook() func(context.Context, store.ListChangesetEventsOpts) ([]*types.ChangesetEvent, int64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SyncStoreListChangesetEventsFunc) appendCall(r0 SyncStoreListChangesetEventsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SyncStoreListChangesetEventsFuncCall
+// objects describing the invocations of this function.
+func (f *SyncStoreListChangesetEventsFunc) History() []SyncStoreListChangesetEventsFuncCall {
+	f.mutex.Lock()
+	history := make([]SyncStoreListChangesetEventsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SyncStoreListChangesetEventsFuncCall is an object that describes an
+// invocation of method ListChangesetEvents on an instance of MockSyncStore.
+type SyncStoreListChangesetEventsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 store.ListChangesetEventsOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*types.ChangesetEvent
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int64
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c SyncStoreListChangesetEventsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SyncStoreListChangesetEventsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
 // SyncStoreListChangesetSyncDataFunc describes the behavior when the
 // ListChangesetSyncData method of the parent MockSyncStore instance is
 // invoked.
@@ -17,6 +17,7 @@ type SyncStore interface {
 	GetChangeset(context.Context, store.GetChangesetOpts) (*btypes.Changeset, error)
 	UpdateChangesetCodeHostState(ctx context.Context, cs *btypes.Changeset) error
 	UpsertChangesetEvents(ctx context.Context, cs ...*btypes.ChangesetEvent) error
+	ListChangesetEvents(ctx context.Context, opts store.ListChangesetEventsOpts) ([]*btypes.ChangesetEvent, int64, error)
 	GetSiteCredential(ctx context.Context, opts store.GetSiteCredentialOpts) (*btypes.SiteCredential, error)
 	Transact(context.Context) (*store.Store, error)
 	Repos() database.RepoStore
@@ -26,5 +27,6 @@ type SyncStore interface {
 	GetExternalServiceIDs(ctx context.Context, opts store.GetExternalServiceIDsOpts) ([]int64, error)
 	UserCredentials() database.UserCredentialsStore
 	GetBatchChange(ctx context.Context, opts store.GetBatchChangeOpts) (*btypes.BatchChange, error)
+	GetBatchChangeAutoMergePolicy(ctx context.Context, batchChangeID int64) (*btypes.BatchChangeAutoMergePolicy, error)
 	GitHubAppsStore() ghastore.GitHubAppsStore
 }
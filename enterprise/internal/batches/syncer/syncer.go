@@ -2,6 +2,7 @@ package syncer
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"sync"
 	"time"
@@ -22,6 +23,8 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/metrics"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/internal/redispool"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -38,6 +41,7 @@ type SyncRegistry struct {
 	syncStore   SyncStore
 	httpFactory *httpcli.Factory
 	metrics     *syncerMetrics
+	rateLimits  *sources.RateLimitBudget
 
 	// Used to receive high priority sync requests
 	priorityNotify chan []int64
@@ -66,6 +70,7 @@ func NewSyncRegistry(ctx context.Context, observationCtx *observation.Context, b
 		priorityNotify: make(chan []int64, 500),
 		syncers:        make(map[string]*changesetSyncer),
 		metrics:        makeMetrics(observationCtx),
+		rateLimits:     sources.NewRateLimitBudget(ratelimit.DefaultMonitorRegistry, redispool.Store),
 	}
 }
 
@@ -166,6 +171,7 @@ func (s *SyncRegistry) addCodeHostSyncer(codeHost *btypes.CodeHost) {
 		cancel:         cancel,
 		priorityNotify: make(chan []int64, 500),
 		metrics:        s.metrics,
+		rateLimits:     s.rateLimits,
 	}
 
 	s.syncers[syncerKey] = syncer
@@ -264,6 +270,10 @@ type changesetSyncer struct {
 
 	codeHostURL string
 
+	// rateLimits is used to hold back syncs while the rate limit budget of
+	// the credential used by the changeset is exhausted.
+	rateLimits *sources.RateLimitBudget
+
 	// scheduleInterval determines how often a new schedule will be computed.
 	// NOTE: It involves a DB query but no communication with code hosts.
 	scheduleInterval time.Duration
@@ -271,8 +281,12 @@ type changesetSyncer struct {
 	queue          *changesetPriorityQueue
 	priorityNotify chan []int64
 
+	// autoMergeLimiter limits the changesets merged by auto-merge policies on
+	// this code host.
+	autoMergeLimiter autoMergeLimiter
+
 	// Replaceable for testing
-	syncFunc func(ctx context.Context, id int64) error
+	syncFunc func(ctx context.Context, id int64, background bool) error
 
 	// cancel should be called to stop this syncer
 	cancel context.CancelFunc
@@ -285,6 +299,7 @@ type syncerMetrics struct {
 	computeScheduleDuration *prometheus.HistogramVec
 	scheduleSize            *prometheus.GaugeVec
 	behindSchedule          *prometheus.GaugeVec
+	rateLimited             *prometheus.GaugeVec
 }
 
 func makeMetrics(observationCtx *observation.Context) *syncerMetrics {
@@ -315,6 +330,10 @@ func makeMetrics(observationCtx *observation.Context) *syncerMetrics {
 			Name: "src_repoupdater_changeset_syncer_behind_schedule",
 			Help: "The number of changesets behind schedule",
 		}, []string{"codehost"}),
+		rateLimited: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "src_repoupdater_changeset_syncer_rate_limited",
+			Help: "Whether the last sync was held back by an exhausted rate limit budget",
+		}, []string{"codehost"}),
 	}
 	observationCtx.Registerer.MustRegister(m.syncs)
 	observationCtx.Registerer.MustRegister(m.priorityQueued)
@@ -322,6 +341,7 @@ func makeMetrics(observationCtx *observation.Context) *syncerMetrics {
 	observationCtx.Registerer.MustRegister(m.computeScheduleDuration)
 	observationCtx.Registerer.MustRegister(m.scheduleSize)
 	observationCtx.Registerer.MustRegister(m.behindSchedule)
+	observationCtx.Registerer.MustRegister(m.rateLimited)
 
 	return m
 }
@@ -363,13 +383,13 @@ func (s *changesetSyncer) Run(ctx context.Context) {
 
 		if ok {
 			// Queue isn't empty
-			if next.priority == priorityHigh {
-				// Fire ASAP
-				timer = time.NewTimer(0)
-			} else {
-				// Use scheduled time
-				timer = time.NewTimer(time.Until(next.nextSync))
+			var delay time.Duration
+			if next.priority != priorityHigh {
+				// Use scheduled time, high priority items fire ASAP
+				delay = time.Until(next.nextSync)
 			}
+
+			timer = time.NewTimer(delay)
 			timerChan = timer.C
 		}
 
@@ -405,7 +425,25 @@ func (s *changesetSyncer) Run(ctx context.Context) {
 			s.metrics.behindSchedule.WithLabelValues(s.codeHostURL).Set(float64(behindSchedule))
 		case <-timerChan:
 			start := s.syncStore.Clock()()
-			err := s.syncFunc(ctx, next.changesetID)
+			// Syncs that weren't requested by a user leave a part of the rate
+			// limit budget to interactive operations.
+			err := s.syncFunc(ctx, next.changesetID, next.priority != priorityHigh)
+
+			var rateLimited rateLimitedError
+			if errors.As(err, &rateLimited) {
+				// Hold the sync back until the budget has been reset, instead
+				// of letting it fail.
+				s.metrics.rateLimited.WithLabelValues(s.codeHostURL).Set(1)
+				s.queue.Remove(next.changesetID)
+				s.queue.Upsert(scheduledSync{
+					changesetID: next.changesetID,
+					nextSync:    s.syncStore.Clock()().Add(rateLimited.wait),
+					priority:    priorityNormal,
+				})
+				continue
+			}
+			s.metrics.rateLimited.WithLabelValues(s.codeHostURL).Set(0)
+
 			labelValues := []string{s.codeHostURL, strconv.FormatBool(err == nil)}
 			s.metrics.syncDuration.WithLabelValues(labelValues...).Observe(s.syncStore.Clock()().Sub(start).Seconds())
 			s.metrics.syncs.WithLabelValues(labelValues...).Inc()
@@ -461,8 +499,21 @@ func (s *changesetSyncer) computeSchedule(ctx context.Context) ([]scheduledSync,
 	return ss, nil
 }
 
-// SyncChangeset will sync a single changeset given its id.
-func (s *changesetSyncer) SyncChangeset(ctx context.Context, id int64) error {
+// rateLimitedError is returned by changesetSyncer.SyncChangeset if the sync is
+// held back, because the rate limit budget of the credential the changeset
+// uses is exhausted.
+type rateLimitedError struct {
+	wait time.Duration
+}
+
+func (e rateLimitedError) Error() string {
+	return fmt.Sprintf("rate limit budget exhausted, retrying in %s", e.wait)
+}
+
+// SyncChangeset will sync a single changeset given its id. Background syncs
+// leave a part of the rate limit budget of the credential to interactive
+// operations.
+func (s *changesetSyncer) SyncChangeset(ctx context.Context, id int64, background bool) error {
 	syncLogger := s.logger.With(log.Int64("id", id))
 	syncLogger.Debug("SyncChangeset")
 
@@ -486,6 +537,13 @@ func (s *changesetSyncer) SyncChangeset(ctx context.Context, id int64) error {
 		return err
 	}
 
+	// If the credential can't be determined, loading the source fails below.
+	if cred, err := sources.ChangesetRateLimitCredential(ctx, s.syncStore, cs, repo); err == nil {
+		if wait := s.rateLimits.Wait(cred, background); wait > 0 {
+			return rateLimitedError{wait: wait}
+		}
+	}
+
 	srcer := sources.NewSourcer(s.httpFactory)
 	source, err := srcer.ForChangeset(ctx, s.syncStore, cs, sources.AuthenticationStrategyUserCredential)
 	if err != nil {
@@ -496,7 +554,15 @@ func (s *changesetSyncer) SyncChangeset(ctx context.Context, id int64) error {
 		return err
 	}
 
-	return SyncChangeset(ctx, s.syncStore, gitserver.NewClient(), source, repo, cs)
+	if err := SyncChangeset(ctx, s.syncStore, gitserver.NewClient(), source, repo, cs); err != nil {
+		return err
+	}
+
+	if err := s.enqueueAutoMerge(ctx, cs); err != nil {
+		syncLogger.Warn("Enqueueing changeset for auto-merge", log.Error(err))
+	}
+
+	return nil
 }
 
 // SyncChangeset refreshes the metadata of the given changeset and
@@ -539,5 +605,10 @@ func SyncChangeset(ctx context.Context, syncStore SyncStore, client gitserver.Cl
 		return err
 	}
 
+	// Changesets depending on this one can be published once it is merged.
+	if err := tx.EnqueueDependentChangesets(ctx, c); err != nil {
+		return err
+	}
+
 	return tx.UpsertChangesetEvents(ctx, events...)
 }
@@ -50,7 +50,7 @@ func TestSyncerRun(t *testing.T) {
 			},
 		}, nil)
 
-		syncFunc := func(ctx context.Context, ids int64) error {
+		syncFunc := func(ctx context.Context, ids int64, background bool) error {
 			cancel()
 			return nil
 		}
@@ -122,7 +122,7 @@ func TestSyncerRun(t *testing.T) {
 		}, nil)
 
 		var syncCalled bool
-		syncFunc := func(ctx context.Context, ids int64) error {
+		syncFunc := func(ctx context.Context, ids int64, background bool) error {
 			syncCalled = true
 			return nil
 		}
@@ -143,7 +143,7 @@ func TestSyncerRun(t *testing.T) {
 		// Empty schedule but then we add an item
 		ctx, cancel := context.WithCancel(context.Background())
 
-		syncFunc := func(ctx context.Context, ids int64) error {
+		syncFunc := func(ctx context.Context, ids int64, background bool) error {
 			cancel()
 			return nil
 		}
@@ -164,6 +164,89 @@ func TestSyncerRun(t *testing.T) {
 		}
 	})
 
+	t.Run("Sync due but rate limited", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		now := time.Now()
+		syncStore := newTestStore()
+		syncStore.ListChangesetSyncDataFunc.SetDefaultReturn([]*btypes.ChangesetSyncData{
+			{
+				ChangesetID:       1,
+				UpdatedAt:         now.Add(-2 * maxSyncDelay),
+				LatestEvent:       now.Add(-2 * maxSyncDelay),
+				ExternalUpdatedAt: now.Add(-2 * maxSyncDelay),
+			},
+		}, nil)
+
+		backgroundSyncs := make(chan bool, 1)
+		syncFunc := func(ctx context.Context, ids int64, background bool) error {
+			backgroundSyncs <- background
+			return rateLimitedError{wait: time.Hour}
+		}
+		syncer := &changesetSyncer{
+			logger:           logtest.Scoped(t),
+			syncStore:        syncStore,
+			scheduleInterval: 10 * time.Minute,
+			syncFunc:         syncFunc,
+			metrics:          makeMetrics(&observation.TestContext),
+		}
+		go syncer.Run(ctx)
+
+		select {
+		case background := <-backgroundSyncs:
+			// Syncs that weren't requested by a user leave the rest of the
+			// budget to interactive operations.
+			assert.True(t, background)
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("Sync should have been triggered")
+		}
+
+		// The sync is held back until the budget has been reset, instead of
+		// being retried right away.
+		select {
+		case <-backgroundSyncs:
+			t.Fatal("Sync should not have been retried")
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+
+	t.Run("Priority added while rate limited", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		backgroundSyncs := make(chan bool, 1)
+		syncFunc := func(ctx context.Context, ids int64, background bool) error {
+			backgroundSyncs <- background
+			return rateLimitedError{wait: time.Hour}
+		}
+		syncer := &changesetSyncer{
+			logger:           logtest.Scoped(t),
+			syncStore:        newTestStore(),
+			scheduleInterval: 10 * time.Minute,
+			syncFunc:         syncFunc,
+			priorityNotify:   make(chan []int64, 1),
+			metrics:          makeMetrics(&observation.TestContext),
+		}
+		syncer.priorityNotify <- []int64{1}
+		go syncer.Run(ctx)
+
+		select {
+		case background := <-backgroundSyncs:
+			// Syncs requested by users can use the rest of the budget.
+			assert.False(t, background)
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("Sync not called")
+		}
+
+		// The held back sync loses its priority, so that it doesn't block the
+		// syncs of other changesets.
+		select {
+		case <-backgroundSyncs:
+			t.Fatal("Sync should not have been retried")
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+
 	t.Run("Sync due but reenqueued when namespace deleted", func(t *testing.T) {
 		t.Skip("skipping because flaky")
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
@@ -303,7 +386,7 @@ func TestSyncRegistry_EnqueueChangesetSyncs(t *testing.T) {
 		logger:      logtest.Scoped(t),
 		syncStore:   syncStore,
 		codeHostURL: codeHostURL,
-		syncFunc: func(ctx context.Context, id int64) error {
+		syncFunc: func(ctx context.Context, id int64, background bool) error {
 			syncChan <- id
 			return nil
 		},
@@ -49,6 +49,9 @@ type TestChangesetOpts struct {
 	OwnedByBatchChange int64
 
 	Closing    bool
+	Merging    bool
+	Conflicted bool
+	Rebasing   bool
 	IsArchived bool
 	Archive    bool
 
@@ -99,7 +102,10 @@ func BuildChangeset(opts TestChangesetOpts) *btypes.Changeset {
 
 		OwnedByBatchChangeID: opts.OwnedByBatchChange,
 
-		Closing: opts.Closing,
+		Closing:    opts.Closing,
+		Merging:    opts.Merging,
+		Conflicted: opts.Conflicted,
+		Rebasing:   opts.Rebasing,
 
 		ReconcilerState: opts.ReconcilerState,
 		NumFailures:     opts.NumFailures,
@@ -39,6 +39,8 @@ type TestSpecOpts struct {
 	BaseRev string
 	BaseRef string
 
+	DependsOn []string
+
 	Typ btypes.ChangesetSpecType
 }
 
@@ -75,6 +77,7 @@ func BuildChangesetSpec(t *testing.T, opts TestSpecOpts) *btypes.ChangesetSpec {
 		DiffStatAdded:     TestChangsetSpecDiffStat.Added,
 		DiffStatDeleted:   TestChangsetSpecDiffStat.Deleted,
 		Type:              opts.Typ,
+		DependsOn:         opts.DependsOn,
 	}
 
 	return spec
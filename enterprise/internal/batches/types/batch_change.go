@@ -36,6 +36,15 @@ type BatchChange struct {
 
 	ClosedAt time.Time
 
+	// Schedule is the cron expression on which the batch change is
+	// re-executed and re-applied. It is empty for batch changes that are not
+	// run on a schedule.
+	Schedule       string
+	SchedulePaused bool
+	// NextScheduledRunAt is the time at which the next scheduled run of the
+	// batch change is due.
+	NextScheduledRunAt time.Time
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -54,6 +63,10 @@ func (c *BatchChange) Closed() bool { return !c.ClosedAt.IsZero() }
 // yet.
 func (c *BatchChange) IsDraft() bool { return c.LastAppliedAt.IsZero() }
 
+// Scheduled returns true when the batch change has a schedule that is not
+// paused.
+func (c *BatchChange) Scheduled() bool { return c.Schedule != "" && !c.SchedulePaused }
+
 // State returns the user-visible state, collapsing the other state fields into
 // one.
 func (c *BatchChange) State() BatchChangeState {
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types/scheduler/window"
+)
+
+// AutoMergeMethod defines how an auto-merge policy merges changesets.
+type AutoMergeMethod string
+
+// AutoMergeMethod constants.
+const (
+	AutoMergeMethodMerge  AutoMergeMethod = "MERGE"
+	AutoMergeMethodSquash AutoMergeMethod = "SQUASH"
+)
+
+// Valid returns true if the given AutoMergeMethod is valid.
+func (m AutoMergeMethod) Valid() bool {
+	switch m {
+	case AutoMergeMethodMerge, AutoMergeMethodSquash:
+		return true
+	default:
+		return false
+	}
+}
+
+// BatchChangeAutoMergePolicy describes when the changesets owned by a batch
+// change are merged automatically once they have been synced.
+type BatchChangeAutoMergePolicy struct {
+	BatchChangeID int64
+
+	RequiredApprovals    int32
+	RequirePassingChecks bool
+	MergeMethod          AutoMergeMethod
+
+	// MaintenanceWindowDays, MaintenanceWindowStart, and MaintenanceWindowEnd
+	// restrict merges to a window in UTC. Empty values mean that the window
+	// is always open.
+	MaintenanceWindowDays  []string
+	MaintenanceWindowStart string
+	MaintenanceWindowEnd   string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// MaintenanceWindow parses the maintenance window of the policy.
+func (p *BatchChangeAutoMergePolicy) MaintenanceWindow() (window.Window, error) {
+	return window.ParseMaintenanceWindow(p.MaintenanceWindowDays, p.MaintenanceWindowStart, p.MaintenanceWindowEnd)
+}
+
+// Evaluate returns whether the given changeset with the given number of
+// approvals should be merged at the given time. If not, the returned string
+// explains why.
+func (p *BatchChangeAutoMergePolicy) Evaluate(c *Changeset, approvals int, now time.Time) (bool, string) {
+	if c.ExternalState != ChangesetExternalStateOpen {
+		return false, fmt.Sprintf("changeset is %s", c.ExternalState)
+	}
+	if c.ExternalReviewState == ChangesetReviewStateChangesRequested {
+		return false, "changes have been requested"
+	}
+	if approvals < int(p.RequiredApprovals) {
+		return false, fmt.Sprintf("%d of %d required approvals", approvals, p.RequiredApprovals)
+	}
+	if p.RequirePassingChecks && c.ExternalCheckState != ChangesetCheckStatePassed {
+		return false, fmt.Sprintf("checks are %s", c.ExternalCheckState)
+	}
+
+	w, err := p.MaintenanceWindow()
+	if err != nil {
+		return false, fmt.Sprintf("invalid maintenance window: %s", err)
+	}
+	if !w.IsOpen(now.UTC()) {
+		return false, "maintenance window is closed"
+	}
+
+	return true, ""
+}
+
+// ChangesetAutoMergeEvent is the metadata of the changeset events that record
+// the decisions of an auto-merge policy.
+type ChangesetAutoMergeEvent struct {
+	BatchChangeID int64               `json:"batchChangeID"`
+	MergeMethod   AutoMergeMethod     `json:"mergeMethod"`
+	Approvals     int                 `json:"approvals"`
+	CheckState    ChangesetCheckState `json:"checkState"`
+	// Message is the error returned by the code host when the merge failed.
+	Message   string    `json:"message,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBatchChangeAutoMergePolicy_Evaluate(t *testing.T) {
+	// A Saturday.
+	now := time.Date(2023, 6, 24, 3, 0, 0, 0, time.UTC)
+
+	mergeable := func() *Changeset {
+		return &Changeset{
+			ExternalState:       ChangesetExternalStateOpen,
+			ExternalReviewState: ChangesetReviewStateApproved,
+			ExternalCheckState:  ChangesetCheckStatePassed,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		policy    BatchChangeAutoMergePolicy
+		changeset func() *Changeset
+		approvals int
+		want      bool
+	}{
+		"no requirements": {
+			changeset: mergeable,
+			want:      true,
+		},
+		"closed changeset": {
+			changeset: func() *Changeset {
+				c := mergeable()
+				c.ExternalState = ChangesetExternalStateClosed
+				return c
+			},
+			want: false,
+		},
+		"draft changeset": {
+			changeset: func() *Changeset {
+				c := mergeable()
+				c.ExternalState = ChangesetExternalStateDraft
+				return c
+			},
+			want: false,
+		},
+		"changes requested": {
+			changeset: func() *Changeset {
+				c := mergeable()
+				c.ExternalReviewState = ChangesetReviewStateChangesRequested
+				return c
+			},
+			approvals: 3,
+			want:      false,
+		},
+		"not enough approvals": {
+			policy:    BatchChangeAutoMergePolicy{RequiredApprovals: 2},
+			changeset: mergeable,
+			approvals: 1,
+			want:      false,
+		},
+		"enough approvals": {
+			policy:    BatchChangeAutoMergePolicy{RequiredApprovals: 2},
+			changeset: mergeable,
+			approvals: 2,
+			want:      true,
+		},
+		"pending checks": {
+			policy: BatchChangeAutoMergePolicy{RequirePassingChecks: true},
+			changeset: func() *Changeset {
+				c := mergeable()
+				c.ExternalCheckState = ChangesetCheckStatePending
+				return c
+			},
+			want: false,
+		},
+		"pending checks not required": {
+			changeset: func() *Changeset {
+				c := mergeable()
+				c.ExternalCheckState = ChangesetCheckStatePending
+				return c
+			},
+			want: true,
+		},
+		"inside maintenance window": {
+			policy: BatchChangeAutoMergePolicy{
+				MaintenanceWindowDays:  []string{"saturday"},
+				MaintenanceWindowStart: "01:00",
+				MaintenanceWindowEnd:   "05:00",
+			},
+			changeset: mergeable,
+			want:      true,
+		},
+		"outside maintenance window": {
+			policy: BatchChangeAutoMergePolicy{
+				MaintenanceWindowDays: []string{"monday"},
+			},
+			changeset: mergeable,
+			want:      false,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			have, reason := tc.policy.Evaluate(tc.changeset(), tc.approvals, now)
+			if have != tc.want {
+				t.Errorf("unexpected result: have=%t want=%t (reason: %q)", have, tc.want, reason)
+			}
+			if !have && reason == "" {
+				t.Error("no reason given")
+			}
+		})
+	}
+}
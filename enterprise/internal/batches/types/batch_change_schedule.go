@@ -0,0 +1,83 @@
+package types
+
+import (
+	"time"
+
+	"github.com/hashicorp/cronexpr"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// MinBatchChangeScheduleInterval is the shortest interval allowed between two
+// scheduled runs of a batch change. Every run re-resolves and re-executes all
+// workspaces, so running more often would mostly queue up redundant work.
+const MinBatchChangeScheduleInterval = time.Hour
+
+// ParseBatchChangeSchedule parses the given cron expression and validates that
+// it doesn't run more often than MinBatchChangeScheduleInterval.
+func ParseBatchChangeSchedule(schedule string) (*cronexpr.Expression, error) {
+	expr, err := cronexpr.Parse(schedule)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid schedule %q", schedule)
+	}
+
+	runs := expr.NextN(time.Now(), 2)
+	if len(runs) == 0 {
+		return nil, errors.Newf("schedule %q never runs", schedule)
+	}
+	if len(runs) == 2 && runs[1].Sub(runs[0]) < MinBatchChangeScheduleInterval {
+		return nil, errors.Newf("schedule %q runs more often than once every %s", schedule, MinBatchChangeScheduleInterval)
+	}
+
+	return expr, nil
+}
+
+// BatchChangeScheduledRunState defines the possible states of a scheduled run
+// of a batch change.
+type BatchChangeScheduledRunState string
+
+// BatchChangeScheduledRunState constants.
+const (
+	BatchChangeScheduledRunStateResolving BatchChangeScheduledRunState = "RESOLVING"
+	BatchChangeScheduledRunStateExecuting BatchChangeScheduledRunState = "EXECUTING"
+	BatchChangeScheduledRunStateCompleted BatchChangeScheduledRunState = "COMPLETED"
+	BatchChangeScheduledRunStateFailed    BatchChangeScheduledRunState = "FAILED"
+)
+
+// Valid returns true if the given BatchChangeScheduledRunState is valid.
+func (s BatchChangeScheduledRunState) Valid() bool {
+	switch s {
+	case BatchChangeScheduledRunStateResolving,
+		BatchChangeScheduledRunStateExecuting,
+		BatchChangeScheduledRunStateCompleted,
+		BatchChangeScheduledRunStateFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// Finished returns true if the run won't transition to another state.
+func (s BatchChangeScheduledRunState) Finished() bool {
+	return s == BatchChangeScheduledRunStateCompleted || s == BatchChangeScheduledRunStateFailed
+}
+
+// BatchChangeScheduledRun is a single scheduled re-execution of a batch
+// change. A run creates a new batch spec from the raw spec of the batch
+// change, executes it, and applies it once all workspaces have been executed.
+type BatchChangeScheduledRun struct {
+	ID int64
+
+	BatchChangeID int64
+	// BatchSpecID is the batch spec created for this run. It is zero if the
+	// run failed before the batch spec was created, or if the batch spec has
+	// since been deleted.
+	BatchSpecID int64
+
+	State          BatchChangeScheduledRunState
+	FailureMessage string
+	FinishedAt     time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,31 @@
+package types
+
+import "testing"
+
+func TestParseBatchChangeSchedule(t *testing.T) {
+	for _, schedule := range []string{
+		"@daily",
+		"@weekly",
+		"0 * * * *",
+		"30 4 * * 1-5",
+	} {
+		t.Run(schedule, func(t *testing.T) {
+			if _, err := ParseBatchChangeSchedule(schedule); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+
+	for _, schedule := range []string{
+		"",
+		"not a schedule",
+		"*/5 * * * *",
+		"* * * * *",
+	} {
+		t.Run(schedule, func(t *testing.T) {
+			if _, err := ParseBatchChangeSchedule(schedule); err == nil {
+				t.Error("unexpected nil error")
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/emailpatch"
 	gerritbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gerrit"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
 
@@ -48,6 +49,7 @@ const (
 	ChangesetStateReadOnly    ChangesetState = "READONLY"
 	ChangesetStateRetrying    ChangesetState = "RETRYING"
 	ChangesetStateFailed      ChangesetState = "FAILED"
+	ChangesetStateConflicted  ChangesetState = "CONFLICTED"
 )
 
 // Valid returns true if the given ChangesetState is valid.
@@ -63,7 +65,8 @@ func (s ChangesetState) Valid() bool {
 		ChangesetStateDeleted,
 		ChangesetStateReadOnly,
 		ChangesetStateRetrying,
-		ChangesetStateFailed:
+		ChangesetStateFailed,
+		ChangesetStateConflicted:
 		return true
 	default:
 		return false
@@ -313,6 +316,25 @@ type Changeset struct {
 	// reconciler should close the changeset.
 	Closing bool
 
+	// Merging is set to true (along with the ReconcilerState) when an
+	// auto-merge policy decided that the reconciler should merge the
+	// changeset.
+	Merging bool
+
+	// Conflicted is true when the changeset is open, but its diff no longer
+	// applies cleanly to the head of its base branch.
+	Conflicted bool
+	// ConflictCheckBaseRev and ConflictCheckHeadRev are the revisions of the
+	// base branch and the changeset the last time Conflicted was computed. The
+	// check is only done again when either of them changed.
+	ConflictCheckBaseRev string
+	ConflictCheckHeadRev string
+
+	// Rebasing is set to true (along with the ReconcilerState) when the
+	// reconciler should re-apply the changeset's diff to the head of its base
+	// branch and force-push it.
+	Rebasing bool
+
 	// DetachedAt is the time when the changeset became "detached".
 	DetachedAt time.Time
 }
@@ -470,12 +492,23 @@ func (c *Changeset) SetMetadata(meta any) error {
 		c.ExternalServiceType = extsvc.TypeGerrit
 		c.ExternalBranch = gitdomain.EnsureRefPrefix(pr.Change.Branch)
 		c.ExternalUpdatedAt = pr.Change.Updated
+		// Changes of a topic are reviewed and submitted together, so activity
+		// on any of them should get the changeset synced sooner.
+		if pr.Topic != nil && pr.Topic.UpdatedAt.After(c.ExternalUpdatedAt) {
+			c.ExternalUpdatedAt = pr.Topic.UpdatedAt
+		}
 	case *protocol.PerforceChangelist:
 		c.Metadata = pr
 		c.ExternalID = pr.ID
 		c.ExternalServiceType = extsvc.TypePerforce
 		// Perforce does not have a last updated at field on its CL objects, so we set the creation time.
 		c.ExternalUpdatedAt = pr.CreationDate
+	case *emailpatch.Series:
+		c.Metadata = pr
+		c.ExternalID = pr.MessageID
+		c.ExternalServiceType = emailpatch.ExternalServiceType
+		c.ExternalBranch = gitdomain.EnsureRefPrefix(pr.HeadRef)
+		c.ExternalUpdatedAt = pr.UpdatedAt
 	default:
 		return errors.New("setmetadata unknown changeset type")
 	}
@@ -512,6 +545,8 @@ func (c *Changeset) Title() (string, error) {
 		return title, nil
 	case *protocol.PerforceChangelist:
 		return m.Title, nil
+	case *emailpatch.Series:
+		return m.Title, nil
 	default:
 		return "", errors.New("title unknown changeset type")
 	}
@@ -539,6 +574,8 @@ func (c *Changeset) AuthorName() (string, error) {
 		return m.Change.Owner.Name, nil
 	case *protocol.PerforceChangelist:
 		return m.Author, nil
+	case *emailpatch.Series:
+		return m.AuthorName, nil
 	default:
 		return "", errors.New("authorname unknown changeset type")
 	}
@@ -574,6 +611,8 @@ func (c *Changeset) AuthorEmail() (string, error) {
 		return m.Change.Owner.Email, nil
 	case *protocol.PerforceChangelist:
 		return "", nil
+	case *emailpatch.Series:
+		return m.AuthorEmail, nil
 	default:
 		return "", errors.New("author email unknown changeset type")
 	}
@@ -598,6 +637,8 @@ func (c *Changeset) ExternalCreatedAt() time.Time {
 		return m.Change.Created
 	case *protocol.PerforceChangelist:
 		return m.CreationDate
+	case *emailpatch.Series:
+		return m.CreatedAt
 	default:
 		return time.Time{}
 	}
@@ -621,6 +662,8 @@ func (c *Changeset) Body() (string, error) {
 		return m.Change.Subject, nil
 	case *protocol.PerforceChangelist:
 		return "", nil
+	case *emailpatch.Series:
+		return m.Body, nil
 	default:
 		return "", errors.New("body unknown changeset type")
 	}
@@ -689,6 +732,10 @@ func (c *Changeset) URL() (s string, err error) {
 		return m.CodeHostURL.JoinPath("c", url.PathEscape(m.Change.Project), "+", url.PathEscape(strconv.Itoa(m.Change.ChangeNumber))).String(), nil
 	case *protocol.PerforceChangelist:
 		return "", nil
+	case *emailpatch.Series:
+		// Mailing list archives differ between projects, so there is no URL
+		// we could link to.
+		return "", nil
 	default:
 		return "", errors.New("url unknown changeset type")
 	}
@@ -910,6 +957,9 @@ func (c *Changeset) Events() (events []*ChangesetEvent, err error) {
 	case *protocol.PerforceChangelist:
 		// We don't have any events we care about right now
 		break
+	case *emailpatch.Series:
+		// Replies on the mailing list are not tracked.
+		break
 	}
 
 	return events, nil
@@ -934,6 +984,8 @@ func (c *Changeset) HeadRefOid() (string, error) {
 		return "", nil
 	case *protocol.PerforceChangelist:
 		return "", nil
+	case *emailpatch.Series:
+		return "", nil
 	default:
 		return "", errors.New("head ref oid unknown changeset type")
 	}
@@ -957,6 +1009,8 @@ func (c *Changeset) HeadRef() (string, error) {
 		return "", nil
 	case *protocol.PerforceChangelist:
 		return "", nil
+	case *emailpatch.Series:
+		return m.HeadRef, nil
 	default:
 		return "", errors.New("headref unknown changeset type")
 	}
@@ -981,6 +1035,8 @@ func (c *Changeset) BaseRefOid() (string, error) {
 		return "", nil
 	case *protocol.PerforceChangelist:
 		return "", nil
+	case *emailpatch.Series:
+		return "", nil
 	default:
 		return "", errors.New("base ref oid unknown changeset type")
 	}
@@ -1005,6 +1061,8 @@ func (c *Changeset) BaseRef() (string, error) {
 	case *protocol.PerforceChangelist:
 		// TODO: @peterguy we may need to change this to something.
 		return "", nil
+	case *emailpatch.Series:
+		return m.BaseRef, nil
 	default:
 		return "", errors.New(" base ref unknown changeset type")
 	}
@@ -1228,6 +1286,7 @@ type ChangesetsStats struct {
 	Processing int32
 	Deleted    int32
 	Archived   int32
+	Conflicted int32
 }
 
 // ChangesetEventKindFor returns the ChangesetEventKind for the given
@@ -1548,6 +1607,12 @@ func NewChangesetEventMetadata(k ChangesetEventKind) (any, error) {
 			ChangesetEventKindGerritChangeBuildSucceeded:
 			return new(gerrit.Reviewer), nil
 		}
+	case strings.HasPrefix(string(k), "sourcegraph"):
+		switch k {
+		case ChangesetEventKindAutoMergeEnqueued,
+			ChangesetEventKindAutoMergeFailed:
+			return new(ChangesetAutoMergeEvent), nil
+		}
 	}
 	return nil, errors.Errorf("changeset event metadata unknown changeset event kind %q", k)
 }
@@ -0,0 +1,49 @@
+package types
+
+import "time"
+
+// ChangesetCountSnapshotGrouping defines the dimensions by which daily
+// changeset count snapshots can be aggregated.
+type ChangesetCountSnapshotGrouping string
+
+// ChangesetCountSnapshotGrouping constants.
+const (
+	ChangesetCountSnapshotGroupingBatchChange  ChangesetCountSnapshotGrouping = "BATCH_CHANGE"
+	ChangesetCountSnapshotGroupingNamespace    ChangesetCountSnapshotGrouping = "NAMESPACE"
+	ChangesetCountSnapshotGroupingCodeHost     ChangesetCountSnapshotGrouping = "CODE_HOST"
+	ChangesetCountSnapshotGroupingRepoMetadata ChangesetCountSnapshotGrouping = "REPO_METADATA"
+	ChangesetCountSnapshotGroupingOwnerTeam    ChangesetCountSnapshotGrouping = "OWNER_TEAM"
+)
+
+// Valid returns true if the given ChangesetCountSnapshotGrouping is valid.
+func (g ChangesetCountSnapshotGrouping) Valid() bool {
+	switch g {
+	case ChangesetCountSnapshotGroupingBatchChange,
+		ChangesetCountSnapshotGroupingNamespace,
+		ChangesetCountSnapshotGroupingCodeHost,
+		ChangesetCountSnapshotGroupingRepoMetadata,
+		ChangesetCountSnapshotGroupingOwnerTeam:
+		return true
+	default:
+		return false
+	}
+}
+
+// ChangesetCountSnapshot is the number of published changesets in each state
+// on a given day, summed up over the snapshots that fall into the same Group.
+type ChangesetCountSnapshot struct {
+	// Group is the value of the dimension the snapshots were grouped by, for
+	// example the name of the code host or the owner team. It is empty for the
+	// snapshots of repositories that have no value for the dimension.
+	Group string
+	Date  time.Time
+
+	Total                int32
+	Merged               int32
+	Closed               int32
+	Draft                int32
+	Open                 int32
+	OpenApproved         int32
+	OpenChangesRequested int32
+	OpenPending          int32
+}
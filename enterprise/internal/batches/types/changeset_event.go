@@ -125,6 +125,9 @@ const (
 	ChangesetEventKindGerritChangeBuildFailed             ChangesetEventKind = "gerrit:change:build_failed"
 	ChangesetEventKindGerritChangeBuildPending            ChangesetEventKind = "gerrit:change:build_pending"
 
+	ChangesetEventKindAutoMergeEnqueued ChangesetEventKind = "sourcegraph:auto_merge_enqueued"
+	ChangesetEventKindAutoMergeFailed   ChangesetEventKind = "sourcegraph:auto_merge_failed"
+
 	ChangesetEventKindInvalid ChangesetEventKind = "invalid"
 )
 
@@ -370,6 +373,8 @@ func (e *ChangesetEvent) Timestamp() time.Time {
 		t = ev.CreatedDate
 	case *azuredevops.PullRequestMergedEvent:
 		t = ev.CreatedDate
+	case *ChangesetAutoMergeEvent:
+		t = ev.CreatedAt
 	}
 
 	return t
@@ -913,6 +918,9 @@ func (e *ChangesetEvent) Update(o *ChangesetEvent) error {
 	case *azuredevops.PullRequestRejectedEvent:
 		o := o.Metadata.(*azuredevops.PullRequestRejectedEvent)
 		*e = *o
+	case *ChangesetAutoMergeEvent:
+		o := o.Metadata.(*ChangesetAutoMergeEvent)
+		*e = *o
 	default:
 		return errors.Errorf("unknown changeset event metadata %T", e)
 	}
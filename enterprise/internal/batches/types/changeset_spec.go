@@ -74,6 +74,11 @@ func NewChangesetSpecFromSpec(spec *batcheslib.ChangesetSpec) (*ChangesetSpec, e
 		c.CommitMessage = commitMsg
 		c.CommitAuthorName = authorName
 		c.CommitAuthorEmail = authorEmail
+		c.DependsOn = spec.DependsOn
+		if spec.CodeOwners != nil {
+			c.RequestOwnerReviews = spec.CodeOwners.RequestReviews
+			c.AssignOwners = spec.CodeOwners.Assign
+		}
 	}
 
 	c.computeForkNamespace(spec.Fork)
@@ -116,6 +121,18 @@ type ChangesetSpec struct {
 	CommitAuthorEmail string
 
 	ForkNamespace *string
+
+	// DependsOn are the names of the repositories whose changesets have to be
+	// merged before this changeset is published. The prerequisite changesets
+	// are the ones created from changeset specs of the same batch spec.
+	DependsOn []string
+
+	// RequestOwnerReviews requests a review from the code owners of the
+	// files touched by Diff when the changeset is published.
+	RequestOwnerReviews bool
+	// AssignOwners assigns the code owners of the files touched by Diff to
+	// the changeset when it is published.
+	AssignOwners bool
 }
 
 // Clone returns a clone of a ChangesetSpec.
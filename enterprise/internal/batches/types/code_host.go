@@ -16,3 +16,15 @@ type CodeHost struct {
 func (c *CodeHost) IsSupported() bool {
 	return IsKindSupported(extsvc.TypeToKind(c.ExternalServiceType))
 }
+
+// CodeHostQueueDepth is the number of changesets of a code host that are
+// queued for the reconciler.
+type CodeHostQueueDepth struct {
+	ExternalServiceType string
+	ExternalServiceID   string
+	// Queued is the number of changesets that can be processed right away.
+	Queued int
+	// Deferred is the number of changesets whose processing has been deferred,
+	// for example because the rate limit of the code host is exhausted.
+	Deferred int
+}
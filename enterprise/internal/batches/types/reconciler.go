@@ -17,6 +17,7 @@ const (
 	ReconcilerOperationDetach       ReconcilerOperation = "DETACH"
 	ReconcilerOperationArchive      ReconcilerOperation = "ARCHIVE"
 	ReconcilerOperationReattach     ReconcilerOperation = "REATTACH"
+	ReconcilerOperationMerge        ReconcilerOperation = "MERGE"
 )
 
 // Valid returns true if the given ReconcilerOperation is valid.
@@ -34,7 +35,8 @@ func (r ReconcilerOperation) Valid() bool {
 		ReconcilerOperationSleep,
 		ReconcilerOperationDetach,
 		ReconcilerOperationArchive,
-		ReconcilerOperationReattach:
+		ReconcilerOperationReattach,
+		ReconcilerOperationMerge:
 		return true
 	default:
 		return false
@@ -159,3 +159,15 @@ func parseWindow(raw *schema.BatchChangeRolloutWindow) (Window, error) {
 
 	return w, errs
 }
+
+// ParseMaintenanceWindow parses a window without a rate limit from the given
+// days and times of day. It is used by features that only care whether a
+// window is open, such as auto-merge policies.
+func ParseMaintenanceWindow(days []string, start, end string) (Window, error) {
+	return parseWindow(&schema.BatchChangeRolloutWindow{
+		Days:  days,
+		Start: start,
+		End:   end,
+		Rate:  "unlimited",
+	})
+}
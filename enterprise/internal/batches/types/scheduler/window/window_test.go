@@ -375,3 +375,30 @@ func TestParseWindow(t *testing.T) {
 		}
 	})
 }
+
+func TestParseMaintenanceWindow(t *testing.T) {
+	t.Run("errors", func(t *testing.T) {
+		if _, err := ParseMaintenanceWindow([]string{"martedi"}, "", ""); err == nil {
+			t.Error("unexpected nil error")
+		}
+		if _, err := ParseMaintenanceWindow(nil, "01:00", ""); err == nil {
+			t.Error("unexpected nil error")
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		have, err := ParseMaintenanceWindow([]string{"saturday"}, "01:15", "02:30")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := Window{
+			days:  newWeekdaySet(time.Saturday),
+			rate:  rate{n: -1},
+			start: timeOfDayPtr(1, 15),
+			end:   timeOfDayPtr(2, 30),
+		}
+		if diff := cmp.Diff(have, want, cmpOptions); diff != "" {
+			t.Errorf("unexpected window (-have +want):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,67 @@
+package inference
+
+import (
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/autoindexing/internal/inference/libs"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func dotnetJob(root, buildFile string) config.IndexJob {
+	expectedIndexerImage, _ := libs.DefaultIndexerForLang("dotnet")
+	return config.IndexJob{
+		Steps: []config.DockerStep{
+			{
+				Root:     root,
+				Image:    expectedIndexerImage,
+				Commands: []string{"dotnet restore " + buildFile},
+			},
+		},
+		LocalSteps:  nil,
+		Root:        root,
+		Indexer:     expectedIndexerImage,
+		IndexerArgs: []string{"scip-dotnet", "index", buildFile},
+		Outfile:     "index.scip",
+	}
+}
+
+func TestDotnetGenerator(t *testing.T) {
+	testGenerators(t,
+		generatorTestCase{
+			description: "dotnet solution",
+			repositoryContents: map[string]string{
+				"App.sln":                          "",
+				"src/App/App.csproj":               "",
+				"src/App/Program.cs":               "",
+				"src/App.Core/App.Core.csproj":     "",
+				"src/App.Core/bin/Debug/Stale.sln": "",
+			},
+			expected: []config.IndexJob{dotnetJob("", "App.sln")},
+		},
+		generatorTestCase{
+			description: "dotnet project without solution",
+			repositoryContents: map[string]string{
+				"App.csproj": "",
+				"Program.cs": "",
+			},
+			expected: []config.IndexJob{dotnetJob("", "App.csproj")},
+		},
+		generatorTestCase{
+			description: "dotnet multiple solutions and standalone projects",
+			repositoryContents: map[string]string{
+				"backend/Backend.sln":                      "",
+				"backend/Api/Api.csproj":                   "",
+				"backend/Other.sln":                        "",
+				"tools/Cli/Cli.csproj":                     "",
+				"tools/Legacy/Legacy.vbproj":               "",
+				"backend/tests/Api.Tests/Api.Tests.csproj": "",
+			},
+			expected: []config.IndexJob{
+				dotnetJob("backend", "Backend.sln"),
+				dotnetJob("backend", "Other.sln"),
+				dotnetJob("tools/Cli", "Cli.csproj"),
+				dotnetJob("tools/Legacy", "Legacy.vbproj"),
+			},
+		},
+	)
+}
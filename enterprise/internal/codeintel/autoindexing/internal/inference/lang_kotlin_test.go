@@ -0,0 +1,48 @@
+package inference
+
+import (
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func TestKotlinGenerator(t *testing.T) {
+	testGenerators(t,
+		generatorTestCase{
+			description: "Kotlin project with Gradle Kotlin DSL",
+			repositoryContents: map[string]string{
+				"build.gradle.kts":                   "",
+				"src/main/kotlin/com/example/App.kt": "",
+			},
+			expected: []config.IndexJob{autoJob("")},
+		},
+		generatorTestCase{
+			description: "Multi-project Kotlin build with settings file",
+			repositoryContents: map[string]string{
+				"settings.gradle.kts":                        "",
+				"app/build.gradle.kts":                       "",
+				"app/src/main/kotlin/com/example/App.kt":     "",
+				"lib/build.gradle.kts":                       "",
+				"lib/src/main/kotlin/com/example/Library.kt": "",
+			},
+			expected: []config.IndexJob{autoJob("")},
+		},
+		generatorTestCase{
+			description: "Independent Kotlin projects",
+			repositoryContents: map[string]string{
+				"server/settings.gradle.kts":                      "",
+				"server/src/main/kotlin/com/example/Server.kt":    "",
+				"android/build.gradle.kts":                        "",
+				"android/app/src/main/kotlin/com/example/Main.kt": "",
+			},
+			expected: []config.IndexJob{autoJob("android"), autoJob("server")},
+		},
+		generatorTestCase{
+			description: "Kotlin build without sources",
+			repositoryContents: map[string]string{
+				"settings.gradle.kts": "",
+			},
+			expected: []config.IndexJob{},
+		},
+	)
+}
@@ -0,0 +1,63 @@
+package inference
+
+import (
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/autoindexing/internal/inference/libs"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func phpJob(root string) config.IndexJob {
+	expectedIndexerImage, _ := libs.DefaultIndexerForLang("php")
+	return config.IndexJob{
+		Steps: []config.DockerStep{
+			{
+				Root:  root,
+				Image: expectedIndexerImage,
+				Commands: []string{
+					"composer install --no-interaction --no-scripts --ignore-platform-reqs",
+					"composer require --dev --no-interaction --ignore-platform-reqs davidrjenni/scip-php",
+				},
+			},
+		},
+		LocalSteps:  nil,
+		Root:        root,
+		Indexer:     expectedIndexerImage,
+		IndexerArgs: []string{"vendor/bin/scip-php"},
+		Outfile:     "index.scip",
+	}
+}
+
+func TestPHPGenerator(t *testing.T) {
+	testGenerators(t,
+		generatorTestCase{
+			description: "composer project",
+			repositoryContents: map[string]string{
+				"composer.json":                     "",
+				"composer.lock":                     "",
+				"src/Foo.php":                       "",
+				"vendor/acme/widgets/composer.json": "",
+			},
+			expected: []config.IndexJob{phpJob("")},
+		},
+		generatorTestCase{
+			description: "composer monorepo with nested packages",
+			repositoryContents: map[string]string{
+				"composer.json":                "",
+				"packages/core/composer.json":  "",
+				"packages/http/composer.json":  "",
+				"packages/http/src/Client.php": "",
+			},
+			expected: []config.IndexJob{phpJob("")},
+		},
+		generatorTestCase{
+			description: "multiple composer projects",
+			repositoryContents: map[string]string{
+				"api/composer.json":                     "",
+				"admin/composer.json":                   "",
+				"api/vendor/acme/widgets/composer.json": "",
+			},
+			expected: []config.IndexJob{phpJob("admin"), phpJob("api")},
+		},
+	)
+}
@@ -17,8 +17,10 @@ type indexesAPI struct{}
 
 var defaultIndexers = map[string]string{
 	"clang":      "sourcegraph/lsif-clang",
+	"dotnet":     "sourcegraph/scip-dotnet",
 	"go":         "sourcegraph/scip-go",
 	"java":       "sourcegraph/scip-java",
+	"php":        "composer",
 	"python":     "sourcegraph/scip-python",
 	"rust":       "sourcegraph/scip-rust",
 	"typescript": "sourcegraph/scip-typescript",
@@ -37,6 +39,13 @@ var defaultIndexerSHAs = map[string]string{
 	"sourcegraph/scip-ruby":       "sha256:f18eb10da9cc1998a7d5b123deefae0f69016614cbf323ec5edcd09a529d466e",
 }
 
+// Indexers without a recorded digest are referenced by tag. The PHP indexer runs in the
+// official composer image, as scip-php is installed into the project being indexed.
+var defaultIndexerTags = map[string]string{
+	"sourcegraph/scip-dotnet": "latest",
+	"composer":                "2",
+}
+
 func DefaultIndexerForLang(language string) (string, bool) {
 	indexer, ok := defaultIndexers[language]
 	if !ok {
@@ -45,7 +54,12 @@ func DefaultIndexerForLang(language string) (string, bool) {
 
 	sha, ok := defaultIndexerSHAs[indexer]
 	if !ok {
-		panic(fmt.Sprintf("no SHA set for indexer %q", indexer))
+		tag, ok := defaultIndexerTags[indexer]
+		if !ok {
+			panic(fmt.Sprintf("no SHA set for indexer %q", indexer))
+		}
+
+		return fmt.Sprintf("%s:%s", indexer, tag), true
 	}
 
 	return fmt.Sprintf("%s@%s", indexer, sha), true
@@ -5,17 +5,31 @@ set -u
 DOCKER_USER=${DOCKER_USER:?"No DOCKER_USER is set."}
 DOCKER_PASS=${DOCKER_PASS:?"No DOCKER_PASS is set."}
 
-for indexer in lsif-clang scip-go lsif-rust scip-rust scip-java scip-python scip-typescript scip-ruby; do
-  tag="latest"
-  if [[ "${indexer}" = "scip-python" ]] || [[ "${indexer}" = "scip-typescript" || "${indexer}" = "scip-ruby" ]]; then
-    tag="autoindex"
-  fi
+for image in \
+  sourcegraph/lsif-clang:latest \
+  sourcegraph/scip-go:latest \
+  sourcegraph/lsif-rust:latest \
+  sourcegraph/scip-rust:latest \
+  sourcegraph/scip-java:latest \
+  sourcegraph/scip-python:autoindex \
+  sourcegraph/scip-typescript:autoindex \
+  sourcegraph/scip-ruby:autoindex \
+  sourcegraph/scip-dotnet:latest \
+  composer:2; do
+  indexer="${image%:*}"
 
-  sha=$(docker buildx imagetools inspect sourcegraph/${indexer}:${tag} --raw | sha256sum | awk '{print "\"" "sha256:" $1 "\""}')
+  sha=$(docker buildx imagetools inspect "${image}" --raw | sha256sum | awk '{print "\"" "sha256:" $1 "\""}')
 
-  sed -i.bak \
-    "s|\("'"'"sourcegraph/${indexer}"'"'":\).*|\1${sha},|g" \
-    indexes.go
+  if sed -n '/^var defaultIndexerSHAs = /,/^}/p' indexes.go | grep -q "^	\"${indexer}\":"; then
+    sed -i.bak \
+      "/^var defaultIndexerSHAs = /,/^}/ s|\("'"'"${indexer}"'"'":\).*|\1${sha},|g" \
+      indexes.go
+  else
+    # Add the indexer to the end of defaultIndexerSHAs
+    sed -i.bak \
+      "/^var defaultIndexerSHAs = /,/^}/ s|^}|	\"${indexer}\": ${sha},\n}|" \
+      indexes.go
+  fi
 
   echo "Updated tag for ${indexer}"
   rm indexes.go.bak
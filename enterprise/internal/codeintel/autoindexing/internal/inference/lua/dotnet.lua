@@ -0,0 +1,108 @@
+local path = require "path"
+local recognizer = require "sg.autoindex.recognizer"
+local pattern = require "sg.autoindex.patterns"
+
+local indexer = require("sg.autoindex.indexes").get "dotnet"
+local outfile = "index.scip"
+
+-- Returns true if the given path is within a build output directory.
+local is_build_output = function(filepath)
+  local ancestors = path.ancestors(filepath)
+  for i = 1, #ancestors do
+    local base = path.basename(ancestors[i])
+    if base == "bin" or base == "obj" then
+      return true
+    end
+  end
+
+  return false
+end
+
+local has_extension = function(filepath, ext)
+  return string.sub(filepath, -string.len(ext) - 1) == "." .. ext
+end
+
+-- Returns a map from directories to the lexicographically first of the given
+-- build files within that directory.
+local build_files_by_dir = function(filepaths)
+  local by_dir = {}
+  for _, filepath in ipairs(filepaths) do
+    local dir = path.dirname(filepath)
+    if by_dir[dir] == nil or filepath < by_dir[dir] then
+      by_dir[dir] = filepath
+    end
+  end
+
+  return by_dir
+end
+
+local make_job = function(root, build_file)
+  local name = path.basename(build_file)
+
+  return {
+    steps = {
+      {
+        root = root,
+        image = indexer,
+        commands = { "dotnet restore " .. name },
+      },
+    },
+    root = root,
+    indexer = indexer,
+    indexer_args = { "scip-dotnet", "index", name },
+    outfile = outfile,
+  }
+end
+
+return recognizer.new_path_recognizer {
+  patterns = {
+    pattern.new_path_extension "sln",
+    pattern.new_path_extension "csproj",
+    pattern.new_path_extension "vbproj",
+  },
+
+  -- Invoked when solution or project files exist. Each solution file is indexed
+  -- separately, as solutions in the same directory may cover different projects.
+  -- Projects that are not nested under a solution directory are indexed
+  -- individually.
+  generate = function(_, paths)
+    local solutions = {}
+    local projects = {}
+    for i = 1, #paths do
+      if is_build_output(paths[i]) then
+        -- skip copies of build files in build output directories
+      elseif has_extension(paths[i], "sln") then
+        table.insert(solutions, paths[i])
+      else
+        table.insert(projects, paths[i])
+      end
+    end
+
+    local solution_dirs = {}
+    local projects_by_dir = build_files_by_dir(projects)
+
+    local jobs = {}
+    for _, solution in ipairs(solutions) do
+      local root = path.dirname(solution)
+      solution_dirs[root] = true
+      table.insert(jobs, make_job(root, solution))
+    end
+
+    for root, project in pairs(projects_by_dir) do
+      local in_solution = false
+      local ancestors = path.ancestors(project)
+      for i = 1, #ancestors do
+        if solution_dirs[ancestors[i]] then
+          in_solution = true
+          break
+        end
+      end
+
+      if not in_solution then
+        table.insert(jobs, make_job(root, project))
+      end
+    end
+
+    return jobs
+  end,
+}
@@ -8,6 +8,8 @@ local is_project_structure_supported = function(base)
     ["build.gradle.kts"] = true,
     ["build.sbt"] = true,
     ["build.sc"] = true,
+    ["settings.gradle"] = true,
+    ["settings.gradle.kts"] = true,
   }
   return supported[base] ~= nil
 end
@@ -38,6 +40,7 @@ return recognizer.new_path_recognizer {
     pattern.new_path_basename("build.gradle.kts"),
     pattern.new_path_basename("gradlew"),
     pattern.new_path_basename("settings.gradle"),
+    pattern.new_path_basename("settings.gradle.kts"),
     -- Maven
     pattern.new_path_basename("pom.xml"),
     -- SBT
@@ -70,6 +73,7 @@ return recognizer.new_path_recognizer {
         patterns = {
           new_rooted_extension(project_root, "java"),
           new_rooted_extension(project_root, "scala"),
+          -- Kotlin sources are indexed by scip-java via the semanticdb-kotlinc compiler plugin.
           new_rooted_extension(project_root, "kt"),
         },
 
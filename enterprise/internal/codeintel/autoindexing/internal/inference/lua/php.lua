@@ -0,0 +1,61 @@
+local path = require "path"
+local recognizer = require "sg.autoindex.recognizer"
+local pattern = require "sg.autoindex.patterns"
+
+local indexer = require("sg.autoindex.indexes").get "php"
+local outfile = "index.scip"
+
+return recognizer.new_path_recognizer {
+  patterns = {
+    pattern.new_path_basename "composer.json",
+  },
+
+  -- Invoked when composer.json files exist. Each composer project is indexed
+  -- with scip-php, which is installed as a development dependency so that it
+  -- can resolve symbols through the project's autoloader. Projects nested in
+  -- another composer project (e.g., path repositories of a monorepo) are
+  -- indexed along with the enclosing project. Installed dependencies within
+  -- vendor directories are never indexed.
+  generate = function(_, paths)
+    local roots = {}
+    for i = 1, #paths do
+      local root = path.dirname(paths[i])
+      if not string.find("/" .. root .. "/", "/vendor/", 1, true) then
+        roots[root] = true
+      end
+    end
+
+    local jobs = {}
+    for root in pairs(roots) do
+      local is_nested = false
+      local ancestors = path.ancestors(root)
+      for i = 1, #ancestors do
+        if root ~= "" and roots[ancestors[i]] ~= nil then
+          is_nested = true
+          break
+        end
+      end
+
+      if not is_nested then
+        table.insert(jobs, {
+          steps = {
+            {
+              root = root,
+              image = indexer,
+              commands = {
+                "composer install --no-interaction --no-scripts --ignore-platform-reqs",
+                "composer require --dev --no-interaction --ignore-platform-reqs davidrjenni/scip-php",
+              },
+            },
+          },
+          root = root,
+          indexer = indexer,
+          indexer_args = { "vendor/bin/scip-php" },
+          outfile = outfile,
+        })
+      end
+    end
+
+    return jobs
+  end,
+}
@@ -2,6 +2,7 @@ package codenav
 
 import (
 	"context"
+	"io"
 	"strconv"
 	"strings"
 
@@ -85,22 +86,26 @@ func NewGitTreeTranslator(client gitserver.Client, args *requestArgs, hunkCache
 // GetTargetCommitPathFromSourcePath translates the given path from the source commit into the given target
 // commit. If revese is true, then the source and target commits are swapped.
 func (g *gitTreeTranslator) GetTargetCommitPathFromSourcePath(ctx context.Context, commit, path string, reverse bool) (string, bool, error) {
-	return path, true, nil
+	targetPath, _, err := g.readCachedTranslation(ctx, g.localRequestArgs.repo, g.localRequestArgs.commit, commit, path, reverse)
+	if err != nil {
+		return "", false, err
+	}
+
+	return targetPath, true, nil
 }
 
 // GetTargetCommitPositionFromSourcePosition translates the given position from the source commit into the given
 // target commit. The target commit path and position are returned, along with a boolean flag
 // indicating that the translation was successful. If revese is true, then the source and
 // target commits are swapped.
-// TODO: No todo just letting me know that I updated path just on this one. Need to do it like that.
 func (g *gitTreeTranslator) GetTargetCommitPositionFromSourcePosition(ctx context.Context, commit string, px shared.Position, reverse bool) (string, shared.Position, bool, error) {
-	hunks, err := g.readCachedHunks(ctx, g.localRequestArgs.repo, g.localRequestArgs.commit, commit, g.localRequestArgs.path, reverse)
+	path, hunks, err := g.readCachedTranslation(ctx, g.localRequestArgs.repo, g.localRequestArgs.commit, commit, g.localRequestArgs.path, reverse)
 	if err != nil {
 		return "", shared.Position{}, false, err
 	}
 
 	commitPosition, ok := translatePosition(hunks, px)
-	return g.localRequestArgs.path, commitPosition, ok, nil
+	return path, commitPosition, ok, nil
 }
 
 // GetTargetCommitRangeFromSourceRange translates the given range from the source commit into the given target
@@ -108,7 +113,7 @@ func (g *gitTreeTranslator) GetTargetCommitPositionFromSourcePosition(ctx contex
 // that the translation was successful. If revese is true, then the source and target commits
 // are swapped.
 func (g *gitTreeTranslator) GetTargetCommitRangeFromSourceRange(ctx context.Context, commit, path string, rx shared.Range, reverse bool) (string, shared.Range, bool, error) {
-	hunks, err := g.readCachedHunks(ctx, g.localRequestArgs.repo, g.localRequestArgs.commit, commit, path, reverse)
+	path, hunks, err := g.readCachedTranslation(ctx, g.localRequestArgs.repo, g.localRequestArgs.commit, commit, path, reverse)
 	if err != nil {
 		return "", shared.Range{}, false, err
 	}
@@ -117,6 +122,120 @@ func (g *gitTreeTranslator) GetTargetCommitRangeFromSourceRange(ctx context.Cont
 	return path, commitRange, ok, nil
 }
 
+// readCachedTranslation returns the path in the target commit equivalent to the given path in the
+// source commit, along with a position-ordered slice of changes between the two versions of the file.
+// If reverse is true, then the source and target commits are swapped. Renames are only resolved when
+// the given path no longer exists in the target commit, so the common case requires a single diff of
+// the given path.
+func (g *gitTreeTranslator) readCachedTranslation(ctx context.Context, repo *sgtypes.Repo, sourceCommit, targetCommit, path string, reverse bool) (string, []*diff.Hunk, error) {
+	if sourceCommit == targetCommit {
+		return path, nil, nil
+	}
+	if reverse {
+		sourceCommit, targetCommit = targetCommit, sourceCommit
+	}
+
+	hunks, err := g.readCachedHunks(ctx, repo, sourceCommit, targetCommit, path, false)
+	if err != nil {
+		return "", nil, err
+	}
+	if !isDeletion(hunks) {
+		return path, hunks, nil
+	}
+
+	renames, err := g.readCachedRenames(ctx, repo, sourceCommit, targetCommit)
+	if err != nil {
+		return "", nil, err
+	}
+	if renamed, ok := renames[path]; ok {
+		return renamed.path, renamed.hunks, nil
+	}
+
+	return path, hunks, nil
+}
+
+// renamedFile is the target path of a file moved between two commits along with the changes made
+// to the file's content.
+type renamedFile struct {
+	path  string
+	hunks []*diff.Hunk
+}
+
+// readCachedRenames returns a map from paths in the source commit to the files they were renamed or
+// moved to in the target commit. If the git tree translator has a hunk cache, it will read from it
+// before attempting to contact a remote server, and populate the cache with new results.
+func (g *gitTreeTranslator) readCachedRenames(ctx context.Context, repo *sgtypes.Repo, sourceCommit, targetCommit string) (map[string]renamedFile, error) {
+	if g.hunkCache == nil {
+		return g.readRenames(ctx, repo, sourceCommit, targetCommit)
+	}
+
+	key := makeKey("renames", strconv.FormatInt(int64(repo.ID), 10), sourceCommit, targetCommit)
+	if renames, ok := g.hunkCache.Get(key); ok {
+		return renames.(map[string]renamedFile), nil
+	}
+
+	renames, err := g.readRenames(ctx, repo, sourceCommit, targetCommit)
+	if err != nil {
+		return nil, err
+	}
+
+	g.hunkCache.Set(key, renames, int64(len(renames)+1))
+
+	return renames, nil
+}
+
+// readRenames returns a map from paths in the source commit to the files they were renamed or moved
+// to in the target commit, as determined by git's rename detection.
+func (g *gitTreeTranslator) readRenames(ctx context.Context, repo *sgtypes.Repo, sourceCommit, targetCommit string) (map[string]renamedFile, error) {
+	iter, err := g.client.Diff(ctx, authz.DefaultSubRepoPermsChecker, gitserver.DiffOptions{
+		Repo:      repo.Name,
+		Base:      sourceCommit,
+		Head:      targetCommit,
+		RangeType: "..",
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	renames := map[string]renamedFile{}
+	for {
+		fileDiff, err := iter.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+
+		if origName, newName, ok := renamedPaths(fileDiff); ok {
+			renames[origName] = renamedFile{path: newName, hunks: fileDiff.Hunks}
+		}
+	}
+
+	return renames, nil
+}
+
+// renamedPaths returns the original and new paths of the given file diff if it describes a rename.
+func renamedPaths(fileDiff *diff.FileDiff) (origName, newName string, _ bool) {
+	for _, line := range fileDiff.Extended {
+		if name, ok := strings.CutPrefix(line, "rename from "); ok {
+			origName = name
+		}
+		if name, ok := strings.CutPrefix(line, "rename to "); ok {
+			newName = name
+		}
+	}
+
+	return origName, newName, origName != "" && newName != ""
+}
+
+// isDeletion returns true if the given hunks remove the entire content of a file, which is the case
+// when the file does not exist in the target commit.
+func isDeletion(hunks []*diff.Hunk) bool {
+	return len(hunks) == 1 && hunks[0].NewStartLine == 0 && hunks[0].NewLines == 0
+}
+
 // readCachedHunks returns a position-ordered slice of changes (additions or deletions) of
 // the given path between the given source and target commits. If reverse is true, then the
 // source and target commits are swapped. If the git tree translator has a hunk cache, it
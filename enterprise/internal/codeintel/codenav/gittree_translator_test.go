@@ -256,6 +256,99 @@ func TestGetTargetCommitRangeFromSourceRangeReverse(t *testing.T) {
 	}
 }
 
+const renamedFileDeletionDiff = `diff --git foo/baz.go foo/baz.go
+deleted file mode 100644
+index 1111111..0000000
+--- foo/baz.go
++++ /dev/null
+@@ -1,3 +0,0 @@
+-package foo
+-import "fmt"
+-
+`
+
+const renamedFileRenameDiff = `diff --git foo/baz.go foo/bar.go
+similarity index 90%
+rename from foo/baz.go
+rename to foo/bar.go
+index 1111111..2222222 100644
+--- foo/baz.go
++++ foo/bar.go
+@@ -1,3 +1,4 @@
+ package foo
++
+ import "fmt"
+ 
+`
+
+func TestGetTargetCommitPositionFromSourcePositionRenamed(t *testing.T) {
+	client := gitserver.NewMockClientWithExecReader(func(_ context.Context, _ api.RepoName, args []string) (reader io.ReadCloser, err error) {
+		if len(args) > 1 && args[1] == "--find-renames" {
+			expectedArgs := []string{"diff", "--find-renames", "--full-index", "--inter-hunk-context=3", "--no-prefix", "deadbeef1..deadbeef2", "--"}
+			if diff := cmp.Diff(expectedArgs, args); diff != "" {
+				t.Errorf("unexpected exec reader args (-want +got):\n%s", diff)
+			}
+
+			return io.NopCloser(bytes.NewReader([]byte(renamedFileRenameDiff))), nil
+		}
+
+		expectedArgs := []string{"diff", "deadbeef1", "deadbeef2", "--", "foo/baz.go"}
+		if diff := cmp.Diff(expectedArgs, args); diff != "" {
+			t.Errorf("unexpected exec reader args (-want +got):\n%s", diff)
+		}
+
+		return io.NopCloser(bytes.NewReader([]byte(renamedFileDeletionDiff))), nil
+	})
+
+	args := &requestArgs{
+		repo:   &sgtypes.Repo{ID: 50},
+		commit: "deadbeef1",
+		path:   "foo/baz.go",
+	}
+	adjuster := NewGitTreeTranslator(client, args, nil)
+	path, posOut, ok, err := adjuster.GetTargetCommitPositionFromSourcePosition(context.Background(), "deadbeef2", shared.Position{Line: 1, Character: 3}, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !ok {
+		t.Errorf("expected translation to succeed")
+	}
+	if path != "foo/bar.go" {
+		t.Errorf("unexpected path. want=%s have=%s", "foo/bar.go", path)
+	}
+
+	expectedPos := shared.Position{Line: 2, Character: 3}
+	if diff := cmp.Diff(expectedPos, posOut); diff != "" {
+		t.Errorf("unexpected position (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetTargetCommitPathFromSourcePathDeleted(t *testing.T) {
+	client := gitserver.NewMockClientWithExecReader(func(_ context.Context, _ api.RepoName, args []string) (reader io.ReadCloser, err error) {
+		// The file is deleted and no renames are detected between the commits
+		return io.NopCloser(bytes.NewReader([]byte(renamedFileDeletionDiff))), nil
+	})
+
+	args := &requestArgs{
+		repo:   &sgtypes.Repo{ID: 50},
+		commit: "deadbeef1",
+		path:   "foo/baz.go",
+	}
+	adjuster := NewGitTreeTranslator(client, args, nil)
+	path, ok, err := adjuster.GetTargetCommitPathFromSourcePath(context.Background(), "deadbeef2", "foo/baz.go", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !ok {
+		t.Errorf("expected translation to succeed")
+	}
+	if path != "foo/baz.go" {
+		t.Errorf("unexpected path. want=%s have=%s", "foo/baz.go", path)
+	}
+}
+
 type gitTreeTranslatorTestCase struct {
 	diff         string // The git diff output
 	diffName     string // The git diff output name
@@ -0,0 +1,144 @@
+package lsifstore
+
+import (
+	"context"
+	"sort"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// SearchDiagnostics returns the diagnostics extracted from the given uploads that match the given
+// filters. The given map associates each upload identifier with the root of that upload, which is
+// prepended to the document paths of the returned diagnostics. This method also returns the size of
+// the complete result set.
+func (s *store) SearchDiagnostics(ctx context.Context, uploadRoots map[int]string, args shared.SearchDiagnosticsArgs) (_ []shared.Diagnostic, _ int, err error) {
+	ctx, _, endObservation := s.operations.searchDiagnostics.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("numUploads", len(uploadRoots)),
+		attribute.IntSlice("severities", args.Severities),
+		attribute.StringSlice("codes", args.Codes),
+		attribute.String("messagePattern", args.MessagePattern),
+		attribute.Int("limit", args.Limit),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if len(uploadRoots) == 0 {
+		return nil, 0, nil
+	}
+
+	uploadIDs := make([]int, 0, len(uploadRoots))
+	for uploadID := range uploadRoots {
+		uploadIDs = append(uploadIDs, uploadID)
+	}
+	sort.Ints(uploadIDs)
+
+	roots := make([]string, 0, len(uploadIDs))
+	for _, uploadID := range uploadIDs {
+		roots = append(roots, uploadRoots[uploadID])
+	}
+
+	return scanDiagnosticsWithCount(s.db.Query(ctx, sqlf.Sprintf(
+		searchDiagnosticsQuery,
+		pq.Array(uploadIDs),
+		pq.Array(roots),
+		sqlf.Join(makeSearchDiagnosticsConditions(args), " AND "),
+		args.Limit,
+	)))
+}
+
+const searchDiagnosticsQuery = `
+WITH
+uploads AS (
+	SELECT u.upload_id, u.root
+	FROM unnest(%s::integer[], %s::text[]) AS u(upload_id, root)
+),
+candidates AS (
+	SELECT
+		d.id,
+		d.upload_id,
+		u.root || dl.document_path AS path,
+		d.severity,
+		d.code,
+		d.message,
+		d.source,
+		d.start_line,
+		d.start_character,
+		d.end_line,
+		d.end_character
+	FROM codeintel_scip_diagnostics d
+	JOIN uploads u ON u.upload_id = d.upload_id
+	JOIN codeintel_scip_document_lookup dl ON dl.id = d.document_lookup_id
+)
+SELECT
+	c.upload_id,
+	c.path,
+	c.severity,
+	c.code,
+	c.message,
+	c.source,
+	c.start_line,
+	c.start_character,
+	c.end_line,
+	c.end_character,
+	COUNT(*) OVER() AS count
+FROM candidates c
+WHERE %s
+ORDER BY c.path, c.start_line, c.start_character, c.id
+LIMIT %s
+`
+
+func makeSearchDiagnosticsConditions(args shared.SearchDiagnosticsArgs) []*sqlf.Query {
+	// Patterns are matched case-insensitively unless otherwise requested
+	matchOperator := sqlf.Sprintf("~*")
+	if args.CaseSensitive {
+		matchOperator = sqlf.Sprintf("~")
+	}
+
+	conds := []*sqlf.Query{sqlf.Sprintf("TRUE")}
+	if len(args.Severities) > 0 {
+		conds = append(conds, sqlf.Sprintf("c.severity = ANY(%s)", pq.Array(args.Severities)))
+	}
+	if len(args.ExcludeSeverities) > 0 {
+		conds = append(conds, sqlf.Sprintf("NOT (c.severity = ANY(%s))", pq.Array(args.ExcludeSeverities)))
+	}
+	if len(args.Codes) > 0 {
+		conds = append(conds, sqlf.Sprintf("c.code = ANY(%s)", pq.Array(args.Codes)))
+	}
+	if len(args.ExcludeCodes) > 0 {
+		conds = append(conds, sqlf.Sprintf("NOT (c.code = ANY(%s))", pq.Array(args.ExcludeCodes)))
+	}
+	if args.MessagePattern != "" {
+		conds = append(conds, sqlf.Sprintf("c.message %s %s", matchOperator, args.MessagePattern))
+	}
+	for _, pattern := range args.IncludePaths {
+		conds = append(conds, sqlf.Sprintf("c.path %s %s", matchOperator, pattern))
+	}
+	for _, pattern := range args.ExcludePaths {
+		conds = append(conds, sqlf.Sprintf("NOT (c.path %s %s)", matchOperator, pattern))
+	}
+
+	return conds
+}
+
+var scanDiagnosticsWithCount = basestore.NewSliceWithCountScanner(func(s dbutil.Scanner) (d shared.Diagnostic, count int, _ error) {
+	err := s.Scan(
+		&d.DumpID,
+		&d.Path,
+		&d.Severity,
+		&d.Code,
+		&d.Message,
+		&d.Source,
+		&d.StartLine,
+		&d.StartCharacter,
+		&d.EndLine,
+		&d.EndCharacter,
+		&count,
+	)
+	return d, count, err
+})
@@ -0,0 +1,110 @@
+package lsifstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	codeintelshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+func TestSearchDiagnostics(t *testing.T) {
+	logger := logtest.Scoped(t)
+	codeIntelDB := codeintelshared.NewCodeIntelDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, codeIntelDB)
+	ctx := context.Background()
+
+	for _, query := range []string{
+		`INSERT INTO codeintel_scip_documents (id, schema_version, payload_hash, raw_scip_payload) VALUES (1, 1, '\x01', '\x01')`,
+		`INSERT INTO codeintel_scip_document_lookup (id, upload_id, document_path, document_id) VALUES (1, 10, 'a.go', 1), (2, 10, 'b/b.go', 1), (3, 11, 'c.ts', 1), (4, 12, 'd.go', 1)`,
+		`INSERT INTO codeintel_scip_diagnostics (upload_id, document_lookup_id, severity, code, message, source, start_line, start_character, end_line, end_character) VALUES
+			(10, 1, 2, 'SA1019', 'strings.Title is deprecated', 'staticcheck', 4, 1, 4, 13),
+			(10, 1, 1, 'E0001', 'undefined: foo', 'compiler', 2, 3, 2, 6),
+			(10, 2, 2, 'SA1019', 'ioutil.ReadAll is deprecated', 'staticcheck', 7, 0, 7, 14),
+			(11, 3, 2, 'TS6385', 'substr is deprecated', 'tsc', 1, 0, 1, 6),
+			(12, 4, 2, 'SA1019', 'unrelated upload is deprecated', 'staticcheck', 1, 0, 1, 6)`,
+	} {
+		if _, err := codeIntelDB.Handle().ExecContext(ctx, query); err != nil {
+			t.Fatalf("unexpected error inserting test data: %s", err)
+		}
+	}
+
+	uploadRoots := map[int]string{10: "", 11: "web/"}
+
+	diagnostic := func(dumpID int, path string, severity int, code, message, source string, startLine, startCharacter, endLine, endCharacter int) shared.Diagnostic {
+		return shared.Diagnostic{
+			DumpID: dumpID,
+			Path:   path,
+			DiagnosticData: precise.DiagnosticData{
+				Severity:       severity,
+				Code:           code,
+				Message:        message,
+				Source:         source,
+				StartLine:      startLine,
+				StartCharacter: startCharacter,
+				EndLine:        endLine,
+				EndCharacter:   endCharacter,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name                string
+		args                shared.SearchDiagnosticsArgs
+		expectedCount       int
+		expectedDiagnostics []shared.Diagnostic
+	}{
+		{
+			name:          "all",
+			args:          shared.SearchDiagnosticsArgs{Limit: 2},
+			expectedCount: 4,
+			expectedDiagnostics: []shared.Diagnostic{
+				diagnostic(10, "a.go", 1, "E0001", "undefined: foo", "compiler", 2, 3, 2, 6),
+				diagnostic(10, "a.go", 2, "SA1019", "strings.Title is deprecated", "staticcheck", 4, 1, 4, 13),
+			},
+		},
+		{
+			name:          "severity and code",
+			args:          shared.SearchDiagnosticsArgs{Severities: []int{2}, ExcludeCodes: []string{"TS6385"}, Limit: 10},
+			expectedCount: 2,
+			expectedDiagnostics: []shared.Diagnostic{
+				diagnostic(10, "a.go", 2, "SA1019", "strings.Title is deprecated", "staticcheck", 4, 1, 4, 13),
+				diagnostic(10, "b/b.go", 2, "SA1019", "ioutil.ReadAll is deprecated", "staticcheck", 7, 0, 7, 14),
+			},
+		},
+		{
+			name:          "message and path",
+			args:          shared.SearchDiagnosticsArgs{MessagePattern: "DEPRECATED", IncludePaths: []string{`^web/`}, Limit: 10},
+			expectedCount: 1,
+			expectedDiagnostics: []shared.Diagnostic{
+				diagnostic(11, "web/c.ts", 2, "TS6385", "substr is deprecated", "tsc", 1, 0, 1, 6),
+			},
+		},
+		{
+			name:          "case sensitive",
+			args:          shared.SearchDiagnosticsArgs{MessagePattern: "DEPRECATED", CaseSensitive: true, Limit: 10},
+			expectedCount: 0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			diagnostics, count, err := store.SearchDiagnostics(ctx, uploadRoots, testCase.args)
+			if err != nil {
+				t.Fatalf("unexpected error searching diagnostics: %s", err)
+			}
+			if count != testCase.expectedCount {
+				t.Errorf("unexpected count. want=%d have=%d", testCase.expectedCount, count)
+			}
+			if diff := cmp.Diff(testCase.expectedDiagnostics, diagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
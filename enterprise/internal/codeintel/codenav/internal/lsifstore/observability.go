@@ -20,6 +20,7 @@ type operations struct {
 	getBulkMonikerLocations    *observation.Operation
 	getHover                   *observation.Operation
 	getDiagnostics             *observation.Operation
+	searchDiagnostics          *observation.Operation
 	scipDocument               *observation.Operation
 }
 
@@ -56,6 +57,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 		getBulkMonikerLocations:    op("GetBulkMonikerLocations"),
 		getHover:                   op("GetHover"),
 		getDiagnostics:             op("GetDiagnostics"),
+		searchDiagnostics:          op("SearchDiagnostics"),
 		scipDocument:               op("SCIPDocument"),
 	}
 }
@@ -62,6 +62,9 @@ type MockLsifStore struct {
 	// SCIPDocumentFunc is an instance of a mock function object controlling
 	// the behavior of the method SCIPDocument.
 	SCIPDocumentFunc *LsifStoreSCIPDocumentFunc
+	// SearchDiagnosticsFunc is an instance of a mock function object
+	// controlling the behavior of the method SearchDiagnostics.
+	SearchDiagnosticsFunc *LsifStoreSearchDiagnosticsFunc
 }
 
 // NewMockLsifStore creates a new mock of the LsifStore interface. All
@@ -133,6 +136,11 @@ func NewMockLsifStore() *MockLsifStore {
 				return
 			},
 		},
+		SearchDiagnosticsFunc: &LsifStoreSearchDiagnosticsFunc{
+			defaultHook: func(context.Context, map[int]string, shared.SearchDiagnosticsArgs) (r0 []shared.Diagnostic, r1 int, r2 error) {
+				return
+			},
+		},
 	}
 }
 
@@ -205,6 +213,11 @@ func NewStrictMockLsifStore() *MockLsifStore {
 				panic("unexpected invocation of MockLsifStore.SCIPDocument")
 			},
 		},
+		SearchDiagnosticsFunc: &LsifStoreSearchDiagnosticsFunc{
+			defaultHook: func(context.Context, map[int]string, shared.SearchDiagnosticsArgs) ([]shared.Diagnostic, int, error) {
+				panic("unexpected invocation of MockLsifStore.SearchDiagnostics")
+			},
+		},
 	}
 }
 
@@ -251,6 +264,9 @@ func NewMockLsifStoreFrom(i lsifstore.LsifStore) *MockLsifStore {
 		SCIPDocumentFunc: &LsifStoreSCIPDocumentFunc{
 			defaultHook: i.SCIPDocument,
 		},
+		SearchDiagnosticsFunc: &LsifStoreSearchDiagnosticsFunc{
+			defaultHook: i.SearchDiagnostics,
+		},
 	}
 }
 
@@ -1822,6 +1838,120 @@ func (c LsifStoreSCIPDocumentFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// LsifStoreSearchDiagnosticsFunc describes the behavior when the
+// SearchDiagnostics method of the parent MockLsifStore instance is invoked.
+type LsifStoreSearchDiagnosticsFunc struct {
+	defaultHook func(context.Context, map[int]string, shared.SearchDiagnosticsArgs) ([]shared.Diagnostic, int, error)
+	hooks       []func(context.Context, map[int]string, shared.SearchDiagnosticsArgs) ([]shared.Diagnostic, int, error)
+	history     []LsifStoreSearchDiagnosticsFuncCall
+	mutex       sync.Mutex
+}
+
+// SearchDiagnostics delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockLsifStore) SearchDiagnostics(v0 context.Context, v1 map[int]string, v2 shared.SearchDiagnosticsArgs) ([]shared.Diagnostic, int, error) {
+	r0, r1, r2 := m.SearchDiagnosticsFunc.nextHook()(v0, v1, v2)
+	m.SearchDiagnosticsFunc.appendCall(LsifStoreSearchDiagnosticsFuncCall{v0, v1, v2, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the SearchDiagnostics
+// method of the parent MockLsifStore instance is invoked and the hook queue
+// is empty.
+func (f *LsifStoreSearchDiagnosticsFunc) SetDefaultHook(hook func(context.Context, map[int]string, shared.SearchDiagnosticsArgs) ([]shared.Diagnostic, int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SearchDiagnostics method of the parent MockLsifStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *LsifStoreSearchDiagnosticsFunc) PushHook(hook func(context.Context, map[int]string, shared.SearchDiagnosticsArgs) ([]shared.Diagnostic, int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *LsifStoreSearchDiagnosticsFunc) SetDefaultReturn(r0 []shared.Diagnostic, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, map[int]string, shared.SearchDiagnosticsArgs) ([]shared.Diagnostic, int, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *LsifStoreSearchDiagnosticsFunc) PushReturn(r0 []shared.Diagnostic, r1 int, r2 error) {
+	f.PushHook(func(context.Context, map[int]string, shared.SearchDiagnosticsArgs) ([]shared.Diagnostic, int, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *LsifStoreSearchDiagnosticsFunc) nextHook() func(context.Context, map[int]string, shared.SearchDiagnosticsArgs) ([]shared.Diagnostic, int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *LsifStoreSearchDiagnosticsFunc) appendCall(r0 LsifStoreSearchDiagnosticsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of LsifStoreSearchDiagnosticsFuncCall objects
+// describing the invocations of this function.
+func (f *LsifStoreSearchDiagnosticsFunc) History() []LsifStoreSearchDiagnosticsFuncCall {
+	f.mutex.Lock()
+	history := make([]LsifStoreSearchDiagnosticsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// LsifStoreSearchDiagnosticsFuncCall is an object that describes an
+// invocation of method SearchDiagnostics on an instance of MockLsifStore.
+type LsifStoreSearchDiagnosticsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 map[int]string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 shared.SearchDiagnosticsArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []shared.Diagnostic
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c LsifStoreSearchDiagnosticsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c LsifStoreSearchDiagnosticsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
 // MockGitTreeTranslator is a mock implementation of the GitTreeTranslator
 // interface (from the package
 // github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav)
@@ -2299,6 +2429,9 @@ type MockUploadService struct {
 	// InferClosestUploadsFunc is an instance of a mock function object
 	// controlling the behavior of the method InferClosestUploads.
 	InferClosestUploadsFunc *UploadServiceInferClosestUploadsFunc
+	// MarkUploadsQueriedFunc is an instance of a mock function object
+	// controlling the behavior of the method MarkUploadsQueried.
+	MarkUploadsQueriedFunc *UploadServiceMarkUploadsQueriedFunc
 }
 
 // NewMockUploadService creates a new mock of the UploadService interface.
@@ -2325,6 +2458,11 @@ func NewMockUploadService() *MockUploadService {
 				return
 			},
 		},
+		MarkUploadsQueriedFunc: &UploadServiceMarkUploadsQueriedFunc{
+			defaultHook: func(context.Context, ...int) (r0 error) {
+				return
+			},
+		},
 	}
 }
 
@@ -2352,6 +2490,11 @@ func NewStrictMockUploadService() *MockUploadService {
 				panic("unexpected invocation of MockUploadService.InferClosestUploads")
 			},
 		},
+		MarkUploadsQueriedFunc: &UploadServiceMarkUploadsQueriedFunc{
+			defaultHook: func(context.Context, ...int) error {
+				panic("unexpected invocation of MockUploadService.MarkUploadsQueried")
+			},
+		},
 	}
 }
 
@@ -2372,6 +2515,9 @@ func NewMockUploadServiceFrom(i UploadService) *MockUploadService {
 		InferClosestUploadsFunc: &UploadServiceInferClosestUploadsFunc{
 			defaultHook: i.InferClosestUploads,
 		},
+		MarkUploadsQueriedFunc: &UploadServiceMarkUploadsQueriedFunc{
+			defaultHook: i.MarkUploadsQueried,
+		},
 	}
 }
 
@@ -2850,3 +2996,118 @@ func (c UploadServiceInferClosestUploadsFuncCall) Args() []interface{} {
 func (c UploadServiceInferClosestUploadsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
+
+// UploadServiceMarkUploadsQueriedFunc describes the behavior when the
+// MarkUploadsQueried method of the parent MockUploadService instance is
+// invoked.
+type UploadServiceMarkUploadsQueriedFunc struct {
+	defaultHook func(context.Context, ...int) error
+	hooks       []func(context.Context, ...int) error
+	history     []UploadServiceMarkUploadsQueriedFuncCall
+	mutex       sync.Mutex
+}
+
+// MarkUploadsQueried delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockUploadService) MarkUploadsQueried(v0 context.Context, v1 ...int) error {
+	r0 := m.MarkUploadsQueriedFunc.nextHook()(v0, v1...)
+	m.MarkUploadsQueriedFunc.appendCall(UploadServiceMarkUploadsQueriedFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the MarkUploadsQueried
+// method of the parent MockUploadService instance is invoked and the hook
+// queue is empty.
+func (f *UploadServiceMarkUploadsQueriedFunc) SetDefaultHook(hook func(context.Context, ...int) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// MarkUploadsQueried method of the parent MockUploadService instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *UploadServiceMarkUploadsQueriedFunc) PushHook(hook func(context.Context, ...int) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *UploadServiceMarkUploadsQueriedFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, ...int) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *UploadServiceMarkUploadsQueriedFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, ...int) error {
+		return r0
+	})
+}
+
+func (f *UploadServiceMarkUploadsQueriedFunc) nextHook() func(context.Context, ...int) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *UploadServiceMarkUploadsQueriedFunc) appendCall(r0 UploadServiceMarkUploadsQueriedFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of UploadServiceMarkUploadsQueriedFuncCall
+// objects describing the invocations of this function.
+func (f *UploadServiceMarkUploadsQueriedFunc) History() []UploadServiceMarkUploadsQueriedFuncCall {
+	f.mutex.Lock()
+	history := make([]UploadServiceMarkUploadsQueriedFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// UploadServiceMarkUploadsQueriedFuncCall is an object that describes an
+// invocation of method MarkUploadsQueried on an instance of
+// MockUploadService.
+type UploadServiceMarkUploadsQueriedFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c UploadServiceMarkUploadsQueriedFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c UploadServiceMarkUploadsQueriedFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
@@ -12,16 +12,21 @@ import (
 )
 
 type operations struct {
-	getReferences          *observation.Operation
-	getImplementations     *observation.Operation
-	getDiagnostics         *observation.Operation
-	getHover               *observation.Operation
-	getDefinitions         *observation.Operation
-	getRanges              *observation.Operation
-	getStencil             *observation.Operation
-	getClosestDumpsForBlob *observation.Operation
-	snapshotForDocument    *observation.Operation
-	visibleUploadsForPath  *observation.Operation
+	getReferences               *observation.Operation
+	getImplementations          *observation.Operation
+	getIncomingCalls            *observation.Operation
+	getOutgoingCalls            *observation.Operation
+	getTypeHierarchy            *observation.Operation
+	getDiagnostics              *observation.Operation
+	searchDiagnostics           *observation.Operation
+	getHover                    *observation.Operation
+	getDefinitions              *observation.Operation
+	getRanges                   *observation.Operation
+	getStencil                  *observation.Operation
+	getClosestDumpsForBlob      *observation.Operation
+	snapshotForDocument         *observation.Operation
+	visibleUploadsForPath       *observation.Operation
+	getSymbolReferenceLocations *observation.Operation
 }
 
 var m = new(metrics.SingletonREDMetrics)
@@ -45,16 +50,21 @@ func newOperations(observationCtx *observation.Context) *operations {
 	}
 
 	return &operations{
-		getReferences:          op("getReferences"),
-		getImplementations:     op("getImplementations"),
-		getDiagnostics:         op("getDiagnostics"),
-		getHover:               op("getHover"),
-		getDefinitions:         op("getDefinitions"),
-		getRanges:              op("getRanges"),
-		getStencil:             op("getStencil"),
-		getClosestDumpsForBlob: op("GetClosestDumpsForBlob"),
-		snapshotForDocument:    op("SnapshotForDocument"),
-		visibleUploadsForPath:  op("VisibleUploadsForPath"),
+		getReferences:               op("getReferences"),
+		getImplementations:          op("getImplementations"),
+		getIncomingCalls:            op("getIncomingCalls"),
+		getOutgoingCalls:            op("getOutgoingCalls"),
+		getTypeHierarchy:            op("getTypeHierarchy"),
+		getDiagnostics:              op("getDiagnostics"),
+		searchDiagnostics:           op("SearchDiagnostics"),
+		getHover:                    op("getHover"),
+		getDefinitions:              op("getDefinitions"),
+		getRanges:                   op("getRanges"),
+		getStencil:                  op("getStencil"),
+		getClosestDumpsForBlob:      op("GetClosestDumpsForBlob"),
+		snapshotForDocument:         op("SnapshotForDocument"),
+		visibleUploadsForPath:       op("VisibleUploadsForPath"),
+		getSymbolReferenceLocations: op("GetSymbolReferenceLocations"),
 	}
 }
 
@@ -0,0 +1,210 @@
+package search
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sourcegraph/conc/pool"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	searchrepos "github.com/sourcegraph/sourcegraph/internal/search/repos"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+)
+
+// diagnosticSearchConcurrency bounds the number of repositories searched
+// for diagnostics at once.
+const diagnosticSearchConcurrency = 4
+
+// severities maps the values accepted by the `severity:` filter to SCIP
+// diagnostic severities.
+var severities = map[string]int{
+	"error":       1,
+	"warning":     2,
+	"information": 3,
+	"hint":        4,
+}
+
+// severityNames is the inverse of severities.
+var severityNames = func() map[int]string {
+	names := make(map[int]string, len(severities))
+	for name, severity := range severities {
+		names[severity] = name
+	}
+	return names
+}()
+
+// NewDiagnosticSearchJob returns a job that searches the diagnostics extracted
+// from the precise indexes of every repository revision matched by repoOpts.
+// Diagnostics are reported at the commit of the index that contains them.
+func NewDiagnosticSearchJob(svc CodeNavService, patternInfo *search.DiagnosticPatternInfo, repoOpts search.RepoOptions) job.Job {
+	return &diagnosticSearchJob{
+		svc:         svc,
+		patternInfo: patternInfo,
+		repoOpts:    repoOpts,
+	}
+}
+
+type diagnosticSearchJob struct {
+	svc         CodeNavService
+	patternInfo *search.DiagnosticPatternInfo
+	repoOpts    search.RepoOptions
+}
+
+func (j *diagnosticSearchJob) Run(ctx context.Context, clients job.RuntimeClients, stream streaming.Sender) (alert *search.Alert, err error) {
+	_, ctx, stream, finish := job.StartSpan(ctx, stream, j)
+	defer func() { finish(alert, err) }()
+
+	args := toSearchDiagnosticsArgs(j.patternInfo)
+
+	searchRepoRev := func(ctx context.Context, repoRev *search.RepositoryRevisions) error {
+		limitHit := false
+		var errs error
+		for _, rev := range repoRev.Revs {
+			matches, revLimitHit, err := j.searchRev(ctx, clients.Gitserver, repoRev, rev, args)
+			if err != nil {
+				errs = err
+				break
+			}
+			limitHit = limitHit || revLimitHit
+
+			if len(matches) > 0 {
+				stream.Send(streaming.SearchEvent{Results: matches})
+			}
+		}
+
+		statusMap, limitHit, err := search.HandleRepoSearchResult(repoRev.Repo.ID, repoRev.Revs, limitHit, false, errs)
+		stream.Send(streaming.SearchEvent{
+			Stats: streaming.Stats{
+				IsLimitHit: limitHit,
+				Status:     statusMap,
+			},
+		})
+		return err
+	}
+
+	repos := searchrepos.NewResolver(clients.Logger, clients.DB, clients.Gitserver, clients.SearcherURLs, clients.Zoekt)
+	it := repos.Iterator(ctx, j.repoOpts)
+
+	p := pool.New().WithContext(ctx).WithMaxGoroutines(diagnosticSearchConcurrency).WithFirstError()
+
+	for it.Next() {
+		page := it.Current()
+		page.MaybeSendStats(stream)
+
+		for _, repoRev := range page.RepoRevs {
+			repoRev := repoRev
+			p.Go(func(ctx context.Context) error {
+				return searchRepoRev(ctx, repoRev)
+			})
+		}
+	}
+
+	if err := p.Wait(); err != nil {
+		return nil, err
+	}
+	return nil, it.Err()
+}
+
+// searchRev returns the diagnostics visible from the given revision of a repository, grouped by file.
+func (j *diagnosticSearchJob) searchRev(ctx context.Context, gitserverClient gitserver.Client, repoRev *search.RepositoryRevisions, rev string, args shared.SearchDiagnosticsArgs) (result.Matches, bool, error) {
+	commit, err := gitserverClient.ResolveRevision(ctx, repoRev.Repo.Name, rev, gitserver.ResolveRevisionOptions{NoEnsureRevision: true})
+	if err != nil {
+		return nil, false, err
+	}
+
+	diagnostics, totalCount, err := j.svc.SearchDiagnostics(ctx, int(repoRev.Repo.ID), string(commit), args)
+	if err != nil {
+		return nil, false, err
+	}
+
+	inputRev := rev
+	return diagnosticsToMatches(repoRev, &inputRev, diagnostics), totalCount > len(diagnostics), nil
+}
+
+func (j *diagnosticSearchJob) Name() string {
+	return "DiagnosticSearchJob"
+}
+
+func (j *diagnosticSearchJob) Attributes(v job.Verbosity) (res []attribute.KeyValue) {
+	switch v {
+	case job.VerbosityMax:
+		fallthrough
+	case job.VerbosityBasic:
+		res = append(res, trace.Scoped("patternInfo", j.patternInfo.Fields()...)...)
+		res = append(res, trace.Scoped("repoOpts", j.repoOpts.Attributes()...)...)
+	}
+	return res
+}
+
+func (j *diagnosticSearchJob) Children() []job.Describer       { return nil }
+func (j *diagnosticSearchJob) MapChildren(job.MapFunc) job.Job { return j }
+
+// toSearchDiagnosticsArgs converts the parameters of a `type:diagnostic`
+// search into store arguments. Severity names have already been validated
+// by the query parser.
+func toSearchDiagnosticsArgs(patternInfo *search.DiagnosticPatternInfo) shared.SearchDiagnosticsArgs {
+	toSeverities := func(names []string) []int {
+		values := make([]int, 0, len(names))
+		for _, name := range names {
+			if severity, ok := severities[strings.ToLower(name)]; ok {
+				values = append(values, severity)
+			}
+		}
+		return values
+	}
+
+	return shared.SearchDiagnosticsArgs{
+		Severities:        toSeverities(patternInfo.Severities),
+		ExcludeSeverities: toSeverities(patternInfo.ExcludeSeverities),
+		Codes:             patternInfo.Codes,
+		ExcludeCodes:      patternInfo.ExcludeCodes,
+		MessagePattern:    patternInfo.Pattern,
+		IncludePaths:      patternInfo.IncludePatterns,
+		ExcludePaths:      patternInfo.ExcludePatterns,
+		CaseSensitive:     patternInfo.IsCaseSensitive,
+		Limit:             patternInfo.Limit,
+	}
+}
+
+// diagnosticsToMatches groups diagnostics by the commit and path of the file
+// they are attached to. Diagnostics are expected to be ordered by path.
+func diagnosticsToMatches(repoRev *search.RepositoryRevisions, inputRev *string, diagnostics []codenav.DiagnosticAtUpload) result.Matches {
+	var (
+		matches result.Matches
+		current *result.DiagnosticMatch
+	)
+	for _, diagnostic := range diagnostics {
+		commit := api.CommitID(diagnostic.AdjustedCommit)
+		if current == nil || current.CommitID != commit || current.Path != diagnostic.Path {
+			current = &result.DiagnosticMatch{
+				File: result.File{
+					InputRev: inputRev,
+					Repo:     repoRev.Repo,
+					CommitID: commit,
+					Path:     diagnostic.Path,
+				},
+			}
+			matches = append(matches, current)
+		}
+
+		current.Diagnostics = append(current.Diagnostics, result.Diagnostic{
+			Severity: severityNames[diagnostic.Severity],
+			Code:     diagnostic.Code,
+			Message:  diagnostic.Message,
+			Source:   diagnostic.Source,
+			Range: result.Range{
+				Start: result.Location{Line: diagnostic.AdjustedRange.Start.Line, Column: diagnostic.AdjustedRange.Start.Character},
+				End:   result.Location{Line: diagnostic.AdjustedRange.End.Line, Column: diagnostic.AdjustedRange.End.Character},
+			},
+		})
+	}
+	return matches
+}
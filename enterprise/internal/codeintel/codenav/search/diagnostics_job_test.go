@@ -0,0 +1,111 @@
+package search
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+func TestDiagnosticSearchJobSearchRev(t *testing.T) {
+	repo := types.MinimalRepo{ID: 1, Name: "github.com/sourcegraph/sourcegraph"}
+
+	diagnostic := func(path string, severity int, code, message string, line int) codenav.DiagnosticAtUpload {
+		return codenav.DiagnosticAtUpload{
+			Diagnostic: shared.Diagnostic{
+				DumpID: 42,
+				Path:   path,
+				DiagnosticData: precise.DiagnosticData{
+					Severity: severity,
+					Code:     code,
+					Message:  message,
+					Source:   "staticcheck",
+				},
+			},
+			AdjustedCommit: "cafebabe",
+			AdjustedRange: shared.Range{
+				Start: shared.Position{Line: line, Character: 1},
+				End:   shared.Position{Line: line, Character: 6},
+			},
+		}
+	}
+
+	svc := &fakeCodeNavService{
+		diagnostics: []codenav.DiagnosticAtUpload{
+			diagnostic("a.go", 2, "SA1019", "strings.Title is deprecated", 3),
+			diagnostic("a.go", 1, "E0001", "undefined: foo", 7),
+			diagnostic("b.go", 2, "SA1019", "ioutil.ReadAll is deprecated", 1),
+		},
+		diagnosticsCount: 5,
+	}
+
+	gs := gitserver.NewMockClient()
+	gs.ResolveRevisionFunc.SetDefaultHook(func(_ context.Context, name api.RepoName, spec string, _ gitserver.ResolveRevisionOptions) (api.CommitID, error) {
+		if name != repo.Name || spec != "main" {
+			t.Errorf("unexpected revision resolved %s@%s", name, spec)
+		}
+		return "deadbeef", nil
+	})
+
+	patternInfo := &search.DiagnosticPatternInfo{
+		Pattern:           "deprecated",
+		Severities:        []string{"Warning"},
+		ExcludeSeverities: []string{"hint"},
+		Codes:             []string{"SA1019"},
+		IncludePatterns:   []string{`\.go$`},
+		Limit:             3,
+	}
+	j := NewDiagnosticSearchJob(svc, patternInfo, search.RepoOptions{}).(*diagnosticSearchJob)
+
+	matches, limitHit, err := j.searchRev(context.Background(), gs, &search.RepositoryRevisions{Repo: repo, Revs: []string{"main"}}, "main", toSearchDiagnosticsArgs(patternInfo))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !limitHit {
+		t.Errorf("expected limit to be hit")
+	}
+
+	expectedArgs := shared.SearchDiagnosticsArgs{
+		Severities:        []int{2},
+		ExcludeSeverities: []int{4},
+		Codes:             []string{"SA1019"},
+		MessagePattern:    "deprecated",
+		IncludePaths:      []string{`\.go$`},
+		Limit:             3,
+	}
+	if diff := cmp.Diff(expectedArgs, svc.lastDiagnosticsArgs); diff != "" {
+		t.Errorf("unexpected args (-want +got):\n%s", diff)
+	}
+
+	inputRev := "main"
+	rng := func(line int) result.Range {
+		return result.Range{Start: result.Location{Line: line, Column: 1}, End: result.Location{Line: line, Column: 6}}
+	}
+	want := result.Matches{
+		&result.DiagnosticMatch{
+			File: result.File{InputRev: &inputRev, Repo: repo, CommitID: "cafebabe", Path: "a.go"},
+			Diagnostics: []result.Diagnostic{
+				{Severity: "warning", Code: "SA1019", Message: "strings.Title is deprecated", Source: "staticcheck", Range: rng(3)},
+				{Severity: "error", Code: "E0001", Message: "undefined: foo", Source: "staticcheck", Range: rng(7)},
+			},
+		},
+		&result.DiagnosticMatch{
+			File: result.File{InputRev: &inputRev, Repo: repo, CommitID: "cafebabe", Path: "b.go"},
+			Diagnostics: []result.Diagnostic{
+				{Severity: "warning", Code: "SA1019", Message: "ioutil.ReadAll is deprecated", Source: "staticcheck", Range: rng(1)},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, matches); diff != "" {
+		t.Errorf("unexpected matches (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,371 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/grafana/regexp"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	uploadsshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/jobutil"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// CodeNavService is the subset of the code navigation service used to
+// resolve precise references of symbols and to search diagnostics.
+type CodeNavService interface {
+	GetClosestDumpsForBlob(ctx context.Context, repositoryID int, commit, path string, exactPath bool, indexer string) ([]uploadsshared.Dump, error)
+	GetReferences(ctx context.Context, args codenav.RequestArgs, requestState codenav.RequestState, cursor codenav.ReferencesCursor) ([]shared.UploadLocation, codenav.ReferencesCursor, error)
+	SearchDiagnostics(ctx context.Context, repositoryID int, commit string, args shared.SearchDiagnosticsArgs) ([]codenav.DiagnosticAtUpload, int, error)
+}
+
+const (
+	// maxReferencesPerSymbol bounds the number of references resolved for
+	// a single symbol, to keep queries selecting very common symbols fast.
+	maxReferencesPerSymbol = 500
+
+	// referencesPageSize is the page size used when paging through precise
+	// references.
+	referencesPageSize = 100
+
+	// maximumIndexesPerMonikerSearch mirrors the default used by the code
+	// navigation GraphQL resolvers.
+	maximumIndexesPerMonikerSearch = 500
+
+	hunkCacheSize = 1000
+)
+
+// NewSelectReferencesJob returns a job that expands every symbol result of
+// child into the references of that symbol. References are resolved with
+// precise code intelligence where an index is available, and by searching
+// for the symbol name in the symbol's repository otherwise. A nil svc
+// resolves only search-based references.
+func NewSelectReferencesJob(svc CodeNavService, child job.Job) job.Job {
+	return &selectReferencesJob{
+		svc:   svc,
+		child: child,
+	}
+}
+
+type selectReferencesJob struct {
+	svc   CodeNavService
+	child job.Job
+}
+
+func (s *selectReferencesJob) Run(ctx context.Context, clients job.RuntimeClients, stream streaming.Sender) (alert *search.Alert, err error) {
+	_, ctx, stream, finish := job.StartSpan(ctx, stream, s)
+	defer func() { finish(alert, err) }()
+
+	hunkCache, err := codenav.NewHunkCache(hunkCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		mu    sync.Mutex
+		errs  error
+		dedup = result.NewDeduper()
+		seen  = map[symbolKey]struct{}{}
+	)
+
+	filteredStream := streaming.StreamFunc(func(event streaming.SearchEvent) {
+		var results result.Matches
+		for _, match := range event.Results {
+			fm, ok := match.(*result.FileMatch)
+			if !ok {
+				continue
+			}
+			for _, sym := range fm.Symbols {
+				key := symbolKey{repo: fm.Repo.ID, commit: fm.CommitID, path: fm.Path, line: sym.Symbol.Line, character: sym.Symbol.Character}
+				mu.Lock()
+				_, ok := seen[key]
+				seen[key] = struct{}{}
+				mu.Unlock()
+				if ok {
+					continue
+				}
+
+				references, err := s.references(ctx, clients, hunkCache, fm, sym.Symbol)
+				if err != nil {
+					mu.Lock()
+					errs = errors.Append(errs, err)
+					mu.Unlock()
+					continue
+				}
+				results = append(results, references...)
+			}
+		}
+
+		mu.Lock()
+		var deduped result.Matches
+		for _, m := range results {
+			if !dedup.Seen(m) {
+				dedup.Add(m)
+				deduped = append(deduped, m)
+			}
+		}
+		mu.Unlock()
+
+		event.Results = deduped
+		stream.Send(event)
+	})
+
+	alert, err = s.child.Run(ctx, clients, filteredStream)
+	return alert, errors.Append(err, errs)
+}
+
+func (s *selectReferencesJob) Name() string {
+	return "SelectSymbolReferencesJob"
+}
+
+func (s *selectReferencesJob) Attributes(v job.Verbosity) (res []attribute.KeyValue) {
+	switch v {
+	case job.VerbosityMax:
+		fallthrough
+	case job.VerbosityBasic:
+		res = append(res, attribute.Bool("precise", s.svc != nil))
+	}
+	return res
+}
+
+func (s *selectReferencesJob) Children() []job.Describer {
+	return []job.Describer{s.child}
+}
+
+func (s *selectReferencesJob) MapChildren(fn job.MapFunc) job.Job {
+	cp := *s
+	cp.child = job.Map(s.child, fn)
+	return &cp
+}
+
+type symbolKey struct {
+	repo      api.RepoID
+	commit    api.CommitID
+	path      string
+	line      int
+	character int
+}
+
+// references returns the references of the given symbol as file matches.
+// Precise references are preferred; search-based references are returned
+// if there is no precise index covering the symbol's file.
+func (s *selectReferencesJob) references(ctx context.Context, clients job.RuntimeClients, hunkCache codenav.HunkCache, fm *result.FileMatch, sym result.Symbol) (result.Matches, error) {
+	if s.svc != nil {
+		locations, err := s.preciseReferences(ctx, clients, hunkCache, fm, sym)
+		if err != nil {
+			return nil, err
+		}
+		if len(locations) > 0 {
+			return locationsToMatches(ctx, clients, locations)
+		}
+	}
+
+	return searchBasedReferences(ctx, clients, fm, sym)
+}
+
+func (s *selectReferencesJob) preciseReferences(ctx context.Context, clients job.RuntimeClients, hunkCache codenav.HunkCache, fm *result.FileMatch, sym result.Symbol) ([]shared.UploadLocation, error) {
+	uploads, err := s.svc.GetClosestDumpsForBlob(ctx, int(fm.Repo.ID), string(fm.CommitID), fm.Path, true, "")
+	if err != nil || len(uploads) == 0 {
+		return nil, err
+	}
+
+	repo, err := clients.DB.Repos().Get(ctx, fm.Repo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	requestState := codenav.NewRequestState(
+		uploads,
+		clients.DB.Repos(),
+		authz.DefaultSubRepoPermsChecker,
+		clients.Gitserver,
+		repo,
+		string(fm.CommitID),
+		fm.Path,
+		maximumIndexesPerMonikerSearch,
+		hunkCache,
+	)
+
+	args := codenav.RequestArgs{
+		RepositoryID: int(fm.Repo.ID),
+		Commit:       string(fm.CommitID),
+		Path:         fm.Path,
+		Line:         sym.Line - 1, // symbol lines are 1-based
+		Character:    sym.Character,
+		Limit:        referencesPageSize,
+	}
+
+	var locations []shared.UploadLocation
+	cursor := codenav.ReferencesCursor{Phase: "local"}
+	for cursor.Phase != "done" && len(locations) < maxReferencesPerSymbol {
+		phase := cursor.Phase
+		var page []shared.UploadLocation
+		page, cursor, err = s.svc.GetReferences(ctx, args, requestState, cursor)
+		if err != nil {
+			return nil, err
+		}
+		if len(page) == 0 && cursor.Phase == phase {
+			// Guard against cursors that make no progress.
+			break
+		}
+		locations = append(locations, page...)
+	}
+	return locations, nil
+}
+
+type fileKey struct {
+	repoID   int
+	repoName string
+	commit   string
+	path     string
+}
+
+// locationsToMatches groups precise locations by file and converts them into
+// file matches with one chunk per referencing line.
+func locationsToMatches(ctx context.Context, clients job.RuntimeClients, locations []shared.UploadLocation) (result.Matches, error) {
+	byFile := map[fileKey][]shared.Range{}
+	var keys []fileKey
+	for _, location := range locations {
+		key := fileKey{
+			repoID:   location.Dump.RepositoryID,
+			repoName: location.Dump.RepositoryName,
+			commit:   location.TargetCommit,
+			path:     location.Path,
+		}
+		if _, ok := byFile[key]; !ok {
+			keys = append(keys, key)
+		}
+		byFile[key] = append(byFile[key], location.TargetRange)
+	}
+
+	matches := make(result.Matches, 0, len(keys))
+	for _, key := range keys {
+		content, err := clients.Gitserver.ReadFile(ctx, authz.DefaultSubRepoPermsChecker, api.RepoName(key.repoName), api.CommitID(key.commit), key.path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				// The file is gone or not visible to the current user.
+				continue
+			}
+			return nil, err
+		}
+
+		matches = append(matches, &result.FileMatch{
+			File: result.File{
+				Repo:     types.MinimalRepo{ID: api.RepoID(key.repoID), Name: api.RepoName(key.repoName)},
+				CommitID: api.CommitID(key.commit),
+				Path:     key.path,
+			},
+			ChunkMatches: rangesToChunks(string(content), byFile[key]),
+		})
+	}
+	return matches, nil
+}
+
+// rangesToChunks converts single-line LSP ranges into chunk matches over the
+// given file content. Ranges on the same line share a chunk.
+func rangesToChunks(content string, ranges []shared.Range) result.ChunkMatches {
+	lines := strings.SplitAfter(content, "\n")
+	offsets := make([]int, len(lines))
+	for i := 1; i < len(lines); i++ {
+		offsets[i] = offsets[i-1] + len(lines[i-1])
+	}
+
+	sort.Slice(ranges, func(i, j int) bool {
+		if ranges[i].Start.Line != ranges[j].Start.Line {
+			return ranges[i].Start.Line < ranges[j].Start.Line
+		}
+		return ranges[i].Start.Character < ranges[j].Start.Character
+	})
+
+	var chunks result.ChunkMatches
+	for _, r := range ranges {
+		if r.Start.Line < 0 || r.Start.Line >= len(lines) {
+			continue
+		}
+		line := strings.TrimSuffix(lines[r.Start.Line], "\n")
+		start := location(line, offsets[r.Start.Line], r.Start.Line, r.Start.Character)
+		endCharacter := r.End.Character
+		if r.End.Line != r.Start.Line {
+			endCharacter = utf8.RuneCountInString(line)
+		}
+		end := location(line, offsets[r.Start.Line], r.Start.Line, endCharacter)
+
+		if n := len(chunks); n > 0 && chunks[n-1].ContentStart.Line == r.Start.Line {
+			chunks[n-1].Ranges = append(chunks[n-1].Ranges, result.Range{Start: start, End: end})
+			continue
+		}
+		chunks = append(chunks, result.ChunkMatch{
+			Content:      line,
+			ContentStart: result.Location{Offset: offsets[r.Start.Line], Line: r.Start.Line},
+			Ranges:       result.Ranges{{Start: start, End: end}},
+		})
+	}
+	return chunks
+}
+
+// location converts a character position on a line into a result.Location.
+// Characters past the end of the line are clamped.
+func location(line string, lineOffset, lineNumber, character int) result.Location {
+	offset := 0
+	column := 0
+	for offset < len(line) && column < character {
+		_, size := utf8.DecodeRuneInString(line[offset:])
+		offset += size
+		column++
+	}
+	return result.Location{Offset: lineOffset + offset, Line: lineNumber, Column: column}
+}
+
+// searchBasedReferences searches for whole-word occurrences of the symbol
+// name in the symbol's repository at the same commit.
+func searchBasedReferences(ctx context.Context, clients job.RuntimeClients, fm *result.FileMatch, sym result.Symbol) (result.Matches, error) {
+	q := fmt.Sprintf(
+		`repo:^%s$@%s type:file case:yes count:%d \b%s\b`,
+		regexp.QuoteMeta(string(fm.Repo.Name)),
+		fm.CommitID,
+		maxReferencesPerSymbol,
+		regexp.QuoteMeta(sym.Name),
+	)
+
+	plan, err := query.Pipeline(query.InitRegexp(q))
+	if err != nil {
+		return nil, err
+	}
+	inputs := &search.Inputs{
+		Plan:          plan,
+		Query:         plan.ToQ(),
+		OriginalQuery: q,
+		PatternType:   query.SearchTypeRegex,
+		UserSettings:  &schema.Settings{},
+		Features:      &search.Features{},
+		Protocol:      search.Streaming,
+	}
+
+	planJob, err := jobutil.NewPlanJob(inputs, plan, jobutil.NewUnimplementedEnterpriseJobs())
+	if err != nil {
+		return nil, err
+	}
+
+	agg := streaming.NewAggregatingStream()
+	if _, err := planJob.Run(ctx, clients, agg); err != nil {
+		return nil, err
+	}
+	return agg.Results, nil
+}
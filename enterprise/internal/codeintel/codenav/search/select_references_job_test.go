@@ -0,0 +1,157 @@
+package search
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	uploadsshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/mockjob"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestSelectReferencesJob(t *testing.T) {
+	repo := types.MinimalRepo{ID: 1, Name: "github.com/sourcegraph/sourcegraph"}
+	symbolMatch := &result.FileMatch{
+		File: result.File{Repo: repo, CommitID: "deadbeef", Path: "lib/deprecated.go"},
+		Symbols: []*result.SymbolMatch{{
+			Symbol: result.Symbol{Name: "DeprecatedFoo", Line: 3, Character: 5, Kind: "function"},
+		}},
+	}
+
+	child := mockjob.NewMockJob()
+	child.RunFunc.SetDefaultHook(func(_ context.Context, _ job.RuntimeClients, s streaming.Sender) (*search.Alert, error) {
+		// Send the same symbol twice to ensure it is only expanded once.
+		s.Send(streaming.SearchEvent{Results: result.Matches{symbolMatch}})
+		s.Send(streaming.SearchEvent{Results: result.Matches{symbolMatch}})
+		return nil, nil
+	})
+
+	svc := &fakeCodeNavService{
+		dumps: []uploadsshared.Dump{{ID: 42, RepositoryID: 1, RepositoryName: string(repo.Name), Commit: "deadbeef"}},
+		locations: []shared.UploadLocation{
+			{
+				Dump:         uploadsshared.Dump{RepositoryID: 2, RepositoryName: "github.com/sourcegraph/caller"},
+				Path:         "main.go",
+				TargetCommit: "cafebabe",
+				TargetRange:  shared.Range{Start: shared.Position{Line: 1, Character: 5}, End: shared.Position{Line: 1, Character: 18}},
+			},
+		},
+	}
+
+	repos := database.NewMockRepoStore()
+	repos.GetFunc.SetDefaultReturn(&types.Repo{ID: 1, Name: repo.Name}, nil)
+	db := database.NewMockDB()
+	db.ReposFunc.SetDefaultReturn(repos)
+
+	gs := gitserver.NewMockClient()
+	gs.ReadFileFunc.SetDefaultHook(func(_ context.Context, _ authz.SubRepoPermissionChecker, repo api.RepoName, commit api.CommitID, path string) ([]byte, error) {
+		if repo != "github.com/sourcegraph/caller" || commit != "cafebabe" || path != "main.go" {
+			t.Errorf("unexpected file read %s@%s/%s", repo, commit, path)
+		}
+		return []byte("func main() {\n\tlib.DeprecatedFoo()\n}\n"), nil
+	})
+
+	agg := streaming.NewAggregatingStream()
+	j := NewSelectReferencesJob(svc, child)
+	if _, err := j.Run(context.Background(), job.RuntimeClients{DB: db, Gitserver: gs}, agg); err != nil {
+		t.Fatal(err)
+	}
+
+	if svc.calls != 1 {
+		t.Errorf("expected symbol to be expanded once, got %d calls", svc.calls)
+	}
+	if svc.lastArgs.Line != 2 || svc.lastArgs.Character != 5 {
+		t.Errorf("unexpected request position %d:%d", svc.lastArgs.Line, svc.lastArgs.Character)
+	}
+
+	want := result.Matches{
+		&result.FileMatch{
+			File: result.File{
+				Repo:     types.MinimalRepo{ID: 2, Name: "github.com/sourcegraph/caller"},
+				CommitID: "cafebabe",
+				Path:     "main.go",
+			},
+			ChunkMatches: result.ChunkMatches{{
+				Content:      "\tlib.DeprecatedFoo()",
+				ContentStart: result.Location{Offset: 14, Line: 1},
+				Ranges: result.Ranges{{
+					Start: result.Location{Offset: 19, Line: 1, Column: 5},
+					End:   result.Location{Offset: 32, Line: 1, Column: 18},
+				}},
+			}},
+		},
+	}
+	if diff := cmp.Diff(want, agg.Results); diff != "" {
+		t.Errorf("unexpected results (-want +got):\n%s", diff)
+	}
+}
+
+func TestRangesToChunks(t *testing.T) {
+	content := "a := foo()\nb := foo() + foo()\n"
+	ranges := []shared.Range{
+		{Start: shared.Position{Line: 1, Character: 13}, End: shared.Position{Line: 1, Character: 16}},
+		{Start: shared.Position{Line: 0, Character: 5}, End: shared.Position{Line: 0, Character: 8}},
+		{Start: shared.Position{Line: 1, Character: 5}, End: shared.Position{Line: 1, Character: 8}},
+		{Start: shared.Position{Line: 7, Character: 0}, End: shared.Position{Line: 7, Character: 3}},
+	}
+
+	want := result.ChunkMatches{
+		{
+			Content:      "a := foo()",
+			ContentStart: result.Location{Offset: 0, Line: 0},
+			Ranges: result.Ranges{
+				{Start: result.Location{Offset: 5, Column: 5}, End: result.Location{Offset: 8, Column: 8}},
+			},
+		},
+		{
+			Content:      "b := foo() + foo()",
+			ContentStart: result.Location{Offset: 11, Line: 1},
+			Ranges: result.Ranges{
+				{Start: result.Location{Offset: 16, Line: 1, Column: 5}, End: result.Location{Offset: 19, Line: 1, Column: 8}},
+				{Start: result.Location{Offset: 24, Line: 1, Column: 13}, End: result.Location{Offset: 27, Line: 1, Column: 16}},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, rangesToChunks(content, ranges)); diff != "" {
+		t.Errorf("unexpected chunks (-want +got):\n%s", diff)
+	}
+}
+
+type fakeCodeNavService struct {
+	dumps     []uploadsshared.Dump
+	locations []shared.UploadLocation
+	calls     int
+	lastArgs  codenav.RequestArgs
+
+	diagnostics         []codenav.DiagnosticAtUpload
+	diagnosticsCount    int
+	lastDiagnosticsArgs shared.SearchDiagnosticsArgs
+}
+
+func (s *fakeCodeNavService) GetClosestDumpsForBlob(context.Context, int, string, string, bool, string) ([]uploadsshared.Dump, error) {
+	return s.dumps, nil
+}
+
+func (s *fakeCodeNavService) GetReferences(_ context.Context, args codenav.RequestArgs, _ codenav.RequestState, cursor codenav.ReferencesCursor) ([]shared.UploadLocation, codenav.ReferencesCursor, error) {
+	s.calls++
+	s.lastArgs = args
+	cursor.Phase = "done"
+	return s.locations, cursor, nil
+}
+
+func (s *fakeCodeNavService) SearchDiagnostics(_ context.Context, _ int, _ string, args shared.SearchDiagnosticsArgs) ([]codenav.DiagnosticAtUpload, int, error) {
+	s.lastDiagnosticsArgs = args
+	return s.diagnostics, s.diagnosticsCount, nil
+}
@@ -25,14 +25,19 @@ import (
 )
 
 type Service struct {
-	repoStore  database.RepoStore
-	lsifstore  lsifstore.LsifStore
-	gitserver  gitserver.Client
-	uploadSvc  UploadService
-	operations *operations
-	logger     log.Logger
+	repoStore   database.RepoStore
+	lsifstore   lsifstore.LsifStore
+	gitserver   gitserver.Client
+	uploadSvc   UploadService
+	renameCache HunkCache
+	operations  *operations
+	logger      log.Logger
 }
 
+// renameCacheSize is the maximum cost of the rename maps shared by the git tree translators that
+// GetClosestDumpsForBlob creates. The cost of a rename map is its number of entries.
+const renameCacheSize = 100_000
+
 func newService(
 	observationCtx *observation.Context,
 	repoStore database.RepoStore,
@@ -40,13 +45,21 @@ func newService(
 	uploadSvc UploadService,
 	gitserver gitserver.Client,
 ) *Service {
+	// The rename maps are keyed by repository and commit pair, so they can be shared across requests.
+	// Without a cache the translator reads renames from gitserver on every lookup.
+	var renameCache HunkCache
+	if cache, err := NewHunkCache(renameCacheSize); err == nil {
+		renameCache = cache
+	}
+
 	return &Service{
-		repoStore:  repoStore,
-		lsifstore:  lsifstore,
-		gitserver:  gitserver,
-		uploadSvc:  uploadSvc,
-		operations: newOperations(observationCtx),
-		logger:     log.Scoped("codenav", ""),
+		repoStore:   repoStore,
+		lsifstore:   lsifstore,
+		gitserver:   gitserver,
+		uploadSvc:   uploadSvc,
+		renameCache: renameCache,
+		operations:  newOperations(observationCtx),
+		logger:      log.Scoped("codenav", ""),
 	}
 }
 
@@ -97,7 +110,7 @@ func (s *Service) GetHover(ctx context.Context, args RequestArgs, requestState R
 		}
 
 		// Adjust the highlighted range back to the appropriate range in the target commit
-		_, adjustedRange, _, err := s.getSourceRange(ctx, args, requestState, cachedUploads[i].RepositoryID, cachedUploads[i].Commit, args.Path, rn)
+		_, _, adjustedRange, _, err := s.getSourceRange(ctx, args, requestState, cachedUploads[i].RepositoryID, cachedUploads[i].Commit, adjustedUpload.TargetPath, rn)
 		if err != nil {
 			return "", shared.Range{}, false, err
 		}
@@ -538,35 +551,36 @@ func (s *Service) getUploadLocations(ctx context.Context, args RequestArgs, requ
 // the requested commit. If the translation fails, then the original commit and range are used as the
 // commit and range of the adjusted location and a false flag is returned.
 func (s *Service) getUploadLocation(ctx context.Context, args RequestArgs, requestState RequestState, dump uploadsshared.Dump, location shared.Location) (shared.UploadLocation, bool, error) {
-	adjustedCommit, adjustedRange, ok, err := s.getSourceRange(ctx, args, requestState, dump.RepositoryID, dump.Commit, dump.Root+location.Path, location.Range)
+	adjustedCommit, adjustedPath, adjustedRange, ok, err := s.getSourceRange(ctx, args, requestState, dump.RepositoryID, dump.Commit, dump.Root+location.Path, location.Range)
 	if err != nil {
 		return shared.UploadLocation{}, ok, err
 	}
 
 	return shared.UploadLocation{
 		Dump:         dump,
-		Path:         dump.Root + location.Path,
+		Path:         adjustedPath,
 		TargetCommit: adjustedCommit,
 		TargetRange:  adjustedRange,
 	}, ok, nil
 }
 
-// getSourceRange translates a range (relative to the indexed commit) into an equivalent range in the requested
-// commit. If the translation fails, then the original commit and range are returned along with a false-valued
-// flag.
-func (s *Service) getSourceRange(ctx context.Context, args RequestArgs, requestState RequestState, repositoryID int, commit, path string, rng shared.Range) (string, shared.Range, bool, error) {
+// getSourceRange translates a range (relative to the indexed commit) into an equivalent path and range in the
+// requested commit. The path differs from the given path if the file was renamed or moved since the indexed
+// commit. If the translation fails, then the original commit, path, and range are returned along with a
+// false-valued flag.
+func (s *Service) getSourceRange(ctx context.Context, args RequestArgs, requestState RequestState, repositoryID int, commit, path string, rng shared.Range) (string, string, shared.Range, bool, error) {
 	if repositoryID != args.RepositoryID {
 		// No diffs between distinct repositories
-		return commit, rng, true, nil
+		return commit, path, rng, true, nil
 	}
 
-	if _, sourceRange, ok, err := requestState.GitTreeTranslator.GetTargetCommitRangeFromSourceRange(ctx, commit, path, rng, true); err != nil {
-		return "", shared.Range{}, false, errors.Wrap(err, "gitTreeTranslator.GetTargetCommitRangeFromSourceRange")
+	if sourcePath, sourceRange, ok, err := requestState.GitTreeTranslator.GetTargetCommitRangeFromSourceRange(ctx, commit, path, rng, true); err != nil {
+		return "", "", shared.Range{}, false, errors.Wrap(err, "gitTreeTranslator.GetTargetCommitRangeFromSourceRange")
 	} else if ok {
-		return args.Commit, sourceRange, true, nil
+		return args.Commit, sourcePath, sourceRange, true, nil
 	}
 
-	return commit, rng, false, nil
+	return commit, path, rng, false, nil
 }
 
 // getUploadsByIDs returns a slice of uploads with the given identifiers. This method will not return a
@@ -994,7 +1008,7 @@ func (s *Service) getRequestedCommitDiagnostic(ctx context.Context, args Request
 	// call below, and is also reflected in the embedded diagnostic value in the return.
 	diagnostic.Path = adjustedUpload.Upload.Root + diagnostic.Path
 
-	adjustedCommit, adjustedRange, _, err := s.getSourceRange(
+	adjustedCommit, _, adjustedRange, _, err := s.getSourceRange(
 		ctx,
 		args,
 		requestState,
@@ -1015,6 +1029,63 @@ func (s *Service) getRequestedCommitDiagnostic(ctx context.Context, args Request
 	}, nil
 }
 
+// SearchDiagnostics returns the diagnostics matching the given filters that were extracted from the
+// precise indexes visible from the given commit of a repository. Diagnostics are not adjusted to the
+// given commit and are reported relative to the commit of the index from which they were extracted.
+// This method also returns the total number of matching diagnostics.
+func (s *Service) SearchDiagnostics(ctx context.Context, repositoryID int, commit string, args shared.SearchDiagnosticsArgs) (diagnosticsAtUploads []DiagnosticAtUpload, _ int, err error) {
+	ctx, trace, endObservation := s.operations.searchDiagnostics.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", repositoryID),
+		attribute.String("commit", commit),
+		attribute.IntSlice("severities", args.Severities),
+		attribute.StringSlice("codes", args.Codes),
+		attribute.Int("limit", args.Limit),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	uploads, err := s.GetClosestDumpsForBlob(ctx, repositoryID, commit, "", false, "")
+	if err != nil {
+		return nil, 0, err
+	}
+	trace.AddEvent("GetClosestDumpsForBlob", attribute.Int("numUploads", len(uploads)))
+
+	if len(uploads) == 0 {
+		return nil, 0, nil
+	}
+
+	uploadsByID := make(map[int]uploadsshared.Dump, len(uploads))
+	uploadRoots := make(map[int]string, len(uploads))
+	for _, upload := range uploads {
+		uploadsByID[upload.ID] = upload
+		uploadRoots[upload.ID] = upload.Root
+	}
+
+	diagnostics, totalCount, err := s.lsifstore.SearchDiagnostics(ctx, uploadRoots, args)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "lsifStore.SearchDiagnostics")
+	}
+	trace.AddEvent("SearchDiagnostics",
+		attribute.Int("totalCount", totalCount),
+		attribute.Int("numDiagnostics", len(diagnostics)))
+
+	diagnosticsAtUploads = make([]DiagnosticAtUpload, 0, len(diagnostics))
+	for _, diagnostic := range diagnostics {
+		upload := uploadsByID[diagnostic.DumpID]
+
+		diagnosticsAtUploads = append(diagnosticsAtUploads, DiagnosticAtUpload{
+			Diagnostic:     diagnostic,
+			Dump:           upload,
+			AdjustedCommit: upload.Commit,
+			AdjustedRange: shared.Range{
+				Start: shared.Position{Line: diagnostic.StartLine, Character: diagnostic.StartCharacter},
+				End:   shared.Position{Line: diagnostic.EndLine, Character: diagnostic.EndCharacter},
+			},
+		})
+	}
+
+	return diagnosticsAtUploads, totalCount, nil
+}
+
 func (s *Service) VisibleUploadsForPath(ctx context.Context, requestState RequestState) (dumps []uploadsshared.Dump, err error) {
 	ctx, _, endObservation := s.operations.visibleUploadsForPath.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
 		attribute.String("path", requestState.Path),
@@ -1115,7 +1186,7 @@ func (s *Service) GetRanges(ctx context.Context, args RequestArgs, requestState
 // equivalent range summary in the requested commit. If the translation fails, a false-valued flag
 // is returned.
 func (s *Service) getCodeIntelligenceRange(ctx context.Context, args RequestArgs, requestState RequestState, upload visibleUpload, rn shared.CodeIntelligenceRange) (AdjustedCodeIntelligenceRange, bool, error) {
-	_, adjustedRange, ok, err := s.getSourceRange(ctx, args, requestState, upload.Upload.RepositoryID, upload.Upload.Commit, upload.TargetPath, rn.Range)
+	_, _, adjustedRange, ok, err := s.getSourceRange(ctx, args, requestState, upload.Upload.RepositoryID, upload.Upload.Commit, upload.TargetPath, rn.Range)
 	if err != nil || !ok {
 		return AdjustedCodeIntelligenceRange{}, false, err
 	}
@@ -1172,11 +1243,12 @@ func (s *Service) GetStencil(ctx context.Context, args RequestArgs, requestState
 			return nil, errors.Wrap(err, "lsifStore.Stencil")
 		}
 
+		targetPath := adjustedUploads[i].TargetPath
 		for i, rn := range ranges {
 			// FIXME: change this at it expects an empty uploadsshared.Dump{}
 			cu := requestState.GetCacheUploadsAtIndex(i)
 			// Adjust the highlighted range back to the appropriate range in the target commit
-			_, adjustedRange, _, err := s.getSourceRange(ctx, args, requestState, cu.RepositoryID, cu.Commit, args.Path, rn)
+			_, _, adjustedRange, _, err := s.getSourceRange(ctx, args, requestState, cu.RepositoryID, cu.Commit, targetPath, rn)
 			if err != nil {
 				return nil, err
 			}
@@ -1190,6 +1262,29 @@ func (s *Service) GetStencil(ctx context.Context, args RequestArgs, requestState
 	return dedupeRanges(sortedRanges), nil
 }
 
+// GetSymbolReferenceLocations returns up to limit locations within the given upload that reference
+// one of the given fully-qualified SCIP symbol names.
+func (s *Service) GetSymbolReferenceLocations(ctx context.Context, uploadID int, symbolNames []string, limit int) (_ []shared.Location, err error) {
+	ctx, _, endObservation := s.operations.getSymbolReferenceLocations.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("uploadID", uploadID),
+		attribute.StringSlice("symbolNames", symbolNames),
+		attribute.Int("limit", limit),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	monikers := make([]precise.MonikerData, 0, len(symbolNames))
+	for _, symbolName := range symbolNames {
+		monikers = append(monikers, precise.MonikerData{Scheme: "scip", Identifier: symbolName})
+	}
+
+	locations, _, err := s.lsifstore.GetBulkMonikerLocations(ctx, "references", []int{uploadID}, monikers, limit, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "lsifStore.GetBulkMonikerLocations")
+	}
+
+	return locations, nil
+}
+
 // TODO(#48681) - do not proxy this
 func (s *Service) GetDumpsByIDs(ctx context.Context, ids []int) ([]uploadsshared.Dump, error) {
 	return s.uploadSvc.GetDumpsByIDs(ctx, ids)
@@ -1229,13 +1324,26 @@ func (s *Service) GetClosestDumpsForBlob(ctx context.Context, repositoryID int,
 	// Filter in-place
 	filtered := candidatesWithCommits[:0]
 
+	var gitTreeTranslator GitTreeTranslator
 	for i := range candidatesWithCommits {
 		if exactPath {
-			// TODO - this breaks if the file was renamed in git diff
-			pathExists, err := s.lsifstore.GetPathExists(ctx, candidates[i].ID, strings.TrimPrefix(path, candidates[i].Root))
+			pathExists, err := s.lsifstore.GetPathExists(ctx, candidatesWithCommits[i].ID, strings.TrimPrefix(path, candidatesWithCommits[i].Root))
 			if err != nil {
 				return nil, errors.Wrap(err, "lsifStore.Exists")
 			}
+			if !pathExists {
+				// The file may have been renamed or moved since the indexed commit
+				if gitTreeTranslator == nil {
+					if gitTreeTranslator, err = s.newGitTreeTranslator(ctx, repositoryID, commit, path); err != nil {
+						return nil, err
+					}
+				}
+
+				pathExists, err = s.renamedPathExists(ctx, gitTreeTranslator, candidatesWithCommits[i], path)
+				if err != nil {
+					return nil, err
+				}
+			}
 			if !pathExists {
 				continue
 			}
@@ -1243,15 +1351,59 @@ func (s *Service) GetClosestDumpsForBlob(ctx context.Context, repositoryID int,
 			// TODO(efritz) - ensure there's a valid document path for this condition as well
 		}
 
-		filtered = append(filtered, uploadCandidates[i])
+		filtered = append(filtered, candidatesWithCommits[i])
 	}
 	trace.AddEvent("TODO Domain Owner",
 		attribute.Int("numFiltered", len(filtered)),
 		attribute.String("filtered", uploadIDsToString(filtered)))
 
+	// Record which uploads answer queries, so that storage quotas evict the least queried uploads
+	// first. This is best-effort and must not fail the query.
+	ids := make([]int, 0, len(filtered))
+	for _, dump := range filtered {
+		ids = append(ids, dump.ID)
+	}
+	if err := s.uploadSvc.MarkUploadsQueried(ctx, ids...); err != nil {
+		s.logger.Warn("failed to record queried uploads", log.Error(err))
+	}
+
 	return filtered, nil
 }
 
+// newGitTreeTranslator creates a git tree translator for the given path at the given commit of a repository.
+// The translator shares the service's rename cache.
+func (s *Service) newGitTreeTranslator(ctx context.Context, repositoryID int, commit, path string) (GitTreeTranslator, error) {
+	repo, err := s.repoStore.Get(ctx, api.RepoID(repositoryID))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewGitTreeTranslator(s.gitserver, &requestArgs{
+		repo:   repo,
+		commit: commit,
+		path:   path,
+	}, s.renameCache), nil
+}
+
+// renamedPathExists determines if the given upload contains the document for the given path as it was
+// named in the upload's commit, if the path differs from the given one.
+func (s *Service) renamedPathExists(ctx context.Context, gitTreeTranslator GitTreeTranslator, upload uploadsshared.Dump, path string) (bool, error) {
+	targetPath, ok, err := gitTreeTranslator.GetTargetCommitPathFromSourcePath(ctx, upload.Commit, path, false)
+	if err != nil {
+		return false, errors.Wrap(err, "gitTreeTranslator.GetTargetCommitPathFromSourcePath")
+	}
+	if !ok || targetPath == path || !strings.HasPrefix(targetPath, upload.Root) {
+		return false, nil
+	}
+
+	pathExists, err := s.lsifstore.GetPathExists(ctx, upload.ID, strings.TrimPrefix(targetPath, upload.Root))
+	if err != nil {
+		return false, errors.Wrap(err, "lsifStore.Exists")
+	}
+
+	return pathExists, nil
+}
+
 // filterUploadsWithCommits removes the uploads for commits which are unknown to gitserver from the given
 // slice. The slice is filtered in-place and returned (to update the slice length).
 func filterUploadsWithCommits(ctx context.Context, commitCache CommitCache, uploads []uploadsshared.Dump) ([]uploadsshared.Dump, error) {
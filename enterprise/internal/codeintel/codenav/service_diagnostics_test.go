@@ -9,6 +9,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
 	uploadsshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
@@ -169,3 +170,64 @@ func TestDiagnosticsWithSubRepoPermissions(t *testing.T) {
 		t.Errorf("unexpected limits (-want +got):\n%s", diff)
 	}
 }
+
+func TestSearchDiagnostics(t *testing.T) {
+	// Set up mocks
+	mockRepoStore := defaultMockRepoStore()
+	mockLsifStore := NewMockLsifStore()
+	mockUploadSvc := NewMockUploadService()
+	mockGitserverClient := gitserver.NewMockClient()
+
+	// Init service
+	svc := newService(&observation.TestContext, mockRepoStore, mockLsifStore, mockUploadSvc, mockGitserverClient)
+
+	uploads := []uploadsshared.Dump{
+		{ID: 50, RepositoryID: 42, Commit: "deadbeef1", Root: "sub1/"},
+		{ID: 51, RepositoryID: 42, Commit: "deadbeef2", Root: "sub2/"},
+	}
+	mockUploadSvc.InferClosestUploadsFunc.PushReturn(uploads, nil)
+	mockGitserverClient.CommitsExistFunc.SetDefaultHook(func(ctx context.Context, _ authz.SubRepoPermissionChecker, rcs []api.RepoCommit) (exists []bool, _ error) {
+		for range rcs {
+			exists = append(exists, true)
+		}
+		return
+	})
+
+	diagnostics := []shared.Diagnostic{
+		{DumpID: 51, Path: "sub2/main.go", DiagnosticData: precise.DiagnosticData{Severity: 2, Code: "SA1019", StartLine: 3, StartCharacter: 4, EndLine: 3, EndCharacter: 10}},
+	}
+	mockLsifStore.SearchDiagnosticsFunc.PushReturn(diagnostics, 7, nil)
+
+	args := shared.SearchDiagnosticsArgs{Severities: []int{2}, Codes: []string{"SA1019"}, Limit: 1}
+	adjustedDiagnostics, totalCount, err := svc.SearchDiagnostics(context.Background(), 42, mockCommit, args)
+	if err != nil {
+		t.Fatalf("unexpected error searching diagnostics: %s", err)
+	}
+
+	if totalCount != 7 {
+		t.Errorf("unexpected count. want=%d have=%d", 7, totalCount)
+	}
+
+	expectedDiagnostics := []DiagnosticAtUpload{
+		{
+			Dump:           uploads[1],
+			AdjustedCommit: "deadbeef2",
+			AdjustedRange:  shared.Range{Start: shared.Position{Line: 3, Character: 4}, End: shared.Position{Line: 3, Character: 10}},
+			Diagnostic:     diagnostics[0],
+		},
+	}
+	if diff := cmp.Diff(expectedDiagnostics, adjustedDiagnostics); diff != "" {
+		t.Errorf("unexpected diagnostics (-want +got):\n%s", diff)
+	}
+
+	if history := mockLsifStore.SearchDiagnosticsFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected number of calls. want=%d have=%d", 1, len(history))
+	} else {
+		if diff := cmp.Diff(map[int]string{50: "sub1/", 51: "sub2/"}, history[0].Arg1); diff != "" {
+			t.Errorf("unexpected upload roots (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff(args, history[0].Arg2); diff != "" {
+			t.Errorf("unexpected args (-want +got):\n%s", diff)
+		}
+	}
+}
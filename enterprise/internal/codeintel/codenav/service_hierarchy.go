@@ -0,0 +1,871 @@
+package codenav
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/sourcegraph/scip/bindings/go/scip"
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/exp/slices"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// MaximumHierarchyDepth is the maximum depth of a call or type hierarchy request.
+const MaximumHierarchyDepth = 10
+
+// hierarchyItemLimit is the maximum number of symbols visited by a single hierarchy traversal.
+const hierarchyItemLimit = 250
+
+// hierarchyLocationLimit is the maximum number of locations considered when expanding a single
+// symbol of a hierarchy.
+const hierarchyLocationLimit = 1000
+
+// GetIncomingCalls returns the calls of the function or method at the given position, followed
+// (breadth-first) by the calls of each caller up to the requested depth.
+func (s *Service) GetIncomingCalls(ctx context.Context, args HierarchyArgs, requestState RequestState, cursor HierarchyCursor) (_ []HierarchyEdge, _ HierarchyCursor, err error) {
+	ctx, _, endObservation := observeResolver(ctx, &err, s.operations.getIncomingCalls, serviceObserverThreshold, hierarchyObservationArgs(args, requestState))
+	defer endObservation()
+
+	return s.walkHierarchy(ctx, args, requestState, cursor, s.getCallers, true)
+}
+
+// GetOutgoingCalls returns the calls made by the function or method at the given position, followed
+// (breadth-first) by the calls made by each callee up to the requested depth.
+func (s *Service) GetOutgoingCalls(ctx context.Context, args HierarchyArgs, requestState RequestState, cursor HierarchyCursor) (_ []HierarchyEdge, _ HierarchyCursor, err error) {
+	ctx, _, endObservation := observeResolver(ctx, &err, s.operations.getOutgoingCalls, serviceObserverThreshold, hierarchyObservationArgs(args, requestState))
+	defer endObservation()
+
+	return s.walkHierarchy(ctx, args, requestState, cursor, s.getCallees, false)
+}
+
+// GetTypeHierarchy returns the supertypes or subtypes of the type (or method) at the given position,
+// followed (breadth-first) by their supertypes or subtypes up to the requested depth.
+func (s *Service) GetTypeHierarchy(ctx context.Context, args HierarchyArgs, requestState RequestState, direction TypeHierarchyDirection, cursor HierarchyCursor) (_ []HierarchyEdge, _ HierarchyCursor, err error) {
+	observationArgs := hierarchyObservationArgs(args, requestState)
+	observationArgs.Attrs = append(observationArgs.Attrs, attribute.String("direction", string(direction)))
+	ctx, _, endObservation := observeResolver(ctx, &err, s.operations.getTypeHierarchy, serviceObserverThreshold, observationArgs)
+	defer endObservation()
+
+	switch direction {
+	case TypeHierarchySupertypes:
+		return s.walkHierarchy(ctx, args, requestState, cursor, s.getSupertypes, false)
+	case TypeHierarchySubtypes:
+		return s.walkHierarchy(ctx, args, requestState, cursor, s.getSubtypes, true)
+	default:
+		return nil, cursor, errors.Newf("unknown type hierarchy direction %q", direction)
+	}
+}
+
+func hierarchyObservationArgs(args HierarchyArgs, requestState RequestState) observation.Args {
+	return observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", args.RepositoryID),
+		attribute.String("commit", args.Commit),
+		attribute.String("path", args.Path),
+		attribute.Int("numUploads", len(requestState.GetCacheUploads())),
+		attribute.String("uploads", uploadIDsToString(requestState.GetCacheUploads())),
+		attribute.Int("line", args.Line),
+		attribute.Int("character", args.Character),
+		attribute.Int("depth", args.Depth),
+	}}
+}
+
+// hierarchyNeighbor is an item adjacent to an expanded item of a hierarchy along with the ranges
+// relating the two, e.g. the call sites of a callee within its caller.
+type hierarchyNeighbor struct {
+	item   HierarchyItem
+	ranges []shared.Location
+}
+
+// expandHierarchyItemFn returns the neighbors of the given item in a particular hierarchy. The
+// neighbors must be returned in a deterministic order, as pages are offsets into this slice.
+type expandHierarchyItemFn = func(ctx context.Context, args HierarchyArgs, requestState RequestState, documents hierarchyDocuments, item HierarchyItem) ([]hierarchyNeighbor, error)
+
+// walkHierarchy returns the next page of edges of a breadth-first traversal of the hierarchy defined
+// by the given expansion function, starting at the symbol at the requested position. The given cursor
+// is adjusted to reflect the state required to resolve the next page of edges. If discoveredIsFrom is
+// true, each neighbor is the source of the edge relating it to the expanded item.
+func (s *Service) walkHierarchy(
+	ctx context.Context,
+	args HierarchyArgs,
+	requestState RequestState,
+	cursor HierarchyCursor,
+	expand expandHierarchyItemFn,
+	discoveredIsFrom bool,
+) ([]HierarchyEdge, HierarchyCursor, error) {
+	documents := hierarchyDocuments{}
+
+	if cursor.Phase == "start" {
+		roots, err := s.getHierarchyRoots(ctx, args, requestState, documents)
+		if err != nil {
+			return nil, cursor, err
+		}
+
+		cursor.Queue = nil
+		cursor.Visited = nil
+		cursor.EdgeOffset = 0
+		for _, root := range roots {
+			cursor.enqueue(root)
+		}
+		cursor.Phase = "traverse"
+	}
+
+	type hierarchyStep struct {
+		parent   HierarchyItem
+		neighbor hierarchyNeighbor
+	}
+
+	var steps []hierarchyStep
+	for cursor.Phase == "traverse" && len(steps) < args.Limit && len(cursor.Queue) > 0 {
+		parent := cursor.Queue[0]
+
+		neighbors, err := expand(ctx, args, requestState, documents, parent)
+		if err != nil {
+			return nil, cursor, err
+		}
+
+		// Enqueue the neighbors only on the first expansion of an item. Subsequent
+		// expansions of the same item only resume the page of its edges.
+		if cursor.EdgeOffset == 0 && parent.Depth+1 < args.Depth {
+			for _, neighbor := range neighbors {
+				if neighbor.item.Path == "" {
+					// Symbols without an indexed definition can't be expanded
+					continue
+				}
+
+				neighbor.item.Depth = parent.Depth + 1
+				cursor.enqueue(neighbor.item)
+			}
+		}
+
+		numNeighbors := len(neighbors)
+		if cursor.EdgeOffset < numNeighbors {
+			neighbors = neighbors[cursor.EdgeOffset:]
+		} else {
+			neighbors = nil
+		}
+		if remaining := args.Limit - len(steps); len(neighbors) > remaining {
+			neighbors = neighbors[:remaining]
+		}
+
+		for _, neighbor := range neighbors {
+			steps = append(steps, hierarchyStep{parent: parent, neighbor: neighbor})
+		}
+
+		cursor.EdgeOffset += len(neighbors)
+		if cursor.EdgeOffset >= numNeighbors {
+			// Move on to the next item on the next iteration
+			cursor.Queue = cursor.Queue[1:]
+			cursor.EdgeOffset = 0
+		}
+	}
+	if len(cursor.Queue) == 0 {
+		cursor.Phase = "done"
+	}
+
+	// Items in the cursor may refer to uploads that were found by a moniker search during a previous
+	// request. Hydrate these uploads before adjusting locations.
+	uploadIDs := make([]int, 0, len(steps)*2)
+	for _, step := range steps {
+		uploadIDs = append(uploadIDs, step.parent.UploadID, step.neighbor.item.UploadID)
+	}
+	if _, err := s.getUploadsByIDs(ctx, uniqueIDs(uploadIDs), requestState); err != nil {
+		return nil, cursor, err
+	}
+
+	edges := make([]HierarchyEdge, 0, len(steps))
+	for _, step := range steps {
+		parent, ok, err := s.getHierarchySymbol(ctx, args, requestState, step.parent)
+		if err != nil {
+			return nil, cursor, err
+		}
+		if !ok {
+			continue
+		}
+
+		neighbor, ok, err := s.getHierarchySymbol(ctx, args, requestState, step.neighbor.item)
+		if err != nil {
+			return nil, cursor, err
+		}
+		if !ok {
+			continue
+		}
+
+		var ranges []shared.UploadLocation
+		if len(step.neighbor.ranges) > 0 {
+			if ranges, err = s.getUploadLocations(ctx, args.RequestArgs, requestState, step.neighbor.ranges, true); err != nil {
+				return nil, cursor, err
+			}
+			if len(ranges) == 0 {
+				// All ranges relating the two symbols are hidden from the user
+				continue
+			}
+		}
+
+		edge := HierarchyEdge{From: neighbor, To: parent, Ranges: ranges, Depth: step.parent.Depth + 1}
+		if !discoveredIsFrom {
+			edge.From, edge.To = parent, neighbor
+		}
+		edges = append(edges, edge)
+	}
+
+	return edges, cursor, nil
+}
+
+// Validate returns an error if the cursor could not have been produced by a traversal of the
+// given depth. Cursors are supplied by clients, so they must be validated before the traversal
+// is resumed: an oversized queue would make a single request expand an unbounded number of items.
+func (c HierarchyCursor) Validate(depth int) error {
+	switch c.Phase {
+	case "start", "traverse", "done":
+	default:
+		return errors.Newf("unknown phase %q", c.Phase)
+	}
+	if len(c.Visited) > hierarchyItemLimit {
+		return errors.Newf("more than %d visited items", hierarchyItemLimit)
+	}
+	if len(c.Queue) > len(c.Visited) {
+		return errors.New("more queued than visited items")
+	}
+	if c.EdgeOffset < 0 {
+		return errors.New("negative edge offset")
+	}
+	for _, item := range c.Queue {
+		if item.Depth < 0 || item.Depth >= depth {
+			return errors.Newf("queued item at depth %d exceeds the requested depth %d", item.Depth, depth)
+		}
+		if !slices.Contains(c.Visited, item.key()) {
+			return errors.New("queued item was not visited")
+		}
+	}
+	return nil
+}
+
+// enqueue adds the given item to the traversal queue unless it has been enqueued before or the
+// traversal has reached the maximum number of visited items.
+func (c *HierarchyCursor) enqueue(item HierarchyItem) {
+	key := item.key()
+	if len(c.Visited) >= hierarchyItemLimit || slices.Contains(c.Visited, key) {
+		return
+	}
+
+	c.Visited = append(c.Visited, key)
+	c.Queue = append(c.Queue, item)
+}
+
+func (i HierarchyItem) key() string {
+	return fmt.Sprintf("%d:%s:%s", i.UploadID, i.Path, i.Symbol)
+}
+
+// getHierarchySymbol returns the given item with its definition adjusted to the target commit. If
+// the definition is hidden from the user, a false-valued flag is returned.
+func (s *Service) getHierarchySymbol(ctx context.Context, args HierarchyArgs, requestState RequestState, item HierarchyItem) (HierarchySymbol, bool, error) {
+	symbol := HierarchySymbol{
+		Symbol:      item.Symbol,
+		DisplayName: item.DisplayName,
+		Kind:        item.Kind,
+	}
+	if item.Path == "" {
+		return symbol, true, nil
+	}
+
+	locations, err := s.getUploadLocations(ctx, args.RequestArgs, requestState, []shared.Location{{DumpID: item.UploadID, Path: item.Path, Range: item.Range}}, true)
+	if err != nil || len(locations) == 0 {
+		return HierarchySymbol{}, false, err
+	}
+	symbol.Location = &locations[0]
+
+	return symbol, true, nil
+}
+
+// getHierarchyRoots returns an item for the symbol at the requested position within each visible upload.
+func (s *Service) getHierarchyRoots(ctx context.Context, args HierarchyArgs, requestState RequestState, documents hierarchyDocuments) ([]HierarchyItem, error) {
+	visibleUploads, err := s.getVisibleUploads(ctx, args.Line, args.Character, requestState)
+	if err != nil {
+		return nil, err
+	}
+
+	var roots []HierarchyItem
+	for _, upload := range visibleUploads {
+		document, err := s.getHierarchyDocument(ctx, documents, upload.Upload.ID, upload.TargetPathWithoutRoot)
+		if err != nil {
+			return nil, err
+		}
+		if document == nil {
+			continue
+		}
+
+		for _, occurrence := range scip.FindOccurrences(document.Occurrences, int32(upload.TargetPosition.Line), int32(upload.TargetPosition.Character)) {
+			if occurrence.Symbol == "" {
+				continue
+			}
+
+			items, err := s.resolveHierarchyItems(ctx, requestState, documents, upload.Upload.ID, upload.TargetPathWithoutRoot, document, []string{occurrence.Symbol})
+			if err != nil {
+				return nil, err
+			}
+			roots = append(roots, items...)
+			break
+		}
+	}
+
+	return roots, nil
+}
+
+// getCallers returns the functions and methods calling the given item, along with the call sites
+// within each caller. Callers are found in the upload defining the item as well as in all uploads
+// referencing the item's moniker.
+func (s *Service) getCallers(ctx context.Context, args HierarchyArgs, requestState RequestState, documents hierarchyDocuments, item HierarchyItem) ([]hierarchyNeighbor, error) {
+	var locations []shared.Location
+	if scip.IsLocalSymbol(item.Symbol) {
+		document, err := s.getHierarchyDocument(ctx, documents, item.UploadID, item.Path)
+		if err != nil || document == nil {
+			return nil, err
+		}
+
+		for _, occurrence := range document.Occurrences {
+			if occurrence.Symbol == item.Symbol && !scip.SymbolRole_Definition.Matches(occurrence) {
+				locations = append(locations, shared.Location{DumpID: item.UploadID, Path: item.Path, Range: convertSCIPRange(occurrence.Range)})
+			}
+		}
+	} else {
+		uploadIDs, err := s.getHierarchyUploadIDs(ctx, args, requestState, item)
+		if err != nil {
+			return nil, err
+		}
+
+		locations, _, err = s.lsifstore.GetBulkMonikerLocations(ctx, "references", uploadIDs, []precise.MonikerData{{Identifier: item.Symbol}}, hierarchyLocationLimit, 0)
+		if err != nil {
+			return nil, errors.Wrap(err, "lsifStore.GetBulkMonikerLocations")
+		}
+	}
+
+	neighborsByKey := map[string]*hierarchyNeighbor{}
+	for _, location := range locations {
+		document, err := s.getHierarchyDocument(ctx, documents, location.DumpID, location.Path)
+		if err != nil {
+			return nil, err
+		}
+		if document == nil {
+			continue
+		}
+
+		caller, ok := document.enclosingCallable(location.Range)
+		if !ok {
+			continue
+		}
+
+		callerItem := newHierarchyItem(location.DumpID, location.Path, document, caller)
+		neighbor, ok := neighborsByKey[callerItem.key()]
+		if !ok {
+			neighbor = &hierarchyNeighbor{item: callerItem}
+			neighborsByKey[callerItem.key()] = neighbor
+		}
+		neighbor.ranges = append(neighbor.ranges, location)
+	}
+
+	return sortHierarchyNeighbors(neighborsByKey), nil
+}
+
+// getCallees returns the functions and methods called within the body of the given item, along with
+// the call sites of each callee.
+func (s *Service) getCallees(ctx context.Context, args HierarchyArgs, requestState RequestState, documents hierarchyDocuments, item HierarchyItem) ([]hierarchyNeighbor, error) {
+	if item.Path == "" || item.EnclosingRange == (shared.Range{}) {
+		// The body of the symbol is unknown
+		return nil, nil
+	}
+
+	document, err := s.getHierarchyDocument(ctx, documents, item.UploadID, item.Path)
+	if err != nil || document == nil {
+		return nil, err
+	}
+
+	var symbols []string
+	callSitesBySymbol := map[string][]shared.Location{}
+	for _, occurrence := range document.Occurrences {
+		if occurrence.Symbol == "" || scip.SymbolRole_Definition.Matches(occurrence) {
+			continue
+		}
+
+		r := convertSCIPRange(occurrence.Range)
+		if !rangeContainsPosition(item.EnclosingRange, r.Start) || !document.isCallable(occurrence.Symbol) {
+			continue
+		}
+
+		if _, ok := callSitesBySymbol[occurrence.Symbol]; !ok {
+			symbols = append(symbols, occurrence.Symbol)
+		}
+		callSitesBySymbol[occurrence.Symbol] = append(callSitesBySymbol[occurrence.Symbol], shared.Location{
+			DumpID: item.UploadID,
+			Path:   item.Path,
+			Range:  r,
+		})
+	}
+
+	callees, err := s.resolveHierarchyItems(ctx, requestState, documents, item.UploadID, item.Path, document, symbols)
+	if err != nil {
+		return nil, err
+	}
+
+	neighbors := make([]hierarchyNeighbor, 0, len(callees))
+	for _, callee := range callees {
+		neighbors = append(neighbors, hierarchyNeighbor{item: callee, ranges: callSitesBySymbol[callee.Symbol]})
+	}
+
+	return neighbors, nil
+}
+
+// getSupertypes returns the symbols the given item implements according to the relationships of its
+// symbol information.
+func (s *Service) getSupertypes(ctx context.Context, args HierarchyArgs, requestState RequestState, documents hierarchyDocuments, item HierarchyItem) ([]hierarchyNeighbor, error) {
+	if item.Path == "" {
+		return nil, nil
+	}
+
+	document, err := s.getHierarchyDocument(ctx, documents, item.UploadID, item.Path)
+	if err != nil || document == nil {
+		return nil, err
+	}
+
+	symbolInformation, ok := document.symbols[item.Symbol]
+	if !ok {
+		return nil, nil
+	}
+
+	var symbols []string
+	for _, relationship := range symbolInformation.Relationships {
+		if relationship.IsImplementation && !slices.Contains(symbols, relationship.Symbol) {
+			symbols = append(symbols, relationship.Symbol)
+		}
+	}
+
+	supertypes, err := s.resolveHierarchyItems(ctx, requestState, documents, item.UploadID, item.Path, document, symbols)
+	if err != nil {
+		return nil, err
+	}
+
+	neighbors := make([]hierarchyNeighbor, 0, len(supertypes))
+	for _, supertype := range supertypes {
+		neighbors = append(neighbors, hierarchyNeighbor{item: supertype})
+	}
+
+	return neighbors, nil
+}
+
+// getSubtypes returns the symbols implementing the given item. Subtypes are found in the upload
+// defining the item as well as in all uploads referencing the item's moniker.
+func (s *Service) getSubtypes(ctx context.Context, args HierarchyArgs, requestState RequestState, documents hierarchyDocuments, item HierarchyItem) ([]hierarchyNeighbor, error) {
+	implementsItem := func(document *hierarchyDocument, occurrence *scip.Occurrence) bool {
+		if symbolInformation, ok := document.symbols[occurrence.Symbol]; ok {
+			for _, relationship := range symbolInformation.Relationships {
+				if relationship.IsImplementation && relationship.Symbol == item.Symbol {
+					return true
+				}
+			}
+		}
+
+		return false
+	}
+
+	var locations []shared.Location
+	if scip.IsLocalSymbol(item.Symbol) {
+		if item.Path == "" {
+			return nil, nil
+		}
+		locations = []shared.Location{{DumpID: item.UploadID, Path: item.Path}}
+	} else {
+		uploadIDs, err := s.getHierarchyUploadIDs(ctx, args, requestState, item)
+		if err != nil {
+			return nil, err
+		}
+
+		locations, _, err = s.lsifstore.GetBulkMonikerLocations(ctx, "implementations", uploadIDs, []precise.MonikerData{{Identifier: item.Symbol}}, hierarchyLocationLimit, 0)
+		if err != nil {
+			return nil, errors.Wrap(err, "lsifStore.GetBulkMonikerLocations")
+		}
+	}
+
+	neighborsByKey := map[string]*hierarchyNeighbor{}
+	for _, location := range locations {
+		document, err := s.getHierarchyDocument(ctx, documents, location.DumpID, location.Path)
+		if err != nil {
+			return nil, err
+		}
+		if document == nil {
+			continue
+		}
+
+		for _, occurrence := range document.Occurrences {
+			if !scip.SymbolRole_Definition.Matches(occurrence) || !implementsItem(document, occurrence) {
+				continue
+			}
+			if !scip.IsLocalSymbol(item.Symbol) && convertSCIPRange(occurrence.Range) != location.Range {
+				continue
+			}
+
+			subtype := newHierarchyItem(location.DumpID, location.Path, document, occurrence)
+			neighborsByKey[subtype.key()] = &hierarchyNeighbor{item: subtype}
+		}
+	}
+
+	return sortHierarchyNeighbors(neighborsByKey), nil
+}
+
+// getHierarchyUploadIDs returns the identifier of the upload containing the given item followed by
+// the identifiers of the uploads referencing the item's moniker from other repositories or roots.
+func (s *Service) getHierarchyUploadIDs(ctx context.Context, args HierarchyArgs, requestState RequestState, item HierarchyItem) ([]int, error) {
+	uploadIDs := []int{item.UploadID}
+
+	moniker, ok := symbolToQualifiedMoniker(item.Symbol, precise.Export)
+	if !ok {
+		return uploadIDs, nil
+	}
+
+	referenceUploadIDs, _, _, err := s.uploadSvc.GetUploadIDsWithReferences(
+		ctx,
+		[]precise.QualifiedMonikerData{moniker},
+		uploadIDs,
+		args.RepositoryID,
+		args.Commit,
+		requestState.maximumIndexesPerMonikerSearch,
+		0,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove uploads for commits unknown to gitserver
+	referenceUploads, err := s.getUploadsByIDs(ctx, referenceUploadIDs, requestState)
+	if err != nil {
+		return nil, err
+	}
+	for _, upload := range referenceUploads {
+		uploadIDs = append(uploadIDs, upload.ID)
+	}
+
+	return uploadIDs, nil
+}
+
+// resolveHierarchyItems returns an item for each of the given symbols occurring in the given document.
+// Definitions are searched for in the given document, then in the remainder of the upload, and then
+// in the uploads providing the symbol's moniker. Symbols without any indexed definition are returned
+// as items without a path.
+func (s *Service) resolveHierarchyItems(
+	ctx context.Context,
+	requestState RequestState,
+	documents hierarchyDocuments,
+	uploadID int,
+	path string,
+	document *hierarchyDocument,
+	symbols []string,
+) ([]HierarchyItem, error) {
+	itemsBySymbol := make(map[string]HierarchyItem, len(symbols))
+	for _, occurrence := range document.Occurrences {
+		if !slices.Contains(symbols, occurrence.Symbol) || !scip.SymbolRole_Definition.Matches(occurrence) {
+			continue
+		}
+		if _, ok := itemsBySymbol[occurrence.Symbol]; !ok {
+			itemsBySymbol[occurrence.Symbol] = newHierarchyItem(uploadID, path, document, occurrence)
+		}
+	}
+
+	unresolvedMonikers := func() (monikers []precise.MonikerData) {
+		for _, symbol := range symbols {
+			if _, ok := itemsBySymbol[symbol]; !ok && !scip.IsLocalSymbol(symbol) {
+				monikers = append(monikers, precise.MonikerData{Identifier: symbol})
+			}
+		}
+		return monikers
+	}
+
+	addDefinitions := func(uploadIDs []int, monikers []precise.MonikerData) error {
+		locations, _, err := s.lsifstore.GetBulkMonikerLocations(ctx, "definitions", uploadIDs, monikers, hierarchyLocationLimit, 0)
+		if err != nil {
+			return errors.Wrap(err, "lsifStore.GetBulkMonikerLocations")
+		}
+
+		for _, location := range locations {
+			definitionDocument, err := s.getHierarchyDocument(ctx, documents, location.DumpID, location.Path)
+			if err != nil {
+				return err
+			}
+			if definitionDocument == nil {
+				continue
+			}
+
+			for _, occurrence := range definitionDocument.Occurrences {
+				if !slices.Contains(symbols, occurrence.Symbol) || !scip.SymbolRole_Definition.Matches(occurrence) || convertSCIPRange(occurrence.Range) != location.Range {
+					continue
+				}
+				if _, ok := itemsBySymbol[occurrence.Symbol]; !ok {
+					itemsBySymbol[occurrence.Symbol] = newHierarchyItem(location.DumpID, location.Path, definitionDocument, occurrence)
+				}
+			}
+		}
+
+		return nil
+	}
+
+	// Search the remainder of the same upload
+	if monikers := unresolvedMonikers(); len(monikers) > 0 {
+		if err := addDefinitions([]int{uploadID}, monikers); err != nil {
+			return nil, err
+		}
+	}
+
+	// Search the uploads providing the remaining monikers
+	if monikers := unresolvedMonikers(); len(monikers) > 0 {
+		qualifiedMonikers := make([]precise.QualifiedMonikerData, 0, len(monikers))
+		for _, moniker := range monikers {
+			if qualifiedMoniker, ok := symbolToQualifiedMoniker(moniker.Identifier, precise.Import); ok {
+				qualifiedMonikers = append(qualifiedMonikers, qualifiedMoniker)
+			}
+		}
+
+		if len(qualifiedMonikers) > 0 {
+			definitionUploads, err := s.getUploadsWithDefinitionsForMonikers(ctx, qualifiedMonikers, requestState)
+			if err != nil {
+				return nil, err
+			}
+
+			definitionUploadIDs := make([]int, 0, len(definitionUploads))
+			for _, upload := range definitionUploads {
+				if upload.ID != uploadID {
+					definitionUploadIDs = append(definitionUploadIDs, upload.ID)
+				}
+			}
+
+			if len(definitionUploadIDs) > 0 {
+				if err := addDefinitions(definitionUploadIDs, monikers); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	items := make([]HierarchyItem, 0, len(symbols))
+	for _, symbol := range symbols {
+		item, ok := itemsBySymbol[symbol]
+		if !ok {
+			displayName, kind := document.describe(symbol)
+			item = HierarchyItem{UploadID: uploadID, Symbol: symbol, DisplayName: displayName, Kind: kind}
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// symbolToQualifiedMoniker returns the qualified moniker of the given global SCIP symbol.
+func symbolToQualifiedMoniker(symbol, kind string) (precise.QualifiedMonikerData, bool) {
+	if scip.IsLocalSymbol(symbol) {
+		return precise.QualifiedMonikerData{}, false
+	}
+
+	parsedSymbol, err := scip.ParseSymbol(symbol)
+	if err != nil || parsedSymbol.Package == nil {
+		return precise.QualifiedMonikerData{}, false
+	}
+
+	return precise.QualifiedMonikerData{
+		MonikerData: precise.MonikerData{
+			Scheme:     parsedSymbol.Scheme,
+			Kind:       kind,
+			Identifier: symbol,
+		},
+		PackageInformationData: precise.PackageInformationData{
+			Manager: parsedSymbol.Package.Manager,
+			Name:    parsedSymbol.Package.Name,
+			Version: parsedSymbol.Package.Version,
+		},
+	}, true
+}
+
+func newHierarchyItem(uploadID int, path string, document *hierarchyDocument, occurrence *scip.Occurrence) HierarchyItem {
+	displayName, kind := document.describe(occurrence.Symbol)
+
+	item := HierarchyItem{
+		UploadID:    uploadID,
+		Path:        path,
+		Symbol:      occurrence.Symbol,
+		DisplayName: displayName,
+		Kind:        kind,
+		Range:       convertSCIPRange(occurrence.Range),
+	}
+	if len(occurrence.EnclosingRange) > 0 {
+		item.EnclosingRange = convertSCIPRange(occurrence.EnclosingRange)
+	}
+
+	return item
+}
+
+// sortHierarchyNeighbors returns the given neighbors ordered by their key along with their ranges
+// ordered by position.
+func sortHierarchyNeighbors(neighborsByKey map[string]*hierarchyNeighbor) []hierarchyNeighbor {
+	keys := make([]string, 0, len(neighborsByKey))
+	for key := range neighborsByKey {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	neighbors := make([]hierarchyNeighbor, 0, len(keys))
+	for _, key := range keys {
+		neighbor := *neighborsByKey[key]
+		sort.SliceStable(neighbor.ranges, func(i, j int) bool {
+			return comparePositions(neighbor.ranges[i].Range.Start, neighbor.ranges[j].Range.Start) < 0
+		})
+		neighbors = append(neighbors, neighbor)
+	}
+
+	return neighbors
+}
+
+func comparePositions(a, b shared.Position) int {
+	if a.Line != b.Line {
+		return a.Line - b.Line
+	}
+
+	return a.Character - b.Character
+}
+
+func convertSCIPRange(r []int32) shared.Range {
+	scipRange := scip.NewRange(r)
+
+	return shared.Range{
+		Start: shared.Position{Line: int(scipRange.Start.Line), Character: int(scipRange.Start.Character)},
+		End:   shared.Position{Line: int(scipRange.End.Line), Character: int(scipRange.End.Character)},
+	}
+}
+
+func uniqueIDs(ids []int) []int {
+	seen := make(map[int]struct{}, len(ids))
+	unique := ids[:0]
+	for _, id := range ids {
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			unique = append(unique, id)
+		}
+	}
+
+	return unique
+}
+
+// hierarchyDocument is a SCIP document along with a lookup table of its symbol information.
+type hierarchyDocument struct {
+	*scip.Document
+	symbols map[string]*scip.SymbolInformation
+}
+
+type hierarchyDocumentKey struct {
+	uploadID int
+	path     string
+}
+
+// hierarchyDocuments caches the documents read during a single hierarchy request.
+type hierarchyDocuments map[hierarchyDocumentKey]*hierarchyDocument
+
+// getHierarchyDocument returns the document with the given path in the given upload, or nil if the
+// upload does not contain such a document.
+func (s *Service) getHierarchyDocument(ctx context.Context, documents hierarchyDocuments, uploadID int, path string) (*hierarchyDocument, error) {
+	key := hierarchyDocumentKey{uploadID: uploadID, path: path}
+	if document, ok := documents[key]; ok {
+		return document, nil
+	}
+
+	scipDocument, err := s.lsifstore.SCIPDocument(ctx, uploadID, path)
+	if err != nil {
+		return nil, errors.Wrap(err, "lsifStore.SCIPDocument")
+	}
+
+	var document *hierarchyDocument
+	if scipDocument != nil {
+		document = &hierarchyDocument{Document: scipDocument, symbols: scipDocument.SymbolTable()}
+	}
+	documents[key] = document
+
+	return document, nil
+}
+
+// describe returns the display name and kind of the given symbol. The display name falls back to
+// the name of the symbol's last descriptor when the indexer does not provide one.
+func (d *hierarchyDocument) describe(symbol string) (displayName, kind string) {
+	if symbolInformation, ok := d.symbols[symbol]; ok {
+		displayName = symbolInformation.DisplayName
+		if symbolInformation.Kind != scip.SymbolInformation_UnspecifiedKind {
+			kind = symbolInformation.Kind.String()
+		}
+	}
+
+	if displayName == "" {
+		displayName = symbol
+		if parsedSymbol, err := scip.ParseSymbol(symbol); err == nil && len(parsedSymbol.Descriptors) > 0 {
+			displayName = parsedSymbol.Descriptors[len(parsedSymbol.Descriptors)-1].Name
+		}
+	}
+
+	return displayName, kind
+}
+
+// isCallable returns true if the given symbol denotes a function, method, or constructor. Symbols
+// without a known kind are callable if their last descriptor is a method descriptor.
+func (d *hierarchyDocument) isCallable(symbol string) bool {
+	if symbolInformation, ok := d.symbols[symbol]; ok {
+		switch symbolInformation.Kind {
+		case
+			scip.SymbolInformation_Constructor,
+			scip.SymbolInformation_Function,
+			scip.SymbolInformation_Getter,
+			scip.SymbolInformation_Macro,
+			scip.SymbolInformation_Method,
+			scip.SymbolInformation_Setter:
+			return true
+
+		case scip.SymbolInformation_UnspecifiedKind:
+
+		default:
+			return false
+		}
+	}
+
+	if scip.IsLocalSymbol(symbol) {
+		return false
+	}
+
+	parsedSymbol, err := scip.ParseSymbol(symbol)
+	if err != nil || len(parsedSymbol.Descriptors) == 0 {
+		return false
+	}
+
+	return parsedSymbol.Descriptors[len(parsedSymbol.Descriptors)-1].Suffix == scip.Descriptor_Method
+}
+
+// enclosingCallable returns the definition of the innermost function or method whose enclosing
+// range contains the given range.
+func (d *hierarchyDocument) enclosingCallable(r shared.Range) (*scip.Occurrence, bool) {
+	var (
+		innermost      *scip.Occurrence
+		innermostRange shared.Range
+	)
+
+	for _, occurrence := range d.Occurrences {
+		if len(occurrence.EnclosingRange) == 0 || !scip.SymbolRole_Definition.Matches(occurrence) {
+			continue
+		}
+
+		enclosingRange := convertSCIPRange(occurrence.EnclosingRange)
+		if !rangeContainsPosition(enclosingRange, r.Start) || !d.isCallable(occurrence.Symbol) {
+			continue
+		}
+
+		if innermost == nil || rangeContainsPosition(innermostRange, enclosingRange.Start) {
+			innermost = occurrence
+			innermostRange = enclosingRange
+		}
+	}
+
+	return innermost, innermost != nil
+}
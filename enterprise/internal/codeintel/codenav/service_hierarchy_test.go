@@ -0,0 +1,258 @@
+package codenav
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	uploadsshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	sgtypes "github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+const (
+	hierarchyMainSymbol   = "scip-go gomod example v1 main/main()."
+	hierarchyHelperSymbol = "scip-go gomod example v1 main/helper()."
+	hierarchyLeafSymbol   = "scip-go gomod example v1 main/leaf()."
+	hierarchyIfaceSymbol  = "scip-go gomod example v1 main/Shape#"
+	hierarchyImplSymbol   = "scip-go gomod example v1 main/Square#"
+)
+
+// hierarchyDocuments returns a main.go document (at mockPath) in which main calls helper twice and
+// helper calls leaf, which is defined in leaf.go. Square implements Shape.
+func hierarchyTestDocuments() map[string]*scip.Document {
+	definition := int32(scip.SymbolRole_Definition)
+
+	return map[string]*scip.Document{
+		mockPath: {
+			Occurrences: []*scip.Occurrence{
+				{Range: []int32{0, 5, 9}, Symbol: hierarchyMainSymbol, SymbolRoles: definition, EnclosingRange: []int32{0, 0, 5, 1}},
+				{Range: []int32{1, 1, 7}, Symbol: hierarchyHelperSymbol},
+				{Range: []int32{2, 1, 7}, Symbol: hierarchyHelperSymbol},
+				{Range: []int32{6, 5, 11}, Symbol: hierarchyHelperSymbol, SymbolRoles: definition, EnclosingRange: []int32{6, 0, 9, 1}},
+				{Range: []int32{7, 1, 5}, Symbol: hierarchyLeafSymbol},
+				{Range: []int32{11, 5, 10}, Symbol: hierarchyIfaceSymbol, SymbolRoles: definition},
+				{Range: []int32{12, 5, 11}, Symbol: hierarchyImplSymbol, SymbolRoles: definition},
+			},
+			Symbols: []*scip.SymbolInformation{
+				{Symbol: hierarchyMainSymbol, Kind: scip.SymbolInformation_Function},
+				{Symbol: hierarchyHelperSymbol, DisplayName: "helper"},
+				{Symbol: hierarchyIfaceSymbol, Kind: scip.SymbolInformation_Interface},
+				{Symbol: hierarchyImplSymbol, Relationships: []*scip.Relationship{{Symbol: hierarchyIfaceSymbol, IsImplementation: true}}},
+			},
+		},
+		"s1/leaf.go": {
+			Occurrences: []*scip.Occurrence{
+				{Range: []int32{0, 5, 9}, Symbol: hierarchyLeafSymbol, SymbolRoles: definition, EnclosingRange: []int32{0, 0, 2, 1}},
+			},
+		},
+	}
+}
+
+func setupHierarchyTest(t *testing.T) (*Service, *MockLsifStore, RequestState, uploadsshared.Dump) {
+	mockRepoStore := defaultMockRepoStore()
+	mockLsifStore := NewMockLsifStore()
+	mockUploadSvc := NewMockUploadService()
+	mockGitserverClient := gitserver.NewMockClient()
+	hunkCache, _ := NewHunkCache(50)
+
+	svc := newService(&observation.TestContext, mockRepoStore, mockLsifStore, mockUploadSvc, mockGitserverClient)
+
+	mockRequestState := RequestState{}
+	mockRequestState.SetLocalCommitCache(mockRepoStore, mockGitserverClient)
+	mockRequestState.SetLocalGitTreeTranslator(mockGitserverClient, &sgtypes.Repo{}, mockCommit, mockPath, hunkCache)
+	upload := uploadsshared.Dump{ID: 50, Commit: mockCommit}
+	mockRequestState.SetUploadsDataLoader([]uploadsshared.Dump{upload})
+
+	documents := hierarchyTestDocuments()
+	mockLsifStore.SCIPDocumentFunc.SetDefaultHook(func(_ context.Context, uploadID int, path string) (*scip.Document, error) {
+		if uploadID != upload.ID {
+			return nil, nil
+		}
+		return documents[path], nil
+	})
+	mockLsifStore.GetBulkMonikerLocationsFunc.SetDefaultHook(func(_ context.Context, tableName string, _ []int, monikers []precise.MonikerData, _, _ int) ([]shared.Location, int, error) {
+		var locations []shared.Location
+		for _, moniker := range monikers {
+			switch {
+			case tableName == "definitions" && moniker.Identifier == hierarchyLeafSymbol:
+				locations = append(locations, shared.Location{DumpID: upload.ID, Path: "s1/leaf.go", Range: newHierarchyTestRange(0, 5, 0, 9)})
+			case tableName == "references" && moniker.Identifier == hierarchyHelperSymbol:
+				locations = append(locations,
+					shared.Location{DumpID: upload.ID, Path: mockPath, Range: newHierarchyTestRange(2, 1, 2, 7)},
+					shared.Location{DumpID: upload.ID, Path: mockPath, Range: newHierarchyTestRange(1, 1, 1, 7)},
+				)
+			}
+		}
+		return locations, len(locations), nil
+	})
+
+	return svc, mockLsifStore, mockRequestState, upload
+}
+
+func TestOutgoingCalls(t *testing.T) {
+	svc, _, mockRequestState, upload := setupHierarchyTest(t)
+
+	args := HierarchyArgs{
+		RequestArgs: RequestArgs{RepositoryID: 42, Commit: mockCommit, Path: mockPath, Line: 0, Character: 6, Limit: 1},
+		Depth:       2,
+	}
+
+	main := HierarchySymbol{Symbol: hierarchyMainSymbol, DisplayName: "main", Kind: "Function", Location: hierarchyTestLocation(upload, mockPath, 0, 5, 0, 9)}
+	helper := HierarchySymbol{Symbol: hierarchyHelperSymbol, DisplayName: "helper", Location: hierarchyTestLocation(upload, mockPath, 6, 5, 6, 11)}
+	leaf := HierarchySymbol{Symbol: hierarchyLeafSymbol, DisplayName: "leaf", Location: hierarchyTestLocation(upload, "s1/leaf.go", 0, 5, 0, 9)}
+
+	// First page
+	edges, cursor, err := svc.GetOutgoingCalls(context.Background(), args, mockRequestState, HierarchyCursor{Phase: "start"})
+	if err != nil {
+		t.Fatalf("unexpected error querying outgoing calls: %s", err)
+	}
+	expectedEdges := []HierarchyEdge{
+		{From: main, To: helper, Depth: 1, Ranges: []shared.UploadLocation{
+			*hierarchyTestLocation(upload, mockPath, 1, 1, 1, 7),
+			*hierarchyTestLocation(upload, mockPath, 2, 1, 2, 7),
+		}},
+	}
+	if diff := cmp.Diff(expectedEdges, edges); diff != "" {
+		t.Errorf("unexpected edges (-want +got):\n%s", diff)
+	}
+	if cursor.Phase != "traverse" {
+		t.Fatalf("unexpected phase. want=%q have=%q", "traverse", cursor.Phase)
+	}
+
+	// Second page
+	edges, cursor, err = svc.GetOutgoingCalls(context.Background(), args, mockRequestState, cursor)
+	if err != nil {
+		t.Fatalf("unexpected error querying outgoing calls: %s", err)
+	}
+	expectedEdges = []HierarchyEdge{
+		{From: helper, To: leaf, Depth: 2, Ranges: []shared.UploadLocation{
+			*hierarchyTestLocation(upload, mockPath, 7, 1, 7, 5),
+		}},
+	}
+	if diff := cmp.Diff(expectedEdges, edges); diff != "" {
+		t.Errorf("unexpected edges (-want +got):\n%s", diff)
+	}
+	if cursor.Phase != "done" {
+		t.Errorf("unexpected phase. want=%q have=%q", "done", cursor.Phase)
+	}
+
+	// Depth limit
+	args.Depth = 1
+	args.Limit = 10
+	edges, _, err = svc.GetOutgoingCalls(context.Background(), args, mockRequestState, HierarchyCursor{Phase: "start"})
+	if err != nil {
+		t.Fatalf("unexpected error querying outgoing calls: %s", err)
+	}
+	if len(edges) != 1 {
+		t.Errorf("unexpected number of edges. want=%d have=%d", 1, len(edges))
+	}
+}
+
+func TestIncomingCalls(t *testing.T) {
+	svc, _, mockRequestState, upload := setupHierarchyTest(t)
+
+	args := HierarchyArgs{
+		RequestArgs: RequestArgs{RepositoryID: 42, Commit: mockCommit, Path: mockPath, Line: 6, Character: 6, Limit: 10},
+		Depth:       3,
+	}
+	edges, cursor, err := svc.GetIncomingCalls(context.Background(), args, mockRequestState, HierarchyCursor{Phase: "start"})
+	if err != nil {
+		t.Fatalf("unexpected error querying incoming calls: %s", err)
+	}
+
+	expectedEdges := []HierarchyEdge{
+		{
+			From:  HierarchySymbol{Symbol: hierarchyMainSymbol, DisplayName: "main", Kind: "Function", Location: hierarchyTestLocation(upload, mockPath, 0, 5, 0, 9)},
+			To:    HierarchySymbol{Symbol: hierarchyHelperSymbol, DisplayName: "helper", Location: hierarchyTestLocation(upload, mockPath, 6, 5, 6, 11)},
+			Depth: 1,
+			Ranges: []shared.UploadLocation{
+				*hierarchyTestLocation(upload, mockPath, 1, 1, 1, 7),
+				*hierarchyTestLocation(upload, mockPath, 2, 1, 2, 7),
+			},
+		},
+	}
+	if diff := cmp.Diff(expectedEdges, edges); diff != "" {
+		t.Errorf("unexpected edges (-want +got):\n%s", diff)
+	}
+	if cursor.Phase != "done" {
+		t.Errorf("unexpected phase. want=%q have=%q", "done", cursor.Phase)
+	}
+}
+
+func TestTypeHierarchy(t *testing.T) {
+	svc, _, mockRequestState, upload := setupHierarchyTest(t)
+
+	args := HierarchyArgs{
+		RequestArgs: RequestArgs{RepositoryID: 42, Commit: mockCommit, Path: mockPath, Line: 12, Character: 6, Limit: 10},
+		Depth:       1,
+	}
+	edges, _, err := svc.GetTypeHierarchy(context.Background(), args, mockRequestState, TypeHierarchySupertypes, HierarchyCursor{Phase: "start"})
+	if err != nil {
+		t.Fatalf("unexpected error querying type hierarchy: %s", err)
+	}
+
+	expectedEdges := []HierarchyEdge{
+		{
+			From:  HierarchySymbol{Symbol: hierarchyImplSymbol, DisplayName: "Square", Location: hierarchyTestLocation(upload, mockPath, 12, 5, 12, 11)},
+			To:    HierarchySymbol{Symbol: hierarchyIfaceSymbol, DisplayName: "Shape", Kind: "Interface", Location: hierarchyTestLocation(upload, mockPath, 11, 5, 11, 10)},
+			Depth: 1,
+		},
+	}
+	if diff := cmp.Diff(expectedEdges, edges); diff != "" {
+		t.Errorf("unexpected edges (-want +got):\n%s", diff)
+	}
+}
+
+func TestHierarchyCursorValidate(t *testing.T) {
+	item := HierarchyItem{UploadID: 50, Path: mockPath, Symbol: hierarchyHelperSymbol, Depth: 1}
+
+	tooManyVisited := make([]string, hierarchyItemLimit+1)
+	for i := range tooManyVisited {
+		tooManyVisited[i] = fmt.Sprintf("%d", i)
+	}
+
+	testCases := []struct {
+		name   string
+		cursor HierarchyCursor
+		valid  bool
+	}{
+		{name: "start", cursor: HierarchyCursor{Phase: "start"}, valid: true},
+		{name: "traverse", cursor: HierarchyCursor{Phase: "traverse", Queue: []HierarchyItem{item}, Visited: []string{item.key()}, EdgeOffset: 3}, valid: true},
+		{name: "unknown phase", cursor: HierarchyCursor{Phase: "unknown"}},
+		{name: "too many visited items", cursor: HierarchyCursor{Phase: "traverse", Visited: tooManyVisited}},
+		{name: "unvisited queued item", cursor: HierarchyCursor{Phase: "traverse", Queue: []HierarchyItem{item}, Visited: []string{"other"}}},
+		{name: "queued item too deep", cursor: HierarchyCursor{Phase: "traverse", Queue: []HierarchyItem{{UploadID: 50, Path: mockPath, Symbol: hierarchyHelperSymbol, Depth: 2}}, Visited: []string{item.key()}}},
+		{name: "negative edge offset", cursor: HierarchyCursor{Phase: "traverse", EdgeOffset: -1}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if err := testCase.cursor.Validate(2); (err == nil) != testCase.valid {
+				t.Errorf("unexpected validation result. want valid=%v have err=%v", testCase.valid, err)
+			}
+		})
+	}
+}
+
+func newHierarchyTestRange(startLine, startCharacter, endLine, endCharacter int) shared.Range {
+	return shared.Range{
+		Start: shared.Position{Line: startLine, Character: startCharacter},
+		End:   shared.Position{Line: endLine, Character: endCharacter},
+	}
+}
+
+func hierarchyTestLocation(upload uploadsshared.Dump, path string, startLine, startCharacter, endLine, endCharacter int) *shared.UploadLocation {
+	return &shared.UploadLocation{
+		Dump:         upload,
+		Path:         path,
+		TargetCommit: mockCommit,
+		TargetRange:  newHierarchyTestRange(startLine, startCharacter, endLine, endCharacter),
+	}
+}
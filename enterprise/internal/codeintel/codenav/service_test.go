@@ -3,9 +3,14 @@ package codenav
 import (
 	"context"
 	"fmt"
+	"testing"
 
+	uploadsshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
 	internaltypes "github.com/sourcegraph/sourcegraph/internal/types"
 )
 
@@ -25,3 +30,35 @@ func defaultMockRepoStore() *database.MockRepoStore {
 
 	return repoStore
 }
+
+func TestGetClosestDumpsForBlob(t *testing.T) {
+	mockRepoStore := defaultMockRepoStore()
+	mockLsifStore := NewMockLsifStore()
+	mockUploadSvc := NewMockUploadService()
+	mockGitserverClient := gitserver.NewMockClient()
+	svc := newService(&observation.TestContext, mockRepoStore, mockLsifStore, mockUploadSvc, mockGitserverClient)
+
+	mockUploadSvc.InferClosestUploadsFunc.PushReturn([]uploadsshared.Dump{
+		{ID: 150, Commit: "deadbeef1", Root: "sub1/"},
+		{ID: 151, Commit: "deadbeef2", Root: "sub2/"},
+	}, nil)
+
+	// upload #150's commit no longer exists
+	mockGitserverClient.CommitsExistFunc.SetDefaultHook(func(ctx context.Context, _ authz.SubRepoPermissionChecker, rcs []api.RepoCommit) (exists []bool, _ error) {
+		for _, rc := range rcs {
+			exists = append(exists, rc.CommitID != "deadbeef1")
+		}
+		return
+	})
+	mockLsifStore.GetPathExistsFunc.SetDefaultHook(func(_ context.Context, uploadID int, path string) (bool, error) {
+		return uploadID == 151 && path == "main.go", nil
+	})
+
+	dumps, err := svc.GetClosestDumpsForBlob(context.Background(), 42, "deadbeef", "sub2/main.go", true, "")
+	if err != nil {
+		t.Fatalf("unexpected error getting closest dumps: %s", err)
+	}
+	if len(dumps) != 1 || dumps[0].ID != 151 {
+		t.Errorf("unexpected dumps. want=[151] have=%v", dumps)
+	}
+}
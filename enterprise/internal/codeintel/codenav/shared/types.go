@@ -20,6 +20,28 @@ type Diagnostic struct {
 	precise.DiagnosticData
 }
 
+// SearchDiagnosticsArgs filters the diagnostics extracted from a set of precise indexes.
+type SearchDiagnosticsArgs struct {
+	// Severities and ExcludeSeverities filter diagnostics by their SCIP severity value.
+	// An empty set of severities matches diagnostics of any severity.
+	Severities        []int
+	ExcludeSeverities []int
+
+	// Codes and ExcludeCodes filter diagnostics by their exact diagnostic code.
+	// An empty set of codes matches diagnostics with any code.
+	Codes        []string
+	ExcludeCodes []string
+
+	// MessagePattern, IncludePaths, and ExcludePaths are regular expressions matched against
+	// the diagnostic message and the path of the document (relative to the repository root).
+	MessagePattern string
+	IncludePaths   []string
+	ExcludePaths   []string
+	CaseSensitive  bool
+
+	Limit int
+}
+
 // CodeIntelligenceRange pairs a range with its definitions, references, implementations, and hover text.
 type CodeIntelligenceRange struct {
 	Range           Range
@@ -13,6 +13,9 @@ type CodeNavService interface {
 	GetReferences(ctx context.Context, args codenav.RequestArgs, requestState codenav.RequestState, cursor codenav.ReferencesCursor) (_ []shared.UploadLocation, nextCursor codenav.ReferencesCursor, err error)
 	GetImplementations(ctx context.Context, args codenav.RequestArgs, requestState codenav.RequestState, cursor codenav.ImplementationsCursor) (_ []shared.UploadLocation, nextCursor codenav.ImplementationsCursor, err error)
 	GetPrototypes(ctx context.Context, args codenav.RequestArgs, requestState codenav.RequestState, cursor codenav.ImplementationsCursor) (_ []shared.UploadLocation, nextCursor codenav.ImplementationsCursor, err error)
+	GetIncomingCalls(ctx context.Context, args codenav.HierarchyArgs, requestState codenav.RequestState, cursor codenav.HierarchyCursor) (_ []codenav.HierarchyEdge, nextCursor codenav.HierarchyCursor, err error)
+	GetOutgoingCalls(ctx context.Context, args codenav.HierarchyArgs, requestState codenav.RequestState, cursor codenav.HierarchyCursor) (_ []codenav.HierarchyEdge, nextCursor codenav.HierarchyCursor, err error)
+	GetTypeHierarchy(ctx context.Context, args codenav.HierarchyArgs, requestState codenav.RequestState, direction codenav.TypeHierarchyDirection, cursor codenav.HierarchyCursor) (_ []codenav.HierarchyEdge, nextCursor codenav.HierarchyCursor, err error)
 	GetDefinitions(ctx context.Context, args codenav.RequestArgs, requestState codenav.RequestState) (_ []shared.UploadLocation, err error)
 	GetDiagnostics(ctx context.Context, args codenav.RequestArgs, requestState codenav.RequestState) (diagnosticsAtUploads []codenav.DiagnosticAtUpload, _ int, err error)
 	GetRanges(ctx context.Context, args codenav.RequestArgs, requestState codenav.RequestState, startLine, endLine int) (adjustedRanges []codenav.AdjustedCodeIntelligenceRange, err error)
@@ -20,6 +20,9 @@ type operations struct {
 	references      *observation.Operation
 	implementations *observation.Operation
 	prototypes      *observation.Operation
+	incomingCalls   *observation.Operation
+	outgoingCalls   *observation.Operation
+	typeHierarchy   *observation.Operation
 	diagnostics     *observation.Operation
 	stencil         *observation.Operation
 	ranges          *observation.Operation
@@ -50,6 +53,9 @@ func newOperations(observationCtx *observation.Context) *operations {
 		references:      op("References"),
 		implementations: op("Implementations"),
 		prototypes:      op("Prototypes"),
+		incomingCalls:   op("IncomingCalls"),
+		outgoingCalls:   op("OutgoingCalls"),
+		typeHierarchy:   op("TypeHierarchy"),
 		diagnostics:     op("Diagnostics"),
 		stencil:         op("Stencil"),
 		ranges:          op("Ranges"),
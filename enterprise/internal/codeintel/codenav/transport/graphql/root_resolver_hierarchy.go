@@ -0,0 +1,217 @@
+package graphql
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/shared/resolvers/gitresolvers"
+	resolverstubs "github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+// DefaultHierarchyPageSize is the hierarchy result page size when no limit is supplied.
+const DefaultHierarchyPageSize = 50
+
+// DefaultHierarchyDepth is the hierarchy depth when no depth is supplied.
+const DefaultHierarchyDepth = 1
+
+// ErrIllegalDepth occurs when the user requests a hierarchy less than one level deep.
+var ErrIllegalDepth = errors.New("illegal depth")
+
+func (r *gitBlobLSIFDataResolver) IncomingCalls(ctx context.Context, args *resolverstubs.LSIFHierarchyQueryArgs) (_ resolverstubs.CallHierarchyConnectionResolver, err error) {
+	hierarchyArgs, cursor, err := r.getHierarchyArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	ctx, _, endObservation := observeResolver(ctx, &err, r.operations.incomingCalls, time.Second, getObservationArgs(hierarchyArgs.RequestArgs))
+	defer endObservation()
+
+	edges, nextCursor, err := r.codeNavSvc.GetIncomingCalls(ctx, hierarchyArgs, r.requestState, cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "codeNavSvc.GetIncomingCalls")
+	}
+
+	return newCallHierarchyConnectionResolver(edges, encodeHierarchyCursor(nextCursor), r.locationResolver), nil
+}
+
+func (r *gitBlobLSIFDataResolver) OutgoingCalls(ctx context.Context, args *resolverstubs.LSIFHierarchyQueryArgs) (_ resolverstubs.CallHierarchyConnectionResolver, err error) {
+	hierarchyArgs, cursor, err := r.getHierarchyArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	ctx, _, endObservation := observeResolver(ctx, &err, r.operations.outgoingCalls, time.Second, getObservationArgs(hierarchyArgs.RequestArgs))
+	defer endObservation()
+
+	edges, nextCursor, err := r.codeNavSvc.GetOutgoingCalls(ctx, hierarchyArgs, r.requestState, cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "codeNavSvc.GetOutgoingCalls")
+	}
+
+	return newCallHierarchyConnectionResolver(edges, encodeHierarchyCursor(nextCursor), r.locationResolver), nil
+}
+
+func (r *gitBlobLSIFDataResolver) TypeHierarchy(ctx context.Context, args *resolverstubs.LSIFTypeHierarchyQueryArgs) (_ resolverstubs.TypeHierarchyConnectionResolver, err error) {
+	hierarchyArgs, cursor, err := r.getHierarchyArgs(&args.LSIFHierarchyQueryArgs)
+	if err != nil {
+		return nil, err
+	}
+	ctx, _, endObservation := observeResolver(ctx, &err, r.operations.typeHierarchy, time.Second, getObservationArgs(hierarchyArgs.RequestArgs))
+	defer endObservation()
+
+	direction := codenav.TypeHierarchyDirection(strings.ToLower(args.Direction))
+	edges, nextCursor, err := r.codeNavSvc.GetTypeHierarchy(ctx, hierarchyArgs, r.requestState, direction, cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "codeNavSvc.GetTypeHierarchy")
+	}
+
+	resolvers := make([]resolverstubs.TypeHierarchyEdgeResolver, 0, len(edges))
+	for _, edge := range edges {
+		resolvers = append(resolvers, &typeHierarchyEdgeResolver{edge: edge, locationResolver: r.locationResolver})
+	}
+
+	return resolverstubs.NewCursorConnectionResolver(resolvers, encodeCursor(encodeHierarchyCursor(nextCursor))), nil
+}
+
+// getHierarchyArgs validates the given arguments and decodes the cursor given from a previous response,
+// or creates a new one with default values.
+func (r *gitBlobLSIFDataResolver) getHierarchyArgs(args *resolverstubs.LSIFHierarchyQueryArgs) (codenav.HierarchyArgs, codenav.HierarchyCursor, error) {
+	limit := int(pointers.Deref(args.First, DefaultHierarchyPageSize))
+	if limit <= 0 {
+		return codenav.HierarchyArgs{}, codenav.HierarchyCursor{}, ErrIllegalLimit
+	}
+
+	depth := int(pointers.Deref(args.Depth, DefaultHierarchyDepth))
+	if depth <= 0 {
+		return codenav.HierarchyArgs{}, codenav.HierarchyCursor{}, ErrIllegalDepth
+	}
+	if depth > codenav.MaximumHierarchyDepth {
+		depth = codenav.MaximumHierarchyDepth
+	}
+
+	rawCursor, err := decodeCursor(args.After)
+	if err != nil {
+		return codenav.HierarchyArgs{}, codenav.HierarchyCursor{}, err
+	}
+
+	cursor, err := decodeHierarchyCursor(rawCursor)
+	if err != nil {
+		return codenav.HierarchyArgs{}, codenav.HierarchyCursor{}, errors.Wrap(err, fmt.Sprintf("invalid cursor: %q", rawCursor))
+	}
+	// 🚨 SECURITY: The cursor is supplied by the client and drives the traversal.
+	if err := cursor.Validate(depth); err != nil {
+		return codenav.HierarchyArgs{}, codenav.HierarchyCursor{}, errors.Wrap(err, "invalid cursor")
+	}
+
+	return codenav.HierarchyArgs{
+		RequestArgs: codenav.RequestArgs{
+			RepositoryID: r.requestState.RepositoryID,
+			Commit:       r.requestState.Commit,
+			Path:         r.requestState.Path,
+			Line:         int(args.Line),
+			Character:    int(args.Character),
+			Limit:        limit,
+			RawCursor:    rawCursor,
+		},
+		Depth: depth,
+	}, cursor, nil
+}
+
+//
+//
+
+func newCallHierarchyConnectionResolver(edges []codenav.HierarchyEdge, cursor *string, locationResolver *gitresolvers.CachedLocationResolver) resolverstubs.CallHierarchyConnectionResolver {
+	resolvers := make([]resolverstubs.CallHierarchyCallResolver, 0, len(edges))
+	for _, edge := range edges {
+		resolvers = append(resolvers, &callHierarchyCallResolver{edge: edge, locationResolver: locationResolver})
+	}
+
+	return resolverstubs.NewCursorConnectionResolver(resolvers, encodeCursor(cursor))
+}
+
+type callHierarchyCallResolver struct {
+	edge             codenav.HierarchyEdge
+	locationResolver *gitresolvers.CachedLocationResolver
+}
+
+func (r *callHierarchyCallResolver) Caller() resolverstubs.HierarchySymbolResolver {
+	return &hierarchySymbolResolver{symbol: r.edge.From, locationResolver: r.locationResolver}
+}
+
+func (r *callHierarchyCallResolver) Callee() resolverstubs.HierarchySymbolResolver {
+	return &hierarchySymbolResolver{symbol: r.edge.To, locationResolver: r.locationResolver}
+}
+
+func (r *callHierarchyCallResolver) CallSites(ctx context.Context) ([]resolverstubs.LocationResolver, error) {
+	return resolveLocations(ctx, r.locationResolver, r.edge.Ranges)
+}
+
+func (r *callHierarchyCallResolver) Depth() int32 { return int32(r.edge.Depth) }
+
+type typeHierarchyEdgeResolver struct {
+	edge             codenav.HierarchyEdge
+	locationResolver *gitresolvers.CachedLocationResolver
+}
+
+func (r *typeHierarchyEdgeResolver) Subtype() resolverstubs.HierarchySymbolResolver {
+	return &hierarchySymbolResolver{symbol: r.edge.From, locationResolver: r.locationResolver}
+}
+
+func (r *typeHierarchyEdgeResolver) Supertype() resolverstubs.HierarchySymbolResolver {
+	return &hierarchySymbolResolver{symbol: r.edge.To, locationResolver: r.locationResolver}
+}
+
+func (r *typeHierarchyEdgeResolver) Depth() int32 { return int32(r.edge.Depth) }
+
+type hierarchySymbolResolver struct {
+	symbol           codenav.HierarchySymbol
+	locationResolver *gitresolvers.CachedLocationResolver
+}
+
+func (r *hierarchySymbolResolver) Symbol() string      { return r.symbol.Symbol }
+func (r *hierarchySymbolResolver) DisplayName() string { return r.symbol.DisplayName }
+func (r *hierarchySymbolResolver) Kind() *string       { return pointers.NonZeroPtr(r.symbol.Kind) }
+
+func (r *hierarchySymbolResolver) Location(ctx context.Context) (resolverstubs.LocationResolver, error) {
+	if r.symbol.Location == nil {
+		return nil, nil
+	}
+
+	return resolveLocation(ctx, r.locationResolver, *r.symbol.Location)
+}
+
+//
+//
+
+// decodeHierarchyCursor is the inverse of encodeHierarchyCursor. If the given encoded string is
+// empty, then a fresh cursor is returned.
+func decodeHierarchyCursor(rawEncoded string) (codenav.HierarchyCursor, error) {
+	if rawEncoded == "" {
+		return codenav.HierarchyCursor{Phase: "start"}, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(rawEncoded)
+	if err != nil {
+		return codenav.HierarchyCursor{}, err
+	}
+
+	var cursor codenav.HierarchyCursor
+	err = json.Unmarshal(raw, &cursor)
+	return cursor, err
+}
+
+// encodeHierarchyCursor returns an encoding of the given cursor suitable for a URL or a GraphQL
+// token. A nil value is returned once the traversal is done.
+func encodeHierarchyCursor(cursor codenav.HierarchyCursor) *string {
+	if cursor.Phase == "done" {
+		return nil
+	}
+
+	rawEncoded, _ := json.Marshal(cursor)
+	return pointers.Ptr(base64.RawURLEncoding.EncodeToString(rawEncoded))
+}
@@ -120,3 +120,63 @@ type RemoteCursor struct {
 	// The location offset within the associated batch of uploads.
 	LocationOffset int `json:"locationOffset"`
 }
+
+// HierarchyArgs are the arguments of a call or type hierarchy request. Depth is the maximum
+// number of edges between the symbol at the requested position and any returned symbol.
+type HierarchyArgs struct {
+	RequestArgs
+	Depth int
+}
+
+// TypeHierarchyDirection denotes which side of a type hierarchy is traversed.
+type TypeHierarchyDirection string
+
+const (
+	TypeHierarchySupertypes TypeHierarchyDirection = "supertypes"
+	TypeHierarchySubtypes   TypeHierarchyDirection = "subtypes"
+)
+
+// HierarchyItem is a symbol visited while walking a call or type hierarchy. The path and ranges
+// are relative to the root and indexed commit of the upload defining the symbol. The path is empty
+// for symbols which are not defined within any known index.
+type HierarchyItem struct {
+	UploadID       int          `json:"uploadID"`
+	Path           string       `json:"path"`
+	Symbol         string       `json:"symbol"`
+	DisplayName    string       `json:"displayName"`
+	Kind           string       `json:"kind"`
+	Range          shared.Range `json:"range"`
+	EnclosingRange shared.Range `json:"enclosingRange"`
+	Depth          int          `json:"depth"`
+}
+
+// HierarchySymbol is a symbol of a call or type hierarchy along with the location of its
+// definition, adjusted to the target (originally requested) commit where possible.
+type HierarchySymbol struct {
+	Symbol      string
+	DisplayName string
+	Kind        string
+	Location    *shared.UploadLocation
+}
+
+// HierarchyEdge relates two symbols of a call or type hierarchy. In a call hierarchy, From calls
+// To at each of the given ranges. In a type hierarchy, From is a subtype of To and there are no
+// ranges. Depth is the number of edges between the requested symbol and the edge's far end.
+type HierarchyEdge struct {
+	From   HierarchySymbol
+	To     HierarchySymbol
+	Ranges []shared.UploadLocation
+	Depth  int
+}
+
+// HierarchyCursor stores (enough of) the state of a previous hierarchy request to resume the
+// breadth-first traversal of the hierarchy with the next page of edges.
+type HierarchyCursor struct {
+	Phase string `json:"phase"`
+	// The items still to be expanded, in traversal order.
+	Queue []HierarchyItem `json:"queue"`
+	// The keys of all items which have been enqueued so far.
+	Visited []string `json:"visited"`
+	// The edge offset within the expansion of the first queued item.
+	EdgeOffset int `json:"edgeOffset"`
+}
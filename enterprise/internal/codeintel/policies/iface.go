@@ -2,8 +2,12 @@ package policies
 
 import (
 	"context"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
 )
 
 type UploadService interface {
 	GetCommitsVisibleToUpload(ctx context.Context, uploadID, limit int, token *string) (_ []string, nextToken *string, err error)
+	GetUploadStorageSize(ctx context.Context, repositoryID int) (int64, error)
+	GetQuotaEvictionCandidates(ctx context.Context, repositoryID, limit, offset int) ([]shared.QuotaEvictionCandidate, error)
 }
@@ -17,6 +17,9 @@ type operations struct {
 	getRepoIDsByGlobPatterns                    *observation.Operation
 	updateReposMatchingPatterns                 *observation.Operation
 	selectPoliciesForRepositoryMembershipUpdate *observation.Operation
+	getStorageQuota                             *observation.Operation
+	updateStorageQuota                          *observation.Operation
+	deleteStorageQuota                          *observation.Operation
 }
 
 var m = new(metrics.SingletonREDMetrics)
@@ -49,5 +52,8 @@ func newOperations(observationCtx *observation.Context) *operations {
 		getRepoIDsByGlobPatterns:                    op("GetRepoIDsByGlobPatterns"),
 		updateReposMatchingPatterns:                 op("UpdateReposMatchingPatterns"),
 		selectPoliciesForRepositoryMembershipUpdate: op("SelectPoliciesForRepositoryMembershipUpdate"),
+		getStorageQuota:                             op("GetStorageQuota"),
+		updateStorageQuota:                          op("UpdateStorageQuota"),
+		deleteStorageQuota:                          op("DeleteStorageQuota"),
 	}
 }
@@ -0,0 +1,87 @@
+package store
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/policies/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// GetStorageQuota returns the storage quota configured for the given repository. If the given
+// repository identifier is zero, the instance-wide storage quota is returned.
+func (s *store) GetStorageQuota(ctx context.Context, repositoryID int) (_ shared.StorageQuota, _ bool, err error) {
+	ctx, _, endObservation := s.operations.getStorageQuota.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", repositoryID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return scanFirstStorageQuota(s.db.Query(ctx, sqlf.Sprintf(getStorageQuotaQuery, repositoryID)))
+}
+
+const getStorageQuotaQuery = `
+SELECT
+	q.id,
+	q.repository_id,
+	q.max_repository_bytes,
+	q.max_total_bytes
+FROM codeintel_upload_storage_quotas q
+WHERE COALESCE(q.repository_id, 0) = %s
+`
+
+// UpdateStorageQuota creates or replaces the storage quota of the repository (or the instance-wide
+// storage quota if no repository is set) described by the given quota.
+func (s *store) UpdateStorageQuota(ctx context.Context, quota shared.StorageQuota) (err error) {
+	ctx, _, endObservation := s.operations.updateStorageQuota.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	return s.db.Exec(ctx, sqlf.Sprintf(
+		updateStorageQuotaQuery,
+		dbutil.NullInt{N: quota.RepositoryID},
+		dbutil.NullInt64{N: quota.MaxRepositoryBytes},
+		dbutil.NullInt64{N: quota.MaxTotalBytes},
+	))
+}
+
+const updateStorageQuotaQuery = `
+INSERT INTO codeintel_upload_storage_quotas (repository_id, max_repository_bytes, max_total_bytes)
+VALUES (%s, %s, %s)
+ON CONFLICT ((COALESCE(repository_id, 0))) DO UPDATE SET
+	max_repository_bytes = EXCLUDED.max_repository_bytes,
+	max_total_bytes = EXCLUDED.max_total_bytes,
+	updated_at = NOW()
+`
+
+// DeleteStorageQuota removes the storage quota of the given repository, or the instance-wide
+// storage quota if the given repository identifier is zero.
+func (s *store) DeleteStorageQuota(ctx context.Context, repositoryID int) (err error) {
+	ctx, _, endObservation := s.operations.deleteStorageQuota.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", repositoryID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return s.db.Exec(ctx, sqlf.Sprintf(deleteStorageQuotaQuery, repositoryID))
+}
+
+const deleteStorageQuotaQuery = `
+DELETE FROM codeintel_upload_storage_quotas WHERE COALESCE(repository_id, 0) = %s
+`
+
+//
+//
+
+func scanStorageQuota(s dbutil.Scanner) (quota shared.StorageQuota, err error) {
+	err = s.Scan(
+		&quota.ID,
+		&quota.RepositoryID,
+		&quota.MaxRepositoryBytes,
+		&quota.MaxTotalBytes,
+	)
+	return quota, err
+}
+
+var scanFirstStorageQuota = basestore.NewFirstScanner(scanStorageQuota)
@@ -0,0 +1,80 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/policies/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestStorageQuotas(t *testing.T) {
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+	ctx := context.Background()
+
+	insertRepo(t, db, 50, "", false)
+
+	var (
+		repositoryID = 50
+		v1           = int64(100)
+		v2           = int64(200)
+		v3           = int64(300)
+	)
+
+	if _, ok, err := store.GetStorageQuota(ctx, 0); err != nil {
+		t.Fatalf("unexpected error getting storage quota: %s", err)
+	} else if ok {
+		t.Fatalf("unexpected storage quota")
+	}
+
+	for _, quota := range []shared.StorageQuota{
+		{MaxRepositoryBytes: &v1, MaxTotalBytes: &v3},
+		{MaxRepositoryBytes: &v1, MaxTotalBytes: &v2},
+		{RepositoryID: &repositoryID, MaxRepositoryBytes: &v2},
+	} {
+		if err := store.UpdateStorageQuota(ctx, quota); err != nil {
+			t.Fatalf("unexpected error updating storage quota: %s", err)
+		}
+	}
+
+	if err := store.UpdateStorageQuota(ctx, shared.StorageQuota{RepositoryID: &repositoryID, MaxTotalBytes: &v3}); err == nil {
+		t.Fatalf("expected error setting a total storage quota on a repository")
+	}
+
+	for id, expectedQuota := range map[int]shared.StorageQuota{
+		0:            {MaxRepositoryBytes: &v1, MaxTotalBytes: &v2},
+		repositoryID: {RepositoryID: &repositoryID, MaxRepositoryBytes: &v2},
+	} {
+		quota, ok, err := store.GetStorageQuota(ctx, id)
+		if err != nil {
+			t.Fatalf("unexpected error getting storage quota: %s", err)
+		} else if !ok {
+			t.Fatalf("expected storage quota for repository %d", id)
+		}
+		if diff := cmp.Diff(expectedQuota, quota, cmpopts.IgnoreFields(shared.StorageQuota{}, "ID")); diff != "" {
+			t.Errorf("unexpected storage quota (-want +got):\n%s", diff)
+		}
+	}
+
+	if err := store.DeleteStorageQuota(ctx, repositoryID); err != nil {
+		t.Fatalf("unexpected error deleting storage quota: %s", err)
+	}
+	if _, ok, err := store.GetStorageQuota(ctx, repositoryID); err != nil {
+		t.Fatalf("unexpected error getting storage quota: %s", err)
+	} else if ok {
+		t.Fatalf("unexpected storage quota after deletion")
+	}
+	if _, ok, err := store.GetStorageQuota(ctx, 0); err != nil {
+		t.Fatalf("unexpected error getting storage quota: %s", err)
+	} else if !ok {
+		t.Fatalf("expected instance-wide storage quota to remain")
+	}
+}
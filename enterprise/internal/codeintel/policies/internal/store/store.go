@@ -27,6 +27,11 @@ type Store interface {
 	GetRepoIDsByGlobPatterns(ctx context.Context, patterns []string, limit, offset int) ([]int, int, error)
 	UpdateReposMatchingPatterns(ctx context.Context, patterns []string, policyID int, repositoryMatchLimit *int) error
 	SelectPoliciesForRepositoryMembershipUpdate(ctx context.Context, batchSize int) ([]shared.ConfigurationPolicy, error)
+
+	// Storage quotas
+	GetStorageQuota(ctx context.Context, repositoryID int) (shared.StorageQuota, bool, error)
+	UpdateStorageQuota(ctx context.Context, quota shared.StorageQuota) error
+	DeleteStorageQuota(ctx context.Context, repositoryID int) error
 }
 
 type store struct {
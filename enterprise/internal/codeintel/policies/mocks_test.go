@@ -12,6 +12,7 @@ import (
 
 	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/policies/internal/store"
 	shared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/policies/shared"
+	shared1 "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
 )
 
 // MockStore is a mock implementation of the Store interface (from the
@@ -27,6 +28,9 @@ type MockStore struct {
 	// object controlling the behavior of the method
 	// DeleteConfigurationPolicyByID.
 	DeleteConfigurationPolicyByIDFunc *StoreDeleteConfigurationPolicyByIDFunc
+	// DeleteStorageQuotaFunc is an instance of a mock function object
+	// controlling the behavior of the method DeleteStorageQuota.
+	DeleteStorageQuotaFunc *StoreDeleteStorageQuotaFunc
 	// GetConfigurationPoliciesFunc is an instance of a mock function object
 	// controlling the behavior of the method GetConfigurationPolicies.
 	GetConfigurationPoliciesFunc *StoreGetConfigurationPoliciesFunc
@@ -37,6 +41,9 @@ type MockStore struct {
 	// GetRepoIDsByGlobPatternsFunc is an instance of a mock function object
 	// controlling the behavior of the method GetRepoIDsByGlobPatterns.
 	GetRepoIDsByGlobPatternsFunc *StoreGetRepoIDsByGlobPatternsFunc
+	// GetStorageQuotaFunc is an instance of a mock function object
+	// controlling the behavior of the method GetStorageQuota.
+	GetStorageQuotaFunc *StoreGetStorageQuotaFunc
 	// RepoCountFunc is an instance of a mock function object controlling
 	// the behavior of the method RepoCount.
 	RepoCountFunc *StoreRepoCountFunc
@@ -52,6 +59,9 @@ type MockStore struct {
 	// object controlling the behavior of the method
 	// UpdateReposMatchingPatterns.
 	UpdateReposMatchingPatternsFunc *StoreUpdateReposMatchingPatternsFunc
+	// UpdateStorageQuotaFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdateStorageQuota.
+	UpdateStorageQuotaFunc *StoreUpdateStorageQuotaFunc
 }
 
 // NewMockStore creates a new mock of the Store interface. All methods
@@ -68,6 +78,11 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		DeleteStorageQuotaFunc: &StoreDeleteStorageQuotaFunc{
+			defaultHook: func(context.Context, int) (r0 error) {
+				return
+			},
+		},
 		GetConfigurationPoliciesFunc: &StoreGetConfigurationPoliciesFunc{
 			defaultHook: func(context.Context, shared.GetConfigurationPoliciesOptions) (r0 []shared.ConfigurationPolicy, r1 int, r2 error) {
 				return
@@ -83,6 +98,11 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		GetStorageQuotaFunc: &StoreGetStorageQuotaFunc{
+			defaultHook: func(context.Context, int) (r0 shared.StorageQuota, r1 bool, r2 error) {
+				return
+			},
+		},
 		RepoCountFunc: &StoreRepoCountFunc{
 			defaultHook: func(context.Context) (r0 int, r1 error) {
 				return
@@ -103,6 +123,11 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		UpdateStorageQuotaFunc: &StoreUpdateStorageQuotaFunc{
+			defaultHook: func(context.Context, shared.StorageQuota) (r0 error) {
+				return
+			},
+		},
 	}
 }
 
@@ -120,6 +145,11 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.DeleteConfigurationPolicyByID")
 			},
 		},
+		DeleteStorageQuotaFunc: &StoreDeleteStorageQuotaFunc{
+			defaultHook: func(context.Context, int) error {
+				panic("unexpected invocation of MockStore.DeleteStorageQuota")
+			},
+		},
 		GetConfigurationPoliciesFunc: &StoreGetConfigurationPoliciesFunc{
 			defaultHook: func(context.Context, shared.GetConfigurationPoliciesOptions) ([]shared.ConfigurationPolicy, int, error) {
 				panic("unexpected invocation of MockStore.GetConfigurationPolicies")
@@ -135,6 +165,11 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.GetRepoIDsByGlobPatterns")
 			},
 		},
+		GetStorageQuotaFunc: &StoreGetStorageQuotaFunc{
+			defaultHook: func(context.Context, int) (shared.StorageQuota, bool, error) {
+				panic("unexpected invocation of MockStore.GetStorageQuota")
+			},
+		},
 		RepoCountFunc: &StoreRepoCountFunc{
 			defaultHook: func(context.Context) (int, error) {
 				panic("unexpected invocation of MockStore.RepoCount")
@@ -155,6 +190,11 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.UpdateReposMatchingPatterns")
 			},
 		},
+		UpdateStorageQuotaFunc: &StoreUpdateStorageQuotaFunc{
+			defaultHook: func(context.Context, shared.StorageQuota) error {
+				panic("unexpected invocation of MockStore.UpdateStorageQuota")
+			},
+		},
 	}
 }
 
@@ -168,6 +208,9 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 		DeleteConfigurationPolicyByIDFunc: &StoreDeleteConfigurationPolicyByIDFunc{
 			defaultHook: i.DeleteConfigurationPolicyByID,
 		},
+		DeleteStorageQuotaFunc: &StoreDeleteStorageQuotaFunc{
+			defaultHook: i.DeleteStorageQuota,
+		},
 		GetConfigurationPoliciesFunc: &StoreGetConfigurationPoliciesFunc{
 			defaultHook: i.GetConfigurationPolicies,
 		},
@@ -177,6 +220,9 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 		GetRepoIDsByGlobPatternsFunc: &StoreGetRepoIDsByGlobPatternsFunc{
 			defaultHook: i.GetRepoIDsByGlobPatterns,
 		},
+		GetStorageQuotaFunc: &StoreGetStorageQuotaFunc{
+			defaultHook: i.GetStorageQuota,
+		},
 		RepoCountFunc: &StoreRepoCountFunc{
 			defaultHook: i.RepoCount,
 		},
@@ -189,6 +235,9 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 		UpdateReposMatchingPatternsFunc: &StoreUpdateReposMatchingPatternsFunc{
 			defaultHook: i.UpdateReposMatchingPatterns,
 		},
+		UpdateStorageQuotaFunc: &StoreUpdateStorageQuotaFunc{
+			defaultHook: i.UpdateStorageQuota,
+		},
 	}
 }
 
@@ -410,6 +459,111 @@ func (c StoreDeleteConfigurationPolicyByIDFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// StoreDeleteStorageQuotaFunc describes the behavior when the
+// DeleteStorageQuota method of the parent MockStore instance is invoked.
+type StoreDeleteStorageQuotaFunc struct {
+	defaultHook func(context.Context, int) error
+	hooks       []func(context.Context, int) error
+	history     []StoreDeleteStorageQuotaFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteStorageQuota delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockStore) DeleteStorageQuota(v0 context.Context, v1 int) error {
+	r0 := m.DeleteStorageQuotaFunc.nextHook()(v0, v1)
+	m.DeleteStorageQuotaFunc.appendCall(StoreDeleteStorageQuotaFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the DeleteStorageQuota
+// method of the parent MockStore instance is invoked and the hook queue is
+// empty.
+func (f *StoreDeleteStorageQuotaFunc) SetDefaultHook(hook func(context.Context, int) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteStorageQuota method of the parent MockStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *StoreDeleteStorageQuotaFunc) PushHook(hook func(context.Context, int) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreDeleteStorageQuotaFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreDeleteStorageQuotaFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int) error {
+		return r0
+	})
+}
+
+func (f *StoreDeleteStorageQuotaFunc) nextHook() func(context.Context, int) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreDeleteStorageQuotaFunc) appendCall(r0 StoreDeleteStorageQuotaFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreDeleteStorageQuotaFuncCall objects
+// describing the invocations of this function.
+func (f *StoreDeleteStorageQuotaFunc) History() []StoreDeleteStorageQuotaFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreDeleteStorageQuotaFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreDeleteStorageQuotaFuncCall is an object that describes an invocation
+// of method DeleteStorageQuota on an instance of MockStore.
+type StoreDeleteStorageQuotaFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreDeleteStorageQuotaFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreDeleteStorageQuotaFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // StoreGetConfigurationPoliciesFunc describes the behavior when the
 // GetConfigurationPolicies method of the parent MockStore instance is
 // invoked.
@@ -756,6 +910,117 @@ func (c StoreGetRepoIDsByGlobPatternsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
+// StoreGetStorageQuotaFunc describes the behavior when the GetStorageQuota
+// method of the parent MockStore instance is invoked.
+type StoreGetStorageQuotaFunc struct {
+	defaultHook func(context.Context, int) (shared.StorageQuota, bool, error)
+	hooks       []func(context.Context, int) (shared.StorageQuota, bool, error)
+	history     []StoreGetStorageQuotaFuncCall
+	mutex       sync.Mutex
+}
+
+// GetStorageQuota delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockStore) GetStorageQuota(v0 context.Context, v1 int) (shared.StorageQuota, bool, error) {
+	r0, r1, r2 := m.GetStorageQuotaFunc.nextHook()(v0, v1)
+	m.GetStorageQuotaFunc.appendCall(StoreGetStorageQuotaFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the GetStorageQuota
+// method of the parent MockStore instance is invoked and the hook queue is
+// empty.
+func (f *StoreGetStorageQuotaFunc) SetDefaultHook(hook func(context.Context, int) (shared.StorageQuota, bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetStorageQuota method of the parent MockStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *StoreGetStorageQuotaFunc) PushHook(hook func(context.Context, int) (shared.StorageQuota, bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreGetStorageQuotaFunc) SetDefaultReturn(r0 shared.StorageQuota, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, int) (shared.StorageQuota, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreGetStorageQuotaFunc) PushReturn(r0 shared.StorageQuota, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, int) (shared.StorageQuota, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *StoreGetStorageQuotaFunc) nextHook() func(context.Context, int) (shared.StorageQuota, bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreGetStorageQuotaFunc) appendCall(r0 StoreGetStorageQuotaFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreGetStorageQuotaFuncCall objects
+// describing the invocations of this function.
+func (f *StoreGetStorageQuotaFunc) History() []StoreGetStorageQuotaFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreGetStorageQuotaFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreGetStorageQuotaFuncCall is an object that describes an invocation of
+// method GetStorageQuota on an instance of MockStore.
+type StoreGetStorageQuotaFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 shared.StorageQuota
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreGetStorageQuotaFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreGetStorageQuotaFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
 // StoreRepoCountFunc describes the behavior when the RepoCount method of
 // the parent MockStore instance is invoked.
 type StoreRepoCountFunc struct {
@@ -1194,6 +1459,111 @@ func (c StoreUpdateReposMatchingPatternsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// StoreUpdateStorageQuotaFunc describes the behavior when the
+// UpdateStorageQuota method of the parent MockStore instance is invoked.
+type StoreUpdateStorageQuotaFunc struct {
+	defaultHook func(context.Context, shared.StorageQuota) error
+	hooks       []func(context.Context, shared.StorageQuota) error
+	history     []StoreUpdateStorageQuotaFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateStorageQuota delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockStore) UpdateStorageQuota(v0 context.Context, v1 shared.StorageQuota) error {
+	r0 := m.UpdateStorageQuotaFunc.nextHook()(v0, v1)
+	m.UpdateStorageQuotaFunc.appendCall(StoreUpdateStorageQuotaFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the UpdateStorageQuota
+// method of the parent MockStore instance is invoked and the hook queue is
+// empty.
+func (f *StoreUpdateStorageQuotaFunc) SetDefaultHook(hook func(context.Context, shared.StorageQuota) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateStorageQuota method of the parent MockStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *StoreUpdateStorageQuotaFunc) PushHook(hook func(context.Context, shared.StorageQuota) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreUpdateStorageQuotaFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, shared.StorageQuota) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreUpdateStorageQuotaFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, shared.StorageQuota) error {
+		return r0
+	})
+}
+
+func (f *StoreUpdateStorageQuotaFunc) nextHook() func(context.Context, shared.StorageQuota) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreUpdateStorageQuotaFunc) appendCall(r0 StoreUpdateStorageQuotaFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreUpdateStorageQuotaFuncCall objects
+// describing the invocations of this function.
+func (f *StoreUpdateStorageQuotaFunc) History() []StoreUpdateStorageQuotaFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreUpdateStorageQuotaFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreUpdateStorageQuotaFuncCall is an object that describes an invocation
+// of method UpdateStorageQuota on an instance of MockStore.
+type StoreUpdateStorageQuotaFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 shared.StorageQuota
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreUpdateStorageQuotaFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreUpdateStorageQuotaFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // MockUploadService is a mock implementation of the UploadService interface
 // (from the package
 // github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/policies)
@@ -1203,6 +1573,13 @@ type MockUploadService struct {
 	// object controlling the behavior of the method
 	// GetCommitsVisibleToUpload.
 	GetCommitsVisibleToUploadFunc *UploadServiceGetCommitsVisibleToUploadFunc
+	// GetQuotaEvictionCandidatesFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// GetQuotaEvictionCandidates.
+	GetQuotaEvictionCandidatesFunc *UploadServiceGetQuotaEvictionCandidatesFunc
+	// GetUploadStorageSizeFunc is an instance of a mock function object
+	// controlling the behavior of the method GetUploadStorageSize.
+	GetUploadStorageSizeFunc *UploadServiceGetUploadStorageSizeFunc
 }
 
 // NewMockUploadService creates a new mock of the UploadService interface.
@@ -1214,6 +1591,16 @@ func NewMockUploadService() *MockUploadService {
 				return
 			},
 		},
+		GetQuotaEvictionCandidatesFunc: &UploadServiceGetQuotaEvictionCandidatesFunc{
+			defaultHook: func(context.Context, int, int, int) (r0 []shared1.QuotaEvictionCandidate, r1 error) {
+				return
+			},
+		},
+		GetUploadStorageSizeFunc: &UploadServiceGetUploadStorageSizeFunc{
+			defaultHook: func(context.Context, int) (r0 int64, r1 error) {
+				return
+			},
+		},
 	}
 }
 
@@ -1226,6 +1613,16 @@ func NewStrictMockUploadService() *MockUploadService {
 				panic("unexpected invocation of MockUploadService.GetCommitsVisibleToUpload")
 			},
 		},
+		GetQuotaEvictionCandidatesFunc: &UploadServiceGetQuotaEvictionCandidatesFunc{
+			defaultHook: func(context.Context, int, int, int) ([]shared1.QuotaEvictionCandidate, error) {
+				panic("unexpected invocation of MockUploadService.GetQuotaEvictionCandidates")
+			},
+		},
+		GetUploadStorageSizeFunc: &UploadServiceGetUploadStorageSizeFunc{
+			defaultHook: func(context.Context, int) (int64, error) {
+				panic("unexpected invocation of MockUploadService.GetUploadStorageSize")
+			},
+		},
 	}
 }
 
@@ -1237,6 +1634,12 @@ func NewMockUploadServiceFrom(i UploadService) *MockUploadService {
 		GetCommitsVisibleToUploadFunc: &UploadServiceGetCommitsVisibleToUploadFunc{
 			defaultHook: i.GetCommitsVisibleToUpload,
 		},
+		GetQuotaEvictionCandidatesFunc: &UploadServiceGetQuotaEvictionCandidatesFunc{
+			defaultHook: i.GetQuotaEvictionCandidates,
+		},
+		GetUploadStorageSizeFunc: &UploadServiceGetUploadStorageSizeFunc{
+			defaultHook: i.GetUploadStorageSize,
+		},
 	}
 }
 
@@ -1360,3 +1763,232 @@ func (c UploadServiceGetCommitsVisibleToUploadFuncCall) Args() []interface{} {
 func (c UploadServiceGetCommitsVisibleToUploadFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
+
+// UploadServiceGetQuotaEvictionCandidatesFunc describes the behavior when
+// the GetQuotaEvictionCandidates method of the parent MockUploadService
+// instance is invoked.
+type UploadServiceGetQuotaEvictionCandidatesFunc struct {
+	defaultHook func(context.Context, int, int, int) ([]shared1.QuotaEvictionCandidate, error)
+	hooks       []func(context.Context, int, int, int) ([]shared1.QuotaEvictionCandidate, error)
+	history     []UploadServiceGetQuotaEvictionCandidatesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetQuotaEvictionCandidates delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockUploadService) GetQuotaEvictionCandidates(v0 context.Context, v1 int, v2 int, v3 int) ([]shared1.QuotaEvictionCandidate, error) {
+	r0, r1 := m.GetQuotaEvictionCandidatesFunc.nextHook()(v0, v1, v2, v3)
+	m.GetQuotaEvictionCandidatesFunc.appendCall(UploadServiceGetQuotaEvictionCandidatesFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetQuotaEvictionCandidates method of the parent MockUploadService
+// instance is invoked and the hook queue is empty.
+func (f *UploadServiceGetQuotaEvictionCandidatesFunc) SetDefaultHook(hook func(context.Context, int, int, int) ([]shared1.QuotaEvictionCandidate, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetQuotaEvictionCandidates method of the parent MockUploadService
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *UploadServiceGetQuotaEvictionCandidatesFunc) PushHook(hook func(context.Context, int, int, int) ([]shared1.QuotaEvictionCandidate, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *UploadServiceGetQuotaEvictionCandidatesFunc) SetDefaultReturn(r0 []shared1.QuotaEvictionCandidate, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, int, int) ([]shared1.QuotaEvictionCandidate, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *UploadServiceGetQuotaEvictionCandidatesFunc) PushReturn(r0 []shared1.QuotaEvictionCandidate, r1 error) {
+	f.PushHook(func(context.Context, int, int, int) ([]shared1.QuotaEvictionCandidate, error) {
+		return r0, r1
+	})
+}
+
+func (f *UploadServiceGetQuotaEvictionCandidatesFunc) nextHook() func(context.Context, int, int, int) ([]shared1.QuotaEvictionCandidate, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *UploadServiceGetQuotaEvictionCandidatesFunc) appendCall(r0 UploadServiceGetQuotaEvictionCandidatesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// UploadServiceGetQuotaEvictionCandidatesFuncCall objects describing the
+// invocations of this function.
+func (f *UploadServiceGetQuotaEvictionCandidatesFunc) History() []UploadServiceGetQuotaEvictionCandidatesFuncCall {
+	f.mutex.Lock()
+	history := make([]UploadServiceGetQuotaEvictionCandidatesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// UploadServiceGetQuotaEvictionCandidatesFuncCall is an object that
+// describes an invocation of method GetQuotaEvictionCandidates on an
+// instance of MockUploadService.
+type UploadServiceGetQuotaEvictionCandidatesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []shared1.QuotaEvictionCandidate
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c UploadServiceGetQuotaEvictionCandidatesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c UploadServiceGetQuotaEvictionCandidatesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// UploadServiceGetUploadStorageSizeFunc describes the behavior when the
+// GetUploadStorageSize method of the parent MockUploadService instance is
+// invoked.
+type UploadServiceGetUploadStorageSizeFunc struct {
+	defaultHook func(context.Context, int) (int64, error)
+	hooks       []func(context.Context, int) (int64, error)
+	history     []UploadServiceGetUploadStorageSizeFuncCall
+	mutex       sync.Mutex
+}
+
+// GetUploadStorageSize delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockUploadService) GetUploadStorageSize(v0 context.Context, v1 int) (int64, error) {
+	r0, r1 := m.GetUploadStorageSizeFunc.nextHook()(v0, v1)
+	m.GetUploadStorageSizeFunc.appendCall(UploadServiceGetUploadStorageSizeFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetUploadStorageSize
+// method of the parent MockUploadService instance is invoked and the hook
+// queue is empty.
+func (f *UploadServiceGetUploadStorageSizeFunc) SetDefaultHook(hook func(context.Context, int) (int64, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetUploadStorageSize method of the parent MockUploadService instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *UploadServiceGetUploadStorageSizeFunc) PushHook(hook func(context.Context, int) (int64, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *UploadServiceGetUploadStorageSizeFunc) SetDefaultReturn(r0 int64, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) (int64, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *UploadServiceGetUploadStorageSizeFunc) PushReturn(r0 int64, r1 error) {
+	f.PushHook(func(context.Context, int) (int64, error) {
+		return r0, r1
+	})
+}
+
+func (f *UploadServiceGetUploadStorageSizeFunc) nextHook() func(context.Context, int) (int64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *UploadServiceGetUploadStorageSizeFunc) appendCall(r0 UploadServiceGetUploadStorageSizeFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of UploadServiceGetUploadStorageSizeFuncCall
+// objects describing the invocations of this function.
+func (f *UploadServiceGetUploadStorageSizeFunc) History() []UploadServiceGetUploadStorageSizeFuncCall {
+	f.mutex.Lock()
+	history := make([]UploadServiceGetUploadStorageSizeFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// UploadServiceGetUploadStorageSizeFuncCall is an object that describes an
+// invocation of method GetUploadStorageSize on an instance of
+// MockUploadService.
+type UploadServiceGetUploadStorageSizeFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int64
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c UploadServiceGetUploadStorageSizeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c UploadServiceGetUploadStorageSizeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
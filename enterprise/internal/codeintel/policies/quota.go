@@ -0,0 +1,36 @@
+package policies
+
+import (
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/policies/shared"
+)
+
+// ProtectedPolicyIDs returns the set of identifiers of the given policies that are marked as protected.
+func ProtectedPolicyIDs(policies []shared.ConfigurationPolicy) map[int]struct{} {
+	ids := map[int]struct{}{}
+	for _, policy := range policies {
+		if policy.Protected {
+			ids[policy.ID] = struct{}{}
+		}
+	}
+
+	return ids
+}
+
+// ProtectsFromQuotaEviction returns true if the given policy match prevents an upload with the given
+// upload time from being evicted to satisfy a storage quota. Storage quotas take precedence over the
+// retention durations of ordinary policies, but never over protected policies nor over the implicit
+// retention of the tip of the default branch.
+func ProtectsFromQuotaEviction(policyMatch PolicyMatch, protectedPolicyIDs map[int]struct{}, uploadedAt, now time.Time) bool {
+	if policyMatch.PolicyDuration != nil && now.Sub(uploadedAt) >= *policyMatch.PolicyDuration {
+		return false
+	}
+
+	if policyMatch.PolicyID == nil {
+		return true
+	}
+
+	_, ok := protectedPolicyIDs[*policyMatch.PolicyID]
+	return ok
+}
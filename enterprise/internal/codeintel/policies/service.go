@@ -123,7 +123,7 @@ func (s *Service) GetRetentionPolicyOverview(ctx context.Context, upload shared.
 		return nil, 0, err
 	}
 
-	visibleCommits, err := s.getCommitsVisibleToUpload(ctx, upload)
+	visibleCommits, err := s.getCommitsVisibleToUpload(ctx, upload.ID)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -280,10 +280,173 @@ func (s *Service) GetPreviewGitObjectFilter(
 	return gitObjects, totalCount, totalCountYoungerThanThreshold, nil
 }
 
-func (s *Service) getCommitsVisibleToUpload(ctx context.Context, upload shared.Upload) (commits []string, err error) {
+func (s *Service) GetStorageQuota(ctx context.Context, repositoryID int) (policiesshared.StorageQuota, bool, error) {
+	return s.store.GetStorageQuota(ctx, repositoryID)
+}
+
+func (s *Service) UpdateStorageQuota(ctx context.Context, quota policiesshared.StorageQuota) error {
+	if quota.RepositoryID != nil && quota.MaxTotalBytes != nil {
+		return errors.New("a total storage quota can only be set on the instance-wide quota")
+	}
+	for _, value := range []*int64{quota.MaxRepositoryBytes, quota.MaxTotalBytes} {
+		if value != nil && *value < 0 {
+			return errors.New("storage quotas must not be negative")
+		}
+	}
+
+	return s.store.UpdateStorageQuota(ctx, quota)
+}
+
+func (s *Service) DeleteStorageQuota(ctx context.Context, repositoryID int) error {
+	return s.store.DeleteStorageQuota(ctx, repositoryID)
+}
+
+// GetRepositoryStorageQuota returns the maximum number of bytes of uploads retained for the given
+// repository. The quota of the repository takes precedence over the instance-wide default. A nil
+// value indicates that the repository has no storage quota.
+func (s *Service) GetRepositoryStorageQuota(ctx context.Context, repositoryID int) (*int64, error) {
+	quota, ok, err := s.store.GetStorageQuota(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	if ok && quota.MaxRepositoryBytes != nil {
+		return quota.MaxRepositoryBytes, nil
+	}
+
+	globalQuota, ok, err := s.store.GetStorageQuota(ctx, 0)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return globalQuota.MaxRepositoryBytes, nil
+}
+
+// GetGlobalStorageQuota returns the maximum number of bytes of uploads retained across all repositories.
+// A nil value indicates that there is no instance-wide storage quota.
+func (s *Service) GetGlobalStorageQuota(ctx context.Context) (*int64, error) {
+	quota, ok, err := s.store.GetStorageQuota(ctx, 0)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return quota.MaxTotalBytes, nil
+}
+
+// GetStorageQuotaOverview returns the storage used by the uploads of the given repository along with the
+// quotas that apply to it. If the repository exceeds its storage quota, the overview includes the uploads
+// that the upload expirer would evict to bring the repository back under quota, in eviction order. Uploads
+// protected by a protected policy or visible from the tip of the default branch are never evicted.
+func (s *Service) GetStorageQuotaOverview(ctx context.Context, repositoryID int, now time.Time) (overview policiesshared.StorageQuotaOverview, err error) {
+	ctx, _, endObservation := s.operations.getStorageQuotaOverview.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	overview.RepositoryID = repositoryID
+
+	if overview.MaxRepositoryBytes, err = s.GetRepositoryStorageQuota(ctx, repositoryID); err != nil {
+		return overview, err
+	}
+	if overview.MaxTotalBytes, err = s.GetGlobalStorageQuota(ctx); err != nil {
+		return overview, err
+	}
+	if overview.RepositoryBytes, err = s.uploadSvc.GetUploadStorageSize(ctx, repositoryID); err != nil {
+		return overview, errors.Wrap(err, "uploadSvc.GetUploadStorageSize")
+	}
+	if overview.TotalBytes, err = s.uploadSvc.GetUploadStorageSize(ctx, 0); err != nil {
+		return overview, errors.Wrap(err, "uploadSvc.GetUploadStorageSize")
+	}
+
+	if overview.MaxRepositoryBytes == nil || overview.RepositoryBytes <= *overview.MaxRepositoryBytes {
+		return overview, nil
+	}
+
+	var (
+		t              = true
+		policyMatcher  = s.getPolicyMatcherFromFactory(RetentionExtractor, true, false)
+		configPolicies []policiesshared.ConfigurationPolicy
+	)
+
+	for {
+		policyBatch, totalCount, err := s.GetConfigurationPolicies(ctx, policiesshared.GetConfigurationPoliciesOptions{
+			RepositoryID:     repositoryID,
+			ForDataRetention: &t,
+			Limit:            storageQuotaOverviewPageSize,
+			Offset:           len(configPolicies),
+		})
+		if err != nil {
+			return overview, err
+		}
+
+		configPolicies = append(configPolicies, policyBatch...)
+
+		if len(policyBatch) == 0 || len(configPolicies) >= totalCount {
+			break
+		}
+	}
+
+	repo, err := s.repoStore.Get(ctx, api.RepoID(repositoryID))
+	if err != nil {
+		return overview, err
+	}
+
+	commitMap, err := policyMatcher.CommitsDescribedByPolicy(ctx, repositoryID, repo.Name, configPolicies, now)
+	if err != nil {
+		return overview, err
+	}
+	protectedPolicyIDs := ProtectedPolicyIDs(configPolicies)
+
+	excess := overview.RepositoryBytes - *overview.MaxRepositoryBytes
+
+	for offset := 0; excess > 0; {
+		candidates, err := s.uploadSvc.GetQuotaEvictionCandidates(ctx, repositoryID, storageQuotaOverviewPageSize, offset)
+		if err != nil {
+			return overview, errors.Wrap(err, "uploadSvc.GetQuotaEvictionCandidates")
+		}
+		if len(candidates) == 0 {
+			break
+		}
+		offset += len(candidates)
+
+		for _, candidate := range candidates {
+			if excess <= 0 {
+				break
+			}
+
+			visibleCommits, err := s.getCommitsVisibleToUpload(ctx, candidate.UploadID)
+			if err != nil {
+				return overview, err
+			}
+			if isProtectedFromQuotaEviction(commitMap, protectedPolicyIDs, visibleCommits, candidate.UploadedAt, now) {
+				continue
+			}
+
+			overview.EvictedUploads = append(overview.EvictedUploads, candidate)
+			excess -= candidate.Size
+		}
+	}
+
+	return overview, nil
+}
+
+// storageQuotaOverviewPageSize is the number of policies and uploads fetched at a time when building
+// a storage quota overview.
+const storageQuotaOverviewPageSize = 100
+
+func isProtectedFromQuotaEviction(commitMap map[string][]PolicyMatch, protectedPolicyIDs map[int]struct{}, visibleCommits []string, uploadedAt, now time.Time) bool {
+	for _, commit := range visibleCommits {
+		for _, policyMatch := range commitMap[commit] {
+			if ProtectsFromQuotaEviction(policyMatch, protectedPolicyIDs, uploadedAt, now) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (s *Service) getCommitsVisibleToUpload(ctx context.Context, uploadID int) (commits []string, err error) {
 	var token *string
 	for first := true; first || token != nil; first = false {
-		cs, nextToken, err := s.uploadSvc.GetCommitsVisibleToUpload(ctx, upload.ID, 50, token)
+		cs, nextToken, err := s.uploadSvc.GetCommitsVisibleToUpload(ctx, uploadID, 50, token)
 		if err != nil {
 			return nil, errors.Wrap(err, "uploadSvc.GetCommitsVisibleToUpload")
 		}
@@ -330,6 +330,72 @@ func TestRetentionPolicyOverview_ByVisibility(t *testing.T) {
 	}
 }
 
+func TestGetStorageQuotaOverview(t *testing.T) {
+	mockStore := NewMockStore()
+	mockRepoStore := defaultMockRepoStore()
+	mockUploadSvc := NewMockUploadService()
+	mockGitserverClient := gitserver.NewMockClient()
+
+	svc := newService(&observation.TestContext, mockStore, mockRepoStore, mockUploadSvc, mockGitserverClient)
+
+	now := time.Unix(1587396557, 0).UTC()
+
+	mockStore.GetStorageQuotaFunc.SetDefaultHook(func(ctx context.Context, repositoryID int) (policiesshared.StorageQuota, bool, error) {
+		if repositoryID != 0 {
+			return policiesshared.StorageQuota{}, false, nil
+		}
+
+		return policiesshared.StorageQuota{MaxRepositoryBytes: pointers.Ptr(int64(100)), MaxTotalBytes: pointers.Ptr(int64(1000))}, true, nil
+	})
+	mockUploadSvc.GetUploadStorageSizeFunc.SetDefaultHook(func(ctx context.Context, repositoryID int) (int64, error) {
+		if repositoryID == 0 {
+			return 900, nil
+		}
+
+		return 250, nil
+	})
+
+	mockStore.GetConfigurationPoliciesFunc.PushReturn([]policiesshared.ConfigurationPolicy{
+		{ID: 1, Type: policiesshared.GitObjectTypeTag, Pattern: "v*", Protected: true, RetentionEnabled: true},
+		{ID: 2, Type: policiesshared.GitObjectTypeTree, Pattern: "*", RetentionEnabled: true, RetentionDuration: pointers.Ptr(time.Hour * 24 * 365)},
+	}, 2, nil)
+	mockGitserverClient.RefDescriptionsFunc.PushReturn(map[string][]gitdomain.RefDescription{
+		"c-tag":     {{Name: "v1.0.0", Type: gitdomain.RefTypeTag}},
+		"c-feature": {{Name: "feature", Type: gitdomain.RefTypeBranch}},
+		"c-main":    {{Name: "main", Type: gitdomain.RefTypeBranch, IsDefaultBranch: true}},
+	}, nil)
+
+	candidates := []shared.QuotaEvictionCandidate{
+		{UploadID: 1, RepositoryID: 42, Commit: "c-tag", Size: 60, UploadedAt: now.Add(-time.Hour)},     // protected policy
+		{UploadID: 2, RepositoryID: 42, Commit: "c-feature", Size: 50, UploadedAt: now.Add(-time.Hour)}, // unprotected policy
+		{UploadID: 3, RepositoryID: 42, Commit: "c-old", Size: 40, UploadedAt: now.Add(-time.Hour)},     // no policy
+		{UploadID: 4, RepositoryID: 42, Commit: "c-main", Size: 100, UploadedAt: now.Add(-time.Hour)},   // tip of default branch
+		{UploadID: 5, RepositoryID: 42, Commit: "c-other", Size: 80, UploadedAt: now.Add(-time.Hour)},   // no policy
+		{UploadID: 6, RepositoryID: 42, Commit: "c-newer", Size: 10, UploadedAt: now},                   // not needed
+	}
+	mockUploadSvc.GetQuotaEvictionCandidatesFunc.PushReturn(candidates, nil)
+	mockUploadSvc.GetCommitsVisibleToUploadFunc.SetDefaultHook(func(ctx context.Context, uploadID, limit int, token *string) ([]string, *string, error) {
+		return []string{candidates[uploadID-1].Commit}, nil, nil
+	})
+
+	overview, err := svc.GetStorageQuotaOverview(context.Background(), 42, now)
+	if err != nil {
+		t.Fatalf("unexpected error resolving storage quota overview: %s", err)
+	}
+
+	expectedOverview := policiesshared.StorageQuotaOverview{
+		RepositoryID:       42,
+		RepositoryBytes:    250,
+		MaxRepositoryBytes: pointers.Ptr(int64(100)),
+		TotalBytes:         900,
+		MaxTotalBytes:      pointers.Ptr(int64(1000)),
+		EvictedUploads:     []shared.QuotaEvictionCandidate{candidates[1], candidates[2], candidates[4]},
+	}
+	if diff := cmp.Diff(expectedOverview, overview); diff != "" {
+		t.Errorf("unexpected storage quota overview (-want +got):\n%s", diff)
+	}
+}
+
 func mockConfigurationPolicies(policies []policiesshared.RetentionPolicyMatchCandidate) (mockedCandidates []policiesshared.RetentionPolicyMatchCandidate, mockedPolicies []policiesshared.ConfigurationPolicy) {
 	for i, policy := range policies {
 		if policy.ConfigurationPolicy != nil {
@@ -1,6 +1,10 @@
 package shared
 
-import "time"
+import (
+	"time"
+
+	uploadsshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
+)
 
 type ConfigurationPolicy struct {
 	ID                        int
@@ -63,3 +67,30 @@ type GetConfigurationPoliciesOptions struct {
 	// Offset indicates the number of results to skip in the result set.
 	Offset int
 }
+
+// StorageQuota bounds the amount of precise code intelligence data retained for a
+// repository, or for the entire instance when RepositoryID is nil.
+type StorageQuota struct {
+	ID           int
+	RepositoryID *int
+
+	// MaxRepositoryBytes is the maximum size of the uploads retained for a single repository.
+	// On the instance-wide quota, this is the default for repositories without a quota.
+	MaxRepositoryBytes *int64
+
+	// MaxTotalBytes is the maximum size of the uploads retained across all repositories. This
+	// value is only set on the instance-wide quota.
+	MaxTotalBytes *int64
+}
+
+// StorageQuotaOverview describes the storage used by the uploads of a repository relative to
+// the quotas that apply to it, along with the uploads that would be evicted on the next pass
+// of the upload expirer.
+type StorageQuotaOverview struct {
+	RepositoryID       int
+	RepositoryBytes    int64
+	MaxRepositoryBytes *int64
+	TotalBytes         int64
+	MaxTotalBytes      *int64
+	EvictedUploads     []uploadsshared.QuotaEvictionCandidate
+}
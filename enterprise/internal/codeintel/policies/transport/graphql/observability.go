@@ -15,6 +15,10 @@ type operations struct {
 	previewGitObjectFilter    *observation.Operation
 	previewRepoFilter         *observation.Operation
 	updateConfigurationPolicy *observation.Operation
+	globalStorageQuota        *observation.Operation
+	updateStorageQuota        *observation.Operation
+	deleteStorageQuota        *observation.Operation
+	storageQuotaOverview      *observation.Operation
 }
 
 func newOperations(observationCtx *observation.Context) *operations {
@@ -41,5 +45,9 @@ func newOperations(observationCtx *observation.Context) *operations {
 		previewGitObjectFilter:    op("PreviewGitObjectFilter"),
 		previewRepoFilter:         op("PreviewRepoFilter"),
 		updateConfigurationPolicy: op("UpdateConfigurationPolicy"),
+		globalStorageQuota:        op("GlobalStorageQuota"),
+		updateStorageQuota:        op("UpdateStorageQuota"),
+		deleteStorageQuota:        op("DeleteStorageQuota"),
+		storageQuotaOverview:      op("StorageQuotaOverview"),
 	}
 }
@@ -0,0 +1,212 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/policies/shared"
+	uploadsshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
+	resolverstubs "github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+// 🚨 SECURITY: Only site admins may view storage quotas
+func (r *rootResolver) CodeIntelligenceGlobalStorageQuota(ctx context.Context) (_ resolverstubs.CodeIntelligenceStorageQuotaResolver, err error) {
+	ctx, _, endObservation := r.operations.globalStorageQuota.With(ctx, &err, observation.Args{})
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	quota, _, err := r.policySvc.GetStorageQuota(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storageQuotaResolver{quota: quota}, nil
+}
+
+// 🚨 SECURITY: Only site admins may modify storage quotas
+func (r *rootResolver) UpdateCodeIntelligenceStorageQuota(ctx context.Context, args *resolverstubs.UpdateCodeIntelligenceStorageQuotaArgs) (_ *resolverstubs.EmptyResponse, err error) {
+	ctx, _, endObservation := r.operations.updateStorageQuota.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("repository", string(pointers.Deref(args.Repository, ""))),
+	}})
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	repositoryID, err := unmarshalOptionalRepositoryID(args.Repository)
+	if err != nil {
+		return nil, err
+	}
+
+	quota := shared.StorageQuota{
+		MaxRepositoryBytes: toBytes(args.MaxRepositoryBytes),
+		MaxTotalBytes:      toBytes(args.MaxTotalBytes),
+	}
+	if repositoryID != 0 {
+		quota.RepositoryID = &repositoryID
+	}
+
+	if err := r.policySvc.UpdateStorageQuota(ctx, quota); err != nil {
+		return nil, err
+	}
+
+	return resolverstubs.Empty, nil
+}
+
+// 🚨 SECURITY: Only site admins may modify storage quotas
+func (r *rootResolver) DeleteCodeIntelligenceStorageQuota(ctx context.Context, args *resolverstubs.DeleteCodeIntelligenceStorageQuotaArgs) (_ *resolverstubs.EmptyResponse, err error) {
+	ctx, _, endObservation := r.operations.deleteStorageQuota.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("repository", string(pointers.Deref(args.Repository, ""))),
+	}})
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	repositoryID, err := unmarshalOptionalRepositoryID(args.Repository)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.policySvc.DeleteStorageQuota(ctx, repositoryID); err != nil {
+		return nil, err
+	}
+
+	return resolverstubs.Empty, nil
+}
+
+// 🚨 SECURITY: Only site admins may view storage quotas
+func (r *rootResolver) CodeIntelligenceStorageQuotaOverview(ctx context.Context, id graphql.ID) (_ resolverstubs.CodeIntelligenceStorageQuotaOverviewResolver, err error) {
+	ctx, _, endObservation := r.operations.storageQuotaOverview.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("repoID", string(id)),
+	}})
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	repositoryID, err := resolverstubs.UnmarshalID[int](id)
+	if err != nil {
+		return nil, err
+	}
+
+	overview, err := r.policySvc.GetStorageQuotaOverview(ctx, repositoryID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &storageQuotaOverviewResolver{overview: overview}, nil
+}
+
+func unmarshalOptionalRepositoryID(id *graphql.ID) (int, error) {
+	if id == nil {
+		return 0, nil
+	}
+
+	return resolverstubs.UnmarshalID[int](*id)
+}
+
+func toBytes(value *float64) *int64 {
+	if value == nil {
+		return nil
+	}
+
+	v := int64(*value)
+	return &v
+}
+
+func fromBytes(value *int64) *float64 {
+	if value == nil {
+		return nil
+	}
+
+	v := float64(*value)
+	return &v
+}
+
+//
+//
+
+type storageQuotaResolver struct {
+	quota shared.StorageQuota
+}
+
+func (r *storageQuotaResolver) MaxRepositoryBytes() *float64 {
+	return fromBytes(r.quota.MaxRepositoryBytes)
+}
+
+func (r *storageQuotaResolver) MaxTotalBytes() *float64 {
+	return fromBytes(r.quota.MaxTotalBytes)
+}
+
+//
+//
+
+type storageQuotaOverviewResolver struct {
+	overview shared.StorageQuotaOverview
+}
+
+func (r *storageQuotaOverviewResolver) RepositoryBytes() float64 {
+	return float64(r.overview.RepositoryBytes)
+}
+
+func (r *storageQuotaOverviewResolver) MaxRepositoryBytes() *float64 {
+	return fromBytes(r.overview.MaxRepositoryBytes)
+}
+
+func (r *storageQuotaOverviewResolver) TotalBytes() float64 {
+	return float64(r.overview.TotalBytes)
+}
+
+func (r *storageQuotaOverviewResolver) MaxTotalBytes() *float64 {
+	return fromBytes(r.overview.MaxTotalBytes)
+}
+
+func (r *storageQuotaOverviewResolver) EvictedIndexes() []resolverstubs.CodeIntelligenceStorageQuotaEvictionResolver {
+	resolvers := make([]resolverstubs.CodeIntelligenceStorageQuotaEvictionResolver, 0, len(r.overview.EvictedUploads))
+	for _, candidate := range r.overview.EvictedUploads {
+		resolvers = append(resolvers, &storageQuotaEvictionResolver{candidate: candidate})
+	}
+
+	return resolvers
+}
+
+//
+//
+
+type storageQuotaEvictionResolver struct {
+	candidate uploadsshared.QuotaEvictionCandidate
+}
+
+func (r *storageQuotaEvictionResolver) PreciseIndexID() graphql.ID {
+	return resolverstubs.MarshalID("PreciseIndex", fmt.Sprintf("U:%d", r.candidate.UploadID))
+}
+
+func (r *storageQuotaEvictionResolver) Commit() string {
+	return r.candidate.Commit
+}
+
+func (r *storageQuotaEvictionResolver) UploadedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.candidate.UploadedAt}
+}
+
+func (r *storageQuotaEvictionResolver) SizeBytes() float64 {
+	return float64(r.candidate.Size)
+}
+
+func (r *storageQuotaEvictionResolver) VisibleAtTip() bool {
+	return r.candidate.VisibleAtTip
+}
@@ -0,0 +1,257 @@
+package sbom
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sbom/shared"
+)
+
+const cycloneDXSpecVersion = "1.5"
+
+type cycloneDXDocument struct {
+	BOMFormat       string                   `json:"bomFormat"`
+	SpecVersion     string                   `json:"specVersion"`
+	SerialNumber    string                   `json:"serialNumber"`
+	Version         int                      `json:"version"`
+	Metadata        cycloneDXMetadata        `json:"metadata"`
+	Components      []cycloneDXComponent     `json:"components"`
+	Dependencies    []cycloneDXDependency    `json:"dependencies"`
+	Vulnerabilities []cycloneDXVulnerability `json:"vulnerabilities,omitempty"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string             `json:"timestamp"`
+	Tools     []cycloneDXTool    `json:"tools"`
+	Component cycloneDXComponent `json:"component"`
+}
+
+type cycloneDXTool struct {
+	Vendor string `json:"vendor"`
+	Name   string `json:"name"`
+}
+
+type cycloneDXComponent struct {
+	Type    string `json:"type"`
+	BOMRef  string `json:"bom-ref"`
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	PURL    string `json:"purl,omitempty"`
+}
+
+type cycloneDXDependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn"`
+}
+
+type cycloneDXVulnerability struct {
+	ID             string                      `json:"id"`
+	Source         *cycloneDXSource            `json:"source,omitempty"`
+	Ratings        []cycloneDXRating           `json:"ratings,omitempty"`
+	CWEs           []int                       `json:"cwes,omitempty"`
+	Description    string                      `json:"description,omitempty"`
+	Detail         string                      `json:"detail,omitempty"`
+	Recommendation string                      `json:"recommendation,omitempty"`
+	Advisories     []cycloneDXAdvisory         `json:"advisories,omitempty"`
+	Published      string                      `json:"published,omitempty"`
+	Updated        string                      `json:"updated,omitempty"`
+	Analysis       cycloneDXAnalysis           `json:"analysis"`
+	Affects        []cycloneDXVulnerableTarget `json:"affects"`
+}
+
+type cycloneDXSource struct {
+	Name string `json:"name,omitempty"`
+	URL  string `json:"url,omitempty"`
+}
+
+type cycloneDXRating struct {
+	Score    *float64 `json:"score,omitempty"`
+	Severity string   `json:"severity,omitempty"`
+	Method   string   `json:"method,omitempty"`
+	Vector   string   `json:"vector,omitempty"`
+}
+
+type cycloneDXAdvisory struct {
+	URL string `json:"url"`
+}
+
+type cycloneDXAnalysis struct {
+	State string `json:"state"`
+}
+
+type cycloneDXVulnerableTarget struct {
+	Ref string `json:"ref"`
+}
+
+// EncodeCycloneDX serializes the given document as a CycloneDX JSON document. Matched
+// vulnerabilities are embedded as VEX statements. Matches are based on package versions
+// alone, so each statement is reported as being in triage.
+func EncodeCycloneDX(document shared.Document) ([]byte, error) {
+	rootRef := document.RootRef()
+
+	components := make([]cycloneDXComponent, 0, len(document.Components))
+	for _, component := range document.Components {
+		components = append(components, cycloneDXComponent{
+			Type:    "library",
+			BOMRef:  component.Ref(),
+			Name:    component.Name,
+			Version: component.Version,
+			PURL:    component.PURL(),
+		})
+	}
+
+	dependencies := make([]cycloneDXDependency, 0, len(document.Dependencies))
+	for _, dependency := range document.Dependencies {
+		dependencies = append(dependencies, cycloneDXDependency{
+			Ref:       dependency.Ref,
+			DependsOn: nonNil(dependency.DependsOn),
+		})
+	}
+
+	vulnerabilities := make([]cycloneDXVulnerability, 0, len(document.Vulnerabilities))
+	for _, v := range document.Vulnerabilities {
+		vulnerabilities = append(vulnerabilities, cycloneDXVulnerabilityFor(v))
+	}
+
+	return json.MarshalIndent(cycloneDXDocument{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  cycloneDXSpecVersion,
+		SerialNumber: "urn:uuid:" + documentUUID(document).String(),
+		Version:      1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: document.CreatedAt.UTC().Format(time.RFC3339),
+			Tools:     []cycloneDXTool{{Vendor: "Sourcegraph", Name: "sourcegraph"}},
+			Component: cycloneDXComponent{
+				Type:    "application",
+				BOMRef:  rootRef,
+				Name:    document.RepositoryName,
+				Version: document.Commit,
+			},
+		},
+		Components:      components,
+		Dependencies:    dependencies,
+		Vulnerabilities: vulnerabilities,
+	}, "", "  ")
+}
+
+func cycloneDXVulnerabilityFor(v shared.Vulnerability) cycloneDXVulnerability {
+	var source *cycloneDXSource
+	if v.DataSource != "" || len(v.URLs) > 0 {
+		source = &cycloneDXSource{Name: v.DataSource}
+		if len(v.URLs) > 0 {
+			source.URL = v.URLs[0]
+		}
+	}
+
+	var ratings []cycloneDXRating
+	if v.Severity != "" || v.CVSSScore != "" || v.CVSSVector != "" {
+		rating := cycloneDXRating{
+			Severity: cycloneDXSeverity(v.Severity),
+			Method:   cvssMethod(v.CVSSVector),
+			Vector:   v.CVSSVector,
+		}
+		if score, err := strconv.ParseFloat(v.CVSSScore, 64); err == nil {
+			rating.Score = &score
+		}
+		ratings = append(ratings, rating)
+	}
+
+	var cwes []int
+	for _, cwe := range v.CWEs {
+		if id, err := strconv.Atoi(strings.TrimPrefix(strings.ToUpper(cwe), "CWE-")); err == nil {
+			cwes = append(cwes, id)
+		}
+	}
+
+	advisories := make([]cycloneDXAdvisory, 0, len(v.URLs))
+	for _, url := range v.URLs {
+		advisories = append(advisories, cycloneDXAdvisory{URL: url})
+	}
+
+	var recommendation string
+	if v.FixedIn != "" {
+		recommendation = "Upgrade to version " + v.FixedIn + " or later."
+	}
+
+	var updated string
+	if v.Modified != nil {
+		updated = v.Modified.UTC().Format(time.RFC3339)
+	}
+
+	affects := make([]cycloneDXVulnerableTarget, 0, len(v.Affects))
+	for _, ref := range v.Affects {
+		affects = append(affects, cycloneDXVulnerableTarget{Ref: ref})
+	}
+
+	return cycloneDXVulnerability{
+		ID:             v.SourceID,
+		Source:         source,
+		Ratings:        ratings,
+		CWEs:           cwes,
+		Description:    v.Summary,
+		Detail:         v.Details,
+		Recommendation: recommendation,
+		Advisories:     advisories,
+		Published:      formatTime(v.Published),
+		Updated:        updated,
+		Analysis:       cycloneDXAnalysis{State: "in_triage"},
+		Affects:        affects,
+	}
+}
+
+// cycloneDXSeverity returns the CycloneDX severity for the given vulnerability severity.
+func cycloneDXSeverity(severity string) string {
+	switch s := strings.ToLower(severity); s {
+	case "critical", "high", "medium", "low", "info", "none":
+		return s
+	case "moderate":
+		return "medium"
+	case "":
+		return ""
+	}
+
+	return "unknown"
+}
+
+// cvssMethod returns the CycloneDX scoring method for the given CVSS vector.
+func cvssMethod(vector string) string {
+	switch {
+	case vector == "":
+		return ""
+	case strings.HasPrefix(vector, "CVSS:4"):
+		return "CVSSv4"
+	case strings.HasPrefix(vector, "CVSS:3.1"):
+		return "CVSSv31"
+	case strings.HasPrefix(vector, "CVSS:3"):
+		return "CVSSv3"
+	case strings.HasPrefix(vector, "AV:"):
+		return "CVSSv2"
+	}
+
+	return "other"
+}
+
+// documentUUID returns a stable identifier for the given document.
+func documentUUID(document shared.Document) uuid.UUID {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(document.RootRef()+"#"+document.CreatedAt.UTC().Format(time.RFC3339Nano)))
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
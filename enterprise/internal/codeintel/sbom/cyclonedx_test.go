@@ -0,0 +1,62 @@
+package sbom
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEncodeCycloneDX(t *testing.T) {
+	payload, err := EncodeCycloneDX(testDocument())
+	if err != nil {
+		t.Fatalf("unexpected error encoding document: %s", err)
+	}
+
+	var document cycloneDXDocument
+	if err := json.Unmarshal(payload, &document); err != nil {
+		t.Fatalf("unexpected error decoding document: %s", err)
+	}
+
+	if document.BOMFormat != "CycloneDX" || document.SpecVersion != cycloneDXSpecVersion {
+		t.Errorf("unexpected header: %s %s", document.BOMFormat, document.SpecVersion)
+	}
+	if document.Metadata.Component.Name != "github.com/sourcegraph/example" || document.Metadata.Component.Version != "deadbeef" {
+		t.Errorf("unexpected metadata component: %+v", document.Metadata.Component)
+	}
+	if document.Metadata.Timestamp != "2023-06-01T12:00:00Z" {
+		t.Errorf("unexpected timestamp: %s", document.Metadata.Timestamp)
+	}
+
+	expectedComponent := cycloneDXComponent{
+		Type:    "library",
+		BOMRef:  "pkg:golang/github.com/go-nacelle/config@v1.2.5",
+		Name:    "github.com/go-nacelle/config",
+		Version: "v1.2.5",
+		PURL:    "pkg:golang/github.com/go-nacelle/config@v1.2.5",
+	}
+	if len(document.Components) != 4 {
+		t.Fatalf("unexpected number of components. want=%d have=%d", 4, len(document.Components))
+	}
+	if diff := cmp.Diff(expectedComponent, document.Components[1]); diff != "" {
+		t.Errorf("unexpected component (-want +got):\n%s", diff)
+	}
+
+	score := 7.5
+	expectedVulnerabilities := []cycloneDXVulnerability{
+		{
+			ID:             "CVE-ABC",
+			Source:         &cycloneDXSource{URL: "https://example.com/CVE-ABC"},
+			Ratings:        []cycloneDXRating{{Score: &score, Severity: "high", Method: "CVSSv31", Vector: "CVSS:3.1/AV:N"}},
+			CWEs:           []int{79},
+			Description:    "bad config",
+			Recommendation: "Upgrade to version v1.2.6 or later.",
+			Advisories:     []cycloneDXAdvisory{{URL: "https://example.com/CVE-ABC"}},
+			Analysis:       cycloneDXAnalysis{State: "in_triage"},
+			Affects:        []cycloneDXVulnerableTarget{{Ref: "pkg:golang/github.com/go-nacelle/config@v1.2.5"}},
+		},
+	}
+	if diff := cmp.Diff(expectedVulnerabilities, document.Vulnerabilities); diff != "" {
+		t.Errorf("unexpected vulnerabilities (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,16 @@
+package sbom
+
+import (
+	"context"
+
+	sentinelshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+	uploadsshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
+)
+
+type UploadService interface {
+	InferClosestUploads(ctx context.Context, repositoryID int, commit, path string, exactPath bool, indexer string) (_ []uploadsshared.Dump, err error)
+}
+
+type SentinelService interface {
+	GetVulnerabilitiesByIDs(ctx context.Context, ids ...int) ([]sentinelshared.Vulnerability, error)
+}
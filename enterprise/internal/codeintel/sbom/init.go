@@ -0,0 +1,25 @@
+package sbom
+
+import (
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sbom/internal/store"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func NewService(
+	observationCtx *observation.Context,
+	db database.DB,
+	uploadSvc UploadService,
+	sentinelSvc SentinelService,
+) *Service {
+	return newService(
+		scopedContext("service", observationCtx),
+		store.New(scopedContext("store", observationCtx), db),
+		uploadSvc,
+		sentinelSvc,
+	)
+}
+
+func scopedContext(component string, parent *observation.Context) *observation.Context {
+	return observation.ScopedContext("codeintel", "sbom", component, parent)
+}
@@ -0,0 +1,73 @@
+package store
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sbom/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func (s *store) GetComponentsByUploadIDs(ctx context.Context, uploadIDs []int) (_ []shared.UploadComponent, err error) {
+	ctx, _, endObservation := s.operations.getComponentsByUploadIDs.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("numUploadIDs", len(uploadIDs)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if len(uploadIDs) == 0 {
+		return nil, nil
+	}
+
+	return scanUploadComponents(s.db.Query(ctx, sqlf.Sprintf(getComponentsByUploadIDsQuery, pq.Array(uploadIDs), pq.Array(uploadIDs))))
+}
+
+const getComponentsByUploadIDsQuery = `
+SELECT p.dump_id, p.scheme, p.manager, p.name, p.version, true AS provided
+FROM lsif_packages p
+WHERE p.dump_id = ANY(%s)
+UNION
+SELECT r.dump_id, r.scheme, r.manager, r.name, r.version, false AS provided
+FROM lsif_references r
+WHERE r.dump_id = ANY(%s)
+ORDER BY dump_id, provided DESC, scheme, manager, name, version
+`
+
+var scanUploadComponents = basestore.NewSliceScanner(func(s dbutil.Scanner) (c shared.UploadComponent, _ error) {
+	err := s.Scan(&c.UploadID, &c.Scheme, &c.Manager, &c.Name, &dbutil.NullString{S: &c.Version}, &c.Provided)
+	return c, err
+})
+
+func (s *store) GetVulnerabilityMatchesByUploadIDs(ctx context.Context, uploadIDs []int) (_ []shared.VulnerabilityMatch, err error) {
+	ctx, _, endObservation := s.operations.getVulnerabilityMatchesByUploadIDs.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("numUploadIDs", len(uploadIDs)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if len(uploadIDs) == 0 {
+		return nil, nil
+	}
+
+	return scanVulnerabilityMatches(s.db.Query(ctx, sqlf.Sprintf(getVulnerabilityMatchesByUploadIDsQuery, pq.Array(uploadIDs))))
+}
+
+const getVulnerabilityMatchesByUploadIDsQuery = `
+SELECT
+	m.upload_id,
+	vap.vulnerability_id,
+	vap.package_name,
+	vap.fixed_in
+FROM vulnerability_matches m
+JOIN vulnerability_affected_packages vap ON vap.id = m.vulnerability_affected_package_id
+WHERE m.upload_id = ANY(%s)
+ORDER BY m.upload_id, vap.vulnerability_id, vap.id
+`
+
+var scanVulnerabilityMatches = basestore.NewSliceScanner(func(s dbutil.Scanner) (m shared.VulnerabilityMatch, _ error) {
+	err := s.Scan(&m.UploadID, &m.VulnerabilityID, &m.PackageName, &dbutil.NullString{S: &m.FixedIn})
+	return m, err
+})
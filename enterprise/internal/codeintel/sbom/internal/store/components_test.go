@@ -0,0 +1,101 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/keegancsmith/sqlf"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sbom/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestGetComponentsByUploadIDs(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	setupPackages(t, db)
+
+	components, err := store.GetComponentsByUploadIDs(ctx, []int{50, 51})
+	if err != nil {
+		t.Fatalf("unexpected error getting components: %s", err)
+	}
+
+	expected := []shared.UploadComponent{
+		{UploadID: 50, Component: shared.Component{Scheme: "scip-go", Manager: "gomod", Name: "github.com/sourcegraph/example", Version: "v1.0.0", Provided: true}},
+		{UploadID: 50, Component: shared.Component{Scheme: "scip-go", Manager: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.5"}},
+		{UploadID: 50, Component: shared.Component{Scheme: "scip-go", Manager: "gomod", Name: "github.com/sourcegraph/log", Version: "v0.0.1"}},
+		{UploadID: 51, Component: shared.Component{Scheme: "scip-typescript", Manager: "npm", Name: "lodash", Version: "4.17.20"}},
+	}
+	if diff := cmp.Diff(expected, components); diff != "" {
+		t.Errorf("unexpected components (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetVulnerabilityMatchesByUploadIDs(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	setupPackages(t, db)
+
+	if err := basestore.NewWithHandle(db.Handle()).Exec(ctx, sqlf.Sprintf(`
+		INSERT INTO vulnerabilities (id, source_id, summary, details, cpes, cwes, aliases, related, data_source, urls, severity, cvss_vector, cvss_score, published_at)
+		VALUES (1, 'CVE-ABC', '', '', '{}', '{}', '{}', '{}', '', '{}', 'HIGH', '', '', NOW());
+
+		INSERT INTO vulnerability_affected_packages (id, vulnerability_id, package_name, language, namespace, version_constraint, fixed, fixed_in)
+		VALUES (1, 1, 'go-nacelle/config', 'go', '', '{"<= v1.2.5"}', true, 'v1.2.6');
+
+		INSERT INTO vulnerability_matches (upload_id, vulnerability_affected_package_id)
+		VALUES (50, 1), (52, 1);
+	`)); err != nil {
+		t.Fatalf("failed to insert vulnerability matches: %s", err)
+	}
+
+	matches, err := store.GetVulnerabilityMatchesByUploadIDs(ctx, []int{50, 51})
+	if err != nil {
+		t.Fatalf("unexpected error getting vulnerability matches: %s", err)
+	}
+
+	expected := []shared.VulnerabilityMatch{
+		{UploadID: 50, VulnerabilityID: 1, PackageName: "go-nacelle/config", FixedIn: "v1.2.6"},
+	}
+	if diff := cmp.Diff(expected, matches); diff != "" {
+		t.Errorf("unexpected vulnerability matches (-want +got):\n%s", diff)
+	}
+}
+
+func setupPackages(t *testing.T, db database.DB) {
+	if err := basestore.NewWithHandle(db.Handle()).Exec(context.Background(), sqlf.Sprintf(`
+		INSERT INTO repo (id, name) VALUES (50, 'github.com/sourcegraph/example');
+
+		INSERT INTO lsif_uploads (id, repository_id, commit, indexer, num_parts, uploaded_parts, state)
+		VALUES
+			(50, 50, 'deadbeef01deadbeef02deadbeef03deadbeef04', 'scip-go', 1, '{}', 'completed'),
+			(51, 50, 'deadbeef01deadbeef02deadbeef03deadbeef04', 'scip-typescript', 1, '{}', 'completed'),
+			(52, 50, 'deadbeef01deadbeef02deadbeef03deadbeef05', 'scip-go', 1, '{}', 'completed');
+
+		INSERT INTO lsif_packages (scheme, manager, name, version, dump_id)
+		VALUES
+			('scip-go', 'gomod', 'github.com/sourcegraph/example', 'v1.0.0', 50),
+			('scip-go', 'gomod', 'github.com/sourcegraph/example', 'v1.1.0', 52);
+
+		INSERT INTO lsif_references (scheme, manager, name, version, dump_id)
+		VALUES
+			('scip-go', 'gomod', 'github.com/sourcegraph/log', 'v0.0.1', 50),
+			('scip-go', 'gomod', 'github.com/go-nacelle/config', 'v1.2.5', 50),
+			('scip-go', 'gomod', 'github.com/go-nacelle/config', 'v1.2.5', 50),
+			('scip-typescript', 'npm', 'lodash', '4.17.20', 51),
+			('scip-go', 'gomod', 'github.com/go-nacelle/config', 'v1.2.6', 52);
+	`)); err != nil {
+		t.Fatalf("failed to insert packages: %s", err)
+	}
+}
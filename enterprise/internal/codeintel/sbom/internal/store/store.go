@@ -0,0 +1,31 @@
+package store
+
+import (
+	"context"
+
+	logger "github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sbom/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type Store interface {
+	GetComponentsByUploadIDs(ctx context.Context, uploadIDs []int) (_ []shared.UploadComponent, err error)
+	GetVulnerabilityMatchesByUploadIDs(ctx context.Context, uploadIDs []int) (_ []shared.VulnerabilityMatch, err error)
+}
+
+type store struct {
+	db         *basestore.Store
+	logger     logger.Logger
+	operations *operations
+}
+
+func New(observationCtx *observation.Context, db database.DB) Store {
+	return &store{
+		db:         basestore.NewWithHandle(db.Handle()),
+		logger:     logger.Scoped("sbom.store", ""),
+		operations: newOperations(observationCtx),
+	}
+}
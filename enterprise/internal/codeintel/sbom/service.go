@@ -0,0 +1,280 @@
+package sbom
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sbom/internal/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sbom/shared"
+	sentinelshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type Service struct {
+	store       store.Store
+	uploadSvc   UploadService
+	sentinelSvc SentinelService
+	clock       func() time.Time
+	operations  *operations
+}
+
+func newService(
+	observationCtx *observation.Context,
+	store store.Store,
+	uploadSvc UploadService,
+	sentinelSvc SentinelService,
+) *Service {
+	return &Service{
+		store:       store,
+		uploadSvc:   uploadSvc,
+		sentinelSvc: sentinelSvc,
+		clock:       time.Now,
+		operations:  newOperations(observationCtx),
+	}
+}
+
+// GetDocument assembles a software bill of materials for the given repository and commit
+// from the packages defined and referenced by the indexes visible at that commit. Known
+// vulnerabilities matched against those indexes are attached to the affected components.
+func (s *Service) GetDocument(ctx context.Context, repositoryID int, repositoryName, commit string) (_ shared.Document, err error) {
+	ctx, _, endObservation := s.operations.getDocument.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", repositoryID),
+		attribute.String("commit", commit),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	dumps, err := s.uploadSvc.InferClosestUploads(ctx, repositoryID, commit, "", false, "")
+	if err != nil {
+		return shared.Document{}, errors.Wrap(err, "uploadSvc.InferClosestUploads")
+	}
+
+	uploadIDs := make([]int, 0, len(dumps))
+	for _, dump := range dumps {
+		uploadIDs = append(uploadIDs, dump.ID)
+	}
+
+	components, err := s.store.GetComponentsByUploadIDs(ctx, uploadIDs)
+	if err != nil {
+		return shared.Document{}, errors.Wrap(err, "store.GetComponentsByUploadIDs")
+	}
+
+	matches, err := s.store.GetVulnerabilityMatchesByUploadIDs(ctx, uploadIDs)
+	if err != nil {
+		return shared.Document{}, errors.Wrap(err, "store.GetVulnerabilityMatchesByUploadIDs")
+	}
+
+	vulnerabilityIDMap := map[int]struct{}{}
+	for _, match := range matches {
+		vulnerabilityIDMap[match.VulnerabilityID] = struct{}{}
+	}
+	vulnerabilityIDs := make([]int, 0, len(vulnerabilityIDMap))
+	for id := range vulnerabilityIDMap {
+		vulnerabilityIDs = append(vulnerabilityIDs, id)
+	}
+
+	var vulnerabilities []sentinelshared.Vulnerability
+	if len(vulnerabilityIDs) > 0 {
+		if vulnerabilities, err = s.sentinelSvc.GetVulnerabilitiesByIDs(ctx, vulnerabilityIDs...); err != nil {
+			return shared.Document{}, errors.Wrap(err, "sentinelSvc.GetVulnerabilitiesByIDs")
+		}
+	}
+
+	return buildDocument(repositoryName, commit, s.clock().UTC(), components, matches, vulnerabilities), nil
+}
+
+// Export serializes the software bill of materials for the given repository and commit
+// in the given format.
+func (s *Service) Export(ctx context.Context, repositoryID int, repositoryName, commit string, format shared.Format) (_ []byte, err error) {
+	ctx, _, endObservation := s.operations.export.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", repositoryID),
+		attribute.String("commit", commit),
+		attribute.String("format", string(format)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	document, err := s.GetDocument(ctx, repositoryID, repositoryName, commit)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case shared.FormatCycloneDX:
+		return EncodeCycloneDX(document)
+	case shared.FormatSPDX:
+		return EncodeSPDX(document, conf.ExternalURL())
+	}
+
+	return nil, errors.Newf("unsupported SBOM format %q", format)
+}
+
+// buildDocument assembles a document from the components and vulnerability matches of
+// a set of indexes. Packages defined by an index depend on every package referenced by
+// the same index; the repository depends on every package it defines, or directly on the
+// referenced packages of indexes that define no packages.
+func buildDocument(
+	repositoryName string,
+	commit string,
+	now time.Time,
+	uploadComponents []shared.UploadComponent,
+	matches []shared.VulnerabilityMatch,
+	vulnerabilities []sentinelshared.Vulnerability,
+) shared.Document {
+	document := shared.Document{
+		RepositoryName: repositoryName,
+		Commit:         commit,
+		CreatedAt:      now,
+	}
+	rootRef := document.RootRef()
+
+	componentsByRef := map[string]shared.Component{}
+	providedByUpload := map[int][]string{}
+	referencedByUpload := map[int][]shared.Component{}
+	for _, uc := range uploadComponents {
+		ref := uc.Ref()
+		if existing, ok := componentsByRef[ref]; !ok || (uc.Provided && !existing.Provided) {
+			componentsByRef[ref] = uc.Component
+		}
+
+		if uc.Provided {
+			providedByUpload[uc.UploadID] = append(providedByUpload[uc.UploadID], ref)
+		} else {
+			referencedByUpload[uc.UploadID] = append(referencedByUpload[uc.UploadID], uc.Component)
+		}
+	}
+
+	dependencies := map[string]map[string]struct{}{rootRef: {}}
+	addDependency := func(from, to string) {
+		if from == to {
+			return
+		}
+		if _, ok := dependencies[from]; !ok {
+			dependencies[from] = map[string]struct{}{}
+		}
+		dependencies[from][to] = struct{}{}
+	}
+
+	for uploadID := range uniqueUploadIDs(uploadComponents) {
+		provided := providedByUpload[uploadID]
+		for _, ref := range provided {
+			addDependency(rootRef, ref)
+		}
+
+		for _, component := range referencedByUpload[uploadID] {
+			if len(provided) == 0 {
+				addDependency(rootRef, component.Ref())
+			}
+			for _, ref := range provided {
+				addDependency(ref, component.Ref())
+			}
+		}
+	}
+
+	for _, component := range componentsByRef {
+		document.Components = append(document.Components, component)
+	}
+	sort.Slice(document.Components, func(i, j int) bool {
+		if document.Components[i].Provided != document.Components[j].Provided {
+			return document.Components[i].Provided
+		}
+		return document.Components[i].Ref() < document.Components[j].Ref()
+	})
+
+	for ref, dependsOn := range dependencies {
+		document.Dependencies = append(document.Dependencies, shared.Dependency{Ref: ref, DependsOn: sortedKeys(dependsOn)})
+	}
+	sort.Slice(document.Dependencies, func(i, j int) bool {
+		if (document.Dependencies[i].Ref == rootRef) != (document.Dependencies[j].Ref == rootRef) {
+			return document.Dependencies[i].Ref == rootRef
+		}
+		return document.Dependencies[i].Ref < document.Dependencies[j].Ref
+	})
+
+	document.Vulnerabilities = buildVulnerabilities(matches, vulnerabilities, referencedByUpload)
+	return document
+}
+
+// buildVulnerabilities converts vulnerability matches into document vulnerabilities. Matches
+// are recorded per index, so the affected components are the packages referenced by the
+// matching index whose name contains the affected package name (mirroring the matcher).
+func buildVulnerabilities(
+	matches []shared.VulnerabilityMatch,
+	vulnerabilities []sentinelshared.Vulnerability,
+	referencedByUpload map[int][]shared.Component,
+) []shared.Vulnerability {
+	vulnerabilitiesByID := make(map[int]sentinelshared.Vulnerability, len(vulnerabilities))
+	for _, v := range vulnerabilities {
+		vulnerabilitiesByID[v.ID] = v
+	}
+
+	affects := map[int]map[string]struct{}{}
+	fixedIn := map[int]string{}
+	for _, match := range matches {
+		if _, ok := vulnerabilitiesByID[match.VulnerabilityID]; !ok {
+			continue
+		}
+		if _, ok := affects[match.VulnerabilityID]; !ok {
+			affects[match.VulnerabilityID] = map[string]struct{}{}
+		}
+		if match.FixedIn != "" {
+			fixedIn[match.VulnerabilityID] = match.FixedIn
+		}
+
+		for _, component := range referencedByUpload[match.UploadID] {
+			if strings.Contains(component.Name, match.PackageName) {
+				affects[match.VulnerabilityID][component.Ref()] = struct{}{}
+			}
+		}
+	}
+
+	result := make([]shared.Vulnerability, 0, len(affects))
+	for id, refs := range affects {
+		if len(refs) == 0 {
+			continue
+		}
+
+		v := vulnerabilitiesByID[id]
+		result = append(result, shared.Vulnerability{
+			SourceID:   v.SourceID,
+			Summary:    v.Summary,
+			Details:    v.Details,
+			DataSource: v.DataSource,
+			URLs:       v.URLs,
+			CWEs:       v.CWEs,
+			Severity:   v.Severity,
+			CVSSVector: v.CVSSVector,
+			CVSSScore:  v.CVSSScore,
+			FixedIn:    fixedIn[id],
+			Published:  v.PublishedAt,
+			Modified:   v.ModifiedAt,
+			Affects:    sortedKeys(refs),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].SourceID < result[j].SourceID })
+
+	return result
+}
+
+func uniqueUploadIDs(uploadComponents []shared.UploadComponent) map[int]struct{} {
+	ids := map[int]struct{}{}
+	for _, uc := range uploadComponents {
+		ids[uc.UploadID] = struct{}{}
+	}
+
+	return ids
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,77 @@
+package sbom
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sbom/shared"
+	sentinelshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+)
+
+var (
+	testNow = time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+	testExample = shared.Component{Scheme: "scip-go", Manager: "gomod", Name: "github.com/sourcegraph/example", Version: "v1.0.0", Provided: true}
+	testConfig  = shared.Component{Scheme: "scip-go", Manager: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.5"}
+	testLog     = shared.Component{Scheme: "scip-go", Manager: "gomod", Name: "github.com/sourcegraph/log", Version: "v0.0.1"}
+	testLodash  = shared.Component{Scheme: "scip-typescript", Manager: "npm", Name: "lodash", Version: "4.17.20"}
+)
+
+func testDocument() shared.Document {
+	return buildDocument(
+		"github.com/sourcegraph/example",
+		"deadbeef",
+		testNow,
+		[]shared.UploadComponent{
+			{UploadID: 50, Component: testExample},
+			{UploadID: 50, Component: testConfig},
+			{UploadID: 50, Component: testLog},
+			{UploadID: 51, Component: testLodash},
+		},
+		[]shared.VulnerabilityMatch{
+			{UploadID: 50, VulnerabilityID: 1, PackageName: "go-nacelle/config", FixedIn: "v1.2.6"},
+			{UploadID: 50, VulnerabilityID: 2, PackageName: "unknown"},
+		},
+		[]sentinelshared.Vulnerability{
+			{ID: 1, SourceID: "CVE-ABC", Summary: "bad config", Severity: "HIGH", CVSSScore: "7.5", CVSSVector: "CVSS:3.1/AV:N", URLs: []string{"https://example.com/CVE-ABC"}, CWEs: []string{"CWE-79"}},
+			{ID: 2, SourceID: "CVE-DEF"},
+		},
+	)
+}
+
+func TestBuildDocument(t *testing.T) {
+	document := testDocument()
+	rootRef := "repository:github.com/sourcegraph/example@deadbeef"
+
+	expectedComponents := []shared.Component{testExample, testConfig, testLog, testLodash}
+	if diff := cmp.Diff(expectedComponents, document.Components); diff != "" {
+		t.Errorf("unexpected components (-want +got):\n%s", diff)
+	}
+
+	expectedDependencies := []shared.Dependency{
+		{Ref: rootRef, DependsOn: []string{testExample.Ref(), testLodash.Ref()}},
+		{Ref: testExample.Ref(), DependsOn: []string{testConfig.Ref(), testLog.Ref()}},
+	}
+	if diff := cmp.Diff(expectedDependencies, document.Dependencies); diff != "" {
+		t.Errorf("unexpected dependencies (-want +got):\n%s", diff)
+	}
+
+	expectedVulnerabilities := []shared.Vulnerability{
+		{
+			SourceID:   "CVE-ABC",
+			Summary:    "bad config",
+			URLs:       []string{"https://example.com/CVE-ABC"},
+			CWEs:       []string{"CWE-79"},
+			Severity:   "HIGH",
+			CVSSVector: "CVSS:3.1/AV:N",
+			CVSSScore:  "7.5",
+			FixedIn:    "v1.2.6",
+			Affects:    []string{testConfig.Ref()},
+		},
+	}
+	if diff := cmp.Diff(expectedVulnerabilities, document.Vulnerabilities); diff != "" {
+		t.Errorf("unexpected vulnerabilities (-want +got):\n%s", diff)
+	}
+}
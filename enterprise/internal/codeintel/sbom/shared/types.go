@@ -0,0 +1,187 @@
+package shared
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Format identifies the serialization format of a software bill of materials.
+type Format string
+
+const (
+	FormatCycloneDX Format = "cyclonedx"
+	FormatSPDX      Format = "spdx"
+)
+
+// ParseFormat returns the format with the given (case-insensitive) name.
+func ParseFormat(name string) (Format, bool) {
+	switch strings.ToLower(name) {
+	case "", string(FormatCycloneDX), "cyclonedx-json":
+		return FormatCycloneDX, true
+	case string(FormatSPDX), "spdx-json":
+		return FormatSPDX, true
+	}
+
+	return "", false
+}
+
+// Document is a format-agnostic software bill of materials for a repository at
+// a particular commit. It is assembled from the packages defined and referenced
+// by the precise code intelligence indexes visible at that commit.
+type Document struct {
+	RepositoryName  string
+	Commit          string
+	CreatedAt       time.Time
+	Components      []Component
+	Dependencies    []Dependency
+	Vulnerabilities []Vulnerability
+}
+
+// RootRef returns the reference of the component describing the repository.
+func (d Document) RootRef() string {
+	return fmt.Sprintf("repository:%s@%s", d.RepositoryName, d.Commit)
+}
+
+// Component is a package defined or referenced by an index.
+type Component struct {
+	Scheme  string
+	Manager string
+	Name    string
+	Version string
+
+	// Provided is true when the package is defined by an index of the repository
+	// rather than being a reference to an external dependency.
+	Provided bool
+}
+
+// UploadComponent is a component defined or referenced by a particular index.
+type UploadComponent struct {
+	UploadID int
+	Component
+}
+
+// Ref returns a stable identifier of the component unique within a document.
+func (c Component) Ref() string {
+	if purl := c.PURL(); purl != "" {
+		return purl
+	}
+
+	return fmt.Sprintf("%s:%s:%s@%s", c.Scheme, c.Manager, c.Name, c.Version)
+}
+
+// PURL returns the package URL of the component, or an empty string if the
+// package manager of the component has no package URL type.
+func (c Component) PURL() string {
+	purlType, ok := purlTypes[strings.ToLower(c.Manager)]
+	if !ok {
+		if purlType, ok = purlTypes[strings.ToLower(c.Scheme)]; !ok {
+			return ""
+		}
+	}
+
+	var namespace, name string
+	switch purlType {
+	case "maven":
+		// Maven coordinates are group:artifact
+		if i := strings.LastIndex(c.Name, ":"); i >= 0 {
+			namespace, name = c.Name[:i], c.Name[i+1:]
+		} else {
+			name = c.Name
+		}
+
+	case "golang", "npm", "composer":
+		// Scoped npm packages, composer vendors, and Go module paths keep their
+		// leading segments as the namespace
+		if i := strings.LastIndex(c.Name, "/"); i >= 0 {
+			namespace, name = c.Name[:i], c.Name[i+1:]
+		} else {
+			name = c.Name
+		}
+
+	default:
+		name = c.Name
+	}
+
+	var sb strings.Builder
+	sb.WriteString("pkg:")
+	sb.WriteString(purlType)
+	sb.WriteString("/")
+	if namespace != "" {
+		for _, segment := range strings.Split(namespace, "/") {
+			sb.WriteString(purlEscape(segment))
+			sb.WriteString("/")
+		}
+	}
+	sb.WriteString(purlEscape(name))
+	if c.Version != "" {
+		sb.WriteString("@")
+		sb.WriteString(purlEscape(c.Version))
+	}
+
+	return sb.String()
+}
+
+// purlEscape percent-encodes a package URL segment. Unlike path escaping, the separator
+// between a name and version must be encoded as well.
+func purlEscape(s string) string {
+	return strings.ReplaceAll(url.PathEscape(s), "@", "%40")
+}
+
+// purlTypes maps the package managers (and, as a fallback, the schemes) emitted by
+// SCIP indexers to package URL types.
+var purlTypes = map[string]string{
+	"npm":             "npm",
+	"scip-typescript": "npm",
+	"gomod":           "golang",
+	"scip-go":         "golang",
+	"maven":           "maven",
+	"semanticdb":      "maven",
+	"scip-java":       "maven",
+	"pip":             "pypi",
+	"python":          "pypi",
+	"scip-python":     "pypi",
+	"cargo":           "cargo",
+	"rust-analyzer":   "cargo",
+	"nuget":           "nuget",
+	"scip-dotnet":     "nuget",
+	"gem":             "gem",
+	"scip-ruby":       "gem",
+	"composer":        "composer",
+	"scip-php":        "composer",
+}
+
+// Dependency records the components directly depended on by a component. The
+// repository itself is represented by the document's root reference.
+type Dependency struct {
+	Ref       string
+	DependsOn []string
+}
+
+// Vulnerability is a known vulnerability matched against the indexes of the
+// repository, along with the components it affects.
+type Vulnerability struct {
+	SourceID   string
+	Summary    string
+	Details    string
+	DataSource string
+	URLs       []string
+	CWEs       []string
+	Severity   string
+	CVSSVector string
+	CVSSScore  string
+	FixedIn    string
+	Published  time.Time
+	Modified   *time.Time
+	Affects    []string
+}
+
+// VulnerabilityMatch is a match between an index and an affected package of a
+// known vulnerability.
+type VulnerabilityMatch struct {
+	UploadID        int
+	VulnerabilityID int
+	PackageName     string
+	FixedIn         string
+}
@@ -0,0 +1,47 @@
+package shared
+
+import "testing"
+
+func TestComponentPURL(t *testing.T) {
+	testCases := []struct {
+		component Component
+		expected  string
+	}{
+		{Component{Scheme: "scip-go", Manager: "gomod", Name: "github.com/sourcegraph/log", Version: "v0.0.1"}, "pkg:golang/github.com/sourcegraph/log@v0.0.1"},
+		{Component{Scheme: "scip-typescript", Manager: "npm", Name: "@types/node", Version: "18.0.0"}, "pkg:npm/%40types/node@18.0.0"},
+		{Component{Scheme: "semanticdb", Manager: "maven", Name: "com.google.guava:guava", Version: "31.1-jre"}, "pkg:maven/com.google.guava/guava@31.1-jre"},
+		{Component{Scheme: "scip-python", Manager: "python", Name: "requests", Version: "2.31.0"}, "pkg:pypi/requests@2.31.0"},
+		{Component{Scheme: "scip-ruby", Manager: "", Name: "rails", Version: ""}, "pkg:gem/rails"},
+		{Component{Scheme: "lsif-clang", Manager: "", Name: "zlib", Version: "1.2.13"}, ""},
+	}
+
+	for _, testCase := range testCases {
+		if purl := testCase.component.PURL(); purl != testCase.expected {
+			t.Errorf("unexpected purl for %s. want=%q have=%q", testCase.component.Name, testCase.expected, purl)
+		}
+	}
+}
+
+func TestComponentRefWithoutPURL(t *testing.T) {
+	component := Component{Scheme: "lsif-clang", Manager: "conan", Name: "zlib", Version: "1.2.13"}
+	if ref := component.Ref(); ref != "lsif-clang:conan:zlib@1.2.13" {
+		t.Errorf("unexpected ref. have=%q", ref)
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for name, expected := range map[string]Format{
+		"":          FormatCycloneDX,
+		"CYCLONEDX": FormatCycloneDX,
+		"spdx":      FormatSPDX,
+		"SPDX-JSON": FormatSPDX,
+	} {
+		if format, ok := ParseFormat(name); !ok || format != expected {
+			t.Errorf("unexpected format for %q. want=%q have=%q", name, expected, format)
+		}
+	}
+
+	if _, ok := ParseFormat("swid"); ok {
+		t.Errorf("expected unknown format to be rejected")
+	}
+}
@@ -0,0 +1,175 @@
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sbom/shared"
+)
+
+const spdxVersion = "SPDX-2.3"
+
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	SPDXID                string            `json:"SPDXID"`
+	Name                  string            `json:"name"`
+	VersionInfo           string            `json:"versionInfo,omitempty"`
+	DownloadLocation      string            `json:"downloadLocation"`
+	FilesAnalyzed         bool              `json:"filesAnalyzed"`
+	PrimaryPackagePurpose string            `json:"primaryPackagePurpose"`
+	ExternalRefs          []spdxExternalRef `json:"externalRefs,omitempty"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+	Comment           string `json:"comment,omitempty"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+const (
+	spdxDocumentID = "SPDXRef-DOCUMENT"
+	spdxRootID     = "SPDXRef-Repository"
+	spdxNoAssert   = "NOASSERTION"
+)
+
+// EncodeSPDX serializes the given document as an SPDX 2.3 JSON document. The document
+// namespace is rooted at the given external URL. SPDX 2.3 has no native VEX support, so
+// matched vulnerabilities are attached to the affected packages as security advisory
+// references.
+func EncodeSPDX(document shared.Document, externalURL string) ([]byte, error) {
+	ids := map[string]string{document.RootRef(): spdxRootID}
+	for i, component := range document.Components {
+		ids[component.Ref()] = fmt.Sprintf("SPDXRef-Package-%d", i+1)
+	}
+
+	advisories := map[string][]spdxExternalRef{}
+	for _, v := range document.Vulnerabilities {
+		if len(v.URLs) == 0 {
+			continue
+		}
+
+		for _, ref := range v.Affects {
+			advisories[ref] = append(advisories[ref], spdxExternalRef{
+				ReferenceCategory: "SECURITY",
+				ReferenceType:     "advisory",
+				ReferenceLocator:  v.URLs[0],
+				Comment:           spdxAdvisoryComment(v),
+			})
+		}
+	}
+
+	packages := make([]spdxPackage, 0, len(document.Components)+1)
+	packages = append(packages, spdxPackage{
+		SPDXID:                spdxRootID,
+		Name:                  document.RepositoryName,
+		VersionInfo:           document.Commit,
+		DownloadLocation:      spdxNoAssert,
+		PrimaryPackagePurpose: "APPLICATION",
+	})
+	for _, component := range document.Components {
+		var externalRefs []spdxExternalRef
+		if purl := component.PURL(); purl != "" {
+			externalRefs = append(externalRefs, spdxExternalRef{
+				ReferenceCategory: "PACKAGE-MANAGER",
+				ReferenceType:     "purl",
+				ReferenceLocator:  purl,
+			})
+		}
+		externalRefs = append(externalRefs, advisories[component.Ref()]...)
+
+		packages = append(packages, spdxPackage{
+			SPDXID:                ids[component.Ref()],
+			Name:                  component.Name,
+			VersionInfo:           component.Version,
+			DownloadLocation:      spdxNoAssert,
+			PrimaryPackagePurpose: "LIBRARY",
+			ExternalRefs:          externalRefs,
+		})
+	}
+
+	relationships := []spdxRelationship{{
+		SPDXElementID:      spdxDocumentID,
+		RelationshipType:   "DESCRIBES",
+		RelatedSPDXElement: spdxRootID,
+	}}
+	for _, dependency := range document.Dependencies {
+		for _, ref := range dependency.DependsOn {
+			from, ok1 := ids[dependency.Ref]
+			to, ok2 := ids[ref]
+			if !ok1 || !ok2 {
+				continue
+			}
+
+			relationships = append(relationships, spdxRelationship{
+				SPDXElementID:      from,
+				RelationshipType:   "DEPENDS_ON",
+				RelatedSPDXElement: to,
+			})
+		}
+	}
+
+	return json.MarshalIndent(spdxDocument{
+		SPDXVersion:       spdxVersion,
+		DataLicense:       "CC0-1.0",
+		SPDXID:            spdxDocumentID,
+		Name:              fmt.Sprintf("%s@%s", document.RepositoryName, document.Commit),
+		DocumentNamespace: spdxNamespace(document, externalURL),
+		CreationInfo: spdxCreationInfo{
+			Created:  document.CreatedAt.UTC().Format(time.RFC3339),
+			Creators: []string{"Organization: Sourcegraph", "Tool: sourcegraph"},
+		},
+		Packages:      packages,
+		Relationships: relationships,
+	}, "", "  ")
+}
+
+// spdxNamespace returns a unique URI for the given document.
+func spdxNamespace(document shared.Document, externalURL string) string {
+	return fmt.Sprintf(
+		"%s/.api/codeintel/sbom/%s/%s-%s",
+		strings.TrimSuffix(externalURL, "/"),
+		(&url.URL{Path: document.RepositoryName}).EscapedPath(),
+		document.Commit,
+		documentUUID(document),
+	)
+}
+
+func spdxAdvisoryComment(v shared.Vulnerability) string {
+	comment := v.SourceID
+	if v.Severity != "" {
+		comment += " (" + strings.ToLower(v.Severity) + ")"
+	}
+	if v.Summary != "" {
+		comment += ": " + v.Summary
+	}
+	if v.FixedIn != "" {
+		comment += "; fixed in " + v.FixedIn
+	}
+
+	return comment
+}
@@ -0,0 +1,59 @@
+package sbom
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEncodeSPDX(t *testing.T) {
+	payload, err := EncodeSPDX(testDocument(), "https://sourcegraph.test/")
+	if err != nil {
+		t.Fatalf("unexpected error encoding document: %s", err)
+	}
+
+	var document spdxDocument
+	if err := json.Unmarshal(payload, &document); err != nil {
+		t.Fatalf("unexpected error decoding document: %s", err)
+	}
+
+	if document.SPDXVersion != "SPDX-2.3" || document.DataLicense != "CC0-1.0" || document.SPDXID != "SPDXRef-DOCUMENT" {
+		t.Errorf("unexpected header: %s %s %s", document.SPDXVersion, document.DataLicense, document.SPDXID)
+	}
+	if !strings.HasPrefix(document.DocumentNamespace, "https://sourcegraph.test/.api/codeintel/sbom/github.com/sourcegraph/example/deadbeef-") {
+		t.Errorf("unexpected namespace: %s", document.DocumentNamespace)
+	}
+
+	// Root package followed by one package per component
+	if len(document.Packages) != 5 {
+		t.Fatalf("unexpected number of packages. want=%d have=%d", 5, len(document.Packages))
+	}
+
+	expectedPackage := spdxPackage{
+		SPDXID:                "SPDXRef-Package-2",
+		Name:                  "github.com/go-nacelle/config",
+		VersionInfo:           "v1.2.5",
+		DownloadLocation:      "NOASSERTION",
+		PrimaryPackagePurpose: "LIBRARY",
+		ExternalRefs: []spdxExternalRef{
+			{ReferenceCategory: "PACKAGE-MANAGER", ReferenceType: "purl", ReferenceLocator: "pkg:golang/github.com/go-nacelle/config@v1.2.5"},
+			{ReferenceCategory: "SECURITY", ReferenceType: "advisory", ReferenceLocator: "https://example.com/CVE-ABC", Comment: "CVE-ABC (high): bad config; fixed in v1.2.6"},
+		},
+	}
+	if diff := cmp.Diff(expectedPackage, document.Packages[2]); diff != "" {
+		t.Errorf("unexpected package (-want +got):\n%s", diff)
+	}
+
+	expectedRelationships := []spdxRelationship{
+		{SPDXElementID: "SPDXRef-DOCUMENT", RelationshipType: "DESCRIBES", RelatedSPDXElement: "SPDXRef-Repository"},
+		{SPDXElementID: "SPDXRef-Repository", RelationshipType: "DEPENDS_ON", RelatedSPDXElement: "SPDXRef-Package-1"},
+		{SPDXElementID: "SPDXRef-Repository", RelationshipType: "DEPENDS_ON", RelatedSPDXElement: "SPDXRef-Package-4"},
+		{SPDXElementID: "SPDXRef-Package-1", RelationshipType: "DEPENDS_ON", RelatedSPDXElement: "SPDXRef-Package-2"},
+		{SPDXElementID: "SPDXRef-Package-1", RelationshipType: "DEPENDS_ON", RelatedSPDXElement: "SPDXRef-Package-3"},
+	}
+	if diff := cmp.Diff(expectedRelationships, document.Relationships); diff != "" {
+		t.Errorf("unexpected relationships (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,17 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sbom/shared"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+type SBOMService interface {
+	GetDocument(ctx context.Context, repositoryID int, repositoryName, commit string) (shared.Document, error)
+}
+
+type RepoStore interface {
+	Get(ctx context.Context, id api.RepoID) (*types.Repo, error)
+}
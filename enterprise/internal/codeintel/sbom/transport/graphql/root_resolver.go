@@ -0,0 +1,146 @@
+package graphql
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sbom"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sbom/shared"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	resolverstubs "github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+type rootResolver struct {
+	sbomSvc         SBOMService
+	repoStore       RepoStore
+	gitserverClient gitserver.Client
+	operations      *operations
+}
+
+func NewRootResolver(
+	observationCtx *observation.Context,
+	sbomSvc SBOMService,
+	repoStore RepoStore,
+	gitserverClient gitserver.Client,
+) resolverstubs.SBOMServiceResolver {
+	return &rootResolver{
+		sbomSvc:         sbomSvc,
+		repoStore:       repoStore,
+		gitserverClient: gitserverClient,
+		operations:      newOperations(observationCtx),
+	}
+}
+
+func (r *rootResolver) SoftwareBillOfMaterials(ctx context.Context, args *resolverstubs.SoftwareBillOfMaterialsArgs) (_ resolverstubs.SoftwareBillOfMaterialsResolver, err error) {
+	ctx, _, endObservation := r.operations.softwareBillOfMaterials.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("repository", string(args.Repository)),
+		attribute.String("revision", pointers.Deref(args.Revision, "")),
+		attribute.String("format", args.Format),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	format, ok := shared.ParseFormat(args.Format)
+	if !ok {
+		return nil, errors.Newf("unsupported format %q", args.Format)
+	}
+
+	repositoryID, err := resolverstubs.UnmarshalID[api.RepoID](args.Repository)
+	if err != nil {
+		return nil, err
+	}
+
+	// 🚨 SECURITY: The repository store enforces that the current user can view the repository.
+	repo, err := r.repoStore.Get(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	commit, err := r.gitserverClient.ResolveRevision(ctx, repo.Name, pointers.Deref(args.Revision, "HEAD"), gitserver.ResolveRevisionOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := r.sbomSvc.GetDocument(ctx, int(repo.ID), string(repo.Name), string(commit))
+	if err != nil {
+		return nil, err
+	}
+
+	return &softwareBillOfMaterialsResolver{document: document, format: format}, nil
+}
+
+type softwareBillOfMaterialsResolver struct {
+	document shared.Document
+	format   shared.Format
+}
+
+func (r *softwareBillOfMaterialsResolver) Format() string {
+	return strings.ToUpper(string(r.format))
+}
+
+func (r *softwareBillOfMaterialsResolver) Commit() string {
+	return r.document.Commit
+}
+
+func (r *softwareBillOfMaterialsResolver) Components() []resolverstubs.SoftwareBillOfMaterialsComponentResolver {
+	resolvers := make([]resolverstubs.SoftwareBillOfMaterialsComponentResolver, 0, len(r.document.Components))
+	for _, component := range r.document.Components {
+		resolvers = append(resolvers, &componentResolver{component: component})
+	}
+
+	return resolvers
+}
+
+func (r *softwareBillOfMaterialsResolver) VulnerabilityCount() int32 {
+	return int32(len(r.document.Vulnerabilities))
+}
+
+func (r *softwareBillOfMaterialsResolver) Document() (string, error) {
+	var (
+		payload []byte
+		err     error
+	)
+	switch r.format {
+	case shared.FormatCycloneDX:
+		payload, err = sbom.EncodeCycloneDX(r.document)
+	case shared.FormatSPDX:
+		payload, err = sbom.EncodeSPDX(r.document, conf.ExternalURL())
+	default:
+		err = errors.Newf("unsupported format %q", r.format)
+	}
+
+	return string(payload), err
+}
+
+func (r *softwareBillOfMaterialsResolver) DownloadURL() string {
+	return strings.TrimSuffix(conf.ExternalURL(), "/") + "/.api/codeintel/sbom?" + url.Values{
+		"repository": []string{r.document.RepositoryName},
+		"commit":     []string{r.document.Commit},
+		"format":     []string{string(r.format)},
+	}.Encode()
+}
+
+type componentResolver struct {
+	component shared.Component
+}
+
+func (r *componentResolver) Name() string    { return r.component.Name }
+func (r *componentResolver) Version() string { return r.component.Version }
+func (r *componentResolver) Scheme() string  { return r.component.Scheme }
+func (r *componentResolver) Manager() string { return r.component.Manager }
+func (r *componentResolver) Provided() bool  { return r.component.Provided }
+
+func (r *componentResolver) PackageURL() *string {
+	if purl := r.component.PURL(); purl != "" {
+		return &purl
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sbom/shared"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+var contentTypes = map[shared.Format]string{
+	shared.FormatCycloneDX: "application/vnd.cyclonedx+json",
+	shared.FormatSPDX:      "application/spdx+json",
+}
+
+// newHandler returns a handler that serves the software bill of materials of the
+// repository and revision given by the `repository` and `commit` query parameters,
+// serialized in the format given by the optional `format` query parameter.
+func newHandler(repoStore RepoStore, svc SBOMService, operations *operations) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		ctx, _, endObservation := operations.export.With(r.Context(), &err, observation.Args{})
+		defer endObservation(1, observation.Args{})
+
+		query := r.URL.Query()
+		format, ok := shared.ParseFormat(query.Get("format"))
+		if !ok {
+			http.Error(w, fmt.Sprintf("unsupported format %q", query.Get("format")), http.StatusBadRequest)
+			return
+		}
+
+		repo, commit, statusCode, err := resolveRepoAndCommit(ctx, repoStore, query.Get("repository"), query.Get("commit"))
+		if err != nil {
+			http.Error(w, err.Error(), statusCode)
+			return
+		}
+
+		payload, err := svc.Export(ctx, int(repo.ID), string(repo.Name), string(commit), format)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", contentTypes[format])
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("%s-%s.%s.json", path.Base(string(repo.Name)), commit, format)))
+		_, _ = w.Write(payload)
+	})
+}
+
+func resolveRepoAndCommit(ctx context.Context, repoStore RepoStore, repoName, rev string) (*types.Repo, api.CommitID, int, error) {
+	if repoName == "" {
+		return nil, "", http.StatusBadRequest, errors.New("repository is required")
+	}
+	if rev == "" {
+		rev = "HEAD"
+	}
+
+	repo, err := repoStore.GetByName(ctx, api.RepoName(repoName))
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return nil, "", http.StatusNotFound, errors.Errorf("unknown repository %q", repoName)
+		}
+
+		return nil, "", http.StatusInternalServerError, err
+	}
+
+	commit, err := repoStore.ResolveRev(ctx, repo, rev)
+	if err != nil {
+		if errors.HasType(err, &gitdomain.RevisionNotFoundError{}) {
+			return nil, "", http.StatusNotFound, errors.Errorf("unknown revision %q", rev)
+		}
+		if gitdomain.IsCloneInProgress(err) {
+			return nil, "", http.StatusServiceUnavailable, errors.New("repository still cloning")
+		}
+
+		return nil, "", http.StatusInternalServerError, err
+	}
+
+	return repo, commit, 0, nil
+}
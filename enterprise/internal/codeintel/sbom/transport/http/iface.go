@@ -0,0 +1,18 @@
+package http
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sbom/shared"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+type RepoStore interface {
+	GetByName(ctx context.Context, name api.RepoName) (*types.Repo, error)
+	ResolveRev(ctx context.Context, repo *types.Repo, rev string) (api.CommitID, error)
+}
+
+type SBOMService interface {
+	Export(ctx context.Context, repositoryID int, repositoryName, commit string, format shared.Format) ([]byte, error)
+}
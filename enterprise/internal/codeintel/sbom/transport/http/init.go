@@ -0,0 +1,38 @@
+package http
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sbom"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+var (
+	handler     http.Handler
+	handlerOnce sync.Once
+)
+
+func GetHandler(svc *sbom.Service, db database.DB, gitserverClient gitserver.Client) http.Handler {
+	handlerOnce.Do(func() {
+		logger := log.Scoped(
+			"sbom.handler",
+			"codeintel sbom http handler",
+		)
+
+		observationCtx := observation.NewContext(logger)
+
+		// 🚨 SECURITY: Repositories are resolved with the actor of the request, so
+		// users can only export bills of materials for repositories they can see.
+		repoStore := backend.NewRepos(logger, db, gitserverClient)
+
+		handler = newHandler(repoStore, svc, newOperations(observationCtx))
+	})
+
+	return handler
+}
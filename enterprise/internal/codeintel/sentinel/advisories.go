@@ -0,0 +1,102 @@
+package sentinel
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func (s *Service) GetInternalAdvisories(ctx context.Context, args shared.GetInternalAdvisoriesArgs) ([]shared.InternalAdvisory, int, error) {
+	return s.store.GetInternalAdvisories(ctx, args)
+}
+
+func (s *Service) GetInternalAdvisoryByID(ctx context.Context, id int) (shared.InternalAdvisory, bool, error) {
+	return s.store.GetInternalAdvisoryByID(ctx, id)
+}
+
+// CreateInternalAdvisory validates and stores a new internal advisory. The advisory is
+// synced into the set of known vulnerabilities on the next run of the downloader.
+func (s *Service) CreateInternalAdvisory(ctx context.Context, advisory shared.Vulnerability) (shared.InternalAdvisory, error) {
+	advisory, err := normalizeInternalAdvisory(advisory)
+	if err != nil {
+		return shared.InternalAdvisory{}, err
+	}
+
+	id, err := s.store.CreateInternalAdvisory(ctx, advisory)
+	if err != nil {
+		return shared.InternalAdvisory{}, err
+	}
+
+	created, _, err := s.store.GetInternalAdvisoryByID(ctx, id)
+	return created, err
+}
+
+// UpdateInternalAdvisory validates and replaces the content of an existing internal advisory.
+func (s *Service) UpdateInternalAdvisory(ctx context.Context, id int, advisory shared.Vulnerability) (shared.InternalAdvisory, bool, error) {
+	advisory, err := normalizeInternalAdvisory(advisory)
+	if err != nil {
+		return shared.InternalAdvisory{}, false, err
+	}
+
+	if ok, err := s.store.UpdateInternalAdvisory(ctx, id, advisory); err != nil || !ok {
+		return shared.InternalAdvisory{}, false, err
+	}
+
+	return s.store.GetInternalAdvisoryByID(ctx, id)
+}
+
+// DeleteInternalAdvisory removes an internal advisory along with the vulnerability it was
+// synced into.
+func (s *Service) DeleteInternalAdvisory(ctx context.Context, id int) (bool, error) {
+	return s.store.DeleteInternalAdvisory(ctx, id)
+}
+
+var validSeverities = []string{"LOW", "MEDIUM", "HIGH", "CRITICAL"}
+
+// normalizeInternalAdvisory returns an error if the given advisory could not be matched
+// against indexes. Otherwise, it returns the advisory with normalized field values.
+func normalizeInternalAdvisory(advisory shared.Vulnerability) (shared.Vulnerability, error) {
+	advisory.SourceID = strings.TrimSpace(advisory.SourceID)
+	if advisory.SourceID == "" {
+		return shared.Vulnerability{}, errors.New("source identifier must not be empty")
+	}
+	if strings.TrimSpace(advisory.Summary) == "" {
+		return shared.Vulnerability{}, errors.New("summary must not be empty")
+	}
+
+	if advisory.Severity != "" {
+		advisory.Severity = strings.ToUpper(advisory.Severity)
+
+		valid := false
+		for _, severity := range validSeverities {
+			if advisory.Severity == severity {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return shared.Vulnerability{}, errors.Newf("invalid severity %q: must be one of %s", advisory.Severity, strings.Join(validSeverities, ", "))
+		}
+	}
+
+	if len(advisory.AffectedPackages) == 0 {
+		return shared.Vulnerability{}, errors.New("at least one affected package must be supplied")
+	}
+	for _, pkg := range advisory.AffectedPackages {
+		if pkg.PackageName == "" || pkg.Language == "" {
+			return shared.Vulnerability{}, errors.New("affected packages must supply a package name and language")
+		}
+
+		if len(pkg.VersionConstraint) > 0 {
+			if _, err := version.NewConstraint(strings.Join(pkg.VersionConstraint, ",")); err != nil {
+				return shared.Vulnerability{}, errors.Wrapf(err, "invalid version constraint for package %q", pkg.PackageName)
+			}
+		}
+	}
+
+	return advisory, nil
+}
@@ -4,33 +4,44 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/background"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/background/downloader"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/background/matcher"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/background/reachability"
 	sentinelstore "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/store"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
 )
 
 func NewService(
 	observationCtx *observation.Context,
 	db database.DB,
+	codenavSvc CodeNavService,
 ) *Service {
 	return newService(
 		scopedContext("service", observationCtx),
 		sentinelstore.New(scopedContext("store", observationCtx), db),
+		codenavSvc,
 	)
 }
 
 var (
-	DownloaderConfigInst = &downloader.Config{}
-	MatcherConfigInst    = &matcher.Config{}
+	DownloaderConfigInst   = &downloader.Config{}
+	MatcherConfigInst      = &matcher.Config{}
+	ReachabilityConfigInst = &reachability.Config{}
 )
 
-func CVEScannerJob(observationCtx *observation.Context, service *Service) []goroutine.BackgroundRoutine {
+// CVEScannerJob returns the routines that sync, match, and analyze vulnerabilities. The
+// upload store is used to read the advisory archive named by the downloader config, and
+// may be nil if no such archive is configured.
+func CVEScannerJob(observationCtx *observation.Context, service *Service, uploadStore uploadstore.Store) []goroutine.BackgroundRoutine {
 	return background.CVEScannerJob(
 		scopedContext("cvescanner", observationCtx),
 		service.store,
+		service.codenavSvc,
+		uploadStore,
 		DownloaderConfigInst,
 		MatcherConfigInst,
+		ReachabilityConfigInst,
 	)
 }
 
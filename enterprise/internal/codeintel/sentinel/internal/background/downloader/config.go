@@ -1,17 +1,38 @@
 package downloader
 
 import (
+	"strings"
 	"time"
 
 	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 type Config struct {
 	env.BaseConfig
 
 	DownloaderInterval time.Duration
+	Sources            []string
+	LocalAdvisoryDir   string
+	AdvisoryArchiveKey string
 }
 
 func (c *Config) Load() {
 	c.DownloaderInterval = c.GetInterval("CODEINTEL_SENTINEL_DOWNLOADER_INTERVAL", "1h", "How frequently to sync the vulnerability database.")
+	c.LocalAdvisoryDir = c.GetOptional("CODEINTEL_SENTINEL_LOCAL_ADVISORY_DIR", "A directory of OSV-formatted advisories (JSON files or zip archives of JSON files) to sync into the vulnerability database.")
+	c.AdvisoryArchiveKey = c.GetOptional("CODEINTEL_SENTINEL_ADVISORY_ARCHIVE_KEY", "The key of a zip archive of OSV-formatted advisories within the precise code intel upload bucket to sync into the vulnerability database.")
+
+	sources := c.Get("CODEINTEL_SENTINEL_SOURCES", SourceGitHub, "A comma-separated list of public advisory databases to sync into the vulnerability database (github, govulndb). Set to none to disable public databases, e.g., in air-gapped deployments.")
+	if sources == "none" {
+		return
+	}
+	for _, source := range strings.Split(sources, ",") {
+		switch source = strings.ToLower(strings.TrimSpace(source)); source {
+		case SourceGitHub, SourceGovulndb:
+			c.Sources = append(c.Sources, source)
+		case "":
+		default:
+			c.AddError(errors.Errorf("invalid source %q for CODEINTEL_SENTINEL_SOURCES: must be github, govulndb, or none", source))
+		}
+	}
 }
@@ -0,0 +1,128 @@
+package downloader
+
+import (
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+)
+
+// mergeAliasedVulnerabilities merges vulnerabilities that describe the same issue. Two
+// vulnerabilities describe the same issue when the identifier or aliases of one overlap
+// with those of the other (e.g., a GHSA advisory and a Go advisory that both alias the
+// same CVE). Overlap is transitive.
+//
+// The given vulnerabilities are expected in order of preference. The identifier and data
+// of the first vulnerability of each group is kept; the remaining members contribute their
+// identifiers as aliases, along with any packages and fields missing from the first.
+func mergeAliasedVulnerabilities(vulnerabilities []shared.Vulnerability) []shared.Vulnerability {
+	parents := make([]int, len(vulnerabilities))
+	for i := range parents {
+		parents[i] = i
+	}
+
+	var find func(i int) int
+	find = func(i int) int {
+		if parents[i] != i {
+			parents[i] = find(parents[i])
+		}
+
+		return parents[i]
+	}
+
+	union := func(i, j int) {
+		ri, rj := find(i), find(j)
+		if ri > rj {
+			ri, rj = rj, ri
+		}
+
+		// Keep the most preferred vulnerability as the root of the group
+		parents[rj] = ri
+	}
+
+	owners := map[string]int{}
+	for i, v := range vulnerabilities {
+		for _, id := range append([]string{v.SourceID}, v.Aliases...) {
+			if id == "" {
+				continue
+			}
+
+			if j, ok := owners[id]; ok {
+				union(i, j)
+			} else {
+				owners[id] = i
+			}
+		}
+	}
+
+	merged := make([]shared.Vulnerability, 0, len(vulnerabilities))
+	indexes := map[int]int{}
+	for i, v := range vulnerabilities {
+		root := find(i)
+		if root == i {
+			indexes[i] = len(merged)
+			merged = append(merged, v)
+			continue
+		}
+
+		mergeVulnerability(&merged[indexes[root]], v)
+	}
+
+	return merged
+}
+
+// mergeVulnerability folds the identifiers and missing data of other into v.
+func mergeVulnerability(v *shared.Vulnerability, other shared.Vulnerability) {
+	aliases := append([]string{other.SourceID}, other.Aliases...)
+	v.Aliases = unionStrings(v.Aliases, aliases, v.SourceID)
+	v.Related = unionStrings(v.Related, other.Related, v.SourceID)
+	v.CPEs = unionStrings(v.CPEs, other.CPEs, "")
+	v.CWEs = unionStrings(v.CWEs, other.CWEs, "")
+	v.URLs = unionStrings(v.URLs, other.URLs, "")
+
+	for _, field := range []struct {
+		value *string
+		other string
+	}{
+		{&v.Summary, other.Summary},
+		{&v.Details, other.Details},
+		{&v.DataSource, other.DataSource},
+		{&v.Severity, other.Severity},
+		{&v.CVSSVector, other.CVSSVector},
+		{&v.CVSSScore, other.CVSSScore},
+	} {
+		if *field.value == "" {
+			*field.value = field.other
+		}
+	}
+	if v.PublishedAt.IsZero() {
+		v.PublishedAt = other.PublishedAt
+	}
+
+outer:
+	for _, pkg := range other.AffectedPackages {
+		for _, existing := range v.AffectedPackages {
+			if existing.Language == pkg.Language && existing.PackageName == pkg.PackageName {
+				continue outer
+			}
+		}
+
+		v.AffectedPackages = append(v.AffectedPackages, pkg)
+	}
+}
+
+// unionStrings appends the values of b missing from a, skipping the given excluded value.
+func unionStrings(a, b []string, exclude string) []string {
+	seen := make(map[string]struct{}, len(a))
+	for _, value := range a {
+		seen[value] = struct{}{}
+	}
+
+	for _, value := range b {
+		if _, ok := seen[value]; ok || value == "" || value == exclude {
+			continue
+		}
+
+		seen[value] = struct{}{}
+		a = append(a, value)
+	}
+
+	return a
+}
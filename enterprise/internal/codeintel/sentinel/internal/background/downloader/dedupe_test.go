@@ -0,0 +1,64 @@
+package downloader
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+)
+
+func TestMergeAliasedVulnerabilities(t *testing.T) {
+	vulnerabilities := []shared.Vulnerability{
+		{
+			SourceID: "ACME-2023-0001",
+			Summary:  "Internal summary",
+			Aliases:  []string{"CVE-2023-0001"},
+			AffectedPackages: []shared.AffectedPackage{
+				{PackageName: "golang.org/x/net", Language: "go", Namespace: "internal"},
+			},
+		},
+		{
+			SourceID: "GHSA-aaaa-bbbb-cccc",
+			Summary:  "GitHub summary",
+			Severity: "HIGH",
+			Aliases:  []string{"CVE-2023-0001"},
+			URLs:     []string{"https://github.com/advisories/GHSA-aaaa-bbbb-cccc"},
+			AffectedPackages: []shared.AffectedPackage{
+				{PackageName: "golang.org/x/net", Language: "go", Namespace: "github:Go"},
+				{PackageName: "golang.org/x/text", Language: "go", Namespace: "github:Go"},
+			},
+		},
+		{
+			SourceID: "GHSA-dddd-eeee-ffff",
+			Summary:  "Unrelated",
+		},
+		{
+			// Related to the first only transitively via the GHSA identifier
+			SourceID: "GO-2023-0001",
+			Aliases:  []string{"GHSA-aaaa-bbbb-cccc"},
+			URLs:     []string{"https://github.com/advisories/GHSA-aaaa-bbbb-cccc", "https://pkg.go.dev/vuln/GO-2023-0001"},
+		},
+	}
+
+	expected := []shared.Vulnerability{
+		{
+			SourceID: "ACME-2023-0001",
+			Summary:  "Internal summary",
+			Severity: "HIGH",
+			Aliases:  []string{"CVE-2023-0001", "GHSA-aaaa-bbbb-cccc", "GO-2023-0001"},
+			URLs:     []string{"https://github.com/advisories/GHSA-aaaa-bbbb-cccc", "https://pkg.go.dev/vuln/GO-2023-0001"},
+			AffectedPackages: []shared.AffectedPackage{
+				{PackageName: "golang.org/x/net", Language: "go", Namespace: "internal"},
+				{PackageName: "golang.org/x/text", Language: "go", Namespace: "github:Go"},
+			},
+		},
+		{
+			SourceID: "GHSA-dddd-eeee-ffff",
+			Summary:  "Unrelated",
+		},
+	}
+	if diff := cmp.Diff(expected, mergeAliasedVulnerabilities(vulnerabilities)); diff != "" {
+		t.Errorf("unexpected vulnerabilities (-want +got):\n%s", diff)
+	}
+}
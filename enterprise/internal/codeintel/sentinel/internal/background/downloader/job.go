@@ -10,33 +10,36 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
-func NewCVEDownloader(store store.Store, observationCtx *observation.Context, config *Config) goroutine.BackgroundRoutine {
+// NewCVEDownloader returns a routine that periodically syncs advisories from the enabled
+// sources into Postgres. The upload store is optional and only read when an advisory
+// archive key is configured.
+func NewCVEDownloader(store store.Store, uploadStore uploadstore.Store, observationCtx *observation.Context, config *Config) goroutine.BackgroundRoutine {
 	cveParser := &CVEParser{
 		store:  store,
 		logger: log.Scoped("sentinel.parser", ""),
 	}
+	sources := newSources(cveParser, store, uploadStore, config)
 	metrics := newMetrics(observationCtx)
 
 	return goroutine.NewPeriodicGoroutine(
 		actor.WithInternalActor(context.Background()),
 		goroutine.HandlerFunc(func(ctx context.Context) error {
-			vulnerabilities, err := cveParser.handle(ctx)
-			if err != nil {
-				return err
-			}
+			vulnerabilities, readErr := cveParser.handle(ctx, sources, metrics)
 
 			numVulnerabilitiesInserted, err := store.InsertVulnerabilities(ctx, vulnerabilities)
 			if err != nil {
-				return err
+				return errors.Append(readErr, err)
 			}
 
 			metrics.numVulnerabilitiesInserted.Add(float64(numVulnerabilitiesInserted))
-			return nil
+			return readErr
 		}),
 		goroutine.WithName("codeintel.sentinel-cve-downloader"),
-		goroutine.WithDescription("Periodically syncs GitHub advisory records into Postgres."),
+		goroutine.WithDescription("Periodically syncs vulnerability advisories into Postgres."),
 		goroutine.WithInterval(config.DownloaderInterval),
 	)
 }
@@ -52,6 +55,21 @@ func NewCVEParser() *CVEParser {
 	}
 }
 
-func (parser *CVEParser) handle(ctx context.Context) ([]shared.Vulnerability, error) {
-	return parser.ReadGitHubAdvisoryDB(ctx, false)
+// handle reads the advisories of each source and merges those describing the same issue.
+// A source that cannot be read does not prevent the advisories of the remaining sources
+// from being synced.
+func (parser *CVEParser) handle(ctx context.Context, sources []Source, metrics *metrics) (_ []shared.Vulnerability, errs error) {
+	var vulnerabilities []shared.Vulnerability
+	for _, source := range sources {
+		vulns, err := source.Read(ctx)
+		if err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "failed to read %s advisories", source.Name()))
+			continue
+		}
+
+		metrics.numAdvisoriesRead.WithLabelValues(source.Name()).Add(float64(len(vulns)))
+		vulnerabilities = append(vulnerabilities, vulns...)
+	}
+
+	return mergeAliasedVulnerabilities(vulnerabilities), errs
 }
@@ -7,6 +7,7 @@ import (
 )
 
 type metrics struct {
+	numAdvisoriesRead          *prometheus.CounterVec
 	numVulnerabilitiesInserted prometheus.Counter
 }
 
@@ -21,12 +22,19 @@ func newMetrics(observationCtx *observation.Context) *metrics {
 		return counter
 	}
 
+	numAdvisoriesRead := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "src_codeintel_sentinel_num_advisories_read_total",
+		Help: "The number of advisories read from each vulnerability source.",
+	}, []string{"source"})
+	observationCtx.Registerer.MustRegister(numAdvisoriesRead)
+
 	numVulnerabilitiesInserted := counter(
 		"src_codeintel_sentinel_num_vulnerabilities_inserted_total",
-		"The number of vulnerability records inserted into Postgres.",
+		"The number of vulnerability records inserted or updated in Postgres.",
 	)
 
 	return &metrics{
+		numAdvisoriesRead:          numAdvisoriesRead,
 		numVulnerabilitiesInserted: numVulnerabilitiesInserted,
 	}
 }
@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/mitchellh/mapstructure"
@@ -104,6 +105,11 @@ type GHSADatabaseSpecific struct {
 	CweIDs                 []string  `mapstructure:"cwe_ids" json:"cwe_ids"`
 }
 
+// GHSAAffectedEcosystemSpecific represents the custom data format used by GHSA for OSV.Affected.EcosystemSpecific
+type GHSAAffectedEcosystemSpecific struct {
+	AffectedFunctions []string `mapstructure:"affected_functions" json:"affected_functions"`
+}
+
 type GHSA int64
 
 func (g GHSA) topLevelHandler(o OSV, v *shared.Vulnerability) (err error) {
@@ -148,9 +154,47 @@ func (g GHSA) affectedHandler(a OSVAffected, affectedPackage *shared.AffectedPac
 	affectedPackage.Language = githubEcosystemToLanguage(a.Package.Ecosystem)
 	affectedPackage.Namespace = "github:" + a.Package.Ecosystem
 
+	if a.EcosystemSpecific == nil || a.Package.Ecosystem != "Go" {
+		// Affected functions of other ecosystems are not qualified consistently
+		// enough to be split into a package path and symbol
+		return nil
+	}
+
+	var es GHSAAffectedEcosystemSpecific
+	if err := mapstructure.Decode(a.EcosystemSpecific, &es); err != nil {
+		return errors.Wrap(err, "cannot map EcosystemSpecific to GHSAAffectedEcosystemSpecific")
+	}
+
+	affectedPackage.AffectedSymbols = goAffectedFunctionsToSymbols(es.AffectedFunctions)
 	return nil
 }
 
+// goAffectedFunctionsToSymbols groups fully-qualified Go functions and methods (e.g.,
+// `golang.org/x/net/html.Tokenizer.Next`) by their import path.
+func goAffectedFunctionsToSymbols(affectedFunctions []string) []shared.AffectedSymbol {
+	var affectedSymbols []shared.AffectedSymbol
+	indexes := map[string]int{}
+
+	for _, affectedFunction := range affectedFunctions {
+		slash := strings.LastIndex(affectedFunction, "/")
+		dot := strings.Index(affectedFunction[slash+1:], ".")
+		if dot < 0 {
+			continue
+		}
+		path, symbol := affectedFunction[:slash+1+dot], affectedFunction[slash+1+dot+1:]
+
+		i, ok := indexes[path]
+		if !ok {
+			i = len(affectedSymbols)
+			indexes[path] = i
+			affectedSymbols = append(affectedSymbols, shared.AffectedSymbol{Path: path})
+		}
+		affectedSymbols[i].Symbols = append(affectedSymbols[i].Symbols, symbol)
+	}
+
+	return affectedSymbols
+}
+
 // GHSAUnreviewedError is used to indicate when a vulnerability has not been reviewed, and should be skipped
 type GHSAUnreviewedError struct {
 	msg string
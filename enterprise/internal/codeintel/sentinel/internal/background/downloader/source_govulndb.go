@@ -19,7 +19,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
-const govulndbAdvisoryDatabaseURL = "https://github.com/golang/vuln/archive/refs/heads/master.zip"
+const govulndbAdvisoryDatabaseURL = "https://github.com/golang/vulndb/archive/refs/heads/master.zip"
 
 // ReadGoVulnDb fetches a copy of the Go Vulnerability Database and converts it to the internal Vulnerability format
 func (parser *CVEParser) ReadGoVulnDb(ctx context.Context, useLocalCache bool) (vulns []shared.Vulnerability, err error) {
@@ -42,7 +42,7 @@ func (parser *CVEParser) ReadGoVulnDb(ctx context.Context, useLocalCache bool) (
 		return nil, errors.Newf("unexpected status code %d", resp.StatusCode)
 	}
 
-	return parser.ParseGitHubAdvisoryDB(resp.Body)
+	return parser.ParseGovulndbAdvisoryDB(resp.Body)
 }
 
 func (parser *CVEParser) ParseGovulndbAdvisoryDB(govulndbReader io.Reader) (vulns []shared.Vulnerability, err error) {
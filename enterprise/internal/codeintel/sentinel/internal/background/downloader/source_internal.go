@@ -0,0 +1,58 @@
+package downloader
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+)
+
+const internalAdvisoriesPageSize = 1000
+
+// internalSource reads the advisories authored on this instance.
+type internalSource struct {
+	store store.Store
+}
+
+func (s *internalSource) Name() string {
+	return "internal"
+}
+
+func (s *internalSource) Read(ctx context.Context) (vulns []shared.Vulnerability, _ error) {
+	for offset := 0; ; offset += internalAdvisoriesPageSize {
+		advisories, totalCount, err := s.store.GetInternalAdvisories(ctx, shared.GetInternalAdvisoriesArgs{
+			Limit:  internalAdvisoriesPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, advisory := range advisories {
+			vulns = append(vulns, internalAdvisoryToVuln(advisory))
+		}
+
+		if len(advisories) == 0 || offset+len(advisories) >= totalCount {
+			return vulns, nil
+		}
+	}
+}
+
+func internalAdvisoryToVuln(advisory shared.InternalAdvisory) shared.Vulnerability {
+	v := advisory.Advisory
+	if v.PublishedAt.IsZero() {
+		v.PublishedAt = advisory.CreatedAt
+	}
+	if v.ModifiedAt == nil {
+		updatedAt := advisory.UpdatedAt
+		v.ModifiedAt = &updatedAt
+	}
+
+	for i := range v.AffectedPackages {
+		if v.AffectedPackages[i].Namespace == "" {
+			v.AffectedPackages[i].Namespace = "internal"
+		}
+	}
+
+	return v
+}
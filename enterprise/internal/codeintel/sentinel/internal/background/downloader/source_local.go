@@ -0,0 +1,226 @@
+package downloader
+
+// Read vulnerabilities from OSV feeds maintained alongside the instance, either as a
+// directory of advisories on local disk or as an archive within the upload store. These
+// feeds allow air-gapped instances to mirror public databases and to publish advisories
+// that are not (yet) public.
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// directorySource reads OSV advisories from JSON files and zip archives of JSON files
+// found (recursively) within a directory.
+type directorySource struct {
+	parser *CVEParser
+	dir    string
+}
+
+func (s *directorySource) Name() string {
+	return "directory"
+}
+
+func (s *directorySource) Read(ctx context.Context) (vulns []shared.Vulnerability, err error) {
+	err = filepath.WalkDir(s.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		switch filepath.Ext(path) {
+		case ".json":
+			f, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			if v, ok := s.parser.parseOSVFeedAdvisory(f, path); ok {
+				vulns = append(vulns, v)
+			}
+
+		case ".zip":
+			f, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			archiveVulns, err := s.parser.ParseOSVFeedArchive(f)
+			if err != nil {
+				return errors.Wrapf(err, "failed to read archive %q", path)
+			}
+			vulns = append(vulns, archiveVulns...)
+		}
+
+		return nil
+	})
+
+	return vulns, err
+}
+
+// uploadStoreSource reads OSV advisories from a zip archive of JSON files stored under a
+// fixed key in the upload store.
+type uploadStoreSource struct {
+	parser      *CVEParser
+	uploadStore uploadstore.Store
+	key         string
+}
+
+func (s *uploadStoreSource) Name() string {
+	return "uploadstore"
+}
+
+func (s *uploadStoreSource) Read(ctx context.Context) ([]shared.Vulnerability, error) {
+	rc, err := s.uploadStore.Get(ctx, s.key)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	return s.parser.ParseOSVFeedArchive(rc)
+}
+
+// ParseOSVFeedArchive converts the OSV advisories within a zip archive to the internal
+// Vulnerability format. Advisories that cannot be parsed are skipped.
+func (parser *CVEParser) ParseOSVFeedArchive(archiveReader io.Reader) (vulns []shared.Vulnerability, err error) {
+	content, err := io.ReadAll(archiveReader)
+	if err != nil {
+		return nil, err
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range zr.File {
+		if filepath.Ext(f.Name) != ".json" {
+			continue
+		}
+
+		r, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+
+		if v, ok := parser.parseOSVFeedAdvisory(r, f.Name); ok {
+			vulns = append(vulns, v)
+		}
+	}
+
+	return vulns, nil
+}
+
+// parseOSVFeedAdvisory converts a single OSV advisory. Malformed advisories are logged and
+// skipped so that a single bad file does not block the remainder of the feed.
+func (parser *CVEParser) parseOSVFeedAdvisory(r io.Reader, name string) (shared.Vulnerability, bool) {
+	var osvVuln OSV
+	if err := json.NewDecoder(r).Decode(&osvVuln); err != nil {
+		parser.logger.Warn(
+			"could not decode OSV advisory",
+			log.String("type", "dataWarning"),
+			log.String("file", name),
+			log.Error(err),
+		)
+		return shared.Vulnerability{}, false
+	}
+
+	var f OSVFeed
+	v, err := parser.osvToVuln(osvVuln, f)
+	if err != nil {
+		parser.logger.Warn(
+			"could not convert OSV advisory",
+			log.String("type", "dataWarning"),
+			log.String("file", name),
+			log.String("sourceID", osvVuln.ID),
+			log.Error(err),
+		)
+		return shared.Vulnerability{}, false
+	}
+
+	return v, true
+}
+
+//
+// Handlers for OSV feeds
+//
+
+// OSVFeedDatabaseSpecific represents the subset of GHSA's custom top-level data that is
+// commonly reproduced by mirrors and hand-authored advisories.
+type OSVFeedDatabaseSpecific struct {
+	Severity string   `mapstructure:"severity" json:"severity"`
+	CweIDs   []string `mapstructure:"cwe_ids" json:"cwe_ids"`
+}
+
+// OSVFeedAffectedEcosystemSpecific accepts affected Go symbols in either the Govulndb or
+// the GHSA format.
+type OSVFeedAffectedEcosystemSpecific struct {
+	GovulndbAffectedEcosystemSpecific `mapstructure:",squash"`
+	GHSAAffectedEcosystemSpecific     `mapstructure:",squash"`
+}
+
+// OSVFeed handles advisories of feeds without provider-specific extensions.
+type OSVFeed int64
+
+func (f OSVFeed) topLevelHandler(o OSV, v *shared.Vulnerability) error {
+	var databaseSpecific OSVFeedDatabaseSpecific
+	if err := mapstructure.Decode(o.DatabaseSpecific, &databaseSpecific); err != nil {
+		return errors.Wrap(err, "cannot map DatabaseSpecific to OSVFeedDatabaseSpecific")
+	}
+
+	v.Severity = databaseSpecific.Severity
+	v.CWEs = databaseSpecific.CweIDs
+
+	for _, reference := range o.References {
+		if reference.Type == "ADVISORY" {
+			v.DataSource = reference.URL
+			break
+		}
+	}
+
+	return nil
+}
+
+func (f OSVFeed) affectedHandler(a OSVAffected, affectedPackage *shared.AffectedPackage) error {
+	affectedPackage.Language = githubEcosystemToLanguage(a.Package.Ecosystem)
+	affectedPackage.Namespace = "osv:" + a.Package.Ecosystem
+
+	if a.EcosystemSpecific == nil || a.Package.Ecosystem != "Go" {
+		return nil
+	}
+
+	var es OSVFeedAffectedEcosystemSpecific
+	if err := mapstructure.Decode(a.EcosystemSpecific, &es); err != nil {
+		return errors.Wrap(err, "cannot map EcosystemSpecific to OSVFeedAffectedEcosystemSpecific")
+	}
+
+	for _, i := range es.Imports {
+		affectedPackage.AffectedSymbols = append(affectedPackage.AffectedSymbols, shared.AffectedSymbol{
+			Path:    i.Path,
+			Symbols: i.Symbols,
+		})
+	}
+	if len(affectedPackage.AffectedSymbols) == 0 {
+		affectedPackage.AffectedSymbols = goAffectedFunctionsToSymbols(es.AffectedFunctions)
+	}
+
+	return nil
+}
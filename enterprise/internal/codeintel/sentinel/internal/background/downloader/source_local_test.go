@@ -0,0 +1,132 @@
+package downloader
+
+import (
+	"archive/zip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+)
+
+const testLocalAdvisory = `{
+	"id": "ACME-2023-0001",
+	"aliases": ["CVE-2023-0001"],
+	"summary": "Unbounded allocation in widget parser",
+	"published": "2023-06-01T00:00:00Z",
+	"references": [{"type": "ADVISORY", "url": "https://security.acme.internal/ACME-2023-0001"}],
+	"database_specific": {"severity": "HIGH", "cwe_ids": ["CWE-770"]},
+	"affected": [{
+		"package": {"ecosystem": "Go", "name": "acme.internal/widgets"},
+		"ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}, {"fixed": "1.2.0"}]}],
+		"ecosystem_specific": {"imports": [{"path": "acme.internal/widgets/parse", "symbols": ["Parse"]}]}
+	}]
+}`
+
+const testArchivedAdvisory = `{
+	"id": "GHSA-aaaa-bbbb-cccc",
+	"summary": "Prototype pollution",
+	"affected": [{
+		"package": {"ecosystem": "npm", "name": "left-pad"},
+		"ranges": [{"type": "SEMVER", "events": [{"introduced": "1.0.0"}, {"last_affected": "1.3.0"}]}]
+	}]
+}`
+
+func TestDirectorySource(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "nested"), os.ModePerm); err != nil {
+		t.Fatalf("unexpected error creating directory: %s", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "ACME-2023-0001.json"), []byte(testLocalAdvisory), os.ModePerm); err != nil {
+		t.Fatalf("unexpected error writing advisory: %s", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "malformed.json"), []byte(`{`), os.ModePerm); err != nil {
+		t.Fatalf("unexpected error writing advisory: %s", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte(`# Advisories`), os.ModePerm); err != nil {
+		t.Fatalf("unexpected error writing readme: %s", err)
+	}
+	writeTestArchive(t, filepath.Join(dir, "nested", "mirror.zip"), map[string]string{
+		"advisories/GHSA-aaaa-bbbb-cccc.json": testArchivedAdvisory,
+	})
+
+	source := &directorySource{
+		parser: &CVEParser{logger: logtest.Scoped(t)},
+		dir:    dir,
+	}
+	vulnerabilities, err := source.Read(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error reading advisories: %s", err)
+	}
+
+	fixedIn := "1.2.0"
+	if len(vulnerabilities) != 2 {
+		t.Fatalf("unexpected number of vulnerabilities. want=%d have=%d", 2, len(vulnerabilities))
+	}
+	if diff := cmp.Diff(shared.Vulnerability{
+		SourceID:   "ACME-2023-0001",
+		Summary:    "Unbounded allocation in widget parser",
+		Aliases:    []string{"CVE-2023-0001"},
+		CWEs:       []string{"CWE-770"},
+		DataSource: "https://security.acme.internal/ACME-2023-0001",
+		URLs:       []string{"https://security.acme.internal/ACME-2023-0001"},
+		Severity:   "HIGH",
+		AffectedPackages: []shared.AffectedPackage{{
+			PackageName:       "acme.internal/widgets",
+			Language:          "go",
+			Namespace:         "osv:Go",
+			VersionConstraint: []string{">=0", "<1.2.0"},
+			Fixed:             true,
+			FixedIn:           &fixedIn,
+			AffectedSymbols: []shared.AffectedSymbol{
+				{Path: "acme.internal/widgets/parse", Symbols: []string{"Parse"}},
+			},
+		}},
+	}, withoutTimestamps(vulnerabilities[0])); diff != "" {
+		t.Errorf("unexpected vulnerability (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(shared.Vulnerability{
+		SourceID: "GHSA-aaaa-bbbb-cccc",
+		Summary:  "Prototype pollution",
+		AffectedPackages: []shared.AffectedPackage{{
+			PackageName:       "left-pad",
+			Language:          "Javascript",
+			Namespace:         "osv:npm",
+			VersionConstraint: []string{">=1.0.0", "<=1.3.0"},
+		}},
+	}, withoutTimestamps(vulnerabilities[1])); diff != "" {
+		t.Errorf("unexpected vulnerability (-want +got):\n%s", diff)
+	}
+}
+
+func writeTestArchive(t *testing.T, path string, files map[string]string) {
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("unexpected error creating archive: %s", err)
+	}
+	defer f.Close()
+
+	zw := zip.NewWriter(f)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("unexpected error creating archive entry: %s", err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatalf("unexpected error writing archive entry: %s", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("unexpected error closing archive: %s", err)
+	}
+}
+
+func withoutTimestamps(v shared.Vulnerability) shared.Vulnerability {
+	v.PublishedAt, v.ModifiedAt, v.WithdrawnAt = time.Time{}, nil, nil
+	return v
+}
@@ -0,0 +1,73 @@
+package downloader
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+)
+
+// Source is a feed of vulnerability advisories.
+type Source interface {
+	// Name identifies the source in logs and metrics.
+	Name() string
+
+	// Read returns the advisories currently published by the source.
+	Read(ctx context.Context) ([]shared.Vulnerability, error)
+}
+
+// Names of the public advisory databases that can be enabled via CODEINTEL_SENTINEL_SOURCES.
+const (
+	SourceGitHub   = "github"
+	SourceGovulndb = "govulndb"
+)
+
+// newSources returns the sources enabled by the given config in order of preference.
+// Advisories authored on this instance are preferred over local feeds, which are in turn
+// preferred over public databases.
+func newSources(parser *CVEParser, store store.Store, uploadStore uploadstore.Store, config *Config) []Source {
+	sources := []Source{&internalSource{store: store}}
+
+	if config.LocalAdvisoryDir != "" {
+		sources = append(sources, &directorySource{parser: parser, dir: config.LocalAdvisoryDir})
+	}
+	if uploadStore != nil && config.AdvisoryArchiveKey != "" {
+		sources = append(sources, &uploadStoreSource{parser: parser, uploadStore: uploadStore, key: config.AdvisoryArchiveKey})
+	}
+
+	for _, name := range config.Sources {
+		switch name {
+		case SourceGitHub:
+			sources = append(sources, &githubSource{parser: parser})
+		case SourceGovulndb:
+			sources = append(sources, &govulndbSource{parser: parser})
+		}
+	}
+
+	return sources
+}
+
+type githubSource struct {
+	parser *CVEParser
+}
+
+func (s *githubSource) Name() string {
+	return SourceGitHub
+}
+
+func (s *githubSource) Read(ctx context.Context) ([]shared.Vulnerability, error) {
+	return s.parser.ReadGitHubAdvisoryDB(ctx, false)
+}
+
+type govulndbSource struct {
+	parser *CVEParser
+}
+
+func (s *govulndbSource) Name() string {
+	return SourceGovulndb
+}
+
+func (s *govulndbSource) Read(ctx context.Context) ([]shared.Vulnerability, error) {
+	return s.parser.ReadGoVulnDb(ctx, false)
+}
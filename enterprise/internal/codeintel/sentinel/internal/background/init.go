@@ -5,23 +5,29 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/background/downloader"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/background/matcher"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/background/reachability"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/store"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
 )
 
 func CVEScannerJob(
 	observationCtx *observation.Context,
 	store store.Store,
+	codenavSvc reachability.CodeNavService,
+	uploadStore uploadstore.Store,
 	downloaderConfig *downloader.Config,
 	matcherConfig *matcher.Config,
+	reachabilityConfig *reachability.Config,
 ) []goroutine.BackgroundRoutine {
 	if os.Getenv("RUN_EXPERIMENTAL_SENTINEL_JOBS") != "true" {
 		return nil
 	}
 
 	return []goroutine.BackgroundRoutine{
-		downloader.NewCVEDownloader(store, observationCtx, downloaderConfig),
+		downloader.NewCVEDownloader(store, uploadStore, observationCtx, downloaderConfig),
 		matcher.NewCVEMatcher(store, observationCtx, matcherConfig),
+		reachability.NewReachabilityChecker(store, codenavSvc, observationCtx, reachabilityConfig),
 	}
 }
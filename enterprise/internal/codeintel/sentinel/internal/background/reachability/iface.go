@@ -0,0 +1,11 @@
+package reachability
+
+import (
+	"context"
+
+	codenavshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+)
+
+type CodeNavService interface {
+	GetSymbolReferenceLocations(ctx context.Context, uploadID int, symbolNames []string, limit int) ([]codenavshared.Location, error)
+}
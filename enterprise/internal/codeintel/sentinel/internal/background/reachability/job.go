@@ -0,0 +1,90 @@
+package reachability
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func NewReachabilityChecker(store store.Store, codenavSvc CodeNavService, observationCtx *observation.Context, config *Config) goroutine.BackgroundRoutine {
+	metrics := newMetrics(observationCtx)
+
+	return goroutine.NewPeriodicGoroutine(
+		actor.WithInternalActor(context.Background()),
+		goroutine.HandlerFunc(func(ctx context.Context) error {
+			candidates, err := store.GetReachabilityCandidates(ctx, config.BatchSize)
+			if err != nil {
+				return err
+			}
+
+			for _, candidate := range candidates {
+				reachable, callSites, err := checkReachability(ctx, codenavSvc, candidate, config.MaxCallSites)
+				if err != nil {
+					return err
+				}
+
+				if err := store.UpdateReachability(ctx, candidate.MatchID, reachable, callSites); err != nil {
+					return err
+				}
+
+				metrics.numMatchesChecked.Inc()
+				if reachable != nil {
+					if *reachable {
+						metrics.numMatchesReachable.Inc()
+					} else {
+						metrics.numMatchesUnreachable.Inc()
+					}
+				}
+			}
+
+			return nil
+		}),
+		goroutine.WithName("codeintel.sentinel-reachability-checker"),
+		goroutine.WithDescription("Determines whether SCIP indexes matching known vulnerabilities reference the affected symbols."),
+		goroutine.WithInterval(config.Interval),
+	)
+}
+
+// checkReachability determines whether the index of the given candidate references any of the
+// affected symbols of the matched vulnerability. Up to maxCallSites referencing locations are
+// returned as examples. A nil reachability is returned when the affected symbols are unknown.
+func checkReachability(ctx context.Context, codenavSvc CodeNavService, candidate shared.ReachabilityCandidate, maxCallSites int) (*bool, []shared.VulnerabilityMatchCallSite, error) {
+	affectedSymbols := symbolNamesForCandidate(candidate)
+	if len(affectedSymbols) == 0 {
+		return nil, nil, nil
+	}
+	if maxCallSites < 1 {
+		maxCallSites = 1
+	}
+
+	var callSites []shared.VulnerabilityMatchCallSite
+	for _, affected := range affectedSymbols {
+		locations, err := codenavSvc.GetSymbolReferenceLocations(ctx, candidate.UploadID, affected.symbolNames, maxCallSites-len(callSites))
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "codenavSvc.GetSymbolReferenceLocations")
+		}
+
+		for _, location := range locations {
+			callSites = append(callSites, shared.VulnerabilityMatchCallSite{
+				Symbol:         affected.symbol,
+				Path:           location.Path,
+				StartLine:      location.Range.Start.Line,
+				StartCharacter: location.Range.Start.Character,
+				EndLine:        location.Range.End.Line,
+				EndCharacter:   location.Range.End.Character,
+			})
+		}
+
+		if len(callSites) >= maxCallSites {
+			break
+		}
+	}
+
+	reachable := len(callSites) > 0
+	return &reachable, callSites, nil
+}
@@ -0,0 +1,44 @@
+package reachability
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type metrics struct {
+	numMatchesChecked     prometheus.Counter
+	numMatchesReachable   prometheus.Counter
+	numMatchesUnreachable prometheus.Counter
+}
+
+func newMetrics(observationCtx *observation.Context) *metrics {
+	counter := func(name, help string) prometheus.Counter {
+		counter := prometheus.NewCounter(prometheus.CounterOpts{
+			Name: name,
+			Help: help,
+		})
+
+		observationCtx.Registerer.MustRegister(counter)
+		return counter
+	}
+
+	numMatchesChecked := counter(
+		"src_codeintel_sentinel_num_matches_reachability_checked_total",
+		"The total number of vulnerability matches checked for reachability.",
+	)
+	numMatchesReachable := counter(
+		"src_codeintel_sentinel_num_matches_reachable_total",
+		"The total number of vulnerability matches found to reference an affected symbol.",
+	)
+	numMatchesUnreachable := counter(
+		"src_codeintel_sentinel_num_matches_unreachable_total",
+		"The total number of vulnerability matches found to reference no affected symbol.",
+	)
+
+	return &metrics{
+		numMatchesChecked:     numMatchesChecked,
+		numMatchesReachable:   numMatchesReachable,
+		numMatchesUnreachable: numMatchesUnreachable,
+	}
+}
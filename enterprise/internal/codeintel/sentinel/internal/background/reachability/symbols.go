@@ -0,0 +1,96 @@
+package reachability
+
+import (
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+)
+
+// affectedSymbolNames pairs an affected symbol of a vulnerability (e.g., `golang.org/x/net/html.Parse`)
+// with the SCIP symbol names by which a matched index may refer to it.
+type affectedSymbolNames struct {
+	symbol      string
+	symbolNames []string
+}
+
+// symbolNamesForCandidate returns the affected symbols of the given candidate along with the SCIP
+// symbol names by which each of the packages referenced by the matched index would refer to them.
+// Only Go is supported for now: other advisory sources do not report affected symbols in a form we
+// can map onto SCIP descriptors. An empty slice is returned for unsupported candidates.
+func symbolNamesForCandidate(candidate shared.ReachabilityCandidate) []affectedSymbolNames {
+	if candidate.Language != "go" {
+		return nil
+	}
+
+	var affected []affectedSymbolNames
+	for _, affectedSymbol := range candidate.AffectedSymbols {
+		for _, symbol := range affectedSymbol.Symbols {
+			var symbolNames []string
+			for _, pkg := range candidate.Packages {
+				if affectedSymbol.Path != pkg.Name && !strings.HasPrefix(affectedSymbol.Path, pkg.Name+"/") {
+					continue
+				}
+
+				symbolNames = append(symbolNames, goSymbolName(pkg, affectedSymbol.Path, symbol))
+			}
+
+			if len(symbolNames) > 0 {
+				affected = append(affected, affectedSymbolNames{
+					symbol:      affectedSymbol.Path + "." + symbol,
+					symbolNames: symbolNames,
+				})
+			}
+		}
+	}
+
+	return affected
+}
+
+// goSymbolName returns the SCIP symbol name of the given function (`Func`) or method (`Type.Method`)
+// of the given Go package as emitted by scip-go.
+func goSymbolName(pkg shared.ReferencedPackage, importPath, symbol string) string {
+	descriptors := formatDescriptor(importPath) + "/"
+	if typeName, methodName, ok := strings.Cut(symbol, "."); ok {
+		descriptors += formatDescriptor(typeName) + "#" + formatDescriptor(methodName) + "()."
+	} else {
+		descriptors += formatDescriptor(symbol) + "()."
+	}
+
+	return strings.Join([]string{
+		escapeSpaces(pkg.Scheme),
+		formatPackageField(pkg.Manager),
+		formatPackageField(pkg.Name),
+		formatPackageField(pkg.Version),
+		descriptors,
+	}, " ")
+}
+
+// formatPackageField formats a package manager, name, or version of a SCIP symbol name, where
+// an empty value is represented by a single period.
+func formatPackageField(value string) string {
+	if value == "" {
+		return "."
+	}
+
+	return escapeSpaces(value)
+}
+
+func escapeSpaces(value string) string {
+	return strings.ReplaceAll(value, " ", "  ")
+}
+
+// formatDescriptor formats the name of a SCIP descriptor, surrounding it with backticks
+// if it contains characters outside of the simple identifier alphabet.
+func formatDescriptor(name string) string {
+	for _, r := range name {
+		if !isSimpleIdentifierCharacter(r) {
+			return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+		}
+	}
+
+	return name
+}
+
+func isSimpleIdentifierCharacter(r rune) bool {
+	return r == '_' || r == '+' || r == '-' || r == '$' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9')
+}
@@ -0,0 +1,117 @@
+package reachability
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	codenavshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+)
+
+var testCandidate = shared.ReachabilityCandidate{
+	MatchID:  1,
+	UploadID: 42,
+	Language: "go",
+	AffectedSymbols: []shared.AffectedSymbol{
+		{Path: "golang.org/x/net/html", Symbols: []string{"Parse", "Tokenizer.Next"}},
+		{Path: "golang.org/x/text/language", Symbols: []string{"Parse"}},
+	},
+	Packages: []shared.ReferencedPackage{
+		{Scheme: "scip-go", Manager: "gomod", Name: "golang.org/x/net", Version: "v0.7.0"},
+	},
+}
+
+func TestSymbolNamesForCandidate(t *testing.T) {
+	expected := []affectedSymbolNames{
+		{
+			symbol:      "golang.org/x/net/html.Parse",
+			symbolNames: []string{"scip-go gomod golang.org/x/net v0.7.0 `golang.org/x/net/html`/Parse()."},
+		},
+		{
+			symbol:      "golang.org/x/net/html.Tokenizer.Next",
+			symbolNames: []string{"scip-go gomod golang.org/x/net v0.7.0 `golang.org/x/net/html`/Tokenizer#Next()."},
+		},
+	}
+	if diff := cmp.Diff(expected, symbolNamesForCandidate(testCandidate), cmp.AllowUnexported(affectedSymbolNames{})); diff != "" {
+		t.Errorf("unexpected symbol names (-want +got):\n%s", diff)
+	}
+
+	unsupported := testCandidate
+	unsupported.Language = "python"
+	if symbolNames := symbolNamesForCandidate(unsupported); len(symbolNames) != 0 {
+		t.Errorf("unexpected symbol names for unsupported language: %v", symbolNames)
+	}
+}
+
+func TestGoSymbolNameEmptyPackageFields(t *testing.T) {
+	pkg := shared.ReferencedPackage{Scheme: "gomod", Name: "github.com/go-nacelle/config"}
+	expected := "gomod . github.com/go-nacelle/config . `github.com/go-nacelle/config`/NewConfig()."
+
+	if symbolName := goSymbolName(pkg, "github.com/go-nacelle/config", "NewConfig"); symbolName != expected {
+		t.Errorf("unexpected symbol name. want=%q have=%q", expected, symbolName)
+	}
+}
+
+type testCodeNavService map[string][]codenavshared.Location
+
+func (s testCodeNavService) GetSymbolReferenceLocations(_ context.Context, _ int, symbolNames []string, limit int) ([]codenavshared.Location, error) {
+	var locations []codenavshared.Location
+	for _, symbolName := range symbolNames {
+		locations = append(locations, s[symbolName]...)
+	}
+	if len(locations) > limit {
+		locations = locations[:limit]
+	}
+
+	return locations, nil
+}
+
+func TestCheckReachability(t *testing.T) {
+	location := codenavshared.Location{
+		DumpID: 42,
+		Path:   "parse.go",
+		Range:  codenavshared.Range{Start: codenavshared.Position{Line: 10, Character: 8}, End: codenavshared.Position{Line: 10, Character: 13}},
+	}
+	codenavSvc := testCodeNavService{
+		"scip-go gomod golang.org/x/net v0.7.0 `golang.org/x/net/html`/Parse().": {location, location},
+	}
+
+	reachable, callSites, err := checkReachability(context.Background(), codenavSvc, testCandidate, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if reachable == nil || !*reachable {
+		t.Fatalf("expected candidate to be reachable")
+	}
+	expectedCallSite := shared.VulnerabilityMatchCallSite{
+		Symbol:         "golang.org/x/net/html.Parse",
+		Path:           "parse.go",
+		StartLine:      10,
+		StartCharacter: 8,
+		EndLine:        10,
+		EndCharacter:   13,
+	}
+	if diff := cmp.Diff([]shared.VulnerabilityMatchCallSite{expectedCallSite, expectedCallSite}, callSites); diff != "" {
+		t.Errorf("unexpected call sites (-want +got):\n%s", diff)
+	}
+
+	if _, callSites, _ := checkReachability(context.Background(), codenavSvc, testCandidate, 1); len(callSites) != 1 {
+		t.Errorf("unexpected number of call sites. want=%d have=%d", 1, len(callSites))
+	}
+
+	reachable, _, err = checkReachability(context.Background(), testCodeNavService{}, testCandidate, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if reachable == nil || *reachable {
+		t.Errorf("expected candidate to be unreachable")
+	}
+
+	unsupported := testCandidate
+	unsupported.Language = "python"
+	if reachable, _, _ := checkReachability(context.Background(), codenavSvc, unsupported, 10); reachable != nil {
+		t.Errorf("expected reachability of unsupported candidate to be unknown")
+	}
+}
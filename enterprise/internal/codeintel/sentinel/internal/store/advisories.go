@@ -0,0 +1,217 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jackc/pgconn"
+	"github.com/keegancsmith/sqlf"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ErrDuplicateSourceID occurs when an internal advisory would share a source identifier
+// with another internal advisory.
+var ErrDuplicateSourceID = errors.New("an internal advisory with this source identifier already exists")
+
+func (s *store) GetInternalAdvisoryByID(ctx context.Context, id int) (_ shared.InternalAdvisory, _ bool, err error) {
+	ctx, _, endObservation := s.operations.getInternalAdvisoryByID.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("id", id),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	advisories, _, err := scanInternalAdvisoriesAndCount(s.db.Query(ctx, sqlf.Sprintf(getInternalAdvisoryByIDQuery, id)))
+	if err != nil || len(advisories) == 0 {
+		return shared.InternalAdvisory{}, false, err
+	}
+
+	return advisories[0], true, nil
+}
+
+const getInternalAdvisoryByIDQuery = `
+SELECT
+	` + internalAdvisoryFields + `,
+	0 AS count
+FROM vulnerability_internal_advisories a
+WHERE a.id = %s
+`
+
+const internalAdvisoryFields = `
+	a.id,
+	a.source_id,
+	a.advisory,
+	a.created_at,
+	a.updated_at
+`
+
+func (s *store) GetInternalAdvisories(ctx context.Context, args shared.GetInternalAdvisoriesArgs) (_ []shared.InternalAdvisory, _ int, err error) {
+	ctx, _, endObservation := s.operations.getInternalAdvisories.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("limit", args.Limit),
+		attribute.Int("offset", args.Offset),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return scanInternalAdvisoriesAndCount(s.db.Query(ctx, sqlf.Sprintf(getInternalAdvisoriesQuery, args.Limit, args.Offset)))
+}
+
+const getInternalAdvisoriesQuery = `
+SELECT
+	` + internalAdvisoryFields + `,
+	COUNT(*) OVER() AS count
+FROM vulnerability_internal_advisories a
+ORDER BY a.id
+LIMIT %s
+OFFSET %s
+`
+
+func (s *store) CreateInternalAdvisory(ctx context.Context, advisory shared.Vulnerability) (_ int, err error) {
+	ctx, _, endObservation := s.operations.createInternalAdvisory.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("sourceID", advisory.SourceID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	serialized, err := serializeInternalAdvisory(advisory)
+	if err != nil {
+		return 0, err
+	}
+
+	id, _, err := basestore.ScanFirstInt(s.db.Query(ctx, sqlf.Sprintf(createInternalAdvisoryQuery, advisory.SourceID, serialized)))
+	if isUniqueConstraintViolation(err) {
+		return 0, ErrDuplicateSourceID
+	}
+
+	return id, err
+}
+
+const createInternalAdvisoryQuery = `
+INSERT INTO vulnerability_internal_advisories (source_id, advisory)
+VALUES (%s, %s)
+RETURNING id
+`
+
+func (s *store) UpdateInternalAdvisory(ctx context.Context, id int, advisory shared.Vulnerability) (_ bool, err error) {
+	ctx, _, endObservation := s.operations.updateInternalAdvisory.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("id", id),
+		attribute.String("sourceID", advisory.SourceID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	serialized, err := serializeInternalAdvisory(advisory)
+	if err != nil {
+		return false, err
+	}
+
+	var found bool
+	err = s.db.WithTransact(ctx, func(tx *basestore.Store) error {
+		previousSourceID, ok, err := basestore.ScanFirstString(tx.Query(ctx, sqlf.Sprintf(updateInternalAdvisoryQuery, advisory.SourceID, serialized, id)))
+		if err != nil || !ok {
+			return err
+		}
+		found = true
+
+		if previousSourceID == advisory.SourceID {
+			return nil
+		}
+
+		// The advisory was synced under its previous identifier
+		return tx.Exec(ctx, sqlf.Sprintf(deleteSyncedInternalAdvisoryQuery, previousSourceID))
+	})
+	if isUniqueConstraintViolation(err) {
+		return false, ErrDuplicateSourceID
+	}
+
+	return found, err
+}
+
+const updateInternalAdvisoryQuery = `
+WITH previous AS (
+	SELECT id, source_id
+	FROM vulnerability_internal_advisories
+	WHERE id = %s
+	FOR UPDATE
+)
+UPDATE vulnerability_internal_advisories a
+SET source_id = %s, advisory = %s, updated_at = NOW()
+FROM previous p
+WHERE a.id = p.id
+RETURNING p.source_id
+`
+
+func (s *store) DeleteInternalAdvisory(ctx context.Context, id int) (_ bool, err error) {
+	ctx, _, endObservation := s.operations.deleteInternalAdvisory.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("id", id),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	var found bool
+	err = s.db.WithTransact(ctx, func(tx *basestore.Store) error {
+		sourceID, ok, err := basestore.ScanFirstString(tx.Query(ctx, sqlf.Sprintf(deleteInternalAdvisoryQuery, id)))
+		if err != nil || !ok {
+			return err
+		}
+		found = true
+
+		return tx.Exec(ctx, sqlf.Sprintf(deleteSyncedInternalAdvisoryQuery, sourceID))
+	})
+
+	return found, err
+}
+
+const deleteInternalAdvisoryQuery = `
+DELETE FROM vulnerability_internal_advisories
+WHERE id = %s
+RETURNING source_id
+`
+
+// NOTE: If an external source also publishes an advisory with this identifier, it will
+// be re-inserted on the next sync.
+const deleteSyncedInternalAdvisoryQuery = `
+DELETE FROM vulnerabilities
+WHERE source_id = %s
+`
+
+//
+//
+
+// serializeInternalAdvisory returns the payload stored alongside the source identifier
+// of an internal advisory.
+func serializeInternalAdvisory(advisory shared.Vulnerability) ([]byte, error) {
+	advisory.ID = 0
+	advisory.SourceID = ""
+
+	return json.Marshal(advisory)
+}
+
+var scanInternalAdvisoriesAndCount = basestore.NewSliceWithCountScanner(func(s dbutil.Scanner) (advisory shared.InternalAdvisory, count int, _ error) {
+	var (
+		sourceID   string
+		serialized []byte
+	)
+	if err := s.Scan(
+		&advisory.ID,
+		&sourceID,
+		&serialized,
+		&advisory.CreatedAt,
+		&advisory.UpdatedAt,
+		&count,
+	); err != nil {
+		return shared.InternalAdvisory{}, 0, err
+	}
+
+	if err := json.Unmarshal(serialized, &advisory.Advisory); err != nil {
+		return shared.InternalAdvisory{}, 0, err
+	}
+	advisory.Advisory.SourceID = sourceID
+
+	return advisory, count, nil
+})
+
+func isUniqueConstraintViolation(err error) bool {
+	var e *pgconn.PgError
+	return errors.As(err, &e) && e.Code == "23505"
+}
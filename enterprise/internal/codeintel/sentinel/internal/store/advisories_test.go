@@ -0,0 +1,99 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestInternalAdvisories(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	advisory := shared.Vulnerability{
+		SourceID: "ACME-2023-0001",
+		Summary:  "Deserialization of untrusted data",
+		Aliases:  []string{"CVE-2023-1234"},
+		AffectedPackages: []shared.AffectedPackage{{
+			PackageName:       "acme/widgets",
+			Language:          "go",
+			Namespace:         "internal",
+			VersionConstraint: []string{">=1.0.0", "<1.2.0"},
+		}},
+	}
+
+	id, err := store.CreateInternalAdvisory(ctx, advisory)
+	if err != nil {
+		t.Fatalf("unexpected error creating internal advisory: %s", err)
+	}
+	if _, err := store.CreateInternalAdvisory(ctx, advisory); !errors.Is(err, ErrDuplicateSourceID) {
+		t.Fatalf("unexpected error creating duplicate internal advisory. want=%q have=%q", ErrDuplicateSourceID, err)
+	}
+
+	stored, ok, err := store.GetInternalAdvisoryByID(ctx, id)
+	if err != nil {
+		t.Fatalf("unexpected error getting internal advisory: %s", err)
+	}
+	if !ok {
+		t.Fatalf("expected internal advisory to exist")
+	}
+	if diff := cmp.Diff(advisory, stored.Advisory); diff != "" {
+		t.Errorf("unexpected advisory (-want +got):\n%s", diff)
+	}
+
+	// Simulate a sync of the advisory
+	if _, err := store.InsertVulnerabilities(ctx, []shared.Vulnerability{advisory}); err != nil {
+		t.Fatalf("unexpected error inserting vulnerabilities: %s", err)
+	}
+
+	advisory.SourceID = "ACME-2023-0002"
+	if ok, err := store.UpdateInternalAdvisory(ctx, id, advisory); err != nil {
+		t.Fatalf("unexpected error updating internal advisory: %s", err)
+	} else if !ok {
+		t.Fatalf("expected internal advisory to exist")
+	}
+	if ok, err := store.UpdateInternalAdvisory(ctx, id+1, advisory); err != nil {
+		t.Fatalf("unexpected error updating internal advisory: %s", err)
+	} else if ok {
+		t.Fatalf("expected internal advisory not to exist")
+	}
+
+	// The advisory synced under its previous identifier should be removed
+	if _, totalCount, err := store.GetVulnerabilities(ctx, shared.GetVulnerabilitiesArgs{Limit: 10}); err != nil {
+		t.Fatalf("unexpected error getting vulnerabilities: %s", err)
+	} else if totalCount != 0 {
+		t.Errorf("unexpected number of vulnerabilities. want=%d have=%d", 0, totalCount)
+	}
+
+	advisories, totalCount, err := store.GetInternalAdvisories(ctx, shared.GetInternalAdvisoriesArgs{Limit: 10})
+	if err != nil {
+		t.Fatalf("unexpected error getting internal advisories: %s", err)
+	}
+	if totalCount != 1 || len(advisories) != 1 {
+		t.Fatalf("unexpected number of internal advisories. want=%d have=%d", 1, totalCount)
+	}
+	if diff := cmp.Diff(advisory, advisories[0].Advisory); diff != "" {
+		t.Errorf("unexpected advisory (-want +got):\n%s", diff)
+	}
+
+	if ok, err := store.DeleteInternalAdvisory(ctx, id); err != nil {
+		t.Fatalf("unexpected error deleting internal advisory: %s", err)
+	} else if !ok {
+		t.Fatalf("expected internal advisory to exist")
+	}
+	if _, ok, err := store.GetInternalAdvisoryByID(ctx, id); err != nil {
+		t.Fatalf("unexpected error getting internal advisory: %s", err)
+	} else if ok {
+		t.Fatalf("expected internal advisory to be deleted")
+	}
+}
@@ -35,6 +35,7 @@ const vulnerabilityMatchByIDQuery = `
 SELECT
 	m.id,
 	m.upload_id,
+	m.reachable,
 	vap.vulnerability_id,
 	vap.package_name,
 	vap.language,
@@ -60,6 +61,7 @@ func (s *store) GetVulnerabilityMatches(ctx context.Context, args shared.GetVuln
 		attribute.String("severity", args.Severity),
 		attribute.String("language", args.Language),
 		attribute.String("repositoryName", args.RepositoryName),
+		attribute.String("reachability", args.Reachability),
 	}})
 	defer endObservation(1, observation.Args{})
 
@@ -73,6 +75,14 @@ func (s *store) GetVulnerabilityMatches(ctx context.Context, args shared.GetVuln
 	if args.RepositoryName != "" {
 		conds = append(conds, sqlf.Sprintf("r.name = %s", args.RepositoryName))
 	}
+	switch args.Reachability {
+	case shared.ReachabilityReachable:
+		conds = append(conds, sqlf.Sprintf("m.reachable"))
+	case shared.ReachabilityUnreachable:
+		conds = append(conds, sqlf.Sprintf("NOT m.reachable"))
+	case shared.ReachabilityUnknown:
+		conds = append(conds, sqlf.Sprintf("m.reachable IS NULL"))
+	}
 	if len(conds) == 0 {
 		conds = append(conds, sqlf.Sprintf("TRUE"))
 	}
@@ -85,13 +95,15 @@ WITH limited_matches AS (
 	SELECT
 		m.id,
 		m.upload_id,
-		m.vulnerability_affected_package_id
+		m.vulnerability_affected_package_id,
+		m.reachable
 	FROM vulnerability_matches m
 	ORDER BY id
 )
 SELECT
 	m.id,
 	m.upload_id,
+	m.reachable,
 	vap.vulnerability_id,
 	vap.package_name,
 	vap.language,
@@ -367,6 +379,7 @@ var scanVulnerabilityMatchesAndCount = func(rows basestore.Rows, queryErr error)
 		if err := s.Scan(
 			&match.ID,
 			&match.UploadID,
+			&match.Reachable,
 			&match.VulnerabilityID,
 			// RHS(s) of left join (may be null)
 			&dbutil.NullString{S: &vap.PackageName},
@@ -376,7 +389,7 @@ var scanVulnerabilityMatchesAndCount = func(rows basestore.Rows, queryErr error)
 			&dbutil.NullBool{B: &vap.Fixed},
 			&dbutil.NullString{S: &fixedIn},
 			&dbutil.NullString{S: &vas.Path},
-			pq.Array(vas.Symbols),
+			pq.Array(&vas.Symbols),
 			&dbutil.NullString{S: &vul.Severity},
 			&count,
 		); err != nil {
@@ -17,6 +17,14 @@ type operations struct {
 	getVulnerabilityMatchesSummaryCount      *observation.Operation
 	getVulnerabilityMatchesCountByRepository *observation.Operation
 	scanMatches                              *observation.Operation
+	getReachabilityCandidates                *observation.Operation
+	updateReachability                       *observation.Operation
+	getVulnerabilityMatchCallSites           *observation.Operation
+	getInternalAdvisoryByID                  *observation.Operation
+	getInternalAdvisories                    *observation.Operation
+	createInternalAdvisory                   *observation.Operation
+	updateInternalAdvisory                   *observation.Operation
+	deleteInternalAdvisory                   *observation.Operation
 }
 
 var m = new(metrics.SingletonREDMetrics)
@@ -49,5 +57,13 @@ func newOperations(observationCtx *observation.Context) *operations {
 		getVulnerabilityMatchesSummaryCount:      op("GetVulnerabilityMatchesSummaryCount"),
 		getVulnerabilityMatchesCountByRepository: op("GetVulnerabilityMatchesCountByRepository"),
 		scanMatches:                              op("ScanMatches"),
+		getReachabilityCandidates:                op("GetReachabilityCandidates"),
+		updateReachability:                       op("UpdateReachability"),
+		getVulnerabilityMatchCallSites:           op("GetVulnerabilityMatchCallSites"),
+		getInternalAdvisoryByID:                  op("GetInternalAdvisoryByID"),
+		getInternalAdvisories:                    op("GetInternalAdvisories"),
+		createInternalAdvisory:                   op("CreateInternalAdvisory"),
+		updateInternalAdvisory:                   op("UpdateInternalAdvisory"),
+		deleteInternalAdvisory:                   op("DeleteInternalAdvisory"),
 	}
 }
@@ -0,0 +1,223 @@
+package store
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/batch"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func (s *store) GetReachabilityCandidates(ctx context.Context, batchSize int) (_ []shared.ReachabilityCandidate, err error) {
+	ctx, _, endObservation := s.operations.getReachabilityCandidates.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchSize", batchSize),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	candidates, err := scanReachabilityCandidates(s.db.Query(ctx, sqlf.Sprintf(getReachabilityCandidatesQuery, batchSize)))
+	if err != nil || len(candidates) == 0 {
+		return nil, err
+	}
+
+	matchIDs := make([]int, 0, len(candidates))
+	for _, candidate := range candidates {
+		matchIDs = append(matchIDs, candidate.MatchID)
+	}
+
+	packagesByMatchID, err := scanReferencedPackagesByMatchID(s.db.Query(ctx, sqlf.Sprintf(getReachabilityCandidatePackagesQuery, pq.Array(matchIDs))))
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range candidates {
+		candidates[i].Packages = packagesByMatchID[candidates[i].MatchID]
+	}
+
+	return candidates, nil
+}
+
+const getReachabilityCandidatesQuery = `
+WITH candidates AS (
+	SELECT
+		m.id,
+		m.upload_id,
+		m.vulnerability_affected_package_id
+	FROM vulnerability_matches m
+	WHERE m.reachability_checked_at IS NULL
+	ORDER BY m.id
+	LIMIT %s
+)
+SELECT
+	c.id,
+	c.upload_id,
+	vap.language,
+	vas.path,
+	vas.symbols
+FROM candidates c
+JOIN vulnerability_affected_packages vap ON vap.id = c.vulnerability_affected_package_id
+LEFT JOIN vulnerability_affected_symbols vas ON vas.vulnerability_affected_package_id = vap.id
+ORDER BY c.id, vas.id
+`
+
+// NOTE: This mirrors the (loose) package name matching performed by ScanMatches so that
+// we can reconstruct the symbol names the matched index would use for affected symbols.
+const getReachabilityCandidatePackagesQuery = `
+SELECT DISTINCT
+	m.id,
+	r.scheme,
+	r.manager,
+	r.name,
+	r.version
+FROM vulnerability_matches m
+JOIN vulnerability_affected_packages vap ON vap.id = m.vulnerability_affected_package_id
+JOIN lsif_references r ON r.dump_id = m.upload_id AND r.name LIKE '%%' || vap.package_name || '%%'
+WHERE m.id = ANY(%s)
+ORDER BY m.id, r.scheme, r.manager, r.name, r.version
+`
+
+func (s *store) UpdateReachability(ctx context.Context, matchID int, reachable *bool, callSites []shared.VulnerabilityMatchCallSite) (err error) {
+	ctx, _, endObservation := s.operations.updateReachability.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("matchID", matchID),
+		attribute.Int("numCallSites", len(callSites)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return s.db.WithTransact(ctx, func(tx *basestore.Store) error {
+		if err := tx.Exec(ctx, sqlf.Sprintf(updateReachabilityQuery, dbutil.NullBool{B: reachable}, matchID)); err != nil {
+			return err
+		}
+
+		if err := tx.Exec(ctx, sqlf.Sprintf(deleteCallSitesQuery, matchID)); err != nil {
+			return err
+		}
+
+		return batch.WithInserter(
+			ctx,
+			tx.Handle(),
+			"vulnerability_match_call_sites",
+			batch.MaxNumPostgresParameters,
+			[]string{
+				"vulnerability_match_id",
+				"symbol",
+				"document_path",
+				"start_line",
+				"start_character",
+				"end_line",
+				"end_character",
+			},
+			func(inserter *batch.Inserter) error {
+				for _, callSite := range callSites {
+					if err := inserter.Insert(
+						ctx,
+						matchID,
+						callSite.Symbol,
+						callSite.Path,
+						callSite.StartLine,
+						callSite.StartCharacter,
+						callSite.EndLine,
+						callSite.EndCharacter,
+					); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			},
+		)
+	})
+}
+
+const updateReachabilityQuery = `
+UPDATE vulnerability_matches
+SET reachable = %s, reachability_checked_at = NOW()
+WHERE id = %s
+`
+
+const deleteCallSitesQuery = `
+DELETE FROM vulnerability_match_call_sites WHERE vulnerability_match_id = %s
+`
+
+func (s *store) GetVulnerabilityMatchCallSites(ctx context.Context, matchID int) (_ []shared.VulnerabilityMatchCallSite, err error) {
+	ctx, _, endObservation := s.operations.getVulnerabilityMatchCallSites.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("matchID", matchID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return scanCallSites(s.db.Query(ctx, sqlf.Sprintf(getVulnerabilityMatchCallSitesQuery, matchID)))
+}
+
+const getVulnerabilityMatchCallSitesQuery = `
+SELECT
+	symbol,
+	document_path,
+	start_line,
+	start_character,
+	end_line,
+	end_character
+FROM vulnerability_match_call_sites
+WHERE vulnerability_match_id = %s
+ORDER BY id
+`
+
+//
+//
+
+var scanReachabilityCandidates = func(rows basestore.Rows, queryErr error) ([]shared.ReachabilityCandidate, error) {
+	candidates, err := basestore.NewSliceScanner(func(s dbutil.Scanner) (candidate shared.ReachabilityCandidate, _ error) {
+		var vas shared.AffectedSymbol
+		if err := s.Scan(
+			&candidate.MatchID,
+			&candidate.UploadID,
+			&candidate.Language,
+			// RHS of left join (may be null)
+			&dbutil.NullString{S: &vas.Path},
+			pq.Array(&vas.Symbols),
+		); err != nil {
+			return shared.ReachabilityCandidate{}, err
+		}
+
+		if vas.Path != "" {
+			candidate.AffectedSymbols = append(candidate.AffectedSymbols, vas)
+		}
+
+		return candidate, nil
+	})(rows, queryErr)
+	if err != nil {
+		return nil, err
+	}
+
+	flattened := []shared.ReachabilityCandidate{}
+	for _, candidate := range candidates {
+		i := len(flattened) - 1
+		if len(flattened) == 0 || flattened[i].MatchID != candidate.MatchID {
+			flattened = append(flattened, candidate)
+		} else {
+			flattened[i].AffectedSymbols = append(flattened[i].AffectedSymbols, candidate.AffectedSymbols...)
+		}
+	}
+
+	return flattened, nil
+}
+
+var scanReferencedPackagesByMatchID = basestore.NewMapSliceScanner(func(s dbutil.Scanner) (matchID int, pkg shared.ReferencedPackage, _ error) {
+	err := s.Scan(&matchID, &pkg.Scheme, &pkg.Manager, &pkg.Name, &dbutil.NullString{S: &pkg.Version})
+	return matchID, pkg, err
+})
+
+var scanCallSites = basestore.NewSliceScanner(func(s dbutil.Scanner) (callSite shared.VulnerabilityMatchCallSite, _ error) {
+	err := s.Scan(
+		&callSite.Symbol,
+		&callSite.Path,
+		&callSite.StartLine,
+		&callSite.StartCharacter,
+		&callSite.EndLine,
+		&callSite.EndCharacter,
+	)
+	return callSite, err
+})
@@ -0,0 +1,107 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestReachability(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	setupReferences(t, db)
+
+	affectedSymbols := []shared.AffectedSymbol{
+		{Path: "github.com/go-nacelle/config", Symbols: []string{"Config.Load", "NewConfig"}},
+	}
+	vulnerabilities := []shared.Vulnerability{
+		{ID: 1, SourceID: "CVE-ABC", AffectedPackages: []shared.AffectedPackage{{
+			Language:          "go",
+			PackageName:       "go-nacelle/config",
+			VersionConstraint: []string{"<= v1.2.5"},
+			AffectedSymbols:   affectedSymbols,
+		}}},
+	}
+	if _, err := store.InsertVulnerabilities(ctx, vulnerabilities); err != nil {
+		t.Fatalf("unexpected error inserting vulnerabilities: %s", err)
+	}
+	if _, _, err := store.ScanMatches(ctx, 100); err != nil {
+		t.Fatalf("unexpected error scanning matches: %s", err)
+	}
+
+	candidates, err := store.GetReachabilityCandidates(ctx, 100)
+	if err != nil {
+		t.Fatalf("unexpected error getting reachability candidates: %s", err)
+	}
+	if len(candidates) != 3 {
+		t.Fatalf("unexpected number of candidates. want=%d have=%d", 3, len(candidates))
+	}
+
+	expectedCandidate := shared.ReachabilityCandidate{
+		MatchID:         candidates[0].MatchID,
+		UploadID:        50,
+		Language:        "go",
+		AffectedSymbols: affectedSymbols,
+		Packages: []shared.ReferencedPackage{
+			{Scheme: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.3"},
+		},
+	}
+	if diff := cmp.Diff(expectedCandidate, candidates[0]); diff != "" {
+		t.Errorf("unexpected candidate (-want +got):\n%s", diff)
+	}
+
+	reachable := true
+	callSites := []shared.VulnerabilityMatchCallSite{
+		{Symbol: "NewConfig", Path: "main.go", StartLine: 10, StartCharacter: 4, EndLine: 10, EndCharacter: 13},
+	}
+	if err := store.UpdateReachability(ctx, candidates[0].MatchID, &reachable, callSites); err != nil {
+		t.Fatalf("unexpected error updating reachability: %s", err)
+	}
+	if err := store.UpdateReachability(ctx, candidates[1].MatchID, nil, nil); err != nil {
+		t.Fatalf("unexpected error updating reachability: %s", err)
+	}
+
+	if remaining, err := store.GetReachabilityCandidates(ctx, 100); err != nil {
+		t.Fatalf("unexpected error getting reachability candidates: %s", err)
+	} else if len(remaining) != 1 {
+		t.Fatalf("unexpected number of candidates. want=%d have=%d", 1, len(remaining))
+	}
+
+	match, _, err := store.VulnerabilityMatchByID(ctx, candidates[0].MatchID)
+	if err != nil {
+		t.Fatalf("unexpected error getting vulnerability match: %s", err)
+	}
+	if match.Reachable == nil || !*match.Reachable {
+		t.Errorf("expected match to be reachable")
+	}
+
+	if matches, _, err := store.GetVulnerabilityMatches(ctx, shared.GetVulnerabilityMatchesArgs{Limit: 10, Reachability: shared.ReachabilityReachable}); err != nil {
+		t.Fatalf("unexpected error getting vulnerability matches: %s", err)
+	} else if len(matches) != 1 || matches[0].ID != candidates[0].MatchID {
+		t.Errorf("unexpected reachable matches: %v", matches)
+	}
+
+	if matches, _, err := store.GetVulnerabilityMatches(ctx, shared.GetVulnerabilityMatchesArgs{Limit: 10, Reachability: shared.ReachabilityUnknown}); err != nil {
+		t.Fatalf("unexpected error getting vulnerability matches: %s", err)
+	} else if len(matches) != 2 {
+		t.Errorf("unexpected number of matches with unknown reachability. want=%d have=%d", 2, len(matches))
+	}
+
+	storedCallSites, err := store.GetVulnerabilityMatchCallSites(ctx, candidates[0].MatchID)
+	if err != nil {
+		t.Fatalf("unexpected error getting call sites: %s", err)
+	}
+	if diff := cmp.Diff(callSites, storedCallSites); diff != "" {
+		t.Errorf("unexpected call sites (-want +got):\n%s", diff)
+	}
+}
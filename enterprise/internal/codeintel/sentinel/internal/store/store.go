@@ -24,6 +24,18 @@ type Store interface {
 	GetVulnerabilityMatchesSummaryCount(ctx context.Context) (counts shared.GetVulnerabilityMatchesSummaryCounts, err error)
 	GetVulnerabilityMatchesCountByRepository(ctx context.Context, args shared.GetVulnerabilityMatchesCountByRepositoryArgs) (_ []shared.VulnerabilityMatchesByRepository, _ int, err error)
 	ScanMatches(ctx context.Context, batchSize int) (numReferencesScanned int, numVulnerabilityMatches int, _ error)
+
+	// Reachability
+	GetReachabilityCandidates(ctx context.Context, batchSize int) ([]shared.ReachabilityCandidate, error)
+	UpdateReachability(ctx context.Context, matchID int, reachable *bool, callSites []shared.VulnerabilityMatchCallSite) error
+	GetVulnerabilityMatchCallSites(ctx context.Context, matchID int) ([]shared.VulnerabilityMatchCallSite, error)
+
+	// Internal advisories
+	GetInternalAdvisoryByID(ctx context.Context, id int) (shared.InternalAdvisory, bool, error)
+	GetInternalAdvisories(ctx context.Context, args shared.GetInternalAdvisoriesArgs) ([]shared.InternalAdvisory, int, error)
+	CreateInternalAdvisory(ctx context.Context, advisory shared.Vulnerability) (int, error)
+	UpdateInternalAdvisory(ctx context.Context, id int, advisory shared.Vulnerability) (bool, error)
+	DeleteInternalAdvisory(ctx context.Context, id int) (bool, error)
 }
 
 type store struct {
@@ -245,12 +245,18 @@ func (s *store) InsertVulnerabilities(ctx context.Context, vulnerabilities []sha
 		if err != nil {
 			return err
 		}
+		if err := tx.Exec(ctx, sqlf.Sprintf(insertVulnerabilitiesDeleteStaleAffectedPackagesQuery)); err != nil {
+			return err
+		}
 		if err := tx.Exec(ctx, sqlf.Sprintf(insertVulnerabilitiesAffectedPackagesUpdateQuery)); err != nil {
 			return err
 		}
 		if err := tx.Exec(ctx, sqlf.Sprintf(insertVulnerabilitiesAffectedSymbolsUpdateQuery)); err != nil {
 			return err
 		}
+		if err := tx.Exec(ctx, sqlf.Sprintf(insertVulnerabilitiesDeleteSupersededQuery)); err != nil {
+			return err
+		}
 
 		a = count
 		return nil
@@ -328,13 +334,76 @@ WITH ins AS (
 		modified_at,
 		withdrawn_at
 	FROM t_vulnerabilities
-	-- TODO - we'd prefer to update rather than keep first write
-	ON CONFLICT DO NOTHING
+	ON CONFLICT (source_id) DO UPDATE SET
+		summary = EXCLUDED.summary,
+		details = EXCLUDED.details,
+		cpes = EXCLUDED.cpes,
+		cwes = EXCLUDED.cwes,
+		aliases = EXCLUDED.aliases,
+		related = EXCLUDED.related,
+		data_source = EXCLUDED.data_source,
+		urls = EXCLUDED.urls,
+		severity = EXCLUDED.severity,
+		cvss_vector = EXCLUDED.cvss_vector,
+		cvss_score = EXCLUDED.cvss_score,
+		published_at = EXCLUDED.published_at,
+		modified_at = EXCLUDED.modified_at,
+		withdrawn_at = EXCLUDED.withdrawn_at
+	WHERE
+		-- Skip no-op updates so that we only count new or changed records
+		(
+			vulnerabilities.summary,
+			vulnerabilities.details,
+			vulnerabilities.cpes,
+			vulnerabilities.cwes,
+			vulnerabilities.aliases,
+			vulnerabilities.related,
+			vulnerabilities.data_source,
+			vulnerabilities.urls,
+			vulnerabilities.severity,
+			vulnerabilities.cvss_vector,
+			vulnerabilities.cvss_score,
+			vulnerabilities.published_at,
+			vulnerabilities.modified_at,
+			vulnerabilities.withdrawn_at
+		) IS DISTINCT FROM (
+			EXCLUDED.summary,
+			EXCLUDED.details,
+			EXCLUDED.cpes,
+			EXCLUDED.cwes,
+			EXCLUDED.aliases,
+			EXCLUDED.related,
+			EXCLUDED.data_source,
+			EXCLUDED.urls,
+			EXCLUDED.severity,
+			EXCLUDED.cvss_vector,
+			EXCLUDED.cvss_score,
+			EXCLUDED.published_at,
+			EXCLUDED.modified_at,
+			EXCLUDED.withdrawn_at
+		)
 	RETURNING 1
 )
 SELECT COUNT(*) FROM ins
 `
 
+// Packages that are no longer listed by an updated vulnerability are removed along with
+// their matches.
+const insertVulnerabilitiesDeleteStaleAffectedPackagesQuery = `
+DELETE FROM vulnerability_affected_packages vap
+USING vulnerabilities v
+WHERE
+	v.id = vap.vulnerability_id AND
+	v.source_id IN (SELECT tv.source_id FROM t_vulnerabilities tv) AND
+	NOT EXISTS (
+		SELECT 1
+		FROM t_vulnerability_affected_packages tvap
+		WHERE
+			tvap.source_id = v.source_id AND
+			tvap.package_name = vap.package_name
+	)
+`
+
 const insertVulnerabilitiesAffectedPackagesUpdateQuery = `
 INSERT INTO vulnerability_affected_packages(
 	vulnerability_id,
@@ -354,8 +423,12 @@ SELECT
 	fixed,
 	fixed_in
 FROM t_vulnerability_affected_packages vap
--- TODO - we'd prefer to update rather than keep first write
-ON CONFLICT DO NOTHING
+ON CONFLICT (vulnerability_id, package_name) DO UPDATE SET
+	language = EXCLUDED.language,
+	namespace = EXCLUDED.namespace,
+	version_constraint = EXCLUDED.version_constraint,
+	fixed = EXCLUDED.fixed,
+	fixed_in = EXCLUDED.fixed_in
 `
 
 const insertVulnerabilitiesAffectedSymbolsUpdateQuery = `
@@ -373,14 +446,40 @@ json_candidates AS (
 candidates AS (
 	SELECT
 		c.id,
-		c.affected_symbol->'path'::text AS path,
+		c.affected_symbol->>'path' AS path,
 		ARRAY(SELECT json_array_elements_text(c.affected_symbol->'symbols'))::text[] AS symbols
 	FROM json_candidates c
 )
 INSERT INTO vulnerability_affected_symbols(vulnerability_affected_package_id, path, symbols)
 SELECT c.id, c.path, c.symbols FROM candidates c
--- TODO - we'd prefer to update rather than keep first write
-ON CONFLICT DO NOTHING
+ON CONFLICT (vulnerability_affected_package_id, path) DO UPDATE SET
+	symbols = EXCLUDED.symbols
+`
+
+// Vulnerabilities previously stored under an identifier that is now an alias of another
+// vulnerability (e.g., once an internal advisory claims a public CVE) are removed. Uploads
+// matching the removed vulnerabilities are marked to be re-scanned so that the matches are
+// recreated against the surviving record.
+const insertVulnerabilitiesDeleteSupersededQuery = `
+WITH
+superseded AS (
+	SELECT v.id
+	FROM vulnerabilities v
+	WHERE
+		v.source_id IN (SELECT unnest(tv.aliases) FROM t_vulnerabilities tv) AND
+		v.source_id NOT IN (SELECT tv.source_id FROM t_vulnerabilities tv)
+),
+rescan AS (
+	DELETE FROM lsif_uploads_vulnerability_scan uvs
+	WHERE uvs.upload_id IN (
+		SELECT m.upload_id
+		FROM vulnerability_matches m
+		JOIN vulnerability_affected_packages vap ON vap.id = m.vulnerability_affected_package_id
+		WHERE vap.vulnerability_id IN (SELECT s.id FROM superseded s)
+	)
+)
+DELETE FROM vulnerabilities
+WHERE id IN (SELECT s.id FROM superseded s)
 `
 
 //
@@ -418,7 +517,7 @@ var scanSingleVulnerabilityAndCount = func(s dbutil.Scanner) (v shared.Vulnerabi
 		&dbutil.NullBool{B: &vap.Fixed},
 		&dbutil.NullString{S: &fixedIn},
 		&dbutil.NullString{S: &vas.Path},
-		pq.Array(vas.Symbols),
+		pq.Array(&vas.Symbols),
 		&count,
 	); err != nil {
 		return shared.Vulnerability{}, 0, err
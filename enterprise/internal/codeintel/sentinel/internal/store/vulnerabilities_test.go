@@ -135,3 +135,52 @@ func TestGetVulnerabilities(t *testing.T) {
 		}
 	}
 }
+
+func TestInsertVulnerabilitiesUpdatesAndSupersedes(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	if _, err := store.InsertVulnerabilities(ctx, testVulnerabilities); err != nil {
+		t.Fatalf("unexpected error inserting vulnerabilities: %s", err)
+	}
+
+	// Re-inserting unchanged records is a no-op
+	if count, err := store.InsertVulnerabilities(ctx, testVulnerabilities[:2]); err != nil {
+		t.Fatalf("unexpected error inserting vulnerabilities: %s", err)
+	} else if count != 0 {
+		t.Errorf("unexpected number of written vulnerabilities. want=%d have=%d", 0, count)
+	}
+
+	updated := []shared.Vulnerability{
+		// Changes an existing record
+		{SourceID: "CVE-ABC", Summary: "updated", AffectedPackages: []shared.AffectedPackage{badConfig}},
+		// Claims CVE-DEF as an alias
+		{SourceID: "ACME-001", Aliases: []string{"CVE-DEF"}},
+	}
+	if count, err := store.InsertVulnerabilities(ctx, updated); err != nil {
+		t.Fatalf("unexpected error inserting vulnerabilities: %s", err)
+	} else if count != 2 {
+		t.Errorf("unexpected number of written vulnerabilities. want=%d have=%d", 2, count)
+	}
+
+	vulnerabilities, _, err := store.GetVulnerabilities(ctx, shared.GetVulnerabilitiesArgs{Limit: 20})
+	if err != nil {
+		t.Fatalf("unexpected error getting vulnerabilities: %s", err)
+	}
+
+	summaries := map[string]string{}
+	for _, vulnerability := range vulnerabilities {
+		summaries[vulnerability.SourceID] = vulnerability.Summary
+	}
+	if summary := summaries["CVE-ABC"]; summary != "updated" {
+		t.Errorf("unexpected summary. want=%q have=%q", "updated", summary)
+	}
+	if _, ok := summaries["CVE-DEF"]; ok {
+		t.Errorf("expected superseded vulnerability to be removed")
+	}
+	if _, ok := summaries["ACME-001"]; !ok {
+		t.Errorf("expected aliasing vulnerability to be inserted")
+	}
+}
@@ -10,15 +10,18 @@ import (
 
 type Service struct {
 	store      store.Store
+	codenavSvc CodeNavService
 	operations *operations
 }
 
 func newService(
 	observationCtx *observation.Context,
 	store store.Store,
+	codenavSvc CodeNavService,
 ) *Service {
 	return &Service{
 		store:      store,
+		codenavSvc: codenavSvc,
 		operations: newOperations(observationCtx),
 	}
 }
@@ -43,6 +46,10 @@ func (s *Service) GetVulnerabilityMatches(ctx context.Context, args shared.GetVu
 	return s.store.GetVulnerabilityMatches(ctx, args)
 }
 
+func (s *Service) GetVulnerabilityMatchCallSites(ctx context.Context, matchID int) ([]shared.VulnerabilityMatchCallSite, error) {
+	return s.store.GetVulnerabilityMatchCallSites(ctx, matchID)
+}
+
 func (s *Service) GetVulnerabilityMatchesSummaryCounts(ctx context.Context) (shared.GetVulnerabilityMatchesSummaryCounts, error) {
 	return s.store.GetVulnerabilityMatchesSummaryCount(ctx)
 }
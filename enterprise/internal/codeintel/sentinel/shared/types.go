@@ -56,6 +56,63 @@ type VulnerabilityMatch struct {
 	UploadID        int
 	VulnerabilityID int
 	AffectedPackage AffectedPackage
+
+	// Reachable is true when the matched index references one of the affected symbols of
+	// the vulnerability, false when it references none of them, and nil when reachability
+	// has not (or cannot) be determined.
+	Reachable *bool
+}
+
+// Values of GetVulnerabilityMatchesArgs.Reachability.
+const (
+	ReachabilityReachable   = "reachable"
+	ReachabilityUnreachable = "unreachable"
+	ReachabilityUnknown     = "unknown"
+)
+
+// VulnerabilityMatchCallSite is a location within a matched index that references an
+// affected symbol of the vulnerability.
+type VulnerabilityMatchCallSite struct {
+	Symbol         string
+	Path           string
+	StartLine      int
+	StartCharacter int
+	EndLine        int
+	EndCharacter   int
+}
+
+// ReachabilityCandidate is a vulnerability match whose reachability has not yet been
+// determined, along with the packages referenced by the matched index that satisfy the
+// match.
+type ReachabilityCandidate struct {
+	MatchID         int
+	UploadID        int
+	Language        string
+	AffectedSymbols []AffectedSymbol
+	Packages        []ReferencedPackage
+}
+
+// ReferencedPackage is a package referenced by an index.
+type ReferencedPackage struct {
+	Scheme  string
+	Manager string
+	Name    string
+	Version string
+}
+
+// InternalAdvisory is a vulnerability advisory authored on this instance rather than
+// synced from an external source. Internal advisories are synced into the set of known
+// vulnerabilities alongside those of external sources.
+type InternalAdvisory struct {
+	ID        int
+	Advisory  Vulnerability
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type GetInternalAdvisoriesArgs struct {
+	Limit  int
+	Offset int
 }
 
 type GetVulnerabilitiesArgs struct {
@@ -69,6 +126,7 @@ type GetVulnerabilityMatchesArgs struct {
 	Severity       string
 	Language       string
 	RepositoryName string
+	Reachability   string
 }
 
 type GetVulnerabilityMatchesSummaryCounts struct {
@@ -13,6 +13,12 @@ type SentinelService interface {
 
 	GetVulnerabilityMatches(ctx context.Context, args shared.GetVulnerabilityMatchesArgs) ([]shared.VulnerabilityMatch, int, error)
 	VulnerabilityMatchByID(ctx context.Context, id int) (shared.VulnerabilityMatch, bool, error)
+	GetVulnerabilityMatchCallSites(ctx context.Context, matchID int) ([]shared.VulnerabilityMatchCallSite, error)
 	GetVulnerabilityMatchesSummaryCounts(ctx context.Context) (shared.GetVulnerabilityMatchesSummaryCounts, error)
 	GetVulnerabilityMatchesCountByRepository(ctx context.Context, args shared.GetVulnerabilityMatchesCountByRepositoryArgs) (_ []shared.VulnerabilityMatchesByRepository, _ int, err error)
+
+	GetInternalAdvisories(ctx context.Context, args shared.GetInternalAdvisoriesArgs) ([]shared.InternalAdvisory, int, error)
+	CreateInternalAdvisory(ctx context.Context, advisory shared.Vulnerability) (shared.InternalAdvisory, error)
+	UpdateInternalAdvisory(ctx context.Context, id int, advisory shared.Vulnerability) (shared.InternalAdvisory, bool, error)
+	DeleteInternalAdvisory(ctx context.Context, id int) (bool, error)
 }
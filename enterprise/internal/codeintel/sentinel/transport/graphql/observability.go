@@ -14,6 +14,10 @@ type operations struct {
 	vulnerabilityMatchByID                *observation.Operation
 	vulnerabilityMatchesSummaryCounts     *observation.Operation
 	vulnerabilityMatchesCountByRepository *observation.Operation
+	getInternalAdvisories                 *observation.Operation
+	createInternalAdvisory                *observation.Operation
+	updateInternalAdvisory                *observation.Operation
+	deleteInternalAdvisory                *observation.Operation
 }
 
 func newOperations(observationCtx *observation.Context) *operations {
@@ -39,5 +43,9 @@ func newOperations(observationCtx *observation.Context) *operations {
 		vulnerabilityMatchByID:                op("VulnerabilityMatchByID"),
 		vulnerabilityMatchesSummaryCounts:     op("VulnerabilityMatchesSummaryCounts"),
 		vulnerabilityMatchesCountByRepository: op("VulnerabilityMatchesCountByRepository"),
+		getInternalAdvisories:                 op("InternalVulnerabilityAdvisories"),
+		createInternalAdvisory:                op("CreateInternalVulnerabilityAdvisory"),
+		updateInternalAdvisory:                op("UpdateInternalVulnerabilityAdvisory"),
+		deleteInternalAdvisory:                op("DeleteInternalVulnerabilityAdvisory"),
 	}
 }
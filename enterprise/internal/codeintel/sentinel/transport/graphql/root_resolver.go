@@ -2,13 +2,17 @@ package graphql
 
 import (
 	"context"
+	"path"
+	"strings"
 
 	"github.com/graph-gophers/graphql-go"
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+	sharedresolvers "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/shared/resolvers"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/shared/resolvers/gitresolvers"
 	uploadsgraphql "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/transport/graphql"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	resolverstubs "github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
 	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
@@ -17,6 +21,7 @@ import (
 
 type rootResolver struct {
 	sentinelSvc                 SentinelService
+	siteAdminChecker            sharedresolvers.SiteAdminChecker
 	vulnerabilityLoaderFactory  VulnerabilityLoaderFactory
 	uploadLoaderFactory         uploadsgraphql.UploadLoaderFactory
 	indexLoaderFactory          uploadsgraphql.IndexLoaderFactory
@@ -28,6 +33,7 @@ type rootResolver struct {
 func NewRootResolver(
 	observationCtx *observation.Context,
 	sentinelSvc SentinelService,
+	siteAdminChecker sharedresolvers.SiteAdminChecker,
 	uploadLoaderFactory uploadsgraphql.UploadLoaderFactory,
 	indexLoaderFactory uploadsgraphql.IndexLoaderFactory,
 	locationResolverFactory *gitresolvers.CachedLocationResolverFactory,
@@ -35,6 +41,7 @@ func NewRootResolver(
 ) resolverstubs.SentinelServiceResolver {
 	return &rootResolver{
 		sentinelSvc:                 sentinelSvc,
+		siteAdminChecker:            siteAdminChecker,
 		vulnerabilityLoaderFactory:  NewVulnerabilityLoaderFactory(sentinelSvc),
 		uploadLoaderFactory:         uploadLoaderFactory,
 		indexLoaderFactory:          indexLoaderFactory,
@@ -99,12 +106,18 @@ func (r *rootResolver) VulnerabilityMatches(ctx context.Context, args resolverst
 		repositoryName = *args.RepositoryName
 	}
 
+	reachability := ""
+	if args.Reachability != nil {
+		reachability = strings.ToLower(*args.Reachability)
+	}
+
 	matches, totalCount, err := r.sentinelSvc.GetVulnerabilityMatches(ctx, shared.GetVulnerabilityMatchesArgs{
 		Limit:          int(limit),
 		Offset:         int(offset),
 		Language:       language,
 		Severity:       severity,
 		RepositoryName: repositoryName,
+		Reachability:   reachability,
 	})
 	if err != nil {
 		return nil, err
@@ -122,12 +135,14 @@ func (r *rootResolver) VulnerabilityMatches(ctx context.Context, args resolverst
 	var resolvers []resolverstubs.VulnerabilityMatchResolver
 	for _, m := range matches {
 		resolvers = append(resolvers, &vulnerabilityMatchResolver{
-			uploadLoader:        uploadLoader,
-			indexLoader:         indexLoader,
-			locationResolver:    locationResolver,
-			errTracer:           errTracer,
-			vulnerabilityLoader: vulnerabilityLoader,
-			m:                   m,
+			sentinelSvc:                 r.sentinelSvc,
+			uploadLoader:                uploadLoader,
+			indexLoader:                 indexLoader,
+			locationResolver:            locationResolver,
+			errTracer:                   errTracer,
+			vulnerabilityLoader:         vulnerabilityLoader,
+			m:                           m,
+			preciseIndexResolverFactory: r.preciseIndexResolverFactory,
 		})
 	}
 
@@ -210,6 +225,7 @@ func (r *rootResolver) VulnerabilityMatchByID(ctx context.Context, vulnerability
 	locationResolver := r.locationResolverFactory.Create()
 
 	return &vulnerabilityMatchResolver{
+		sentinelSvc:      r.sentinelSvc,
 		uploadLoader:     uploadLoader,
 		indexLoader:      indexLoader,
 		locationResolver: locationResolver,
@@ -317,6 +333,7 @@ func (r *vulnerabilityAffectedSymbolResolver) Path() string      { return r.s.Pa
 func (r *vulnerabilityAffectedSymbolResolver) Symbols() []string { return r.s.Symbols }
 
 type vulnerabilityMatchResolver struct {
+	sentinelSvc                 SentinelService
 	uploadLoader                uploadsgraphql.UploadLoader
 	indexLoader                 uploadsgraphql.IndexLoader
 	locationResolver            *gitresolvers.CachedLocationResolver
@@ -352,6 +369,87 @@ func (r *vulnerabilityMatchResolver) PreciseIndex(ctx context.Context) (resolver
 	return r.preciseIndexResolverFactory.Create(ctx, r.uploadLoader, r.indexLoader, r.locationResolver, r.errTracer, &upload, nil)
 }
 
+func (r *vulnerabilityMatchResolver) Reachability() string {
+	if r.m.Reachable == nil {
+		return "UNKNOWN"
+	}
+	if *r.m.Reachable {
+		return "REACHABLE"
+	}
+
+	return "UNREACHABLE"
+}
+
+func (r *vulnerabilityMatchResolver) CallSites(ctx context.Context) ([]resolverstubs.VulnerabilityMatchCallSiteResolver, error) {
+	if r.m.Reachable == nil || !*r.m.Reachable {
+		return nil, nil
+	}
+
+	upload, ok, err := r.uploadLoader.GetByID(ctx, r.m.UploadID)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	callSites, err := r.sentinelSvc.GetVulnerabilityMatchCallSites(ctx, r.m.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]resolverstubs.VulnerabilityMatchCallSiteResolver, 0, len(callSites))
+	for _, callSite := range callSites {
+		resolvers = append(resolvers, &vulnerabilityMatchCallSiteResolver{
+			locationResolver: r.locationResolver,
+			repositoryID:     api.RepoID(upload.RepositoryID),
+			commit:           upload.Commit,
+			path:             path.Join(upload.Root, callSite.Path),
+			callSite:         callSite,
+		})
+	}
+
+	return resolvers, nil
+}
+
+//
+//
+
+type vulnerabilityMatchCallSiteResolver struct {
+	locationResolver *gitresolvers.CachedLocationResolver
+	repositoryID     api.RepoID
+	commit           string
+	path             string
+	callSite         shared.VulnerabilityMatchCallSite
+}
+
+func (r *vulnerabilityMatchCallSiteResolver) Symbol() string { return r.callSite.Symbol }
+func (r *vulnerabilityMatchCallSiteResolver) Path() string   { return r.path }
+
+func (r *vulnerabilityMatchCallSiteResolver) Range() resolverstubs.RangeResolver {
+	return &callSiteRangeResolver{
+		start: callSitePositionResolver{line: r.callSite.StartLine, character: r.callSite.StartCharacter},
+		end:   callSitePositionResolver{line: r.callSite.EndLine, character: r.callSite.EndCharacter},
+	}
+}
+
+func (r *vulnerabilityMatchCallSiteResolver) Blob(ctx context.Context) (resolverstubs.GitTreeEntryResolver, error) {
+	return r.locationResolver.Path(ctx, r.repositoryID, r.commit, r.path, false)
+}
+
+type callSiteRangeResolver struct {
+	start callSitePositionResolver
+	end   callSitePositionResolver
+}
+
+func (r *callSiteRangeResolver) Start() resolverstubs.PositionResolver { return r.start }
+func (r *callSiteRangeResolver) End() resolverstubs.PositionResolver   { return r.end }
+
+type callSitePositionResolver struct {
+	line      int
+	character int
+}
+
+func (r callSitePositionResolver) Line() int32      { return int32(r.line) }
+func (r callSitePositionResolver) Character() int32 { return int32(r.character) }
+
 //
 //
 
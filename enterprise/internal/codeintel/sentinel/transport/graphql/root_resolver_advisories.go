@@ -0,0 +1,188 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+	resolverstubs "github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+// 🚨 SECURITY: Only site admins may view internal vulnerability advisories
+func (r *rootResolver) InternalVulnerabilityAdvisories(ctx context.Context, args resolverstubs.GetInternalVulnerabilityAdvisoriesArgs) (_ resolverstubs.InternalVulnerabilityAdvisoryConnectionResolver, err error) {
+	ctx, _, endObservation := r.operations.getInternalAdvisories.WithErrors(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("first", int(pointers.Deref(args.First, 0))),
+		attribute.String("after", pointers.Deref(args.After, "")),
+	}})
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	limit, offset, err := args.ParseLimitOffset(50)
+	if err != nil {
+		return nil, err
+	}
+
+	advisories, totalCount, err := r.sentinelSvc.GetInternalAdvisories(ctx, shared.GetInternalAdvisoriesArgs{
+		Limit:  int(limit),
+		Offset: int(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resolvers []resolverstubs.InternalVulnerabilityAdvisoryResolver
+	for _, advisory := range advisories {
+		resolvers = append(resolvers, &internalVulnerabilityAdvisoryResolver{a: advisory})
+	}
+
+	return resolverstubs.NewTotalCountConnectionResolver(resolvers, offset, int32(totalCount)), nil
+}
+
+// 🚨 SECURITY: Only site admins may modify internal vulnerability advisories
+func (r *rootResolver) CreateInternalVulnerabilityAdvisory(ctx context.Context, args resolverstubs.CreateInternalVulnerabilityAdvisoryArgs) (_ resolverstubs.InternalVulnerabilityAdvisoryResolver, err error) {
+	ctx, _, endObservation := r.operations.createInternalAdvisory.WithErrors(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("sourceID", args.Advisory.SourceID),
+	}})
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	advisory, err := r.sentinelSvc.CreateInternalAdvisory(ctx, internalAdvisoryFromInput(args.Advisory))
+	if err != nil {
+		return nil, err
+	}
+
+	return &internalVulnerabilityAdvisoryResolver{a: advisory}, nil
+}
+
+// 🚨 SECURITY: Only site admins may modify internal vulnerability advisories
+func (r *rootResolver) UpdateInternalVulnerabilityAdvisory(ctx context.Context, args resolverstubs.UpdateInternalVulnerabilityAdvisoryArgs) (_ resolverstubs.InternalVulnerabilityAdvisoryResolver, err error) {
+	ctx, _, endObservation := r.operations.updateInternalAdvisory.WithErrors(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("advisoryID", string(args.ID)),
+		attribute.String("sourceID", args.Advisory.SourceID),
+	}})
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := resolverstubs.UnmarshalID[int](args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	advisory, ok, err := r.sentinelSvc.UpdateInternalAdvisory(ctx, id, internalAdvisoryFromInput(args.Advisory))
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.Newf("unknown internal vulnerability advisory %q", args.ID)
+	}
+
+	return &internalVulnerabilityAdvisoryResolver{a: advisory}, nil
+}
+
+// 🚨 SECURITY: Only site admins may modify internal vulnerability advisories
+func (r *rootResolver) DeleteInternalVulnerabilityAdvisory(ctx context.Context, args resolverstubs.DeleteInternalVulnerabilityAdvisoryArgs) (_ *resolverstubs.EmptyResponse, err error) {
+	ctx, _, endObservation := r.operations.deleteInternalAdvisory.WithErrors(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("advisoryID", string(args.ID)),
+	}})
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := resolverstubs.UnmarshalID[int](args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := r.sentinelSvc.DeleteInternalAdvisory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.Newf("unknown internal vulnerability advisory %q", args.ID)
+	}
+
+	return resolverstubs.Empty, nil
+}
+
+func internalAdvisoryFromInput(input resolverstubs.InternalVulnerabilityAdvisoryInput) shared.Vulnerability {
+	advisory := shared.Vulnerability{
+		SourceID:   input.SourceID,
+		Summary:    input.Summary,
+		Details:    pointers.Deref(input.Details, ""),
+		CWEs:       pointers.Deref(input.CWEs, nil),
+		Aliases:    pointers.Deref(input.Aliases, nil),
+		Related:    pointers.Deref(input.Related, nil),
+		URLs:       pointers.Deref(input.URLs, nil),
+		Severity:   pointers.Deref(input.Severity, ""),
+		CVSSVector: pointers.Deref(input.CVSSVector, ""),
+	}
+
+	for _, pkg := range input.AffectedPackages {
+		affectedPackage := shared.AffectedPackage{
+			PackageName:       pkg.PackageName,
+			Language:          pkg.Language,
+			VersionConstraint: pkg.VersionConstraint,
+			Fixed:             pkg.FixedIn != nil,
+			FixedIn:           pkg.FixedIn,
+		}
+		for _, symbol := range pointers.Deref(pkg.AffectedSymbols, nil) {
+			affectedPackage.AffectedSymbols = append(affectedPackage.AffectedSymbols, shared.AffectedSymbol{
+				Path:    symbol.Path,
+				Symbols: symbol.Symbols,
+			})
+		}
+
+		advisory.AffectedPackages = append(advisory.AffectedPackages, affectedPackage)
+	}
+
+	return advisory
+}
+
+//
+//
+
+type internalVulnerabilityAdvisoryResolver struct {
+	a shared.InternalAdvisory
+}
+
+func (r *internalVulnerabilityAdvisoryResolver) ID() graphql.ID {
+	return resolverstubs.MarshalID("InternalVulnerabilityAdvisory", r.a.ID)
+}
+func (r *internalVulnerabilityAdvisoryResolver) SourceID() string   { return r.a.Advisory.SourceID }
+func (r *internalVulnerabilityAdvisoryResolver) Summary() string    { return r.a.Advisory.Summary }
+func (r *internalVulnerabilityAdvisoryResolver) Details() string    { return r.a.Advisory.Details }
+func (r *internalVulnerabilityAdvisoryResolver) CWEs() []string     { return r.a.Advisory.CWEs }
+func (r *internalVulnerabilityAdvisoryResolver) Aliases() []string  { return r.a.Advisory.Aliases }
+func (r *internalVulnerabilityAdvisoryResolver) Related() []string  { return r.a.Advisory.Related }
+func (r *internalVulnerabilityAdvisoryResolver) URLs() []string     { return r.a.Advisory.URLs }
+func (r *internalVulnerabilityAdvisoryResolver) Severity() string   { return r.a.Advisory.Severity }
+func (r *internalVulnerabilityAdvisoryResolver) CVSSVector() string { return r.a.Advisory.CVSSVector }
+
+func (r *internalVulnerabilityAdvisoryResolver) AffectedPackages() []resolverstubs.VulnerabilityAffectedPackageResolver {
+	return (&vulnerabilityResolver{v: r.a.Advisory}).AffectedPackages()
+}
+
+func (r *internalVulnerabilityAdvisoryResolver) CreatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.a.CreatedAt}
+}
+
+func (r *internalVulnerabilityAdvisoryResolver) UpdatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.a.UpdatedAt}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/dependencies"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/policies"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/ranking"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sbom"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel"
 	codeintelshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/shared"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads"
@@ -24,6 +25,7 @@ type Services struct {
 	RankingService      *ranking.Service
 	UploadsService      *uploads.Service
 	SentinelService     *sentinel.Service
+	SBOMService         *sbom.Service
 	ContextService      *context.Service
 	GitserverClient     gitserver.Client
 }
@@ -44,8 +46,9 @@ func NewServices(deps ServiceDependencies) (Services, error) {
 	autoIndexingSvc := autoindexing.NewService(deps.ObservationCtx, db, dependenciesSvc, policiesSvc, gitserverClient)
 	codenavSvc := codenav.NewService(deps.ObservationCtx, db, codeIntelDB, uploadsSvc, gitserverClient)
 	rankingSvc := ranking.NewService(deps.ObservationCtx, db, codeIntelDB)
-	sentinelService := sentinel.NewService(deps.ObservationCtx, db)
+	sentinelService := sentinel.NewService(deps.ObservationCtx, db, codenavSvc)
 	contextService := context.NewService(deps.ObservationCtx, db)
+	sbomService := sbom.NewService(deps.ObservationCtx, db, uploadsSvc, sentinelService)
 
 	return Services{
 		AutoIndexingService: autoIndexingSvc,
@@ -55,6 +58,7 @@ func NewServices(deps ServiceDependencies) (Services, error) {
 		RankingService:      rankingSvc,
 		UploadsService:      uploadsSvc,
 		SentinelService:     sentinelService,
+		SBOMService:         sbomService,
 		ContextService:      contextService,
 		GitserverClient:     gitserverClient,
 	}, nil
@@ -107,6 +107,10 @@ type MockStore struct {
 	// GetOldestCommitDateFunc is an instance of a mock function object
 	// controlling the behavior of the method GetOldestCommitDate.
 	GetOldestCommitDateFunc *StoreGetOldestCommitDateFunc
+	// GetQuotaEvictionCandidatesFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// GetQuotaEvictionCandidates.
+	GetQuotaEvictionCandidatesFunc *StoreGetQuotaEvictionCandidatesFunc
 	// GetRecentIndexesSummaryFunc is an instance of a mock function object
 	// controlling the behavior of the method GetRecentIndexesSummary.
 	GetRecentIndexesSummaryFunc *StoreGetRecentIndexesSummaryFunc
@@ -124,6 +128,9 @@ type MockStore struct {
 	// object controlling the behavior of the method
 	// GetUploadIDsWithReferences.
 	GetUploadIDsWithReferencesFunc *StoreGetUploadIDsWithReferencesFunc
+	// GetUploadStorageSizeFunc is an instance of a mock function object
+	// controlling the behavior of the method GetUploadStorageSize.
+	GetUploadStorageSizeFunc *StoreGetUploadStorageSizeFunc
 	// GetUploadsFunc is an instance of a mock function object controlling
 	// the behavior of the method GetUploads.
 	GetUploadsFunc *StoreGetUploadsFunc
@@ -163,6 +170,9 @@ type MockStore struct {
 	// MarkQueuedFunc is an instance of a mock function object controlling
 	// the behavior of the method MarkQueued.
 	MarkQueuedFunc *StoreMarkQueuedFunc
+	// MarkUploadsQueriedFunc is an instance of a mock function object
+	// controlling the behavior of the method MarkUploadsQueried.
+	MarkUploadsQueriedFunc *StoreMarkUploadsQueriedFunc
 	// NumRepositoriesWithCodeIntelligenceFunc is an instance of a mock
 	// function object controlling the behavior of the method
 	// NumRepositoriesWithCodeIntelligence.
@@ -366,6 +376,11 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		GetQuotaEvictionCandidatesFunc: &StoreGetQuotaEvictionCandidatesFunc{
+			defaultHook: func(context.Context, int, int, int) (r0 []shared.QuotaEvictionCandidate, r1 error) {
+				return
+			},
+		},
 		GetRecentIndexesSummaryFunc: &StoreGetRecentIndexesSummaryFunc{
 			defaultHook: func(context.Context, int) (r0 []shared.IndexesWithRepositoryNamespace, r1 error) {
 				return
@@ -391,6 +406,11 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		GetUploadStorageSizeFunc: &StoreGetUploadStorageSizeFunc{
+			defaultHook: func(context.Context, int) (r0 int64, r1 error) {
+				return
+			},
+		},
 		GetUploadsFunc: &StoreGetUploadsFunc{
 			defaultHook: func(context.Context, shared.GetUploadsOptions) (r0 []shared.Upload, r1 int, r2 error) {
 				return
@@ -451,6 +471,11 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		MarkUploadsQueriedFunc: &StoreMarkUploadsQueriedFunc{
+			defaultHook: func(context.Context, ...int) (r0 error) {
+				return
+			},
+		},
 		NumRepositoriesWithCodeIntelligenceFunc: &StoreNumRepositoriesWithCodeIntelligenceFunc{
 			defaultHook: func(context.Context) (r0 int, r1 error) {
 				return
@@ -693,6 +718,11 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.GetOldestCommitDate")
 			},
 		},
+		GetQuotaEvictionCandidatesFunc: &StoreGetQuotaEvictionCandidatesFunc{
+			defaultHook: func(context.Context, int, int, int) ([]shared.QuotaEvictionCandidate, error) {
+				panic("unexpected invocation of MockStore.GetQuotaEvictionCandidates")
+			},
+		},
 		GetRecentIndexesSummaryFunc: &StoreGetRecentIndexesSummaryFunc{
 			defaultHook: func(context.Context, int) ([]shared.IndexesWithRepositoryNamespace, error) {
 				panic("unexpected invocation of MockStore.GetRecentIndexesSummary")
@@ -718,6 +748,11 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.GetUploadIDsWithReferences")
 			},
 		},
+		GetUploadStorageSizeFunc: &StoreGetUploadStorageSizeFunc{
+			defaultHook: func(context.Context, int) (int64, error) {
+				panic("unexpected invocation of MockStore.GetUploadStorageSize")
+			},
+		},
 		GetUploadsFunc: &StoreGetUploadsFunc{
 			defaultHook: func(context.Context, shared.GetUploadsOptions) ([]shared.Upload, int, error) {
 				panic("unexpected invocation of MockStore.GetUploads")
@@ -778,6 +813,11 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.MarkQueued")
 			},
 		},
+		MarkUploadsQueriedFunc: &StoreMarkUploadsQueriedFunc{
+			defaultHook: func(context.Context, ...int) error {
+				panic("unexpected invocation of MockStore.MarkUploadsQueried")
+			},
+		},
 		NumRepositoriesWithCodeIntelligenceFunc: &StoreNumRepositoriesWithCodeIntelligenceFunc{
 			defaultHook: func(context.Context) (int, error) {
 				panic("unexpected invocation of MockStore.NumRepositoriesWithCodeIntelligence")
@@ -970,6 +1010,9 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 		GetOldestCommitDateFunc: &StoreGetOldestCommitDateFunc{
 			defaultHook: i.GetOldestCommitDate,
 		},
+		GetQuotaEvictionCandidatesFunc: &StoreGetQuotaEvictionCandidatesFunc{
+			defaultHook: i.GetQuotaEvictionCandidates,
+		},
 		GetRecentIndexesSummaryFunc: &StoreGetRecentIndexesSummaryFunc{
 			defaultHook: i.GetRecentIndexesSummary,
 		},
@@ -985,6 +1028,9 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 		GetUploadIDsWithReferencesFunc: &StoreGetUploadIDsWithReferencesFunc{
 			defaultHook: i.GetUploadIDsWithReferences,
 		},
+		GetUploadStorageSizeFunc: &StoreGetUploadStorageSizeFunc{
+			defaultHook: i.GetUploadStorageSize,
+		},
 		GetUploadsFunc: &StoreGetUploadsFunc{
 			defaultHook: i.GetUploads,
 		},
@@ -1021,6 +1067,9 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 		MarkQueuedFunc: &StoreMarkQueuedFunc{
 			defaultHook: i.MarkQueued,
 		},
+		MarkUploadsQueriedFunc: &StoreMarkUploadsQueriedFunc{
+			defaultHook: i.MarkUploadsQueried,
+		},
 		NumRepositoriesWithCodeIntelligenceFunc: &StoreNumRepositoriesWithCodeIntelligenceFunc{
 			defaultHook: i.NumRepositoriesWithCodeIntelligence,
 		},
@@ -3885,6 +3934,123 @@ func (c StoreGetOldestCommitDateFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
+// StoreGetQuotaEvictionCandidatesFunc describes the behavior when the
+// GetQuotaEvictionCandidates method of the parent MockStore instance is
+// invoked.
+type StoreGetQuotaEvictionCandidatesFunc struct {
+	defaultHook func(context.Context, int, int, int) ([]shared.QuotaEvictionCandidate, error)
+	hooks       []func(context.Context, int, int, int) ([]shared.QuotaEvictionCandidate, error)
+	history     []StoreGetQuotaEvictionCandidatesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetQuotaEvictionCandidates delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockStore) GetQuotaEvictionCandidates(v0 context.Context, v1 int, v2 int, v3 int) ([]shared.QuotaEvictionCandidate, error) {
+	r0, r1 := m.GetQuotaEvictionCandidatesFunc.nextHook()(v0, v1, v2, v3)
+	m.GetQuotaEvictionCandidatesFunc.appendCall(StoreGetQuotaEvictionCandidatesFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetQuotaEvictionCandidates method of the parent MockStore instance is
+// invoked and the hook queue is empty.
+func (f *StoreGetQuotaEvictionCandidatesFunc) SetDefaultHook(hook func(context.Context, int, int, int) ([]shared.QuotaEvictionCandidate, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetQuotaEvictionCandidates method of the parent MockStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *StoreGetQuotaEvictionCandidatesFunc) PushHook(hook func(context.Context, int, int, int) ([]shared.QuotaEvictionCandidate, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreGetQuotaEvictionCandidatesFunc) SetDefaultReturn(r0 []shared.QuotaEvictionCandidate, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, int, int) ([]shared.QuotaEvictionCandidate, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreGetQuotaEvictionCandidatesFunc) PushReturn(r0 []shared.QuotaEvictionCandidate, r1 error) {
+	f.PushHook(func(context.Context, int, int, int) ([]shared.QuotaEvictionCandidate, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreGetQuotaEvictionCandidatesFunc) nextHook() func(context.Context, int, int, int) ([]shared.QuotaEvictionCandidate, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreGetQuotaEvictionCandidatesFunc) appendCall(r0 StoreGetQuotaEvictionCandidatesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreGetQuotaEvictionCandidatesFuncCall
+// objects describing the invocations of this function.
+func (f *StoreGetQuotaEvictionCandidatesFunc) History() []StoreGetQuotaEvictionCandidatesFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreGetQuotaEvictionCandidatesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreGetQuotaEvictionCandidatesFuncCall is an object that describes an
+// invocation of method GetQuotaEvictionCandidates on an instance of
+// MockStore.
+type StoreGetQuotaEvictionCandidatesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []shared.QuotaEvictionCandidate
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreGetQuotaEvictionCandidatesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreGetQuotaEvictionCandidatesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // StoreGetRecentIndexesSummaryFunc describes the behavior when the
 // GetRecentIndexesSummary method of the parent MockStore instance is
 // invoked.
@@ -4456,6 +4622,114 @@ func (c StoreGetUploadIDsWithReferencesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2, c.Result3}
 }
 
+// StoreGetUploadStorageSizeFunc describes the behavior when the
+// GetUploadStorageSize method of the parent MockStore instance is invoked.
+type StoreGetUploadStorageSizeFunc struct {
+	defaultHook func(context.Context, int) (int64, error)
+	hooks       []func(context.Context, int) (int64, error)
+	history     []StoreGetUploadStorageSizeFuncCall
+	mutex       sync.Mutex
+}
+
+// GetUploadStorageSize delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockStore) GetUploadStorageSize(v0 context.Context, v1 int) (int64, error) {
+	r0, r1 := m.GetUploadStorageSizeFunc.nextHook()(v0, v1)
+	m.GetUploadStorageSizeFunc.appendCall(StoreGetUploadStorageSizeFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetUploadStorageSize
+// method of the parent MockStore instance is invoked and the hook queue is
+// empty.
+func (f *StoreGetUploadStorageSizeFunc) SetDefaultHook(hook func(context.Context, int) (int64, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetUploadStorageSize method of the parent MockStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *StoreGetUploadStorageSizeFunc) PushHook(hook func(context.Context, int) (int64, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreGetUploadStorageSizeFunc) SetDefaultReturn(r0 int64, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) (int64, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreGetUploadStorageSizeFunc) PushReturn(r0 int64, r1 error) {
+	f.PushHook(func(context.Context, int) (int64, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreGetUploadStorageSizeFunc) nextHook() func(context.Context, int) (int64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreGetUploadStorageSizeFunc) appendCall(r0 StoreGetUploadStorageSizeFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreGetUploadStorageSizeFuncCall objects
+// describing the invocations of this function.
+func (f *StoreGetUploadStorageSizeFunc) History() []StoreGetUploadStorageSizeFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreGetUploadStorageSizeFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreGetUploadStorageSizeFuncCall is an object that describes an
+// invocation of method GetUploadStorageSize on an instance of MockStore.
+type StoreGetUploadStorageSizeFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int64
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreGetUploadStorageSizeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreGetUploadStorageSizeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // StoreGetUploadsFunc describes the behavior when the GetUploads method of
 // the parent MockStore instance is invoked.
 type StoreGetUploadsFunc struct {
@@ -5786,6 +6060,118 @@ func (c StoreMarkQueuedFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// StoreMarkUploadsQueriedFunc describes the behavior when the
+// MarkUploadsQueried method of the parent MockStore instance is invoked.
+type StoreMarkUploadsQueriedFunc struct {
+	defaultHook func(context.Context, ...int) error
+	hooks       []func(context.Context, ...int) error
+	history     []StoreMarkUploadsQueriedFuncCall
+	mutex       sync.Mutex
+}
+
+// MarkUploadsQueried delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockStore) MarkUploadsQueried(v0 context.Context, v1 ...int) error {
+	r0 := m.MarkUploadsQueriedFunc.nextHook()(v0, v1...)
+	m.MarkUploadsQueriedFunc.appendCall(StoreMarkUploadsQueriedFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the MarkUploadsQueried
+// method of the parent MockStore instance is invoked and the hook queue is
+// empty.
+func (f *StoreMarkUploadsQueriedFunc) SetDefaultHook(hook func(context.Context, ...int) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// MarkUploadsQueried method of the parent MockStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *StoreMarkUploadsQueriedFunc) PushHook(hook func(context.Context, ...int) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreMarkUploadsQueriedFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, ...int) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreMarkUploadsQueriedFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, ...int) error {
+		return r0
+	})
+}
+
+func (f *StoreMarkUploadsQueriedFunc) nextHook() func(context.Context, ...int) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreMarkUploadsQueriedFunc) appendCall(r0 StoreMarkUploadsQueriedFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreMarkUploadsQueriedFuncCall objects
+// describing the invocations of this function.
+func (f *StoreMarkUploadsQueriedFunc) History() []StoreMarkUploadsQueriedFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreMarkUploadsQueriedFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreMarkUploadsQueriedFuncCall is an object that describes an invocation
+// of method MarkUploadsQueried on an instance of MockStore.
+type StoreMarkUploadsQueriedFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c StoreMarkUploadsQueriedFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreMarkUploadsQueriedFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // StoreNumRepositoriesWithCodeIntelligenceFunc describes the behavior when
 // the NumRepositoriesWithCodeIntelligence method of the parent MockStore
 // instance is invoked.
@@ -11,6 +11,7 @@ type Config struct {
 
 	CommitBatchSize        int
 	ExpirerInterval        time.Duration
+	GlobalQuotaInterval    time.Duration
 	PolicyBatchSize        int
 	RepositoryBatchSize    int
 	RepositoryProcessDelay time.Duration
@@ -28,6 +29,7 @@ func (c *Config) Load() {
 
 	c.CommitBatchSize = c.GetInt(commitBatchSize, "100", "The number of commits to process per upload at a time.")
 	c.ExpirerInterval = c.GetInterval("CODEINTEL_UPLOAD_EXPIRER_INTERVAL", "1s", "How frequently to run the upload expirer routine.")
+	c.GlobalQuotaInterval = c.GetInterval("CODEINTEL_UPLOAD_EXPIRER_GLOBAL_QUOTA_INTERVAL", "1h", "How frequently to enforce the instance-wide storage quota.")
 	c.PolicyBatchSize = c.GetInt(policyBatchSize, "100", "The number of policies to consider for expiration at a time.")
 	c.RepositoryBatchSize = c.GetInt(repositoryBatchSize, "100", "The number of repositories to consider for expiration at a time.")
 	c.RepositoryProcessDelay = c.GetInterval(repositoryProcessDelay, "24h", "The minimum frequency that the same repository's uploads can be considered for expiration.")
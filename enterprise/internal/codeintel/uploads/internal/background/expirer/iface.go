@@ -11,6 +11,8 @@ import (
 
 type PolicyService interface {
 	GetConfigurationPolicies(ctx context.Context, opts policiesshared.GetConfigurationPoliciesOptions) ([]policiesshared.ConfigurationPolicy, int, error)
+	GetRepositoryStorageQuota(ctx context.Context, repositoryID int) (*int64, error)
+	GetGlobalStorageQuota(ctx context.Context) (*int64, error)
 }
 
 type PolicyMatcher interface {
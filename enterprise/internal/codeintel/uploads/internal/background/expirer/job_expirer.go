@@ -48,6 +48,9 @@ type expirer struct {
 	repoStore     database.RepoStore
 	policySvc     PolicyService
 	policyMatcher PolicyMatcher
+
+	// lastGlobalQuotaScan is the last time the instance-wide storage quota was enforced.
+	lastGlobalQuotaScan time.Time
 }
 
 // handleExpiredUploadsBatch compares the age of upload records against the age of uploads
@@ -55,6 +58,10 @@ type expirer struct {
 //
 // Uploads that are older than the protected retention age are marked as expired. Expired records with
 // no dependents will be removed by the expiredUploadDeleter.
+//
+// Repositories that still exceed their storage quota afterwards have their least valuable uploads marked
+// as expired as well. The instance-wide storage quota is enforced in the same manner, but at most once per
+// configured global quota interval.
 func (s *expirer) HandleExpiredUploadsBatch(ctx context.Context, metrics *ExpirationMetrics, cfg *Config) (err error) {
 	// Get the batch of repositories that we'll handle in this invocation of the periodic goroutine. This
 	// set should contain repositories that have yet to be updated, or that have been updated least recently.
@@ -66,13 +73,10 @@ func (s *expirer) HandleExpiredUploadsBatch(ctx context.Context, metrics *Expira
 	if err != nil {
 		return errors.Wrap(err, "uploadSvc.SelectRepositoriesForRetentionScan")
 	}
-	if len(repositories) == 0 {
-		// All repositories updated recently enough
-		return nil
-	}
 
 	now := timeutil.Now()
 
+	// Note that repositories may be empty if all repositories have been updated recently enough
 	for _, repositoryID := range repositories {
 		if repositoryErr := s.handleRepository(ctx, repositoryID, cfg, now, metrics); repositoryErr != nil {
 			if err == nil {
@@ -83,6 +87,18 @@ func (s *expirer) HandleExpiredUploadsBatch(ctx context.Context, metrics *Expira
 		}
 	}
 
+	if now.Sub(s.lastGlobalQuotaScan) >= cfg.GlobalQuotaInterval {
+		s.lastGlobalQuotaScan = now
+
+		if quotaErr := s.enforceGlobalQuota(ctx, cfg, now, metrics); quotaErr != nil {
+			if err == nil {
+				err = quotaErr
+			} else {
+				err = errors.Append(err, quotaErr)
+			}
+		}
+	}
+
 	return err
 }
 
@@ -93,7 +109,7 @@ func (s *expirer) handleRepository(ctx context.Context, repositoryID int, cfg *C
 	// never be empty as we have multiple protected data retention policies on the global scope so
 	// that all data visible from a tag or branch tip is protected for at least a short amount of
 	// time after upload.
-	commitMap, err := s.buildCommitMap(ctx, repositoryID, cfg, now)
+	commitMap, protectedPolicyIDs, err := s.buildCommitMap(ctx, repositoryID, cfg, now)
 	if err != nil {
 		return err
 	}
@@ -128,9 +144,12 @@ func (s *expirer) handleRepository(ctx context.Context, repositoryID int, cfg *C
 			LastRetentionScanBefore: &lastRetentionScanBefore,
 			InCommitGraph:           true,
 		})
-		if err != nil || len(uploads) == 0 {
+		if err != nil {
 			return err
 		}
+		if len(uploads) == 0 {
+			break
+		}
 
 		if err := s.handleUploads(ctx, commitMap, uploads, cfg, metrics, now); err != nil {
 			// Note that we collect errors in the lop of the handleUploads call, but we will still terminate
@@ -139,20 +158,25 @@ func (s *expirer) handleRepository(ctx context.Context, repositoryID int, cfg *C
 			return err
 		}
 	}
+
+	// Uploads that have outlived their retention policies are now expired. If the repository still
+	// exceeds its storage quota, evict its least valuable unprotected uploads as well.
+	return s.enforceRepositoryQuota(ctx, repositoryID, commitMap, protectedPolicyIDs, cfg, metrics, now)
 }
 
 // buildCommitMap will iterate the complete set of configuration policies that apply to a particular
-// repository and build a map from commits to the policies that apply to them.
-func (s *expirer) buildCommitMap(ctx context.Context, repositoryID int, cfg *Config, now time.Time) (map[string][]policies.PolicyMatch, error) {
+// repository and build a map from commits to the policies that apply to them. The identifiers of the
+// protected policies among them are also returned.
+func (s *expirer) buildCommitMap(ctx context.Context, repositoryID int, cfg *Config, now time.Time) (map[string][]policies.PolicyMatch, map[int]struct{}, error) {
 	var (
-		t        = true
-		offset   int
-		policies []policiesshared.ConfigurationPolicy
+		t              = true
+		offset         int
+		configPolicies []policiesshared.ConfigurationPolicy
 	)
 
 	repo, err := s.repoStore.Get(ctx, api.RepoID(repositoryID))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	repoName := repo.Name
 
@@ -165,11 +189,11 @@ func (s *expirer) buildCommitMap(ctx context.Context, repositoryID int, cfg *Con
 			Offset:           offset,
 		})
 		if err != nil {
-			return nil, errors.Wrap(err, "policySvc.GetConfigurationPolicies")
+			return nil, nil, errors.Wrap(err, "policySvc.GetConfigurationPolicies")
 		}
 
 		offset += len(policyBatch)
-		policies = append(policies, policyBatch...)
+		configPolicies = append(configPolicies, policyBatch...)
 
 		if len(policyBatch) == 0 || offset >= totalCount {
 			break
@@ -177,7 +201,12 @@ func (s *expirer) buildCommitMap(ctx context.Context, repositoryID int, cfg *Con
 	}
 
 	// Get the set of commits within this repository that match a data retention policy
-	return s.policyMatcher.CommitsDescribedByPolicy(ctx, repositoryID, repoName, policies, now)
+	commitMap, err := s.policyMatcher.CommitsDescribedByPolicy(ctx, repositoryID, repoName, configPolicies, now)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return commitMap, policies.ProtectedPolicyIDs(configPolicies), nil
 }
 
 func (s *expirer) handleUploads(
@@ -246,6 +275,21 @@ func (s *expirer) isUploadProtectedByPolicy(
 ) (bool, error) {
 	metrics.NumUploadsScanned.Inc()
 
+	return s.isUploadProtected(ctx, commitMap, upload.ID, cfg, metrics, func(policyMatch policies.PolicyMatch) bool {
+		return policyMatch.PolicyDuration == nil || now.Sub(upload.UploadedAt) < *policyMatch.PolicyDuration
+	})
+}
+
+// isUploadProtected returns true if any commit visible to the given upload is matched by a policy for which
+// the given protects function returns true.
+func (s *expirer) isUploadProtected(
+	ctx context.Context,
+	commitMap map[string][]policies.PolicyMatch,
+	uploadID int,
+	cfg *Config,
+	metrics *ExpirationMetrics,
+	protects func(policyMatch policies.PolicyMatch) bool,
+) (bool, error) {
 	var token *string
 
 	for first := true; first || token != nil; first = false {
@@ -259,7 +303,7 @@ func (s *expirer) isUploadProtectedByPolicy(
 		//
 		// We check the set of commits visible to an upload in batches as in some cases it can be very large; for
 		// example, a single historic commit providing code intelligence for all descendants.
-		commits, nextToken, err := s.store.GetCommitsVisibleToUpload(ctx, upload.ID, cfg.CommitBatchSize, token)
+		commits, nextToken, err := s.store.GetCommitsVisibleToUpload(ctx, uploadID, cfg.CommitBatchSize, token)
 		if err != nil {
 			return false, errors.Wrap(err, "uploadSvc.CommitsVisibleToUpload")
 		}
@@ -270,7 +314,7 @@ func (s *expirer) isUploadProtectedByPolicy(
 		for _, commit := range commits {
 			if policyMatches, ok := commitMap[commit]; ok {
 				for _, policyMatch := range policyMatches {
-					if policyMatch.PolicyDuration == nil || now.Sub(upload.UploadedAt) < *policyMatch.PolicyDuration {
+					if protects(policyMatch) {
 						return true, nil
 					}
 				}
@@ -280,3 +324,139 @@ func (s *expirer) isUploadProtectedByPolicy(
 
 	return false, nil
 }
+
+// enforceRepositoryQuota evicts the least valuable uploads of the given repository until the size of its
+// remaining uploads fits within its storage quota. Uploads protected by a protected policy or visible from
+// the tip of the default branch are never evicted, even if the repository remains over quota.
+func (s *expirer) enforceRepositoryQuota(
+	ctx context.Context,
+	repositoryID int,
+	commitMap map[string][]policies.PolicyMatch,
+	protectedPolicyIDs map[int]struct{},
+	cfg *Config,
+	metrics *ExpirationMetrics,
+	now time.Time,
+) error {
+	maxBytes, err := s.policySvc.GetRepositoryStorageQuota(ctx, repositoryID)
+	if err != nil {
+		return errors.Wrap(err, "policySvc.GetRepositoryStorageQuota")
+	}
+	if maxBytes == nil {
+		return nil
+	}
+
+	size, err := s.store.GetUploadStorageSize(ctx, repositoryID)
+	if err != nil {
+		return errors.Wrap(err, "uploadSvc.GetUploadStorageSize")
+	}
+
+	policiesForRepository := func(int) (map[string][]policies.PolicyMatch, map[int]struct{}, error) {
+		return commitMap, protectedPolicyIDs, nil
+	}
+
+	return s.evictForQuota(ctx, repositoryID, size-*maxBytes, policiesForRepository, cfg, metrics, now)
+}
+
+// enforceGlobalQuota evicts the least valuable uploads across all repositories until the size of the
+// remaining uploads fits within the instance-wide storage quota.
+func (s *expirer) enforceGlobalQuota(ctx context.Context, cfg *Config, now time.Time, metrics *ExpirationMetrics) error {
+	maxBytes, err := s.policySvc.GetGlobalStorageQuota(ctx)
+	if err != nil {
+		return errors.Wrap(err, "policySvc.GetGlobalStorageQuota")
+	}
+	if maxBytes == nil {
+		return nil
+	}
+
+	size, err := s.store.GetUploadStorageSize(ctx, 0)
+	if err != nil {
+		return errors.Wrap(err, "uploadSvc.GetUploadStorageSize")
+	}
+
+	type repositoryPolicies struct {
+		commitMap          map[string][]policies.PolicyMatch
+		protectedPolicyIDs map[int]struct{}
+	}
+	cache := map[int]repositoryPolicies{}
+
+	policiesForRepository := func(repositoryID int) (map[string][]policies.PolicyMatch, map[int]struct{}, error) {
+		if p, ok := cache[repositoryID]; ok {
+			return p.commitMap, p.protectedPolicyIDs, nil
+		}
+
+		commitMap, protectedPolicyIDs, err := s.buildCommitMap(ctx, repositoryID, cfg, now)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		cache[repositoryID] = repositoryPolicies{commitMap, protectedPolicyIDs}
+		return commitMap, protectedPolicyIDs, nil
+	}
+
+	return s.evictForQuota(ctx, 0, size-*maxBytes, policiesForRepository, cfg, metrics, now)
+}
+
+// evictForQuota marks the least valuable unprotected uploads of the given repository (or of all repositories
+// if the given repository identifier is zero) as expired until at least excess bytes have been evicted or no
+// candidates remain.
+func (s *expirer) evictForQuota(
+	ctx context.Context,
+	repositoryID int,
+	excess int64,
+	policiesForRepository func(repositoryID int) (map[string][]policies.PolicyMatch, map[int]struct{}, error),
+	cfg *Config,
+	metrics *ExpirationMetrics,
+	now time.Time,
+) error {
+	// Evicted uploads are no longer returned as candidates, so we only need to page past the
+	// candidates that we have decided to keep.
+	offset := 0
+
+	for excess > 0 {
+		candidates, err := s.store.GetQuotaEvictionCandidates(ctx, repositoryID, cfg.UploadBatchSize, offset)
+		if err != nil {
+			return errors.Wrap(err, "uploadSvc.GetQuotaEvictionCandidates")
+		}
+		if len(candidates) == 0 {
+			return nil
+		}
+
+		evictedUploadIDs := make([]int, 0, len(candidates))
+		for _, candidate := range candidates {
+			if excess <= 0 {
+				break
+			}
+
+			commitMap, protectedPolicyIDs, err := policiesForRepository(candidate.RepositoryID)
+			if err != nil {
+				return err
+			}
+
+			uploadedAt := candidate.UploadedAt
+			protected, err := s.isUploadProtected(ctx, commitMap, candidate.UploadID, cfg, metrics, func(policyMatch policies.PolicyMatch) bool {
+				return policies.ProtectsFromQuotaEviction(policyMatch, protectedPolicyIDs, uploadedAt, now)
+			})
+			if err != nil {
+				return err
+			}
+
+			if protected {
+				offset++
+				continue
+			}
+
+			evictedUploadIDs = append(evictedUploadIDs, candidate.UploadID)
+			excess -= candidate.Size
+		}
+
+		if len(evictedUploadIDs) > 0 {
+			if err := s.store.UpdateUploadRetention(ctx, nil, evictedUploadIDs); err != nil {
+				return errors.Wrap(err, "uploadSvc.UpdateUploadRetention")
+			}
+
+			metrics.NumUploadsEvictedForQuota.Add(float64(len(evictedUploadIDs)))
+		}
+	}
+
+	return nil
+}
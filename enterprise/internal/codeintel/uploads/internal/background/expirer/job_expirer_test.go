@@ -91,6 +91,166 @@ func TestUploadExpirer(t *testing.T) {
 	}
 }
 
+func TestUploadExpirerStorageQuota(t *testing.T) {
+	now := timeutil.Now()
+
+	// Candidates are listed in eviction order; the sum of their sizes is 370 bytes
+	candidates := []uploadsshared.QuotaEvictionCandidate{
+		{UploadID: 1, RepositoryID: 50, Commit: "c-protected", Size: 100, UploadedAt: daysAgo(now, 3)},
+		{UploadID: 2, RepositoryID: 51, Commit: "c-unprotected", Size: 50, UploadedAt: daysAgo(now, 3)},
+		{UploadID: 3, RepositoryID: 50, Commit: "c-old", Size: 50, UploadedAt: daysAgo(now, 3)},
+		{UploadID: 4, RepositoryID: 51, Commit: "c-tip", Size: 100, UploadedAt: daysAgo(now, 2)},
+		{UploadID: 5, RepositoryID: 50, Commit: "c-other", Size: 60, UploadedAt: daysAgo(now, 1)},
+		{UploadID: 6, RepositoryID: 51, Commit: "c-new", Size: 10, UploadedAt: now},
+	}
+
+	setup := func(repositoryQuota, globalQuota *int64) (*MockStore, *MockPolicyMatcher, *expirer) {
+		evicted := map[int]struct{}{}
+
+		uploadSvc := NewMockStore()
+		uploadSvc.SetRepositoriesForRetentionScanFunc.PushReturn([]int{50}, nil)
+		uploadSvc.GetUploadStorageSizeFunc.SetDefaultHook(func(ctx context.Context, repositoryID int) (size int64, _ error) {
+			for _, candidate := range candidates {
+				if _, ok := evicted[candidate.UploadID]; ok {
+					continue
+				}
+				if repositoryID == 0 || candidate.RepositoryID == repositoryID {
+					size += candidate.Size
+				}
+			}
+			return size, nil
+		})
+		uploadSvc.GetQuotaEvictionCandidatesFunc.SetDefaultHook(func(ctx context.Context, repositoryID, limit, offset int) (filtered []uploadsshared.QuotaEvictionCandidate, _ error) {
+			for _, candidate := range candidates {
+				if _, ok := evicted[candidate.UploadID]; ok || (repositoryID != 0 && candidate.RepositoryID != repositoryID) {
+					continue
+				}
+				filtered = append(filtered, candidate)
+			}
+			if offset > len(filtered) {
+				return nil, nil
+			}
+			filtered = filtered[offset:]
+			if len(filtered) > limit {
+				filtered = filtered[:limit]
+			}
+			return filtered, nil
+		})
+		uploadSvc.GetCommitsVisibleToUploadFunc.SetDefaultHook(func(ctx context.Context, uploadID, limit int, token *string) ([]string, *string, error) {
+			return []string{candidates[uploadID-1].Commit}, nil, nil
+		})
+		uploadSvc.UpdateUploadRetentionFunc.SetDefaultHook(func(ctx context.Context, protectedIDs, expiredIDs []int) error {
+			for _, id := range expiredIDs {
+				evicted[id] = struct{}{}
+			}
+			return nil
+		})
+
+		policySvc := NewMockPolicyService()
+		policySvc.GetConfigurationPoliciesFunc.SetDefaultHook(func(ctx context.Context, opts policiesshared.GetConfigurationPoliciesOptions) ([]policiesshared.ConfigurationPolicy, int, error) {
+			return []policiesshared.ConfigurationPolicy{{ID: 1, Protected: true}, {ID: 2}}, 2, nil
+		})
+		policySvc.GetRepositoryStorageQuotaFunc.SetDefaultReturn(repositoryQuota, nil)
+		policySvc.GetGlobalStorageQuotaFunc.SetDefaultReturn(globalQuota, nil)
+
+		policyMatcher := NewMockPolicyMatcher()
+		policyMatcher.CommitsDescribedByPolicyFunc.SetDefaultReturn(map[string][]policies.PolicyMatch{
+			"c-protected":   {{PolicyID: pointers.Ptr(1)}},
+			"c-unprotected": {{PolicyID: pointers.Ptr(2), PolicyDuration: days(365)}},
+			"c-tip":         {{PolicyID: nil}},
+		}, nil)
+
+		return uploadSvc, policyMatcher, &expirer{
+			store:         uploadSvc,
+			policySvc:     policySvc,
+			policyMatcher: policyMatcher,
+			repoStore:     defaultMockRepoStore(),
+		}
+	}
+
+	evictedIDs := func(uploadSvc *MockStore) (ids []int) {
+		for _, call := range uploadSvc.UpdateUploadRetentionFunc.History() {
+			ids = append(ids, call.Arg2...)
+		}
+		sort.Ints(ids)
+		return ids
+	}
+
+	cfg := &Config{
+		UploadBatchSize:     2,
+		CommitBatchSize:     100,
+		PolicyBatchSize:     100,
+		GlobalQuotaInterval: time.Hour,
+	}
+
+	t.Run("repository", func(t *testing.T) {
+		// Repository 50 holds 210 bytes; 110 bytes must be evicted
+		uploadSvc, _, uploadExpirer := setup(pointers.Ptr(int64(100)), nil)
+
+		if err := uploadExpirer.HandleExpiredUploadsBatch(context.Background(), NewExpirationMetrics(&observation.TestContext), cfg); err != nil {
+			t.Fatalf("unexpected error from handle: %s", err)
+		}
+
+		if diff := cmp.Diff([]int{3, 5}, evictedIDs(uploadSvc)); diff != "" {
+			t.Errorf("unexpected evicted upload identifiers (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("repeated enforcement", func(t *testing.T) {
+		// Repository 50 holds 210 bytes; 50 bytes must be evicted
+		uploadSvc, policyMatcher, uploadExpirer := setup(pointers.Ptr(int64(160)), nil)
+		metrics := NewExpirationMetrics(&observation.TestContext)
+
+		commitMap, err := policyMatcher.CommitsDescribedByPolicy(context.Background(), 50, "", nil, now)
+		if err != nil {
+			t.Fatalf("unexpected error building commit map: %s", err)
+		}
+		protectedPolicyIDs := map[int]struct{}{1: {}}
+
+		if err := uploadExpirer.enforceRepositoryQuota(context.Background(), 50, commitMap, protectedPolicyIDs, cfg, metrics, now); err != nil {
+			t.Fatalf("unexpected error enforcing quota: %s", err)
+		}
+		if diff := cmp.Diff([]int{3}, evictedIDs(uploadSvc)); diff != "" {
+			t.Errorf("unexpected evicted upload identifiers (-want +got):\n%s", diff)
+		}
+
+		// Evicted uploads no longer count toward the quota, so nothing else is evicted
+		if err := uploadExpirer.enforceRepositoryQuota(context.Background(), 50, commitMap, protectedPolicyIDs, cfg, metrics, now); err != nil {
+			t.Fatalf("unexpected error enforcing quota: %s", err)
+		}
+		if diff := cmp.Diff([]int{3}, evictedIDs(uploadSvc)); diff != "" {
+			t.Errorf("unexpected evicted upload identifiers after second run (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("global", func(t *testing.T) {
+		// All repositories hold 370 bytes; 210 bytes must be evicted, but only 170 bytes are unprotected
+		uploadSvc, policyMatcher, uploadExpirer := setup(nil, pointers.Ptr(int64(160)))
+
+		if err := uploadExpirer.HandleExpiredUploadsBatch(context.Background(), NewExpirationMetrics(&observation.TestContext), cfg); err != nil {
+			t.Fatalf("unexpected error from handle: %s", err)
+		}
+
+		if diff := cmp.Diff([]int{2, 3, 5, 6}, evictedIDs(uploadSvc)); diff != "" {
+			t.Errorf("unexpected evicted upload identifiers (-want +got):\n%s", diff)
+		}
+
+		// Repository 50 is scanned for retention, then once more for each repository during quota enforcement
+		if calls := len(policyMatcher.CommitsDescribedByPolicyFunc.History()); calls != 3 {
+			t.Errorf("unexpected number of calls to CommitsDescribedByPolicy. want=%d have=%d", 3, calls)
+		}
+
+		// The global quota is not re-enforced within the configured interval
+		numCalls := len(uploadSvc.GetQuotaEvictionCandidatesFunc.History())
+		if err := uploadExpirer.HandleExpiredUploadsBatch(context.Background(), NewExpirationMetrics(&observation.TestContext), cfg); err != nil {
+			t.Fatalf("unexpected error from handle: %s", err)
+		}
+		if calls := len(uploadSvc.GetQuotaEvictionCandidatesFunc.History()); calls != numCalls {
+			t.Errorf("unexpected number of calls to GetQuotaEvictionCandidates. want=%d have=%d", numCalls, calls)
+		}
+	})
+}
+
 func setupMockPolicyService() *MockPolicyService {
 	policies := []policiesshared.ConfigurationPolicy{
 		{ID: 1, RepositoryID: nil},
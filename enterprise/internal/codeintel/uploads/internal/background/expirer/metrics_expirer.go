@@ -8,10 +8,11 @@ import (
 )
 
 type ExpirationMetrics struct {
-	NumRepositoriesScanned prometheus.Counter
-	NumUploadsExpired      prometheus.Counter
-	NumUploadsScanned      prometheus.Counter
-	NumCommitsScanned      prometheus.Counter
+	NumRepositoriesScanned    prometheus.Counter
+	NumUploadsExpired         prometheus.Counter
+	NumUploadsEvictedForQuota prometheus.Counter
+	NumUploadsScanned         prometheus.Counter
+	NumCommitsScanned         prometheus.Counter
 }
 
 var expirationMetrics = memo.NewMemoizedConstructorWithArg(func(r prometheus.Registerer) (*ExpirationMetrics, error) {
@@ -41,12 +42,17 @@ var expirationMetrics = memo.NewMemoizedConstructorWithArg(func(r prometheus.Reg
 		"src_codeintel_background_upload_records_expired_total",
 		"The number of codeintel upload records marked as expired.",
 	)
+	numUploadsEvictedForQuota := counter(
+		"src_codeintel_background_upload_records_evicted_for_quota_total",
+		"The number of codeintel upload records marked as expired to satisfy a storage quota.",
+	)
 
 	return &ExpirationMetrics{
-		NumRepositoriesScanned: numRepositoriesScanned,
-		NumUploadsScanned:      numUploadsScanned,
-		NumCommitsScanned:      numCommitsScanned,
-		NumUploadsExpired:      numUploadsExpired,
+		NumRepositoriesScanned:    numRepositoriesScanned,
+		NumUploadsScanned:         numUploadsScanned,
+		NumCommitsScanned:         numCommitsScanned,
+		NumUploadsExpired:         numUploadsExpired,
+		NumUploadsEvictedForQuota: numUploadsEvictedForQuota,
 	}, nil
 })
 
@@ -212,6 +212,13 @@ type MockPolicyService struct {
 	// GetConfigurationPoliciesFunc is an instance of a mock function object
 	// controlling the behavior of the method GetConfigurationPolicies.
 	GetConfigurationPoliciesFunc *PolicyServiceGetConfigurationPoliciesFunc
+	// GetGlobalStorageQuotaFunc is an instance of a mock function object
+	// controlling the behavior of the method GetGlobalStorageQuota.
+	GetGlobalStorageQuotaFunc *PolicyServiceGetGlobalStorageQuotaFunc
+	// GetRepositoryStorageQuotaFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// GetRepositoryStorageQuota.
+	GetRepositoryStorageQuotaFunc *PolicyServiceGetRepositoryStorageQuotaFunc
 }
 
 // NewMockPolicyService creates a new mock of the PolicyService interface.
@@ -223,6 +230,16 @@ func NewMockPolicyService() *MockPolicyService {
 				return
 			},
 		},
+		GetGlobalStorageQuotaFunc: &PolicyServiceGetGlobalStorageQuotaFunc{
+			defaultHook: func(context.Context) (r0 *int64, r1 error) {
+				return
+			},
+		},
+		GetRepositoryStorageQuotaFunc: &PolicyServiceGetRepositoryStorageQuotaFunc{
+			defaultHook: func(context.Context, int) (r0 *int64, r1 error) {
+				return
+			},
+		},
 	}
 }
 
@@ -235,6 +252,16 @@ func NewStrictMockPolicyService() *MockPolicyService {
 				panic("unexpected invocation of MockPolicyService.GetConfigurationPolicies")
 			},
 		},
+		GetGlobalStorageQuotaFunc: &PolicyServiceGetGlobalStorageQuotaFunc{
+			defaultHook: func(context.Context) (*int64, error) {
+				panic("unexpected invocation of MockPolicyService.GetGlobalStorageQuota")
+			},
+		},
+		GetRepositoryStorageQuotaFunc: &PolicyServiceGetRepositoryStorageQuotaFunc{
+			defaultHook: func(context.Context, int) (*int64, error) {
+				panic("unexpected invocation of MockPolicyService.GetRepositoryStorageQuota")
+			},
+		},
 	}
 }
 
@@ -246,6 +273,12 @@ func NewMockPolicyServiceFrom(i PolicyService) *MockPolicyService {
 		GetConfigurationPoliciesFunc: &PolicyServiceGetConfigurationPoliciesFunc{
 			defaultHook: i.GetConfigurationPolicies,
 		},
+		GetGlobalStorageQuotaFunc: &PolicyServiceGetGlobalStorageQuotaFunc{
+			defaultHook: i.GetGlobalStorageQuota,
+		},
+		GetRepositoryStorageQuotaFunc: &PolicyServiceGetRepositoryStorageQuotaFunc{
+			defaultHook: i.GetRepositoryStorageQuota,
+		},
 	}
 }
 
@@ -364,6 +397,226 @@ func (c PolicyServiceGetConfigurationPoliciesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
+// PolicyServiceGetGlobalStorageQuotaFunc describes the behavior when the
+// GetGlobalStorageQuota method of the parent MockPolicyService instance is
+// invoked.
+type PolicyServiceGetGlobalStorageQuotaFunc struct {
+	defaultHook func(context.Context) (*int64, error)
+	hooks       []func(context.Context) (*int64, error)
+	history     []PolicyServiceGetGlobalStorageQuotaFuncCall
+	mutex       sync.Mutex
+}
+
+// GetGlobalStorageQuota delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockPolicyService) GetGlobalStorageQuota(v0 context.Context) (*int64, error) {
+	r0, r1 := m.GetGlobalStorageQuotaFunc.nextHook()(v0)
+	m.GetGlobalStorageQuotaFunc.appendCall(PolicyServiceGetGlobalStorageQuotaFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetGlobalStorageQuota method of the parent MockPolicyService instance is
+// invoked and the hook queue is empty.
+func (f *PolicyServiceGetGlobalStorageQuotaFunc) SetDefaultHook(hook func(context.Context) (*int64, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetGlobalStorageQuota method of the parent MockPolicyService instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *PolicyServiceGetGlobalStorageQuotaFunc) PushHook(hook func(context.Context) (*int64, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *PolicyServiceGetGlobalStorageQuotaFunc) SetDefaultReturn(r0 *int64, r1 error) {
+	f.SetDefaultHook(func(context.Context) (*int64, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *PolicyServiceGetGlobalStorageQuotaFunc) PushReturn(r0 *int64, r1 error) {
+	f.PushHook(func(context.Context) (*int64, error) {
+		return r0, r1
+	})
+}
+
+func (f *PolicyServiceGetGlobalStorageQuotaFunc) nextHook() func(context.Context) (*int64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *PolicyServiceGetGlobalStorageQuotaFunc) appendCall(r0 PolicyServiceGetGlobalStorageQuotaFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of PolicyServiceGetGlobalStorageQuotaFuncCall
+// objects describing the invocations of this function.
+func (f *PolicyServiceGetGlobalStorageQuotaFunc) History() []PolicyServiceGetGlobalStorageQuotaFuncCall {
+	f.mutex.Lock()
+	history := make([]PolicyServiceGetGlobalStorageQuotaFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// PolicyServiceGetGlobalStorageQuotaFuncCall is an object that describes an
+// invocation of method GetGlobalStorageQuota on an instance of
+// MockPolicyService.
+type PolicyServiceGetGlobalStorageQuotaFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *int64
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c PolicyServiceGetGlobalStorageQuotaFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c PolicyServiceGetGlobalStorageQuotaFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// PolicyServiceGetRepositoryStorageQuotaFunc describes the behavior when
+// the GetRepositoryStorageQuota method of the parent MockPolicyService
+// instance is invoked.
+type PolicyServiceGetRepositoryStorageQuotaFunc struct {
+	defaultHook func(context.Context, int) (*int64, error)
+	hooks       []func(context.Context, int) (*int64, error)
+	history     []PolicyServiceGetRepositoryStorageQuotaFuncCall
+	mutex       sync.Mutex
+}
+
+// GetRepositoryStorageQuota delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockPolicyService) GetRepositoryStorageQuota(v0 context.Context, v1 int) (*int64, error) {
+	r0, r1 := m.GetRepositoryStorageQuotaFunc.nextHook()(v0, v1)
+	m.GetRepositoryStorageQuotaFunc.appendCall(PolicyServiceGetRepositoryStorageQuotaFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetRepositoryStorageQuota method of the parent MockPolicyService instance
+// is invoked and the hook queue is empty.
+func (f *PolicyServiceGetRepositoryStorageQuotaFunc) SetDefaultHook(hook func(context.Context, int) (*int64, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetRepositoryStorageQuota method of the parent MockPolicyService instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *PolicyServiceGetRepositoryStorageQuotaFunc) PushHook(hook func(context.Context, int) (*int64, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *PolicyServiceGetRepositoryStorageQuotaFunc) SetDefaultReturn(r0 *int64, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) (*int64, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *PolicyServiceGetRepositoryStorageQuotaFunc) PushReturn(r0 *int64, r1 error) {
+	f.PushHook(func(context.Context, int) (*int64, error) {
+		return r0, r1
+	})
+}
+
+func (f *PolicyServiceGetRepositoryStorageQuotaFunc) nextHook() func(context.Context, int) (*int64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *PolicyServiceGetRepositoryStorageQuotaFunc) appendCall(r0 PolicyServiceGetRepositoryStorageQuotaFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// PolicyServiceGetRepositoryStorageQuotaFuncCall objects describing the
+// invocations of this function.
+func (f *PolicyServiceGetRepositoryStorageQuotaFunc) History() []PolicyServiceGetRepositoryStorageQuotaFuncCall {
+	f.mutex.Lock()
+	history := make([]PolicyServiceGetRepositoryStorageQuotaFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// PolicyServiceGetRepositoryStorageQuotaFuncCall is an object that
+// describes an invocation of method GetRepositoryStorageQuota on an
+// instance of MockPolicyService.
+type PolicyServiceGetRepositoryStorageQuotaFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *int64
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c PolicyServiceGetRepositoryStorageQuotaFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c PolicyServiceGetRepositoryStorageQuotaFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // MockStore is a mock implementation of the Store interface (from the
 // package
 // github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/internal/store)
@@ -451,6 +704,10 @@ type MockStore struct {
 	// GetOldestCommitDateFunc is an instance of a mock function object
 	// controlling the behavior of the method GetOldestCommitDate.
 	GetOldestCommitDateFunc *StoreGetOldestCommitDateFunc
+	// GetQuotaEvictionCandidatesFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// GetQuotaEvictionCandidates.
+	GetQuotaEvictionCandidatesFunc *StoreGetQuotaEvictionCandidatesFunc
 	// GetRecentIndexesSummaryFunc is an instance of a mock function object
 	// controlling the behavior of the method GetRecentIndexesSummary.
 	GetRecentIndexesSummaryFunc *StoreGetRecentIndexesSummaryFunc
@@ -468,6 +725,9 @@ type MockStore struct {
 	// object controlling the behavior of the method
 	// GetUploadIDsWithReferences.
 	GetUploadIDsWithReferencesFunc *StoreGetUploadIDsWithReferencesFunc
+	// GetUploadStorageSizeFunc is an instance of a mock function object
+	// controlling the behavior of the method GetUploadStorageSize.
+	GetUploadStorageSizeFunc *StoreGetUploadStorageSizeFunc
 	// GetUploadsFunc is an instance of a mock function object controlling
 	// the behavior of the method GetUploads.
 	GetUploadsFunc *StoreGetUploadsFunc
@@ -507,6 +767,9 @@ type MockStore struct {
 	// MarkQueuedFunc is an instance of a mock function object controlling
 	// the behavior of the method MarkQueued.
 	MarkQueuedFunc *StoreMarkQueuedFunc
+	// MarkUploadsQueriedFunc is an instance of a mock function object
+	// controlling the behavior of the method MarkUploadsQueried.
+	MarkUploadsQueriedFunc *StoreMarkUploadsQueriedFunc
 	// NumRepositoriesWithCodeIntelligenceFunc is an instance of a mock
 	// function object controlling the behavior of the method
 	// NumRepositoriesWithCodeIntelligence.
@@ -710,6 +973,11 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		GetQuotaEvictionCandidatesFunc: &StoreGetQuotaEvictionCandidatesFunc{
+			defaultHook: func(context.Context, int, int, int) (r0 []shared1.QuotaEvictionCandidate, r1 error) {
+				return
+			},
+		},
 		GetRecentIndexesSummaryFunc: &StoreGetRecentIndexesSummaryFunc{
 			defaultHook: func(context.Context, int) (r0 []shared1.IndexesWithRepositoryNamespace, r1 error) {
 				return
@@ -735,6 +1003,11 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		GetUploadStorageSizeFunc: &StoreGetUploadStorageSizeFunc{
+			defaultHook: func(context.Context, int) (r0 int64, r1 error) {
+				return
+			},
+		},
 		GetUploadsFunc: &StoreGetUploadsFunc{
 			defaultHook: func(context.Context, shared1.GetUploadsOptions) (r0 []shared1.Upload, r1 int, r2 error) {
 				return
@@ -795,6 +1068,11 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		MarkUploadsQueriedFunc: &StoreMarkUploadsQueriedFunc{
+			defaultHook: func(context.Context, ...int) (r0 error) {
+				return
+			},
+		},
 		NumRepositoriesWithCodeIntelligenceFunc: &StoreNumRepositoriesWithCodeIntelligenceFunc{
 			defaultHook: func(context.Context) (r0 int, r1 error) {
 				return
@@ -1037,6 +1315,11 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.GetOldestCommitDate")
 			},
 		},
+		GetQuotaEvictionCandidatesFunc: &StoreGetQuotaEvictionCandidatesFunc{
+			defaultHook: func(context.Context, int, int, int) ([]shared1.QuotaEvictionCandidate, error) {
+				panic("unexpected invocation of MockStore.GetQuotaEvictionCandidates")
+			},
+		},
 		GetRecentIndexesSummaryFunc: &StoreGetRecentIndexesSummaryFunc{
 			defaultHook: func(context.Context, int) ([]shared1.IndexesWithRepositoryNamespace, error) {
 				panic("unexpected invocation of MockStore.GetRecentIndexesSummary")
@@ -1062,6 +1345,11 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.GetUploadIDsWithReferences")
 			},
 		},
+		GetUploadStorageSizeFunc: &StoreGetUploadStorageSizeFunc{
+			defaultHook: func(context.Context, int) (int64, error) {
+				panic("unexpected invocation of MockStore.GetUploadStorageSize")
+			},
+		},
 		GetUploadsFunc: &StoreGetUploadsFunc{
 			defaultHook: func(context.Context, shared1.GetUploadsOptions) ([]shared1.Upload, int, error) {
 				panic("unexpected invocation of MockStore.GetUploads")
@@ -1122,6 +1410,11 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.MarkQueued")
 			},
 		},
+		MarkUploadsQueriedFunc: &StoreMarkUploadsQueriedFunc{
+			defaultHook: func(context.Context, ...int) error {
+				panic("unexpected invocation of MockStore.MarkUploadsQueried")
+			},
+		},
 		NumRepositoriesWithCodeIntelligenceFunc: &StoreNumRepositoriesWithCodeIntelligenceFunc{
 			defaultHook: func(context.Context) (int, error) {
 				panic("unexpected invocation of MockStore.NumRepositoriesWithCodeIntelligence")
@@ -1314,6 +1607,9 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 		GetOldestCommitDateFunc: &StoreGetOldestCommitDateFunc{
 			defaultHook: i.GetOldestCommitDate,
 		},
+		GetQuotaEvictionCandidatesFunc: &StoreGetQuotaEvictionCandidatesFunc{
+			defaultHook: i.GetQuotaEvictionCandidates,
+		},
 		GetRecentIndexesSummaryFunc: &StoreGetRecentIndexesSummaryFunc{
 			defaultHook: i.GetRecentIndexesSummary,
 		},
@@ -1329,6 +1625,9 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 		GetUploadIDsWithReferencesFunc: &StoreGetUploadIDsWithReferencesFunc{
 			defaultHook: i.GetUploadIDsWithReferences,
 		},
+		GetUploadStorageSizeFunc: &StoreGetUploadStorageSizeFunc{
+			defaultHook: i.GetUploadStorageSize,
+		},
 		GetUploadsFunc: &StoreGetUploadsFunc{
 			defaultHook: i.GetUploads,
 		},
@@ -1365,6 +1664,9 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 		MarkQueuedFunc: &StoreMarkQueuedFunc{
 			defaultHook: i.MarkQueued,
 		},
+		MarkUploadsQueriedFunc: &StoreMarkUploadsQueriedFunc{
+			defaultHook: i.MarkUploadsQueried,
+		},
 		NumRepositoriesWithCodeIntelligenceFunc: &StoreNumRepositoriesWithCodeIntelligenceFunc{
 			defaultHook: i.NumRepositoriesWithCodeIntelligence,
 		},
@@ -4229,36 +4531,37 @@ func (c StoreGetOldestCommitDateFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// StoreGetRecentIndexesSummaryFunc describes the behavior when the
-// GetRecentIndexesSummary method of the parent MockStore instance is
+// StoreGetQuotaEvictionCandidatesFunc describes the behavior when the
+// GetQuotaEvictionCandidates method of the parent MockStore instance is
 // invoked.
-type StoreGetRecentIndexesSummaryFunc struct {
-	defaultHook func(context.Context, int) ([]shared1.IndexesWithRepositoryNamespace, error)
-	hooks       []func(context.Context, int) ([]shared1.IndexesWithRepositoryNamespace, error)
-	history     []StoreGetRecentIndexesSummaryFuncCall
+type StoreGetQuotaEvictionCandidatesFunc struct {
+	defaultHook func(context.Context, int, int, int) ([]shared1.QuotaEvictionCandidate, error)
+	hooks       []func(context.Context, int, int, int) ([]shared1.QuotaEvictionCandidate, error)
+	history     []StoreGetQuotaEvictionCandidatesFuncCall
 	mutex       sync.Mutex
 }
 
-// GetRecentIndexesSummary delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockStore) GetRecentIndexesSummary(v0 context.Context, v1 int) ([]shared1.IndexesWithRepositoryNamespace, error) {
-	r0, r1 := m.GetRecentIndexesSummaryFunc.nextHook()(v0, v1)
-	m.GetRecentIndexesSummaryFunc.appendCall(StoreGetRecentIndexesSummaryFuncCall{v0, v1, r0, r1})
+// GetQuotaEvictionCandidates delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockStore) GetQuotaEvictionCandidates(v0 context.Context, v1 int, v2 int, v3 int) ([]shared1.QuotaEvictionCandidate, error) {
+	r0, r1 := m.GetQuotaEvictionCandidatesFunc.nextHook()(v0, v1, v2, v3)
+	m.GetQuotaEvictionCandidatesFunc.appendCall(StoreGetQuotaEvictionCandidatesFuncCall{v0, v1, v2, v3, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
-// GetRecentIndexesSummary method of the parent MockStore instance is
+// GetQuotaEvictionCandidates method of the parent MockStore instance is
 // invoked and the hook queue is empty.
-func (f *StoreGetRecentIndexesSummaryFunc) SetDefaultHook(hook func(context.Context, int) ([]shared1.IndexesWithRepositoryNamespace, error)) {
+func (f *StoreGetQuotaEvictionCandidatesFunc) SetDefaultHook(hook func(context.Context, int, int, int) ([]shared1.QuotaEvictionCandidate, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetRecentIndexesSummary method of the parent MockStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *StoreGetRecentIndexesSummaryFunc) PushHook(hook func(context.Context, int) ([]shared1.IndexesWithRepositoryNamespace, error)) {
+// GetQuotaEvictionCandidates method of the parent MockStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *StoreGetQuotaEvictionCandidatesFunc) PushHook(hook func(context.Context, int, int, int) ([]shared1.QuotaEvictionCandidate, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4266,20 +4569,20 @@ func (f *StoreGetRecentIndexesSummaryFunc) PushHook(hook func(context.Context, i
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *StoreGetRecentIndexesSummaryFunc) SetDefaultReturn(r0 []shared1.IndexesWithRepositoryNamespace, r1 error) {
-	f.SetDefaultHook(func(context.Context, int) ([]shared1.IndexesWithRepositoryNamespace, error) {
+func (f *StoreGetQuotaEvictionCandidatesFunc) SetDefaultReturn(r0 []shared1.QuotaEvictionCandidate, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, int, int) ([]shared1.QuotaEvictionCandidate, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *StoreGetRecentIndexesSummaryFunc) PushReturn(r0 []shared1.IndexesWithRepositoryNamespace, r1 error) {
-	f.PushHook(func(context.Context, int) ([]shared1.IndexesWithRepositoryNamespace, error) {
+func (f *StoreGetQuotaEvictionCandidatesFunc) PushReturn(r0 []shared1.QuotaEvictionCandidate, r1 error) {
+	f.PushHook(func(context.Context, int, int, int) ([]shared1.QuotaEvictionCandidate, error) {
 		return r0, r1
 	})
 }
 
-func (f *StoreGetRecentIndexesSummaryFunc) nextHook() func(context.Context, int) ([]shared1.IndexesWithRepositoryNamespace, error) {
+func (f *StoreGetQuotaEvictionCandidatesFunc) nextHook() func(context.Context, int, int, int) ([]shared1.QuotaEvictionCandidate, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4292,35 +4595,42 @@ func (f *StoreGetRecentIndexesSummaryFunc) nextHook() func(context.Context, int)
 	return hook
 }
 
-func (f *StoreGetRecentIndexesSummaryFunc) appendCall(r0 StoreGetRecentIndexesSummaryFuncCall) {
+func (f *StoreGetQuotaEvictionCandidatesFunc) appendCall(r0 StoreGetQuotaEvictionCandidatesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of StoreGetRecentIndexesSummaryFuncCall
+// History returns a sequence of StoreGetQuotaEvictionCandidatesFuncCall
 // objects describing the invocations of this function.
-func (f *StoreGetRecentIndexesSummaryFunc) History() []StoreGetRecentIndexesSummaryFuncCall {
+func (f *StoreGetQuotaEvictionCandidatesFunc) History() []StoreGetQuotaEvictionCandidatesFuncCall {
 	f.mutex.Lock()
-	history := make([]StoreGetRecentIndexesSummaryFuncCall, len(f.history))
+	history := make([]StoreGetQuotaEvictionCandidatesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// StoreGetRecentIndexesSummaryFuncCall is an object that describes an
-// invocation of method GetRecentIndexesSummary on an instance of MockStore.
-type StoreGetRecentIndexesSummaryFuncCall struct {
+// StoreGetQuotaEvictionCandidatesFuncCall is an object that describes an
+// invocation of method GetQuotaEvictionCandidates on an instance of
+// MockStore.
+type StoreGetQuotaEvictionCandidatesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []shared1.IndexesWithRepositoryNamespace
+	Result0 []shared1.QuotaEvictionCandidate
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -4328,30 +4638,139 @@ type StoreGetRecentIndexesSummaryFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c StoreGetRecentIndexesSummaryFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c StoreGetQuotaEvictionCandidatesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c StoreGetRecentIndexesSummaryFuncCall) Results() []interface{} {
+func (c StoreGetQuotaEvictionCandidatesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// StoreGetRecentUploadsSummaryFunc describes the behavior when the
-// GetRecentUploadsSummary method of the parent MockStore instance is
+// StoreGetRecentIndexesSummaryFunc describes the behavior when the
+// GetRecentIndexesSummary method of the parent MockStore instance is
 // invoked.
-type StoreGetRecentUploadsSummaryFunc struct {
-	defaultHook func(context.Context, int) ([]shared1.UploadsWithRepositoryNamespace, error)
-	hooks       []func(context.Context, int) ([]shared1.UploadsWithRepositoryNamespace, error)
-	history     []StoreGetRecentUploadsSummaryFuncCall
+type StoreGetRecentIndexesSummaryFunc struct {
+	defaultHook func(context.Context, int) ([]shared1.IndexesWithRepositoryNamespace, error)
+	hooks       []func(context.Context, int) ([]shared1.IndexesWithRepositoryNamespace, error)
+	history     []StoreGetRecentIndexesSummaryFuncCall
 	mutex       sync.Mutex
 }
 
-// GetRecentUploadsSummary delegates to the next hook function in the queue
+// GetRecentIndexesSummary delegates to the next hook function in the queue
 // and stores the parameter and result values of this invocation.
-func (m *MockStore) GetRecentUploadsSummary(v0 context.Context, v1 int) ([]shared1.UploadsWithRepositoryNamespace, error) {
-	r0, r1 := m.GetRecentUploadsSummaryFunc.nextHook()(v0, v1)
+func (m *MockStore) GetRecentIndexesSummary(v0 context.Context, v1 int) ([]shared1.IndexesWithRepositoryNamespace, error) {
+	r0, r1 := m.GetRecentIndexesSummaryFunc.nextHook()(v0, v1)
+	m.GetRecentIndexesSummaryFunc.appendCall(StoreGetRecentIndexesSummaryFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetRecentIndexesSummary method of the parent MockStore instance is
+// invoked and the hook queue is empty.
+func (f *StoreGetRecentIndexesSummaryFunc) SetDefaultHook(hook func(context.Context, int) ([]shared1.IndexesWithRepositoryNamespace, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetRecentIndexesSummary method of the parent MockStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *StoreGetRecentIndexesSummaryFunc) PushHook(hook func(context.Context, int) ([]shared1.IndexesWithRepositoryNamespace, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreGetRecentIndexesSummaryFunc) SetDefaultReturn(r0 []shared1.IndexesWithRepositoryNamespace, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) ([]shared1.IndexesWithRepositoryNamespace, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreGetRecentIndexesSummaryFunc) PushReturn(r0 []shared1.IndexesWithRepositoryNamespace, r1 error) {
+	f.PushHook(func(context.Context, int) ([]shared1.IndexesWithRepositoryNamespace, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreGetRecentIndexesSummaryFunc) nextHook() func(context.Context, int) ([]shared1.IndexesWithRepositoryNamespace, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreGetRecentIndexesSummaryFunc) appendCall(r0 StoreGetRecentIndexesSummaryFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreGetRecentIndexesSummaryFuncCall
+// objects describing the invocations of this function.
+func (f *StoreGetRecentIndexesSummaryFunc) History() []StoreGetRecentIndexesSummaryFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreGetRecentIndexesSummaryFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreGetRecentIndexesSummaryFuncCall is an object that describes an
+// invocation of method GetRecentIndexesSummary on an instance of MockStore.
+type StoreGetRecentIndexesSummaryFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []shared1.IndexesWithRepositoryNamespace
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreGetRecentIndexesSummaryFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreGetRecentIndexesSummaryFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// StoreGetRecentUploadsSummaryFunc describes the behavior when the
+// GetRecentUploadsSummary method of the parent MockStore instance is
+// invoked.
+type StoreGetRecentUploadsSummaryFunc struct {
+	defaultHook func(context.Context, int) ([]shared1.UploadsWithRepositoryNamespace, error)
+	hooks       []func(context.Context, int) ([]shared1.UploadsWithRepositoryNamespace, error)
+	history     []StoreGetRecentUploadsSummaryFuncCall
+	mutex       sync.Mutex
+}
+
+// GetRecentUploadsSummary delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockStore) GetRecentUploadsSummary(v0 context.Context, v1 int) ([]shared1.UploadsWithRepositoryNamespace, error) {
+	r0, r1 := m.GetRecentUploadsSummaryFunc.nextHook()(v0, v1)
 	m.GetRecentUploadsSummaryFunc.appendCall(StoreGetRecentUploadsSummaryFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
@@ -4800,6 +5219,114 @@ func (c StoreGetUploadIDsWithReferencesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2, c.Result3}
 }
 
+// StoreGetUploadStorageSizeFunc describes the behavior when the
+// GetUploadStorageSize method of the parent MockStore instance is invoked.
+type StoreGetUploadStorageSizeFunc struct {
+	defaultHook func(context.Context, int) (int64, error)
+	hooks       []func(context.Context, int) (int64, error)
+	history     []StoreGetUploadStorageSizeFuncCall
+	mutex       sync.Mutex
+}
+
+// GetUploadStorageSize delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockStore) GetUploadStorageSize(v0 context.Context, v1 int) (int64, error) {
+	r0, r1 := m.GetUploadStorageSizeFunc.nextHook()(v0, v1)
+	m.GetUploadStorageSizeFunc.appendCall(StoreGetUploadStorageSizeFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetUploadStorageSize
+// method of the parent MockStore instance is invoked and the hook queue is
+// empty.
+func (f *StoreGetUploadStorageSizeFunc) SetDefaultHook(hook func(context.Context, int) (int64, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetUploadStorageSize method of the parent MockStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *StoreGetUploadStorageSizeFunc) PushHook(hook func(context.Context, int) (int64, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreGetUploadStorageSizeFunc) SetDefaultReturn(r0 int64, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) (int64, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreGetUploadStorageSizeFunc) PushReturn(r0 int64, r1 error) {
+	f.PushHook(func(context.Context, int) (int64, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreGetUploadStorageSizeFunc) nextHook() func(context.Context, int) (int64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreGetUploadStorageSizeFunc) appendCall(r0 StoreGetUploadStorageSizeFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreGetUploadStorageSizeFuncCall objects
+// describing the invocations of this function.
+func (f *StoreGetUploadStorageSizeFunc) History() []StoreGetUploadStorageSizeFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreGetUploadStorageSizeFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreGetUploadStorageSizeFuncCall is an object that describes an
+// invocation of method GetUploadStorageSize on an instance of MockStore.
+type StoreGetUploadStorageSizeFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int64
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreGetUploadStorageSizeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreGetUploadStorageSizeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // StoreGetUploadsFunc describes the behavior when the GetUploads method of
 // the parent MockStore instance is invoked.
 type StoreGetUploadsFunc struct {
@@ -6130,38 +6657,35 @@ func (c StoreMarkQueuedFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// StoreNumRepositoriesWithCodeIntelligenceFunc describes the behavior when
-// the NumRepositoriesWithCodeIntelligence method of the parent MockStore
-// instance is invoked.
-type StoreNumRepositoriesWithCodeIntelligenceFunc struct {
-	defaultHook func(context.Context) (int, error)
-	hooks       []func(context.Context) (int, error)
-	history     []StoreNumRepositoriesWithCodeIntelligenceFuncCall
+// StoreMarkUploadsQueriedFunc describes the behavior when the
+// MarkUploadsQueried method of the parent MockStore instance is invoked.
+type StoreMarkUploadsQueriedFunc struct {
+	defaultHook func(context.Context, ...int) error
+	hooks       []func(context.Context, ...int) error
+	history     []StoreMarkUploadsQueriedFuncCall
 	mutex       sync.Mutex
 }
 
-// NumRepositoriesWithCodeIntelligence delegates to the next hook function
-// in the queue and stores the parameter and result values of this
-// invocation.
-func (m *MockStore) NumRepositoriesWithCodeIntelligence(v0 context.Context) (int, error) {
-	r0, r1 := m.NumRepositoriesWithCodeIntelligenceFunc.nextHook()(v0)
-	m.NumRepositoriesWithCodeIntelligenceFunc.appendCall(StoreNumRepositoriesWithCodeIntelligenceFuncCall{v0, r0, r1})
-	return r0, r1
+// MarkUploadsQueried delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockStore) MarkUploadsQueried(v0 context.Context, v1 ...int) error {
+	r0 := m.MarkUploadsQueriedFunc.nextHook()(v0, v1...)
+	m.MarkUploadsQueriedFunc.appendCall(StoreMarkUploadsQueriedFuncCall{v0, v1, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the
-// NumRepositoriesWithCodeIntelligence method of the parent MockStore
-// instance is invoked and the hook queue is empty.
-func (f *StoreNumRepositoriesWithCodeIntelligenceFunc) SetDefaultHook(hook func(context.Context) (int, error)) {
+// SetDefaultHook sets function that is called when the MarkUploadsQueried
+// method of the parent MockStore instance is invoked and the hook queue is
+// empty.
+func (f *StoreMarkUploadsQueriedFunc) SetDefaultHook(hook func(context.Context, ...int) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// NumRepositoriesWithCodeIntelligence method of the parent MockStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *StoreNumRepositoriesWithCodeIntelligenceFunc) PushHook(hook func(context.Context) (int, error)) {
+// MarkUploadsQueried method of the parent MockStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *StoreMarkUploadsQueriedFunc) PushHook(hook func(context.Context, ...int) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -6169,20 +6693,20 @@ func (f *StoreNumRepositoriesWithCodeIntelligenceFunc) PushHook(hook func(contex
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *StoreNumRepositoriesWithCodeIntelligenceFunc) SetDefaultReturn(r0 int, r1 error) {
-	f.SetDefaultHook(func(context.Context) (int, error) {
-		return r0, r1
+func (f *StoreMarkUploadsQueriedFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, ...int) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *StoreNumRepositoriesWithCodeIntelligenceFunc) PushReturn(r0 int, r1 error) {
-	f.PushHook(func(context.Context) (int, error) {
-		return r0, r1
+func (f *StoreMarkUploadsQueriedFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, ...int) error {
+		return r0
 	})
 }
 
-func (f *StoreNumRepositoriesWithCodeIntelligenceFunc) nextHook() func(context.Context) (int, error) {
+func (f *StoreMarkUploadsQueriedFunc) nextHook() func(context.Context, ...int) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -6195,45 +6719,160 @@ func (f *StoreNumRepositoriesWithCodeIntelligenceFunc) nextHook() func(context.C
 	return hook
 }
 
-func (f *StoreNumRepositoriesWithCodeIntelligenceFunc) appendCall(r0 StoreNumRepositoriesWithCodeIntelligenceFuncCall) {
+func (f *StoreMarkUploadsQueriedFunc) appendCall(r0 StoreMarkUploadsQueriedFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// StoreNumRepositoriesWithCodeIntelligenceFuncCall objects describing the
-// invocations of this function.
-func (f *StoreNumRepositoriesWithCodeIntelligenceFunc) History() []StoreNumRepositoriesWithCodeIntelligenceFuncCall {
+// History returns a sequence of StoreMarkUploadsQueriedFuncCall objects
+// describing the invocations of this function.
+func (f *StoreMarkUploadsQueriedFunc) History() []StoreMarkUploadsQueriedFuncCall {
 	f.mutex.Lock()
-	history := make([]StoreNumRepositoriesWithCodeIntelligenceFuncCall, len(f.history))
+	history := make([]StoreMarkUploadsQueriedFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// StoreNumRepositoriesWithCodeIntelligenceFuncCall is an object that
-// describes an invocation of method NumRepositoriesWithCodeIntelligence on
-// an instance of MockStore.
-type StoreNumRepositoriesWithCodeIntelligenceFuncCall struct {
+// StoreMarkUploadsQueriedFuncCall is an object that describes an invocation
+// of method MarkUploadsQueried on an instance of MockStore.
+type StoreMarkUploadsQueriedFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 int
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation.
-func (c StoreNumRepositoriesWithCodeIntelligenceFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
-}
-
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c StoreMarkUploadsQueriedFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreMarkUploadsQueriedFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// StoreNumRepositoriesWithCodeIntelligenceFunc describes the behavior when
+// the NumRepositoriesWithCodeIntelligence method of the parent MockStore
+// instance is invoked.
+type StoreNumRepositoriesWithCodeIntelligenceFunc struct {
+	defaultHook func(context.Context) (int, error)
+	hooks       []func(context.Context) (int, error)
+	history     []StoreNumRepositoriesWithCodeIntelligenceFuncCall
+	mutex       sync.Mutex
+}
+
+// NumRepositoriesWithCodeIntelligence delegates to the next hook function
+// in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockStore) NumRepositoriesWithCodeIntelligence(v0 context.Context) (int, error) {
+	r0, r1 := m.NumRepositoriesWithCodeIntelligenceFunc.nextHook()(v0)
+	m.NumRepositoriesWithCodeIntelligenceFunc.appendCall(StoreNumRepositoriesWithCodeIntelligenceFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// NumRepositoriesWithCodeIntelligence method of the parent MockStore
+// instance is invoked and the hook queue is empty.
+func (f *StoreNumRepositoriesWithCodeIntelligenceFunc) SetDefaultHook(hook func(context.Context) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// NumRepositoriesWithCodeIntelligence method of the parent MockStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *StoreNumRepositoriesWithCodeIntelligenceFunc) PushHook(hook func(context.Context) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreNumRepositoriesWithCodeIntelligenceFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreNumRepositoriesWithCodeIntelligenceFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreNumRepositoriesWithCodeIntelligenceFunc) nextHook() func(context.Context) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreNumRepositoriesWithCodeIntelligenceFunc) appendCall(r0 StoreNumRepositoriesWithCodeIntelligenceFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// StoreNumRepositoriesWithCodeIntelligenceFuncCall objects describing the
+// invocations of this function.
+func (f *StoreNumRepositoriesWithCodeIntelligenceFunc) History() []StoreNumRepositoriesWithCodeIntelligenceFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreNumRepositoriesWithCodeIntelligenceFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreNumRepositoriesWithCodeIntelligenceFuncCall is an object that
+// describes an invocation of method NumRepositoriesWithCodeIntelligence on
+// an instance of MockStore.
+type StoreNumRepositoriesWithCodeIntelligenceFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreNumRepositoriesWithCodeIntelligenceFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
 // Results returns an interface slice containing the results of this
 // invocation.
 func (c StoreNumRepositoriesWithCodeIntelligenceFuncCall) Results() []interface{} {
@@ -8572,6 +9211,9 @@ func (c StoreWorkerutilStoreFuncCall) Results() []interface{} {
 // github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/internal/lsifstore)
 // used for unit testing.
 type MockLSIFSCIPWriter struct {
+	// CopyDocumentFunc is an instance of a mock function object controlling
+	// the behavior of the method CopyDocument.
+	CopyDocumentFunc *LSIFSCIPWriterCopyDocumentFunc
 	// FlushFunc is an instance of a mock function object controlling the
 	// behavior of the method Flush.
 	FlushFunc *LSIFSCIPWriterFlushFunc
@@ -8584,6 +9226,11 @@ type MockLSIFSCIPWriter struct {
 // methods return zero values for all results, unless overwritten.
 func NewMockLSIFSCIPWriter() *MockLSIFSCIPWriter {
 	return &MockLSIFSCIPWriter{
+		CopyDocumentFunc: &LSIFSCIPWriterCopyDocumentFunc{
+			defaultHook: func(context.Context, string, int, *scip.Document) (r0 error) {
+				return
+			},
+		},
 		FlushFunc: &LSIFSCIPWriterFlushFunc{
 			defaultHook: func(context.Context) (r0 uint32, r1 error) {
 				return
@@ -8601,6 +9248,11 @@ func NewMockLSIFSCIPWriter() *MockLSIFSCIPWriter {
 // interface. All methods panic on invocation, unless overwritten.
 func NewStrictMockLSIFSCIPWriter() *MockLSIFSCIPWriter {
 	return &MockLSIFSCIPWriter{
+		CopyDocumentFunc: &LSIFSCIPWriterCopyDocumentFunc{
+			defaultHook: func(context.Context, string, int, *scip.Document) error {
+				panic("unexpected invocation of MockLSIFSCIPWriter.CopyDocument")
+			},
+		},
 		FlushFunc: &LSIFSCIPWriterFlushFunc{
 			defaultHook: func(context.Context) (uint32, error) {
 				panic("unexpected invocation of MockLSIFSCIPWriter.Flush")
@@ -8619,6 +9271,9 @@ func NewStrictMockLSIFSCIPWriter() *MockLSIFSCIPWriter {
 // overwritten.
 func NewMockLSIFSCIPWriterFrom(i lsifstore.SCIPWriter) *MockLSIFSCIPWriter {
 	return &MockLSIFSCIPWriter{
+		CopyDocumentFunc: &LSIFSCIPWriterCopyDocumentFunc{
+			defaultHook: i.CopyDocument,
+		},
 		FlushFunc: &LSIFSCIPWriterFlushFunc{
 			defaultHook: i.Flush,
 		},
@@ -8628,6 +9283,117 @@ func NewMockLSIFSCIPWriterFrom(i lsifstore.SCIPWriter) *MockLSIFSCIPWriter {
 	}
 }
 
+// LSIFSCIPWriterCopyDocumentFunc describes the behavior when the
+// CopyDocument method of the parent MockLSIFSCIPWriter instance is invoked.
+type LSIFSCIPWriterCopyDocumentFunc struct {
+	defaultHook func(context.Context, string, int, *scip.Document) error
+	hooks       []func(context.Context, string, int, *scip.Document) error
+	history     []LSIFSCIPWriterCopyDocumentFuncCall
+	mutex       sync.Mutex
+}
+
+// CopyDocument delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockLSIFSCIPWriter) CopyDocument(v0 context.Context, v1 string, v2 int, v3 *scip.Document) error {
+	r0 := m.CopyDocumentFunc.nextHook()(v0, v1, v2, v3)
+	m.CopyDocumentFunc.appendCall(LSIFSCIPWriterCopyDocumentFuncCall{v0, v1, v2, v3, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the CopyDocument method
+// of the parent MockLSIFSCIPWriter instance is invoked and the hook queue
+// is empty.
+func (f *LSIFSCIPWriterCopyDocumentFunc) SetDefaultHook(hook func(context.Context, string, int, *scip.Document) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CopyDocument method of the parent MockLSIFSCIPWriter instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *LSIFSCIPWriterCopyDocumentFunc) PushHook(hook func(context.Context, string, int, *scip.Document) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *LSIFSCIPWriterCopyDocumentFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, int, *scip.Document) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *LSIFSCIPWriterCopyDocumentFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, int, *scip.Document) error {
+		return r0
+	})
+}
+
+func (f *LSIFSCIPWriterCopyDocumentFunc) nextHook() func(context.Context, string, int, *scip.Document) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *LSIFSCIPWriterCopyDocumentFunc) appendCall(r0 LSIFSCIPWriterCopyDocumentFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of LSIFSCIPWriterCopyDocumentFuncCall objects
+// describing the invocations of this function.
+func (f *LSIFSCIPWriterCopyDocumentFunc) History() []LSIFSCIPWriterCopyDocumentFuncCall {
+	f.mutex.Lock()
+	history := make([]LSIFSCIPWriterCopyDocumentFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// LSIFSCIPWriterCopyDocumentFuncCall is an object that describes an
+// invocation of method CopyDocument on an instance of MockLSIFSCIPWriter.
+type LSIFSCIPWriterCopyDocumentFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 *scip.Document
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c LSIFSCIPWriterCopyDocumentFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c LSIFSCIPWriterCopyDocumentFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // LSIFSCIPWriterFlushFunc describes the behavior when the Flush method of
 // the parent MockLSIFSCIPWriter instance is invoked.
 type LSIFSCIPWriterFlushFunc struct {
@@ -8859,6 +9625,9 @@ type MockLSIFStore struct {
 	// object controlling the behavior of the method
 	// DeleteUnreferencedDocuments.
 	DeleteUnreferencedDocumentsFunc *LSIFStoreDeleteUnreferencedDocumentsFunc
+	// GetDocumentPathsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetDocumentPaths.
+	GetDocumentPathsFunc *LSIFStoreGetDocumentPathsFunc
 	// IDsWithMetaFunc is an instance of a mock function object controlling
 	// the behavior of the method IDsWithMeta.
 	IDsWithMetaFunc *LSIFStoreIDsWithMetaFunc
@@ -8879,6 +9648,9 @@ type MockLSIFStore struct {
 	// object controlling the behavior of the method
 	// ReconcileCandidatesWithTime.
 	ReconcileCandidatesWithTimeFunc *LSIFStoreReconcileCandidatesWithTimeFunc
+	// ScanDocumentsFunc is an instance of a mock function object
+	// controlling the behavior of the method ScanDocuments.
+	ScanDocumentsFunc *LSIFStoreScanDocumentsFunc
 	// WithTransactionFunc is an instance of a mock function object
 	// controlling the behavior of the method WithTransaction.
 	WithTransactionFunc *LSIFStoreWithTransactionFunc
@@ -8903,6 +9675,11 @@ func NewMockLSIFStore() *MockLSIFStore {
 				return
 			},
 		},
+		GetDocumentPathsFunc: &LSIFStoreGetDocumentPathsFunc{
+			defaultHook: func(context.Context, int) (r0 []string, r1 error) {
+				return
+			},
+		},
 		IDsWithMetaFunc: &LSIFStoreIDsWithMetaFunc{
 			defaultHook: func(context.Context, []int) (r0 []int, r1 error) {
 				return
@@ -8933,6 +9710,11 @@ func NewMockLSIFStore() *MockLSIFStore {
 				return
 			},
 		},
+		ScanDocumentsFunc: &LSIFStoreScanDocumentsFunc{
+			defaultHook: func(context.Context, int, []string, func(path string, documentID int, document *scip.Document) error) (r0 error) {
+				return
+			},
+		},
 		WithTransactionFunc: &LSIFStoreWithTransactionFunc{
 			defaultHook: func(context.Context, func(s lsifstore.Store) error) (r0 error) {
 				return
@@ -8960,6 +9742,11 @@ func NewStrictMockLSIFStore() *MockLSIFStore {
 				panic("unexpected invocation of MockLSIFStore.DeleteUnreferencedDocuments")
 			},
 		},
+		GetDocumentPathsFunc: &LSIFStoreGetDocumentPathsFunc{
+			defaultHook: func(context.Context, int) ([]string, error) {
+				panic("unexpected invocation of MockLSIFStore.GetDocumentPaths")
+			},
+		},
 		IDsWithMetaFunc: &LSIFStoreIDsWithMetaFunc{
 			defaultHook: func(context.Context, []int) ([]int, error) {
 				panic("unexpected invocation of MockLSIFStore.IDsWithMeta")
@@ -8990,6 +9777,11 @@ func NewStrictMockLSIFStore() *MockLSIFStore {
 				panic("unexpected invocation of MockLSIFStore.ReconcileCandidatesWithTime")
 			},
 		},
+		ScanDocumentsFunc: &LSIFStoreScanDocumentsFunc{
+			defaultHook: func(context.Context, int, []string, func(path string, documentID int, document *scip.Document) error) error {
+				panic("unexpected invocation of MockLSIFStore.ScanDocuments")
+			},
+		},
 		WithTransactionFunc: &LSIFStoreWithTransactionFunc{
 			defaultHook: func(context.Context, func(s lsifstore.Store) error) error {
 				panic("unexpected invocation of MockLSIFStore.WithTransaction")
@@ -9011,6 +9803,9 @@ func NewMockLSIFStoreFrom(i lsifstore.Store) *MockLSIFStore {
 		DeleteUnreferencedDocumentsFunc: &LSIFStoreDeleteUnreferencedDocumentsFunc{
 			defaultHook: i.DeleteUnreferencedDocuments,
 		},
+		GetDocumentPathsFunc: &LSIFStoreGetDocumentPathsFunc{
+			defaultHook: i.GetDocumentPaths,
+		},
 		IDsWithMetaFunc: &LSIFStoreIDsWithMetaFunc{
 			defaultHook: i.IDsWithMeta,
 		},
@@ -9029,6 +9824,9 @@ func NewMockLSIFStoreFrom(i lsifstore.Store) *MockLSIFStore {
 		ReconcileCandidatesWithTimeFunc: &LSIFStoreReconcileCandidatesWithTimeFunc{
 			defaultHook: i.ReconcileCandidatesWithTime,
 		},
+		ScanDocumentsFunc: &LSIFStoreScanDocumentsFunc{
+			defaultHook: i.ScanDocuments,
+		},
 		WithTransactionFunc: &LSIFStoreWithTransactionFunc{
 			defaultHook: i.WithTransaction,
 		},
@@ -9381,6 +10179,114 @@ func (c LSIFStoreDeleteUnreferencedDocumentsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
+// LSIFStoreGetDocumentPathsFunc describes the behavior when the
+// GetDocumentPaths method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreGetDocumentPathsFunc struct {
+	defaultHook func(context.Context, int) ([]string, error)
+	hooks       []func(context.Context, int) ([]string, error)
+	history     []LSIFStoreGetDocumentPathsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetDocumentPaths delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockLSIFStore) GetDocumentPaths(v0 context.Context, v1 int) ([]string, error) {
+	r0, r1 := m.GetDocumentPathsFunc.nextHook()(v0, v1)
+	m.GetDocumentPathsFunc.appendCall(LSIFStoreGetDocumentPathsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetDocumentPaths
+// method of the parent MockLSIFStore instance is invoked and the hook queue
+// is empty.
+func (f *LSIFStoreGetDocumentPathsFunc) SetDefaultHook(hook func(context.Context, int) ([]string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetDocumentPaths method of the parent MockLSIFStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *LSIFStoreGetDocumentPathsFunc) PushHook(hook func(context.Context, int) ([]string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *LSIFStoreGetDocumentPathsFunc) SetDefaultReturn(r0 []string, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) ([]string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *LSIFStoreGetDocumentPathsFunc) PushReturn(r0 []string, r1 error) {
+	f.PushHook(func(context.Context, int) ([]string, error) {
+		return r0, r1
+	})
+}
+
+func (f *LSIFStoreGetDocumentPathsFunc) nextHook() func(context.Context, int) ([]string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *LSIFStoreGetDocumentPathsFunc) appendCall(r0 LSIFStoreGetDocumentPathsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of LSIFStoreGetDocumentPathsFuncCall objects
+// describing the invocations of this function.
+func (f *LSIFStoreGetDocumentPathsFunc) History() []LSIFStoreGetDocumentPathsFuncCall {
+	f.mutex.Lock()
+	history := make([]LSIFStoreGetDocumentPathsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// LSIFStoreGetDocumentPathsFuncCall is an object that describes an
+// invocation of method GetDocumentPaths on an instance of MockLSIFStore.
+type LSIFStoreGetDocumentPathsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c LSIFStoreGetDocumentPathsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c LSIFStoreGetDocumentPathsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // LSIFStoreIDsWithMetaFunc describes the behavior when the IDsWithMeta
 // method of the parent MockLSIFStore instance is invoked.
 type LSIFStoreIDsWithMetaFunc struct {
@@ -10049,6 +10955,117 @@ func (c LSIFStoreReconcileCandidatesWithTimeFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// LSIFStoreScanDocumentsFunc describes the behavior when the ScanDocuments
+// method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreScanDocumentsFunc struct {
+	defaultHook func(context.Context, int, []string, func(path string, documentID int, document *scip.Document) error) error
+	hooks       []func(context.Context, int, []string, func(path string, documentID int, document *scip.Document) error) error
+	history     []LSIFStoreScanDocumentsFuncCall
+	mutex       sync.Mutex
+}
+
+// ScanDocuments delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockLSIFStore) ScanDocuments(v0 context.Context, v1 int, v2 []string, v3 func(path string, documentID int, document *scip.Document) error) error {
+	r0 := m.ScanDocumentsFunc.nextHook()(v0, v1, v2, v3)
+	m.ScanDocumentsFunc.appendCall(LSIFStoreScanDocumentsFuncCall{v0, v1, v2, v3, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the ScanDocuments method
+// of the parent MockLSIFStore instance is invoked and the hook queue is
+// empty.
+func (f *LSIFStoreScanDocumentsFunc) SetDefaultHook(hook func(context.Context, int, []string, func(path string, documentID int, document *scip.Document) error) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ScanDocuments method of the parent MockLSIFStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *LSIFStoreScanDocumentsFunc) PushHook(hook func(context.Context, int, []string, func(path string, documentID int, document *scip.Document) error) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *LSIFStoreScanDocumentsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int, []string, func(path string, documentID int, document *scip.Document) error) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *LSIFStoreScanDocumentsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int, []string, func(path string, documentID int, document *scip.Document) error) error {
+		return r0
+	})
+}
+
+func (f *LSIFStoreScanDocumentsFunc) nextHook() func(context.Context, int, []string, func(path string, documentID int, document *scip.Document) error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *LSIFStoreScanDocumentsFunc) appendCall(r0 LSIFStoreScanDocumentsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of LSIFStoreScanDocumentsFuncCall objects
+// describing the invocations of this function.
+func (f *LSIFStoreScanDocumentsFunc) History() []LSIFStoreScanDocumentsFuncCall {
+	f.mutex.Lock()
+	history := make([]LSIFStoreScanDocumentsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// LSIFStoreScanDocumentsFuncCall is an object that describes an invocation
+// of method ScanDocuments on an instance of MockLSIFStore.
+type LSIFStoreScanDocumentsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 func(path string, documentID int, document *scip.Document) error
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c LSIFStoreScanDocumentsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c LSIFStoreScanDocumentsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // LSIFStoreWithTransactionFunc describes the behavior when the
 // WithTransaction method of the parent MockLSIFStore instance is invoked.
 type LSIFStoreWithTransactionFunc struct {
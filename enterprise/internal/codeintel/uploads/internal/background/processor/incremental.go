@@ -0,0 +1,106 @@
+package processor
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/log"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/internal/lsifstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/internal/store"
+	uploadsshared "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
+	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/pathexistence"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// scanBaseDocumentsFunc invokes the given function with each document of the base upload of an
+// incremental upload that is carried over into the incremental upload. Documents with a path in
+// the given replaced set are skipped.
+type scanBaseDocumentsFunc func(ctx context.Context, replacedPaths map[string]struct{}, f func(document lsifstore.ProcessedSCIPDocument) error) error
+
+// newBaseDocumentScanner returns a function that streams the documents of the given base upload
+// that are not replaced by the incremental upload and that still exist in the incremental upload's
+// commit. The returned documents reference the existing payload rows of the base upload.
+func newBaseDocumentScanner(lsifStore lsifstore.Store, baseUploadID int, root string, getChildren pathexistence.GetChildrenFunc) scanBaseDocumentsFunc {
+	return func(ctx context.Context, replacedPaths map[string]struct{}, f func(document lsifstore.ProcessedSCIPDocument) error) error {
+		basePaths, err := lsifStore.GetDocumentPaths(ctx, baseUploadID)
+		if err != nil {
+			return errors.Wrap(err, "lsifStore.GetDocumentPaths")
+		}
+
+		candidates := make([]string, 0, len(basePaths))
+		for _, path := range basePaths {
+			if _, ok := replacedPaths[path]; !ok {
+				candidates = append(candidates, path)
+			}
+		}
+
+		// Drop documents of files deleted between the base commit and this commit
+		missingPaths, err := missingPaths(ctx, candidates, root, getChildren)
+		if err != nil {
+			return err
+		}
+
+		paths := candidates[:0]
+		for _, path := range candidates {
+			if _, ok := missingPaths[path]; !ok {
+				paths = append(paths, path)
+			}
+		}
+
+		return lsifStore.ScanDocuments(ctx, baseUploadID, paths, func(path string, documentID int, document *scip.Document) error {
+			return f(lsifstore.ProcessedSCIPDocument{
+				Path:       path,
+				Document:   document,
+				DocumentID: documentID,
+			})
+		})
+	}
+}
+
+// isRemovedDocument returns true if the given document of an incremental index has no data. Such
+// documents mark the removal of the base upload's document with the same path.
+func isRemovedDocument(document *scip.Document) bool {
+	return len(document.Occurrences) == 0 && len(document.Symbols) == 0
+}
+
+// requeueIfBaseUploadPending requeues the given incremental upload if its base upload has not yet
+// finished processing. The base upload's data must be in the codeintel database before the
+// documents of the incremental upload can be layered on top of it.
+func requeueIfBaseUploadPending(ctx context.Context, logger log.Logger, dbStore store.Store, workerStore dbworkerstore.Store[uploadsshared.Upload], upload uploadsshared.Upload) (requeued bool, _ error) {
+	if upload.BaseUploadID == nil {
+		return false, nil
+	}
+
+	baseUpload, ok, err := dbStore.GetUploadByID(ctx, *upload.BaseUploadID)
+	if err != nil {
+		return false, errors.Wrap(err, "store.GetUploadByID")
+	}
+	if !ok {
+		return false, errors.Newf("base upload %d no longer exists; a full index must be uploaded", *upload.BaseUploadID)
+	}
+
+	switch baseUpload.State {
+	case "completed":
+	case "uploading", "queued", "processing":
+		after := time.Now().UTC().Add(requeueDelay)
+
+		if err := workerStore.Requeue(ctx, upload.ID, after); err != nil {
+			return false, errors.Wrap(err, "store.Requeue")
+		}
+		logger.Warn("Requeued LSIF upload record",
+			log.Int("id", upload.ID),
+			log.String("reason", "base upload still processing"))
+		return true, nil
+	default:
+		return false, errors.Newf("base upload %d is in state %q; a full index must be uploaded", baseUpload.ID, baseUpload.State)
+	}
+
+	if baseUpload.RepositoryID != upload.RepositoryID || baseUpload.Root != upload.Root || baseUpload.Indexer != upload.Indexer {
+		return false, errors.Newf("base upload %d does not match the repository, root, and indexer of this upload", baseUpload.ID)
+	}
+
+	return false, nil
+}
@@ -0,0 +1,53 @@
+package processor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/internal/lsifstore"
+)
+
+func TestBaseDocumentScanner(t *testing.T) {
+	mockLSIFStore := NewMockLSIFStore()
+	mockLSIFStore.GetDocumentPathsFunc.SetDefaultReturn([]string{"a.go", "b.go", "c.go", "d.go"}, nil)
+	mockLSIFStore.ScanDocumentsFunc.SetDefaultHook(func(_ context.Context, _ int, paths []string, f func(path string, documentID int, document *scip.Document) error) error {
+		for i, path := range paths {
+			if err := f(path, 100+i, &scip.Document{}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	// d.go was deleted since the base commit
+	getChildren := func(_ context.Context, dirnames []string) (map[string][]string, error) {
+		return map[string][]string{"": {"a.go", "b.go", "c.go"}}, nil
+	}
+
+	scanBaseDocuments := newBaseDocumentScanner(mockLSIFStore, 41, "", getChildren)
+
+	var documents []lsifstore.ProcessedSCIPDocument
+	if err := scanBaseDocuments(context.Background(), map[string]struct{}{"a.go": {}}, func(document lsifstore.ProcessedSCIPDocument) error {
+		documents = append(documents, document)
+		return nil
+	}); err != nil {
+		t.Fatalf("unexpected error scanning base documents: %s", err)
+	}
+
+	if history := mockLSIFStore.ScanDocumentsFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected number of ScanDocuments calls. want=%d have=%d", 1, len(history))
+	} else if history[0].Arg1 != 41 {
+		t.Errorf("unexpected upload id. want=%d have=%d", 41, history[0].Arg1)
+	}
+
+	expectedDocuments := []lsifstore.ProcessedSCIPDocument{
+		{Path: "b.go", Document: &scip.Document{}, DocumentID: 100},
+		{Path: "c.go", Document: &scip.Document{}, DocumentID: 101},
+	}
+	if diff := cmp.Diff(expectedDocuments, documents, cmp.Comparer(func(a, b *scip.Document) bool { return (a == nil) == (b == nil) })); diff != "" {
+		t.Errorf("unexpected documents (-want +got):\n%s", diff)
+	}
+}
@@ -224,6 +224,10 @@ func (h *handler) HandleRawUpload(ctx context.Context, logger log.Logger, upload
 		return requeued, err
 	}
 
+	if requeued, err := requeueIfBaseUploadPending(ctx, logger, h.store, h.workerStore, upload); err != nil || requeued {
+		return requeued, err
+	}
+
 	// Determine if the upload is for the default Git branch.
 	isDefaultBranch, err := h.defaultBranchContains(ctx, repo.Name, upload.Commit)
 	if err != nil {
@@ -278,7 +282,12 @@ func (h *handler) HandleRawUpload(ctx context.Context, logger log.Logger, upload
 			rSize = *upload.UncompressedSize
 		}
 
-		correlatedSCIPData, err := correlateSCIP(ctx, r, rSize, upload.Root, getChildren)
+		var scanBaseDocuments scanBaseDocumentsFunc
+		if upload.BaseUploadID != nil {
+			scanBaseDocuments = newBaseDocumentScanner(h.lsifStore, *upload.BaseUploadID, upload.Root, getChildren)
+		}
+
+		correlatedSCIPData, err := correlateSCIP(ctx, r, rSize, upload.Root, getChildren, scanBaseDocuments)
 		if err != nil {
 			return errors.Wrap(err, "conversion.Correlate")
 		}
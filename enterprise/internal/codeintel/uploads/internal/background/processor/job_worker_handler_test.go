@@ -369,6 +369,54 @@ func TestHandleCloneInProgress(t *testing.T) {
 	}
 }
 
+func TestHandleBaseUploadProcessing(t *testing.T) {
+	baseUploadID := 41
+	upload := shared.Upload{
+		ID:           42,
+		Root:         "root/",
+		Commit:       "deadbeef",
+		RepositoryID: 50,
+		Indexer:      "scip-go",
+		ContentType:  "application/x-protobuf+scip",
+		BaseUploadID: &baseUploadID,
+	}
+
+	mockWorkerStore := NewMockWorkerStore[shared.Upload]()
+	mockDBStore := NewMockStore()
+	mockRepoStore := defaultMockRepoStore()
+	mockUploadStore := uploadstoremocks.NewMockStore()
+	gitserverClient := gitserver.NewMockClient()
+
+	mockDBStore.GetUploadByIDFunc.SetDefaultReturn(shared.Upload{
+		ID:           baseUploadID,
+		Root:         "root/",
+		RepositoryID: 50,
+		Indexer:      "scip-go",
+		State:        "processing",
+	}, true, nil)
+
+	svc := &handler{
+		store:           mockDBStore,
+		gitserverClient: gitserverClient,
+		repoStore:       mockRepoStore,
+		workerStore:     mockWorkerStore,
+	}
+
+	requeued, err := svc.HandleRawUpload(context.Background(), logtest.Scoped(t), upload, mockUploadStore, observation.TestTraceLogger(logtest.Scoped(t)))
+	if err != nil {
+		t.Fatalf("unexpected error handling upload: %s", err)
+	} else if !requeued {
+		t.Errorf("expected upload to be requeued")
+	}
+
+	if len(mockWorkerStore.RequeueFunc.History()) != 1 {
+		t.Errorf("unexpected number of Requeue calls. want=%d have=%d", 1, len(mockWorkerStore.RequeueFunc.History()))
+	}
+	if len(mockUploadStore.GetFunc.History()) != 0 {
+		t.Errorf("unexpected number of upload store Get calls. want=%d have=%d", 0, len(mockUploadStore.GetFunc.History()))
+	}
+}
+
 //
 //
 
@@ -27,12 +27,18 @@ import (
 // the set of processed documents *before* accessing the package or package reference channels - they
 // will not be written to until the documents channel has been closed. Consumers should process both
 // package and package reference channels concurrently.
+//
+// If scanBaseDocuments is non-nil, the index is an incremental index layered on top of a base upload.
+// Documents of the base upload that are not replaced by the index are emitted after the documents of
+// the index itself, and documents of the index without occurrences or symbols mark the removal of
+// the base document with the same path.
 func correlateSCIP(
 	ctx context.Context,
 	r io.Reader,
 	rSize int64,
 	root string,
 	getChildren pathexistence.GetChildrenFunc,
+	scanBaseDocuments scanBaseDocumentsFunc,
 ) (lsifstore.ProcessedSCIPData, error) {
 	index, err := readIndex(r, rSize)
 	if err != nil {
@@ -44,6 +50,12 @@ func correlateSCIP(
 		return lsifstore.ProcessedSCIPData{}, err
 	}
 
+	// Stash the paths replaced by an incremental index before processing clears them
+	replacedPaths := make(map[string]struct{}, len(index.Documents))
+	for _, document := range index.Documents {
+		replacedPaths[document.RelativePath] = struct{}{}
+	}
+
 	var (
 		documents             = make(chan lsifstore.ProcessedSCIPDocument)
 		packages              = make(chan precise.Package)
@@ -55,21 +67,11 @@ func correlateSCIP(
 		defer close(documents)
 
 		packageSet := map[precise.Package]bool{}
-		for _, document := range scip.SortDocuments(scip.FlattenDocuments(index.Documents)) {
-			if _, ok := ignorePaths[document.RelativePath]; ok {
-				continue
-			}
-
-			select {
-			case documents <- processDocument(document, externalSymbolsByName):
-			case <-ctx.Done():
-				return
-			}
-
-			// While processing this document, stash the unique packages of each symbol name
-			// in the document. If there is an occurrence that defines that symbol, mark that
-			// package as being one that we define (rather than simply reference).
 
+		// While processing a document, stash the unique packages of each symbol name in the
+		// document. If there is an occurrence that defines that symbol, mark that package as
+		// being one that we define (rather than simply reference).
+		addPackages := func(document *scip.Document) {
 			for _, symbol := range document.Symbols {
 				if pkg, ok := packageFromSymbol(symbol.Symbol); ok {
 					// no-op if key exists; add false if key is absent
@@ -100,6 +102,42 @@ func correlateSCIP(
 			}
 		}
 
+		for _, document := range scip.SortDocuments(scip.FlattenDocuments(index.Documents)) {
+			if _, ok := ignorePaths[document.RelativePath]; ok {
+				continue
+			}
+			if scanBaseDocuments != nil && isRemovedDocument(document) {
+				continue
+			}
+
+			select {
+			case documents <- processDocument(document, externalSymbolsByName):
+			case <-ctx.Done():
+				return
+			}
+
+			addPackages(document)
+		}
+
+		if scanBaseDocuments != nil {
+			if err := scanBaseDocuments(ctx, replacedPaths, func(document lsifstore.ProcessedSCIPDocument) error {
+				select {
+				case documents <- document:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+
+				addPackages(document.Document)
+				return nil
+			}); err != nil {
+				select {
+				case documents <- lsifstore.ProcessedSCIPDocument{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+		}
+
 		go func() {
 			defer close(packages)
 			defer close(packageReferences)
@@ -232,19 +270,25 @@ func ignorePaths(ctx context.Context, documents []*scip.Document, root string, g
 		paths = append(paths, document.RelativePath)
 	}
 
+	return missingPaths(ctx, paths, root, getChildren)
+}
+
+// missingPaths returns a set consisting of the given relative paths that are not resolvable
+// via Git.
+func missingPaths(ctx context.Context, paths []string, root string, getChildren pathexistence.GetChildrenFunc) (map[string]struct{}, error) {
 	checker, err := pathexistence.NewExistenceChecker(ctx, root, paths, getChildren)
 	if err != nil {
 		return nil, err
 	}
 
-	ignorePathMap := map[string]struct{}{}
-	for _, document := range documents {
-		if !checker.Exists(document.RelativePath) {
-			ignorePathMap[document.RelativePath] = struct{}{}
+	missingPathMap := map[string]struct{}{}
+	for _, path := range paths {
+		if !checker.Exists(path) {
+			missingPathMap[path] = struct{}{}
 		}
 	}
 
-	return ignorePathMap, nil
+	return missingPathMap, nil
 }
 
 // readExternalSymbols inverts the external symbols from the given index into a map keyed by name.
@@ -388,7 +432,17 @@ func writeSCIPData(
 
 		var numDocuments uint32
 		for document := range correlatedSCIPData.Documents {
-			if err := scipWriter.InsertDocument(ctx, document.Path, document.Document); err != nil {
+			if document.Err != nil {
+				return document.Err
+			}
+
+			if document.DocumentID != 0 {
+				// Reuse the payload of a document carried over from a base upload
+				err = scipWriter.CopyDocument(ctx, document.Path, document.DocumentID, document.Document)
+			} else {
+				err = scipWriter.InsertDocument(ctx, document.Path, document.Document)
+			}
+			if err != nil {
 				return err
 			}
 
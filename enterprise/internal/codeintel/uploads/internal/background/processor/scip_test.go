@@ -40,7 +40,7 @@ func TestCorrelateSCIP(t *testing.T) {
 	// Correlate and consume channels from returned object
 	correlatedSCIPData, err := correlateSCIP(ctx, testReader(), n, "", func(ctx context.Context, dirnames []string) (map[string][]string, error) {
 		return scipDirectoryChildren, nil
-	})
+	}, nil)
 	if err != nil {
 		t.Fatalf("unexpected error processing SCIP: %s", err)
 	}
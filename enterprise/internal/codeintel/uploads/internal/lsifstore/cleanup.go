@@ -215,7 +215,7 @@ SELECT COUNT(*) FROM del
 `
 
 func (s *store) DeleteUnreferencedDocuments(ctx context.Context, batchSize int, maxAge time.Duration, now time.Time) (_, _ int, err error) {
-	ctx, _, endObservation := s.operations.idsWithMeta.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+	ctx, _, endObservation := s.operations.deleteUnreferencedDocuments.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
 		attribute.Stringer("maxAge", maxAge),
 	}})
 	defer endObservation(1, observation.Args{})
@@ -257,7 +257,7 @@ locked_documents AS (
 	JOIN codeintel_scip_documents sd ON sd.id = d.document_id
 	WHERE NOT EXISTS (SELECT 1 FROM codeintel_scip_document_lookup sdl WHERE sdl.document_id = sd.id)
 	ORDER BY sd.id
-	FOR UPDATE OF sd
+	FOR UPDATE OF sd SKIP LOCKED
 ),
 deleted_documents AS (
 	DELETE FROM codeintel_scip_documents
@@ -265,8 +265,17 @@ deleted_documents AS (
 	RETURNING id
 ),
 deleted_candidates AS (
+	-- Keep the log entries of documents that are currently locked by an upload that is
+	-- re-using the payload; these are re-checked on a subsequent run.
 	DELETE FROM codeintel_scip_documents_dereference_logs
-	WHERE id IN (SELECT id FROM candidates)
+	WHERE id IN (
+		SELECT c.id
+		FROM candidates c
+		WHERE
+			c.document_id IN (SELECT id FROM locked_documents) OR
+			NOT EXISTS (SELECT 1 FROM codeintel_scip_documents sd WHERE sd.id = c.document_id) OR
+			EXISTS (SELECT 1 FROM codeintel_scip_document_lookup sdl WHERE sdl.document_id = c.document_id)
+	)
 	RETURNING id
 )
 SELECT
@@ -46,6 +46,10 @@ type ProcessedSCIPDocument struct {
 	Path     string
 	Document *scip.Document
 	Err      error
+
+	// DocumentID is the identifier of an existing document payload that is reused (rather than
+	// re-inserted) for this document. This is set for documents carried over from a base upload.
+	DocumentID int
 }
 
 func (s *store) InsertMetadata(ctx context.Context, uploadID int, meta ProcessedMetadata) (err error) {
@@ -113,11 +117,29 @@ func (s *store) NewSCIPWriter(ctx context.Context, uploadID int) (SCIPWriter, er
 		"type_definition_ranges",
 	)
 
+	diagnosticInserter := batch.NewInserter(
+		ctx,
+		s.db.Handle(),
+		"codeintel_scip_diagnostics",
+		batch.MaxNumPostgresParameters,
+		"upload_id",
+		"document_lookup_id",
+		"severity",
+		"code",
+		"message",
+		"source",
+		"start_line",
+		"start_character",
+		"end_line",
+		"end_character",
+	)
+
 	scipWriter := &scipWriter{
 		uploadID:           uploadID,
 		db:                 s.db,
 		symbolNameInserter: symbolNameInserter,
 		symbolInserter:     symbolInserter,
+		diagnosticInserter: diagnosticInserter,
 		count:              0,
 	}
 
@@ -149,6 +171,7 @@ type scipWriter struct {
 	db                 *basestore.Store
 	symbolNameInserter *batch.Inserter
 	symbolInserter     *batch.Inserter
+	diagnosticInserter *batch.Inserter
 	count              uint32
 	batchPayloadSum    int
 	batch              []bufferedDocument
@@ -159,6 +182,7 @@ type bufferedDocument struct {
 	scipDocument *scip.Document
 	payload      []byte
 	payloadHash  []byte
+	documentID   int
 }
 
 const (
@@ -178,15 +202,12 @@ func (s *scipWriter) InsertDocument(ctx context.Context, path string, scipDocume
 		return err
 	}
 
-	compressedPayload, err := shared.Compressor.Compress(bytes.NewReader(payload))
-	if err != nil {
-		return err
-	}
-
+	// Payloads are compressed only when flushed, as documents already stored by another
+	// upload are referenced by their hash and never written again.
 	s.batch = append(s.batch, bufferedDocument{
 		path:         path,
 		scipDocument: scipDocument,
-		payload:      compressedPayload,
+		payload:      payload,
 		payloadHash:  hashPayload(payload),
 	})
 	s.batchPayloadSum += len(payload)
@@ -199,56 +220,47 @@ func (s *scipWriter) InsertDocument(ctx context.Context, path string, scipDocume
 	return nil
 }
 
+// CopyDocument adds a document whose payload already exists in the codeintel_scip_documents table
+// with the given identifier. Only the document lookup and symbol data are written for such documents.
+func (s *scipWriter) CopyDocument(ctx context.Context, path string, documentID int, scipDocument *scip.Document) error {
+	s.batch = append(s.batch, bufferedDocument{
+		path:         path,
+		scipDocument: scipDocument,
+		documentID:   documentID,
+	})
+
+	if len(s.batch) >= DocumentsBatchSize {
+		if err := s.flush(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *scipWriter) flush(ctx context.Context) error {
 	documents := s.batch
 	s.batch = nil
 	s.batchPayloadSum = 0
 
-	documentIDs, err := batch.WithInserterForIdentifiers(
-		ctx,
-		s.db.Handle(),
-		"codeintel_scip_documents",
-		batch.MaxNumPostgresParameters,
-		[]string{
-			"schema_version",
-			"payload_hash",
-			"raw_scip_payload",
-		},
-		"ON CONFLICT DO NOTHING",
-		"id",
-		func(inserter *batch.Inserter) error {
-			for _, document := range documents {
-				if err := inserter.Insert(ctx, 1, document.payloadHash, document.payload); err != nil {
-					return err
-				}
-			}
+	newDocuments := make([]bufferedDocument, 0, len(documents))
+	for _, document := range documents {
+		if document.documentID == 0 {
+			newDocuments = append(newDocuments, document)
+		}
+	}
 
-			return nil
-		},
-	)
+	newDocumentIDs, err := s.insertDocumentPayloads(ctx, newDocuments)
 	if err != nil {
 		return err
 	}
-	if len(documentIDs) != len(documents) {
-		hashes := make([][]byte, 0, len(documents))
-		hashSet := make(map[string]struct{}, len(documents))
-		for _, document := range documents {
-			key := hex.EncodeToString(document.payloadHash)
-			if _, ok := hashSet[key]; !ok {
-				hashSet[key] = struct{}{}
-				hashes = append(hashes, document.payloadHash)
-			}
-		}
-		idsByHash, err := scanIDsByHash(s.db.Query(ctx, sqlf.Sprintf(scipWriterWriteFetchDocumentsQuery, pq.Array(hashes))))
-		if err != nil {
-			return err
-		}
-		documentIDs = documentIDs[:0]
-		for _, document := range documents {
-			documentIDs = append(documentIDs, idsByHash[hex.EncodeToString(document.payloadHash)])
-		}
-		if len(idsByHash) != len(hashes) {
-			return errors.New("unexpected number of document records inserted/retrieved")
+
+	documentIDs := make([]int, 0, len(documents))
+	for _, document := range documents {
+		if document.documentID != 0 {
+			documentIDs = append(documentIDs, document.documentID)
+		} else {
+			documentIDs = append(documentIDs, newDocumentIDs[0])
+			newDocumentIDs = newDocumentIDs[1:]
 		}
 	}
 
@@ -281,6 +293,12 @@ func (s *scipWriter) flush(ctx context.Context) error {
 		return errors.New("unexpected number of document lookup records inserted")
 	}
 
+	for i, document := range documents {
+		if err := s.insertDiagnostics(ctx, documentLookupIDs[i], document.scipDocument); err != nil {
+			return err
+		}
+	}
+
 	symbolNameMap := map[string]struct{}{}
 	invertedRangeIndexes := make([][]shared.InvertedRangeIndex, 0, len(documents))
 	for _, document := range documents {
@@ -368,12 +386,156 @@ func (s *scipWriter) flush(ctx context.Context) error {
 	return nil
 }
 
+// insertDiagnostics writes the diagnostics attached to the occurrences of the given document so
+// that they can be queried without decoding the document payload.
+func (s *scipWriter) insertDiagnostics(ctx context.Context, documentLookupID int, scipDocument *scip.Document) error {
+	for _, occurrence := range scipDocument.Occurrences {
+		if len(occurrence.Diagnostics) == 0 {
+			continue
+		}
+
+		r := scip.NewRange(occurrence.Range)
+
+		for _, diagnostic := range occurrence.Diagnostics {
+			if err := s.diagnosticInserter.Insert(
+				ctx,
+				s.uploadID,
+				documentLookupID,
+				int(diagnostic.Severity),
+				diagnostic.Code,
+				diagnostic.Message,
+				diagnostic.Source,
+				r.Start.Line,
+				r.Start.Character,
+				r.End.Line,
+				r.End.Character,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// insertDocumentPayloads returns the identifiers of the payload rows of the given documents in the
+// same order as the given documents. Documents are content-addressed by the hash of their payload:
+// payloads that are already stored (e.g., by a previous upload of the same repository) are reused,
+// and only the remaining payloads are compressed and inserted.
+func (s *scipWriter) insertDocumentPayloads(ctx context.Context, documents []bufferedDocument) ([]int, error) {
+	if len(documents) == 0 {
+		return nil, nil
+	}
+
+	hashes := make([][]byte, 0, len(documents))
+	hashSet := make(map[string]struct{}, len(documents))
+	for _, document := range documents {
+		key := hex.EncodeToString(document.payloadHash)
+		if _, ok := hashSet[key]; !ok {
+			hashSet[key] = struct{}{}
+			hashes = append(hashes, document.payloadHash)
+		}
+	}
+
+	idsByHash, err := s.fetchDocumentIDsByHash(ctx, hashes)
+	if err != nil {
+		return nil, err
+	}
+
+	missing := make([]bufferedDocument, 0, len(documents))
+	for _, document := range documents {
+		key := hex.EncodeToString(document.payloadHash)
+		if _, ok := idsByHash[key]; ok {
+			continue
+		}
+
+		// Mark as seen so that duplicate payloads within the batch are inserted only once
+		idsByHash[key] = 0
+		missing = append(missing, document)
+	}
+
+	if len(missing) > 0 {
+		insertedIDs, err := batch.WithInserterForIdentifiers(
+			ctx,
+			s.db.Handle(),
+			"codeintel_scip_documents",
+			batch.MaxNumPostgresParameters,
+			[]string{
+				"schema_version",
+				"payload_hash",
+				"raw_scip_payload",
+			},
+			"ON CONFLICT DO NOTHING",
+			"id",
+			func(inserter *batch.Inserter) error {
+				for _, document := range missing {
+					compressedPayload, err := shared.Compressor.Compress(bytes.NewReader(document.payload))
+					if err != nil {
+						return err
+					}
+
+					if err := inserter.Insert(ctx, 1, document.payloadHash, compressedPayload); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(insertedIDs) == len(missing) {
+			for i, document := range missing {
+				idsByHash[hex.EncodeToString(document.payloadHash)] = insertedIDs[i]
+			}
+		} else {
+			// A concurrent writer inserted some of the same payloads; re-read the identifiers
+			// of all payloads that we attempted to insert.
+			missingHashes := make([][]byte, 0, len(missing))
+			for _, document := range missing {
+				missingHashes = append(missingHashes, document.payloadHash)
+			}
+
+			missingIDsByHash, err := s.fetchDocumentIDsByHash(ctx, missingHashes)
+			if err != nil {
+				return nil, err
+			}
+			for hash, id := range missingIDsByHash {
+				idsByHash[hash] = id
+			}
+		}
+	}
+
+	documentIDs := make([]int, 0, len(documents))
+	for _, document := range documents {
+		id := idsByHash[hex.EncodeToString(document.payloadHash)]
+		if id == 0 {
+			return nil, errors.New("unexpected number of document records inserted/retrieved")
+		}
+
+		documentIDs = append(documentIDs, id)
+	}
+
+	return documentIDs, nil
+}
+
+// fetchDocumentIDsByHash returns a map from the hex-encoded hashes to the identifiers of the existing
+// payload rows matching the given payload hashes. The matching rows are locked so that they cannot be
+// removed by the unreferenced document janitor before the referencing lookup rows are committed.
+func (s *scipWriter) fetchDocumentIDsByHash(ctx context.Context, hashes [][]byte) (map[string]int, error) {
+	return scanIDsByHash(s.db.Query(ctx, sqlf.Sprintf(scipWriterWriteFetchDocumentsQuery, pq.Array(hashes))))
+}
+
 const scipWriterWriteFetchDocumentsQuery = `
 SELECT
 	encode(payload_hash, 'hex'),
 	id
 FROM codeintel_scip_documents
 WHERE payload_hash = ANY(%s)
+ORDER BY id
+FOR KEY SHARE
 `
 
 func (s *scipWriter) Flush(ctx context.Context) (uint32, error) {
@@ -389,6 +551,9 @@ func (s *scipWriter) Flush(ctx context.Context) (uint32, error) {
 	if err := s.symbolInserter.Flush(ctx); err != nil {
 		return 0, err
 	}
+	if err := s.diagnosticInserter.Flush(ctx); err != nil {
+		return 0, err
+	}
 
 	// Move all data from temp tables into target tables
 	if err := s.db.Exec(ctx, sqlf.Sprintf(scipWriterFlushSymbolNamesQuery, s.uploadID)); err != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/sourcegraph/log/logtest"
 	"github.com/sourcegraph/scip/bindings/go/scip"
 
@@ -107,6 +108,55 @@ func TestInsertSharedDocumentsConcurrently(t *testing.T) {
 	}
 }
 
+func TestInsertDuplicateDocumentsInBatch(t *testing.T) {
+	logger := logtest.Scoped(t)
+	codeIntelDB := codeintelshared.NewCodeIntelDB(logger, dbtest.NewDB(logger, t))
+	store := newInternal(&observation.TestContext, codeIntelDB)
+	ctx := context.Background()
+
+	tx, err := store.Transact(ctx)
+	if err != nil {
+		t.Fatalf("failed to start transaction: %s", err)
+	}
+	scipWriter, err := tx.NewSCIPWriter(ctx, 24)
+	if err != nil {
+		t.Fatalf("failed to create SCIP writer: %s", err)
+	}
+	for _, path := range []string{"a/generated.go", "b/generated.go", "c/generated.go"} {
+		if err := scipWriter.InsertDocument(
+			ctx,
+			path,
+			&scip.Document{
+				Symbols: []*scip.SymbolInformation{
+					{Symbol: "lorem ipsum dolor sit amet"},
+				},
+			},
+		); err != nil {
+			t.Fatalf("failed to write SCIP document: %s", err)
+		}
+	}
+	if _, err := scipWriter.Flush(ctx); err != nil {
+		t.Fatalf("failed to flush SCIP data: %s", err)
+	}
+	if err := tx.Done(nil); err != nil {
+		t.Fatalf("failed to commit transaction: %s", err)
+	}
+
+	count, _, err := basestore.ScanFirstInt(codeIntelDB.Handle().QueryContext(ctx, `SELECT COUNT(*) FROM codeintel_scip_documents`))
+	if err != nil {
+		t.Fatalf("failed to query number of SCIP documents: %s", err)
+	} else if expected := 1; count != expected {
+		t.Fatalf("unexpected number of documents. want=%d have=%d", expected, count)
+	}
+
+	count, _, err = basestore.ScanFirstInt(codeIntelDB.Handle().QueryContext(ctx, `SELECT COUNT(DISTINCT document_id) FROM codeintel_scip_document_lookup WHERE upload_id = 24`))
+	if err != nil {
+		t.Fatalf("failed to query number of SCIP document references: %s", err)
+	} else if expected := 1; count != expected {
+		t.Fatalf("unexpected number of referenced documents. want=%d have=%d", expected, count)
+	}
+}
+
 func TestInsertDocumentWithSymbols(t *testing.T) {
 	logger := logtest.Scoped(t)
 	codeIntelDB := codeintelshared.NewCodeIntelDB(logger, dbtest.NewDB(logger, t))
@@ -175,3 +225,76 @@ func TestInsertDocumentWithSymbols(t *testing.T) {
 		t.Fatalf("unexpected number of symbols inserted. want=%d have=%d", expected, n)
 	}
 }
+
+func TestInsertDocumentWithDiagnostics(t *testing.T) {
+	logger := logtest.Scoped(t)
+	codeIntelDB := codeintelshared.NewCodeIntelDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, codeIntelDB)
+	ctx := context.Background()
+
+	if err := store.WithTransaction(ctx, func(tx Store) error {
+		scipWriter24, err := tx.NewSCIPWriter(ctx, 24)
+		if err != nil {
+			t.Fatalf("failed to write SCIP symbols: %s", err)
+		}
+
+		if err := scipWriter24.InsertDocument(
+			ctx,
+			"internal/util.go",
+			&scip.Document{
+				Occurrences: []*scip.Occurrence{
+					{
+						Range:  []int32{3, 25, 3, 30},
+						Symbol: "foo.bar.ident",
+						Diagnostics: []*scip.Diagnostic{
+							{Severity: scip.Severity_Warning, Code: "SA1019", Message: "ident is deprecated", Source: "staticcheck"},
+							{Severity: scip.Severity_Error, Code: "E1", Message: "ident is unused", Source: "vet"},
+						},
+					},
+					{
+						Range:  []int32{4, 25, 30},
+						Symbol: "foo.bar.ident",
+					},
+				},
+			},
+		); err != nil {
+			t.Fatalf("failed to write SCIP document: %s", err)
+		}
+
+		if _, err := scipWriter24.Flush(ctx); err != nil {
+			t.Fatalf("failed to write SCIP symbols: %s", err)
+		}
+
+		return nil
+	}); err != nil {
+		t.Fatalf("failed to commit transaction: %s", err)
+	}
+
+	codes, err := basestore.ScanStrings(codeIntelDB.Handle().QueryContext(ctx, `
+		SELECT d.code || ':' || d.severity || ':' || dl.document_path || ':' || d.start_line || ':' || d.end_character
+		FROM codeintel_scip_diagnostics d
+		JOIN codeintel_scip_document_lookup dl ON dl.id = d.document_lookup_id
+		WHERE d.upload_id = 24
+		ORDER BY d.code
+	`))
+	if err != nil {
+		t.Fatalf("unexpected error querying diagnostics: %s", err)
+	}
+
+	expectedCodes := []string{"E1:1:internal/util.go:3:30", "SA1019:2:internal/util.go:3:30"}
+	if diff := cmp.Diff(expectedCodes, codes); diff != "" {
+		t.Errorf("unexpected diagnostics (-want +got):\n%s", diff)
+	}
+
+	if err := store.DeleteLsifDataByUploadIds(ctx, 24); err != nil {
+		t.Fatalf("unexpected error deleting upload data: %s", err)
+	}
+
+	count, _, err := basestore.ScanFirstInt(codeIntelDB.Handle().QueryContext(ctx, `SELECT COUNT(*) FROM codeintel_scip_diagnostics`))
+	if err != nil {
+		t.Fatalf("unexpected error counting diagnostics: %s", err)
+	}
+	if count != 0 {
+		t.Errorf("expected diagnostics to be removed with their upload, have %d", count)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 
 	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
 	"github.com/sourcegraph/scip/bindings/go/scip"
 	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/protobuf/proto"
@@ -66,3 +67,82 @@ JOIN codeintel_scip_documents sd ON sd.id = sid.document_id
 WHERE sid.upload_id = %s
 ORDER BY sid.document_path
 `
+
+// GetDocumentPaths returns the paths of all documents of the given upload.
+func (s *store) GetDocumentPaths(ctx context.Context, uploadID int) (_ []string, err error) {
+	ctx, _, endObservation := s.operations.getDocumentPaths.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("uploadID", uploadID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return basestore.ScanStrings(s.db.Query(ctx, sqlf.Sprintf(getDocumentPathsQuery, uploadID)))
+}
+
+const getDocumentPathsQuery = `
+SELECT sid.document_path
+FROM codeintel_scip_document_lookup sid
+WHERE sid.upload_id = %s
+ORDER BY sid.document_path
+`
+
+// ScanDocuments invokes the given function with each document of the given upload with one of the
+// given paths, along with the identifier of the (shared) document payload.
+func (s *store) ScanDocuments(
+	ctx context.Context,
+	uploadID int,
+	paths []string,
+	f func(path string, documentID int, document *scip.Document) error,
+) (err error) {
+	ctx, _, endObservation := s.operations.scanDocuments.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("uploadID", uploadID),
+		attribute.Int("numPaths", len(paths)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if len(paths) == 0 {
+		return nil
+	}
+
+	rows, err := s.db.Query(ctx, sqlf.Sprintf(scanDocumentsQuery, uploadID, pq.Array(paths)))
+	if err != nil {
+		return err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	for rows.Next() {
+		var path string
+		var documentID int
+		var compressedSCIPPayload []byte
+		if err := rows.Scan(&path, &documentID, &compressedSCIPPayload); err != nil {
+			return err
+		}
+
+		scipPayload, err := shared.Decompressor.Decompress(bytes.NewReader(compressedSCIPPayload))
+		if err != nil {
+			return err
+		}
+
+		var document scip.Document
+		if err := proto.Unmarshal(scipPayload, &document); err != nil {
+			return err
+		}
+		if err := f(path, documentID, &document); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const scanDocumentsQuery = `
+SELECT
+	sid.document_path,
+	sd.id,
+	sd.raw_scip_payload
+FROM codeintel_scip_document_lookup sid
+JOIN codeintel_scip_documents sd ON sd.id = sid.document_id
+WHERE
+	sid.upload_id = %s AND
+	sid.document_path = ANY(%s)
+ORDER BY sid.document_path
+`
@@ -28,11 +28,14 @@ type Store interface {
 	DeleteUnreferencedDocuments(ctx context.Context, batchSize int, maxAge time.Duration, now time.Time) (numScanned, numDeleted int, err error)
 
 	// Scan/export document data
+	GetDocumentPaths(ctx context.Context, uploadID int) ([]string, error)
+	ScanDocuments(ctx context.Context, uploadID int, paths []string, f func(path string, documentID int, document *scip.Document) error) error
 	InsertDefinitionsAndReferencesForDocument(ctx context.Context, upload shared.ExportedUpload, rankingGraphKey string, rankingBatchSize int, f func(ctx context.Context, upload shared.ExportedUpload, rankingBatchSize int, rankingGraphKey, path string, document *scip.Document) error) (err error)
 }
 
 type SCIPWriter interface {
 	InsertDocument(ctx context.Context, path string, scipDocument *scip.Document) error
+	CopyDocument(ctx context.Context, path string, documentID int, scipDocument *scip.Document) error
 	Flush(ctx context.Context) (uint32, error)
 }
 
@@ -7,9 +7,12 @@ import (
 	"time"
 
 	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
 	"go.opentelemetry.io/otel/attribute"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/shared"
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/timeutil"
 )
@@ -131,6 +134,125 @@ const updateUploadRetentionQuery = `
 UPDATE lsif_uploads SET %s WHERE id IN (%s)
 `
 
+// GetUploadStorageSize returns the total size in bytes of the completed and unexpired uploads of the
+// given repository, or of all repositories if the given repository identifier is zero. The size of an
+// upload is its uncompressed size when known, and the size of the uploaded payload otherwise.
+func (s *store) GetUploadStorageSize(ctx context.Context, repositoryID int) (_ int64, err error) {
+	ctx, _, endObservation := s.operations.getUploadStorageSize.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", repositoryID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	size, _, err := basestore.ScanFirstInt64(s.db.Query(ctx, sqlf.Sprintf(getUploadStorageSizeQuery, repositoryID, repositoryID)))
+	return size, err
+}
+
+const getUploadStorageSizeQuery = `
+SELECT COALESCE(SUM(COALESCE(u.uncompressed_size, u.upload_size, 0)), 0)::bigint
+FROM lsif_uploads u
+WHERE
+	u.state = 'completed' AND
+	NOT u.expired AND
+	(%s = 0 OR u.repository_id = %s)
+`
+
+// GetQuotaEvictionCandidates returns a page of the completed and unexpired uploads of the given repository
+// (or of all repositories if the given repository identifier is zero) ordered from least to most valuable.
+// Uploads that are not visible from the tip of any branch or tag come first, then older uploads before
+// newer ones, and finally uploads that were never queried or least recently queried before uploads that
+// were queried more recently. Uploads that have not yet been installed into the commit graph of their
+// repository are never candidates, as whether they are visible from a tip is not yet known.
+func (s *store) GetQuotaEvictionCandidates(ctx context.Context, repositoryID, limit, offset int) (_ []shared.QuotaEvictionCandidate, err error) {
+	ctx, _, endObservation := s.operations.getQuotaEvictionCandidates.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", repositoryID),
+		attribute.Int("limit", limit),
+		attribute.Int("offset", offset),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return scanQuotaEvictionCandidates(s.db.Query(ctx, sqlf.Sprintf(
+		getQuotaEvictionCandidatesQuery,
+		repositoryID,
+		repositoryID,
+		limit,
+		offset,
+	)))
+}
+
+const getQuotaEvictionCandidatesQuery = `
+SELECT
+	u.id,
+	u.repository_id,
+	u.commit,
+	u.uploaded_at,
+	COALESCE(u.uncompressed_size, u.upload_size, 0),
+	EXISTS (SELECT 1 FROM lsif_uploads_visible_at_tip t WHERE t.upload_id = u.id) AS visible_at_tip,
+	u.last_queried_at
+FROM lsif_uploads u
+WHERE
+	u.state = 'completed' AND
+	NOT u.expired AND
+	(%s = 0 OR u.repository_id = %s) AND
+	u.finished_at < (SELECT updated_at FROM lsif_dirty_repositories ldr WHERE ldr.repository_id = u.repository_id)
+ORDER BY
+	visible_at_tip,
+	u.uploaded_at,
+	u.last_queried_at NULLS FIRST,
+	u.id
+LIMIT %s OFFSET %s
+`
+
+var scanQuotaEvictionCandidates = basestore.NewSliceScanner(func(s dbutil.Scanner) (c shared.QuotaEvictionCandidate, err error) {
+	err = s.Scan(
+		&c.UploadID,
+		&c.RepositoryID,
+		&c.Commit,
+		&c.UploadedAt,
+		&c.Size,
+		&c.VisibleAtTip,
+		&c.LastQueriedAt,
+	)
+	return c, err
+})
+
+// MarkUploadsQueried records that the given uploads were used to answer a code navigation query. The
+// timestamp is only updated once an hour, so that frequently queried uploads aren't written on every
+// request.
+func (s *store) MarkUploadsQueried(ctx context.Context, ids ...int) (err error) {
+	ctx, _, endObservation := s.operations.markUploadsQueried.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("numIDs", len(ids)),
+		attribute.IntSlice("ids", ids),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return s.db.Exec(ctx, sqlf.Sprintf(markUploadsQueriedQuery, pq.Array(ids)))
+}
+
+const markUploadsQueriedQuery = `
+UPDATE lsif_uploads
+SET last_queried_at = NOW()
+WHERE
+	id = ANY(%s) AND
+	(last_queried_at IS NULL OR last_queried_at < NOW() - '1 hour'::interval)
+`
+
+// pendingIncrementalUploadExistsQueryFragment is true when the upload u is the base of an incremental
+// upload that has not yet been processed. Such uploads are kept until the documents they share with
+// the incremental upload have been copied over.
+const pendingIncrementalUploadExistsQueryFragment = `
+EXISTS (
+	SELECT 1
+	FROM lsif_uploads iu
+	WHERE
+		iu.base_upload_id = u.id AND
+		iu.state IN ('uploading', 'queued', 'processing')
+)
+`
+
 // SoftDeleteExpiredUploads marks upload records that are both expired and have no references
 // as deleted. The associated repositories will be marked as dirty so that their commit graphs
 // are updated in the near future.
@@ -184,7 +306,7 @@ WITH
 expired_uploads AS (
 	SELECT u.id
 	FROM lsif_uploads u
-	WHERE u.state = 'completed' AND u.expired
+	WHERE u.state = 'completed' AND u.expired AND NOT (` + pendingIncrementalUploadExistsQueryFragment + `)
 	ORDER BY u.last_referenced_scan_at NULLS FIRST, u.finished_at, u.id
 	LIMIT %s
 ),
@@ -370,7 +492,7 @@ root_upload_and_packages AS (
 			` + packageRankingQueryFragment + ` AS rank
 		FROM lsif_uploads u
 		LEFT JOIN lsif_packages p ON p.dump_id = u.id
-		WHERE u.state = 'completed' AND u.expired
+		WHERE u.state = 'completed' AND u.expired AND NOT (` + pendingIncrementalUploadExistsQueryFragment + `)
 	) s
 
 	WHERE s.rank = 1 AND EXISTS (
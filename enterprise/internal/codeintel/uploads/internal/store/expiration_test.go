@@ -4,6 +4,7 @@ import (
 	"context"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/sourcegraph/log/logtest"
@@ -14,6 +15,145 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 )
 
+func TestGetUploadStorageSize(t *testing.T) {
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+	ctx := context.Background()
+
+	size := func(v int64) *int64 { return &v }
+
+	insertUploads(t, db,
+		shared.Upload{ID: 50, RepositoryID: 100, State: "completed", UploadSize: size(10)},
+		shared.Upload{ID: 51, RepositoryID: 100, State: "completed", UploadSize: size(20)},
+		shared.Upload{ID: 52, RepositoryID: 100, State: "queued", UploadSize: size(40)},
+		shared.Upload{ID: 53, RepositoryID: 101, State: "completed", UploadSize: size(80)},
+		shared.Upload{ID: 54, RepositoryID: 101, State: "completed", UploadSize: size(160)},
+	)
+	if err := store.UpdateUploadRetention(ctx, nil, []int{54}); err != nil {
+		t.Fatalf("unexpected error marking uploads as expired: %s", err)
+	}
+
+	for repositoryID, expectedSize := range map[int]int64{
+		0:   110,
+		100: 30,
+		101: 80,
+		102: 0,
+	} {
+		if size, err := store.GetUploadStorageSize(ctx, repositoryID); err != nil {
+			t.Fatalf("unexpected error getting upload storage size: %s", err)
+		} else if size != expectedSize {
+			t.Errorf("unexpected storage size for repository %d. want=%d have=%d", repositoryID, expectedSize, size)
+		}
+	}
+}
+
+func TestGetQuotaEvictionCandidates(t *testing.T) {
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+	ctx := context.Background()
+
+	t1 := time.Unix(1587396557, 0).UTC()
+	t2 := t1.Add(time.Hour)
+
+	t3 := t1.Add(time.Hour * 2)
+
+	insertUploads(t, db,
+		shared.Upload{ID: 50, RepositoryID: 100, State: "completed", UploadedAt: t1, FinishedAt: &t1}, // visible at tip
+		shared.Upload{ID: 51, RepositoryID: 100, State: "completed", UploadedAt: t2, FinishedAt: &t2},
+		shared.Upload{ID: 52, RepositoryID: 100, State: "completed", UploadedAt: t1, FinishedAt: &t1},
+		shared.Upload{ID: 53, RepositoryID: 100, State: "completed", UploadedAt: t1, FinishedAt: &t1},
+		shared.Upload{ID: 54, RepositoryID: 101, State: "completed", UploadedAt: t1, FinishedAt: &t1},
+		shared.Upload{ID: 55, RepositoryID: 101, State: "completed", UploadedAt: t3, FinishedAt: &t3}, // not in commit graph
+	)
+
+	if _, err := db.ExecContext(ctx, `
+		INSERT INTO lsif_dirty_repositories
+			(repository_id, dirty_token, update_token, updated_at)
+		VALUES
+			(100, 1, 1, $1),
+			(101, 1, 1, $1)
+	`, t2.Add(time.Minute)); err != nil {
+		t.Fatalf("unexpected error inserting dirty repositories: %s", err)
+	}
+
+	if _, err := db.ExecContext(ctx, `
+		INSERT INTO lsif_uploads_visible_at_tip
+			(repository_id, upload_id, is_default_branch)
+		VALUES
+			(100, 50, true)
+	`); err != nil {
+		t.Fatalf("unexpected error inserting visible uploads: %s", err)
+	}
+	// Uploads that were never queried come before uploads that were
+	if err := store.MarkUploadsQueried(ctx, 52); err != nil {
+		t.Fatalf("unexpected error marking uploads as queried: %s", err)
+	}
+
+	candidates, err := store.GetQuotaEvictionCandidates(ctx, 100, 10, 0)
+	if err != nil {
+		t.Fatalf("unexpected error getting eviction candidates: %s", err)
+	}
+
+	var ids []int
+	for _, candidate := range candidates {
+		ids = append(ids, candidate.UploadID)
+	}
+	if diff := cmp.Diff([]int{53, 52, 51, 50}, ids); diff != "" {
+		t.Errorf("unexpected eviction order (-want +got):\n%s", diff)
+	}
+
+	if candidates, err := store.GetQuotaEvictionCandidates(ctx, 0, 2, 1); err != nil {
+		t.Fatalf("unexpected error getting eviction candidates: %s", err)
+	} else if len(candidates) != 2 || candidates[0].UploadID != 54 || candidates[1].UploadID != 52 {
+		t.Errorf("unexpected eviction candidates page: %v", candidates)
+	}
+}
+
+func TestMarkUploadsQueried(t *testing.T) {
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+	ctx := context.Background()
+
+	insertUploads(t, db,
+		shared.Upload{ID: 50, RepositoryID: 100, State: "completed"},
+		shared.Upload{ID: 51, RepositoryID: 100, State: "completed"},
+		shared.Upload{ID: 52, RepositoryID: 100, State: "completed"},
+	)
+
+	recent := time.Now().Add(-time.Minute).UTC().Truncate(time.Microsecond)
+	if _, err := db.ExecContext(ctx, `UPDATE lsif_uploads SET last_queried_at = $1 WHERE id = 50`, recent); err != nil {
+		t.Fatalf("unexpected error updating uploads: %s", err)
+	}
+	if _, err := db.ExecContext(ctx, `UPDATE lsif_uploads SET last_queried_at = $1 WHERE id = 51`, recent.Add(-2*time.Hour)); err != nil {
+		t.Fatalf("unexpected error updating uploads: %s", err)
+	}
+
+	if err := store.MarkUploadsQueried(ctx, 50, 51); err != nil {
+		t.Fatalf("unexpected error marking uploads as queried: %s", err)
+	}
+
+	lastQueriedAt := func(id int) (queriedAt *time.Time) {
+		if err := db.QueryRowContext(ctx, `SELECT last_queried_at FROM lsif_uploads WHERE id = $1`, id).Scan(&queriedAt); err != nil {
+			t.Fatalf("unexpected error querying uploads: %s", err)
+		}
+		return queriedAt
+	}
+
+	// Recently queried uploads are not written again
+	if have := lastQueriedAt(50); have == nil || !have.Equal(recent) {
+		t.Errorf("unexpected last queried time for upload 50. want=%s have=%v", recent, have)
+	}
+	if have := lastQueriedAt(51); have == nil || !have.After(recent) {
+		t.Errorf("expected last queried time for upload 51 to be updated. have=%v", have)
+	}
+	if have := lastQueriedAt(52); have != nil {
+		t.Errorf("unexpected last queried time for upload 52. have=%v", have)
+	}
+}
+
 func TestSoftDeleteExpiredUploads(t *testing.T) {
 	logger := logtest.Scoped(t)
 	db := database.NewDB(logger, dbtest.NewDB(logger, t))
@@ -94,6 +234,44 @@ func TestSoftDeleteExpiredUploads(t *testing.T) {
 	}
 }
 
+func TestSoftDeleteExpiredUploadsWithPendingIncrementalUpload(t *testing.T) {
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	baseUploadID1 := 50
+	baseUploadID2 := 51
+	insertUploads(t, db,
+		shared.Upload{ID: 50, RepositoryID: 100, State: "completed"},
+		shared.Upload{ID: 51, RepositoryID: 100, State: "completed", Root: "sub/"},
+		shared.Upload{ID: 52, RepositoryID: 100, State: "queued", BaseUploadID: &baseUploadID1},
+		shared.Upload{ID: 53, RepositoryID: 100, State: "completed", Root: "sub/", BaseUploadID: &baseUploadID2},
+	)
+
+	if err := store.UpdateUploadRetention(context.Background(), []int{}, []int{50, 51}); err != nil {
+		t.Fatalf("unexpected error marking uploads as expired: %s", err)
+	}
+
+	if _, count, err := store.SoftDeleteExpiredUploads(context.Background(), 100); err != nil {
+		t.Fatalf("unexpected error soft deleting uploads: %s", err)
+	} else if count != 1 {
+		t.Fatalf("unexpected number of uploads deleted: want=%d have=%d", 1, count)
+	}
+
+	// Upload 50 is kept until the queued incremental upload layered on top of it is processed
+	expectedStates := map[int]string{
+		50: "completed",
+		51: "deleting",
+		52: "queued",
+		53: "completed",
+	}
+	if states, err := getUploadStates(db, 50, 51, 52, 53); err != nil {
+		t.Fatalf("unexpected error getting states: %s", err)
+	} else if diff := cmp.Diff(expectedStates, states); diff != "" {
+		t.Errorf("unexpected upload states (-want +got):\n%s", diff)
+	}
+}
+
 func TestSoftDeleteExpiredUploadsViaTraversal(t *testing.T) {
 	logger := logtest.Scoped(t)
 	db := database.NewDB(logger, dbtest.NewDB(logger, t))
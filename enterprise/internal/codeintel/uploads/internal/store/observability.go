@@ -47,6 +47,9 @@ type operations struct {
 	persistNearestUploadsLinks           *observation.Operation
 	persistUploadsVisibleAtTip           *observation.Operation
 	updateUploadRetention                *observation.Operation
+	getUploadStorageSize                 *observation.Operation
+	getQuotaEvictionCandidates           *observation.Operation
+	markUploadsQueried                   *observation.Operation
 	updateCommittedAt                    *observation.Operation
 	sourcedCommitsWithoutCommittedAt     *observation.Operation
 	deleteUploadsWithoutRepository       *observation.Operation
@@ -149,6 +152,9 @@ func newOperations(observationCtx *observation.Context) *operations {
 		getVisibleUploadsMatchingMonikers:    op("GetVisibleUploadsMatchingMonikers"),
 		updateUploadsVisibleToCommits:        op("UpdateUploadsVisibleToCommits"),
 		updateUploadRetention:                op("UpdateUploadRetention"),
+		getUploadStorageSize:                 op("GetUploadStorageSize"),
+		getQuotaEvictionCandidates:           op("GetQuotaEvictionCandidates"),
+		markUploadsQueried:                   op("MarkUploadsQueried"),
 		updateCommittedAt:                    op("UpdateCommittedAt"),
 		sourcedCommitsWithoutCommittedAt:     op("SourcedCommitsWithoutCommittedAt"),
 		deleteUploadsStuckUploading:          op("DeleteUploadsStuckUploading"),
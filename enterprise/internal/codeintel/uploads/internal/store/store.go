@@ -78,6 +78,9 @@ type Store interface {
 	GetLastUploadRetentionScanForRepository(ctx context.Context, repositoryID int) (*time.Time, error)
 	SetRepositoriesForRetentionScan(ctx context.Context, processDelay time.Duration, limit int) ([]int, error)
 	UpdateUploadRetention(ctx context.Context, protectedIDs, expiredIDs []int) error
+	GetUploadStorageSize(ctx context.Context, repositoryID int) (int64, error)
+	GetQuotaEvictionCandidates(ctx context.Context, repositoryID, limit, offset int) ([]shared.QuotaEvictionCandidate, error)
+	MarkUploadsQueried(ctx context.Context, ids ...int) error
 	SoftDeleteExpiredUploads(ctx context.Context, batchSize int) (int, int, error)
 	SoftDeleteExpiredUploadsViaTraversal(ctx context.Context, maxTraversal int) (int, int, error)
 
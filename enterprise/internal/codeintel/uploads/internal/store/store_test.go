@@ -215,8 +215,9 @@ func insertUploads(t testing.TB, db database.DB, uploads ...shared.Upload) {
 				upload_size,
 				associated_index_id,
 				content_type,
-				should_reindex
-			) VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
+				should_reindex,
+				base_upload_id
+			) VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
 		`,
 			upload.ID,
 			upload.Commit,
@@ -238,6 +239,7 @@ func insertUploads(t testing.TB, db database.DB, uploads ...shared.Upload) {
 			upload.AssociatedIndexID,
 			upload.ContentType,
 			upload.ShouldReindex,
+			upload.BaseUploadID,
 		)
 
 		if _, err := db.ExecContext(context.Background(), query.Query(sqlf.PostgresBindVar), query.Args()...); err != nil {
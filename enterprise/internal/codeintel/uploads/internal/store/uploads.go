@@ -110,7 +110,8 @@ SELECT
 	u.content_type,
 	u.should_reindex,
 	s.rank,
-	u.uncompressed_size
+	u.uncompressed_size,
+	u.base_upload_id
 FROM %s
 LEFT JOIN (` + uploadRankQueryFragment + `) s
 ON u.id = s.id
@@ -155,6 +156,7 @@ func scanCompleteUpload(s dbutil.Scanner) (upload shared.Upload, _ error) {
 		&upload.ShouldReindex,
 		&upload.Rank,
 		&upload.UncompressedSize,
+		&upload.BaseUploadID,
 	); err != nil {
 		return upload, err
 	}
@@ -210,7 +212,8 @@ SELECT
 	u.content_type,
 	u.should_reindex,
 	s.rank,
-	u.uncompressed_size
+	u.uncompressed_size,
+	u.base_upload_id
 FROM lsif_uploads u
 LEFT JOIN (` + uploadRankQueryFragment + `) s
 ON u.id = s.id
@@ -329,7 +332,8 @@ SELECT
 	u.content_type,
 	u.should_reindex,
 	s.rank,
-	u.uncompressed_size
+	u.uncompressed_size,
+	u.base_upload_id
 FROM lsif_uploads u
 LEFT JOIN (` + uploadRankQueryFragment + `) s
 ON u.id = s.id
@@ -1084,7 +1088,8 @@ func buildGetConditionsAndCte(opts shared.GetUploadsOptions) (*sqlf.Query, []*sq
 				content_type,
 				should_reindex,
 				expired,
-				uncompressed_size
+				uncompressed_size,
+				base_upload_id
 			FROM lsif_uploads
 			UNION ALL
 			SELECT *
@@ -1176,7 +1181,8 @@ SELECT
 	au.upload_size, au.associated_index_id, au.content_type,
 	false AS should_reindex, -- TODO
 	COALESCE((snapshot->'expired')::boolean, false) AS expired,
-	NULL::bigint AS uncompressed_size
+	NULL::bigint AS uncompressed_size,
+	NULL::integer AS base_upload_id
 FROM (
 	SELECT upload_id, snapshot_transition_columns(transition_columns ORDER BY sequence ASC) AS snapshot
 	FROM lsif_uploads_audit_logs
@@ -45,6 +45,18 @@ func (s *Service) GetCommitsVisibleToUpload(ctx context.Context, uploadID, limit
 	return s.store.GetCommitsVisibleToUpload(ctx, uploadID, limit, token)
 }
 
+func (s *Service) GetUploadStorageSize(ctx context.Context, repositoryID int) (int64, error) {
+	return s.store.GetUploadStorageSize(ctx, repositoryID)
+}
+
+func (s *Service) GetQuotaEvictionCandidates(ctx context.Context, repositoryID, limit, offset int) ([]shared.QuotaEvictionCandidate, error) {
+	return s.store.GetQuotaEvictionCandidates(ctx, repositoryID, limit, offset)
+}
+
+func (s *Service) MarkUploadsQueried(ctx context.Context, ids ...int) error {
+	return s.store.MarkUploadsQueried(ctx, ids...)
+}
+
 func (s *Service) GetCommitGraphMetadata(ctx context.Context, repositoryID int) (bool, *time.Time, error) {
 	return s.store.GetCommitGraphMetadata(ctx, repositoryID)
 }
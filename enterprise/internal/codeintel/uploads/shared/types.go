@@ -35,6 +35,7 @@ type Upload struct {
 	AssociatedIndexID *int
 	ContentType       string
 	ShouldReindex     bool
+	BaseUploadID      *int
 }
 
 func (u Upload) RecordID() int {
@@ -45,6 +46,19 @@ func (u Upload) RecordUID() string {
 	return strconv.Itoa(u.ID)
 }
 
+// QuotaEvictionCandidate is a completed upload considered for eviction when the uploads of
+// its repository (or of the instance) exceed a storage quota. LastQueriedAt is the last time the
+// upload was used to answer a code navigation query, if ever.
+type QuotaEvictionCandidate struct {
+	UploadID      int
+	RepositoryID  int
+	Commit        string
+	UploadedAt    time.Time
+	Size          int64
+	VisibleAtTip  bool
+	LastQueriedAt *time.Time
+}
+
 // TODO - unify with Upload
 // Dump is a subset of the lsif_uploads table (queried via the lsif_dumps_with_repository_name view)
 // and stores only processed records.
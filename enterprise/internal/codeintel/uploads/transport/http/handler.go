@@ -54,6 +54,7 @@ func newHandler(
 			IndexerVersion:    getQuery(r, "indexerVersion"),
 			AssociatedIndexID: getQueryInt(r, "associatedIndexId"),
 			ContentType:       contentType,
+			BaseUploadID:      getQueryInt(r, "baseUploadId"),
 		}, 0, nil
 	}
 
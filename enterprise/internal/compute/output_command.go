@@ -101,6 +101,16 @@ func resultChunks(r result.Match, kind string, onlyPath bool) []string {
 		return []string{content}
 	case *result.OwnerMatch:
 		return []string{m.ResolvedOwner.Identifier()}
+	case *result.DiagnosticMatch:
+		if onlyPath {
+			return []string{m.Path}
+		}
+
+		chunks := make([]string, 0, len(m.Diagnostics))
+		for _, d := range m.Diagnostics {
+			chunks = append(chunks, d.Message)
+		}
+		return chunks
 	default:
 		panic("unsupported result kind in compute output command")
 	}
@@ -310,6 +310,15 @@ func NewMetaEnvironment(r searchresult.Match, content string) *MetaEnvironment {
 			Owner:   m.ResolvedOwner.Identifier(),
 			Content: content,
 		}
+	case *searchresult.DiagnosticMatch:
+		lang, _ := enry.GetLanguageByExtension(m.Path)
+		return &MetaEnvironment{
+			Repo:    string(m.Repo.Name),
+			Path:    m.Path,
+			Commit:  string(m.CommitID),
+			Content: content,
+			Lang:    lang,
+		}
 	}
 	return &MetaEnvironment{}
 }
@@ -7969,6 +7969,9 @@ type MockEnterpriseDB struct {
 	// RepoKVPsFunc is an instance of a mock function object controlling the
 	// behavior of the method RepoKVPs.
 	RepoKVPsFunc *EnterpriseDBRepoKVPsFunc
+	// RepoLanguagesFunc is an instance of a mock function object
+	// controlling the behavior of the method RepoLanguages.
+	RepoLanguagesFunc *EnterpriseDBRepoLanguagesFunc
 	// RepoPathsFunc is an instance of a mock function object controlling
 	// the behavior of the method RepoPaths.
 	RepoPathsFunc *EnterpriseDBRepoPathsFunc
@@ -7990,6 +7993,9 @@ type MockEnterpriseDB struct {
 	// SearchContextsFunc is an instance of a mock function object
 	// controlling the behavior of the method SearchContexts.
 	SearchContextsFunc *EnterpriseDBSearchContextsFunc
+	// SearchHistoryFunc is an instance of a mock function object
+	// controlling the behavior of the method SearchHistory.
+	SearchHistoryFunc *EnterpriseDBSearchHistoryFunc
 	// SecurityEventLogsFunc is an instance of a mock function object
 	// controlling the behavior of the method SecurityEventLogs.
 	SecurityEventLogsFunc *EnterpriseDBSecurityEventLogsFunc
@@ -8253,6 +8259,11 @@ func NewMockEnterpriseDB() *MockEnterpriseDB {
 				return
 			},
 		},
+		RepoLanguagesFunc: &EnterpriseDBRepoLanguagesFunc{
+			defaultHook: func() (r0 database.RepoLanguagesStore) {
+				return
+			},
+		},
 		RepoPathsFunc: &EnterpriseDBRepoPathsFunc{
 			defaultHook: func() (r0 database.RepoPathStore) {
 				return
@@ -8288,6 +8299,11 @@ func NewMockEnterpriseDB() *MockEnterpriseDB {
 				return
 			},
 		},
+		SearchHistoryFunc: &EnterpriseDBSearchHistoryFunc{
+			defaultHook: func() (r0 database.SearchHistoryStore) {
+				return
+			},
+		},
 		SecurityEventLogsFunc: &EnterpriseDBSecurityEventLogsFunc{
 			defaultHook: func() (r0 database.SecurityEventLogsStore) {
 				return
@@ -8580,6 +8596,11 @@ func NewStrictMockEnterpriseDB() *MockEnterpriseDB {
 				panic("unexpected invocation of MockEnterpriseDB.RepoKVPs")
 			},
 		},
+		RepoLanguagesFunc: &EnterpriseDBRepoLanguagesFunc{
+			defaultHook: func() database.RepoLanguagesStore {
+				panic("unexpected invocation of MockEnterpriseDB.RepoLanguages")
+			},
+		},
 		RepoPathsFunc: &EnterpriseDBRepoPathsFunc{
 			defaultHook: func() database.RepoPathStore {
 				panic("unexpected invocation of MockEnterpriseDB.RepoPaths")
@@ -8615,6 +8636,11 @@ func NewStrictMockEnterpriseDB() *MockEnterpriseDB {
 				panic("unexpected invocation of MockEnterpriseDB.SearchContexts")
 			},
 		},
+		SearchHistoryFunc: &EnterpriseDBSearchHistoryFunc{
+			defaultHook: func() database.SearchHistoryStore {
+				panic("unexpected invocation of MockEnterpriseDB.SearchHistory")
+			},
+		},
 		SecurityEventLogsFunc: &EnterpriseDBSecurityEventLogsFunc{
 			defaultHook: func() database.SecurityEventLogsStore {
 				panic("unexpected invocation of MockEnterpriseDB.SecurityEventLogs")
@@ -8822,6 +8848,9 @@ func NewMockEnterpriseDBFrom(i EnterpriseDB) *MockEnterpriseDB {
 		RepoKVPsFunc: &EnterpriseDBRepoKVPsFunc{
 			defaultHook: i.RepoKVPs,
 		},
+		RepoLanguagesFunc: &EnterpriseDBRepoLanguagesFunc{
+			defaultHook: i.RepoLanguages,
+		},
 		RepoPathsFunc: &EnterpriseDBRepoPathsFunc{
 			defaultHook: i.RepoPaths,
 		},
@@ -8843,6 +8872,9 @@ func NewMockEnterpriseDBFrom(i EnterpriseDB) *MockEnterpriseDB {
 		SearchContextsFunc: &EnterpriseDBSearchContextsFunc{
 			defaultHook: i.SearchContexts,
 		},
+		SearchHistoryFunc: &EnterpriseDBSearchHistoryFunc{
+			defaultHook: i.SearchHistory,
+		},
 		SecurityEventLogsFunc: &EnterpriseDBSecurityEventLogsFunc{
 			defaultHook: i.SecurityEventLogs,
 		},
@@ -13246,6 +13278,105 @@ func (c EnterpriseDBRepoKVPsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// EnterpriseDBRepoLanguagesFunc describes the behavior when the
+// RepoLanguages method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBRepoLanguagesFunc struct {
+	defaultHook func() database.RepoLanguagesStore
+	hooks       []func() database.RepoLanguagesStore
+	history     []EnterpriseDBRepoLanguagesFuncCall
+	mutex       sync.Mutex
+}
+
+// RepoLanguages delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) RepoLanguages() database.RepoLanguagesStore {
+	r0 := m.RepoLanguagesFunc.nextHook()()
+	m.RepoLanguagesFunc.appendCall(EnterpriseDBRepoLanguagesFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the RepoLanguages method
+// of the parent MockEnterpriseDB instance is invoked and the hook queue is
+// empty.
+func (f *EnterpriseDBRepoLanguagesFunc) SetDefaultHook(hook func() database.RepoLanguagesStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RepoLanguages method of the parent MockEnterpriseDB instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *EnterpriseDBRepoLanguagesFunc) PushHook(hook func() database.RepoLanguagesStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *EnterpriseDBRepoLanguagesFunc) SetDefaultReturn(r0 database.RepoLanguagesStore) {
+	f.SetDefaultHook(func() database.RepoLanguagesStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *EnterpriseDBRepoLanguagesFunc) PushReturn(r0 database.RepoLanguagesStore) {
+	f.PushHook(func() database.RepoLanguagesStore {
+		return r0
+	})
+}
+
+func (f *EnterpriseDBRepoLanguagesFunc) nextHook() func() database.RepoLanguagesStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnterpriseDBRepoLanguagesFunc) appendCall(r0 EnterpriseDBRepoLanguagesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of EnterpriseDBRepoLanguagesFuncCall objects
+// describing the invocations of this function.
+func (f *EnterpriseDBRepoLanguagesFunc) History() []EnterpriseDBRepoLanguagesFuncCall {
+	f.mutex.Lock()
+	history := make([]EnterpriseDBRepoLanguagesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnterpriseDBRepoLanguagesFuncCall is an object that describes an
+// invocation of method RepoLanguages on an instance of MockEnterpriseDB.
+type EnterpriseDBRepoLanguagesFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.RepoLanguagesStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnterpriseDBRepoLanguagesFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnterpriseDBRepoLanguagesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // EnterpriseDBRepoPathsFunc describes the behavior when the RepoPaths
 // method of the parent MockEnterpriseDB instance is invoked.
 type EnterpriseDBRepoPathsFunc struct {
@@ -13938,6 +14069,105 @@ func (c EnterpriseDBSearchContextsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// EnterpriseDBSearchHistoryFunc describes the behavior when the
+// SearchHistory method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBSearchHistoryFunc struct {
+	defaultHook func() database.SearchHistoryStore
+	hooks       []func() database.SearchHistoryStore
+	history     []EnterpriseDBSearchHistoryFuncCall
+	mutex       sync.Mutex
+}
+
+// SearchHistory delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) SearchHistory() database.SearchHistoryStore {
+	r0 := m.SearchHistoryFunc.nextHook()()
+	m.SearchHistoryFunc.appendCall(EnterpriseDBSearchHistoryFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the SearchHistory method
+// of the parent MockEnterpriseDB instance is invoked and the hook queue is
+// empty.
+func (f *EnterpriseDBSearchHistoryFunc) SetDefaultHook(hook func() database.SearchHistoryStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SearchHistory method of the parent MockEnterpriseDB instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *EnterpriseDBSearchHistoryFunc) PushHook(hook func() database.SearchHistoryStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *EnterpriseDBSearchHistoryFunc) SetDefaultReturn(r0 database.SearchHistoryStore) {
+	f.SetDefaultHook(func() database.SearchHistoryStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *EnterpriseDBSearchHistoryFunc) PushReturn(r0 database.SearchHistoryStore) {
+	f.PushHook(func() database.SearchHistoryStore {
+		return r0
+	})
+}
+
+func (f *EnterpriseDBSearchHistoryFunc) nextHook() func() database.SearchHistoryStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnterpriseDBSearchHistoryFunc) appendCall(r0 EnterpriseDBSearchHistoryFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of EnterpriseDBSearchHistoryFuncCall objects
+// describing the invocations of this function.
+func (f *EnterpriseDBSearchHistoryFunc) History() []EnterpriseDBSearchHistoryFuncCall {
+	f.mutex.Lock()
+	history := make([]EnterpriseDBSearchHistoryFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnterpriseDBSearchHistoryFuncCall is an object that describes an
+// invocation of method SearchHistory on an instance of MockEnterpriseDB.
+type EnterpriseDBSearchHistoryFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.SearchHistoryStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnterpriseDBSearchHistoryFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnterpriseDBSearchHistoryFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // EnterpriseDBSecurityEventLogsFunc describes the behavior when the
 // SecurityEventLogs method of the parent MockEnterpriseDB instance is
 // invoked.
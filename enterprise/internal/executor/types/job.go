@@ -280,6 +280,11 @@ type DockerStep struct {
 
 	// Env specifies a set of NAME=value pairs to supply to the docker command.
 	Env []string `json:"env"`
+
+	// ParallelGroup groups consecutive steps that are run in parallel. All
+	// consecutive steps with the same non-empty group are started together,
+	// and the next step is only run once all of them have completed.
+	ParallelGroup string `json:"parallelGroup,omitempty"`
 }
 
 // CliStep is a step that runs a src-cli command.
@@ -108,6 +108,10 @@ func TabulationDecoder() (streamhttp.FrontendStreamDecoder, *TabulationResult) {
 					count := len(match.Symbols)
 					tr.TotalCount += count
 					addCount(match.Repository, match.RepositoryID, count)
+				case *streamhttp.EventDiagnosticMatch:
+					count := len(match.Diagnostics)
+					tr.TotalCount += count
+					addCount(match.Repository, match.RepositoryID, count)
 				}
 			}
 		},
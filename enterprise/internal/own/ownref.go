@@ -346,6 +346,86 @@ func (b *bag) Resolve(ctx context.Context, db edb.EnterpriseDB) {
 	}
 }
 
+// CodeHostAccount is the account that an owner has on a code host.
+type CodeHostAccount struct {
+	// UserID is the ID of the Sourcegraph user the account belongs to.
+	UserID int32
+	// AccountID is the ID of the account on the code host.
+	AccountID string
+	// Login is the handle of the account on the code host, if known.
+	Login string
+}
+
+// CodeHostAccounts maps the given references to the accounts that the users
+// they refer to have on the code host identified by serviceType and serviceID.
+// References that cannot be resolved to a user, or that refer to a user
+// without an account on that code host, are returned as unresolved.
+func CodeHostAccounts(ctx context.Context, db edb.EnterpriseDB, serviceType, serviceID string, refs ...Reference) (accounts []CodeHostAccount, unresolved []Reference, err error) {
+	b := EmptyBag()
+	for _, ref := range refs {
+		b.Add(ref)
+	}
+	b.Resolve(ctx, db)
+
+	refsByUser := make(map[int32][]Reference)
+	var userIDs []int32
+	for _, ref := range refs {
+		owner, ok := b.FindResolved(ref)
+		person, isPerson := owner.(*codeowners.Person)
+		if !ok || !isPerson || person.User == nil {
+			unresolved = append(unresolved, ref)
+			continue
+		}
+		id := person.User.ID
+		if _, ok := refsByUser[id]; !ok {
+			userIDs = append(userIDs, id)
+		}
+		refsByUser[id] = append(refsByUser[id], ref)
+	}
+	if len(userIDs) == 0 {
+		return nil, unresolved, nil
+	}
+
+	accountsByUser, err := db.UserExternalAccounts().ListForUsers(ctx, userIDs)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "UserExternalAccounts.ListForUsers")
+	}
+	for _, id := range userIDs {
+		var found bool
+		for _, account := range accountsByUser[id] {
+			if account.ServiceType != serviceType || account.ServiceID != serviceID {
+				continue
+			}
+			accounts = append(accounts, CodeHostAccount{
+				UserID:    id,
+				AccountID: account.AccountID,
+				Login:     codeHostLogin(ctx, account),
+			})
+			found = true
+			break
+		}
+		if !found {
+			unresolved = append(unresolved, refsByUser[id]...)
+		}
+	}
+	return accounts, unresolved, nil
+}
+
+// codeHostLogin returns the handle of the given account on its code host, or
+// an empty string if it cannot be determined.
+func codeHostLogin(ctx context.Context, account *extsvc.Account) string {
+	p := providers.GetProviderbyServiceType(account.ServiceType)
+	if p == nil {
+		extSvcProviderNotFound.WithLabelValues(account.ServiceType).Inc()
+		return ""
+	}
+	data, err := p.ExternalAccountInfo(ctx, *account)
+	if err != nil || data == nil || data.Login == nil {
+		return ""
+	}
+	return *data.Login
+}
+
 // userReferences represents all the references found for a given user in the database.
 // Every valid `userReferences` object has an `id`
 type userReferences struct {
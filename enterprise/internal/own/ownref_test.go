@@ -346,6 +346,38 @@ func TestBagManyUsers(t *testing.T) {
 	assert.True(t, bag.Contains(Reference{Handle: "jdoe-gh"}))
 }
 
+func TestCodeHostAccounts(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	logger := logtest.Scoped(t)
+	db := edb.NewEnterpriseDB(database.NewDB(logger, dbtest.NewDB(logger, t)))
+	ctx := context.Background()
+	user, err := initUser(ctx, t, db)
+	require.NoError(t, err)
+
+	refs := []Reference{
+		{Email: verifiedEmail},
+		{Handle: username},
+		{Handle: "someone-else"},
+	}
+	serviceID := fmt.Sprintf("https://%s.com/%s", extsvc.TypeGitHub, gitHubLogin)
+	accounts, unresolved, err := CodeHostAccounts(ctx, db, extsvc.TypeGitHub, serviceID, refs...)
+	require.NoError(t, err)
+	assert.Equal(t, []CodeHostAccount{{
+		UserID:    user.ID,
+		AccountID: "1337" + gitHubLogin,
+		Login:     gitHubLogin,
+	}}, accounts)
+	assert.Equal(t, []Reference{{Handle: "someone-else"}}, unresolved)
+
+	// The user has no account on this code host.
+	accounts, unresolved, err = CodeHostAccounts(ctx, db, extsvc.TypeBitbucketServer, "https://bitbucket.example.com/", refs[0])
+	require.NoError(t, err)
+	assert.Empty(t, accounts)
+	assert.Equal(t, []Reference{refs[0]}, unresolved)
+}
+
 func initUser(ctx context.Context, t *testing.T, db edb.EnterpriseDB) (*types.User, error) {
 	t.Helper()
 	user, err := db.Users().Create(ctx, database.NewUser{
@@ -0,0 +1,108 @@
+package own
+
+import (
+	"context"
+	"net/mail"
+	"strings"
+
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/own/codeowners"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/search/suggest"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// NewOwnerSuggester returns a suggest.OwnerSuggester that suggests the owners
+// found in CODEOWNERS files, ranked by the number of files they own across the
+// instance. Users and teams that can be assigned ownership fill up the
+// remaining suggestions.
+func NewOwnerSuggester(db database.DB) suggest.OwnerSuggester {
+	return &ownerSuggester{db: edb.NewEnterpriseDB(db)}
+}
+
+type ownerSuggester struct {
+	db edb.EnterpriseDB
+}
+
+func (s *ownerSuggester) SuggestOwners(ctx context.Context, input string, limit int) ([]suggest.Owner, error) {
+	counts, err := s.db.OwnershipStats().QueryIndividualCounts(ctx, database.TreeLocationOpts{
+		ReferencePrefix: input,
+	}, &database.LimitOffset{Limit: limit})
+	if err != nil {
+		return nil, errors.Wrap(err, "querying CODEOWNERS owners")
+	}
+
+	references := make([]string, 0, len(counts))
+	for _, c := range counts {
+		references = append(references, c.CodeownersReference)
+	}
+	bag := ByTextReference(ctx, s.db, references...)
+
+	seen := map[string]struct{}{}
+	owners := make([]suggest.Owner, 0, limit)
+	add := func(owner suggest.Owner) {
+		if _, ok := seen[owner.Reference]; ok || len(owners) >= limit {
+			return
+		}
+		seen[owner.Reference] = struct{}{}
+		owners = append(owners, owner)
+	}
+
+	for _, c := range counts {
+		owner := suggest.Owner{Reference: c.CodeownersReference, OwnedFiles: c.CodeownedFileCount}
+		ref := Reference{Email: c.CodeownersReference}
+		if _, err := mail.ParseAddress(c.CodeownersReference); err != nil {
+			// CODEOWNERS references are stored without the leading @ of
+			// handles.
+			owner.Reference = "@" + strings.TrimPrefix(c.CodeownersReference, "@")
+			ref = Reference{Handle: c.CodeownersReference}
+		}
+		if resolved, ok := bag.FindResolved(ref); ok {
+			owner.Description = displayName(resolved)
+		}
+		add(owner)
+	}
+
+	if len(owners) >= limit {
+		return owners, nil
+	}
+
+	users, err := s.db.Users().List(ctx, &database.UsersListOptions{
+		Query:                       input,
+		ExcludeSourcegraphOperators: true,
+		LimitOffset:                 &database.LimitOffset{Limit: limit},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing users")
+	}
+	for _, user := range users {
+		add(suggest.Owner{Reference: "@" + user.Username, Description: user.DisplayName})
+	}
+
+	teams, _, err := s.db.Teams().ListTeams(ctx, database.ListTeamsOpts{
+		Search:      input,
+		LimitOffset: &database.LimitOffset{Limit: limit},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing teams")
+	}
+	for _, team := range teams {
+		add(suggest.Owner{Reference: "@" + team.Name, Description: team.DisplayName})
+	}
+
+	return owners, nil
+}
+
+func displayName(owner codeowners.ResolvedOwner) string {
+	switch o := owner.(type) {
+	case *codeowners.Person:
+		if o.User != nil {
+			return o.User.DisplayName
+		}
+	case *codeowners.Team:
+		if o.Team != nil {
+			return o.Team.DisplayName
+		}
+	}
+	return ""
+}
@@ -1,16 +1,25 @@
 package search
 
 import (
+	codenavsearch "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/search"
 	ownsearch "github.com/sourcegraph/sourcegraph/enterprise/internal/own/search"
+	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/search/job"
 	"github.com/sourcegraph/sourcegraph/internal/search/job/jobutil"
 )
 
-func NewEnterpriseSearchJobs() jobutil.EnterpriseJobs {
-	return &enterpriseJobs{}
+// NewEnterpriseSearchJobs returns the enterprise implementations of search
+// jobs. codeNavService is used to resolve precise references for
+// `select:symbol.references` and to search diagnostics for `type:diagnostic`.
+// It may be nil, in which case only search-based references are returned and
+// diagnostic searches are unavailable.
+func NewEnterpriseSearchJobs(codeNavService codenavsearch.CodeNavService) jobutil.EnterpriseJobs {
+	return &enterpriseJobs{codeNavService: codeNavService}
 }
 
-type enterpriseJobs struct{}
+type enterpriseJobs struct {
+	codeNavService codenavsearch.CodeNavService
+}
 
 func (e *enterpriseJobs) FileHasOwnerJob(child job.Job, includeOwners, excludeOwners []string) job.Job {
 	return ownsearch.NewFileHasOwnersJob(child, includeOwners, excludeOwners)
@@ -19,3 +28,14 @@ func (e *enterpriseJobs) FileHasOwnerJob(child job.Job, includeOwners, excludeOw
 func (e *enterpriseJobs) SelectFileOwnerJob(child job.Job) job.Job {
 	return ownsearch.NewSelectOwnersJob(child)
 }
+
+func (e *enterpriseJobs) SelectSymbolReferencesJob(child job.Job) job.Job {
+	return codenavsearch.NewSelectReferencesJob(e.codeNavService, child)
+}
+
+func (e *enterpriseJobs) DiagnosticSearchJob(patternInfo *search.DiagnosticPatternInfo, repoOpts search.RepoOptions) job.Job {
+	if e.codeNavService == nil {
+		return jobutil.NewUnimplementedJob("`type:diagnostic` searches are not available in this context")
+	}
+	return codenavsearch.NewDiagnosticSearchJob(e.codeNavService, patternInfo, repoOpts)
+}
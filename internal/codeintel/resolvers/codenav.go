@@ -33,6 +33,9 @@ type GitBlobLSIFDataResolver interface {
 	References(ctx context.Context, args *LSIFPagedQueryPositionArgs) (LocationConnectionResolver, error)
 	Implementations(ctx context.Context, args *LSIFPagedQueryPositionArgs) (LocationConnectionResolver, error)
 	Prototypes(ctx context.Context, args *LSIFPagedQueryPositionArgs) (LocationConnectionResolver, error)
+	IncomingCalls(ctx context.Context, args *LSIFHierarchyQueryArgs) (CallHierarchyConnectionResolver, error)
+	OutgoingCalls(ctx context.Context, args *LSIFHierarchyQueryArgs) (CallHierarchyConnectionResolver, error)
+	TypeHierarchy(ctx context.Context, args *LSIFTypeHierarchyQueryArgs) (TypeHierarchyConnectionResolver, error)
 	Hover(ctx context.Context, args *LSIFQueryPositionArgs) (HoverResolver, error)
 	VisibleIndexes(ctx context.Context) (_ *[]PreciseIndexResolver, err error)
 	Snapshot(ctx context.Context, args *struct{ IndexID graphql.ID }) (_ *[]SnapshotDataResolver, err error)
@@ -61,6 +64,43 @@ type LSIFPagedQueryPositionArgs struct {
 	Filter *string
 }
 
+type LSIFHierarchyQueryArgs struct {
+	Line      int32
+	Character int32
+	Depth     *int32
+	PagedConnectionArgs
+}
+
+type LSIFTypeHierarchyQueryArgs struct {
+	LSIFHierarchyQueryArgs
+	Direction string
+}
+
+type (
+	CallHierarchyConnectionResolver = PagedConnectionResolver[CallHierarchyCallResolver]
+	TypeHierarchyConnectionResolver = PagedConnectionResolver[TypeHierarchyEdgeResolver]
+)
+
+type CallHierarchyCallResolver interface {
+	Caller() HierarchySymbolResolver
+	Callee() HierarchySymbolResolver
+	CallSites(ctx context.Context) ([]LocationResolver, error)
+	Depth() int32
+}
+
+type TypeHierarchyEdgeResolver interface {
+	Subtype() HierarchySymbolResolver
+	Supertype() HierarchySymbolResolver
+	Depth() int32
+}
+
+type HierarchySymbolResolver interface {
+	Symbol() string
+	DisplayName() string
+	Kind() *string
+	Location(ctx context.Context) (LocationResolver, error)
+}
+
 type (
 	CodeIntelligenceRangeConnectionResolver = ConnectionResolver[CodeIntelligenceRangeResolver]
 )
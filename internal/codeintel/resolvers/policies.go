@@ -21,6 +21,12 @@ type PoliciesServiceResolver interface {
 	// Filter previews
 	PreviewRepositoryFilter(ctx context.Context, args *PreviewRepositoryFilterArgs) (RepositoryFilterPreviewResolver, error)
 	PreviewGitObjectFilter(ctx context.Context, id graphql.ID, args *PreviewGitObjectFilterArgs) (GitObjectFilterPreviewResolver, error)
+
+	// Storage quotas
+	CodeIntelligenceGlobalStorageQuota(ctx context.Context) (CodeIntelligenceStorageQuotaResolver, error)
+	UpdateCodeIntelligenceStorageQuota(ctx context.Context, args *UpdateCodeIntelligenceStorageQuotaArgs) (*EmptyResponse, error)
+	DeleteCodeIntelligenceStorageQuota(ctx context.Context, args *DeleteCodeIntelligenceStorageQuotaArgs) (*EmptyResponse, error)
+	CodeIntelligenceStorageQuotaOverview(ctx context.Context, id graphql.ID) (CodeIntelligenceStorageQuotaOverviewResolver, error)
 }
 
 type CodeIntelligenceConfigurationPoliciesArgs struct {
@@ -76,6 +82,16 @@ type PreviewGitObjectFilterArgs struct {
 	CountObjectsYoungerThanHours *int32
 }
 
+type UpdateCodeIntelligenceStorageQuotaArgs struct {
+	Repository         *graphql.ID
+	MaxRepositoryBytes *float64
+	MaxTotalBytes      *float64
+}
+
+type DeleteCodeIntelligenceStorageQuotaArgs struct {
+	Repository *graphql.ID
+}
+
 type (
 	CodeIntelligenceConfigurationPolicyConnectionResolver = PagedConnectionWithTotalCountResolver[CodeIntelligenceConfigurationPolicyResolver]
 )
@@ -117,6 +133,27 @@ type CodeIntelGitObjectResolver interface {
 	CommittedAt() gqlutil.DateTime
 }
 
+type CodeIntelligenceStorageQuotaResolver interface {
+	MaxRepositoryBytes() *float64
+	MaxTotalBytes() *float64
+}
+
+type CodeIntelligenceStorageQuotaOverviewResolver interface {
+	RepositoryBytes() float64
+	MaxRepositoryBytes() *float64
+	TotalBytes() float64
+	MaxTotalBytes() *float64
+	EvictedIndexes() []CodeIntelligenceStorageQuotaEvictionResolver
+}
+
+type CodeIntelligenceStorageQuotaEvictionResolver interface {
+	PreciseIndexID() graphql.ID
+	Commit() string
+	UploadedAt() gqlutil.DateTime
+	SizeBytes() float64
+	VisibleAtTip() bool
+}
+
 type GitObjectType string
 
 func (GitObjectType) ImplementsGraphQLType(name string) bool { return name == "GitObjectType" }
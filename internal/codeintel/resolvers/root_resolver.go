@@ -15,6 +15,7 @@ type RootResolver interface {
 	SentinelServiceResolver
 	UploadsServiceResolver
 	RankingServiceResolver
+	SBOMServiceResolver
 }
 
 type Resolver struct {
@@ -24,6 +25,7 @@ type Resolver struct {
 	uploadsRootResolver      UploadsServiceResolver
 	sentinelRootResolver     SentinelServiceResolver
 	rankingServiceResolver   RankingServiceResolver
+	sbomRootResolver         SBOMServiceResolver
 }
 
 func NewCodeIntelResolver(
@@ -33,6 +35,7 @@ func NewCodeIntelResolver(
 	uploadsRootResolver UploadsServiceResolver,
 	sentinelRootResolver SentinelServiceResolver,
 	rankingServiceResolver RankingServiceResolver,
+	sbomRootResolver SBOMServiceResolver,
 ) *Resolver {
 	return &Resolver{
 		autoIndexingRootResolver: autoIndexingRootResolver,
@@ -41,6 +44,7 @@ func NewCodeIntelResolver(
 		uploadsRootResolver:      uploadsRootResolver,
 		sentinelRootResolver:     sentinelRootResolver,
 		rankingServiceResolver:   rankingServiceResolver,
+		sbomRootResolver:         sbomRootResolver,
 	}
 }
 
@@ -113,6 +117,22 @@ func (r *Resolver) VulnerabilityMatchesCountByRepository(ctx context.Context, ar
 	return r.sentinelRootResolver.VulnerabilityMatchesCountByRepository(ctx, args)
 }
 
+func (r *Resolver) InternalVulnerabilityAdvisories(ctx context.Context, args GetInternalVulnerabilityAdvisoriesArgs) (_ InternalVulnerabilityAdvisoryConnectionResolver, err error) {
+	return r.sentinelRootResolver.InternalVulnerabilityAdvisories(ctx, args)
+}
+
+func (r *Resolver) CreateInternalVulnerabilityAdvisory(ctx context.Context, args CreateInternalVulnerabilityAdvisoryArgs) (_ InternalVulnerabilityAdvisoryResolver, err error) {
+	return r.sentinelRootResolver.CreateInternalVulnerabilityAdvisory(ctx, args)
+}
+
+func (r *Resolver) UpdateInternalVulnerabilityAdvisory(ctx context.Context, args UpdateInternalVulnerabilityAdvisoryArgs) (_ InternalVulnerabilityAdvisoryResolver, err error) {
+	return r.sentinelRootResolver.UpdateInternalVulnerabilityAdvisory(ctx, args)
+}
+
+func (r *Resolver) DeleteInternalVulnerabilityAdvisory(ctx context.Context, args DeleteInternalVulnerabilityAdvisoryArgs) (_ *EmptyResponse, err error) {
+	return r.sentinelRootResolver.DeleteInternalVulnerabilityAdvisory(ctx, args)
+}
+
 func (r *Resolver) IndexerKeys(ctx context.Context, opts *IndexerKeyQueryArgs) (_ []string, err error) {
 	return r.uploadsRootResolver.IndexerKeys(ctx, opts)
 }
@@ -209,6 +229,22 @@ func (r *Resolver) PreviewGitObjectFilter(ctx context.Context, id graphql.ID, ar
 	return r.policiesRootResolver.PreviewGitObjectFilter(ctx, id, args)
 }
 
+func (r *Resolver) CodeIntelligenceGlobalStorageQuota(ctx context.Context) (_ CodeIntelligenceStorageQuotaResolver, err error) {
+	return r.policiesRootResolver.CodeIntelligenceGlobalStorageQuota(ctx)
+}
+
+func (r *Resolver) UpdateCodeIntelligenceStorageQuota(ctx context.Context, args *UpdateCodeIntelligenceStorageQuotaArgs) (_ *EmptyResponse, err error) {
+	return r.policiesRootResolver.UpdateCodeIntelligenceStorageQuota(ctx, args)
+}
+
+func (r *Resolver) DeleteCodeIntelligenceStorageQuota(ctx context.Context, args *DeleteCodeIntelligenceStorageQuotaArgs) (_ *EmptyResponse, err error) {
+	return r.policiesRootResolver.DeleteCodeIntelligenceStorageQuota(ctx, args)
+}
+
+func (r *Resolver) CodeIntelligenceStorageQuotaOverview(ctx context.Context, id graphql.ID) (_ CodeIntelligenceStorageQuotaOverviewResolver, err error) {
+	return r.policiesRootResolver.CodeIntelligenceStorageQuotaOverview(ctx, id)
+}
+
 func (r *Resolver) RankingSummary(ctx context.Context) (_ GlobalRankingSummaryResolver, err error) {
 	return r.rankingServiceResolver.RankingSummary(ctx)
 }
@@ -220,3 +256,7 @@ func (r *Resolver) BumpDerivativeGraphKey(ctx context.Context) (_ *EmptyResponse
 func (r *Resolver) DeleteRankingProgress(ctx context.Context, args *DeleteRankingProgressArgs) (_ *EmptyResponse, err error) {
 	return r.rankingServiceResolver.DeleteRankingProgress(ctx, args)
 }
+
+func (r *Resolver) SoftwareBillOfMaterials(ctx context.Context, args *SoftwareBillOfMaterialsArgs) (_ SoftwareBillOfMaterialsResolver, err error) {
+	return r.sbomRootResolver.SoftwareBillOfMaterials(ctx, args)
+}
@@ -0,0 +1,35 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+)
+
+type SBOMServiceResolver interface {
+	SoftwareBillOfMaterials(ctx context.Context, args *SoftwareBillOfMaterialsArgs) (SoftwareBillOfMaterialsResolver, error)
+}
+
+type SoftwareBillOfMaterialsArgs struct {
+	Repository graphql.ID
+	Revision   *string
+	Format     string
+}
+
+type SoftwareBillOfMaterialsResolver interface {
+	Format() string
+	Commit() string
+	Components() []SoftwareBillOfMaterialsComponentResolver
+	VulnerabilityCount() int32
+	Document() (string, error)
+	DownloadURL() string
+}
+
+type SoftwareBillOfMaterialsComponentResolver interface {
+	Name() string
+	Version() string
+	Scheme() string
+	Manager() string
+	PackageURL() *string
+	Provided() bool
+}
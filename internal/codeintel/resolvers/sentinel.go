@@ -18,6 +18,12 @@ type SentinelServiceResolver interface {
 	VulnerabilityMatchByID(ctx context.Context, id graphql.ID) (_ VulnerabilityMatchResolver, err error)
 	VulnerabilityMatchesSummaryCounts(ctx context.Context) (VulnerabilityMatchesSummaryCountResolver, error)
 	VulnerabilityMatchesCountByRepository(ctx context.Context, args GetVulnerabilityMatchCountByRepositoryArgs) (VulnerabilityMatchCountByRepositoryConnectionResolver, error)
+
+	// Internal advisories
+	InternalVulnerabilityAdvisories(ctx context.Context, args GetInternalVulnerabilityAdvisoriesArgs) (InternalVulnerabilityAdvisoryConnectionResolver, error)
+	CreateInternalVulnerabilityAdvisory(ctx context.Context, args CreateInternalVulnerabilityAdvisoryArgs) (InternalVulnerabilityAdvisoryResolver, error)
+	UpdateInternalVulnerabilityAdvisory(ctx context.Context, args UpdateInternalVulnerabilityAdvisoryArgs) (InternalVulnerabilityAdvisoryResolver, error)
+	DeleteInternalVulnerabilityAdvisory(ctx context.Context, args DeleteInternalVulnerabilityAdvisoryArgs) (*EmptyResponse, error)
 }
 
 type (
@@ -25,6 +31,8 @@ type (
 	VulnerabilityConnectionResolver                       = PagedConnectionWithTotalCountResolver[VulnerabilityResolver]
 	VulnerabilityMatchConnectionResolver                  = PagedConnectionWithTotalCountResolver[VulnerabilityMatchResolver]
 	VulnerabilityMatchCountByRepositoryConnectionResolver = PagedConnectionWithTotalCountResolver[VulnerabilityMatchCountByRepositoryResolver]
+	GetInternalVulnerabilityAdvisoriesArgs                = PagedConnectionArgs
+	InternalVulnerabilityAdvisoryConnectionResolver       = PagedConnectionWithTotalCountResolver[InternalVulnerabilityAdvisoryResolver]
 )
 
 type GetVulnerabilityMatchesArgs struct {
@@ -32,6 +40,7 @@ type GetVulnerabilityMatchesArgs struct {
 	Severity       *string
 	Language       *string
 	RepositoryName *string
+	Reachability   *string
 }
 
 type VulnerabilityResolver interface {
@@ -74,6 +83,15 @@ type VulnerabilityMatchResolver interface {
 	Vulnerability(ctx context.Context) (VulnerabilityResolver, error)
 	AffectedPackage(ctx context.Context) (VulnerabilityAffectedPackageResolver, error)
 	PreciseIndex(ctx context.Context) (PreciseIndexResolver, error)
+	Reachability() string
+	CallSites(ctx context.Context) ([]VulnerabilityMatchCallSiteResolver, error)
+}
+
+type VulnerabilityMatchCallSiteResolver interface {
+	Symbol() string
+	Path() string
+	Range() RangeResolver
+	Blob(ctx context.Context) (GitTreeEntryResolver, error)
 }
 
 type VulnerabilityMatchesSummaryCountResolver interface {
@@ -94,3 +112,58 @@ type VulnerabilityMatchCountByRepositoryResolver interface {
 	RepositoryName() string
 	MatchCount() int32
 }
+
+type InternalVulnerabilityAdvisoryResolver interface {
+	ID() graphql.ID
+	SourceID() string
+	Summary() string
+	Details() string
+	CWEs() []string
+	Aliases() []string
+	Related() []string
+	URLs() []string
+	Severity() string
+	CVSSVector() string
+	AffectedPackages() []VulnerabilityAffectedPackageResolver
+	CreatedAt() gqlutil.DateTime
+	UpdatedAt() gqlutil.DateTime
+}
+
+type CreateInternalVulnerabilityAdvisoryArgs struct {
+	Advisory InternalVulnerabilityAdvisoryInput
+}
+
+type UpdateInternalVulnerabilityAdvisoryArgs struct {
+	ID       graphql.ID
+	Advisory InternalVulnerabilityAdvisoryInput
+}
+
+type DeleteInternalVulnerabilityAdvisoryArgs struct {
+	ID graphql.ID
+}
+
+type InternalVulnerabilityAdvisoryInput struct {
+	SourceID         string
+	Summary          string
+	Details          *string
+	CWEs             *[]string
+	Aliases          *[]string
+	Related          *[]string
+	URLs             *[]string
+	Severity         *string
+	CVSSVector       *string
+	AffectedPackages []InternalVulnerabilityAffectedPackageInput
+}
+
+type InternalVulnerabilityAffectedPackageInput struct {
+	PackageName       string
+	Language          string
+	VersionConstraint []string
+	FixedIn           *string
+	AffectedSymbols   *[]VulnerabilityAffectedSymbolInput
+}
+
+type VulnerabilityAffectedSymbolInput struct {
+	Path    string
+	Symbols []string
+}
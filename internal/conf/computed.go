@@ -187,6 +187,15 @@ func BatchChangesEnabled() bool {
 	return true
 }
 
+// BatchChangesAutoMergeRateLimit returns the maximum number of changesets that
+// auto-merge policies merge per hour on each code host.
+func BatchChangesAutoMergeRateLimit() int {
+	if limit := Get().BatchChangesAutoMergeRateLimit; limit != nil {
+		return *limit
+	}
+	return 60
+}
+
 func BatchChangesRestrictedToAdmins() bool {
 	if restricted := Get().BatchChangesRestrictToAdmins; restricted != nil {
 		return *restricted
@@ -468,6 +477,43 @@ func SearchFlushWallTime(keywordScoring bool) time.Duration {
 	}
 }
 
+// SearchHistoryEnabled returns whether searches run by signed-in users are
+// recorded in their search history. It defaults to true.
+func SearchHistoryEnabled() bool {
+	if c := Get().SearchHistory; c != nil && c.Enabled != nil {
+		return *c.Enabled
+	}
+	return true
+}
+
+// SearchHistoryRetention returns how long search history entries are
+// retained after they were last run. It defaults to 90 days and is never
+// less than one hour.
+func SearchHistoryRetention() time.Duration {
+	const defaultRetention = 90 * 24 * time.Hour
+	c := Get().SearchHistory
+	if c == nil || c.Retention == "" {
+		return defaultRetention
+	}
+	retention, err := time.ParseDuration(c.Retention)
+	if err != nil {
+		return defaultRetention
+	}
+	if retention < time.Hour {
+		return time.Hour
+	}
+	return retention
+}
+
+// SearchHistoryMaxEntriesPerUser returns the maximum number of unpinned
+// search history entries retained per user. It defaults to 1000.
+func SearchHistoryMaxEntriesPerUser() int {
+	if c := Get().SearchHistory; c != nil && c.MaxEntriesPerUser > 0 {
+		return c.MaxEntriesPerUser
+	}
+	return 1000
+}
+
 func ExperimentalFeatures() schema.ExperimentalFeatures {
 	val := Get().ExperimentalFeatures
 	if val == nil {
@@ -54,6 +54,7 @@ type DB interface {
 	Roles() RoleStore
 	SavedSearches() SavedSearchStore
 	SearchContexts() SearchContextsStore
+	SearchHistory() SearchHistoryStore
 	Settings() SettingsStore
 	TemporarySettings() TemporarySettingsStore
 	UserCredentials(encryption.Key) UserCredentialsStore
@@ -64,6 +65,7 @@ type DB interface {
 	WebhookLogs(encryption.Key) WebhookLogStore
 	Webhooks(encryption.Key) WebhookStore
 	RepoStatistics() RepoStatisticsStore
+	RepoLanguages() RepoLanguagesStore
 	Executors() ExecutorStore
 	ExecutorSecrets(encryption.Key) ExecutorSecretStore
 	ExecutorSecretAccessLogs() ExecutorSecretAccessLogStore
@@ -264,6 +266,10 @@ func (d *db) SearchContexts() SearchContextsStore {
 	return SearchContextsWith(d.logger, d.Store)
 }
 
+func (d *db) SearchHistory() SearchHistoryStore {
+	return SearchHistoryWith(d.Store)
+}
+
 func (d *db) Settings() SettingsStore {
 	return SettingsWith(d.Store)
 }
@@ -304,6 +310,10 @@ func (d *db) RepoStatistics() RepoStatisticsStore {
 	return RepoStatisticsWith(d.Store)
 }
 
+func (d *db) RepoLanguages() RepoLanguagesStore {
+	return RepoLanguagesWith(d.Store)
+}
+
 func (d *db) Executors() ExecutorStore {
 	return ExecutorsWith(d.Store)
 }
@@ -5963,6 +5963,9 @@ type MockDB struct {
 	// RepoKVPsFunc is an instance of a mock function object controlling the
 	// behavior of the method RepoKVPs.
 	RepoKVPsFunc *DBRepoKVPsFunc
+	// RepoLanguagesFunc is an instance of a mock function object
+	// controlling the behavior of the method RepoLanguages.
+	RepoLanguagesFunc *DBRepoLanguagesFunc
 	// RepoPathsFunc is an instance of a mock function object controlling
 	// the behavior of the method RepoPaths.
 	RepoPathsFunc *DBRepoPathsFunc
@@ -5984,6 +5987,9 @@ type MockDB struct {
 	// SearchContextsFunc is an instance of a mock function object
 	// controlling the behavior of the method SearchContexts.
 	SearchContextsFunc *DBSearchContextsFunc
+	// SearchHistoryFunc is an instance of a mock function object
+	// controlling the behavior of the method SearchHistory.
+	SearchHistoryFunc *DBSearchHistoryFunc
 	// SecurityEventLogsFunc is an instance of a mock function object
 	// controlling the behavior of the method SecurityEventLogs.
 	SecurityEventLogsFunc *DBSecurityEventLogsFunc
@@ -6224,6 +6230,11 @@ func NewMockDB() *MockDB {
 				return
 			},
 		},
+		RepoLanguagesFunc: &DBRepoLanguagesFunc{
+			defaultHook: func() (r0 RepoLanguagesStore) {
+				return
+			},
+		},
 		RepoPathsFunc: &DBRepoPathsFunc{
 			defaultHook: func() (r0 RepoPathStore) {
 				return
@@ -6259,6 +6270,11 @@ func NewMockDB() *MockDB {
 				return
 			},
 		},
+		SearchHistoryFunc: &DBSearchHistoryFunc{
+			defaultHook: func() (r0 SearchHistoryStore) {
+				return
+			},
+		},
 		SecurityEventLogsFunc: &DBSecurityEventLogsFunc{
 			defaultHook: func() (r0 SecurityEventLogsStore) {
 				return
@@ -6526,6 +6542,11 @@ func NewStrictMockDB() *MockDB {
 				panic("unexpected invocation of MockDB.RepoKVPs")
 			},
 		},
+		RepoLanguagesFunc: &DBRepoLanguagesFunc{
+			defaultHook: func() RepoLanguagesStore {
+				panic("unexpected invocation of MockDB.RepoLanguages")
+			},
+		},
 		RepoPathsFunc: &DBRepoPathsFunc{
 			defaultHook: func() RepoPathStore {
 				panic("unexpected invocation of MockDB.RepoPaths")
@@ -6561,6 +6582,11 @@ func NewStrictMockDB() *MockDB {
 				panic("unexpected invocation of MockDB.SearchContexts")
 			},
 		},
+		SearchHistoryFunc: &DBSearchHistoryFunc{
+			defaultHook: func() SearchHistoryStore {
+				panic("unexpected invocation of MockDB.SearchHistory")
+			},
+		},
 		SecurityEventLogsFunc: &DBSecurityEventLogsFunc{
 			defaultHook: func() SecurityEventLogsStore {
 				panic("unexpected invocation of MockDB.SecurityEventLogs")
@@ -6750,6 +6776,9 @@ func NewMockDBFrom(i DB) *MockDB {
 		RepoKVPsFunc: &DBRepoKVPsFunc{
 			defaultHook: i.RepoKVPs,
 		},
+		RepoLanguagesFunc: &DBRepoLanguagesFunc{
+			defaultHook: i.RepoLanguages,
+		},
 		RepoPathsFunc: &DBRepoPathsFunc{
 			defaultHook: i.RepoPaths,
 		},
@@ -6771,6 +6800,9 @@ func NewMockDBFrom(i DB) *MockDB {
 		SearchContextsFunc: &DBSearchContextsFunc{
 			defaultHook: i.SearchContexts,
 		},
+		SearchHistoryFunc: &DBSearchHistoryFunc{
+			defaultHook: i.SearchHistory,
+		},
 		SecurityEventLogsFunc: &DBSecurityEventLogsFunc{
 			defaultHook: i.SecurityEventLogs,
 		},
@@ -10725,6 +10757,104 @@ func (c DBRepoKVPsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// DBRepoLanguagesFunc describes the behavior when the RepoLanguages method
+// of the parent MockDB instance is invoked.
+type DBRepoLanguagesFunc struct {
+	defaultHook func() RepoLanguagesStore
+	hooks       []func() RepoLanguagesStore
+	history     []DBRepoLanguagesFuncCall
+	mutex       sync.Mutex
+}
+
+// RepoLanguages delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockDB) RepoLanguages() RepoLanguagesStore {
+	r0 := m.RepoLanguagesFunc.nextHook()()
+	m.RepoLanguagesFunc.appendCall(DBRepoLanguagesFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the RepoLanguages method
+// of the parent MockDB instance is invoked and the hook queue is empty.
+func (f *DBRepoLanguagesFunc) SetDefaultHook(hook func() RepoLanguagesStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RepoLanguages method of the parent MockDB instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *DBRepoLanguagesFunc) PushHook(hook func() RepoLanguagesStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *DBRepoLanguagesFunc) SetDefaultReturn(r0 RepoLanguagesStore) {
+	f.SetDefaultHook(func() RepoLanguagesStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *DBRepoLanguagesFunc) PushReturn(r0 RepoLanguagesStore) {
+	f.PushHook(func() RepoLanguagesStore {
+		return r0
+	})
+}
+
+func (f *DBRepoLanguagesFunc) nextHook() func() RepoLanguagesStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBRepoLanguagesFunc) appendCall(r0 DBRepoLanguagesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBRepoLanguagesFuncCall objects describing
+// the invocations of this function.
+func (f *DBRepoLanguagesFunc) History() []DBRepoLanguagesFuncCall {
+	f.mutex.Lock()
+	history := make([]DBRepoLanguagesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBRepoLanguagesFuncCall is an object that describes an invocation of
+// method RepoLanguages on an instance of MockDB.
+type DBRepoLanguagesFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 RepoLanguagesStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBRepoLanguagesFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBRepoLanguagesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // DBRepoPathsFunc describes the behavior when the RepoPaths method of the
 // parent MockDB instance is invoked.
 type DBRepoPathsFunc struct {
@@ -11414,6 +11544,104 @@ func (c DBSearchContextsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// DBSearchHistoryFunc describes the behavior when the SearchHistory method
+// of the parent MockDB instance is invoked.
+type DBSearchHistoryFunc struct {
+	defaultHook func() SearchHistoryStore
+	hooks       []func() SearchHistoryStore
+	history     []DBSearchHistoryFuncCall
+	mutex       sync.Mutex
+}
+
+// SearchHistory delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockDB) SearchHistory() SearchHistoryStore {
+	r0 := m.SearchHistoryFunc.nextHook()()
+	m.SearchHistoryFunc.appendCall(DBSearchHistoryFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the SearchHistory method
+// of the parent MockDB instance is invoked and the hook queue is empty.
+func (f *DBSearchHistoryFunc) SetDefaultHook(hook func() SearchHistoryStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SearchHistory method of the parent MockDB instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *DBSearchHistoryFunc) PushHook(hook func() SearchHistoryStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *DBSearchHistoryFunc) SetDefaultReturn(r0 SearchHistoryStore) {
+	f.SetDefaultHook(func() SearchHistoryStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *DBSearchHistoryFunc) PushReturn(r0 SearchHistoryStore) {
+	f.PushHook(func() SearchHistoryStore {
+		return r0
+	})
+}
+
+func (f *DBSearchHistoryFunc) nextHook() func() SearchHistoryStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBSearchHistoryFunc) appendCall(r0 DBSearchHistoryFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBSearchHistoryFuncCall objects describing
+// the invocations of this function.
+func (f *DBSearchHistoryFunc) History() []DBSearchHistoryFuncCall {
+	f.mutex.Lock()
+	history := make([]DBSearchHistoryFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBSearchHistoryFuncCall is an object that describes an invocation of
+// method SearchHistory on an instance of MockDB.
+type DBSearchHistoryFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 SearchHistoryStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBSearchHistoryFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBSearchHistoryFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // DBSecurityEventLogsFunc describes the behavior when the SecurityEventLogs
 // method of the parent MockDB instance is invoked.
 type DBSecurityEventLogsFunc struct {
@@ -45334,97 +45562,162 @@ func (c RepoCommitsChangelistsStoreGetRepoCommitChangelistFuncCall) Results() []
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// MockRepoPathStore is a mock implementation of the RepoPathStore interface
-// (from the package github.com/sourcegraph/sourcegraph/internal/database)
-// used for unit testing.
-type MockRepoPathStore struct {
-	// AggregateFileCountFunc is an instance of a mock function object
-	// controlling the behavior of the method AggregateFileCount.
-	AggregateFileCountFunc *RepoPathStoreAggregateFileCountFunc
-	// UpdateFileCountsFunc is an instance of a mock function object
-	// controlling the behavior of the method UpdateFileCounts.
-	UpdateFileCountsFunc *RepoPathStoreUpdateFileCountsFunc
+// MockRepoLanguagesStore is a mock implementation of the RepoLanguagesStore
+// interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/database) used for unit
+// testing.
+type MockRepoLanguagesStore struct {
+	// GetFunc is an instance of a mock function object controlling the
+	// behavior of the method Get.
+	GetFunc *RepoLanguagesStoreGetFunc
+	// HandleFunc is an instance of a mock function object controlling the
+	// behavior of the method Handle.
+	HandleFunc *RepoLanguagesStoreHandleFunc
+	// ListStaleFunc is an instance of a mock function object controlling
+	// the behavior of the method ListStale.
+	ListStaleFunc *RepoLanguagesStoreListStaleFunc
+	// TouchFunc is an instance of a mock function object controlling the
+	// behavior of the method Touch.
+	TouchFunc *RepoLanguagesStoreTouchFunc
+	// UpdateFunc is an instance of a mock function object controlling the
+	// behavior of the method Update.
+	UpdateFunc *RepoLanguagesStoreUpdateFunc
+	// WithFunc is an instance of a mock function object controlling the
+	// behavior of the method With.
+	WithFunc *RepoLanguagesStoreWithFunc
 }
 
-// NewMockRepoPathStore creates a new mock of the RepoPathStore interface.
-// All methods return zero values for all results, unless overwritten.
-func NewMockRepoPathStore() *MockRepoPathStore {
-	return &MockRepoPathStore{
-		AggregateFileCountFunc: &RepoPathStoreAggregateFileCountFunc{
-			defaultHook: func(context.Context, TreeLocationOpts) (r0 int32, r1 error) {
+// NewMockRepoLanguagesStore creates a new mock of the RepoLanguagesStore
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockRepoLanguagesStore() *MockRepoLanguagesStore {
+	return &MockRepoLanguagesStore{
+		GetFunc: &RepoLanguagesStoreGetFunc{
+			defaultHook: func(context.Context, api.RepoID) (r0 *RepoLanguageStats, r1 error) {
 				return
 			},
 		},
-		UpdateFileCountsFunc: &RepoPathStoreUpdateFileCountsFunc{
-			defaultHook: func(context.Context, api.RepoID, RepoTreeCounts, time.Time) (r0 int, r1 error) {
+		HandleFunc: &RepoLanguagesStoreHandleFunc{
+			defaultHook: func() (r0 basestore.TransactableHandle) {
+				return
+			},
+		},
+		ListStaleFunc: &RepoLanguagesStoreListStaleFunc{
+			defaultHook: func(context.Context, time.Time, int) (r0 []RepoLanguageStats, r1 error) {
+				return
+			},
+		},
+		TouchFunc: &RepoLanguagesStoreTouchFunc{
+			defaultHook: func(context.Context, api.RepoID) (r0 error) {
+				return
+			},
+		},
+		UpdateFunc: &RepoLanguagesStoreUpdateFunc{
+			defaultHook: func(context.Context, api.RepoID, api.CommitID, []RepoLanguage) (r0 error) {
+				return
+			},
+		},
+		WithFunc: &RepoLanguagesStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) (r0 RepoLanguagesStore) {
 				return
 			},
 		},
 	}
 }
 
-// NewStrictMockRepoPathStore creates a new mock of the RepoPathStore
-// interface. All methods panic on invocation, unless overwritten.
-func NewStrictMockRepoPathStore() *MockRepoPathStore {
-	return &MockRepoPathStore{
-		AggregateFileCountFunc: &RepoPathStoreAggregateFileCountFunc{
-			defaultHook: func(context.Context, TreeLocationOpts) (int32, error) {
-				panic("unexpected invocation of MockRepoPathStore.AggregateFileCount")
+// NewStrictMockRepoLanguagesStore creates a new mock of the
+// RepoLanguagesStore interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockRepoLanguagesStore() *MockRepoLanguagesStore {
+	return &MockRepoLanguagesStore{
+		GetFunc: &RepoLanguagesStoreGetFunc{
+			defaultHook: func(context.Context, api.RepoID) (*RepoLanguageStats, error) {
+				panic("unexpected invocation of MockRepoLanguagesStore.Get")
 			},
 		},
-		UpdateFileCountsFunc: &RepoPathStoreUpdateFileCountsFunc{
-			defaultHook: func(context.Context, api.RepoID, RepoTreeCounts, time.Time) (int, error) {
-				panic("unexpected invocation of MockRepoPathStore.UpdateFileCounts")
+		HandleFunc: &RepoLanguagesStoreHandleFunc{
+			defaultHook: func() basestore.TransactableHandle {
+				panic("unexpected invocation of MockRepoLanguagesStore.Handle")
+			},
+		},
+		ListStaleFunc: &RepoLanguagesStoreListStaleFunc{
+			defaultHook: func(context.Context, time.Time, int) ([]RepoLanguageStats, error) {
+				panic("unexpected invocation of MockRepoLanguagesStore.ListStale")
+			},
+		},
+		TouchFunc: &RepoLanguagesStoreTouchFunc{
+			defaultHook: func(context.Context, api.RepoID) error {
+				panic("unexpected invocation of MockRepoLanguagesStore.Touch")
+			},
+		},
+		UpdateFunc: &RepoLanguagesStoreUpdateFunc{
+			defaultHook: func(context.Context, api.RepoID, api.CommitID, []RepoLanguage) error {
+				panic("unexpected invocation of MockRepoLanguagesStore.Update")
+			},
+		},
+		WithFunc: &RepoLanguagesStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) RepoLanguagesStore {
+				panic("unexpected invocation of MockRepoLanguagesStore.With")
 			},
 		},
 	}
 }
 
-// NewMockRepoPathStoreFrom creates a new mock of the MockRepoPathStore
-// interface. All methods delegate to the given implementation, unless
-// overwritten.
-func NewMockRepoPathStoreFrom(i RepoPathStore) *MockRepoPathStore {
-	return &MockRepoPathStore{
-		AggregateFileCountFunc: &RepoPathStoreAggregateFileCountFunc{
-			defaultHook: i.AggregateFileCount,
+// NewMockRepoLanguagesStoreFrom creates a new mock of the
+// MockRepoLanguagesStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockRepoLanguagesStoreFrom(i RepoLanguagesStore) *MockRepoLanguagesStore {
+	return &MockRepoLanguagesStore{
+		GetFunc: &RepoLanguagesStoreGetFunc{
+			defaultHook: i.Get,
 		},
-		UpdateFileCountsFunc: &RepoPathStoreUpdateFileCountsFunc{
-			defaultHook: i.UpdateFileCounts,
+		HandleFunc: &RepoLanguagesStoreHandleFunc{
+			defaultHook: i.Handle,
+		},
+		ListStaleFunc: &RepoLanguagesStoreListStaleFunc{
+			defaultHook: i.ListStale,
+		},
+		TouchFunc: &RepoLanguagesStoreTouchFunc{
+			defaultHook: i.Touch,
+		},
+		UpdateFunc: &RepoLanguagesStoreUpdateFunc{
+			defaultHook: i.Update,
+		},
+		WithFunc: &RepoLanguagesStoreWithFunc{
+			defaultHook: i.With,
 		},
 	}
 }
 
-// RepoPathStoreAggregateFileCountFunc describes the behavior when the
-// AggregateFileCount method of the parent MockRepoPathStore instance is
-// invoked.
-type RepoPathStoreAggregateFileCountFunc struct {
-	defaultHook func(context.Context, TreeLocationOpts) (int32, error)
-	hooks       []func(context.Context, TreeLocationOpts) (int32, error)
-	history     []RepoPathStoreAggregateFileCountFuncCall
+// RepoLanguagesStoreGetFunc describes the behavior when the Get method of
+// the parent MockRepoLanguagesStore instance is invoked.
+type RepoLanguagesStoreGetFunc struct {
+	defaultHook func(context.Context, api.RepoID) (*RepoLanguageStats, error)
+	hooks       []func(context.Context, api.RepoID) (*RepoLanguageStats, error)
+	history     []RepoLanguagesStoreGetFuncCall
 	mutex       sync.Mutex
 }
 
-// AggregateFileCount delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockRepoPathStore) AggregateFileCount(v0 context.Context, v1 TreeLocationOpts) (int32, error) {
-	r0, r1 := m.AggregateFileCountFunc.nextHook()(v0, v1)
-	m.AggregateFileCountFunc.appendCall(RepoPathStoreAggregateFileCountFuncCall{v0, v1, r0, r1})
+// Get delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoLanguagesStore) Get(v0 context.Context, v1 api.RepoID) (*RepoLanguageStats, error) {
+	r0, r1 := m.GetFunc.nextHook()(v0, v1)
+	m.GetFunc.appendCall(RepoLanguagesStoreGetFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the AggregateFileCount
-// method of the parent MockRepoPathStore instance is invoked and the hook
-// queue is empty.
-func (f *RepoPathStoreAggregateFileCountFunc) SetDefaultHook(hook func(context.Context, TreeLocationOpts) (int32, error)) {
+// SetDefaultHook sets function that is called when the Get method of the
+// parent MockRepoLanguagesStore instance is invoked and the hook queue is
+// empty.
+func (f *RepoLanguagesStoreGetFunc) SetDefaultHook(hook func(context.Context, api.RepoID) (*RepoLanguageStats, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// AggregateFileCount method of the parent MockRepoPathStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *RepoPathStoreAggregateFileCountFunc) PushHook(hook func(context.Context, TreeLocationOpts) (int32, error)) {
+// Get method of the parent MockRepoLanguagesStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RepoLanguagesStoreGetFunc) PushHook(hook func(context.Context, api.RepoID) (*RepoLanguageStats, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -45432,20 +45725,20 @@ func (f *RepoPathStoreAggregateFileCountFunc) PushHook(hook func(context.Context
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoPathStoreAggregateFileCountFunc) SetDefaultReturn(r0 int32, r1 error) {
-	f.SetDefaultHook(func(context.Context, TreeLocationOpts) (int32, error) {
+func (f *RepoLanguagesStoreGetFunc) SetDefaultReturn(r0 *RepoLanguageStats, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID) (*RepoLanguageStats, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoPathStoreAggregateFileCountFunc) PushReturn(r0 int32, r1 error) {
-	f.PushHook(func(context.Context, TreeLocationOpts) (int32, error) {
+func (f *RepoLanguagesStoreGetFunc) PushReturn(r0 *RepoLanguageStats, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID) (*RepoLanguageStats, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoPathStoreAggregateFileCountFunc) nextHook() func(context.Context, TreeLocationOpts) (int32, error) {
+func (f *RepoLanguagesStoreGetFunc) nextHook() func(context.Context, api.RepoID) (*RepoLanguageStats, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -45458,36 +45751,35 @@ func (f *RepoPathStoreAggregateFileCountFunc) nextHook() func(context.Context, T
 	return hook
 }
 
-func (f *RepoPathStoreAggregateFileCountFunc) appendCall(r0 RepoPathStoreAggregateFileCountFuncCall) {
+func (f *RepoLanguagesStoreGetFunc) appendCall(r0 RepoLanguagesStoreGetFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoPathStoreAggregateFileCountFuncCall
-// objects describing the invocations of this function.
-func (f *RepoPathStoreAggregateFileCountFunc) History() []RepoPathStoreAggregateFileCountFuncCall {
+// History returns a sequence of RepoLanguagesStoreGetFuncCall objects
+// describing the invocations of this function.
+func (f *RepoLanguagesStoreGetFunc) History() []RepoLanguagesStoreGetFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoPathStoreAggregateFileCountFuncCall, len(f.history))
+	history := make([]RepoLanguagesStoreGetFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoPathStoreAggregateFileCountFuncCall is an object that describes an
-// invocation of method AggregateFileCount on an instance of
-// MockRepoPathStore.
-type RepoPathStoreAggregateFileCountFuncCall struct {
+// RepoLanguagesStoreGetFuncCall is an object that describes an invocation
+// of method Get on an instance of MockRepoLanguagesStore.
+type RepoLanguagesStoreGetFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 TreeLocationOpts
+	Arg1 api.RepoID
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 int32
+	Result0 *RepoLanguageStats
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -45495,46 +45787,144 @@ type RepoPathStoreAggregateFileCountFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoPathStoreAggregateFileCountFuncCall) Args() []interface{} {
+func (c RepoLanguagesStoreGetFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoPathStoreAggregateFileCountFuncCall) Results() []interface{} {
+func (c RepoLanguagesStoreGetFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoPathStoreUpdateFileCountsFunc describes the behavior when the
-// UpdateFileCounts method of the parent MockRepoPathStore instance is
-// invoked.
-type RepoPathStoreUpdateFileCountsFunc struct {
-	defaultHook func(context.Context, api.RepoID, RepoTreeCounts, time.Time) (int, error)
-	hooks       []func(context.Context, api.RepoID, RepoTreeCounts, time.Time) (int, error)
-	history     []RepoPathStoreUpdateFileCountsFuncCall
+// RepoLanguagesStoreHandleFunc describes the behavior when the Handle
+// method of the parent MockRepoLanguagesStore instance is invoked.
+type RepoLanguagesStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []RepoLanguagesStoreHandleFuncCall
 	mutex       sync.Mutex
 }
 
-// UpdateFileCounts delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockRepoPathStore) UpdateFileCounts(v0 context.Context, v1 api.RepoID, v2 RepoTreeCounts, v3 time.Time) (int, error) {
-	r0, r1 := m.UpdateFileCountsFunc.nextHook()(v0, v1, v2, v3)
-	m.UpdateFileCountsFunc.appendCall(RepoPathStoreUpdateFileCountsFuncCall{v0, v1, v2, v3, r0, r1})
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoLanguagesStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(RepoLanguagesStoreHandleFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockRepoLanguagesStore instance is invoked and the hook queue is
+// empty.
+func (f *RepoLanguagesStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Handle method of the parent MockRepoLanguagesStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RepoLanguagesStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoLanguagesStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoLanguagesStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+func (f *RepoLanguagesStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoLanguagesStoreHandleFunc) appendCall(r0 RepoLanguagesStoreHandleFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoLanguagesStoreHandleFuncCall objects
+// describing the invocations of this function.
+func (f *RepoLanguagesStoreHandleFunc) History() []RepoLanguagesStoreHandleFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoLanguagesStoreHandleFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoLanguagesStoreHandleFuncCall is an object that describes an
+// invocation of method Handle on an instance of MockRepoLanguagesStore.
+type RepoLanguagesStoreHandleFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 basestore.TransactableHandle
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoLanguagesStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoLanguagesStoreHandleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RepoLanguagesStoreListStaleFunc describes the behavior when the ListStale
+// method of the parent MockRepoLanguagesStore instance is invoked.
+type RepoLanguagesStoreListStaleFunc struct {
+	defaultHook func(context.Context, time.Time, int) ([]RepoLanguageStats, error)
+	hooks       []func(context.Context, time.Time, int) ([]RepoLanguageStats, error)
+	history     []RepoLanguagesStoreListStaleFuncCall
+	mutex       sync.Mutex
+}
+
+// ListStale delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoLanguagesStore) ListStale(v0 context.Context, v1 time.Time, v2 int) ([]RepoLanguageStats, error) {
+	r0, r1 := m.ListStaleFunc.nextHook()(v0, v1, v2)
+	m.ListStaleFunc.appendCall(RepoLanguagesStoreListStaleFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the UpdateFileCounts
-// method of the parent MockRepoPathStore instance is invoked and the hook
-// queue is empty.
-func (f *RepoPathStoreUpdateFileCountsFunc) SetDefaultHook(hook func(context.Context, api.RepoID, RepoTreeCounts, time.Time) (int, error)) {
+// SetDefaultHook sets function that is called when the ListStale method of
+// the parent MockRepoLanguagesStore instance is invoked and the hook queue
+// is empty.
+func (f *RepoLanguagesStoreListStaleFunc) SetDefaultHook(hook func(context.Context, time.Time, int) ([]RepoLanguageStats, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// UpdateFileCounts method of the parent MockRepoPathStore instance invokes
+// ListStale method of the parent MockRepoLanguagesStore instance invokes
 // the hook at the front of the queue and discards it. After the queue is
 // empty, the default hook function is invoked for any future action.
-func (f *RepoPathStoreUpdateFileCountsFunc) PushHook(hook func(context.Context, api.RepoID, RepoTreeCounts, time.Time) (int, error)) {
+func (f *RepoLanguagesStoreListStaleFunc) PushHook(hook func(context.Context, time.Time, int) ([]RepoLanguageStats, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -45542,20 +45932,20 @@ func (f *RepoPathStoreUpdateFileCountsFunc) PushHook(hook func(context.Context,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoPathStoreUpdateFileCountsFunc) SetDefaultReturn(r0 int, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoID, RepoTreeCounts, time.Time) (int, error) {
+func (f *RepoLanguagesStoreListStaleFunc) SetDefaultReturn(r0 []RepoLanguageStats, r1 error) {
+	f.SetDefaultHook(func(context.Context, time.Time, int) ([]RepoLanguageStats, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoPathStoreUpdateFileCountsFunc) PushReturn(r0 int, r1 error) {
-	f.PushHook(func(context.Context, api.RepoID, RepoTreeCounts, time.Time) (int, error) {
+func (f *RepoLanguagesStoreListStaleFunc) PushReturn(r0 []RepoLanguageStats, r1 error) {
+	f.PushHook(func(context.Context, time.Time, int) ([]RepoLanguageStats, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoPathStoreUpdateFileCountsFunc) nextHook() func(context.Context, api.RepoID, RepoTreeCounts, time.Time) (int, error) {
+func (f *RepoLanguagesStoreListStaleFunc) nextHook() func(context.Context, time.Time, int) ([]RepoLanguageStats, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -45568,42 +45958,38 @@ func (f *RepoPathStoreUpdateFileCountsFunc) nextHook() func(context.Context, api
 	return hook
 }
 
-func (f *RepoPathStoreUpdateFileCountsFunc) appendCall(r0 RepoPathStoreUpdateFileCountsFuncCall) {
+func (f *RepoLanguagesStoreListStaleFunc) appendCall(r0 RepoLanguagesStoreListStaleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoPathStoreUpdateFileCountsFuncCall
-// objects describing the invocations of this function.
-func (f *RepoPathStoreUpdateFileCountsFunc) History() []RepoPathStoreUpdateFileCountsFuncCall {
+// History returns a sequence of RepoLanguagesStoreListStaleFuncCall objects
+// describing the invocations of this function.
+func (f *RepoLanguagesStoreListStaleFunc) History() []RepoLanguagesStoreListStaleFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoPathStoreUpdateFileCountsFuncCall, len(f.history))
+	history := make([]RepoLanguagesStoreListStaleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoPathStoreUpdateFileCountsFuncCall is an object that describes an
-// invocation of method UpdateFileCounts on an instance of
-// MockRepoPathStore.
-type RepoPathStoreUpdateFileCountsFuncCall struct {
+// RepoLanguagesStoreListStaleFuncCall is an object that describes an
+// invocation of method ListStale on an instance of MockRepoLanguagesStore.
+type RepoLanguagesStoreListStaleFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 api.RepoID
+	Arg1 time.Time
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 RepoTreeCounts
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 time.Time
+	Arg2 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 int
+	Result0 []RepoLanguageStats
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -45611,175 +45997,45 @@ type RepoPathStoreUpdateFileCountsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoPathStoreUpdateFileCountsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c RepoLanguagesStoreListStaleFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoPathStoreUpdateFileCountsFuncCall) Results() []interface{} {
+func (c RepoLanguagesStoreListStaleFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// MockRepoStatisticsStore is a mock implementation of the
-// RepoStatisticsStore interface (from the package
-// github.com/sourcegraph/sourcegraph/internal/database) used for unit
-// testing.
-type MockRepoStatisticsStore struct {
-	// CompactRepoStatisticsFunc is an instance of a mock function object
-	// controlling the behavior of the method CompactRepoStatistics.
-	CompactRepoStatisticsFunc *RepoStatisticsStoreCompactRepoStatisticsFunc
-	// GetGitserverReposStatisticsFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// GetGitserverReposStatistics.
-	GetGitserverReposStatisticsFunc *RepoStatisticsStoreGetGitserverReposStatisticsFunc
-	// GetRepoStatisticsFunc is an instance of a mock function object
-	// controlling the behavior of the method GetRepoStatistics.
-	GetRepoStatisticsFunc *RepoStatisticsStoreGetRepoStatisticsFunc
-	// HandleFunc is an instance of a mock function object controlling the
-	// behavior of the method Handle.
-	HandleFunc *RepoStatisticsStoreHandleFunc
-	// WithFunc is an instance of a mock function object controlling the
-	// behavior of the method With.
-	WithFunc *RepoStatisticsStoreWithFunc
-	// WithTransactFunc is an instance of a mock function object controlling
-	// the behavior of the method WithTransact.
-	WithTransactFunc *RepoStatisticsStoreWithTransactFunc
+// RepoLanguagesStoreTouchFunc describes the behavior when the Touch method
+// of the parent MockRepoLanguagesStore instance is invoked.
+type RepoLanguagesStoreTouchFunc struct {
+	defaultHook func(context.Context, api.RepoID) error
+	hooks       []func(context.Context, api.RepoID) error
+	history     []RepoLanguagesStoreTouchFuncCall
+	mutex       sync.Mutex
 }
 
-// NewMockRepoStatisticsStore creates a new mock of the RepoStatisticsStore
-// interface. All methods return zero values for all results, unless
-// overwritten.
-func NewMockRepoStatisticsStore() *MockRepoStatisticsStore {
-	return &MockRepoStatisticsStore{
-		CompactRepoStatisticsFunc: &RepoStatisticsStoreCompactRepoStatisticsFunc{
-			defaultHook: func(context.Context) (r0 error) {
-				return
-			},
-		},
-		GetGitserverReposStatisticsFunc: &RepoStatisticsStoreGetGitserverReposStatisticsFunc{
-			defaultHook: func(context.Context) (r0 []GitserverReposStatistic, r1 error) {
-				return
-			},
-		},
-		GetRepoStatisticsFunc: &RepoStatisticsStoreGetRepoStatisticsFunc{
-			defaultHook: func(context.Context) (r0 RepoStatistics, r1 error) {
-				return
-			},
-		},
-		HandleFunc: &RepoStatisticsStoreHandleFunc{
-			defaultHook: func() (r0 basestore.TransactableHandle) {
-				return
-			},
-		},
-		WithFunc: &RepoStatisticsStoreWithFunc{
-			defaultHook: func(basestore.ShareableStore) (r0 RepoStatisticsStore) {
-				return
-			},
-		},
-		WithTransactFunc: &RepoStatisticsStoreWithTransactFunc{
-			defaultHook: func(context.Context, func(RepoStatisticsStore) error) (r0 error) {
-				return
-			},
-		},
-	}
+// Touch delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoLanguagesStore) Touch(v0 context.Context, v1 api.RepoID) error {
+	r0 := m.TouchFunc.nextHook()(v0, v1)
+	m.TouchFunc.appendCall(RepoLanguagesStoreTouchFuncCall{v0, v1, r0})
+	return r0
 }
 
-// NewStrictMockRepoStatisticsStore creates a new mock of the
-// RepoStatisticsStore interface. All methods panic on invocation, unless
-// overwritten.
-func NewStrictMockRepoStatisticsStore() *MockRepoStatisticsStore {
-	return &MockRepoStatisticsStore{
-		CompactRepoStatisticsFunc: &RepoStatisticsStoreCompactRepoStatisticsFunc{
-			defaultHook: func(context.Context) error {
-				panic("unexpected invocation of MockRepoStatisticsStore.CompactRepoStatistics")
-			},
-		},
-		GetGitserverReposStatisticsFunc: &RepoStatisticsStoreGetGitserverReposStatisticsFunc{
-			defaultHook: func(context.Context) ([]GitserverReposStatistic, error) {
-				panic("unexpected invocation of MockRepoStatisticsStore.GetGitserverReposStatistics")
-			},
-		},
-		GetRepoStatisticsFunc: &RepoStatisticsStoreGetRepoStatisticsFunc{
-			defaultHook: func(context.Context) (RepoStatistics, error) {
-				panic("unexpected invocation of MockRepoStatisticsStore.GetRepoStatistics")
-			},
-		},
-		HandleFunc: &RepoStatisticsStoreHandleFunc{
-			defaultHook: func() basestore.TransactableHandle {
-				panic("unexpected invocation of MockRepoStatisticsStore.Handle")
-			},
-		},
-		WithFunc: &RepoStatisticsStoreWithFunc{
-			defaultHook: func(basestore.ShareableStore) RepoStatisticsStore {
-				panic("unexpected invocation of MockRepoStatisticsStore.With")
-			},
-		},
-		WithTransactFunc: &RepoStatisticsStoreWithTransactFunc{
-			defaultHook: func(context.Context, func(RepoStatisticsStore) error) error {
-				panic("unexpected invocation of MockRepoStatisticsStore.WithTransact")
-			},
-		},
-	}
-}
-
-// NewMockRepoStatisticsStoreFrom creates a new mock of the
-// MockRepoStatisticsStore interface. All methods delegate to the given
-// implementation, unless overwritten.
-func NewMockRepoStatisticsStoreFrom(i RepoStatisticsStore) *MockRepoStatisticsStore {
-	return &MockRepoStatisticsStore{
-		CompactRepoStatisticsFunc: &RepoStatisticsStoreCompactRepoStatisticsFunc{
-			defaultHook: i.CompactRepoStatistics,
-		},
-		GetGitserverReposStatisticsFunc: &RepoStatisticsStoreGetGitserverReposStatisticsFunc{
-			defaultHook: i.GetGitserverReposStatistics,
-		},
-		GetRepoStatisticsFunc: &RepoStatisticsStoreGetRepoStatisticsFunc{
-			defaultHook: i.GetRepoStatistics,
-		},
-		HandleFunc: &RepoStatisticsStoreHandleFunc{
-			defaultHook: i.Handle,
-		},
-		WithFunc: &RepoStatisticsStoreWithFunc{
-			defaultHook: i.With,
-		},
-		WithTransactFunc: &RepoStatisticsStoreWithTransactFunc{
-			defaultHook: i.WithTransact,
-		},
-	}
-}
-
-// RepoStatisticsStoreCompactRepoStatisticsFunc describes the behavior when
-// the CompactRepoStatistics method of the parent MockRepoStatisticsStore
-// instance is invoked.
-type RepoStatisticsStoreCompactRepoStatisticsFunc struct {
-	defaultHook func(context.Context) error
-	hooks       []func(context.Context) error
-	history     []RepoStatisticsStoreCompactRepoStatisticsFuncCall
-	mutex       sync.Mutex
-}
-
-// CompactRepoStatistics delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockRepoStatisticsStore) CompactRepoStatistics(v0 context.Context) error {
-	r0 := m.CompactRepoStatisticsFunc.nextHook()(v0)
-	m.CompactRepoStatisticsFunc.appendCall(RepoStatisticsStoreCompactRepoStatisticsFuncCall{v0, r0})
-	return r0
-}
-
-// SetDefaultHook sets function that is called when the
-// CompactRepoStatistics method of the parent MockRepoStatisticsStore
-// instance is invoked and the hook queue is empty.
-func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) SetDefaultHook(hook func(context.Context) error) {
-	f.defaultHook = hook
+// SetDefaultHook sets function that is called when the Touch method of the
+// parent MockRepoLanguagesStore instance is invoked and the hook queue is
+// empty.
+func (f *RepoLanguagesStoreTouchFunc) SetDefaultHook(hook func(context.Context, api.RepoID) error) {
+	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CompactRepoStatistics method of the parent MockRepoStatisticsStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) PushHook(hook func(context.Context) error) {
+// Touch method of the parent MockRepoLanguagesStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RepoLanguagesStoreTouchFunc) PushHook(hook func(context.Context, api.RepoID) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -45787,20 +46043,20 @@ func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) PushHook(hook func(contex
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context) error {
+func (f *RepoLanguagesStoreTouchFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context) error {
+func (f *RepoLanguagesStoreTouchFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoID) error {
 		return r0
 	})
 }
 
-func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) nextHook() func(context.Context) error {
+func (f *RepoLanguagesStoreTouchFunc) nextHook() func(context.Context, api.RepoID) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -45813,31 +46069,32 @@ func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) nextHook() func(context.C
 	return hook
 }
 
-func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) appendCall(r0 RepoStatisticsStoreCompactRepoStatisticsFuncCall) {
+func (f *RepoLanguagesStoreTouchFunc) appendCall(r0 RepoLanguagesStoreTouchFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// RepoStatisticsStoreCompactRepoStatisticsFuncCall objects describing the
-// invocations of this function.
-func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) History() []RepoStatisticsStoreCompactRepoStatisticsFuncCall {
+// History returns a sequence of RepoLanguagesStoreTouchFuncCall objects
+// describing the invocations of this function.
+func (f *RepoLanguagesStoreTouchFunc) History() []RepoLanguagesStoreTouchFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStatisticsStoreCompactRepoStatisticsFuncCall, len(f.history))
+	history := make([]RepoLanguagesStoreTouchFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStatisticsStoreCompactRepoStatisticsFuncCall is an object that
-// describes an invocation of method CompactRepoStatistics on an instance of
-// MockRepoStatisticsStore.
-type RepoStatisticsStoreCompactRepoStatisticsFuncCall struct {
+// RepoLanguagesStoreTouchFuncCall is an object that describes an invocation
+// of method Touch on an instance of MockRepoLanguagesStore.
+type RepoLanguagesStoreTouchFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -45845,47 +46102,45 @@ type RepoStatisticsStoreCompactRepoStatisticsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStatisticsStoreCompactRepoStatisticsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c RepoLanguagesStoreTouchFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStatisticsStoreCompactRepoStatisticsFuncCall) Results() []interface{} {
+func (c RepoLanguagesStoreTouchFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RepoStatisticsStoreGetGitserverReposStatisticsFunc describes the behavior
-// when the GetGitserverReposStatistics method of the parent
-// MockRepoStatisticsStore instance is invoked.
-type RepoStatisticsStoreGetGitserverReposStatisticsFunc struct {
-	defaultHook func(context.Context) ([]GitserverReposStatistic, error)
-	hooks       []func(context.Context) ([]GitserverReposStatistic, error)
-	history     []RepoStatisticsStoreGetGitserverReposStatisticsFuncCall
+// RepoLanguagesStoreUpdateFunc describes the behavior when the Update
+// method of the parent MockRepoLanguagesStore instance is invoked.
+type RepoLanguagesStoreUpdateFunc struct {
+	defaultHook func(context.Context, api.RepoID, api.CommitID, []RepoLanguage) error
+	hooks       []func(context.Context, api.RepoID, api.CommitID, []RepoLanguage) error
+	history     []RepoLanguagesStoreUpdateFuncCall
 	mutex       sync.Mutex
 }
 
-// GetGitserverReposStatistics delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockRepoStatisticsStore) GetGitserverReposStatistics(v0 context.Context) ([]GitserverReposStatistic, error) {
-	r0, r1 := m.GetGitserverReposStatisticsFunc.nextHook()(v0)
-	m.GetGitserverReposStatisticsFunc.appendCall(RepoStatisticsStoreGetGitserverReposStatisticsFuncCall{v0, r0, r1})
-	return r0, r1
+// Update delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoLanguagesStore) Update(v0 context.Context, v1 api.RepoID, v2 api.CommitID, v3 []RepoLanguage) error {
+	r0 := m.UpdateFunc.nextHook()(v0, v1, v2, v3)
+	m.UpdateFunc.appendCall(RepoLanguagesStoreUpdateFuncCall{v0, v1, v2, v3, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the
-// GetGitserverReposStatistics method of the parent MockRepoStatisticsStore
-// instance is invoked and the hook queue is empty.
-func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) SetDefaultHook(hook func(context.Context) ([]GitserverReposStatistic, error)) {
+// SetDefaultHook sets function that is called when the Update method of the
+// parent MockRepoLanguagesStore instance is invoked and the hook queue is
+// empty.
+func (f *RepoLanguagesStoreUpdateFunc) SetDefaultHook(hook func(context.Context, api.RepoID, api.CommitID, []RepoLanguage) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetGitserverReposStatistics method of the parent MockRepoStatisticsStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) PushHook(hook func(context.Context) ([]GitserverReposStatistic, error)) {
+// Update method of the parent MockRepoLanguagesStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RepoLanguagesStoreUpdateFunc) PushHook(hook func(context.Context, api.RepoID, api.CommitID, []RepoLanguage) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -45893,20 +46148,20 @@ func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) PushHook(hook func(
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) SetDefaultReturn(r0 []GitserverReposStatistic, r1 error) {
-	f.SetDefaultHook(func(context.Context) ([]GitserverReposStatistic, error) {
-		return r0, r1
+func (f *RepoLanguagesStoreUpdateFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID, api.CommitID, []RepoLanguage) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) PushReturn(r0 []GitserverReposStatistic, r1 error) {
-	f.PushHook(func(context.Context) ([]GitserverReposStatistic, error) {
-		return r0, r1
+func (f *RepoLanguagesStoreUpdateFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoID, api.CommitID, []RepoLanguage) error {
+		return r0
 	})
 }
 
-func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) nextHook() func(context.Context) ([]GitserverReposStatistic, error) {
+func (f *RepoLanguagesStoreUpdateFunc) nextHook() func(context.Context, api.RepoID, api.CommitID, []RepoLanguage) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -45919,189 +46174,84 @@ func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) nextHook() func(con
 	return hook
 }
 
-func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) appendCall(r0 RepoStatisticsStoreGetGitserverReposStatisticsFuncCall) {
+func (f *RepoLanguagesStoreUpdateFunc) appendCall(r0 RepoLanguagesStoreUpdateFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// RepoStatisticsStoreGetGitserverReposStatisticsFuncCall objects describing
-// the invocations of this function.
-func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) History() []RepoStatisticsStoreGetGitserverReposStatisticsFuncCall {
+// History returns a sequence of RepoLanguagesStoreUpdateFuncCall objects
+// describing the invocations of this function.
+func (f *RepoLanguagesStoreUpdateFunc) History() []RepoLanguagesStoreUpdateFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStatisticsStoreGetGitserverReposStatisticsFuncCall, len(f.history))
+	history := make([]RepoLanguagesStoreUpdateFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStatisticsStoreGetGitserverReposStatisticsFuncCall is an object that
-// describes an invocation of method GetGitserverReposStatistics on an
-// instance of MockRepoStatisticsStore.
-type RepoStatisticsStoreGetGitserverReposStatisticsFuncCall struct {
+// RepoLanguagesStoreUpdateFuncCall is an object that describes an
+// invocation of method Update on an instance of MockRepoLanguagesStore.
+type RepoLanguagesStoreUpdateFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Result0 is the value of the 1st result returned from this method
+	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Result0 []GitserverReposStatistic
-	// Result1 is the value of the 2nd result returned from this method
+	Arg1 api.RepoID
+	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Result1 error
-}
-
-// Args returns an interface slice containing the arguments of this
-// invocation.
-func (c RepoStatisticsStoreGetGitserverReposStatisticsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
-}
-
-// Results returns an interface slice containing the results of this
-// invocation.
-func (c RepoStatisticsStoreGetGitserverReposStatisticsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
-}
-
-// RepoStatisticsStoreGetRepoStatisticsFunc describes the behavior when the
-// GetRepoStatistics method of the parent MockRepoStatisticsStore instance
-// is invoked.
-type RepoStatisticsStoreGetRepoStatisticsFunc struct {
-	defaultHook func(context.Context) (RepoStatistics, error)
-	hooks       []func(context.Context) (RepoStatistics, error)
-	history     []RepoStatisticsStoreGetRepoStatisticsFuncCall
-	mutex       sync.Mutex
-}
-
-// GetRepoStatistics delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockRepoStatisticsStore) GetRepoStatistics(v0 context.Context) (RepoStatistics, error) {
-	r0, r1 := m.GetRepoStatisticsFunc.nextHook()(v0)
-	m.GetRepoStatisticsFunc.appendCall(RepoStatisticsStoreGetRepoStatisticsFuncCall{v0, r0, r1})
-	return r0, r1
-}
-
-// SetDefaultHook sets function that is called when the GetRepoStatistics
-// method of the parent MockRepoStatisticsStore instance is invoked and the
-// hook queue is empty.
-func (f *RepoStatisticsStoreGetRepoStatisticsFunc) SetDefaultHook(hook func(context.Context) (RepoStatistics, error)) {
-	f.defaultHook = hook
-}
-
-// PushHook adds a function to the end of hook queue. Each invocation of the
-// GetRepoStatistics method of the parent MockRepoStatisticsStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *RepoStatisticsStoreGetRepoStatisticsFunc) PushHook(hook func(context.Context) (RepoStatistics, error)) {
-	f.mutex.Lock()
-	f.hooks = append(f.hooks, hook)
-	f.mutex.Unlock()
-}
-
-// SetDefaultReturn calls SetDefaultHook with a function that returns the
-// given values.
-func (f *RepoStatisticsStoreGetRepoStatisticsFunc) SetDefaultReturn(r0 RepoStatistics, r1 error) {
-	f.SetDefaultHook(func(context.Context) (RepoStatistics, error) {
-		return r0, r1
-	})
-}
-
-// PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStatisticsStoreGetRepoStatisticsFunc) PushReturn(r0 RepoStatistics, r1 error) {
-	f.PushHook(func(context.Context) (RepoStatistics, error) {
-		return r0, r1
-	})
-}
-
-func (f *RepoStatisticsStoreGetRepoStatisticsFunc) nextHook() func(context.Context) (RepoStatistics, error) {
-	f.mutex.Lock()
-	defer f.mutex.Unlock()
-
-	if len(f.hooks) == 0 {
-		return f.defaultHook
-	}
-
-	hook := f.hooks[0]
-	f.hooks = f.hooks[1:]
-	return hook
-}
-
-func (f *RepoStatisticsStoreGetRepoStatisticsFunc) appendCall(r0 RepoStatisticsStoreGetRepoStatisticsFuncCall) {
-	f.mutex.Lock()
-	f.history = append(f.history, r0)
-	f.mutex.Unlock()
-}
-
-// History returns a sequence of
-// RepoStatisticsStoreGetRepoStatisticsFuncCall objects describing the
-// invocations of this function.
-func (f *RepoStatisticsStoreGetRepoStatisticsFunc) History() []RepoStatisticsStoreGetRepoStatisticsFuncCall {
-	f.mutex.Lock()
-	history := make([]RepoStatisticsStoreGetRepoStatisticsFuncCall, len(f.history))
-	copy(history, f.history)
-	f.mutex.Unlock()
-
-	return history
-}
-
-// RepoStatisticsStoreGetRepoStatisticsFuncCall is an object that describes
-// an invocation of method GetRepoStatistics on an instance of
-// MockRepoStatisticsStore.
-type RepoStatisticsStoreGetRepoStatisticsFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
-	Arg0 context.Context
+	Arg3 []RepoLanguage
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 RepoStatistics
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStatisticsStoreGetRepoStatisticsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c RepoLanguagesStoreUpdateFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStatisticsStoreGetRepoStatisticsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c RepoLanguagesStoreUpdateFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// RepoStatisticsStoreHandleFunc describes the behavior when the Handle
-// method of the parent MockRepoStatisticsStore instance is invoked.
-type RepoStatisticsStoreHandleFunc struct {
-	defaultHook func() basestore.TransactableHandle
-	hooks       []func() basestore.TransactableHandle
-	history     []RepoStatisticsStoreHandleFuncCall
+// RepoLanguagesStoreWithFunc describes the behavior when the With method of
+// the parent MockRepoLanguagesStore instance is invoked.
+type RepoLanguagesStoreWithFunc struct {
+	defaultHook func(basestore.ShareableStore) RepoLanguagesStore
+	hooks       []func(basestore.ShareableStore) RepoLanguagesStore
+	history     []RepoLanguagesStoreWithFuncCall
 	mutex       sync.Mutex
 }
 
-// Handle delegates to the next hook function in the queue and stores the
+// With delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRepoStatisticsStore) Handle() basestore.TransactableHandle {
-	r0 := m.HandleFunc.nextHook()()
-	m.HandleFunc.appendCall(RepoStatisticsStoreHandleFuncCall{r0})
+func (m *MockRepoLanguagesStore) With(v0 basestore.ShareableStore) RepoLanguagesStore {
+	r0 := m.WithFunc.nextHook()(v0)
+	m.WithFunc.appendCall(RepoLanguagesStoreWithFuncCall{v0, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Handle method of the
-// parent MockRepoStatisticsStore instance is invoked and the hook queue is
+// SetDefaultHook sets function that is called when the With method of the
+// parent MockRepoLanguagesStore instance is invoked and the hook queue is
 // empty.
-func (f *RepoStatisticsStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+func (f *RepoLanguagesStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) RepoLanguagesStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Handle method of the parent MockRepoStatisticsStore instance invokes the
+// With method of the parent MockRepoLanguagesStore instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *RepoStatisticsStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+func (f *RepoLanguagesStoreWithFunc) PushHook(hook func(basestore.ShareableStore) RepoLanguagesStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -46109,20 +46259,20 @@ func (f *RepoStatisticsStoreHandleFunc) PushHook(hook func() basestore.Transacta
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStatisticsStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
-	f.SetDefaultHook(func() basestore.TransactableHandle {
+func (f *RepoLanguagesStoreWithFunc) SetDefaultReturn(r0 RepoLanguagesStore) {
+	f.SetDefaultHook(func(basestore.ShareableStore) RepoLanguagesStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStatisticsStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
-	f.PushHook(func() basestore.TransactableHandle {
+func (f *RepoLanguagesStoreWithFunc) PushReturn(r0 RepoLanguagesStore) {
+	f.PushHook(func(basestore.ShareableStore) RepoLanguagesStore {
 		return r0
 	})
 }
 
-func (f *RepoStatisticsStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+func (f *RepoLanguagesStoreWithFunc) nextHook() func(basestore.ShareableStore) RepoLanguagesStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -46135,72 +46285,137 @@ func (f *RepoStatisticsStoreHandleFunc) nextHook() func() basestore.Transactable
 	return hook
 }
 
-func (f *RepoStatisticsStoreHandleFunc) appendCall(r0 RepoStatisticsStoreHandleFuncCall) {
+func (f *RepoLanguagesStoreWithFunc) appendCall(r0 RepoLanguagesStoreWithFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStatisticsStoreHandleFuncCall objects
+// History returns a sequence of RepoLanguagesStoreWithFuncCall objects
 // describing the invocations of this function.
-func (f *RepoStatisticsStoreHandleFunc) History() []RepoStatisticsStoreHandleFuncCall {
+func (f *RepoLanguagesStoreWithFunc) History() []RepoLanguagesStoreWithFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStatisticsStoreHandleFuncCall, len(f.history))
+	history := make([]RepoLanguagesStoreWithFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStatisticsStoreHandleFuncCall is an object that describes an
-// invocation of method Handle on an instance of MockRepoStatisticsStore.
-type RepoStatisticsStoreHandleFuncCall struct {
+// RepoLanguagesStoreWithFuncCall is an object that describes an invocation
+// of method With on an instance of MockRepoLanguagesStore.
+type RepoLanguagesStoreWithFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 basestore.ShareableStore
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 basestore.TransactableHandle
+	Result0 RepoLanguagesStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStatisticsStoreHandleFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c RepoLanguagesStoreWithFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStatisticsStoreHandleFuncCall) Results() []interface{} {
+func (c RepoLanguagesStoreWithFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RepoStatisticsStoreWithFunc describes the behavior when the With method
-// of the parent MockRepoStatisticsStore instance is invoked.
-type RepoStatisticsStoreWithFunc struct {
-	defaultHook func(basestore.ShareableStore) RepoStatisticsStore
-	hooks       []func(basestore.ShareableStore) RepoStatisticsStore
-	history     []RepoStatisticsStoreWithFuncCall
+// MockRepoPathStore is a mock implementation of the RepoPathStore interface
+// (from the package github.com/sourcegraph/sourcegraph/internal/database)
+// used for unit testing.
+type MockRepoPathStore struct {
+	// AggregateFileCountFunc is an instance of a mock function object
+	// controlling the behavior of the method AggregateFileCount.
+	AggregateFileCountFunc *RepoPathStoreAggregateFileCountFunc
+	// UpdateFileCountsFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdateFileCounts.
+	UpdateFileCountsFunc *RepoPathStoreUpdateFileCountsFunc
+}
+
+// NewMockRepoPathStore creates a new mock of the RepoPathStore interface.
+// All methods return zero values for all results, unless overwritten.
+func NewMockRepoPathStore() *MockRepoPathStore {
+	return &MockRepoPathStore{
+		AggregateFileCountFunc: &RepoPathStoreAggregateFileCountFunc{
+			defaultHook: func(context.Context, TreeLocationOpts) (r0 int32, r1 error) {
+				return
+			},
+		},
+		UpdateFileCountsFunc: &RepoPathStoreUpdateFileCountsFunc{
+			defaultHook: func(context.Context, api.RepoID, RepoTreeCounts, time.Time) (r0 int, r1 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockRepoPathStore creates a new mock of the RepoPathStore
+// interface. All methods panic on invocation, unless overwritten.
+func NewStrictMockRepoPathStore() *MockRepoPathStore {
+	return &MockRepoPathStore{
+		AggregateFileCountFunc: &RepoPathStoreAggregateFileCountFunc{
+			defaultHook: func(context.Context, TreeLocationOpts) (int32, error) {
+				panic("unexpected invocation of MockRepoPathStore.AggregateFileCount")
+			},
+		},
+		UpdateFileCountsFunc: &RepoPathStoreUpdateFileCountsFunc{
+			defaultHook: func(context.Context, api.RepoID, RepoTreeCounts, time.Time) (int, error) {
+				panic("unexpected invocation of MockRepoPathStore.UpdateFileCounts")
+			},
+		},
+	}
+}
+
+// NewMockRepoPathStoreFrom creates a new mock of the MockRepoPathStore
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockRepoPathStoreFrom(i RepoPathStore) *MockRepoPathStore {
+	return &MockRepoPathStore{
+		AggregateFileCountFunc: &RepoPathStoreAggregateFileCountFunc{
+			defaultHook: i.AggregateFileCount,
+		},
+		UpdateFileCountsFunc: &RepoPathStoreUpdateFileCountsFunc{
+			defaultHook: i.UpdateFileCounts,
+		},
+	}
+}
+
+// RepoPathStoreAggregateFileCountFunc describes the behavior when the
+// AggregateFileCount method of the parent MockRepoPathStore instance is
+// invoked.
+type RepoPathStoreAggregateFileCountFunc struct {
+	defaultHook func(context.Context, TreeLocationOpts) (int32, error)
+	hooks       []func(context.Context, TreeLocationOpts) (int32, error)
+	history     []RepoPathStoreAggregateFileCountFuncCall
 	mutex       sync.Mutex
 }
 
-// With delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStatisticsStore) With(v0 basestore.ShareableStore) RepoStatisticsStore {
-	r0 := m.WithFunc.nextHook()(v0)
-	m.WithFunc.appendCall(RepoStatisticsStoreWithFuncCall{v0, r0})
-	return r0
+// AggregateFileCount delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRepoPathStore) AggregateFileCount(v0 context.Context, v1 TreeLocationOpts) (int32, error) {
+	r0, r1 := m.AggregateFileCountFunc.nextHook()(v0, v1)
+	m.AggregateFileCountFunc.appendCall(RepoPathStoreAggregateFileCountFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the With method of the
-// parent MockRepoStatisticsStore instance is invoked and the hook queue is
-// empty.
-func (f *RepoStatisticsStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) RepoStatisticsStore) {
+// SetDefaultHook sets function that is called when the AggregateFileCount
+// method of the parent MockRepoPathStore instance is invoked and the hook
+// queue is empty.
+func (f *RepoPathStoreAggregateFileCountFunc) SetDefaultHook(hook func(context.Context, TreeLocationOpts) (int32, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// With method of the parent MockRepoStatisticsStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *RepoStatisticsStoreWithFunc) PushHook(hook func(basestore.ShareableStore) RepoStatisticsStore) {
+// AggregateFileCount method of the parent MockRepoPathStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RepoPathStoreAggregateFileCountFunc) PushHook(hook func(context.Context, TreeLocationOpts) (int32, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -46208,20 +46423,20 @@ func (f *RepoStatisticsStoreWithFunc) PushHook(hook func(basestore.ShareableStor
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStatisticsStoreWithFunc) SetDefaultReturn(r0 RepoStatisticsStore) {
-	f.SetDefaultHook(func(basestore.ShareableStore) RepoStatisticsStore {
-		return r0
+func (f *RepoPathStoreAggregateFileCountFunc) SetDefaultReturn(r0 int32, r1 error) {
+	f.SetDefaultHook(func(context.Context, TreeLocationOpts) (int32, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStatisticsStoreWithFunc) PushReturn(r0 RepoStatisticsStore) {
-	f.PushHook(func(basestore.ShareableStore) RepoStatisticsStore {
-		return r0
+func (f *RepoPathStoreAggregateFileCountFunc) PushReturn(r0 int32, r1 error) {
+	f.PushHook(func(context.Context, TreeLocationOpts) (int32, error) {
+		return r0, r1
 	})
 }
 
-func (f *RepoStatisticsStoreWithFunc) nextHook() func(basestore.ShareableStore) RepoStatisticsStore {
+func (f *RepoPathStoreAggregateFileCountFunc) nextHook() func(context.Context, TreeLocationOpts) (int32, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -46234,77 +46449,83 @@ func (f *RepoStatisticsStoreWithFunc) nextHook() func(basestore.ShareableStore)
 	return hook
 }
 
-func (f *RepoStatisticsStoreWithFunc) appendCall(r0 RepoStatisticsStoreWithFuncCall) {
+func (f *RepoPathStoreAggregateFileCountFunc) appendCall(r0 RepoPathStoreAggregateFileCountFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStatisticsStoreWithFuncCall objects
-// describing the invocations of this function.
-func (f *RepoStatisticsStoreWithFunc) History() []RepoStatisticsStoreWithFuncCall {
+// History returns a sequence of RepoPathStoreAggregateFileCountFuncCall
+// objects describing the invocations of this function.
+func (f *RepoPathStoreAggregateFileCountFunc) History() []RepoPathStoreAggregateFileCountFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStatisticsStoreWithFuncCall, len(f.history))
+	history := make([]RepoPathStoreAggregateFileCountFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStatisticsStoreWithFuncCall is an object that describes an invocation
-// of method With on an instance of MockRepoStatisticsStore.
-type RepoStatisticsStoreWithFuncCall struct {
+// RepoPathStoreAggregateFileCountFuncCall is an object that describes an
+// invocation of method AggregateFileCount on an instance of
+// MockRepoPathStore.
+type RepoPathStoreAggregateFileCountFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 basestore.ShareableStore
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 TreeLocationOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 RepoStatisticsStore
+	Result0 int32
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStatisticsStoreWithFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c RepoPathStoreAggregateFileCountFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStatisticsStoreWithFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c RepoPathStoreAggregateFileCountFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStatisticsStoreWithTransactFunc describes the behavior when the
-// WithTransact method of the parent MockRepoStatisticsStore instance is
+// RepoPathStoreUpdateFileCountsFunc describes the behavior when the
+// UpdateFileCounts method of the parent MockRepoPathStore instance is
 // invoked.
-type RepoStatisticsStoreWithTransactFunc struct {
-	defaultHook func(context.Context, func(RepoStatisticsStore) error) error
-	hooks       []func(context.Context, func(RepoStatisticsStore) error) error
-	history     []RepoStatisticsStoreWithTransactFuncCall
+type RepoPathStoreUpdateFileCountsFunc struct {
+	defaultHook func(context.Context, api.RepoID, RepoTreeCounts, time.Time) (int, error)
+	hooks       []func(context.Context, api.RepoID, RepoTreeCounts, time.Time) (int, error)
+	history     []RepoPathStoreUpdateFileCountsFuncCall
 	mutex       sync.Mutex
 }
 
-// WithTransact delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockRepoStatisticsStore) WithTransact(v0 context.Context, v1 func(RepoStatisticsStore) error) error {
-	r0 := m.WithTransactFunc.nextHook()(v0, v1)
-	m.WithTransactFunc.appendCall(RepoStatisticsStoreWithTransactFuncCall{v0, v1, r0})
-	return r0
+// UpdateFileCounts delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRepoPathStore) UpdateFileCounts(v0 context.Context, v1 api.RepoID, v2 RepoTreeCounts, v3 time.Time) (int, error) {
+	r0, r1 := m.UpdateFileCountsFunc.nextHook()(v0, v1, v2, v3)
+	m.UpdateFileCountsFunc.appendCall(RepoPathStoreUpdateFileCountsFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the WithTransact method
-// of the parent MockRepoStatisticsStore instance is invoked and the hook
+// SetDefaultHook sets function that is called when the UpdateFileCounts
+// method of the parent MockRepoPathStore instance is invoked and the hook
 // queue is empty.
-func (f *RepoStatisticsStoreWithTransactFunc) SetDefaultHook(hook func(context.Context, func(RepoStatisticsStore) error) error) {
+func (f *RepoPathStoreUpdateFileCountsFunc) SetDefaultHook(hook func(context.Context, api.RepoID, RepoTreeCounts, time.Time) (int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// WithTransact method of the parent MockRepoStatisticsStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *RepoStatisticsStoreWithTransactFunc) PushHook(hook func(context.Context, func(RepoStatisticsStore) error) error) {
+// UpdateFileCounts method of the parent MockRepoPathStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *RepoPathStoreUpdateFileCountsFunc) PushHook(hook func(context.Context, api.RepoID, RepoTreeCounts, time.Time) (int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -46312,20 +46533,20 @@ func (f *RepoStatisticsStoreWithTransactFunc) PushHook(hook func(context.Context
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStatisticsStoreWithTransactFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, func(RepoStatisticsStore) error) error {
-		return r0
+func (f *RepoPathStoreUpdateFileCountsFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID, RepoTreeCounts, time.Time) (int, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStatisticsStoreWithTransactFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, func(RepoStatisticsStore) error) error {
-		return r0
+func (f *RepoPathStoreUpdateFileCountsFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID, RepoTreeCounts, time.Time) (int, error) {
+		return r0, r1
 	})
 }
 
-func (f *RepoStatisticsStoreWithTransactFunc) nextHook() func(context.Context, func(RepoStatisticsStore) error) error {
+func (f *RepoPathStoreUpdateFileCountsFunc) nextHook() func(context.Context, api.RepoID, RepoTreeCounts, time.Time) (int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -46338,459 +46559,2209 @@ func (f *RepoStatisticsStoreWithTransactFunc) nextHook() func(context.Context, f
 	return hook
 }
 
-func (f *RepoStatisticsStoreWithTransactFunc) appendCall(r0 RepoStatisticsStoreWithTransactFuncCall) {
+func (f *RepoPathStoreUpdateFileCountsFunc) appendCall(r0 RepoPathStoreUpdateFileCountsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStatisticsStoreWithTransactFuncCall
+// History returns a sequence of RepoPathStoreUpdateFileCountsFuncCall
 // objects describing the invocations of this function.
-func (f *RepoStatisticsStoreWithTransactFunc) History() []RepoStatisticsStoreWithTransactFuncCall {
+func (f *RepoPathStoreUpdateFileCountsFunc) History() []RepoPathStoreUpdateFileCountsFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStatisticsStoreWithTransactFuncCall, len(f.history))
+	history := make([]RepoPathStoreUpdateFileCountsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStatisticsStoreWithTransactFuncCall is an object that describes an
-// invocation of method WithTransact on an instance of
-// MockRepoStatisticsStore.
-type RepoStatisticsStoreWithTransactFuncCall struct {
+// RepoPathStoreUpdateFileCountsFuncCall is an object that describes an
+// invocation of method UpdateFileCounts on an instance of
+// MockRepoPathStore.
+type RepoPathStoreUpdateFileCountsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 func(RepoStatisticsStore) error
+	Arg1 api.RepoID
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 RepoTreeCounts
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 time.Time
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStatisticsStoreWithTransactFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c RepoPathStoreUpdateFileCountsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStatisticsStoreWithTransactFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c RepoPathStoreUpdateFileCountsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// MockRepoStore is a mock implementation of the RepoStore interface (from
-// the package github.com/sourcegraph/sourcegraph/internal/database) used
-// for unit testing.
-type MockRepoStore struct {
-	// CountFunc is an instance of a mock function object controlling the
-	// behavior of the method Count.
-	CountFunc *RepoStoreCountFunc
-	// CreateFunc is an instance of a mock function object controlling the
-	// behavior of the method Create.
-	CreateFunc *RepoStoreCreateFunc
-	// DeleteFunc is an instance of a mock function object controlling the
-	// behavior of the method Delete.
-	DeleteFunc *RepoStoreDeleteFunc
-	// DoneFunc is an instance of a mock function object controlling the
-	// behavior of the method Done.
-	DoneFunc *RepoStoreDoneFunc
-	// GetFunc is an instance of a mock function object controlling the
-	// behavior of the method Get.
-	GetFunc *RepoStoreGetFunc
-	// GetByHashedNameFunc is an instance of a mock function object
-	// controlling the behavior of the method GetByHashedName.
-	GetByHashedNameFunc *RepoStoreGetByHashedNameFunc
-	// GetByIDsFunc is an instance of a mock function object controlling the
-	// behavior of the method GetByIDs.
-	GetByIDsFunc *RepoStoreGetByIDsFunc
-	// GetByNameFunc is an instance of a mock function object controlling
-	// the behavior of the method GetByName.
-	GetByNameFunc *RepoStoreGetByNameFunc
-	// GetFirstRepoByCloneURLFunc is an instance of a mock function object
-	// controlling the behavior of the method GetFirstRepoByCloneURL.
-	GetFirstRepoByCloneURLFunc *RepoStoreGetFirstRepoByCloneURLFunc
-	// GetFirstRepoNameByCloneURLFunc is an instance of a mock function
+// MockRepoStatisticsStore is a mock implementation of the
+// RepoStatisticsStore interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/database) used for unit
+// testing.
+type MockRepoStatisticsStore struct {
+	// CompactRepoStatisticsFunc is an instance of a mock function object
+	// controlling the behavior of the method CompactRepoStatistics.
+	CompactRepoStatisticsFunc *RepoStatisticsStoreCompactRepoStatisticsFunc
+	// GetGitserverReposStatisticsFunc is an instance of a mock function
 	// object controlling the behavior of the method
-	// GetFirstRepoNameByCloneURL.
-	GetFirstRepoNameByCloneURLFunc *RepoStoreGetFirstRepoNameByCloneURLFunc
-	// GetRepoDescriptionsByIDsFunc is an instance of a mock function object
-	// controlling the behavior of the method GetRepoDescriptionsByIDs.
-	GetRepoDescriptionsByIDsFunc *RepoStoreGetRepoDescriptionsByIDsFunc
-	// GetReposSetByIDsFunc is an instance of a mock function object
-	// controlling the behavior of the method GetReposSetByIDs.
-	GetReposSetByIDsFunc *RepoStoreGetReposSetByIDsFunc
+	// GetGitserverReposStatistics.
+	GetGitserverReposStatisticsFunc *RepoStatisticsStoreGetGitserverReposStatisticsFunc
+	// GetRepoStatisticsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetRepoStatistics.
+	GetRepoStatisticsFunc *RepoStatisticsStoreGetRepoStatisticsFunc
 	// HandleFunc is an instance of a mock function object controlling the
 	// behavior of the method Handle.
-	HandleFunc *RepoStoreHandleFunc
-	// ListFunc is an instance of a mock function object controlling the
-	// behavior of the method List.
-	ListFunc *RepoStoreListFunc
-	// ListMinimalReposFunc is an instance of a mock function object
-	// controlling the behavior of the method ListMinimalRepos.
-	ListMinimalReposFunc *RepoStoreListMinimalReposFunc
-	// ListSourcegraphDotComIndexableReposFunc is an instance of a mock
-	// function object controlling the behavior of the method
-	// ListSourcegraphDotComIndexableRepos.
-	ListSourcegraphDotComIndexableReposFunc *RepoStoreListSourcegraphDotComIndexableReposFunc
-	// MetadataFunc is an instance of a mock function object controlling the
-	// behavior of the method Metadata.
-	MetadataFunc *RepoStoreMetadataFunc
-	// QueryFunc is an instance of a mock function object controlling the
-	// behavior of the method Query.
-	QueryFunc *RepoStoreQueryFunc
-	// RepoEmbeddingExistsFunc is an instance of a mock function object
-	// controlling the behavior of the method RepoEmbeddingExists.
-	RepoEmbeddingExistsFunc *RepoStoreRepoEmbeddingExistsFunc
-	// StreamMinimalReposFunc is an instance of a mock function object
-	// controlling the behavior of the method StreamMinimalRepos.
-	StreamMinimalReposFunc *RepoStoreStreamMinimalReposFunc
-	// TransactFunc is an instance of a mock function object controlling the
-	// behavior of the method Transact.
-	TransactFunc *RepoStoreTransactFunc
+	HandleFunc *RepoStatisticsStoreHandleFunc
 	// WithFunc is an instance of a mock function object controlling the
 	// behavior of the method With.
-	WithFunc *RepoStoreWithFunc
+	WithFunc *RepoStatisticsStoreWithFunc
+	// WithTransactFunc is an instance of a mock function object controlling
+	// the behavior of the method WithTransact.
+	WithTransactFunc *RepoStatisticsStoreWithTransactFunc
 }
 
-// NewMockRepoStore creates a new mock of the RepoStore interface. All
-// methods return zero values for all results, unless overwritten.
-func NewMockRepoStore() *MockRepoStore {
-	return &MockRepoStore{
-		CountFunc: &RepoStoreCountFunc{
-			defaultHook: func(context.Context, ReposListOptions) (r0 int, r1 error) {
-				return
-			},
-		},
-		CreateFunc: &RepoStoreCreateFunc{
-			defaultHook: func(context.Context, ...*types.Repo) (r0 error) {
-				return
-			},
-		},
-		DeleteFunc: &RepoStoreDeleteFunc{
-			defaultHook: func(context.Context, ...api.RepoID) (r0 error) {
-				return
-			},
-		},
-		DoneFunc: &RepoStoreDoneFunc{
-			defaultHook: func(error) (r0 error) {
-				return
-			},
-		},
-		GetFunc: &RepoStoreGetFunc{
-			defaultHook: func(context.Context, api.RepoID) (r0 *types.Repo, r1 error) {
+// NewMockRepoStatisticsStore creates a new mock of the RepoStatisticsStore
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockRepoStatisticsStore() *MockRepoStatisticsStore {
+	return &MockRepoStatisticsStore{
+		CompactRepoStatisticsFunc: &RepoStatisticsStoreCompactRepoStatisticsFunc{
+			defaultHook: func(context.Context) (r0 error) {
 				return
 			},
 		},
-		GetByHashedNameFunc: &RepoStoreGetByHashedNameFunc{
-			defaultHook: func(context.Context, api.RepoHashedName) (r0 *types.Repo, r1 error) {
+		GetGitserverReposStatisticsFunc: &RepoStatisticsStoreGetGitserverReposStatisticsFunc{
+			defaultHook: func(context.Context) (r0 []GitserverReposStatistic, r1 error) {
 				return
 			},
 		},
-		GetByIDsFunc: &RepoStoreGetByIDsFunc{
-			defaultHook: func(context.Context, ...api.RepoID) (r0 []*types.Repo, r1 error) {
+		GetRepoStatisticsFunc: &RepoStatisticsStoreGetRepoStatisticsFunc{
+			defaultHook: func(context.Context) (r0 RepoStatistics, r1 error) {
 				return
 			},
 		},
-		GetByNameFunc: &RepoStoreGetByNameFunc{
-			defaultHook: func(context.Context, api.RepoName) (r0 *types.Repo, r1 error) {
+		HandleFunc: &RepoStatisticsStoreHandleFunc{
+			defaultHook: func() (r0 basestore.TransactableHandle) {
 				return
 			},
 		},
-		GetFirstRepoByCloneURLFunc: &RepoStoreGetFirstRepoByCloneURLFunc{
-			defaultHook: func(context.Context, string) (r0 *types.Repo, r1 error) {
+		WithFunc: &RepoStatisticsStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) (r0 RepoStatisticsStore) {
 				return
 			},
 		},
-		GetFirstRepoNameByCloneURLFunc: &RepoStoreGetFirstRepoNameByCloneURLFunc{
-			defaultHook: func(context.Context, string) (r0 api.RepoName, r1 error) {
+		WithTransactFunc: &RepoStatisticsStoreWithTransactFunc{
+			defaultHook: func(context.Context, func(RepoStatisticsStore) error) (r0 error) {
 				return
 			},
 		},
-		GetRepoDescriptionsByIDsFunc: &RepoStoreGetRepoDescriptionsByIDsFunc{
-			defaultHook: func(context.Context, ...api.RepoID) (r0 map[api.RepoID]string, r1 error) {
-				return
+	}
+}
+
+// NewStrictMockRepoStatisticsStore creates a new mock of the
+// RepoStatisticsStore interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockRepoStatisticsStore() *MockRepoStatisticsStore {
+	return &MockRepoStatisticsStore{
+		CompactRepoStatisticsFunc: &RepoStatisticsStoreCompactRepoStatisticsFunc{
+			defaultHook: func(context.Context) error {
+				panic("unexpected invocation of MockRepoStatisticsStore.CompactRepoStatistics")
 			},
 		},
-		GetReposSetByIDsFunc: &RepoStoreGetReposSetByIDsFunc{
-			defaultHook: func(context.Context, ...api.RepoID) (r0 map[api.RepoID]*types.Repo, r1 error) {
-				return
+		GetGitserverReposStatisticsFunc: &RepoStatisticsStoreGetGitserverReposStatisticsFunc{
+			defaultHook: func(context.Context) ([]GitserverReposStatistic, error) {
+				panic("unexpected invocation of MockRepoStatisticsStore.GetGitserverReposStatistics")
 			},
 		},
-		HandleFunc: &RepoStoreHandleFunc{
-			defaultHook: func() (r0 basestore.TransactableHandle) {
-				return
+		GetRepoStatisticsFunc: &RepoStatisticsStoreGetRepoStatisticsFunc{
+			defaultHook: func(context.Context) (RepoStatistics, error) {
+				panic("unexpected invocation of MockRepoStatisticsStore.GetRepoStatistics")
 			},
 		},
-		ListFunc: &RepoStoreListFunc{
-			defaultHook: func(context.Context, ReposListOptions) (r0 []*types.Repo, r1 error) {
-				return
+		HandleFunc: &RepoStatisticsStoreHandleFunc{
+			defaultHook: func() basestore.TransactableHandle {
+				panic("unexpected invocation of MockRepoStatisticsStore.Handle")
 			},
 		},
-		ListMinimalReposFunc: &RepoStoreListMinimalReposFunc{
-			defaultHook: func(context.Context, ReposListOptions) (r0 []types.MinimalRepo, r1 error) {
-				return
+		WithFunc: &RepoStatisticsStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) RepoStatisticsStore {
+				panic("unexpected invocation of MockRepoStatisticsStore.With")
 			},
 		},
-		ListSourcegraphDotComIndexableReposFunc: &RepoStoreListSourcegraphDotComIndexableReposFunc{
-			defaultHook: func(context.Context, ListSourcegraphDotComIndexableReposOptions) (r0 []types.MinimalRepo, r1 error) {
-				return
+		WithTransactFunc: &RepoStatisticsStoreWithTransactFunc{
+			defaultHook: func(context.Context, func(RepoStatisticsStore) error) error {
+				panic("unexpected invocation of MockRepoStatisticsStore.WithTransact")
 			},
 		},
-		MetadataFunc: &RepoStoreMetadataFunc{
-			defaultHook: func(context.Context, ...api.RepoID) (r0 []*types.SearchedRepo, r1 error) {
-				return
-			},
+	}
+}
+
+// NewMockRepoStatisticsStoreFrom creates a new mock of the
+// MockRepoStatisticsStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockRepoStatisticsStoreFrom(i RepoStatisticsStore) *MockRepoStatisticsStore {
+	return &MockRepoStatisticsStore{
+		CompactRepoStatisticsFunc: &RepoStatisticsStoreCompactRepoStatisticsFunc{
+			defaultHook: i.CompactRepoStatistics,
 		},
-		QueryFunc: &RepoStoreQueryFunc{
-			defaultHook: func(context.Context, *sqlf.Query) (r0 *sql.Rows, r1 error) {
-				return
-			},
+		GetGitserverReposStatisticsFunc: &RepoStatisticsStoreGetGitserverReposStatisticsFunc{
+			defaultHook: i.GetGitserverReposStatistics,
 		},
-		RepoEmbeddingExistsFunc: &RepoStoreRepoEmbeddingExistsFunc{
-			defaultHook: func(context.Context, api.RepoID) (r0 bool, r1 error) {
-				return
-			},
+		GetRepoStatisticsFunc: &RepoStatisticsStoreGetRepoStatisticsFunc{
+			defaultHook: i.GetRepoStatistics,
 		},
-		StreamMinimalReposFunc: &RepoStoreStreamMinimalReposFunc{
-			defaultHook: func(context.Context, ReposListOptions, func(*types.MinimalRepo)) (r0 error) {
-				return
-			},
+		HandleFunc: &RepoStatisticsStoreHandleFunc{
+			defaultHook: i.Handle,
 		},
-		TransactFunc: &RepoStoreTransactFunc{
-			defaultHook: func(context.Context) (r0 RepoStore, r1 error) {
-				return
-			},
+		WithFunc: &RepoStatisticsStoreWithFunc{
+			defaultHook: i.With,
 		},
-		WithFunc: &RepoStoreWithFunc{
-			defaultHook: func(basestore.ShareableStore) (r0 RepoStore) {
-				return
-			},
+		WithTransactFunc: &RepoStatisticsStoreWithTransactFunc{
+			defaultHook: i.WithTransact,
 		},
 	}
 }
 
-// NewStrictMockRepoStore creates a new mock of the RepoStore interface. All
-// methods panic on invocation, unless overwritten.
-func NewStrictMockRepoStore() *MockRepoStore {
-	return &MockRepoStore{
-		CountFunc: &RepoStoreCountFunc{
-			defaultHook: func(context.Context, ReposListOptions) (int, error) {
-				panic("unexpected invocation of MockRepoStore.Count")
-			},
-		},
-		CreateFunc: &RepoStoreCreateFunc{
-			defaultHook: func(context.Context, ...*types.Repo) error {
-				panic("unexpected invocation of MockRepoStore.Create")
-			},
-		},
-		DeleteFunc: &RepoStoreDeleteFunc{
-			defaultHook: func(context.Context, ...api.RepoID) error {
-				panic("unexpected invocation of MockRepoStore.Delete")
-			},
-		},
-		DoneFunc: &RepoStoreDoneFunc{
-			defaultHook: func(error) error {
-				panic("unexpected invocation of MockRepoStore.Done")
-			},
-		},
-		GetFunc: &RepoStoreGetFunc{
-			defaultHook: func(context.Context, api.RepoID) (*types.Repo, error) {
-				panic("unexpected invocation of MockRepoStore.Get")
-			},
-		},
-		GetByHashedNameFunc: &RepoStoreGetByHashedNameFunc{
-			defaultHook: func(context.Context, api.RepoHashedName) (*types.Repo, error) {
-				panic("unexpected invocation of MockRepoStore.GetByHashedName")
-			},
-		},
-		GetByIDsFunc: &RepoStoreGetByIDsFunc{
-			defaultHook: func(context.Context, ...api.RepoID) ([]*types.Repo, error) {
-				panic("unexpected invocation of MockRepoStore.GetByIDs")
-			},
-		},
-		GetByNameFunc: &RepoStoreGetByNameFunc{
-			defaultHook: func(context.Context, api.RepoName) (*types.Repo, error) {
-				panic("unexpected invocation of MockRepoStore.GetByName")
-			},
-		},
-		GetFirstRepoByCloneURLFunc: &RepoStoreGetFirstRepoByCloneURLFunc{
-			defaultHook: func(context.Context, string) (*types.Repo, error) {
-				panic("unexpected invocation of MockRepoStore.GetFirstRepoByCloneURL")
-			},
-		},
-		GetFirstRepoNameByCloneURLFunc: &RepoStoreGetFirstRepoNameByCloneURLFunc{
-			defaultHook: func(context.Context, string) (api.RepoName, error) {
-				panic("unexpected invocation of MockRepoStore.GetFirstRepoNameByCloneURL")
-			},
-		},
-		GetRepoDescriptionsByIDsFunc: &RepoStoreGetRepoDescriptionsByIDsFunc{
-			defaultHook: func(context.Context, ...api.RepoID) (map[api.RepoID]string, error) {
-				panic("unexpected invocation of MockRepoStore.GetRepoDescriptionsByIDs")
-			},
-		},
-		GetReposSetByIDsFunc: &RepoStoreGetReposSetByIDsFunc{
-			defaultHook: func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
-				panic("unexpected invocation of MockRepoStore.GetReposSetByIDs")
-			},
-		},
-		HandleFunc: &RepoStoreHandleFunc{
-			defaultHook: func() basestore.TransactableHandle {
-				panic("unexpected invocation of MockRepoStore.Handle")
-			},
-		},
-		ListFunc: &RepoStoreListFunc{
-			defaultHook: func(context.Context, ReposListOptions) ([]*types.Repo, error) {
-				panic("unexpected invocation of MockRepoStore.List")
-			},
-		},
-		ListMinimalReposFunc: &RepoStoreListMinimalReposFunc{
-			defaultHook: func(context.Context, ReposListOptions) ([]types.MinimalRepo, error) {
-				panic("unexpected invocation of MockRepoStore.ListMinimalRepos")
-			},
-		},
-		ListSourcegraphDotComIndexableReposFunc: &RepoStoreListSourcegraphDotComIndexableReposFunc{
-			defaultHook: func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
-				panic("unexpected invocation of MockRepoStore.ListSourcegraphDotComIndexableRepos")
-			},
-		},
-		MetadataFunc: &RepoStoreMetadataFunc{
-			defaultHook: func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error) {
-				panic("unexpected invocation of MockRepoStore.Metadata")
-			},
-		},
-		QueryFunc: &RepoStoreQueryFunc{
-			defaultHook: func(context.Context, *sqlf.Query) (*sql.Rows, error) {
-				panic("unexpected invocation of MockRepoStore.Query")
-			},
-		},
-		RepoEmbeddingExistsFunc: &RepoStoreRepoEmbeddingExistsFunc{
-			defaultHook: func(context.Context, api.RepoID) (bool, error) {
-				panic("unexpected invocation of MockRepoStore.RepoEmbeddingExists")
-			},
-		},
-		StreamMinimalReposFunc: &RepoStoreStreamMinimalReposFunc{
-			defaultHook: func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error {
-				panic("unexpected invocation of MockRepoStore.StreamMinimalRepos")
-			},
-		},
-		TransactFunc: &RepoStoreTransactFunc{
-			defaultHook: func(context.Context) (RepoStore, error) {
-				panic("unexpected invocation of MockRepoStore.Transact")
-			},
-		},
-		WithFunc: &RepoStoreWithFunc{
-			defaultHook: func(basestore.ShareableStore) RepoStore {
-				panic("unexpected invocation of MockRepoStore.With")
-			},
-		},
-	}
+// RepoStatisticsStoreCompactRepoStatisticsFunc describes the behavior when
+// the CompactRepoStatistics method of the parent MockRepoStatisticsStore
+// instance is invoked.
+type RepoStatisticsStoreCompactRepoStatisticsFunc struct {
+	defaultHook func(context.Context) error
+	hooks       []func(context.Context) error
+	history     []RepoStatisticsStoreCompactRepoStatisticsFuncCall
+	mutex       sync.Mutex
+}
+
+// CompactRepoStatistics delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockRepoStatisticsStore) CompactRepoStatistics(v0 context.Context) error {
+	r0 := m.CompactRepoStatisticsFunc.nextHook()(v0)
+	m.CompactRepoStatisticsFunc.appendCall(RepoStatisticsStoreCompactRepoStatisticsFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// CompactRepoStatistics method of the parent MockRepoStatisticsStore
+// instance is invoked and the hook queue is empty.
+func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) SetDefaultHook(hook func(context.Context) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CompactRepoStatistics method of the parent MockRepoStatisticsStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) PushHook(hook func(context.Context) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context) error {
+		return r0
+	})
+}
+
+func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) nextHook() func(context.Context) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) appendCall(r0 RepoStatisticsStoreCompactRepoStatisticsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// RepoStatisticsStoreCompactRepoStatisticsFuncCall objects describing the
+// invocations of this function.
+func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) History() []RepoStatisticsStoreCompactRepoStatisticsFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStatisticsStoreCompactRepoStatisticsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStatisticsStoreCompactRepoStatisticsFuncCall is an object that
+// describes an invocation of method CompactRepoStatistics on an instance of
+// MockRepoStatisticsStore.
+type RepoStatisticsStoreCompactRepoStatisticsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStatisticsStoreCompactRepoStatisticsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStatisticsStoreCompactRepoStatisticsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RepoStatisticsStoreGetGitserverReposStatisticsFunc describes the behavior
+// when the GetGitserverReposStatistics method of the parent
+// MockRepoStatisticsStore instance is invoked.
+type RepoStatisticsStoreGetGitserverReposStatisticsFunc struct {
+	defaultHook func(context.Context) ([]GitserverReposStatistic, error)
+	hooks       []func(context.Context) ([]GitserverReposStatistic, error)
+	history     []RepoStatisticsStoreGetGitserverReposStatisticsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetGitserverReposStatistics delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockRepoStatisticsStore) GetGitserverReposStatistics(v0 context.Context) ([]GitserverReposStatistic, error) {
+	r0, r1 := m.GetGitserverReposStatisticsFunc.nextHook()(v0)
+	m.GetGitserverReposStatisticsFunc.appendCall(RepoStatisticsStoreGetGitserverReposStatisticsFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetGitserverReposStatistics method of the parent MockRepoStatisticsStore
+// instance is invoked and the hook queue is empty.
+func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) SetDefaultHook(hook func(context.Context) ([]GitserverReposStatistic, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetGitserverReposStatistics method of the parent MockRepoStatisticsStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) PushHook(hook func(context.Context) ([]GitserverReposStatistic, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) SetDefaultReturn(r0 []GitserverReposStatistic, r1 error) {
+	f.SetDefaultHook(func(context.Context) ([]GitserverReposStatistic, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) PushReturn(r0 []GitserverReposStatistic, r1 error) {
+	f.PushHook(func(context.Context) ([]GitserverReposStatistic, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) nextHook() func(context.Context) ([]GitserverReposStatistic, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) appendCall(r0 RepoStatisticsStoreGetGitserverReposStatisticsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// RepoStatisticsStoreGetGitserverReposStatisticsFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) History() []RepoStatisticsStoreGetGitserverReposStatisticsFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStatisticsStoreGetGitserverReposStatisticsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStatisticsStoreGetGitserverReposStatisticsFuncCall is an object that
+// describes an invocation of method GetGitserverReposStatistics on an
+// instance of MockRepoStatisticsStore.
+type RepoStatisticsStoreGetGitserverReposStatisticsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []GitserverReposStatistic
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStatisticsStoreGetGitserverReposStatisticsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStatisticsStoreGetGitserverReposStatisticsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStatisticsStoreGetRepoStatisticsFunc describes the behavior when the
+// GetRepoStatistics method of the parent MockRepoStatisticsStore instance
+// is invoked.
+type RepoStatisticsStoreGetRepoStatisticsFunc struct {
+	defaultHook func(context.Context) (RepoStatistics, error)
+	hooks       []func(context.Context) (RepoStatistics, error)
+	history     []RepoStatisticsStoreGetRepoStatisticsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetRepoStatistics delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRepoStatisticsStore) GetRepoStatistics(v0 context.Context) (RepoStatistics, error) {
+	r0, r1 := m.GetRepoStatisticsFunc.nextHook()(v0)
+	m.GetRepoStatisticsFunc.appendCall(RepoStatisticsStoreGetRepoStatisticsFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetRepoStatistics
+// method of the parent MockRepoStatisticsStore instance is invoked and the
+// hook queue is empty.
+func (f *RepoStatisticsStoreGetRepoStatisticsFunc) SetDefaultHook(hook func(context.Context) (RepoStatistics, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetRepoStatistics method of the parent MockRepoStatisticsStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RepoStatisticsStoreGetRepoStatisticsFunc) PushHook(hook func(context.Context) (RepoStatistics, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStatisticsStoreGetRepoStatisticsFunc) SetDefaultReturn(r0 RepoStatistics, r1 error) {
+	f.SetDefaultHook(func(context.Context) (RepoStatistics, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStatisticsStoreGetRepoStatisticsFunc) PushReturn(r0 RepoStatistics, r1 error) {
+	f.PushHook(func(context.Context) (RepoStatistics, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStatisticsStoreGetRepoStatisticsFunc) nextHook() func(context.Context) (RepoStatistics, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStatisticsStoreGetRepoStatisticsFunc) appendCall(r0 RepoStatisticsStoreGetRepoStatisticsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// RepoStatisticsStoreGetRepoStatisticsFuncCall objects describing the
+// invocations of this function.
+func (f *RepoStatisticsStoreGetRepoStatisticsFunc) History() []RepoStatisticsStoreGetRepoStatisticsFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStatisticsStoreGetRepoStatisticsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStatisticsStoreGetRepoStatisticsFuncCall is an object that describes
+// an invocation of method GetRepoStatistics on an instance of
+// MockRepoStatisticsStore.
+type RepoStatisticsStoreGetRepoStatisticsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 RepoStatistics
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStatisticsStoreGetRepoStatisticsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStatisticsStoreGetRepoStatisticsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStatisticsStoreHandleFunc describes the behavior when the Handle
+// method of the parent MockRepoStatisticsStore instance is invoked.
+type RepoStatisticsStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []RepoStatisticsStoreHandleFuncCall
+	mutex       sync.Mutex
+}
+
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStatisticsStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(RepoStatisticsStoreHandleFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockRepoStatisticsStore instance is invoked and the hook queue is
+// empty.
+func (f *RepoStatisticsStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Handle method of the parent MockRepoStatisticsStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RepoStatisticsStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStatisticsStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStatisticsStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+func (f *RepoStatisticsStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStatisticsStoreHandleFunc) appendCall(r0 RepoStatisticsStoreHandleFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStatisticsStoreHandleFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStatisticsStoreHandleFunc) History() []RepoStatisticsStoreHandleFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStatisticsStoreHandleFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStatisticsStoreHandleFuncCall is an object that describes an
+// invocation of method Handle on an instance of MockRepoStatisticsStore.
+type RepoStatisticsStoreHandleFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 basestore.TransactableHandle
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStatisticsStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStatisticsStoreHandleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RepoStatisticsStoreWithFunc describes the behavior when the With method
+// of the parent MockRepoStatisticsStore instance is invoked.
+type RepoStatisticsStoreWithFunc struct {
+	defaultHook func(basestore.ShareableStore) RepoStatisticsStore
+	hooks       []func(basestore.ShareableStore) RepoStatisticsStore
+	history     []RepoStatisticsStoreWithFuncCall
+	mutex       sync.Mutex
+}
+
+// With delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStatisticsStore) With(v0 basestore.ShareableStore) RepoStatisticsStore {
+	r0 := m.WithFunc.nextHook()(v0)
+	m.WithFunc.appendCall(RepoStatisticsStoreWithFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the With method of the
+// parent MockRepoStatisticsStore instance is invoked and the hook queue is
+// empty.
+func (f *RepoStatisticsStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) RepoStatisticsStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// With method of the parent MockRepoStatisticsStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RepoStatisticsStoreWithFunc) PushHook(hook func(basestore.ShareableStore) RepoStatisticsStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStatisticsStoreWithFunc) SetDefaultReturn(r0 RepoStatisticsStore) {
+	f.SetDefaultHook(func(basestore.ShareableStore) RepoStatisticsStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStatisticsStoreWithFunc) PushReturn(r0 RepoStatisticsStore) {
+	f.PushHook(func(basestore.ShareableStore) RepoStatisticsStore {
+		return r0
+	})
+}
+
+func (f *RepoStatisticsStoreWithFunc) nextHook() func(basestore.ShareableStore) RepoStatisticsStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStatisticsStoreWithFunc) appendCall(r0 RepoStatisticsStoreWithFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStatisticsStoreWithFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStatisticsStoreWithFunc) History() []RepoStatisticsStoreWithFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStatisticsStoreWithFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStatisticsStoreWithFuncCall is an object that describes an invocation
+// of method With on an instance of MockRepoStatisticsStore.
+type RepoStatisticsStoreWithFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 basestore.ShareableStore
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 RepoStatisticsStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStatisticsStoreWithFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStatisticsStoreWithFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RepoStatisticsStoreWithTransactFunc describes the behavior when the
+// WithTransact method of the parent MockRepoStatisticsStore instance is
+// invoked.
+type RepoStatisticsStoreWithTransactFunc struct {
+	defaultHook func(context.Context, func(RepoStatisticsStore) error) error
+	hooks       []func(context.Context, func(RepoStatisticsStore) error) error
+	history     []RepoStatisticsStoreWithTransactFuncCall
+	mutex       sync.Mutex
+}
+
+// WithTransact delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockRepoStatisticsStore) WithTransact(v0 context.Context, v1 func(RepoStatisticsStore) error) error {
+	r0 := m.WithTransactFunc.nextHook()(v0, v1)
+	m.WithTransactFunc.appendCall(RepoStatisticsStoreWithTransactFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the WithTransact method
+// of the parent MockRepoStatisticsStore instance is invoked and the hook
+// queue is empty.
+func (f *RepoStatisticsStoreWithTransactFunc) SetDefaultHook(hook func(context.Context, func(RepoStatisticsStore) error) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// WithTransact method of the parent MockRepoStatisticsStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RepoStatisticsStoreWithTransactFunc) PushHook(hook func(context.Context, func(RepoStatisticsStore) error) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStatisticsStoreWithTransactFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, func(RepoStatisticsStore) error) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStatisticsStoreWithTransactFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, func(RepoStatisticsStore) error) error {
+		return r0
+	})
+}
+
+func (f *RepoStatisticsStoreWithTransactFunc) nextHook() func(context.Context, func(RepoStatisticsStore) error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStatisticsStoreWithTransactFunc) appendCall(r0 RepoStatisticsStoreWithTransactFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStatisticsStoreWithTransactFuncCall
+// objects describing the invocations of this function.
+func (f *RepoStatisticsStoreWithTransactFunc) History() []RepoStatisticsStoreWithTransactFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStatisticsStoreWithTransactFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStatisticsStoreWithTransactFuncCall is an object that describes an
+// invocation of method WithTransact on an instance of
+// MockRepoStatisticsStore.
+type RepoStatisticsStoreWithTransactFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 func(RepoStatisticsStore) error
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStatisticsStoreWithTransactFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStatisticsStoreWithTransactFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// MockRepoStore is a mock implementation of the RepoStore interface (from
+// the package github.com/sourcegraph/sourcegraph/internal/database) used
+// for unit testing.
+type MockRepoStore struct {
+	// CountFunc is an instance of a mock function object controlling the
+	// behavior of the method Count.
+	CountFunc *RepoStoreCountFunc
+	// CreateFunc is an instance of a mock function object controlling the
+	// behavior of the method Create.
+	CreateFunc *RepoStoreCreateFunc
+	// DeleteFunc is an instance of a mock function object controlling the
+	// behavior of the method Delete.
+	DeleteFunc *RepoStoreDeleteFunc
+	// DoneFunc is an instance of a mock function object controlling the
+	// behavior of the method Done.
+	DoneFunc *RepoStoreDoneFunc
+	// GetFunc is an instance of a mock function object controlling the
+	// behavior of the method Get.
+	GetFunc *RepoStoreGetFunc
+	// GetByHashedNameFunc is an instance of a mock function object
+	// controlling the behavior of the method GetByHashedName.
+	GetByHashedNameFunc *RepoStoreGetByHashedNameFunc
+	// GetByIDsFunc is an instance of a mock function object controlling the
+	// behavior of the method GetByIDs.
+	GetByIDsFunc *RepoStoreGetByIDsFunc
+	// GetByNameFunc is an instance of a mock function object controlling
+	// the behavior of the method GetByName.
+	GetByNameFunc *RepoStoreGetByNameFunc
+	// GetFirstRepoByCloneURLFunc is an instance of a mock function object
+	// controlling the behavior of the method GetFirstRepoByCloneURL.
+	GetFirstRepoByCloneURLFunc *RepoStoreGetFirstRepoByCloneURLFunc
+	// GetFirstRepoNameByCloneURLFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// GetFirstRepoNameByCloneURL.
+	GetFirstRepoNameByCloneURLFunc *RepoStoreGetFirstRepoNameByCloneURLFunc
+	// GetRepoDescriptionsByIDsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetRepoDescriptionsByIDs.
+	GetRepoDescriptionsByIDsFunc *RepoStoreGetRepoDescriptionsByIDsFunc
+	// GetReposSetByIDsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetReposSetByIDs.
+	GetReposSetByIDsFunc *RepoStoreGetReposSetByIDsFunc
+	// HandleFunc is an instance of a mock function object controlling the
+	// behavior of the method Handle.
+	HandleFunc *RepoStoreHandleFunc
+	// ListFunc is an instance of a mock function object controlling the
+	// behavior of the method List.
+	ListFunc *RepoStoreListFunc
+	// ListMinimalReposFunc is an instance of a mock function object
+	// controlling the behavior of the method ListMinimalRepos.
+	ListMinimalReposFunc *RepoStoreListMinimalReposFunc
+	// ListSourcegraphDotComIndexableReposFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// ListSourcegraphDotComIndexableRepos.
+	ListSourcegraphDotComIndexableReposFunc *RepoStoreListSourcegraphDotComIndexableReposFunc
+	// MetadataFunc is an instance of a mock function object controlling the
+	// behavior of the method Metadata.
+	MetadataFunc *RepoStoreMetadataFunc
+	// QueryFunc is an instance of a mock function object controlling the
+	// behavior of the method Query.
+	QueryFunc *RepoStoreQueryFunc
+	// RepoEmbeddingExistsFunc is an instance of a mock function object
+	// controlling the behavior of the method RepoEmbeddingExists.
+	RepoEmbeddingExistsFunc *RepoStoreRepoEmbeddingExistsFunc
+	// StreamMinimalReposFunc is an instance of a mock function object
+	// controlling the behavior of the method StreamMinimalRepos.
+	StreamMinimalReposFunc *RepoStoreStreamMinimalReposFunc
+	// TransactFunc is an instance of a mock function object controlling the
+	// behavior of the method Transact.
+	TransactFunc *RepoStoreTransactFunc
+	// WithFunc is an instance of a mock function object controlling the
+	// behavior of the method With.
+	WithFunc *RepoStoreWithFunc
+}
+
+// NewMockRepoStore creates a new mock of the RepoStore interface. All
+// methods return zero values for all results, unless overwritten.
+func NewMockRepoStore() *MockRepoStore {
+	return &MockRepoStore{
+		CountFunc: &RepoStoreCountFunc{
+			defaultHook: func(context.Context, ReposListOptions) (r0 int, r1 error) {
+				return
+			},
+		},
+		CreateFunc: &RepoStoreCreateFunc{
+			defaultHook: func(context.Context, ...*types.Repo) (r0 error) {
+				return
+			},
+		},
+		DeleteFunc: &RepoStoreDeleteFunc{
+			defaultHook: func(context.Context, ...api.RepoID) (r0 error) {
+				return
+			},
+		},
+		DoneFunc: &RepoStoreDoneFunc{
+			defaultHook: func(error) (r0 error) {
+				return
+			},
+		},
+		GetFunc: &RepoStoreGetFunc{
+			defaultHook: func(context.Context, api.RepoID) (r0 *types.Repo, r1 error) {
+				return
+			},
+		},
+		GetByHashedNameFunc: &RepoStoreGetByHashedNameFunc{
+			defaultHook: func(context.Context, api.RepoHashedName) (r0 *types.Repo, r1 error) {
+				return
+			},
+		},
+		GetByIDsFunc: &RepoStoreGetByIDsFunc{
+			defaultHook: func(context.Context, ...api.RepoID) (r0 []*types.Repo, r1 error) {
+				return
+			},
+		},
+		GetByNameFunc: &RepoStoreGetByNameFunc{
+			defaultHook: func(context.Context, api.RepoName) (r0 *types.Repo, r1 error) {
+				return
+			},
+		},
+		GetFirstRepoByCloneURLFunc: &RepoStoreGetFirstRepoByCloneURLFunc{
+			defaultHook: func(context.Context, string) (r0 *types.Repo, r1 error) {
+				return
+			},
+		},
+		GetFirstRepoNameByCloneURLFunc: &RepoStoreGetFirstRepoNameByCloneURLFunc{
+			defaultHook: func(context.Context, string) (r0 api.RepoName, r1 error) {
+				return
+			},
+		},
+		GetRepoDescriptionsByIDsFunc: &RepoStoreGetRepoDescriptionsByIDsFunc{
+			defaultHook: func(context.Context, ...api.RepoID) (r0 map[api.RepoID]string, r1 error) {
+				return
+			},
+		},
+		GetReposSetByIDsFunc: &RepoStoreGetReposSetByIDsFunc{
+			defaultHook: func(context.Context, ...api.RepoID) (r0 map[api.RepoID]*types.Repo, r1 error) {
+				return
+			},
+		},
+		HandleFunc: &RepoStoreHandleFunc{
+			defaultHook: func() (r0 basestore.TransactableHandle) {
+				return
+			},
+		},
+		ListFunc: &RepoStoreListFunc{
+			defaultHook: func(context.Context, ReposListOptions) (r0 []*types.Repo, r1 error) {
+				return
+			},
+		},
+		ListMinimalReposFunc: &RepoStoreListMinimalReposFunc{
+			defaultHook: func(context.Context, ReposListOptions) (r0 []types.MinimalRepo, r1 error) {
+				return
+			},
+		},
+		ListSourcegraphDotComIndexableReposFunc: &RepoStoreListSourcegraphDotComIndexableReposFunc{
+			defaultHook: func(context.Context, ListSourcegraphDotComIndexableReposOptions) (r0 []types.MinimalRepo, r1 error) {
+				return
+			},
+		},
+		MetadataFunc: &RepoStoreMetadataFunc{
+			defaultHook: func(context.Context, ...api.RepoID) (r0 []*types.SearchedRepo, r1 error) {
+				return
+			},
+		},
+		QueryFunc: &RepoStoreQueryFunc{
+			defaultHook: func(context.Context, *sqlf.Query) (r0 *sql.Rows, r1 error) {
+				return
+			},
+		},
+		RepoEmbeddingExistsFunc: &RepoStoreRepoEmbeddingExistsFunc{
+			defaultHook: func(context.Context, api.RepoID) (r0 bool, r1 error) {
+				return
+			},
+		},
+		StreamMinimalReposFunc: &RepoStoreStreamMinimalReposFunc{
+			defaultHook: func(context.Context, ReposListOptions, func(*types.MinimalRepo)) (r0 error) {
+				return
+			},
+		},
+		TransactFunc: &RepoStoreTransactFunc{
+			defaultHook: func(context.Context) (r0 RepoStore, r1 error) {
+				return
+			},
+		},
+		WithFunc: &RepoStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) (r0 RepoStore) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockRepoStore creates a new mock of the RepoStore interface. All
+// methods panic on invocation, unless overwritten.
+func NewStrictMockRepoStore() *MockRepoStore {
+	return &MockRepoStore{
+		CountFunc: &RepoStoreCountFunc{
+			defaultHook: func(context.Context, ReposListOptions) (int, error) {
+				panic("unexpected invocation of MockRepoStore.Count")
+			},
+		},
+		CreateFunc: &RepoStoreCreateFunc{
+			defaultHook: func(context.Context, ...*types.Repo) error {
+				panic("unexpected invocation of MockRepoStore.Create")
+			},
+		},
+		DeleteFunc: &RepoStoreDeleteFunc{
+			defaultHook: func(context.Context, ...api.RepoID) error {
+				panic("unexpected invocation of MockRepoStore.Delete")
+			},
+		},
+		DoneFunc: &RepoStoreDoneFunc{
+			defaultHook: func(error) error {
+				panic("unexpected invocation of MockRepoStore.Done")
+			},
+		},
+		GetFunc: &RepoStoreGetFunc{
+			defaultHook: func(context.Context, api.RepoID) (*types.Repo, error) {
+				panic("unexpected invocation of MockRepoStore.Get")
+			},
+		},
+		GetByHashedNameFunc: &RepoStoreGetByHashedNameFunc{
+			defaultHook: func(context.Context, api.RepoHashedName) (*types.Repo, error) {
+				panic("unexpected invocation of MockRepoStore.GetByHashedName")
+			},
+		},
+		GetByIDsFunc: &RepoStoreGetByIDsFunc{
+			defaultHook: func(context.Context, ...api.RepoID) ([]*types.Repo, error) {
+				panic("unexpected invocation of MockRepoStore.GetByIDs")
+			},
+		},
+		GetByNameFunc: &RepoStoreGetByNameFunc{
+			defaultHook: func(context.Context, api.RepoName) (*types.Repo, error) {
+				panic("unexpected invocation of MockRepoStore.GetByName")
+			},
+		},
+		GetFirstRepoByCloneURLFunc: &RepoStoreGetFirstRepoByCloneURLFunc{
+			defaultHook: func(context.Context, string) (*types.Repo, error) {
+				panic("unexpected invocation of MockRepoStore.GetFirstRepoByCloneURL")
+			},
+		},
+		GetFirstRepoNameByCloneURLFunc: &RepoStoreGetFirstRepoNameByCloneURLFunc{
+			defaultHook: func(context.Context, string) (api.RepoName, error) {
+				panic("unexpected invocation of MockRepoStore.GetFirstRepoNameByCloneURL")
+			},
+		},
+		GetRepoDescriptionsByIDsFunc: &RepoStoreGetRepoDescriptionsByIDsFunc{
+			defaultHook: func(context.Context, ...api.RepoID) (map[api.RepoID]string, error) {
+				panic("unexpected invocation of MockRepoStore.GetRepoDescriptionsByIDs")
+			},
+		},
+		GetReposSetByIDsFunc: &RepoStoreGetReposSetByIDsFunc{
+			defaultHook: func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
+				panic("unexpected invocation of MockRepoStore.GetReposSetByIDs")
+			},
+		},
+		HandleFunc: &RepoStoreHandleFunc{
+			defaultHook: func() basestore.TransactableHandle {
+				panic("unexpected invocation of MockRepoStore.Handle")
+			},
+		},
+		ListFunc: &RepoStoreListFunc{
+			defaultHook: func(context.Context, ReposListOptions) ([]*types.Repo, error) {
+				panic("unexpected invocation of MockRepoStore.List")
+			},
+		},
+		ListMinimalReposFunc: &RepoStoreListMinimalReposFunc{
+			defaultHook: func(context.Context, ReposListOptions) ([]types.MinimalRepo, error) {
+				panic("unexpected invocation of MockRepoStore.ListMinimalRepos")
+			},
+		},
+		ListSourcegraphDotComIndexableReposFunc: &RepoStoreListSourcegraphDotComIndexableReposFunc{
+			defaultHook: func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
+				panic("unexpected invocation of MockRepoStore.ListSourcegraphDotComIndexableRepos")
+			},
+		},
+		MetadataFunc: &RepoStoreMetadataFunc{
+			defaultHook: func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error) {
+				panic("unexpected invocation of MockRepoStore.Metadata")
+			},
+		},
+		QueryFunc: &RepoStoreQueryFunc{
+			defaultHook: func(context.Context, *sqlf.Query) (*sql.Rows, error) {
+				panic("unexpected invocation of MockRepoStore.Query")
+			},
+		},
+		RepoEmbeddingExistsFunc: &RepoStoreRepoEmbeddingExistsFunc{
+			defaultHook: func(context.Context, api.RepoID) (bool, error) {
+				panic("unexpected invocation of MockRepoStore.RepoEmbeddingExists")
+			},
+		},
+		StreamMinimalReposFunc: &RepoStoreStreamMinimalReposFunc{
+			defaultHook: func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error {
+				panic("unexpected invocation of MockRepoStore.StreamMinimalRepos")
+			},
+		},
+		TransactFunc: &RepoStoreTransactFunc{
+			defaultHook: func(context.Context) (RepoStore, error) {
+				panic("unexpected invocation of MockRepoStore.Transact")
+			},
+		},
+		WithFunc: &RepoStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) RepoStore {
+				panic("unexpected invocation of MockRepoStore.With")
+			},
+		},
+	}
+}
+
+// NewMockRepoStoreFrom creates a new mock of the MockRepoStore interface.
+// All methods delegate to the given implementation, unless overwritten.
+func NewMockRepoStoreFrom(i RepoStore) *MockRepoStore {
+	return &MockRepoStore{
+		CountFunc: &RepoStoreCountFunc{
+			defaultHook: i.Count,
+		},
+		CreateFunc: &RepoStoreCreateFunc{
+			defaultHook: i.Create,
+		},
+		DeleteFunc: &RepoStoreDeleteFunc{
+			defaultHook: i.Delete,
+		},
+		DoneFunc: &RepoStoreDoneFunc{
+			defaultHook: i.Done,
+		},
+		GetFunc: &RepoStoreGetFunc{
+			defaultHook: i.Get,
+		},
+		GetByHashedNameFunc: &RepoStoreGetByHashedNameFunc{
+			defaultHook: i.GetByHashedName,
+		},
+		GetByIDsFunc: &RepoStoreGetByIDsFunc{
+			defaultHook: i.GetByIDs,
+		},
+		GetByNameFunc: &RepoStoreGetByNameFunc{
+			defaultHook: i.GetByName,
+		},
+		GetFirstRepoByCloneURLFunc: &RepoStoreGetFirstRepoByCloneURLFunc{
+			defaultHook: i.GetFirstRepoByCloneURL,
+		},
+		GetFirstRepoNameByCloneURLFunc: &RepoStoreGetFirstRepoNameByCloneURLFunc{
+			defaultHook: i.GetFirstRepoNameByCloneURL,
+		},
+		GetRepoDescriptionsByIDsFunc: &RepoStoreGetRepoDescriptionsByIDsFunc{
+			defaultHook: i.GetRepoDescriptionsByIDs,
+		},
+		GetReposSetByIDsFunc: &RepoStoreGetReposSetByIDsFunc{
+			defaultHook: i.GetReposSetByIDs,
+		},
+		HandleFunc: &RepoStoreHandleFunc{
+			defaultHook: i.Handle,
+		},
+		ListFunc: &RepoStoreListFunc{
+			defaultHook: i.List,
+		},
+		ListMinimalReposFunc: &RepoStoreListMinimalReposFunc{
+			defaultHook: i.ListMinimalRepos,
+		},
+		ListSourcegraphDotComIndexableReposFunc: &RepoStoreListSourcegraphDotComIndexableReposFunc{
+			defaultHook: i.ListSourcegraphDotComIndexableRepos,
+		},
+		MetadataFunc: &RepoStoreMetadataFunc{
+			defaultHook: i.Metadata,
+		},
+		QueryFunc: &RepoStoreQueryFunc{
+			defaultHook: i.Query,
+		},
+		RepoEmbeddingExistsFunc: &RepoStoreRepoEmbeddingExistsFunc{
+			defaultHook: i.RepoEmbeddingExists,
+		},
+		StreamMinimalReposFunc: &RepoStoreStreamMinimalReposFunc{
+			defaultHook: i.StreamMinimalRepos,
+		},
+		TransactFunc: &RepoStoreTransactFunc{
+			defaultHook: i.Transact,
+		},
+		WithFunc: &RepoStoreWithFunc{
+			defaultHook: i.With,
+		},
+	}
+}
+
+// RepoStoreCountFunc describes the behavior when the Count method of the
+// parent MockRepoStore instance is invoked.
+type RepoStoreCountFunc struct {
+	defaultHook func(context.Context, ReposListOptions) (int, error)
+	hooks       []func(context.Context, ReposListOptions) (int, error)
+	history     []RepoStoreCountFuncCall
+	mutex       sync.Mutex
+}
+
+// Count delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) Count(v0 context.Context, v1 ReposListOptions) (int, error) {
+	r0, r1 := m.CountFunc.nextHook()(v0, v1)
+	m.CountFunc.appendCall(RepoStoreCountFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Count method of the
+// parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreCountFunc) SetDefaultHook(hook func(context.Context, ReposListOptions) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Count method of the parent MockRepoStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *RepoStoreCountFunc) PushHook(hook func(context.Context, ReposListOptions) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreCountFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, ReposListOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreCountFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, ReposListOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreCountFunc) nextHook() func(context.Context, ReposListOptions) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreCountFunc) appendCall(r0 RepoStoreCountFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreCountFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStoreCountFunc) History() []RepoStoreCountFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreCountFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreCountFuncCall is an object that describes an invocation of
+// method Count on an instance of MockRepoStore.
+type RepoStoreCountFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 ReposListOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreCountFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreCountFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStoreCreateFunc describes the behavior when the Create method of the
+// parent MockRepoStore instance is invoked.
+type RepoStoreCreateFunc struct {
+	defaultHook func(context.Context, ...*types.Repo) error
+	hooks       []func(context.Context, ...*types.Repo) error
+	history     []RepoStoreCreateFuncCall
+	mutex       sync.Mutex
+}
+
+// Create delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) Create(v0 context.Context, v1 ...*types.Repo) error {
+	r0 := m.CreateFunc.nextHook()(v0, v1...)
+	m.CreateFunc.appendCall(RepoStoreCreateFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Create method of the
+// parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreCreateFunc) SetDefaultHook(hook func(context.Context, ...*types.Repo) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Create method of the parent MockRepoStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RepoStoreCreateFunc) PushHook(hook func(context.Context, ...*types.Repo) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreCreateFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, ...*types.Repo) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreCreateFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, ...*types.Repo) error {
+		return r0
+	})
+}
+
+func (f *RepoStoreCreateFunc) nextHook() func(context.Context, ...*types.Repo) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreCreateFunc) appendCall(r0 RepoStoreCreateFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreCreateFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStoreCreateFunc) History() []RepoStoreCreateFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreCreateFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreCreateFuncCall is an object that describes an invocation of
+// method Create on an instance of MockRepoStore.
+type RepoStoreCreateFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []*types.Repo
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c RepoStoreCreateFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreCreateFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RepoStoreDeleteFunc describes the behavior when the Delete method of the
+// parent MockRepoStore instance is invoked.
+type RepoStoreDeleteFunc struct {
+	defaultHook func(context.Context, ...api.RepoID) error
+	hooks       []func(context.Context, ...api.RepoID) error
+	history     []RepoStoreDeleteFuncCall
+	mutex       sync.Mutex
+}
+
+// Delete delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) Delete(v0 context.Context, v1 ...api.RepoID) error {
+	r0 := m.DeleteFunc.nextHook()(v0, v1...)
+	m.DeleteFunc.appendCall(RepoStoreDeleteFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Delete method of the
+// parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreDeleteFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Delete method of the parent MockRepoStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RepoStoreDeleteFunc) PushHook(hook func(context.Context, ...api.RepoID) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreDeleteFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, ...api.RepoID) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreDeleteFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, ...api.RepoID) error {
+		return r0
+	})
+}
+
+func (f *RepoStoreDeleteFunc) nextHook() func(context.Context, ...api.RepoID) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreDeleteFunc) appendCall(r0 RepoStoreDeleteFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreDeleteFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStoreDeleteFunc) History() []RepoStoreDeleteFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreDeleteFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreDeleteFuncCall is an object that describes an invocation of
+// method Delete on an instance of MockRepoStore.
+type RepoStoreDeleteFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c RepoStoreDeleteFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreDeleteFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RepoStoreDoneFunc describes the behavior when the Done method of the
+// parent MockRepoStore instance is invoked.
+type RepoStoreDoneFunc struct {
+	defaultHook func(error) error
+	hooks       []func(error) error
+	history     []RepoStoreDoneFuncCall
+	mutex       sync.Mutex
+}
+
+// Done delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) Done(v0 error) error {
+	r0 := m.DoneFunc.nextHook()(v0)
+	m.DoneFunc.appendCall(RepoStoreDoneFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Done method of the
+// parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreDoneFunc) SetDefaultHook(hook func(error) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Done method of the parent MockRepoStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *RepoStoreDoneFunc) PushHook(hook func(error) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreDoneFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(error) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreDoneFunc) PushReturn(r0 error) {
+	f.PushHook(func(error) error {
+		return r0
+	})
+}
+
+func (f *RepoStoreDoneFunc) nextHook() func(error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreDoneFunc) appendCall(r0 RepoStoreDoneFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreDoneFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStoreDoneFunc) History() []RepoStoreDoneFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreDoneFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreDoneFuncCall is an object that describes an invocation of method
+// Done on an instance of MockRepoStore.
+type RepoStoreDoneFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 error
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreDoneFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreDoneFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RepoStoreGetFunc describes the behavior when the Get method of the parent
+// MockRepoStore instance is invoked.
+type RepoStoreGetFunc struct {
+	defaultHook func(context.Context, api.RepoID) (*types.Repo, error)
+	hooks       []func(context.Context, api.RepoID) (*types.Repo, error)
+	history     []RepoStoreGetFuncCall
+	mutex       sync.Mutex
+}
+
+// Get delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) Get(v0 context.Context, v1 api.RepoID) (*types.Repo, error) {
+	r0, r1 := m.GetFunc.nextHook()(v0, v1)
+	m.GetFunc.appendCall(RepoStoreGetFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Get method of the
+// parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreGetFunc) SetDefaultHook(hook func(context.Context, api.RepoID) (*types.Repo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Get method of the parent MockRepoStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *RepoStoreGetFunc) PushHook(hook func(context.Context, api.RepoID) (*types.Repo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreGetFunc) SetDefaultReturn(r0 *types.Repo, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID) (*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreGetFunc) PushReturn(r0 *types.Repo, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID) (*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreGetFunc) nextHook() func(context.Context, api.RepoID) (*types.Repo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreGetFunc) appendCall(r0 RepoStoreGetFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreGetFuncCall objects describing the
+// invocations of this function.
+func (f *RepoStoreGetFunc) History() []RepoStoreGetFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreGetFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreGetFuncCall is an object that describes an invocation of method
+// Get on an instance of MockRepoStore.
+type RepoStoreGetFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *types.Repo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreGetFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreGetFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStoreGetByHashedNameFunc describes the behavior when the
+// GetByHashedName method of the parent MockRepoStore instance is invoked.
+type RepoStoreGetByHashedNameFunc struct {
+	defaultHook func(context.Context, api.RepoHashedName) (*types.Repo, error)
+	hooks       []func(context.Context, api.RepoHashedName) (*types.Repo, error)
+	history     []RepoStoreGetByHashedNameFuncCall
+	mutex       sync.Mutex
+}
+
+// GetByHashedName delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRepoStore) GetByHashedName(v0 context.Context, v1 api.RepoHashedName) (*types.Repo, error) {
+	r0, r1 := m.GetByHashedNameFunc.nextHook()(v0, v1)
+	m.GetByHashedNameFunc.appendCall(RepoStoreGetByHashedNameFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetByHashedName
+// method of the parent MockRepoStore instance is invoked and the hook queue
+// is empty.
+func (f *RepoStoreGetByHashedNameFunc) SetDefaultHook(hook func(context.Context, api.RepoHashedName) (*types.Repo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetByHashedName method of the parent MockRepoStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RepoStoreGetByHashedNameFunc) PushHook(hook func(context.Context, api.RepoHashedName) (*types.Repo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreGetByHashedNameFunc) SetDefaultReturn(r0 *types.Repo, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoHashedName) (*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreGetByHashedNameFunc) PushReturn(r0 *types.Repo, r1 error) {
+	f.PushHook(func(context.Context, api.RepoHashedName) (*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreGetByHashedNameFunc) nextHook() func(context.Context, api.RepoHashedName) (*types.Repo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreGetByHashedNameFunc) appendCall(r0 RepoStoreGetByHashedNameFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreGetByHashedNameFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStoreGetByHashedNameFunc) History() []RepoStoreGetByHashedNameFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreGetByHashedNameFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreGetByHashedNameFuncCall is an object that describes an
+// invocation of method GetByHashedName on an instance of MockRepoStore.
+type RepoStoreGetByHashedNameFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoHashedName
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *types.Repo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreGetByHashedNameFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreGetByHashedNameFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStoreGetByIDsFunc describes the behavior when the GetByIDs method of
+// the parent MockRepoStore instance is invoked.
+type RepoStoreGetByIDsFunc struct {
+	defaultHook func(context.Context, ...api.RepoID) ([]*types.Repo, error)
+	hooks       []func(context.Context, ...api.RepoID) ([]*types.Repo, error)
+	history     []RepoStoreGetByIDsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetByIDs delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) GetByIDs(v0 context.Context, v1 ...api.RepoID) ([]*types.Repo, error) {
+	r0, r1 := m.GetByIDsFunc.nextHook()(v0, v1...)
+	m.GetByIDsFunc.appendCall(RepoStoreGetByIDsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetByIDs method of
+// the parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreGetByIDsFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) ([]*types.Repo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetByIDs method of the parent MockRepoStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RepoStoreGetByIDsFunc) PushHook(hook func(context.Context, ...api.RepoID) ([]*types.Repo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreGetByIDsFunc) SetDefaultReturn(r0 []*types.Repo, r1 error) {
+	f.SetDefaultHook(func(context.Context, ...api.RepoID) ([]*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreGetByIDsFunc) PushReturn(r0 []*types.Repo, r1 error) {
+	f.PushHook(func(context.Context, ...api.RepoID) ([]*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreGetByIDsFunc) nextHook() func(context.Context, ...api.RepoID) ([]*types.Repo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreGetByIDsFunc) appendCall(r0 RepoStoreGetByIDsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreGetByIDsFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStoreGetByIDsFunc) History() []RepoStoreGetByIDsFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreGetByIDsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreGetByIDsFuncCall is an object that describes an invocation of
+// method GetByIDs on an instance of MockRepoStore.
+type RepoStoreGetByIDsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*types.Repo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c RepoStoreGetByIDsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreGetByIDsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStoreGetByNameFunc describes the behavior when the GetByName method
+// of the parent MockRepoStore instance is invoked.
+type RepoStoreGetByNameFunc struct {
+	defaultHook func(context.Context, api.RepoName) (*types.Repo, error)
+	hooks       []func(context.Context, api.RepoName) (*types.Repo, error)
+	history     []RepoStoreGetByNameFuncCall
+	mutex       sync.Mutex
+}
+
+// GetByName delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) GetByName(v0 context.Context, v1 api.RepoName) (*types.Repo, error) {
+	r0, r1 := m.GetByNameFunc.nextHook()(v0, v1)
+	m.GetByNameFunc.appendCall(RepoStoreGetByNameFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetByName method of
+// the parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreGetByNameFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*types.Repo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetByName method of the parent MockRepoStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RepoStoreGetByNameFunc) PushHook(hook func(context.Context, api.RepoName) (*types.Repo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreGetByNameFunc) SetDefaultReturn(r0 *types.Repo, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) (*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreGetByNameFunc) PushReturn(r0 *types.Repo, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) (*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreGetByNameFunc) nextHook() func(context.Context, api.RepoName) (*types.Repo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreGetByNameFunc) appendCall(r0 RepoStoreGetByNameFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreGetByNameFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStoreGetByNameFunc) History() []RepoStoreGetByNameFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreGetByNameFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreGetByNameFuncCall is an object that describes an invocation of
+// method GetByName on an instance of MockRepoStore.
+type RepoStoreGetByNameFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *types.Repo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreGetByNameFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
-// NewMockRepoStoreFrom creates a new mock of the MockRepoStore interface.
-// All methods delegate to the given implementation, unless overwritten.
-func NewMockRepoStoreFrom(i RepoStore) *MockRepoStore {
-	return &MockRepoStore{
-		CountFunc: &RepoStoreCountFunc{
-			defaultHook: i.Count,
-		},
-		CreateFunc: &RepoStoreCreateFunc{
-			defaultHook: i.Create,
-		},
-		DeleteFunc: &RepoStoreDeleteFunc{
-			defaultHook: i.Delete,
-		},
-		DoneFunc: &RepoStoreDoneFunc{
-			defaultHook: i.Done,
-		},
-		GetFunc: &RepoStoreGetFunc{
-			defaultHook: i.Get,
-		},
-		GetByHashedNameFunc: &RepoStoreGetByHashedNameFunc{
-			defaultHook: i.GetByHashedName,
-		},
-		GetByIDsFunc: &RepoStoreGetByIDsFunc{
-			defaultHook: i.GetByIDs,
-		},
-		GetByNameFunc: &RepoStoreGetByNameFunc{
-			defaultHook: i.GetByName,
-		},
-		GetFirstRepoByCloneURLFunc: &RepoStoreGetFirstRepoByCloneURLFunc{
-			defaultHook: i.GetFirstRepoByCloneURL,
-		},
-		GetFirstRepoNameByCloneURLFunc: &RepoStoreGetFirstRepoNameByCloneURLFunc{
-			defaultHook: i.GetFirstRepoNameByCloneURL,
-		},
-		GetRepoDescriptionsByIDsFunc: &RepoStoreGetRepoDescriptionsByIDsFunc{
-			defaultHook: i.GetRepoDescriptionsByIDs,
-		},
-		GetReposSetByIDsFunc: &RepoStoreGetReposSetByIDsFunc{
-			defaultHook: i.GetReposSetByIDs,
-		},
-		HandleFunc: &RepoStoreHandleFunc{
-			defaultHook: i.Handle,
-		},
-		ListFunc: &RepoStoreListFunc{
-			defaultHook: i.List,
-		},
-		ListMinimalReposFunc: &RepoStoreListMinimalReposFunc{
-			defaultHook: i.ListMinimalRepos,
-		},
-		ListSourcegraphDotComIndexableReposFunc: &RepoStoreListSourcegraphDotComIndexableReposFunc{
-			defaultHook: i.ListSourcegraphDotComIndexableRepos,
-		},
-		MetadataFunc: &RepoStoreMetadataFunc{
-			defaultHook: i.Metadata,
-		},
-		QueryFunc: &RepoStoreQueryFunc{
-			defaultHook: i.Query,
-		},
-		RepoEmbeddingExistsFunc: &RepoStoreRepoEmbeddingExistsFunc{
-			defaultHook: i.RepoEmbeddingExists,
-		},
-		StreamMinimalReposFunc: &RepoStoreStreamMinimalReposFunc{
-			defaultHook: i.StreamMinimalRepos,
-		},
-		TransactFunc: &RepoStoreTransactFunc{
-			defaultHook: i.Transact,
-		},
-		WithFunc: &RepoStoreWithFunc{
-			defaultHook: i.With,
-		},
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreGetByNameFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStoreGetFirstRepoByCloneURLFunc describes the behavior when the
+// GetFirstRepoByCloneURL method of the parent MockRepoStore instance is
+// invoked.
+type RepoStoreGetFirstRepoByCloneURLFunc struct {
+	defaultHook func(context.Context, string) (*types.Repo, error)
+	hooks       []func(context.Context, string) (*types.Repo, error)
+	history     []RepoStoreGetFirstRepoByCloneURLFuncCall
+	mutex       sync.Mutex
+}
+
+// GetFirstRepoByCloneURL delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockRepoStore) GetFirstRepoByCloneURL(v0 context.Context, v1 string) (*types.Repo, error) {
+	r0, r1 := m.GetFirstRepoByCloneURLFunc.nextHook()(v0, v1)
+	m.GetFirstRepoByCloneURLFunc.appendCall(RepoStoreGetFirstRepoByCloneURLFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetFirstRepoByCloneURL method of the parent MockRepoStore instance is
+// invoked and the hook queue is empty.
+func (f *RepoStoreGetFirstRepoByCloneURLFunc) SetDefaultHook(hook func(context.Context, string) (*types.Repo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetFirstRepoByCloneURL method of the parent MockRepoStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RepoStoreGetFirstRepoByCloneURLFunc) PushHook(hook func(context.Context, string) (*types.Repo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreGetFirstRepoByCloneURLFunc) SetDefaultReturn(r0 *types.Repo, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreGetFirstRepoByCloneURLFunc) PushReturn(r0 *types.Repo, r1 error) {
+	f.PushHook(func(context.Context, string) (*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreGetFirstRepoByCloneURLFunc) nextHook() func(context.Context, string) (*types.Repo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
 	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
 }
 
-// RepoStoreCountFunc describes the behavior when the Count method of the
-// parent MockRepoStore instance is invoked.
-type RepoStoreCountFunc struct {
-	defaultHook func(context.Context, ReposListOptions) (int, error)
-	hooks       []func(context.Context, ReposListOptions) (int, error)
-	history     []RepoStoreCountFuncCall
+func (f *RepoStoreGetFirstRepoByCloneURLFunc) appendCall(r0 RepoStoreGetFirstRepoByCloneURLFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreGetFirstRepoByCloneURLFuncCall
+// objects describing the invocations of this function.
+func (f *RepoStoreGetFirstRepoByCloneURLFunc) History() []RepoStoreGetFirstRepoByCloneURLFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreGetFirstRepoByCloneURLFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreGetFirstRepoByCloneURLFuncCall is an object that describes an
+// invocation of method GetFirstRepoByCloneURL on an instance of
+// MockRepoStore.
+type RepoStoreGetFirstRepoByCloneURLFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *types.Repo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreGetFirstRepoByCloneURLFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreGetFirstRepoByCloneURLFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStoreGetFirstRepoNameByCloneURLFunc describes the behavior when the
+// GetFirstRepoNameByCloneURL method of the parent MockRepoStore instance is
+// invoked.
+type RepoStoreGetFirstRepoNameByCloneURLFunc struct {
+	defaultHook func(context.Context, string) (api.RepoName, error)
+	hooks       []func(context.Context, string) (api.RepoName, error)
+	history     []RepoStoreGetFirstRepoNameByCloneURLFuncCall
 	mutex       sync.Mutex
 }
 
-// Count delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStore) Count(v0 context.Context, v1 ReposListOptions) (int, error) {
-	r0, r1 := m.CountFunc.nextHook()(v0, v1)
-	m.CountFunc.appendCall(RepoStoreCountFuncCall{v0, v1, r0, r1})
+// GetFirstRepoNameByCloneURL delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockRepoStore) GetFirstRepoNameByCloneURL(v0 context.Context, v1 string) (api.RepoName, error) {
+	r0, r1 := m.GetFirstRepoNameByCloneURLFunc.nextHook()(v0, v1)
+	m.GetFirstRepoNameByCloneURLFunc.appendCall(RepoStoreGetFirstRepoNameByCloneURLFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Count method of the
-// parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreCountFunc) SetDefaultHook(hook func(context.Context, ReposListOptions) (int, error)) {
+// SetDefaultHook sets function that is called when the
+// GetFirstRepoNameByCloneURL method of the parent MockRepoStore instance is
+// invoked and the hook queue is empty.
+func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) SetDefaultHook(hook func(context.Context, string) (api.RepoName, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Count method of the parent MockRepoStore instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *RepoStoreCountFunc) PushHook(hook func(context.Context, ReposListOptions) (int, error)) {
+// GetFirstRepoNameByCloneURL method of the parent MockRepoStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) PushHook(hook func(context.Context, string) (api.RepoName, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -46798,20 +48769,20 @@ func (f *RepoStoreCountFunc) PushHook(hook func(context.Context, ReposListOption
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreCountFunc) SetDefaultReturn(r0 int, r1 error) {
-	f.SetDefaultHook(func(context.Context, ReposListOptions) (int, error) {
+func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) SetDefaultReturn(r0 api.RepoName, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (api.RepoName, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreCountFunc) PushReturn(r0 int, r1 error) {
-	f.PushHook(func(context.Context, ReposListOptions) (int, error) {
+func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) PushReturn(r0 api.RepoName, r1 error) {
+	f.PushHook(func(context.Context, string) (api.RepoName, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStoreCountFunc) nextHook() func(context.Context, ReposListOptions) (int, error) {
+func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) nextHook() func(context.Context, string) (api.RepoName, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -46824,35 +48795,36 @@ func (f *RepoStoreCountFunc) nextHook() func(context.Context, ReposListOptions)
 	return hook
 }
 
-func (f *RepoStoreCountFunc) appendCall(r0 RepoStoreCountFuncCall) {
+func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) appendCall(r0 RepoStoreGetFirstRepoNameByCloneURLFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreCountFuncCall objects describing
-// the invocations of this function.
-func (f *RepoStoreCountFunc) History() []RepoStoreCountFuncCall {
+// History returns a sequence of RepoStoreGetFirstRepoNameByCloneURLFuncCall
+// objects describing the invocations of this function.
+func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) History() []RepoStoreGetFirstRepoNameByCloneURLFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreCountFuncCall, len(f.history))
+	history := make([]RepoStoreGetFirstRepoNameByCloneURLFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreCountFuncCall is an object that describes an invocation of
-// method Count on an instance of MockRepoStore.
-type RepoStoreCountFuncCall struct {
+// RepoStoreGetFirstRepoNameByCloneURLFuncCall is an object that describes
+// an invocation of method GetFirstRepoNameByCloneURL on an instance of
+// MockRepoStore.
+type RepoStoreGetFirstRepoNameByCloneURLFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 ReposListOptions
+	Arg1 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 int
+	Result0 api.RepoName
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -46860,44 +48832,47 @@ type RepoStoreCountFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreCountFuncCall) Args() []interface{} {
+func (c RepoStoreGetFirstRepoNameByCloneURLFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreCountFuncCall) Results() []interface{} {
+func (c RepoStoreGetFirstRepoNameByCloneURLFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreCreateFunc describes the behavior when the Create method of the
-// parent MockRepoStore instance is invoked.
-type RepoStoreCreateFunc struct {
-	defaultHook func(context.Context, ...*types.Repo) error
-	hooks       []func(context.Context, ...*types.Repo) error
-	history     []RepoStoreCreateFuncCall
+// RepoStoreGetRepoDescriptionsByIDsFunc describes the behavior when the
+// GetRepoDescriptionsByIDs method of the parent MockRepoStore instance is
+// invoked.
+type RepoStoreGetRepoDescriptionsByIDsFunc struct {
+	defaultHook func(context.Context, ...api.RepoID) (map[api.RepoID]string, error)
+	hooks       []func(context.Context, ...api.RepoID) (map[api.RepoID]string, error)
+	history     []RepoStoreGetRepoDescriptionsByIDsFuncCall
 	mutex       sync.Mutex
 }
 
-// Create delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStore) Create(v0 context.Context, v1 ...*types.Repo) error {
-	r0 := m.CreateFunc.nextHook()(v0, v1...)
-	m.CreateFunc.appendCall(RepoStoreCreateFuncCall{v0, v1, r0})
-	return r0
+// GetRepoDescriptionsByIDs delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockRepoStore) GetRepoDescriptionsByIDs(v0 context.Context, v1 ...api.RepoID) (map[api.RepoID]string, error) {
+	r0, r1 := m.GetRepoDescriptionsByIDsFunc.nextHook()(v0, v1...)
+	m.GetRepoDescriptionsByIDsFunc.appendCall(RepoStoreGetRepoDescriptionsByIDsFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Create method of the
-// parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreCreateFunc) SetDefaultHook(hook func(context.Context, ...*types.Repo) error) {
+// SetDefaultHook sets function that is called when the
+// GetRepoDescriptionsByIDs method of the parent MockRepoStore instance is
+// invoked and the hook queue is empty.
+func (f *RepoStoreGetRepoDescriptionsByIDsFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) (map[api.RepoID]string, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Create method of the parent MockRepoStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *RepoStoreCreateFunc) PushHook(hook func(context.Context, ...*types.Repo) error) {
+// GetRepoDescriptionsByIDs method of the parent MockRepoStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RepoStoreGetRepoDescriptionsByIDsFunc) PushHook(hook func(context.Context, ...api.RepoID) (map[api.RepoID]string, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -46905,20 +48880,20 @@ func (f *RepoStoreCreateFunc) PushHook(hook func(context.Context, ...*types.Repo
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreCreateFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, ...*types.Repo) error {
-		return r0
+func (f *RepoStoreGetRepoDescriptionsByIDsFunc) SetDefaultReturn(r0 map[api.RepoID]string, r1 error) {
+	f.SetDefaultHook(func(context.Context, ...api.RepoID) (map[api.RepoID]string, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreCreateFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, ...*types.Repo) error {
-		return r0
+func (f *RepoStoreGetRepoDescriptionsByIDsFunc) PushReturn(r0 map[api.RepoID]string, r1 error) {
+	f.PushHook(func(context.Context, ...api.RepoID) (map[api.RepoID]string, error) {
+		return r0, r1
 	})
 }
 
-func (f *RepoStoreCreateFunc) nextHook() func(context.Context, ...*types.Repo) error {
+func (f *RepoStoreGetRepoDescriptionsByIDsFunc) nextHook() func(context.Context, ...api.RepoID) (map[api.RepoID]string, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -46931,42 +48906,46 @@ func (f *RepoStoreCreateFunc) nextHook() func(context.Context, ...*types.Repo) e
 	return hook
 }
 
-func (f *RepoStoreCreateFunc) appendCall(r0 RepoStoreCreateFuncCall) {
+func (f *RepoStoreGetRepoDescriptionsByIDsFunc) appendCall(r0 RepoStoreGetRepoDescriptionsByIDsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreCreateFuncCall objects describing
-// the invocations of this function.
-func (f *RepoStoreCreateFunc) History() []RepoStoreCreateFuncCall {
+// History returns a sequence of RepoStoreGetRepoDescriptionsByIDsFuncCall
+// objects describing the invocations of this function.
+func (f *RepoStoreGetRepoDescriptionsByIDsFunc) History() []RepoStoreGetRepoDescriptionsByIDsFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreCreateFuncCall, len(f.history))
+	history := make([]RepoStoreGetRepoDescriptionsByIDsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreCreateFuncCall is an object that describes an invocation of
-// method Create on an instance of MockRepoStore.
-type RepoStoreCreateFuncCall struct {
+// RepoStoreGetRepoDescriptionsByIDsFuncCall is an object that describes an
+// invocation of method GetRepoDescriptionsByIDs on an instance of
+// MockRepoStore.
+type RepoStoreGetRepoDescriptionsByIDsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is a slice containing the values of the variadic arguments
 	// passed to this method invocation.
-	Arg1 []*types.Repo
+	Arg1 []api.RepoID
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 map[api.RepoID]string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c RepoStoreCreateFuncCall) Args() []interface{} {
+func (c RepoStoreGetRepoDescriptionsByIDsFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
 	for _, val := range c.Arg1 {
 		trailing = append(trailing, val)
@@ -46977,38 +48956,39 @@ func (c RepoStoreCreateFuncCall) Args() []interface{} {
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreCreateFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c RepoStoreGetRepoDescriptionsByIDsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreDeleteFunc describes the behavior when the Delete method of the
-// parent MockRepoStore instance is invoked.
-type RepoStoreDeleteFunc struct {
-	defaultHook func(context.Context, ...api.RepoID) error
-	hooks       []func(context.Context, ...api.RepoID) error
-	history     []RepoStoreDeleteFuncCall
+// RepoStoreGetReposSetByIDsFunc describes the behavior when the
+// GetReposSetByIDs method of the parent MockRepoStore instance is invoked.
+type RepoStoreGetReposSetByIDsFunc struct {
+	defaultHook func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error)
+	hooks       []func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error)
+	history     []RepoStoreGetReposSetByIDsFuncCall
 	mutex       sync.Mutex
 }
 
-// Delete delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStore) Delete(v0 context.Context, v1 ...api.RepoID) error {
-	r0 := m.DeleteFunc.nextHook()(v0, v1...)
-	m.DeleteFunc.appendCall(RepoStoreDeleteFuncCall{v0, v1, r0})
-	return r0
+// GetReposSetByIDs delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRepoStore) GetReposSetByIDs(v0 context.Context, v1 ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
+	r0, r1 := m.GetReposSetByIDsFunc.nextHook()(v0, v1...)
+	m.GetReposSetByIDsFunc.appendCall(RepoStoreGetReposSetByIDsFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Delete method of the
-// parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreDeleteFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) error) {
+// SetDefaultHook sets function that is called when the GetReposSetByIDs
+// method of the parent MockRepoStore instance is invoked and the hook queue
+// is empty.
+func (f *RepoStoreGetReposSetByIDsFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Delete method of the parent MockRepoStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *RepoStoreDeleteFunc) PushHook(hook func(context.Context, ...api.RepoID) error) {
+// GetReposSetByIDs method of the parent MockRepoStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RepoStoreGetReposSetByIDsFunc) PushHook(hook func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -47016,20 +48996,20 @@ func (f *RepoStoreDeleteFunc) PushHook(hook func(context.Context, ...api.RepoID)
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreDeleteFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, ...api.RepoID) error {
-		return r0
+func (f *RepoStoreGetReposSetByIDsFunc) SetDefaultReturn(r0 map[api.RepoID]*types.Repo, r1 error) {
+	f.SetDefaultHook(func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreDeleteFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, ...api.RepoID) error {
-		return r0
+func (f *RepoStoreGetReposSetByIDsFunc) PushReturn(r0 map[api.RepoID]*types.Repo, r1 error) {
+	f.PushHook(func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
+		return r0, r1
 	})
 }
 
-func (f *RepoStoreDeleteFunc) nextHook() func(context.Context, ...api.RepoID) error {
+func (f *RepoStoreGetReposSetByIDsFunc) nextHook() func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -47042,26 +49022,26 @@ func (f *RepoStoreDeleteFunc) nextHook() func(context.Context, ...api.RepoID) er
 	return hook
 }
 
-func (f *RepoStoreDeleteFunc) appendCall(r0 RepoStoreDeleteFuncCall) {
+func (f *RepoStoreGetReposSetByIDsFunc) appendCall(r0 RepoStoreGetReposSetByIDsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreDeleteFuncCall objects describing
-// the invocations of this function.
-func (f *RepoStoreDeleteFunc) History() []RepoStoreDeleteFuncCall {
+// History returns a sequence of RepoStoreGetReposSetByIDsFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStoreGetReposSetByIDsFunc) History() []RepoStoreGetReposSetByIDsFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreDeleteFuncCall, len(f.history))
+	history := make([]RepoStoreGetReposSetByIDsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreDeleteFuncCall is an object that describes an invocation of
-// method Delete on an instance of MockRepoStore.
-type RepoStoreDeleteFuncCall struct {
+// RepoStoreGetReposSetByIDsFuncCall is an object that describes an
+// invocation of method GetReposSetByIDs on an instance of MockRepoStore.
+type RepoStoreGetReposSetByIDsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -47070,14 +49050,17 @@ type RepoStoreDeleteFuncCall struct {
 	Arg1 []api.RepoID
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 map[api.RepoID]*types.Repo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c RepoStoreDeleteFuncCall) Args() []interface{} {
+func (c RepoStoreGetReposSetByIDsFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
 	for _, val := range c.Arg1 {
 		trailing = append(trailing, val)
@@ -47088,38 +49071,38 @@ func (c RepoStoreDeleteFuncCall) Args() []interface{} {
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreDeleteFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c RepoStoreGetReposSetByIDsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreDoneFunc describes the behavior when the Done method of the
+// RepoStoreHandleFunc describes the behavior when the Handle method of the
 // parent MockRepoStore instance is invoked.
-type RepoStoreDoneFunc struct {
-	defaultHook func(error) error
-	hooks       []func(error) error
-	history     []RepoStoreDoneFuncCall
+type RepoStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []RepoStoreHandleFuncCall
 	mutex       sync.Mutex
 }
 
-// Done delegates to the next hook function in the queue and stores the
+// Handle delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRepoStore) Done(v0 error) error {
-	r0 := m.DoneFunc.nextHook()(v0)
-	m.DoneFunc.appendCall(RepoStoreDoneFuncCall{v0, r0})
+func (m *MockRepoStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(RepoStoreHandleFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Done method of the
+// SetDefaultHook sets function that is called when the Handle method of the
 // parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreDoneFunc) SetDefaultHook(hook func(error) error) {
+func (f *RepoStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Done method of the parent MockRepoStore instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *RepoStoreDoneFunc) PushHook(hook func(error) error) {
+// Handle method of the parent MockRepoStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RepoStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -47127,20 +49110,20 @@ func (f *RepoStoreDoneFunc) PushHook(hook func(error) error) {
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreDoneFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(error) error {
+func (f *RepoStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreDoneFunc) PushReturn(r0 error) {
-	f.PushHook(func(error) error {
+func (f *RepoStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
-func (f *RepoStoreDoneFunc) nextHook() func(error) error {
+func (f *RepoStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -47153,74 +49136,71 @@ func (f *RepoStoreDoneFunc) nextHook() func(error) error {
 	return hook
 }
 
-func (f *RepoStoreDoneFunc) appendCall(r0 RepoStoreDoneFuncCall) {
+func (f *RepoStoreHandleFunc) appendCall(r0 RepoStoreHandleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreDoneFuncCall objects describing
+// History returns a sequence of RepoStoreHandleFuncCall objects describing
 // the invocations of this function.
-func (f *RepoStoreDoneFunc) History() []RepoStoreDoneFuncCall {
+func (f *RepoStoreHandleFunc) History() []RepoStoreHandleFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreDoneFuncCall, len(f.history))
+	history := make([]RepoStoreHandleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreDoneFuncCall is an object that describes an invocation of method
-// Done on an instance of MockRepoStore.
-type RepoStoreDoneFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 error
+// RepoStoreHandleFuncCall is an object that describes an invocation of
+// method Handle on an instance of MockRepoStore.
+type RepoStoreHandleFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 basestore.TransactableHandle
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreDoneFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c RepoStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreDoneFuncCall) Results() []interface{} {
+func (c RepoStoreHandleFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RepoStoreGetFunc describes the behavior when the Get method of the parent
-// MockRepoStore instance is invoked.
-type RepoStoreGetFunc struct {
-	defaultHook func(context.Context, api.RepoID) (*types.Repo, error)
-	hooks       []func(context.Context, api.RepoID) (*types.Repo, error)
-	history     []RepoStoreGetFuncCall
+// RepoStoreListFunc describes the behavior when the List method of the
+// parent MockRepoStore instance is invoked.
+type RepoStoreListFunc struct {
+	defaultHook func(context.Context, ReposListOptions) ([]*types.Repo, error)
+	hooks       []func(context.Context, ReposListOptions) ([]*types.Repo, error)
+	history     []RepoStoreListFuncCall
 	mutex       sync.Mutex
 }
 
-// Get delegates to the next hook function in the queue and stores the
+// List delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRepoStore) Get(v0 context.Context, v1 api.RepoID) (*types.Repo, error) {
-	r0, r1 := m.GetFunc.nextHook()(v0, v1)
-	m.GetFunc.appendCall(RepoStoreGetFuncCall{v0, v1, r0, r1})
+func (m *MockRepoStore) List(v0 context.Context, v1 ReposListOptions) ([]*types.Repo, error) {
+	r0, r1 := m.ListFunc.nextHook()(v0, v1)
+	m.ListFunc.appendCall(RepoStoreListFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Get method of the
+// SetDefaultHook sets function that is called when the List method of the
 // parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreGetFunc) SetDefaultHook(hook func(context.Context, api.RepoID) (*types.Repo, error)) {
+func (f *RepoStoreListFunc) SetDefaultHook(hook func(context.Context, ReposListOptions) ([]*types.Repo, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Get method of the parent MockRepoStore instance invokes the hook at the
+// List method of the parent MockRepoStore instance invokes the hook at the
 // front of the queue and discards it. After the queue is empty, the default
 // hook function is invoked for any future action.
-func (f *RepoStoreGetFunc) PushHook(hook func(context.Context, api.RepoID) (*types.Repo, error)) {
+func (f *RepoStoreListFunc) PushHook(hook func(context.Context, ReposListOptions) ([]*types.Repo, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -47228,20 +49208,20 @@ func (f *RepoStoreGetFunc) PushHook(hook func(context.Context, api.RepoID) (*typ
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreGetFunc) SetDefaultReturn(r0 *types.Repo, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoID) (*types.Repo, error) {
+func (f *RepoStoreListFunc) SetDefaultReturn(r0 []*types.Repo, r1 error) {
+	f.SetDefaultHook(func(context.Context, ReposListOptions) ([]*types.Repo, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreGetFunc) PushReturn(r0 *types.Repo, r1 error) {
-	f.PushHook(func(context.Context, api.RepoID) (*types.Repo, error) {
+func (f *RepoStoreListFunc) PushReturn(r0 []*types.Repo, r1 error) {
+	f.PushHook(func(context.Context, ReposListOptions) ([]*types.Repo, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStoreGetFunc) nextHook() func(context.Context, api.RepoID) (*types.Repo, error) {
+func (f *RepoStoreListFunc) nextHook() func(context.Context, ReposListOptions) ([]*types.Repo, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -47254,35 +49234,35 @@ func (f *RepoStoreGetFunc) nextHook() func(context.Context, api.RepoID) (*types.
 	return hook
 }
 
-func (f *RepoStoreGetFunc) appendCall(r0 RepoStoreGetFuncCall) {
+func (f *RepoStoreListFunc) appendCall(r0 RepoStoreListFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreGetFuncCall objects describing the
-// invocations of this function.
-func (f *RepoStoreGetFunc) History() []RepoStoreGetFuncCall {
+// History returns a sequence of RepoStoreListFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStoreListFunc) History() []RepoStoreListFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreGetFuncCall, len(f.history))
+	history := make([]RepoStoreListFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreGetFuncCall is an object that describes an invocation of method
-// Get on an instance of MockRepoStore.
-type RepoStoreGetFuncCall struct {
+// RepoStoreListFuncCall is an object that describes an invocation of method
+// List on an instance of MockRepoStore.
+type RepoStoreListFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 api.RepoID
+	Arg1 ReposListOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.Repo
+	Result0 []*types.Repo
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -47290,45 +49270,45 @@ type RepoStoreGetFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreGetFuncCall) Args() []interface{} {
+func (c RepoStoreListFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreGetFuncCall) Results() []interface{} {
+func (c RepoStoreListFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreGetByHashedNameFunc describes the behavior when the
-// GetByHashedName method of the parent MockRepoStore instance is invoked.
-type RepoStoreGetByHashedNameFunc struct {
-	defaultHook func(context.Context, api.RepoHashedName) (*types.Repo, error)
-	hooks       []func(context.Context, api.RepoHashedName) (*types.Repo, error)
-	history     []RepoStoreGetByHashedNameFuncCall
+// RepoStoreListMinimalReposFunc describes the behavior when the
+// ListMinimalRepos method of the parent MockRepoStore instance is invoked.
+type RepoStoreListMinimalReposFunc struct {
+	defaultHook func(context.Context, ReposListOptions) ([]types.MinimalRepo, error)
+	hooks       []func(context.Context, ReposListOptions) ([]types.MinimalRepo, error)
+	history     []RepoStoreListMinimalReposFuncCall
 	mutex       sync.Mutex
 }
 
-// GetByHashedName delegates to the next hook function in the queue and
+// ListMinimalRepos delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockRepoStore) GetByHashedName(v0 context.Context, v1 api.RepoHashedName) (*types.Repo, error) {
-	r0, r1 := m.GetByHashedNameFunc.nextHook()(v0, v1)
-	m.GetByHashedNameFunc.appendCall(RepoStoreGetByHashedNameFuncCall{v0, v1, r0, r1})
+func (m *MockRepoStore) ListMinimalRepos(v0 context.Context, v1 ReposListOptions) ([]types.MinimalRepo, error) {
+	r0, r1 := m.ListMinimalReposFunc.nextHook()(v0, v1)
+	m.ListMinimalReposFunc.appendCall(RepoStoreListMinimalReposFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetByHashedName
+// SetDefaultHook sets function that is called when the ListMinimalRepos
 // method of the parent MockRepoStore instance is invoked and the hook queue
 // is empty.
-func (f *RepoStoreGetByHashedNameFunc) SetDefaultHook(hook func(context.Context, api.RepoHashedName) (*types.Repo, error)) {
+func (f *RepoStoreListMinimalReposFunc) SetDefaultHook(hook func(context.Context, ReposListOptions) ([]types.MinimalRepo, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetByHashedName method of the parent MockRepoStore instance invokes the
+// ListMinimalRepos method of the parent MockRepoStore instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *RepoStoreGetByHashedNameFunc) PushHook(hook func(context.Context, api.RepoHashedName) (*types.Repo, error)) {
+func (f *RepoStoreListMinimalReposFunc) PushHook(hook func(context.Context, ReposListOptions) ([]types.MinimalRepo, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -47336,20 +49316,20 @@ func (f *RepoStoreGetByHashedNameFunc) PushHook(hook func(context.Context, api.R
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreGetByHashedNameFunc) SetDefaultReturn(r0 *types.Repo, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoHashedName) (*types.Repo, error) {
+func (f *RepoStoreListMinimalReposFunc) SetDefaultReturn(r0 []types.MinimalRepo, r1 error) {
+	f.SetDefaultHook(func(context.Context, ReposListOptions) ([]types.MinimalRepo, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreGetByHashedNameFunc) PushReturn(r0 *types.Repo, r1 error) {
-	f.PushHook(func(context.Context, api.RepoHashedName) (*types.Repo, error) {
+func (f *RepoStoreListMinimalReposFunc) PushReturn(r0 []types.MinimalRepo, r1 error) {
+	f.PushHook(func(context.Context, ReposListOptions) ([]types.MinimalRepo, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStoreGetByHashedNameFunc) nextHook() func(context.Context, api.RepoHashedName) (*types.Repo, error) {
+func (f *RepoStoreListMinimalReposFunc) nextHook() func(context.Context, ReposListOptions) ([]types.MinimalRepo, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -47362,35 +49342,35 @@ func (f *RepoStoreGetByHashedNameFunc) nextHook() func(context.Context, api.Repo
 	return hook
 }
 
-func (f *RepoStoreGetByHashedNameFunc) appendCall(r0 RepoStoreGetByHashedNameFuncCall) {
+func (f *RepoStoreListMinimalReposFunc) appendCall(r0 RepoStoreListMinimalReposFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreGetByHashedNameFuncCall objects
+// History returns a sequence of RepoStoreListMinimalReposFuncCall objects
 // describing the invocations of this function.
-func (f *RepoStoreGetByHashedNameFunc) History() []RepoStoreGetByHashedNameFuncCall {
+func (f *RepoStoreListMinimalReposFunc) History() []RepoStoreListMinimalReposFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreGetByHashedNameFuncCall, len(f.history))
+	history := make([]RepoStoreListMinimalReposFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreGetByHashedNameFuncCall is an object that describes an
-// invocation of method GetByHashedName on an instance of MockRepoStore.
-type RepoStoreGetByHashedNameFuncCall struct {
+// RepoStoreListMinimalReposFuncCall is an object that describes an
+// invocation of method ListMinimalRepos on an instance of MockRepoStore.
+type RepoStoreListMinimalReposFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 api.RepoHashedName
+	Arg1 ReposListOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.Repo
+	Result0 []types.MinimalRepo
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -47398,44 +49378,48 @@ type RepoStoreGetByHashedNameFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreGetByHashedNameFuncCall) Args() []interface{} {
+func (c RepoStoreListMinimalReposFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreGetByHashedNameFuncCall) Results() []interface{} {
+func (c RepoStoreListMinimalReposFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreGetByIDsFunc describes the behavior when the GetByIDs method of
-// the parent MockRepoStore instance is invoked.
-type RepoStoreGetByIDsFunc struct {
-	defaultHook func(context.Context, ...api.RepoID) ([]*types.Repo, error)
-	hooks       []func(context.Context, ...api.RepoID) ([]*types.Repo, error)
-	history     []RepoStoreGetByIDsFuncCall
+// RepoStoreListSourcegraphDotComIndexableReposFunc describes the behavior
+// when the ListSourcegraphDotComIndexableRepos method of the parent
+// MockRepoStore instance is invoked.
+type RepoStoreListSourcegraphDotComIndexableReposFunc struct {
+	defaultHook func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error)
+	hooks       []func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error)
+	history     []RepoStoreListSourcegraphDotComIndexableReposFuncCall
 	mutex       sync.Mutex
 }
 
-// GetByIDs delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStore) GetByIDs(v0 context.Context, v1 ...api.RepoID) ([]*types.Repo, error) {
-	r0, r1 := m.GetByIDsFunc.nextHook()(v0, v1...)
-	m.GetByIDsFunc.appendCall(RepoStoreGetByIDsFuncCall{v0, v1, r0, r1})
+// ListSourcegraphDotComIndexableRepos delegates to the next hook function
+// in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockRepoStore) ListSourcegraphDotComIndexableRepos(v0 context.Context, v1 ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
+	r0, r1 := m.ListSourcegraphDotComIndexableReposFunc.nextHook()(v0, v1)
+	m.ListSourcegraphDotComIndexableReposFunc.appendCall(RepoStoreListSourcegraphDotComIndexableReposFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetByIDs method of
-// the parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreGetByIDsFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) ([]*types.Repo, error)) {
+// SetDefaultHook sets function that is called when the
+// ListSourcegraphDotComIndexableRepos method of the parent MockRepoStore
+// instance is invoked and the hook queue is empty.
+func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) SetDefaultHook(hook func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetByIDs method of the parent MockRepoStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *RepoStoreGetByIDsFunc) PushHook(hook func(context.Context, ...api.RepoID) ([]*types.Repo, error)) {
+// ListSourcegraphDotComIndexableRepos method of the parent MockRepoStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) PushHook(hook func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -47443,20 +49427,20 @@ func (f *RepoStoreGetByIDsFunc) PushHook(hook func(context.Context, ...api.RepoI
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreGetByIDsFunc) SetDefaultReturn(r0 []*types.Repo, r1 error) {
-	f.SetDefaultHook(func(context.Context, ...api.RepoID) ([]*types.Repo, error) {
+func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) SetDefaultReturn(r0 []types.MinimalRepo, r1 error) {
+	f.SetDefaultHook(func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreGetByIDsFunc) PushReturn(r0 []*types.Repo, r1 error) {
-	f.PushHook(func(context.Context, ...api.RepoID) ([]*types.Repo, error) {
+func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) PushReturn(r0 []types.MinimalRepo, r1 error) {
+	f.PushHook(func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStoreGetByIDsFunc) nextHook() func(context.Context, ...api.RepoID) ([]*types.Repo, error) {
+func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) nextHook() func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -47469,87 +49453,82 @@ func (f *RepoStoreGetByIDsFunc) nextHook() func(context.Context, ...api.RepoID)
 	return hook
 }
 
-func (f *RepoStoreGetByIDsFunc) appendCall(r0 RepoStoreGetByIDsFuncCall) {
+func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) appendCall(r0 RepoStoreListSourcegraphDotComIndexableReposFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreGetByIDsFuncCall objects
-// describing the invocations of this function.
-func (f *RepoStoreGetByIDsFunc) History() []RepoStoreGetByIDsFuncCall {
+// History returns a sequence of
+// RepoStoreListSourcegraphDotComIndexableReposFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) History() []RepoStoreListSourcegraphDotComIndexableReposFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreGetByIDsFuncCall, len(f.history))
+	history := make([]RepoStoreListSourcegraphDotComIndexableReposFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreGetByIDsFuncCall is an object that describes an invocation of
-// method GetByIDs on an instance of MockRepoStore.
-type RepoStoreGetByIDsFuncCall struct {
+// RepoStoreListSourcegraphDotComIndexableReposFuncCall is an object that
+// describes an invocation of method ListSourcegraphDotComIndexableRepos on
+// an instance of MockRepoStore.
+type RepoStoreListSourcegraphDotComIndexableReposFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is a slice containing the values of the variadic arguments
-	// passed to this method invocation.
-	Arg1 []api.RepoID
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 ListSourcegraphDotComIndexableReposOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*types.Repo
+	Result0 []types.MinimalRepo
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation. The variadic slice argument is flattened in this array such
-// that one positional argument and three variadic arguments would result in
-// a slice of four, not two.
-func (c RepoStoreGetByIDsFuncCall) Args() []interface{} {
-	trailing := []interface{}{}
-	for _, val := range c.Arg1 {
-		trailing = append(trailing, val)
-	}
-
-	return append([]interface{}{c.Arg0}, trailing...)
+// invocation.
+func (c RepoStoreListSourcegraphDotComIndexableReposFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreGetByIDsFuncCall) Results() []interface{} {
+func (c RepoStoreListSourcegraphDotComIndexableReposFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreGetByNameFunc describes the behavior when the GetByName method
-// of the parent MockRepoStore instance is invoked.
-type RepoStoreGetByNameFunc struct {
-	defaultHook func(context.Context, api.RepoName) (*types.Repo, error)
-	hooks       []func(context.Context, api.RepoName) (*types.Repo, error)
-	history     []RepoStoreGetByNameFuncCall
+// RepoStoreMetadataFunc describes the behavior when the Metadata method of
+// the parent MockRepoStore instance is invoked.
+type RepoStoreMetadataFunc struct {
+	defaultHook func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error)
+	hooks       []func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error)
+	history     []RepoStoreMetadataFuncCall
 	mutex       sync.Mutex
 }
 
-// GetByName delegates to the next hook function in the queue and stores the
+// Metadata delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRepoStore) GetByName(v0 context.Context, v1 api.RepoName) (*types.Repo, error) {
-	r0, r1 := m.GetByNameFunc.nextHook()(v0, v1)
-	m.GetByNameFunc.appendCall(RepoStoreGetByNameFuncCall{v0, v1, r0, r1})
+func (m *MockRepoStore) Metadata(v0 context.Context, v1 ...api.RepoID) ([]*types.SearchedRepo, error) {
+	r0, r1 := m.MetadataFunc.nextHook()(v0, v1...)
+	m.MetadataFunc.appendCall(RepoStoreMetadataFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetByName method of
+// SetDefaultHook sets function that is called when the Metadata method of
 // the parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreGetByNameFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*types.Repo, error)) {
+func (f *RepoStoreMetadataFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetByName method of the parent MockRepoStore instance invokes the hook at
+// Metadata method of the parent MockRepoStore instance invokes the hook at
 // the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *RepoStoreGetByNameFunc) PushHook(hook func(context.Context, api.RepoName) (*types.Repo, error)) {
+func (f *RepoStoreMetadataFunc) PushHook(hook func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -47557,20 +49536,20 @@ func (f *RepoStoreGetByNameFunc) PushHook(hook func(context.Context, api.RepoNam
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreGetByNameFunc) SetDefaultReturn(r0 *types.Repo, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName) (*types.Repo, error) {
+func (f *RepoStoreMetadataFunc) SetDefaultReturn(r0 []*types.SearchedRepo, r1 error) {
+	f.SetDefaultHook(func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreGetByNameFunc) PushReturn(r0 *types.Repo, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName) (*types.Repo, error) {
+func (f *RepoStoreMetadataFunc) PushReturn(r0 []*types.SearchedRepo, r1 error) {
+	f.PushHook(func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStoreGetByNameFunc) nextHook() func(context.Context, api.RepoName) (*types.Repo, error) {
+func (f *RepoStoreMetadataFunc) nextHook() func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -47583,194 +49562,87 @@ func (f *RepoStoreGetByNameFunc) nextHook() func(context.Context, api.RepoName)
 	return hook
 }
 
-func (f *RepoStoreGetByNameFunc) appendCall(r0 RepoStoreGetByNameFuncCall) {
+func (f *RepoStoreMetadataFunc) appendCall(r0 RepoStoreMetadataFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreGetByNameFuncCall objects
+// History returns a sequence of RepoStoreMetadataFuncCall objects
 // describing the invocations of this function.
-func (f *RepoStoreGetByNameFunc) History() []RepoStoreGetByNameFuncCall {
+func (f *RepoStoreMetadataFunc) History() []RepoStoreMetadataFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreGetByNameFuncCall, len(f.history))
+	history := make([]RepoStoreMetadataFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreGetByNameFuncCall is an object that describes an invocation of
-// method GetByName on an instance of MockRepoStore.
-type RepoStoreGetByNameFuncCall struct {
+// RepoStoreMetadataFuncCall is an object that describes an invocation of
+// method Metadata on an instance of MockRepoStore.
+type RepoStoreMetadataFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 api.RepoName
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []api.RepoID
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.Repo
+	Result0 []*types.SearchedRepo
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation.
-func (c RepoStoreGetByNameFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
-}
-
-// Results returns an interface slice containing the results of this
-// invocation.
-func (c RepoStoreGetByNameFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
-}
-
-// RepoStoreGetFirstRepoByCloneURLFunc describes the behavior when the
-// GetFirstRepoByCloneURL method of the parent MockRepoStore instance is
-// invoked.
-type RepoStoreGetFirstRepoByCloneURLFunc struct {
-	defaultHook func(context.Context, string) (*types.Repo, error)
-	hooks       []func(context.Context, string) (*types.Repo, error)
-	history     []RepoStoreGetFirstRepoByCloneURLFuncCall
-	mutex       sync.Mutex
-}
-
-// GetFirstRepoByCloneURL delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockRepoStore) GetFirstRepoByCloneURL(v0 context.Context, v1 string) (*types.Repo, error) {
-	r0, r1 := m.GetFirstRepoByCloneURLFunc.nextHook()(v0, v1)
-	m.GetFirstRepoByCloneURLFunc.appendCall(RepoStoreGetFirstRepoByCloneURLFuncCall{v0, v1, r0, r1})
-	return r0, r1
-}
-
-// SetDefaultHook sets function that is called when the
-// GetFirstRepoByCloneURL method of the parent MockRepoStore instance is
-// invoked and the hook queue is empty.
-func (f *RepoStoreGetFirstRepoByCloneURLFunc) SetDefaultHook(hook func(context.Context, string) (*types.Repo, error)) {
-	f.defaultHook = hook
-}
-
-// PushHook adds a function to the end of hook queue. Each invocation of the
-// GetFirstRepoByCloneURL method of the parent MockRepoStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *RepoStoreGetFirstRepoByCloneURLFunc) PushHook(hook func(context.Context, string) (*types.Repo, error)) {
-	f.mutex.Lock()
-	f.hooks = append(f.hooks, hook)
-	f.mutex.Unlock()
-}
-
-// SetDefaultReturn calls SetDefaultHook with a function that returns the
-// given values.
-func (f *RepoStoreGetFirstRepoByCloneURLFunc) SetDefaultReturn(r0 *types.Repo, r1 error) {
-	f.SetDefaultHook(func(context.Context, string) (*types.Repo, error) {
-		return r0, r1
-	})
-}
-
-// PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreGetFirstRepoByCloneURLFunc) PushReturn(r0 *types.Repo, r1 error) {
-	f.PushHook(func(context.Context, string) (*types.Repo, error) {
-		return r0, r1
-	})
-}
-
-func (f *RepoStoreGetFirstRepoByCloneURLFunc) nextHook() func(context.Context, string) (*types.Repo, error) {
-	f.mutex.Lock()
-	defer f.mutex.Unlock()
-
-	if len(f.hooks) == 0 {
-		return f.defaultHook
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c RepoStoreMetadataFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
 	}
 
-	hook := f.hooks[0]
-	f.hooks = f.hooks[1:]
-	return hook
-}
-
-func (f *RepoStoreGetFirstRepoByCloneURLFunc) appendCall(r0 RepoStoreGetFirstRepoByCloneURLFuncCall) {
-	f.mutex.Lock()
-	f.history = append(f.history, r0)
-	f.mutex.Unlock()
-}
-
-// History returns a sequence of RepoStoreGetFirstRepoByCloneURLFuncCall
-// objects describing the invocations of this function.
-func (f *RepoStoreGetFirstRepoByCloneURLFunc) History() []RepoStoreGetFirstRepoByCloneURLFuncCall {
-	f.mutex.Lock()
-	history := make([]RepoStoreGetFirstRepoByCloneURLFuncCall, len(f.history))
-	copy(history, f.history)
-	f.mutex.Unlock()
-
-	return history
-}
-
-// RepoStoreGetFirstRepoByCloneURLFuncCall is an object that describes an
-// invocation of method GetFirstRepoByCloneURL on an instance of
-// MockRepoStore.
-type RepoStoreGetFirstRepoByCloneURLFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 string
-	// Result0 is the value of the 1st result returned from this method
-	// invocation.
-	Result0 *types.Repo
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
-}
-
-// Args returns an interface slice containing the arguments of this
-// invocation.
-func (c RepoStoreGetFirstRepoByCloneURLFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+	return append([]interface{}{c.Arg0}, trailing...)
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreGetFirstRepoByCloneURLFuncCall) Results() []interface{} {
+func (c RepoStoreMetadataFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreGetFirstRepoNameByCloneURLFunc describes the behavior when the
-// GetFirstRepoNameByCloneURL method of the parent MockRepoStore instance is
-// invoked.
-type RepoStoreGetFirstRepoNameByCloneURLFunc struct {
-	defaultHook func(context.Context, string) (api.RepoName, error)
-	hooks       []func(context.Context, string) (api.RepoName, error)
-	history     []RepoStoreGetFirstRepoNameByCloneURLFuncCall
+// RepoStoreQueryFunc describes the behavior when the Query method of the
+// parent MockRepoStore instance is invoked.
+type RepoStoreQueryFunc struct {
+	defaultHook func(context.Context, *sqlf.Query) (*sql.Rows, error)
+	hooks       []func(context.Context, *sqlf.Query) (*sql.Rows, error)
+	history     []RepoStoreQueryFuncCall
 	mutex       sync.Mutex
 }
 
-// GetFirstRepoNameByCloneURL delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockRepoStore) GetFirstRepoNameByCloneURL(v0 context.Context, v1 string) (api.RepoName, error) {
-	r0, r1 := m.GetFirstRepoNameByCloneURLFunc.nextHook()(v0, v1)
-	m.GetFirstRepoNameByCloneURLFunc.appendCall(RepoStoreGetFirstRepoNameByCloneURLFuncCall{v0, v1, r0, r1})
+// Query delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) Query(v0 context.Context, v1 *sqlf.Query) (*sql.Rows, error) {
+	r0, r1 := m.QueryFunc.nextHook()(v0, v1)
+	m.QueryFunc.appendCall(RepoStoreQueryFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// GetFirstRepoNameByCloneURL method of the parent MockRepoStore instance is
-// invoked and the hook queue is empty.
-func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) SetDefaultHook(hook func(context.Context, string) (api.RepoName, error)) {
+// SetDefaultHook sets function that is called when the Query method of the
+// parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreQueryFunc) SetDefaultHook(hook func(context.Context, *sqlf.Query) (*sql.Rows, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetFirstRepoNameByCloneURL method of the parent MockRepoStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) PushHook(hook func(context.Context, string) (api.RepoName, error)) {
+// Query method of the parent MockRepoStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *RepoStoreQueryFunc) PushHook(hook func(context.Context, *sqlf.Query) (*sql.Rows, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -47778,20 +49650,20 @@ func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) PushHook(hook func(context.Con
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) SetDefaultReturn(r0 api.RepoName, r1 error) {
-	f.SetDefaultHook(func(context.Context, string) (api.RepoName, error) {
+func (f *RepoStoreQueryFunc) SetDefaultReturn(r0 *sql.Rows, r1 error) {
+	f.SetDefaultHook(func(context.Context, *sqlf.Query) (*sql.Rows, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) PushReturn(r0 api.RepoName, r1 error) {
-	f.PushHook(func(context.Context, string) (api.RepoName, error) {
+func (f *RepoStoreQueryFunc) PushReturn(r0 *sql.Rows, r1 error) {
+	f.PushHook(func(context.Context, *sqlf.Query) (*sql.Rows, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) nextHook() func(context.Context, string) (api.RepoName, error) {
+func (f *RepoStoreQueryFunc) nextHook() func(context.Context, *sqlf.Query) (*sql.Rows, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -47804,36 +49676,35 @@ func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) nextHook() func(context.Contex
 	return hook
 }
 
-func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) appendCall(r0 RepoStoreGetFirstRepoNameByCloneURLFuncCall) {
+func (f *RepoStoreQueryFunc) appendCall(r0 RepoStoreQueryFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreGetFirstRepoNameByCloneURLFuncCall
-// objects describing the invocations of this function.
-func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) History() []RepoStoreGetFirstRepoNameByCloneURLFuncCall {
+// History returns a sequence of RepoStoreQueryFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStoreQueryFunc) History() []RepoStoreQueryFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreGetFirstRepoNameByCloneURLFuncCall, len(f.history))
+	history := make([]RepoStoreQueryFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreGetFirstRepoNameByCloneURLFuncCall is an object that describes
-// an invocation of method GetFirstRepoNameByCloneURL on an instance of
-// MockRepoStore.
-type RepoStoreGetFirstRepoNameByCloneURLFuncCall struct {
+// RepoStoreQueryFuncCall is an object that describes an invocation of
+// method Query on an instance of MockRepoStore.
+type RepoStoreQueryFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 string
+	Arg1 *sqlf.Query
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 api.RepoName
+	Result0 *sql.Rows
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -47841,47 +49712,46 @@ type RepoStoreGetFirstRepoNameByCloneURLFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreGetFirstRepoNameByCloneURLFuncCall) Args() []interface{} {
+func (c RepoStoreQueryFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreGetFirstRepoNameByCloneURLFuncCall) Results() []interface{} {
+func (c RepoStoreQueryFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreGetRepoDescriptionsByIDsFunc describes the behavior when the
-// GetRepoDescriptionsByIDs method of the parent MockRepoStore instance is
+// RepoStoreRepoEmbeddingExistsFunc describes the behavior when the
+// RepoEmbeddingExists method of the parent MockRepoStore instance is
 // invoked.
-type RepoStoreGetRepoDescriptionsByIDsFunc struct {
-	defaultHook func(context.Context, ...api.RepoID) (map[api.RepoID]string, error)
-	hooks       []func(context.Context, ...api.RepoID) (map[api.RepoID]string, error)
-	history     []RepoStoreGetRepoDescriptionsByIDsFuncCall
+type RepoStoreRepoEmbeddingExistsFunc struct {
+	defaultHook func(context.Context, api.RepoID) (bool, error)
+	hooks       []func(context.Context, api.RepoID) (bool, error)
+	history     []RepoStoreRepoEmbeddingExistsFuncCall
 	mutex       sync.Mutex
 }
 
-// GetRepoDescriptionsByIDs delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockRepoStore) GetRepoDescriptionsByIDs(v0 context.Context, v1 ...api.RepoID) (map[api.RepoID]string, error) {
-	r0, r1 := m.GetRepoDescriptionsByIDsFunc.nextHook()(v0, v1...)
-	m.GetRepoDescriptionsByIDsFunc.appendCall(RepoStoreGetRepoDescriptionsByIDsFuncCall{v0, v1, r0, r1})
+// RepoEmbeddingExists delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRepoStore) RepoEmbeddingExists(v0 context.Context, v1 api.RepoID) (bool, error) {
+	r0, r1 := m.RepoEmbeddingExistsFunc.nextHook()(v0, v1)
+	m.RepoEmbeddingExistsFunc.appendCall(RepoStoreRepoEmbeddingExistsFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// GetRepoDescriptionsByIDs method of the parent MockRepoStore instance is
-// invoked and the hook queue is empty.
-func (f *RepoStoreGetRepoDescriptionsByIDsFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) (map[api.RepoID]string, error)) {
+// SetDefaultHook sets function that is called when the RepoEmbeddingExists
+// method of the parent MockRepoStore instance is invoked and the hook queue
+// is empty.
+func (f *RepoStoreRepoEmbeddingExistsFunc) SetDefaultHook(hook func(context.Context, api.RepoID) (bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetRepoDescriptionsByIDs method of the parent MockRepoStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *RepoStoreGetRepoDescriptionsByIDsFunc) PushHook(hook func(context.Context, ...api.RepoID) (map[api.RepoID]string, error)) {
+// RepoEmbeddingExists method of the parent MockRepoStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *RepoStoreRepoEmbeddingExistsFunc) PushHook(hook func(context.Context, api.RepoID) (bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -47889,20 +49759,20 @@ func (f *RepoStoreGetRepoDescriptionsByIDsFunc) PushHook(hook func(context.Conte
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreGetRepoDescriptionsByIDsFunc) SetDefaultReturn(r0 map[api.RepoID]string, r1 error) {
-	f.SetDefaultHook(func(context.Context, ...api.RepoID) (map[api.RepoID]string, error) {
+func (f *RepoStoreRepoEmbeddingExistsFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID) (bool, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreGetRepoDescriptionsByIDsFunc) PushReturn(r0 map[api.RepoID]string, r1 error) {
-	f.PushHook(func(context.Context, ...api.RepoID) (map[api.RepoID]string, error) {
+func (f *RepoStoreRepoEmbeddingExistsFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID) (bool, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStoreGetRepoDescriptionsByIDsFunc) nextHook() func(context.Context, ...api.RepoID) (map[api.RepoID]string, error) {
+func (f *RepoStoreRepoEmbeddingExistsFunc) nextHook() func(context.Context, api.RepoID) (bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -47915,89 +49785,82 @@ func (f *RepoStoreGetRepoDescriptionsByIDsFunc) nextHook() func(context.Context,
 	return hook
 }
 
-func (f *RepoStoreGetRepoDescriptionsByIDsFunc) appendCall(r0 RepoStoreGetRepoDescriptionsByIDsFuncCall) {
+func (f *RepoStoreRepoEmbeddingExistsFunc) appendCall(r0 RepoStoreRepoEmbeddingExistsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreGetRepoDescriptionsByIDsFuncCall
+// History returns a sequence of RepoStoreRepoEmbeddingExistsFuncCall
 // objects describing the invocations of this function.
-func (f *RepoStoreGetRepoDescriptionsByIDsFunc) History() []RepoStoreGetRepoDescriptionsByIDsFuncCall {
+func (f *RepoStoreRepoEmbeddingExistsFunc) History() []RepoStoreRepoEmbeddingExistsFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreGetRepoDescriptionsByIDsFuncCall, len(f.history))
+	history := make([]RepoStoreRepoEmbeddingExistsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreGetRepoDescriptionsByIDsFuncCall is an object that describes an
-// invocation of method GetRepoDescriptionsByIDs on an instance of
-// MockRepoStore.
-type RepoStoreGetRepoDescriptionsByIDsFuncCall struct {
+// RepoStoreRepoEmbeddingExistsFuncCall is an object that describes an
+// invocation of method RepoEmbeddingExists on an instance of MockRepoStore.
+type RepoStoreRepoEmbeddingExistsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is a slice containing the values of the variadic arguments
-	// passed to this method invocation.
-	Arg1 []api.RepoID
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 map[api.RepoID]string
+	Result0 bool
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation. The variadic slice argument is flattened in this array such
-// that one positional argument and three variadic arguments would result in
-// a slice of four, not two.
-func (c RepoStoreGetRepoDescriptionsByIDsFuncCall) Args() []interface{} {
-	trailing := []interface{}{}
-	for _, val := range c.Arg1 {
-		trailing = append(trailing, val)
-	}
-
-	return append([]interface{}{c.Arg0}, trailing...)
+// invocation.
+func (c RepoStoreRepoEmbeddingExistsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreGetRepoDescriptionsByIDsFuncCall) Results() []interface{} {
+func (c RepoStoreRepoEmbeddingExistsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreGetReposSetByIDsFunc describes the behavior when the
-// GetReposSetByIDs method of the parent MockRepoStore instance is invoked.
-type RepoStoreGetReposSetByIDsFunc struct {
-	defaultHook func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error)
-	hooks       []func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error)
-	history     []RepoStoreGetReposSetByIDsFuncCall
+// RepoStoreStreamMinimalReposFunc describes the behavior when the
+// StreamMinimalRepos method of the parent MockRepoStore instance is
+// invoked.
+type RepoStoreStreamMinimalReposFunc struct {
+	defaultHook func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error
+	hooks       []func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error
+	history     []RepoStoreStreamMinimalReposFuncCall
 	mutex       sync.Mutex
 }
 
-// GetReposSetByIDs delegates to the next hook function in the queue and
+// StreamMinimalRepos delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockRepoStore) GetReposSetByIDs(v0 context.Context, v1 ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
-	r0, r1 := m.GetReposSetByIDsFunc.nextHook()(v0, v1...)
-	m.GetReposSetByIDsFunc.appendCall(RepoStoreGetReposSetByIDsFuncCall{v0, v1, r0, r1})
-	return r0, r1
+func (m *MockRepoStore) StreamMinimalRepos(v0 context.Context, v1 ReposListOptions, v2 func(*types.MinimalRepo)) error {
+	r0 := m.StreamMinimalReposFunc.nextHook()(v0, v1, v2)
+	m.StreamMinimalReposFunc.appendCall(RepoStoreStreamMinimalReposFuncCall{v0, v1, v2, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the GetReposSetByIDs
+// SetDefaultHook sets function that is called when the StreamMinimalRepos
 // method of the parent MockRepoStore instance is invoked and the hook queue
 // is empty.
-func (f *RepoStoreGetReposSetByIDsFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error)) {
+func (f *RepoStoreStreamMinimalReposFunc) SetDefaultHook(hook func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetReposSetByIDs method of the parent MockRepoStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *RepoStoreGetReposSetByIDsFunc) PushHook(hook func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error)) {
+// StreamMinimalRepos method of the parent MockRepoStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *RepoStoreStreamMinimalReposFunc) PushHook(hook func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -48005,20 +49868,20 @@ func (f *RepoStoreGetReposSetByIDsFunc) PushHook(hook func(context.Context, ...a
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreGetReposSetByIDsFunc) SetDefaultReturn(r0 map[api.RepoID]*types.Repo, r1 error) {
-	f.SetDefaultHook(func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
-		return r0, r1
+func (f *RepoStoreStreamMinimalReposFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreGetReposSetByIDsFunc) PushReturn(r0 map[api.RepoID]*types.Repo, r1 error) {
-	f.PushHook(func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
-		return r0, r1
+func (f *RepoStoreStreamMinimalReposFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error {
+		return r0
 	})
 }
 
-func (f *RepoStoreGetReposSetByIDsFunc) nextHook() func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
+func (f *RepoStoreStreamMinimalReposFunc) nextHook() func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -48031,87 +49894,80 @@ func (f *RepoStoreGetReposSetByIDsFunc) nextHook() func(context.Context, ...api.
 	return hook
 }
 
-func (f *RepoStoreGetReposSetByIDsFunc) appendCall(r0 RepoStoreGetReposSetByIDsFuncCall) {
+func (f *RepoStoreStreamMinimalReposFunc) appendCall(r0 RepoStoreStreamMinimalReposFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreGetReposSetByIDsFuncCall objects
+// History returns a sequence of RepoStoreStreamMinimalReposFuncCall objects
 // describing the invocations of this function.
-func (f *RepoStoreGetReposSetByIDsFunc) History() []RepoStoreGetReposSetByIDsFuncCall {
+func (f *RepoStoreStreamMinimalReposFunc) History() []RepoStoreStreamMinimalReposFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreGetReposSetByIDsFuncCall, len(f.history))
+	history := make([]RepoStoreStreamMinimalReposFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreGetReposSetByIDsFuncCall is an object that describes an
-// invocation of method GetReposSetByIDs on an instance of MockRepoStore.
-type RepoStoreGetReposSetByIDsFuncCall struct {
+// RepoStoreStreamMinimalReposFuncCall is an object that describes an
+// invocation of method StreamMinimalRepos on an instance of MockRepoStore.
+type RepoStoreStreamMinimalReposFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is a slice containing the values of the variadic arguments
-	// passed to this method invocation.
-	Arg1 []api.RepoID
-	// Result0 is the value of the 1st result returned from this method
+	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Result0 map[api.RepoID]*types.Repo
-	// Result1 is the value of the 2nd result returned from this method
+	Arg1 ReposListOptions
+	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Result1 error
+	Arg2 func(*types.MinimalRepo)
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation. The variadic slice argument is flattened in this array such
-// that one positional argument and three variadic arguments would result in
-// a slice of four, not two.
-func (c RepoStoreGetReposSetByIDsFuncCall) Args() []interface{} {
-	trailing := []interface{}{}
-	for _, val := range c.Arg1 {
-		trailing = append(trailing, val)
-	}
-
-	return append([]interface{}{c.Arg0}, trailing...)
+// invocation.
+func (c RepoStoreStreamMinimalReposFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreGetReposSetByIDsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c RepoStoreStreamMinimalReposFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// RepoStoreHandleFunc describes the behavior when the Handle method of the
-// parent MockRepoStore instance is invoked.
-type RepoStoreHandleFunc struct {
-	defaultHook func() basestore.TransactableHandle
-	hooks       []func() basestore.TransactableHandle
-	history     []RepoStoreHandleFuncCall
+// RepoStoreTransactFunc describes the behavior when the Transact method of
+// the parent MockRepoStore instance is invoked.
+type RepoStoreTransactFunc struct {
+	defaultHook func(context.Context) (RepoStore, error)
+	hooks       []func(context.Context) (RepoStore, error)
+	history     []RepoStoreTransactFuncCall
 	mutex       sync.Mutex
 }
 
-// Handle delegates to the next hook function in the queue and stores the
+// Transact delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRepoStore) Handle() basestore.TransactableHandle {
-	r0 := m.HandleFunc.nextHook()()
-	m.HandleFunc.appendCall(RepoStoreHandleFuncCall{r0})
-	return r0
+func (m *MockRepoStore) Transact(v0 context.Context) (RepoStore, error) {
+	r0, r1 := m.TransactFunc.nextHook()(v0)
+	m.TransactFunc.appendCall(RepoStoreTransactFuncCall{v0, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Handle method of the
-// parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+// SetDefaultHook sets function that is called when the Transact method of
+// the parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreTransactFunc) SetDefaultHook(hook func(context.Context) (RepoStore, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Handle method of the parent MockRepoStore instance invokes the hook at
+// Transact method of the parent MockRepoStore instance invokes the hook at
 // the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *RepoStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+func (f *RepoStoreTransactFunc) PushHook(hook func(context.Context) (RepoStore, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -48119,20 +49975,20 @@ func (f *RepoStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle)
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
-	f.SetDefaultHook(func() basestore.TransactableHandle {
-		return r0
+func (f *RepoStoreTransactFunc) SetDefaultReturn(r0 RepoStore, r1 error) {
+	f.SetDefaultHook(func(context.Context) (RepoStore, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
-	f.PushHook(func() basestore.TransactableHandle {
-		return r0
+func (f *RepoStoreTransactFunc) PushReturn(r0 RepoStore, r1 error) {
+	f.PushHook(func(context.Context) (RepoStore, error) {
+		return r0, r1
 	})
 }
 
-func (f *RepoStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+func (f *RepoStoreTransactFunc) nextHook() func(context.Context) (RepoStore, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -48145,71 +50001,77 @@ func (f *RepoStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
 	return hook
 }
 
-func (f *RepoStoreHandleFunc) appendCall(r0 RepoStoreHandleFuncCall) {
+func (f *RepoStoreTransactFunc) appendCall(r0 RepoStoreTransactFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreHandleFuncCall objects describing
-// the invocations of this function.
-func (f *RepoStoreHandleFunc) History() []RepoStoreHandleFuncCall {
+// History returns a sequence of RepoStoreTransactFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStoreTransactFunc) History() []RepoStoreTransactFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreHandleFuncCall, len(f.history))
+	history := make([]RepoStoreTransactFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreHandleFuncCall is an object that describes an invocation of
-// method Handle on an instance of MockRepoStore.
-type RepoStoreHandleFuncCall struct {
+// RepoStoreTransactFuncCall is an object that describes an invocation of
+// method Transact on an instance of MockRepoStore.
+type RepoStoreTransactFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 basestore.TransactableHandle
+	Result0 RepoStore
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreHandleFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c RepoStoreTransactFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreHandleFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c RepoStoreTransactFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreListFunc describes the behavior when the List method of the
+// RepoStoreWithFunc describes the behavior when the With method of the
 // parent MockRepoStore instance is invoked.
-type RepoStoreListFunc struct {
-	defaultHook func(context.Context, ReposListOptions) ([]*types.Repo, error)
-	hooks       []func(context.Context, ReposListOptions) ([]*types.Repo, error)
-	history     []RepoStoreListFuncCall
+type RepoStoreWithFunc struct {
+	defaultHook func(basestore.ShareableStore) RepoStore
+	hooks       []func(basestore.ShareableStore) RepoStore
+	history     []RepoStoreWithFuncCall
 	mutex       sync.Mutex
 }
 
-// List delegates to the next hook function in the queue and stores the
+// With delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRepoStore) List(v0 context.Context, v1 ReposListOptions) ([]*types.Repo, error) {
-	r0, r1 := m.ListFunc.nextHook()(v0, v1)
-	m.ListFunc.appendCall(RepoStoreListFuncCall{v0, v1, r0, r1})
-	return r0, r1
+func (m *MockRepoStore) With(v0 basestore.ShareableStore) RepoStore {
+	r0 := m.WithFunc.nextHook()(v0)
+	m.WithFunc.appendCall(RepoStoreWithFuncCall{v0, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the List method of the
+// SetDefaultHook sets function that is called when the With method of the
 // parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreListFunc) SetDefaultHook(hook func(context.Context, ReposListOptions) ([]*types.Repo, error)) {
+func (f *RepoStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) RepoStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// List method of the parent MockRepoStore instance invokes the hook at the
+// With method of the parent MockRepoStore instance invokes the hook at the
 // front of the queue and discards it. After the queue is empty, the default
 // hook function is invoked for any future action.
-func (f *RepoStoreListFunc) PushHook(hook func(context.Context, ReposListOptions) ([]*types.Repo, error)) {
+func (f *RepoStoreWithFunc) PushHook(hook func(basestore.ShareableStore) RepoStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -48217,20 +50079,20 @@ func (f *RepoStoreListFunc) PushHook(hook func(context.Context, ReposListOptions
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreListFunc) SetDefaultReturn(r0 []*types.Repo, r1 error) {
-	f.SetDefaultHook(func(context.Context, ReposListOptions) ([]*types.Repo, error) {
-		return r0, r1
+func (f *RepoStoreWithFunc) SetDefaultReturn(r0 RepoStore) {
+	f.SetDefaultHook(func(basestore.ShareableStore) RepoStore {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreListFunc) PushReturn(r0 []*types.Repo, r1 error) {
-	f.PushHook(func(context.Context, ReposListOptions) ([]*types.Repo, error) {
-		return r0, r1
+func (f *RepoStoreWithFunc) PushReturn(r0 RepoStore) {
+	f.PushHook(func(basestore.ShareableStore) RepoStore {
+		return r0
 	})
 }
 
-func (f *RepoStoreListFunc) nextHook() func(context.Context, ReposListOptions) ([]*types.Repo, error) {
+func (f *RepoStoreWithFunc) nextHook() func(basestore.ShareableStore) RepoStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -48243,81 +50105,318 @@ func (f *RepoStoreListFunc) nextHook() func(context.Context, ReposListOptions) (
 	return hook
 }
 
-func (f *RepoStoreListFunc) appendCall(r0 RepoStoreListFuncCall) {
+func (f *RepoStoreWithFunc) appendCall(r0 RepoStoreWithFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreListFuncCall objects describing
+// History returns a sequence of RepoStoreWithFuncCall objects describing
 // the invocations of this function.
-func (f *RepoStoreListFunc) History() []RepoStoreListFuncCall {
+func (f *RepoStoreWithFunc) History() []RepoStoreWithFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreListFuncCall, len(f.history))
+	history := make([]RepoStoreWithFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreListFuncCall is an object that describes an invocation of method
-// List on an instance of MockRepoStore.
-type RepoStoreListFuncCall struct {
+// RepoStoreWithFuncCall is an object that describes an invocation of method
+// With on an instance of MockRepoStore.
+type RepoStoreWithFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 ReposListOptions
+	Arg0 basestore.ShareableStore
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*types.Repo
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 RepoStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreWithFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreWithFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// MockRolePermissionStore is a mock implementation of the
+// RolePermissionStore interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/database) used for unit
+// testing.
+type MockRolePermissionStore struct {
+	// AssignFunc is an instance of a mock function object controlling the
+	// behavior of the method Assign.
+	AssignFunc *RolePermissionStoreAssignFunc
+	// AssignToSystemRoleFunc is an instance of a mock function object
+	// controlling the behavior of the method AssignToSystemRole.
+	AssignToSystemRoleFunc *RolePermissionStoreAssignToSystemRoleFunc
+	// BulkAssignPermissionsToRoleFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// BulkAssignPermissionsToRole.
+	BulkAssignPermissionsToRoleFunc *RolePermissionStoreBulkAssignPermissionsToRoleFunc
+	// BulkAssignPermissionsToSystemRolesFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// BulkAssignPermissionsToSystemRoles.
+	BulkAssignPermissionsToSystemRolesFunc *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc
+	// BulkRevokePermissionsForRoleFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// BulkRevokePermissionsForRole.
+	BulkRevokePermissionsForRoleFunc *RolePermissionStoreBulkRevokePermissionsForRoleFunc
+	// GetByPermissionIDFunc is an instance of a mock function object
+	// controlling the behavior of the method GetByPermissionID.
+	GetByPermissionIDFunc *RolePermissionStoreGetByPermissionIDFunc
+	// GetByRoleIDFunc is an instance of a mock function object controlling
+	// the behavior of the method GetByRoleID.
+	GetByRoleIDFunc *RolePermissionStoreGetByRoleIDFunc
+	// GetByRoleIDAndPermissionIDFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// GetByRoleIDAndPermissionID.
+	GetByRoleIDAndPermissionIDFunc *RolePermissionStoreGetByRoleIDAndPermissionIDFunc
+	// HandleFunc is an instance of a mock function object controlling the
+	// behavior of the method Handle.
+	HandleFunc *RolePermissionStoreHandleFunc
+	// RevokeFunc is an instance of a mock function object controlling the
+	// behavior of the method Revoke.
+	RevokeFunc *RolePermissionStoreRevokeFunc
+	// SetPermissionsForRoleFunc is an instance of a mock function object
+	// controlling the behavior of the method SetPermissionsForRole.
+	SetPermissionsForRoleFunc *RolePermissionStoreSetPermissionsForRoleFunc
+	// WithFunc is an instance of a mock function object controlling the
+	// behavior of the method With.
+	WithFunc *RolePermissionStoreWithFunc
+	// WithTransactFunc is an instance of a mock function object controlling
+	// the behavior of the method WithTransact.
+	WithTransactFunc *RolePermissionStoreWithTransactFunc
+}
+
+// NewMockRolePermissionStore creates a new mock of the RolePermissionStore
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockRolePermissionStore() *MockRolePermissionStore {
+	return &MockRolePermissionStore{
+		AssignFunc: &RolePermissionStoreAssignFunc{
+			defaultHook: func(context.Context, AssignRolePermissionOpts) (r0 error) {
+				return
+			},
+		},
+		AssignToSystemRoleFunc: &RolePermissionStoreAssignToSystemRoleFunc{
+			defaultHook: func(context.Context, AssignToSystemRoleOpts) (r0 error) {
+				return
+			},
+		},
+		BulkAssignPermissionsToRoleFunc: &RolePermissionStoreBulkAssignPermissionsToRoleFunc{
+			defaultHook: func(context.Context, BulkAssignPermissionsToRoleOpts) (r0 error) {
+				return
+			},
+		},
+		BulkAssignPermissionsToSystemRolesFunc: &RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc{
+			defaultHook: func(context.Context, BulkAssignPermissionsToSystemRolesOpts) (r0 error) {
+				return
+			},
+		},
+		BulkRevokePermissionsForRoleFunc: &RolePermissionStoreBulkRevokePermissionsForRoleFunc{
+			defaultHook: func(context.Context, BulkRevokePermissionsForRoleOpts) (r0 error) {
+				return
+			},
+		},
+		GetByPermissionIDFunc: &RolePermissionStoreGetByPermissionIDFunc{
+			defaultHook: func(context.Context, GetRolePermissionOpts) (r0 []*types.RolePermission, r1 error) {
+				return
+			},
+		},
+		GetByRoleIDFunc: &RolePermissionStoreGetByRoleIDFunc{
+			defaultHook: func(context.Context, GetRolePermissionOpts) (r0 []*types.RolePermission, r1 error) {
+				return
+			},
+		},
+		GetByRoleIDAndPermissionIDFunc: &RolePermissionStoreGetByRoleIDAndPermissionIDFunc{
+			defaultHook: func(context.Context, GetRolePermissionOpts) (r0 *types.RolePermission, r1 error) {
+				return
+			},
+		},
+		HandleFunc: &RolePermissionStoreHandleFunc{
+			defaultHook: func() (r0 basestore.TransactableHandle) {
+				return
+			},
+		},
+		RevokeFunc: &RolePermissionStoreRevokeFunc{
+			defaultHook: func(context.Context, RevokeRolePermissionOpts) (r0 error) {
+				return
+			},
+		},
+		SetPermissionsForRoleFunc: &RolePermissionStoreSetPermissionsForRoleFunc{
+			defaultHook: func(context.Context, SetPermissionsForRoleOpts) (r0 error) {
+				return
+			},
+		},
+		WithFunc: &RolePermissionStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) (r0 RolePermissionStore) {
+				return
+			},
+		},
+		WithTransactFunc: &RolePermissionStoreWithTransactFunc{
+			defaultHook: func(context.Context, func(RolePermissionStore) error) (r0 error) {
+				return
+			},
+		},
+	}
 }
 
-// Args returns an interface slice containing the arguments of this
-// invocation.
-func (c RepoStoreListFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+// NewStrictMockRolePermissionStore creates a new mock of the
+// RolePermissionStore interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockRolePermissionStore() *MockRolePermissionStore {
+	return &MockRolePermissionStore{
+		AssignFunc: &RolePermissionStoreAssignFunc{
+			defaultHook: func(context.Context, AssignRolePermissionOpts) error {
+				panic("unexpected invocation of MockRolePermissionStore.Assign")
+			},
+		},
+		AssignToSystemRoleFunc: &RolePermissionStoreAssignToSystemRoleFunc{
+			defaultHook: func(context.Context, AssignToSystemRoleOpts) error {
+				panic("unexpected invocation of MockRolePermissionStore.AssignToSystemRole")
+			},
+		},
+		BulkAssignPermissionsToRoleFunc: &RolePermissionStoreBulkAssignPermissionsToRoleFunc{
+			defaultHook: func(context.Context, BulkAssignPermissionsToRoleOpts) error {
+				panic("unexpected invocation of MockRolePermissionStore.BulkAssignPermissionsToRole")
+			},
+		},
+		BulkAssignPermissionsToSystemRolesFunc: &RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc{
+			defaultHook: func(context.Context, BulkAssignPermissionsToSystemRolesOpts) error {
+				panic("unexpected invocation of MockRolePermissionStore.BulkAssignPermissionsToSystemRoles")
+			},
+		},
+		BulkRevokePermissionsForRoleFunc: &RolePermissionStoreBulkRevokePermissionsForRoleFunc{
+			defaultHook: func(context.Context, BulkRevokePermissionsForRoleOpts) error {
+				panic("unexpected invocation of MockRolePermissionStore.BulkRevokePermissionsForRole")
+			},
+		},
+		GetByPermissionIDFunc: &RolePermissionStoreGetByPermissionIDFunc{
+			defaultHook: func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error) {
+				panic("unexpected invocation of MockRolePermissionStore.GetByPermissionID")
+			},
+		},
+		GetByRoleIDFunc: &RolePermissionStoreGetByRoleIDFunc{
+			defaultHook: func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error) {
+				panic("unexpected invocation of MockRolePermissionStore.GetByRoleID")
+			},
+		},
+		GetByRoleIDAndPermissionIDFunc: &RolePermissionStoreGetByRoleIDAndPermissionIDFunc{
+			defaultHook: func(context.Context, GetRolePermissionOpts) (*types.RolePermission, error) {
+				panic("unexpected invocation of MockRolePermissionStore.GetByRoleIDAndPermissionID")
+			},
+		},
+		HandleFunc: &RolePermissionStoreHandleFunc{
+			defaultHook: func() basestore.TransactableHandle {
+				panic("unexpected invocation of MockRolePermissionStore.Handle")
+			},
+		},
+		RevokeFunc: &RolePermissionStoreRevokeFunc{
+			defaultHook: func(context.Context, RevokeRolePermissionOpts) error {
+				panic("unexpected invocation of MockRolePermissionStore.Revoke")
+			},
+		},
+		SetPermissionsForRoleFunc: &RolePermissionStoreSetPermissionsForRoleFunc{
+			defaultHook: func(context.Context, SetPermissionsForRoleOpts) error {
+				panic("unexpected invocation of MockRolePermissionStore.SetPermissionsForRole")
+			},
+		},
+		WithFunc: &RolePermissionStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) RolePermissionStore {
+				panic("unexpected invocation of MockRolePermissionStore.With")
+			},
+		},
+		WithTransactFunc: &RolePermissionStoreWithTransactFunc{
+			defaultHook: func(context.Context, func(RolePermissionStore) error) error {
+				panic("unexpected invocation of MockRolePermissionStore.WithTransact")
+			},
+		},
+	}
 }
 
-// Results returns an interface slice containing the results of this
-// invocation.
-func (c RepoStoreListFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+// NewMockRolePermissionStoreFrom creates a new mock of the
+// MockRolePermissionStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockRolePermissionStoreFrom(i RolePermissionStore) *MockRolePermissionStore {
+	return &MockRolePermissionStore{
+		AssignFunc: &RolePermissionStoreAssignFunc{
+			defaultHook: i.Assign,
+		},
+		AssignToSystemRoleFunc: &RolePermissionStoreAssignToSystemRoleFunc{
+			defaultHook: i.AssignToSystemRole,
+		},
+		BulkAssignPermissionsToRoleFunc: &RolePermissionStoreBulkAssignPermissionsToRoleFunc{
+			defaultHook: i.BulkAssignPermissionsToRole,
+		},
+		BulkAssignPermissionsToSystemRolesFunc: &RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc{
+			defaultHook: i.BulkAssignPermissionsToSystemRoles,
+		},
+		BulkRevokePermissionsForRoleFunc: &RolePermissionStoreBulkRevokePermissionsForRoleFunc{
+			defaultHook: i.BulkRevokePermissionsForRole,
+		},
+		GetByPermissionIDFunc: &RolePermissionStoreGetByPermissionIDFunc{
+			defaultHook: i.GetByPermissionID,
+		},
+		GetByRoleIDFunc: &RolePermissionStoreGetByRoleIDFunc{
+			defaultHook: i.GetByRoleID,
+		},
+		GetByRoleIDAndPermissionIDFunc: &RolePermissionStoreGetByRoleIDAndPermissionIDFunc{
+			defaultHook: i.GetByRoleIDAndPermissionID,
+		},
+		HandleFunc: &RolePermissionStoreHandleFunc{
+			defaultHook: i.Handle,
+		},
+		RevokeFunc: &RolePermissionStoreRevokeFunc{
+			defaultHook: i.Revoke,
+		},
+		SetPermissionsForRoleFunc: &RolePermissionStoreSetPermissionsForRoleFunc{
+			defaultHook: i.SetPermissionsForRole,
+		},
+		WithFunc: &RolePermissionStoreWithFunc{
+			defaultHook: i.With,
+		},
+		WithTransactFunc: &RolePermissionStoreWithTransactFunc{
+			defaultHook: i.WithTransact,
+		},
+	}
 }
 
-// RepoStoreListMinimalReposFunc describes the behavior when the
-// ListMinimalRepos method of the parent MockRepoStore instance is invoked.
-type RepoStoreListMinimalReposFunc struct {
-	defaultHook func(context.Context, ReposListOptions) ([]types.MinimalRepo, error)
-	hooks       []func(context.Context, ReposListOptions) ([]types.MinimalRepo, error)
-	history     []RepoStoreListMinimalReposFuncCall
+// RolePermissionStoreAssignFunc describes the behavior when the Assign
+// method of the parent MockRolePermissionStore instance is invoked.
+type RolePermissionStoreAssignFunc struct {
+	defaultHook func(context.Context, AssignRolePermissionOpts) error
+	hooks       []func(context.Context, AssignRolePermissionOpts) error
+	history     []RolePermissionStoreAssignFuncCall
 	mutex       sync.Mutex
 }
 
-// ListMinimalRepos delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockRepoStore) ListMinimalRepos(v0 context.Context, v1 ReposListOptions) ([]types.MinimalRepo, error) {
-	r0, r1 := m.ListMinimalReposFunc.nextHook()(v0, v1)
-	m.ListMinimalReposFunc.appendCall(RepoStoreListMinimalReposFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// Assign delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRolePermissionStore) Assign(v0 context.Context, v1 AssignRolePermissionOpts) error {
+	r0 := m.AssignFunc.nextHook()(v0, v1)
+	m.AssignFunc.appendCall(RolePermissionStoreAssignFuncCall{v0, v1, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the ListMinimalRepos
-// method of the parent MockRepoStore instance is invoked and the hook queue
-// is empty.
-func (f *RepoStoreListMinimalReposFunc) SetDefaultHook(hook func(context.Context, ReposListOptions) ([]types.MinimalRepo, error)) {
+// SetDefaultHook sets function that is called when the Assign method of the
+// parent MockRolePermissionStore instance is invoked and the hook queue is
+// empty.
+func (f *RolePermissionStoreAssignFunc) SetDefaultHook(hook func(context.Context, AssignRolePermissionOpts) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListMinimalRepos method of the parent MockRepoStore instance invokes the
+// Assign method of the parent MockRolePermissionStore instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *RepoStoreListMinimalReposFunc) PushHook(hook func(context.Context, ReposListOptions) ([]types.MinimalRepo, error)) {
+func (f *RolePermissionStoreAssignFunc) PushHook(hook func(context.Context, AssignRolePermissionOpts) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -48325,20 +50424,20 @@ func (f *RepoStoreListMinimalReposFunc) PushHook(hook func(context.Context, Repo
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreListMinimalReposFunc) SetDefaultReturn(r0 []types.MinimalRepo, r1 error) {
-	f.SetDefaultHook(func(context.Context, ReposListOptions) ([]types.MinimalRepo, error) {
-		return r0, r1
+func (f *RolePermissionStoreAssignFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, AssignRolePermissionOpts) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreListMinimalReposFunc) PushReturn(r0 []types.MinimalRepo, r1 error) {
-	f.PushHook(func(context.Context, ReposListOptions) ([]types.MinimalRepo, error) {
-		return r0, r1
+func (f *RolePermissionStoreAssignFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, AssignRolePermissionOpts) error {
+		return r0
 	})
 }
 
-func (f *RepoStoreListMinimalReposFunc) nextHook() func(context.Context, ReposListOptions) ([]types.MinimalRepo, error) {
+func (f *RolePermissionStoreAssignFunc) nextHook() func(context.Context, AssignRolePermissionOpts) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -48351,84 +50450,80 @@ func (f *RepoStoreListMinimalReposFunc) nextHook() func(context.Context, ReposLi
 	return hook
 }
 
-func (f *RepoStoreListMinimalReposFunc) appendCall(r0 RepoStoreListMinimalReposFuncCall) {
+func (f *RolePermissionStoreAssignFunc) appendCall(r0 RolePermissionStoreAssignFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreListMinimalReposFuncCall objects
+// History returns a sequence of RolePermissionStoreAssignFuncCall objects
 // describing the invocations of this function.
-func (f *RepoStoreListMinimalReposFunc) History() []RepoStoreListMinimalReposFuncCall {
+func (f *RolePermissionStoreAssignFunc) History() []RolePermissionStoreAssignFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreListMinimalReposFuncCall, len(f.history))
+	history := make([]RolePermissionStoreAssignFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreListMinimalReposFuncCall is an object that describes an
-// invocation of method ListMinimalRepos on an instance of MockRepoStore.
-type RepoStoreListMinimalReposFuncCall struct {
+// RolePermissionStoreAssignFuncCall is an object that describes an
+// invocation of method Assign on an instance of MockRolePermissionStore.
+type RolePermissionStoreAssignFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 ReposListOptions
+	Arg1 AssignRolePermissionOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []types.MinimalRepo
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreListMinimalReposFuncCall) Args() []interface{} {
+func (c RolePermissionStoreAssignFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreListMinimalReposFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c RolePermissionStoreAssignFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// RepoStoreListSourcegraphDotComIndexableReposFunc describes the behavior
-// when the ListSourcegraphDotComIndexableRepos method of the parent
-// MockRepoStore instance is invoked.
-type RepoStoreListSourcegraphDotComIndexableReposFunc struct {
-	defaultHook func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error)
-	hooks       []func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error)
-	history     []RepoStoreListSourcegraphDotComIndexableReposFuncCall
+// RolePermissionStoreAssignToSystemRoleFunc describes the behavior when the
+// AssignToSystemRole method of the parent MockRolePermissionStore instance
+// is invoked.
+type RolePermissionStoreAssignToSystemRoleFunc struct {
+	defaultHook func(context.Context, AssignToSystemRoleOpts) error
+	hooks       []func(context.Context, AssignToSystemRoleOpts) error
+	history     []RolePermissionStoreAssignToSystemRoleFuncCall
 	mutex       sync.Mutex
 }
 
-// ListSourcegraphDotComIndexableRepos delegates to the next hook function
-// in the queue and stores the parameter and result values of this
-// invocation.
-func (m *MockRepoStore) ListSourcegraphDotComIndexableRepos(v0 context.Context, v1 ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
-	r0, r1 := m.ListSourcegraphDotComIndexableReposFunc.nextHook()(v0, v1)
-	m.ListSourcegraphDotComIndexableReposFunc.appendCall(RepoStoreListSourcegraphDotComIndexableReposFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// AssignToSystemRole delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRolePermissionStore) AssignToSystemRole(v0 context.Context, v1 AssignToSystemRoleOpts) error {
+	r0 := m.AssignToSystemRoleFunc.nextHook()(v0, v1)
+	m.AssignToSystemRoleFunc.appendCall(RolePermissionStoreAssignToSystemRoleFuncCall{v0, v1, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the
-// ListSourcegraphDotComIndexableRepos method of the parent MockRepoStore
-// instance is invoked and the hook queue is empty.
-func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) SetDefaultHook(hook func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error)) {
+// SetDefaultHook sets function that is called when the AssignToSystemRole
+// method of the parent MockRolePermissionStore instance is invoked and the
+// hook queue is empty.
+func (f *RolePermissionStoreAssignToSystemRoleFunc) SetDefaultHook(hook func(context.Context, AssignToSystemRoleOpts) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListSourcegraphDotComIndexableRepos method of the parent MockRepoStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) PushHook(hook func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error)) {
+// AssignToSystemRole method of the parent MockRolePermissionStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RolePermissionStoreAssignToSystemRoleFunc) PushHook(hook func(context.Context, AssignToSystemRoleOpts) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -48436,20 +50531,20 @@ func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) PushHook(hook func(co
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) SetDefaultReturn(r0 []types.MinimalRepo, r1 error) {
-	f.SetDefaultHook(func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
-		return r0, r1
+func (f *RolePermissionStoreAssignToSystemRoleFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, AssignToSystemRoleOpts) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) PushReturn(r0 []types.MinimalRepo, r1 error) {
-	f.PushHook(func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
-		return r0, r1
+func (f *RolePermissionStoreAssignToSystemRoleFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, AssignToSystemRoleOpts) error {
+		return r0
 	})
 }
 
-func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) nextHook() func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
+func (f *RolePermissionStoreAssignToSystemRoleFunc) nextHook() func(context.Context, AssignToSystemRoleOpts) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -48462,196 +50557,82 @@ func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) nextHook() func(conte
 	return hook
 }
 
-func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) appendCall(r0 RepoStoreListSourcegraphDotComIndexableReposFuncCall) {
+func (f *RolePermissionStoreAssignToSystemRoleFunc) appendCall(r0 RolePermissionStoreAssignToSystemRoleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// RepoStoreListSourcegraphDotComIndexableReposFuncCall objects describing
-// the invocations of this function.
-func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) History() []RepoStoreListSourcegraphDotComIndexableReposFuncCall {
+// RolePermissionStoreAssignToSystemRoleFuncCall objects describing the
+// invocations of this function.
+func (f *RolePermissionStoreAssignToSystemRoleFunc) History() []RolePermissionStoreAssignToSystemRoleFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreListSourcegraphDotComIndexableReposFuncCall, len(f.history))
+	history := make([]RolePermissionStoreAssignToSystemRoleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreListSourcegraphDotComIndexableReposFuncCall is an object that
-// describes an invocation of method ListSourcegraphDotComIndexableRepos on
-// an instance of MockRepoStore.
-type RepoStoreListSourcegraphDotComIndexableReposFuncCall struct {
+// RolePermissionStoreAssignToSystemRoleFuncCall is an object that describes
+// an invocation of method AssignToSystemRole on an instance of
+// MockRolePermissionStore.
+type RolePermissionStoreAssignToSystemRoleFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 ListSourcegraphDotComIndexableReposOptions
+	Arg1 AssignToSystemRoleOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []types.MinimalRepo
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreListSourcegraphDotComIndexableReposFuncCall) Args() []interface{} {
+func (c RolePermissionStoreAssignToSystemRoleFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreListSourcegraphDotComIndexableReposFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
-}
-
-// RepoStoreMetadataFunc describes the behavior when the Metadata method of
-// the parent MockRepoStore instance is invoked.
-type RepoStoreMetadataFunc struct {
-	defaultHook func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error)
-	hooks       []func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error)
-	history     []RepoStoreMetadataFuncCall
-	mutex       sync.Mutex
-}
-
-// Metadata delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStore) Metadata(v0 context.Context, v1 ...api.RepoID) ([]*types.SearchedRepo, error) {
-	r0, r1 := m.MetadataFunc.nextHook()(v0, v1...)
-	m.MetadataFunc.appendCall(RepoStoreMetadataFuncCall{v0, v1, r0, r1})
-	return r0, r1
-}
-
-// SetDefaultHook sets function that is called when the Metadata method of
-// the parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreMetadataFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error)) {
-	f.defaultHook = hook
-}
-
-// PushHook adds a function to the end of hook queue. Each invocation of the
-// Metadata method of the parent MockRepoStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *RepoStoreMetadataFunc) PushHook(hook func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error)) {
-	f.mutex.Lock()
-	f.hooks = append(f.hooks, hook)
-	f.mutex.Unlock()
-}
-
-// SetDefaultReturn calls SetDefaultHook with a function that returns the
-// given values.
-func (f *RepoStoreMetadataFunc) SetDefaultReturn(r0 []*types.SearchedRepo, r1 error) {
-	f.SetDefaultHook(func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error) {
-		return r0, r1
-	})
-}
-
-// PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreMetadataFunc) PushReturn(r0 []*types.SearchedRepo, r1 error) {
-	f.PushHook(func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error) {
-		return r0, r1
-	})
-}
-
-func (f *RepoStoreMetadataFunc) nextHook() func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error) {
-	f.mutex.Lock()
-	defer f.mutex.Unlock()
-
-	if len(f.hooks) == 0 {
-		return f.defaultHook
-	}
-
-	hook := f.hooks[0]
-	f.hooks = f.hooks[1:]
-	return hook
-}
-
-func (f *RepoStoreMetadataFunc) appendCall(r0 RepoStoreMetadataFuncCall) {
-	f.mutex.Lock()
-	f.history = append(f.history, r0)
-	f.mutex.Unlock()
-}
-
-// History returns a sequence of RepoStoreMetadataFuncCall objects
-// describing the invocations of this function.
-func (f *RepoStoreMetadataFunc) History() []RepoStoreMetadataFuncCall {
-	f.mutex.Lock()
-	history := make([]RepoStoreMetadataFuncCall, len(f.history))
-	copy(history, f.history)
-	f.mutex.Unlock()
-
-	return history
-}
-
-// RepoStoreMetadataFuncCall is an object that describes an invocation of
-// method Metadata on an instance of MockRepoStore.
-type RepoStoreMetadataFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 context.Context
-	// Arg1 is a slice containing the values of the variadic arguments
-	// passed to this method invocation.
-	Arg1 []api.RepoID
-	// Result0 is the value of the 1st result returned from this method
-	// invocation.
-	Result0 []*types.SearchedRepo
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
-}
-
-// Args returns an interface slice containing the arguments of this
-// invocation. The variadic slice argument is flattened in this array such
-// that one positional argument and three variadic arguments would result in
-// a slice of four, not two.
-func (c RepoStoreMetadataFuncCall) Args() []interface{} {
-	trailing := []interface{}{}
-	for _, val := range c.Arg1 {
-		trailing = append(trailing, val)
-	}
-
-	return append([]interface{}{c.Arg0}, trailing...)
-}
-
-// Results returns an interface slice containing the results of this
-// invocation.
-func (c RepoStoreMetadataFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c RolePermissionStoreAssignToSystemRoleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// RepoStoreQueryFunc describes the behavior when the Query method of the
-// parent MockRepoStore instance is invoked.
-type RepoStoreQueryFunc struct {
-	defaultHook func(context.Context, *sqlf.Query) (*sql.Rows, error)
-	hooks       []func(context.Context, *sqlf.Query) (*sql.Rows, error)
-	history     []RepoStoreQueryFuncCall
+// RolePermissionStoreBulkAssignPermissionsToRoleFunc describes the behavior
+// when the BulkAssignPermissionsToRole method of the parent
+// MockRolePermissionStore instance is invoked.
+type RolePermissionStoreBulkAssignPermissionsToRoleFunc struct {
+	defaultHook func(context.Context, BulkAssignPermissionsToRoleOpts) error
+	hooks       []func(context.Context, BulkAssignPermissionsToRoleOpts) error
+	history     []RolePermissionStoreBulkAssignPermissionsToRoleFuncCall
 	mutex       sync.Mutex
 }
 
-// Query delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStore) Query(v0 context.Context, v1 *sqlf.Query) (*sql.Rows, error) {
-	r0, r1 := m.QueryFunc.nextHook()(v0, v1)
-	m.QueryFunc.appendCall(RepoStoreQueryFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// BulkAssignPermissionsToRole delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockRolePermissionStore) BulkAssignPermissionsToRole(v0 context.Context, v1 BulkAssignPermissionsToRoleOpts) error {
+	r0 := m.BulkAssignPermissionsToRoleFunc.nextHook()(v0, v1)
+	m.BulkAssignPermissionsToRoleFunc.appendCall(RolePermissionStoreBulkAssignPermissionsToRoleFuncCall{v0, v1, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the Query method of the
-// parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreQueryFunc) SetDefaultHook(hook func(context.Context, *sqlf.Query) (*sql.Rows, error)) {
+// SetDefaultHook sets function that is called when the
+// BulkAssignPermissionsToRole method of the parent MockRolePermissionStore
+// instance is invoked and the hook queue is empty.
+func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) SetDefaultHook(hook func(context.Context, BulkAssignPermissionsToRoleOpts) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Query method of the parent MockRepoStore instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *RepoStoreQueryFunc) PushHook(hook func(context.Context, *sqlf.Query) (*sql.Rows, error)) {
+// BulkAssignPermissionsToRole method of the parent MockRolePermissionStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) PushHook(hook func(context.Context, BulkAssignPermissionsToRoleOpts) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -48659,20 +50640,20 @@ func (f *RepoStoreQueryFunc) PushHook(hook func(context.Context, *sqlf.Query) (*
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreQueryFunc) SetDefaultReturn(r0 *sql.Rows, r1 error) {
-	f.SetDefaultHook(func(context.Context, *sqlf.Query) (*sql.Rows, error) {
-		return r0, r1
+func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, BulkAssignPermissionsToRoleOpts) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreQueryFunc) PushReturn(r0 *sql.Rows, r1 error) {
-	f.PushHook(func(context.Context, *sqlf.Query) (*sql.Rows, error) {
-		return r0, r1
+func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, BulkAssignPermissionsToRoleOpts) error {
+		return r0
 	})
 }
 
-func (f *RepoStoreQueryFunc) nextHook() func(context.Context, *sqlf.Query) (*sql.Rows, error) {
+func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) nextHook() func(context.Context, BulkAssignPermissionsToRoleOpts) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -48685,82 +50666,82 @@ func (f *RepoStoreQueryFunc) nextHook() func(context.Context, *sqlf.Query) (*sql
 	return hook
 }
 
-func (f *RepoStoreQueryFunc) appendCall(r0 RepoStoreQueryFuncCall) {
+func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) appendCall(r0 RolePermissionStoreBulkAssignPermissionsToRoleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreQueryFuncCall objects describing
+// History returns a sequence of
+// RolePermissionStoreBulkAssignPermissionsToRoleFuncCall objects describing
 // the invocations of this function.
-func (f *RepoStoreQueryFunc) History() []RepoStoreQueryFuncCall {
+func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) History() []RolePermissionStoreBulkAssignPermissionsToRoleFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreQueryFuncCall, len(f.history))
+	history := make([]RolePermissionStoreBulkAssignPermissionsToRoleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreQueryFuncCall is an object that describes an invocation of
-// method Query on an instance of MockRepoStore.
-type RepoStoreQueryFuncCall struct {
+// RolePermissionStoreBulkAssignPermissionsToRoleFuncCall is an object that
+// describes an invocation of method BulkAssignPermissionsToRole on an
+// instance of MockRolePermissionStore.
+type RolePermissionStoreBulkAssignPermissionsToRoleFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *sqlf.Query
+	Arg1 BulkAssignPermissionsToRoleOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *sql.Rows
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreQueryFuncCall) Args() []interface{} {
+func (c RolePermissionStoreBulkAssignPermissionsToRoleFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreQueryFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
-}
-
-// RepoStoreRepoEmbeddingExistsFunc describes the behavior when the
-// RepoEmbeddingExists method of the parent MockRepoStore instance is
-// invoked.
-type RepoStoreRepoEmbeddingExistsFunc struct {
-	defaultHook func(context.Context, api.RepoID) (bool, error)
-	hooks       []func(context.Context, api.RepoID) (bool, error)
-	history     []RepoStoreRepoEmbeddingExistsFuncCall
-	mutex       sync.Mutex
+func (c RolePermissionStoreBulkAssignPermissionsToRoleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// RepoEmbeddingExists delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockRepoStore) RepoEmbeddingExists(v0 context.Context, v1 api.RepoID) (bool, error) {
-	r0, r1 := m.RepoEmbeddingExistsFunc.nextHook()(v0, v1)
-	m.RepoEmbeddingExistsFunc.appendCall(RepoStoreRepoEmbeddingExistsFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc describes the
+// behavior when the BulkAssignPermissionsToSystemRoles method of the parent
+// MockRolePermissionStore instance is invoked.
+type RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc struct {
+	defaultHook func(context.Context, BulkAssignPermissionsToSystemRolesOpts) error
+	hooks       []func(context.Context, BulkAssignPermissionsToSystemRolesOpts) error
+	history     []RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall
+	mutex       sync.Mutex
 }
 
-// SetDefaultHook sets function that is called when the RepoEmbeddingExists
-// method of the parent MockRepoStore instance is invoked and the hook queue
-// is empty.
-func (f *RepoStoreRepoEmbeddingExistsFunc) SetDefaultHook(hook func(context.Context, api.RepoID) (bool, error)) {
+// BulkAssignPermissionsToSystemRoles delegates to the next hook function in
+// the queue and stores the parameter and result values of this invocation.
+func (m *MockRolePermissionStore) BulkAssignPermissionsToSystemRoles(v0 context.Context, v1 BulkAssignPermissionsToSystemRolesOpts) error {
+	r0 := m.BulkAssignPermissionsToSystemRolesFunc.nextHook()(v0, v1)
+	m.BulkAssignPermissionsToSystemRolesFunc.appendCall(RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// BulkAssignPermissionsToSystemRoles method of the parent
+// MockRolePermissionStore instance is invoked and the hook queue is empty.
+func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) SetDefaultHook(hook func(context.Context, BulkAssignPermissionsToSystemRolesOpts) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// RepoEmbeddingExists method of the parent MockRepoStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *RepoStoreRepoEmbeddingExistsFunc) PushHook(hook func(context.Context, api.RepoID) (bool, error)) {
+// BulkAssignPermissionsToSystemRoles method of the parent
+// MockRolePermissionStore instance invokes the hook at the front of the
+// queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) PushHook(hook func(context.Context, BulkAssignPermissionsToSystemRolesOpts) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -48768,20 +50749,20 @@ func (f *RepoStoreRepoEmbeddingExistsFunc) PushHook(hook func(context.Context, a
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreRepoEmbeddingExistsFunc) SetDefaultReturn(r0 bool, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoID) (bool, error) {
-		return r0, r1
+func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, BulkAssignPermissionsToSystemRolesOpts) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreRepoEmbeddingExistsFunc) PushReturn(r0 bool, r1 error) {
-	f.PushHook(func(context.Context, api.RepoID) (bool, error) {
-		return r0, r1
+func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, BulkAssignPermissionsToSystemRolesOpts) error {
+		return r0
 	})
 }
 
-func (f *RepoStoreRepoEmbeddingExistsFunc) nextHook() func(context.Context, api.RepoID) (bool, error) {
+func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) nextHook() func(context.Context, BulkAssignPermissionsToSystemRolesOpts) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -48794,82 +50775,83 @@ func (f *RepoStoreRepoEmbeddingExistsFunc) nextHook() func(context.Context, api.
 	return hook
 }
 
-func (f *RepoStoreRepoEmbeddingExistsFunc) appendCall(r0 RepoStoreRepoEmbeddingExistsFuncCall) {
+func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) appendCall(r0 RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreRepoEmbeddingExistsFuncCall
-// objects describing the invocations of this function.
-func (f *RepoStoreRepoEmbeddingExistsFunc) History() []RepoStoreRepoEmbeddingExistsFuncCall {
+// History returns a sequence of
+// RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall objects
+// describing the invocations of this function.
+func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) History() []RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreRepoEmbeddingExistsFuncCall, len(f.history))
+	history := make([]RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreRepoEmbeddingExistsFuncCall is an object that describes an
-// invocation of method RepoEmbeddingExists on an instance of MockRepoStore.
-type RepoStoreRepoEmbeddingExistsFuncCall struct {
+// RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall is an
+// object that describes an invocation of method
+// BulkAssignPermissionsToSystemRoles on an instance of
+// MockRolePermissionStore.
+type RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 api.RepoID
+	Arg1 BulkAssignPermissionsToSystemRolesOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 bool
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreRepoEmbeddingExistsFuncCall) Args() []interface{} {
+func (c RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreRepoEmbeddingExistsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// RepoStoreStreamMinimalReposFunc describes the behavior when the
-// StreamMinimalRepos method of the parent MockRepoStore instance is
-// invoked.
-type RepoStoreStreamMinimalReposFunc struct {
-	defaultHook func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error
-	hooks       []func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error
-	history     []RepoStoreStreamMinimalReposFuncCall
+// RolePermissionStoreBulkRevokePermissionsForRoleFunc describes the
+// behavior when the BulkRevokePermissionsForRole method of the parent
+// MockRolePermissionStore instance is invoked.
+type RolePermissionStoreBulkRevokePermissionsForRoleFunc struct {
+	defaultHook func(context.Context, BulkRevokePermissionsForRoleOpts) error
+	hooks       []func(context.Context, BulkRevokePermissionsForRoleOpts) error
+	history     []RolePermissionStoreBulkRevokePermissionsForRoleFuncCall
 	mutex       sync.Mutex
 }
 
-// StreamMinimalRepos delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockRepoStore) StreamMinimalRepos(v0 context.Context, v1 ReposListOptions, v2 func(*types.MinimalRepo)) error {
-	r0 := m.StreamMinimalReposFunc.nextHook()(v0, v1, v2)
-	m.StreamMinimalReposFunc.appendCall(RepoStoreStreamMinimalReposFuncCall{v0, v1, v2, r0})
+// BulkRevokePermissionsForRole delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockRolePermissionStore) BulkRevokePermissionsForRole(v0 context.Context, v1 BulkRevokePermissionsForRoleOpts) error {
+	r0 := m.BulkRevokePermissionsForRoleFunc.nextHook()(v0, v1)
+	m.BulkRevokePermissionsForRoleFunc.appendCall(RolePermissionStoreBulkRevokePermissionsForRoleFuncCall{v0, v1, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the StreamMinimalRepos
-// method of the parent MockRepoStore instance is invoked and the hook queue
-// is empty.
-func (f *RepoStoreStreamMinimalReposFunc) SetDefaultHook(hook func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error) {
+// SetDefaultHook sets function that is called when the
+// BulkRevokePermissionsForRole method of the parent MockRolePermissionStore
+// instance is invoked and the hook queue is empty.
+func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) SetDefaultHook(hook func(context.Context, BulkRevokePermissionsForRoleOpts) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// StreamMinimalRepos method of the parent MockRepoStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *RepoStoreStreamMinimalReposFunc) PushHook(hook func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error) {
+// BulkRevokePermissionsForRole method of the parent MockRolePermissionStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) PushHook(hook func(context.Context, BulkRevokePermissionsForRoleOpts) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -48877,20 +50859,20 @@ func (f *RepoStoreStreamMinimalReposFunc) PushHook(hook func(context.Context, Re
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreStreamMinimalReposFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error {
+func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, BulkRevokePermissionsForRoleOpts) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreStreamMinimalReposFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error {
+func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, BulkRevokePermissionsForRoleOpts) error {
 		return r0
 	})
 }
 
-func (f *RepoStoreStreamMinimalReposFunc) nextHook() func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error {
+func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) nextHook() func(context.Context, BulkRevokePermissionsForRoleOpts) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -48903,35 +50885,34 @@ func (f *RepoStoreStreamMinimalReposFunc) nextHook() func(context.Context, Repos
 	return hook
 }
 
-func (f *RepoStoreStreamMinimalReposFunc) appendCall(r0 RepoStoreStreamMinimalReposFuncCall) {
+func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) appendCall(r0 RolePermissionStoreBulkRevokePermissionsForRoleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreStreamMinimalReposFuncCall objects
+// History returns a sequence of
+// RolePermissionStoreBulkRevokePermissionsForRoleFuncCall objects
 // describing the invocations of this function.
-func (f *RepoStoreStreamMinimalReposFunc) History() []RepoStoreStreamMinimalReposFuncCall {
+func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) History() []RolePermissionStoreBulkRevokePermissionsForRoleFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreStreamMinimalReposFuncCall, len(f.history))
+	history := make([]RolePermissionStoreBulkRevokePermissionsForRoleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreStreamMinimalReposFuncCall is an object that describes an
-// invocation of method StreamMinimalRepos on an instance of MockRepoStore.
-type RepoStoreStreamMinimalReposFuncCall struct {
+// RolePermissionStoreBulkRevokePermissionsForRoleFuncCall is an object that
+// describes an invocation of method BulkRevokePermissionsForRole on an
+// instance of MockRolePermissionStore.
+type RolePermissionStoreBulkRevokePermissionsForRoleFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 ReposListOptions
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 func(*types.MinimalRepo)
+	Arg1 BulkRevokePermissionsForRoleOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -48939,44 +50920,47 @@ type RepoStoreStreamMinimalReposFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreStreamMinimalReposFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c RolePermissionStoreBulkRevokePermissionsForRoleFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreStreamMinimalReposFuncCall) Results() []interface{} {
+func (c RolePermissionStoreBulkRevokePermissionsForRoleFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RepoStoreTransactFunc describes the behavior when the Transact method of
-// the parent MockRepoStore instance is invoked.
-type RepoStoreTransactFunc struct {
-	defaultHook func(context.Context) (RepoStore, error)
-	hooks       []func(context.Context) (RepoStore, error)
-	history     []RepoStoreTransactFuncCall
+// RolePermissionStoreGetByPermissionIDFunc describes the behavior when the
+// GetByPermissionID method of the parent MockRolePermissionStore instance
+// is invoked.
+type RolePermissionStoreGetByPermissionIDFunc struct {
+	defaultHook func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error)
+	hooks       []func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error)
+	history     []RolePermissionStoreGetByPermissionIDFuncCall
 	mutex       sync.Mutex
 }
 
-// Transact delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStore) Transact(v0 context.Context) (RepoStore, error) {
-	r0, r1 := m.TransactFunc.nextHook()(v0)
-	m.TransactFunc.appendCall(RepoStoreTransactFuncCall{v0, r0, r1})
+// GetByPermissionID delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRolePermissionStore) GetByPermissionID(v0 context.Context, v1 GetRolePermissionOpts) ([]*types.RolePermission, error) {
+	r0, r1 := m.GetByPermissionIDFunc.nextHook()(v0, v1)
+	m.GetByPermissionIDFunc.appendCall(RolePermissionStoreGetByPermissionIDFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Transact method of
-// the parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreTransactFunc) SetDefaultHook(hook func(context.Context) (RepoStore, error)) {
+// SetDefaultHook sets function that is called when the GetByPermissionID
+// method of the parent MockRolePermissionStore instance is invoked and the
+// hook queue is empty.
+func (f *RolePermissionStoreGetByPermissionIDFunc) SetDefaultHook(hook func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Transact method of the parent MockRepoStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *RepoStoreTransactFunc) PushHook(hook func(context.Context) (RepoStore, error)) {
+// GetByPermissionID method of the parent MockRolePermissionStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RolePermissionStoreGetByPermissionIDFunc) PushHook(hook func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -48984,20 +50968,20 @@ func (f *RepoStoreTransactFunc) PushHook(hook func(context.Context) (RepoStore,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreTransactFunc) SetDefaultReturn(r0 RepoStore, r1 error) {
-	f.SetDefaultHook(func(context.Context) (RepoStore, error) {
+func (f *RolePermissionStoreGetByPermissionIDFunc) SetDefaultReturn(r0 []*types.RolePermission, r1 error) {
+	f.SetDefaultHook(func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreTransactFunc) PushReturn(r0 RepoStore, r1 error) {
-	f.PushHook(func(context.Context) (RepoStore, error) {
+func (f *RolePermissionStoreGetByPermissionIDFunc) PushReturn(r0 []*types.RolePermission, r1 error) {
+	f.PushHook(func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStoreTransactFunc) nextHook() func(context.Context) (RepoStore, error) {
+func (f *RolePermissionStoreGetByPermissionIDFunc) nextHook() func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -49010,32 +50994,37 @@ func (f *RepoStoreTransactFunc) nextHook() func(context.Context) (RepoStore, err
 	return hook
 }
 
-func (f *RepoStoreTransactFunc) appendCall(r0 RepoStoreTransactFuncCall) {
+func (f *RolePermissionStoreGetByPermissionIDFunc) appendCall(r0 RolePermissionStoreGetByPermissionIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreTransactFuncCall objects
-// describing the invocations of this function.
-func (f *RepoStoreTransactFunc) History() []RepoStoreTransactFuncCall {
+// History returns a sequence of
+// RolePermissionStoreGetByPermissionIDFuncCall objects describing the
+// invocations of this function.
+func (f *RolePermissionStoreGetByPermissionIDFunc) History() []RolePermissionStoreGetByPermissionIDFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreTransactFuncCall, len(f.history))
+	history := make([]RolePermissionStoreGetByPermissionIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreTransactFuncCall is an object that describes an invocation of
-// method Transact on an instance of MockRepoStore.
-type RepoStoreTransactFuncCall struct {
+// RolePermissionStoreGetByPermissionIDFuncCall is an object that describes
+// an invocation of method GetByPermissionID on an instance of
+// MockRolePermissionStore.
+type RolePermissionStoreGetByPermissionIDFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 GetRolePermissionOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 RepoStore
+	Result0 []*types.RolePermission
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -49043,44 +51032,46 @@ type RepoStoreTransactFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreTransactFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c RolePermissionStoreGetByPermissionIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreTransactFuncCall) Results() []interface{} {
+func (c RolePermissionStoreGetByPermissionIDFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreWithFunc describes the behavior when the With method of the
-// parent MockRepoStore instance is invoked.
-type RepoStoreWithFunc struct {
-	defaultHook func(basestore.ShareableStore) RepoStore
-	hooks       []func(basestore.ShareableStore) RepoStore
-	history     []RepoStoreWithFuncCall
+// RolePermissionStoreGetByRoleIDFunc describes the behavior when the
+// GetByRoleID method of the parent MockRolePermissionStore instance is
+// invoked.
+type RolePermissionStoreGetByRoleIDFunc struct {
+	defaultHook func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error)
+	hooks       []func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error)
+	history     []RolePermissionStoreGetByRoleIDFuncCall
 	mutex       sync.Mutex
 }
 
-// With delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStore) With(v0 basestore.ShareableStore) RepoStore {
-	r0 := m.WithFunc.nextHook()(v0)
-	m.WithFunc.appendCall(RepoStoreWithFuncCall{v0, r0})
-	return r0
+// GetByRoleID delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockRolePermissionStore) GetByRoleID(v0 context.Context, v1 GetRolePermissionOpts) ([]*types.RolePermission, error) {
+	r0, r1 := m.GetByRoleIDFunc.nextHook()(v0, v1)
+	m.GetByRoleIDFunc.appendCall(RolePermissionStoreGetByRoleIDFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the With method of the
-// parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) RepoStore) {
+// SetDefaultHook sets function that is called when the GetByRoleID method
+// of the parent MockRolePermissionStore instance is invoked and the hook
+// queue is empty.
+func (f *RolePermissionStoreGetByRoleIDFunc) SetDefaultHook(hook func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// With method of the parent MockRepoStore instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *RepoStoreWithFunc) PushHook(hook func(basestore.ShareableStore) RepoStore) {
+// GetByRoleID method of the parent MockRolePermissionStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *RolePermissionStoreGetByRoleIDFunc) PushHook(hook func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -49088,20 +51079,20 @@ func (f *RepoStoreWithFunc) PushHook(hook func(basestore.ShareableStore) RepoSto
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreWithFunc) SetDefaultReturn(r0 RepoStore) {
-	f.SetDefaultHook(func(basestore.ShareableStore) RepoStore {
-		return r0
+func (f *RolePermissionStoreGetByRoleIDFunc) SetDefaultReturn(r0 []*types.RolePermission, r1 error) {
+	f.SetDefaultHook(func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreWithFunc) PushReturn(r0 RepoStore) {
-	f.PushHook(func(basestore.ShareableStore) RepoStore {
-		return r0
+func (f *RolePermissionStoreGetByRoleIDFunc) PushReturn(r0 []*types.RolePermission, r1 error) {
+	f.PushHook(func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error) {
+		return r0, r1
 	})
 }
 
-func (f *RepoStoreWithFunc) nextHook() func(basestore.ShareableStore) RepoStore {
+func (f *RolePermissionStoreGetByRoleIDFunc) nextHook() func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -49114,318 +51105,84 @@ func (f *RepoStoreWithFunc) nextHook() func(basestore.ShareableStore) RepoStore
 	return hook
 }
 
-func (f *RepoStoreWithFunc) appendCall(r0 RepoStoreWithFuncCall) {
+func (f *RolePermissionStoreGetByRoleIDFunc) appendCall(r0 RolePermissionStoreGetByRoleIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreWithFuncCall objects describing
-// the invocations of this function.
-func (f *RepoStoreWithFunc) History() []RepoStoreWithFuncCall {
+// History returns a sequence of RolePermissionStoreGetByRoleIDFuncCall
+// objects describing the invocations of this function.
+func (f *RolePermissionStoreGetByRoleIDFunc) History() []RolePermissionStoreGetByRoleIDFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreWithFuncCall, len(f.history))
+	history := make([]RolePermissionStoreGetByRoleIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreWithFuncCall is an object that describes an invocation of method
-// With on an instance of MockRepoStore.
-type RepoStoreWithFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 basestore.ShareableStore
-	// Result0 is the value of the 1st result returned from this method
-	// invocation.
-	Result0 RepoStore
-}
-
-// Args returns an interface slice containing the arguments of this
-// invocation.
-func (c RepoStoreWithFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
-}
-
-// Results returns an interface slice containing the results of this
-// invocation.
-func (c RepoStoreWithFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
-}
-
-// MockRolePermissionStore is a mock implementation of the
-// RolePermissionStore interface (from the package
-// github.com/sourcegraph/sourcegraph/internal/database) used for unit
-// testing.
-type MockRolePermissionStore struct {
-	// AssignFunc is an instance of a mock function object controlling the
-	// behavior of the method Assign.
-	AssignFunc *RolePermissionStoreAssignFunc
-	// AssignToSystemRoleFunc is an instance of a mock function object
-	// controlling the behavior of the method AssignToSystemRole.
-	AssignToSystemRoleFunc *RolePermissionStoreAssignToSystemRoleFunc
-	// BulkAssignPermissionsToRoleFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// BulkAssignPermissionsToRole.
-	BulkAssignPermissionsToRoleFunc *RolePermissionStoreBulkAssignPermissionsToRoleFunc
-	// BulkAssignPermissionsToSystemRolesFunc is an instance of a mock
-	// function object controlling the behavior of the method
-	// BulkAssignPermissionsToSystemRoles.
-	BulkAssignPermissionsToSystemRolesFunc *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc
-	// BulkRevokePermissionsForRoleFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// BulkRevokePermissionsForRole.
-	BulkRevokePermissionsForRoleFunc *RolePermissionStoreBulkRevokePermissionsForRoleFunc
-	// GetByPermissionIDFunc is an instance of a mock function object
-	// controlling the behavior of the method GetByPermissionID.
-	GetByPermissionIDFunc *RolePermissionStoreGetByPermissionIDFunc
-	// GetByRoleIDFunc is an instance of a mock function object controlling
-	// the behavior of the method GetByRoleID.
-	GetByRoleIDFunc *RolePermissionStoreGetByRoleIDFunc
-	// GetByRoleIDAndPermissionIDFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// GetByRoleIDAndPermissionID.
-	GetByRoleIDAndPermissionIDFunc *RolePermissionStoreGetByRoleIDAndPermissionIDFunc
-	// HandleFunc is an instance of a mock function object controlling the
-	// behavior of the method Handle.
-	HandleFunc *RolePermissionStoreHandleFunc
-	// RevokeFunc is an instance of a mock function object controlling the
-	// behavior of the method Revoke.
-	RevokeFunc *RolePermissionStoreRevokeFunc
-	// SetPermissionsForRoleFunc is an instance of a mock function object
-	// controlling the behavior of the method SetPermissionsForRole.
-	SetPermissionsForRoleFunc *RolePermissionStoreSetPermissionsForRoleFunc
-	// WithFunc is an instance of a mock function object controlling the
-	// behavior of the method With.
-	WithFunc *RolePermissionStoreWithFunc
-	// WithTransactFunc is an instance of a mock function object controlling
-	// the behavior of the method WithTransact.
-	WithTransactFunc *RolePermissionStoreWithTransactFunc
-}
-
-// NewMockRolePermissionStore creates a new mock of the RolePermissionStore
-// interface. All methods return zero values for all results, unless
-// overwritten.
-func NewMockRolePermissionStore() *MockRolePermissionStore {
-	return &MockRolePermissionStore{
-		AssignFunc: &RolePermissionStoreAssignFunc{
-			defaultHook: func(context.Context, AssignRolePermissionOpts) (r0 error) {
-				return
-			},
-		},
-		AssignToSystemRoleFunc: &RolePermissionStoreAssignToSystemRoleFunc{
-			defaultHook: func(context.Context, AssignToSystemRoleOpts) (r0 error) {
-				return
-			},
-		},
-		BulkAssignPermissionsToRoleFunc: &RolePermissionStoreBulkAssignPermissionsToRoleFunc{
-			defaultHook: func(context.Context, BulkAssignPermissionsToRoleOpts) (r0 error) {
-				return
-			},
-		},
-		BulkAssignPermissionsToSystemRolesFunc: &RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc{
-			defaultHook: func(context.Context, BulkAssignPermissionsToSystemRolesOpts) (r0 error) {
-				return
-			},
-		},
-		BulkRevokePermissionsForRoleFunc: &RolePermissionStoreBulkRevokePermissionsForRoleFunc{
-			defaultHook: func(context.Context, BulkRevokePermissionsForRoleOpts) (r0 error) {
-				return
-			},
-		},
-		GetByPermissionIDFunc: &RolePermissionStoreGetByPermissionIDFunc{
-			defaultHook: func(context.Context, GetRolePermissionOpts) (r0 []*types.RolePermission, r1 error) {
-				return
-			},
-		},
-		GetByRoleIDFunc: &RolePermissionStoreGetByRoleIDFunc{
-			defaultHook: func(context.Context, GetRolePermissionOpts) (r0 []*types.RolePermission, r1 error) {
-				return
-			},
-		},
-		GetByRoleIDAndPermissionIDFunc: &RolePermissionStoreGetByRoleIDAndPermissionIDFunc{
-			defaultHook: func(context.Context, GetRolePermissionOpts) (r0 *types.RolePermission, r1 error) {
-				return
-			},
-		},
-		HandleFunc: &RolePermissionStoreHandleFunc{
-			defaultHook: func() (r0 basestore.TransactableHandle) {
-				return
-			},
-		},
-		RevokeFunc: &RolePermissionStoreRevokeFunc{
-			defaultHook: func(context.Context, RevokeRolePermissionOpts) (r0 error) {
-				return
-			},
-		},
-		SetPermissionsForRoleFunc: &RolePermissionStoreSetPermissionsForRoleFunc{
-			defaultHook: func(context.Context, SetPermissionsForRoleOpts) (r0 error) {
-				return
-			},
-		},
-		WithFunc: &RolePermissionStoreWithFunc{
-			defaultHook: func(basestore.ShareableStore) (r0 RolePermissionStore) {
-				return
-			},
-		},
-		WithTransactFunc: &RolePermissionStoreWithTransactFunc{
-			defaultHook: func(context.Context, func(RolePermissionStore) error) (r0 error) {
-				return
-			},
-		},
-	}
-}
-
-// NewStrictMockRolePermissionStore creates a new mock of the
-// RolePermissionStore interface. All methods panic on invocation, unless
-// overwritten.
-func NewStrictMockRolePermissionStore() *MockRolePermissionStore {
-	return &MockRolePermissionStore{
-		AssignFunc: &RolePermissionStoreAssignFunc{
-			defaultHook: func(context.Context, AssignRolePermissionOpts) error {
-				panic("unexpected invocation of MockRolePermissionStore.Assign")
-			},
-		},
-		AssignToSystemRoleFunc: &RolePermissionStoreAssignToSystemRoleFunc{
-			defaultHook: func(context.Context, AssignToSystemRoleOpts) error {
-				panic("unexpected invocation of MockRolePermissionStore.AssignToSystemRole")
-			},
-		},
-		BulkAssignPermissionsToRoleFunc: &RolePermissionStoreBulkAssignPermissionsToRoleFunc{
-			defaultHook: func(context.Context, BulkAssignPermissionsToRoleOpts) error {
-				panic("unexpected invocation of MockRolePermissionStore.BulkAssignPermissionsToRole")
-			},
-		},
-		BulkAssignPermissionsToSystemRolesFunc: &RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc{
-			defaultHook: func(context.Context, BulkAssignPermissionsToSystemRolesOpts) error {
-				panic("unexpected invocation of MockRolePermissionStore.BulkAssignPermissionsToSystemRoles")
-			},
-		},
-		BulkRevokePermissionsForRoleFunc: &RolePermissionStoreBulkRevokePermissionsForRoleFunc{
-			defaultHook: func(context.Context, BulkRevokePermissionsForRoleOpts) error {
-				panic("unexpected invocation of MockRolePermissionStore.BulkRevokePermissionsForRole")
-			},
-		},
-		GetByPermissionIDFunc: &RolePermissionStoreGetByPermissionIDFunc{
-			defaultHook: func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error) {
-				panic("unexpected invocation of MockRolePermissionStore.GetByPermissionID")
-			},
-		},
-		GetByRoleIDFunc: &RolePermissionStoreGetByRoleIDFunc{
-			defaultHook: func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error) {
-				panic("unexpected invocation of MockRolePermissionStore.GetByRoleID")
-			},
-		},
-		GetByRoleIDAndPermissionIDFunc: &RolePermissionStoreGetByRoleIDAndPermissionIDFunc{
-			defaultHook: func(context.Context, GetRolePermissionOpts) (*types.RolePermission, error) {
-				panic("unexpected invocation of MockRolePermissionStore.GetByRoleIDAndPermissionID")
-			},
-		},
-		HandleFunc: &RolePermissionStoreHandleFunc{
-			defaultHook: func() basestore.TransactableHandle {
-				panic("unexpected invocation of MockRolePermissionStore.Handle")
-			},
-		},
-		RevokeFunc: &RolePermissionStoreRevokeFunc{
-			defaultHook: func(context.Context, RevokeRolePermissionOpts) error {
-				panic("unexpected invocation of MockRolePermissionStore.Revoke")
-			},
-		},
-		SetPermissionsForRoleFunc: &RolePermissionStoreSetPermissionsForRoleFunc{
-			defaultHook: func(context.Context, SetPermissionsForRoleOpts) error {
-				panic("unexpected invocation of MockRolePermissionStore.SetPermissionsForRole")
-			},
-		},
-		WithFunc: &RolePermissionStoreWithFunc{
-			defaultHook: func(basestore.ShareableStore) RolePermissionStore {
-				panic("unexpected invocation of MockRolePermissionStore.With")
-			},
-		},
-		WithTransactFunc: &RolePermissionStoreWithTransactFunc{
-			defaultHook: func(context.Context, func(RolePermissionStore) error) error {
-				panic("unexpected invocation of MockRolePermissionStore.WithTransact")
-			},
-		},
-	}
+// RolePermissionStoreGetByRoleIDFuncCall is an object that describes an
+// invocation of method GetByRoleID on an instance of
+// MockRolePermissionStore.
+type RolePermissionStoreGetByRoleIDFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 GetRolePermissionOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*types.RolePermission
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
-// NewMockRolePermissionStoreFrom creates a new mock of the
-// MockRolePermissionStore interface. All methods delegate to the given
-// implementation, unless overwritten.
-func NewMockRolePermissionStoreFrom(i RolePermissionStore) *MockRolePermissionStore {
-	return &MockRolePermissionStore{
-		AssignFunc: &RolePermissionStoreAssignFunc{
-			defaultHook: i.Assign,
-		},
-		AssignToSystemRoleFunc: &RolePermissionStoreAssignToSystemRoleFunc{
-			defaultHook: i.AssignToSystemRole,
-		},
-		BulkAssignPermissionsToRoleFunc: &RolePermissionStoreBulkAssignPermissionsToRoleFunc{
-			defaultHook: i.BulkAssignPermissionsToRole,
-		},
-		BulkAssignPermissionsToSystemRolesFunc: &RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc{
-			defaultHook: i.BulkAssignPermissionsToSystemRoles,
-		},
-		BulkRevokePermissionsForRoleFunc: &RolePermissionStoreBulkRevokePermissionsForRoleFunc{
-			defaultHook: i.BulkRevokePermissionsForRole,
-		},
-		GetByPermissionIDFunc: &RolePermissionStoreGetByPermissionIDFunc{
-			defaultHook: i.GetByPermissionID,
-		},
-		GetByRoleIDFunc: &RolePermissionStoreGetByRoleIDFunc{
-			defaultHook: i.GetByRoleID,
-		},
-		GetByRoleIDAndPermissionIDFunc: &RolePermissionStoreGetByRoleIDAndPermissionIDFunc{
-			defaultHook: i.GetByRoleIDAndPermissionID,
-		},
-		HandleFunc: &RolePermissionStoreHandleFunc{
-			defaultHook: i.Handle,
-		},
-		RevokeFunc: &RolePermissionStoreRevokeFunc{
-			defaultHook: i.Revoke,
-		},
-		SetPermissionsForRoleFunc: &RolePermissionStoreSetPermissionsForRoleFunc{
-			defaultHook: i.SetPermissionsForRole,
-		},
-		WithFunc: &RolePermissionStoreWithFunc{
-			defaultHook: i.With,
-		},
-		WithTransactFunc: &RolePermissionStoreWithTransactFunc{
-			defaultHook: i.WithTransact,
-		},
-	}
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RolePermissionStoreGetByRoleIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
-// RolePermissionStoreAssignFunc describes the behavior when the Assign
-// method of the parent MockRolePermissionStore instance is invoked.
-type RolePermissionStoreAssignFunc struct {
-	defaultHook func(context.Context, AssignRolePermissionOpts) error
-	hooks       []func(context.Context, AssignRolePermissionOpts) error
-	history     []RolePermissionStoreAssignFuncCall
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RolePermissionStoreGetByRoleIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RolePermissionStoreGetByRoleIDAndPermissionIDFunc describes the behavior
+// when the GetByRoleIDAndPermissionID method of the parent
+// MockRolePermissionStore instance is invoked.
+type RolePermissionStoreGetByRoleIDAndPermissionIDFunc struct {
+	defaultHook func(context.Context, GetRolePermissionOpts) (*types.RolePermission, error)
+	hooks       []func(context.Context, GetRolePermissionOpts) (*types.RolePermission, error)
+	history     []RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall
 	mutex       sync.Mutex
 }
 
-// Assign delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRolePermissionStore) Assign(v0 context.Context, v1 AssignRolePermissionOpts) error {
-	r0 := m.AssignFunc.nextHook()(v0, v1)
-	m.AssignFunc.appendCall(RolePermissionStoreAssignFuncCall{v0, v1, r0})
-	return r0
+// GetByRoleIDAndPermissionID delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockRolePermissionStore) GetByRoleIDAndPermissionID(v0 context.Context, v1 GetRolePermissionOpts) (*types.RolePermission, error) {
+	r0, r1 := m.GetByRoleIDAndPermissionIDFunc.nextHook()(v0, v1)
+	m.GetByRoleIDAndPermissionIDFunc.appendCall(RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Assign method of the
-// parent MockRolePermissionStore instance is invoked and the hook queue is
-// empty.
-func (f *RolePermissionStoreAssignFunc) SetDefaultHook(hook func(context.Context, AssignRolePermissionOpts) error) {
+// SetDefaultHook sets function that is called when the
+// GetByRoleIDAndPermissionID method of the parent MockRolePermissionStore
+// instance is invoked and the hook queue is empty.
+func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) SetDefaultHook(hook func(context.Context, GetRolePermissionOpts) (*types.RolePermission, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Assign method of the parent MockRolePermissionStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *RolePermissionStoreAssignFunc) PushHook(hook func(context.Context, AssignRolePermissionOpts) error) {
+// GetByRoleIDAndPermissionID method of the parent MockRolePermissionStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) PushHook(hook func(context.Context, GetRolePermissionOpts) (*types.RolePermission, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -49433,20 +51190,20 @@ func (f *RolePermissionStoreAssignFunc) PushHook(hook func(context.Context, Assi
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreAssignFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, AssignRolePermissionOpts) error {
-		return r0
+func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) SetDefaultReturn(r0 *types.RolePermission, r1 error) {
+	f.SetDefaultHook(func(context.Context, GetRolePermissionOpts) (*types.RolePermission, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreAssignFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, AssignRolePermissionOpts) error {
-		return r0
+func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) PushReturn(r0 *types.RolePermission, r1 error) {
+	f.PushHook(func(context.Context, GetRolePermissionOpts) (*types.RolePermission, error) {
+		return r0, r1
 	})
 }
 
-func (f *RolePermissionStoreAssignFunc) nextHook() func(context.Context, AssignRolePermissionOpts) error {
+func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) nextHook() func(context.Context, GetRolePermissionOpts) (*types.RolePermission, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -49459,80 +51216,83 @@ func (f *RolePermissionStoreAssignFunc) nextHook() func(context.Context, AssignR
 	return hook
 }
 
-func (f *RolePermissionStoreAssignFunc) appendCall(r0 RolePermissionStoreAssignFuncCall) {
+func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) appendCall(r0 RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RolePermissionStoreAssignFuncCall objects
-// describing the invocations of this function.
-func (f *RolePermissionStoreAssignFunc) History() []RolePermissionStoreAssignFuncCall {
+// History returns a sequence of
+// RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall objects describing
+// the invocations of this function.
+func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) History() []RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreAssignFuncCall, len(f.history))
+	history := make([]RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreAssignFuncCall is an object that describes an
-// invocation of method Assign on an instance of MockRolePermissionStore.
-type RolePermissionStoreAssignFuncCall struct {
+// RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall is an object that
+// describes an invocation of method GetByRoleIDAndPermissionID on an
+// instance of MockRolePermissionStore.
+type RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 AssignRolePermissionOpts
+	Arg1 GetRolePermissionOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 *types.RolePermission
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreAssignFuncCall) Args() []interface{} {
+func (c RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreAssignFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// RolePermissionStoreAssignToSystemRoleFunc describes the behavior when the
-// AssignToSystemRole method of the parent MockRolePermissionStore instance
-// is invoked.
-type RolePermissionStoreAssignToSystemRoleFunc struct {
-	defaultHook func(context.Context, AssignToSystemRoleOpts) error
-	hooks       []func(context.Context, AssignToSystemRoleOpts) error
-	history     []RolePermissionStoreAssignToSystemRoleFuncCall
+// RolePermissionStoreHandleFunc describes the behavior when the Handle
+// method of the parent MockRolePermissionStore instance is invoked.
+type RolePermissionStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []RolePermissionStoreHandleFuncCall
 	mutex       sync.Mutex
 }
 
-// AssignToSystemRole delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockRolePermissionStore) AssignToSystemRole(v0 context.Context, v1 AssignToSystemRoleOpts) error {
-	r0 := m.AssignToSystemRoleFunc.nextHook()(v0, v1)
-	m.AssignToSystemRoleFunc.appendCall(RolePermissionStoreAssignToSystemRoleFuncCall{v0, v1, r0})
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRolePermissionStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(RolePermissionStoreHandleFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the AssignToSystemRole
-// method of the parent MockRolePermissionStore instance is invoked and the
-// hook queue is empty.
-func (f *RolePermissionStoreAssignToSystemRoleFunc) SetDefaultHook(hook func(context.Context, AssignToSystemRoleOpts) error) {
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockRolePermissionStore instance is invoked and the hook queue is
+// empty.
+func (f *RolePermissionStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// AssignToSystemRole method of the parent MockRolePermissionStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *RolePermissionStoreAssignToSystemRoleFunc) PushHook(hook func(context.Context, AssignToSystemRoleOpts) error) {
+// Handle method of the parent MockRolePermissionStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RolePermissionStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -49540,20 +51300,20 @@ func (f *RolePermissionStoreAssignToSystemRoleFunc) PushHook(hook func(context.C
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreAssignToSystemRoleFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, AssignToSystemRoleOpts) error {
+func (f *RolePermissionStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreAssignToSystemRoleFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, AssignToSystemRoleOpts) error {
+func (f *RolePermissionStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
-func (f *RolePermissionStoreAssignToSystemRoleFunc) nextHook() func(context.Context, AssignToSystemRoleOpts) error {
+func (f *RolePermissionStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -49566,82 +51326,72 @@ func (f *RolePermissionStoreAssignToSystemRoleFunc) nextHook() func(context.Cont
 	return hook
 }
 
-func (f *RolePermissionStoreAssignToSystemRoleFunc) appendCall(r0 RolePermissionStoreAssignToSystemRoleFuncCall) {
+func (f *RolePermissionStoreHandleFunc) appendCall(r0 RolePermissionStoreHandleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// RolePermissionStoreAssignToSystemRoleFuncCall objects describing the
-// invocations of this function.
-func (f *RolePermissionStoreAssignToSystemRoleFunc) History() []RolePermissionStoreAssignToSystemRoleFuncCall {
+// History returns a sequence of RolePermissionStoreHandleFuncCall objects
+// describing the invocations of this function.
+func (f *RolePermissionStoreHandleFunc) History() []RolePermissionStoreHandleFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreAssignToSystemRoleFuncCall, len(f.history))
+	history := make([]RolePermissionStoreHandleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreAssignToSystemRoleFuncCall is an object that describes
-// an invocation of method AssignToSystemRole on an instance of
-// MockRolePermissionStore.
-type RolePermissionStoreAssignToSystemRoleFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 AssignToSystemRoleOpts
+// RolePermissionStoreHandleFuncCall is an object that describes an
+// invocation of method Handle on an instance of MockRolePermissionStore.
+type RolePermissionStoreHandleFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 basestore.TransactableHandle
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreAssignToSystemRoleFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c RolePermissionStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreAssignToSystemRoleFuncCall) Results() []interface{} {
+func (c RolePermissionStoreHandleFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RolePermissionStoreBulkAssignPermissionsToRoleFunc describes the behavior
-// when the BulkAssignPermissionsToRole method of the parent
-// MockRolePermissionStore instance is invoked.
-type RolePermissionStoreBulkAssignPermissionsToRoleFunc struct {
-	defaultHook func(context.Context, BulkAssignPermissionsToRoleOpts) error
-	hooks       []func(context.Context, BulkAssignPermissionsToRoleOpts) error
-	history     []RolePermissionStoreBulkAssignPermissionsToRoleFuncCall
+// RolePermissionStoreRevokeFunc describes the behavior when the Revoke
+// method of the parent MockRolePermissionStore instance is invoked.
+type RolePermissionStoreRevokeFunc struct {
+	defaultHook func(context.Context, RevokeRolePermissionOpts) error
+	hooks       []func(context.Context, RevokeRolePermissionOpts) error
+	history     []RolePermissionStoreRevokeFuncCall
 	mutex       sync.Mutex
 }
 
-// BulkAssignPermissionsToRole delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockRolePermissionStore) BulkAssignPermissionsToRole(v0 context.Context, v1 BulkAssignPermissionsToRoleOpts) error {
-	r0 := m.BulkAssignPermissionsToRoleFunc.nextHook()(v0, v1)
-	m.BulkAssignPermissionsToRoleFunc.appendCall(RolePermissionStoreBulkAssignPermissionsToRoleFuncCall{v0, v1, r0})
+// Revoke delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRolePermissionStore) Revoke(v0 context.Context, v1 RevokeRolePermissionOpts) error {
+	r0 := m.RevokeFunc.nextHook()(v0, v1)
+	m.RevokeFunc.appendCall(RolePermissionStoreRevokeFuncCall{v0, v1, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the
-// BulkAssignPermissionsToRole method of the parent MockRolePermissionStore
-// instance is invoked and the hook queue is empty.
-func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) SetDefaultHook(hook func(context.Context, BulkAssignPermissionsToRoleOpts) error) {
+// SetDefaultHook sets function that is called when the Revoke method of the
+// parent MockRolePermissionStore instance is invoked and the hook queue is
+// empty.
+func (f *RolePermissionStoreRevokeFunc) SetDefaultHook(hook func(context.Context, RevokeRolePermissionOpts) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// BulkAssignPermissionsToRole method of the parent MockRolePermissionStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) PushHook(hook func(context.Context, BulkAssignPermissionsToRoleOpts) error) {
+// Revoke method of the parent MockRolePermissionStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RolePermissionStoreRevokeFunc) PushHook(hook func(context.Context, RevokeRolePermissionOpts) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -49649,20 +51399,20 @@ func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) PushHook(hook func(
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, BulkAssignPermissionsToRoleOpts) error {
+func (f *RolePermissionStoreRevokeFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, RevokeRolePermissionOpts) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, BulkAssignPermissionsToRoleOpts) error {
+func (f *RolePermissionStoreRevokeFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, RevokeRolePermissionOpts) error {
 		return r0
 	})
 }
 
-func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) nextHook() func(context.Context, BulkAssignPermissionsToRoleOpts) error {
+func (f *RolePermissionStoreRevokeFunc) nextHook() func(context.Context, RevokeRolePermissionOpts) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -49675,34 +51425,32 @@ func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) nextHook() func(con
 	return hook
 }
 
-func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) appendCall(r0 RolePermissionStoreBulkAssignPermissionsToRoleFuncCall) {
+func (f *RolePermissionStoreRevokeFunc) appendCall(r0 RolePermissionStoreRevokeFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// RolePermissionStoreBulkAssignPermissionsToRoleFuncCall objects describing
-// the invocations of this function.
-func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) History() []RolePermissionStoreBulkAssignPermissionsToRoleFuncCall {
+// History returns a sequence of RolePermissionStoreRevokeFuncCall objects
+// describing the invocations of this function.
+func (f *RolePermissionStoreRevokeFunc) History() []RolePermissionStoreRevokeFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreBulkAssignPermissionsToRoleFuncCall, len(f.history))
+	history := make([]RolePermissionStoreRevokeFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreBulkAssignPermissionsToRoleFuncCall is an object that
-// describes an invocation of method BulkAssignPermissionsToRole on an
-// instance of MockRolePermissionStore.
-type RolePermissionStoreBulkAssignPermissionsToRoleFuncCall struct {
+// RolePermissionStoreRevokeFuncCall is an object that describes an
+// invocation of method Revoke on an instance of MockRolePermissionStore.
+type RolePermissionStoreRevokeFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 BulkAssignPermissionsToRoleOpts
+	Arg1 RevokeRolePermissionOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -49710,47 +51458,47 @@ type RolePermissionStoreBulkAssignPermissionsToRoleFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreBulkAssignPermissionsToRoleFuncCall) Args() []interface{} {
+func (c RolePermissionStoreRevokeFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreBulkAssignPermissionsToRoleFuncCall) Results() []interface{} {
+func (c RolePermissionStoreRevokeFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc describes the
-// behavior when the BulkAssignPermissionsToSystemRoles method of the parent
-// MockRolePermissionStore instance is invoked.
-type RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc struct {
-	defaultHook func(context.Context, BulkAssignPermissionsToSystemRolesOpts) error
-	hooks       []func(context.Context, BulkAssignPermissionsToSystemRolesOpts) error
-	history     []RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall
+// RolePermissionStoreSetPermissionsForRoleFunc describes the behavior when
+// the SetPermissionsForRole method of the parent MockRolePermissionStore
+// instance is invoked.
+type RolePermissionStoreSetPermissionsForRoleFunc struct {
+	defaultHook func(context.Context, SetPermissionsForRoleOpts) error
+	hooks       []func(context.Context, SetPermissionsForRoleOpts) error
+	history     []RolePermissionStoreSetPermissionsForRoleFuncCall
 	mutex       sync.Mutex
 }
 
-// BulkAssignPermissionsToSystemRoles delegates to the next hook function in
-// the queue and stores the parameter and result values of this invocation.
-func (m *MockRolePermissionStore) BulkAssignPermissionsToSystemRoles(v0 context.Context, v1 BulkAssignPermissionsToSystemRolesOpts) error {
-	r0 := m.BulkAssignPermissionsToSystemRolesFunc.nextHook()(v0, v1)
-	m.BulkAssignPermissionsToSystemRolesFunc.appendCall(RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall{v0, v1, r0})
+// SetPermissionsForRole delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockRolePermissionStore) SetPermissionsForRole(v0 context.Context, v1 SetPermissionsForRoleOpts) error {
+	r0 := m.SetPermissionsForRoleFunc.nextHook()(v0, v1)
+	m.SetPermissionsForRoleFunc.appendCall(RolePermissionStoreSetPermissionsForRoleFuncCall{v0, v1, r0})
 	return r0
 }
 
 // SetDefaultHook sets function that is called when the
-// BulkAssignPermissionsToSystemRoles method of the parent
-// MockRolePermissionStore instance is invoked and the hook queue is empty.
-func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) SetDefaultHook(hook func(context.Context, BulkAssignPermissionsToSystemRolesOpts) error) {
+// SetPermissionsForRole method of the parent MockRolePermissionStore
+// instance is invoked and the hook queue is empty.
+func (f *RolePermissionStoreSetPermissionsForRoleFunc) SetDefaultHook(hook func(context.Context, SetPermissionsForRoleOpts) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// BulkAssignPermissionsToSystemRoles method of the parent
-// MockRolePermissionStore instance invokes the hook at the front of the
-// queue and discards it. After the queue is empty, the default hook
-// function is invoked for any future action.
-func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) PushHook(hook func(context.Context, BulkAssignPermissionsToSystemRolesOpts) error) {
+// SetPermissionsForRole method of the parent MockRolePermissionStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *RolePermissionStoreSetPermissionsForRoleFunc) PushHook(hook func(context.Context, SetPermissionsForRoleOpts) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -49758,20 +51506,20 @@ func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) PushHook(hoo
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, BulkAssignPermissionsToSystemRolesOpts) error {
+func (f *RolePermissionStoreSetPermissionsForRoleFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, SetPermissionsForRoleOpts) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, BulkAssignPermissionsToSystemRolesOpts) error {
+func (f *RolePermissionStoreSetPermissionsForRoleFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, SetPermissionsForRoleOpts) error {
 		return r0
 	})
 }
 
-func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) nextHook() func(context.Context, BulkAssignPermissionsToSystemRolesOpts) error {
+func (f *RolePermissionStoreSetPermissionsForRoleFunc) nextHook() func(context.Context, SetPermissionsForRoleOpts) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -49784,35 +51532,34 @@ func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) nextHook() f
 	return hook
 }
 
-func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) appendCall(r0 RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall) {
+func (f *RolePermissionStoreSetPermissionsForRoleFunc) appendCall(r0 RolePermissionStoreSetPermissionsForRoleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall objects
-// describing the invocations of this function.
-func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) History() []RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall {
+// RolePermissionStoreSetPermissionsForRoleFuncCall objects describing the
+// invocations of this function.
+func (f *RolePermissionStoreSetPermissionsForRoleFunc) History() []RolePermissionStoreSetPermissionsForRoleFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall, len(f.history))
+	history := make([]RolePermissionStoreSetPermissionsForRoleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall is an
-// object that describes an invocation of method
-// BulkAssignPermissionsToSystemRoles on an instance of
+// RolePermissionStoreSetPermissionsForRoleFuncCall is an object that
+// describes an invocation of method SetPermissionsForRole on an instance of
 // MockRolePermissionStore.
-type RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall struct {
+type RolePermissionStoreSetPermissionsForRoleFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 BulkAssignPermissionsToSystemRolesOpts
+	Arg1 SetPermissionsForRoleOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -49820,47 +51567,45 @@ type RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall) Args() []interface{} {
+func (c RolePermissionStoreSetPermissionsForRoleFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall) Results() []interface{} {
+func (c RolePermissionStoreSetPermissionsForRoleFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RolePermissionStoreBulkRevokePermissionsForRoleFunc describes the
-// behavior when the BulkRevokePermissionsForRole method of the parent
-// MockRolePermissionStore instance is invoked.
-type RolePermissionStoreBulkRevokePermissionsForRoleFunc struct {
-	defaultHook func(context.Context, BulkRevokePermissionsForRoleOpts) error
-	hooks       []func(context.Context, BulkRevokePermissionsForRoleOpts) error
-	history     []RolePermissionStoreBulkRevokePermissionsForRoleFuncCall
+// RolePermissionStoreWithFunc describes the behavior when the With method
+// of the parent MockRolePermissionStore instance is invoked.
+type RolePermissionStoreWithFunc struct {
+	defaultHook func(basestore.ShareableStore) RolePermissionStore
+	hooks       []func(basestore.ShareableStore) RolePermissionStore
+	history     []RolePermissionStoreWithFuncCall
 	mutex       sync.Mutex
 }
 
-// BulkRevokePermissionsForRole delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockRolePermissionStore) BulkRevokePermissionsForRole(v0 context.Context, v1 BulkRevokePermissionsForRoleOpts) error {
-	r0 := m.BulkRevokePermissionsForRoleFunc.nextHook()(v0, v1)
-	m.BulkRevokePermissionsForRoleFunc.appendCall(RolePermissionStoreBulkRevokePermissionsForRoleFuncCall{v0, v1, r0})
+// With delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRolePermissionStore) With(v0 basestore.ShareableStore) RolePermissionStore {
+	r0 := m.WithFunc.nextHook()(v0)
+	m.WithFunc.appendCall(RolePermissionStoreWithFuncCall{v0, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the
-// BulkRevokePermissionsForRole method of the parent MockRolePermissionStore
-// instance is invoked and the hook queue is empty.
-func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) SetDefaultHook(hook func(context.Context, BulkRevokePermissionsForRoleOpts) error) {
+// SetDefaultHook sets function that is called when the With method of the
+// parent MockRolePermissionStore instance is invoked and the hook queue is
+// empty.
+func (f *RolePermissionStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) RolePermissionStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// BulkRevokePermissionsForRole method of the parent MockRolePermissionStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) PushHook(hook func(context.Context, BulkRevokePermissionsForRoleOpts) error) {
+// With method of the parent MockRolePermissionStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RolePermissionStoreWithFunc) PushHook(hook func(basestore.ShareableStore) RolePermissionStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -49868,20 +51613,20 @@ func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) PushHook(hook func
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, BulkRevokePermissionsForRoleOpts) error {
+func (f *RolePermissionStoreWithFunc) SetDefaultReturn(r0 RolePermissionStore) {
+	f.SetDefaultHook(func(basestore.ShareableStore) RolePermissionStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, BulkRevokePermissionsForRoleOpts) error {
+func (f *RolePermissionStoreWithFunc) PushReturn(r0 RolePermissionStore) {
+	f.PushHook(func(basestore.ShareableStore) RolePermissionStore {
 		return r0
 	})
 }
 
-func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) nextHook() func(context.Context, BulkRevokePermissionsForRoleOpts) error {
+func (f *RolePermissionStoreWithFunc) nextHook() func(basestore.ShareableStore) RolePermissionStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -49894,82 +51639,77 @@ func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) nextHook() func(co
 	return hook
 }
 
-func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) appendCall(r0 RolePermissionStoreBulkRevokePermissionsForRoleFuncCall) {
+func (f *RolePermissionStoreWithFunc) appendCall(r0 RolePermissionStoreWithFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// RolePermissionStoreBulkRevokePermissionsForRoleFuncCall objects
+// History returns a sequence of RolePermissionStoreWithFuncCall objects
 // describing the invocations of this function.
-func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) History() []RolePermissionStoreBulkRevokePermissionsForRoleFuncCall {
+func (f *RolePermissionStoreWithFunc) History() []RolePermissionStoreWithFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreBulkRevokePermissionsForRoleFuncCall, len(f.history))
+	history := make([]RolePermissionStoreWithFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreBulkRevokePermissionsForRoleFuncCall is an object that
-// describes an invocation of method BulkRevokePermissionsForRole on an
-// instance of MockRolePermissionStore.
-type RolePermissionStoreBulkRevokePermissionsForRoleFuncCall struct {
+// RolePermissionStoreWithFuncCall is an object that describes an invocation
+// of method With on an instance of MockRolePermissionStore.
+type RolePermissionStoreWithFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 BulkRevokePermissionsForRoleOpts
+	Arg0 basestore.ShareableStore
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 RolePermissionStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreBulkRevokePermissionsForRoleFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c RolePermissionStoreWithFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreBulkRevokePermissionsForRoleFuncCall) Results() []interface{} {
+func (c RolePermissionStoreWithFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RolePermissionStoreGetByPermissionIDFunc describes the behavior when the
-// GetByPermissionID method of the parent MockRolePermissionStore instance
-// is invoked.
-type RolePermissionStoreGetByPermissionIDFunc struct {
-	defaultHook func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error)
-	hooks       []func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error)
-	history     []RolePermissionStoreGetByPermissionIDFuncCall
+// RolePermissionStoreWithTransactFunc describes the behavior when the
+// WithTransact method of the parent MockRolePermissionStore instance is
+// invoked.
+type RolePermissionStoreWithTransactFunc struct {
+	defaultHook func(context.Context, func(RolePermissionStore) error) error
+	hooks       []func(context.Context, func(RolePermissionStore) error) error
+	history     []RolePermissionStoreWithTransactFuncCall
 	mutex       sync.Mutex
 }
 
-// GetByPermissionID delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockRolePermissionStore) GetByPermissionID(v0 context.Context, v1 GetRolePermissionOpts) ([]*types.RolePermission, error) {
-	r0, r1 := m.GetByPermissionIDFunc.nextHook()(v0, v1)
-	m.GetByPermissionIDFunc.appendCall(RolePermissionStoreGetByPermissionIDFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// WithTransact delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockRolePermissionStore) WithTransact(v0 context.Context, v1 func(RolePermissionStore) error) error {
+	r0 := m.WithTransactFunc.nextHook()(v0, v1)
+	m.WithTransactFunc.appendCall(RolePermissionStoreWithTransactFuncCall{v0, v1, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the GetByPermissionID
-// method of the parent MockRolePermissionStore instance is invoked and the
-// hook queue is empty.
-func (f *RolePermissionStoreGetByPermissionIDFunc) SetDefaultHook(hook func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error)) {
+// SetDefaultHook sets function that is called when the WithTransact method
+// of the parent MockRolePermissionStore instance is invoked and the hook
+// queue is empty.
+func (f *RolePermissionStoreWithTransactFunc) SetDefaultHook(hook func(context.Context, func(RolePermissionStore) error) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetByPermissionID method of the parent MockRolePermissionStore instance
+// WithTransact method of the parent MockRolePermissionStore instance
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *RolePermissionStoreGetByPermissionIDFunc) PushHook(hook func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error)) {
+func (f *RolePermissionStoreWithTransactFunc) PushHook(hook func(context.Context, func(RolePermissionStore) error) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -49977,20 +51717,20 @@ func (f *RolePermissionStoreGetByPermissionIDFunc) PushHook(hook func(context.Co
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreGetByPermissionIDFunc) SetDefaultReturn(r0 []*types.RolePermission, r1 error) {
-	f.SetDefaultHook(func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error) {
-		return r0, r1
+func (f *RolePermissionStoreWithTransactFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, func(RolePermissionStore) error) error {
+		return r0
 	})
 }
-
-// PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreGetByPermissionIDFunc) PushReturn(r0 []*types.RolePermission, r1 error) {
-	f.PushHook(func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error) {
-		return r0, r1
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RolePermissionStoreWithTransactFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, func(RolePermissionStore) error) error {
+		return r0
 	})
 }
 
-func (f *RolePermissionStoreGetByPermissionIDFunc) nextHook() func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error) {
+func (f *RolePermissionStoreWithTransactFunc) nextHook() func(context.Context, func(RolePermissionStore) error) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -50003,84 +51743,217 @@ func (f *RolePermissionStoreGetByPermissionIDFunc) nextHook() func(context.Conte
 	return hook
 }
 
-func (f *RolePermissionStoreGetByPermissionIDFunc) appendCall(r0 RolePermissionStoreGetByPermissionIDFuncCall) {
+func (f *RolePermissionStoreWithTransactFunc) appendCall(r0 RolePermissionStoreWithTransactFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// RolePermissionStoreGetByPermissionIDFuncCall objects describing the
-// invocations of this function.
-func (f *RolePermissionStoreGetByPermissionIDFunc) History() []RolePermissionStoreGetByPermissionIDFuncCall {
+// History returns a sequence of RolePermissionStoreWithTransactFuncCall
+// objects describing the invocations of this function.
+func (f *RolePermissionStoreWithTransactFunc) History() []RolePermissionStoreWithTransactFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreGetByPermissionIDFuncCall, len(f.history))
+	history := make([]RolePermissionStoreWithTransactFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreGetByPermissionIDFuncCall is an object that describes
-// an invocation of method GetByPermissionID on an instance of
+// RolePermissionStoreWithTransactFuncCall is an object that describes an
+// invocation of method WithTransact on an instance of
 // MockRolePermissionStore.
-type RolePermissionStoreGetByPermissionIDFuncCall struct {
+type RolePermissionStoreWithTransactFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 GetRolePermissionOpts
+	Arg1 func(RolePermissionStore) error
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*types.RolePermission
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreGetByPermissionIDFuncCall) Args() []interface{} {
+func (c RolePermissionStoreWithTransactFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreGetByPermissionIDFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c RolePermissionStoreWithTransactFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// RolePermissionStoreGetByRoleIDFunc describes the behavior when the
-// GetByRoleID method of the parent MockRolePermissionStore instance is
-// invoked.
-type RolePermissionStoreGetByRoleIDFunc struct {
-	defaultHook func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error)
-	hooks       []func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error)
-	history     []RolePermissionStoreGetByRoleIDFuncCall
+// MockRoleStore is a mock implementation of the RoleStore interface (from
+// the package github.com/sourcegraph/sourcegraph/internal/database) used
+// for unit testing.
+type MockRoleStore struct {
+	// CountFunc is an instance of a mock function object controlling the
+	// behavior of the method Count.
+	CountFunc *RoleStoreCountFunc
+	// CreateFunc is an instance of a mock function object controlling the
+	// behavior of the method Create.
+	CreateFunc *RoleStoreCreateFunc
+	// DeleteFunc is an instance of a mock function object controlling the
+	// behavior of the method Delete.
+	DeleteFunc *RoleStoreDeleteFunc
+	// GetFunc is an instance of a mock function object controlling the
+	// behavior of the method Get.
+	GetFunc *RoleStoreGetFunc
+	// HandleFunc is an instance of a mock function object controlling the
+	// behavior of the method Handle.
+	HandleFunc *RoleStoreHandleFunc
+	// ListFunc is an instance of a mock function object controlling the
+	// behavior of the method List.
+	ListFunc *RoleStoreListFunc
+	// UpdateFunc is an instance of a mock function object controlling the
+	// behavior of the method Update.
+	UpdateFunc *RoleStoreUpdateFunc
+}
+
+// NewMockRoleStore creates a new mock of the RoleStore interface. All
+// methods return zero values for all results, unless overwritten.
+func NewMockRoleStore() *MockRoleStore {
+	return &MockRoleStore{
+		CountFunc: &RoleStoreCountFunc{
+			defaultHook: func(context.Context, RolesListOptions) (r0 int, r1 error) {
+				return
+			},
+		},
+		CreateFunc: &RoleStoreCreateFunc{
+			defaultHook: func(context.Context, string, bool) (r0 *types.Role, r1 error) {
+				return
+			},
+		},
+		DeleteFunc: &RoleStoreDeleteFunc{
+			defaultHook: func(context.Context, DeleteRoleOpts) (r0 error) {
+				return
+			},
+		},
+		GetFunc: &RoleStoreGetFunc{
+			defaultHook: func(context.Context, GetRoleOpts) (r0 *types.Role, r1 error) {
+				return
+			},
+		},
+		HandleFunc: &RoleStoreHandleFunc{
+			defaultHook: func() (r0 basestore.TransactableHandle) {
+				return
+			},
+		},
+		ListFunc: &RoleStoreListFunc{
+			defaultHook: func(context.Context, RolesListOptions) (r0 []*types.Role, r1 error) {
+				return
+			},
+		},
+		UpdateFunc: &RoleStoreUpdateFunc{
+			defaultHook: func(context.Context, *types.Role) (r0 *types.Role, r1 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockRoleStore creates a new mock of the RoleStore interface. All
+// methods panic on invocation, unless overwritten.
+func NewStrictMockRoleStore() *MockRoleStore {
+	return &MockRoleStore{
+		CountFunc: &RoleStoreCountFunc{
+			defaultHook: func(context.Context, RolesListOptions) (int, error) {
+				panic("unexpected invocation of MockRoleStore.Count")
+			},
+		},
+		CreateFunc: &RoleStoreCreateFunc{
+			defaultHook: func(context.Context, string, bool) (*types.Role, error) {
+				panic("unexpected invocation of MockRoleStore.Create")
+			},
+		},
+		DeleteFunc: &RoleStoreDeleteFunc{
+			defaultHook: func(context.Context, DeleteRoleOpts) error {
+				panic("unexpected invocation of MockRoleStore.Delete")
+			},
+		},
+		GetFunc: &RoleStoreGetFunc{
+			defaultHook: func(context.Context, GetRoleOpts) (*types.Role, error) {
+				panic("unexpected invocation of MockRoleStore.Get")
+			},
+		},
+		HandleFunc: &RoleStoreHandleFunc{
+			defaultHook: func() basestore.TransactableHandle {
+				panic("unexpected invocation of MockRoleStore.Handle")
+			},
+		},
+		ListFunc: &RoleStoreListFunc{
+			defaultHook: func(context.Context, RolesListOptions) ([]*types.Role, error) {
+				panic("unexpected invocation of MockRoleStore.List")
+			},
+		},
+		UpdateFunc: &RoleStoreUpdateFunc{
+			defaultHook: func(context.Context, *types.Role) (*types.Role, error) {
+				panic("unexpected invocation of MockRoleStore.Update")
+			},
+		},
+	}
+}
+
+// NewMockRoleStoreFrom creates a new mock of the MockRoleStore interface.
+// All methods delegate to the given implementation, unless overwritten.
+func NewMockRoleStoreFrom(i RoleStore) *MockRoleStore {
+	return &MockRoleStore{
+		CountFunc: &RoleStoreCountFunc{
+			defaultHook: i.Count,
+		},
+		CreateFunc: &RoleStoreCreateFunc{
+			defaultHook: i.Create,
+		},
+		DeleteFunc: &RoleStoreDeleteFunc{
+			defaultHook: i.Delete,
+		},
+		GetFunc: &RoleStoreGetFunc{
+			defaultHook: i.Get,
+		},
+		HandleFunc: &RoleStoreHandleFunc{
+			defaultHook: i.Handle,
+		},
+		ListFunc: &RoleStoreListFunc{
+			defaultHook: i.List,
+		},
+		UpdateFunc: &RoleStoreUpdateFunc{
+			defaultHook: i.Update,
+		},
+	}
+}
+
+// RoleStoreCountFunc describes the behavior when the Count method of the
+// parent MockRoleStore instance is invoked.
+type RoleStoreCountFunc struct {
+	defaultHook func(context.Context, RolesListOptions) (int, error)
+	hooks       []func(context.Context, RolesListOptions) (int, error)
+	history     []RoleStoreCountFuncCall
 	mutex       sync.Mutex
 }
 
-// GetByRoleID delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockRolePermissionStore) GetByRoleID(v0 context.Context, v1 GetRolePermissionOpts) ([]*types.RolePermission, error) {
-	r0, r1 := m.GetByRoleIDFunc.nextHook()(v0, v1)
-	m.GetByRoleIDFunc.appendCall(RolePermissionStoreGetByRoleIDFuncCall{v0, v1, r0, r1})
+// Count delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRoleStore) Count(v0 context.Context, v1 RolesListOptions) (int, error) {
+	r0, r1 := m.CountFunc.nextHook()(v0, v1)
+	m.CountFunc.appendCall(RoleStoreCountFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetByRoleID method
-// of the parent MockRolePermissionStore instance is invoked and the hook
-// queue is empty.
-func (f *RolePermissionStoreGetByRoleIDFunc) SetDefaultHook(hook func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error)) {
+// SetDefaultHook sets function that is called when the Count method of the
+// parent MockRoleStore instance is invoked and the hook queue is empty.
+func (f *RoleStoreCountFunc) SetDefaultHook(hook func(context.Context, RolesListOptions) (int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetByRoleID method of the parent MockRolePermissionStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *RolePermissionStoreGetByRoleIDFunc) PushHook(hook func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error)) {
+// Count method of the parent MockRoleStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *RoleStoreCountFunc) PushHook(hook func(context.Context, RolesListOptions) (int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -50088,20 +51961,20 @@ func (f *RolePermissionStoreGetByRoleIDFunc) PushHook(hook func(context.Context,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreGetByRoleIDFunc) SetDefaultReturn(r0 []*types.RolePermission, r1 error) {
-	f.SetDefaultHook(func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error) {
+func (f *RoleStoreCountFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, RolesListOptions) (int, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreGetByRoleIDFunc) PushReturn(r0 []*types.RolePermission, r1 error) {
-	f.PushHook(func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error) {
+func (f *RoleStoreCountFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, RolesListOptions) (int, error) {
 		return r0, r1
 	})
 }
 
-func (f *RolePermissionStoreGetByRoleIDFunc) nextHook() func(context.Context, GetRolePermissionOpts) ([]*types.RolePermission, error) {
+func (f *RoleStoreCountFunc) nextHook() func(context.Context, RolesListOptions) (int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -50114,36 +51987,35 @@ func (f *RolePermissionStoreGetByRoleIDFunc) nextHook() func(context.Context, Ge
 	return hook
 }
 
-func (f *RolePermissionStoreGetByRoleIDFunc) appendCall(r0 RolePermissionStoreGetByRoleIDFuncCall) {
+func (f *RoleStoreCountFunc) appendCall(r0 RoleStoreCountFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RolePermissionStoreGetByRoleIDFuncCall
-// objects describing the invocations of this function.
-func (f *RolePermissionStoreGetByRoleIDFunc) History() []RolePermissionStoreGetByRoleIDFuncCall {
+// History returns a sequence of RoleStoreCountFuncCall objects describing
+// the invocations of this function.
+func (f *RoleStoreCountFunc) History() []RoleStoreCountFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreGetByRoleIDFuncCall, len(f.history))
+	history := make([]RoleStoreCountFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreGetByRoleIDFuncCall is an object that describes an
-// invocation of method GetByRoleID on an instance of
-// MockRolePermissionStore.
-type RolePermissionStoreGetByRoleIDFuncCall struct {
+// RoleStoreCountFuncCall is an object that describes an invocation of
+// method Count on an instance of MockRoleStore.
+type RoleStoreCountFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 GetRolePermissionOpts
+	Arg1 RolesListOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*types.RolePermission
+	Result0 int
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -50151,47 +52023,44 @@ type RolePermissionStoreGetByRoleIDFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreGetByRoleIDFuncCall) Args() []interface{} {
+func (c RoleStoreCountFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreGetByRoleIDFuncCall) Results() []interface{} {
+func (c RoleStoreCountFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RolePermissionStoreGetByRoleIDAndPermissionIDFunc describes the behavior
-// when the GetByRoleIDAndPermissionID method of the parent
-// MockRolePermissionStore instance is invoked.
-type RolePermissionStoreGetByRoleIDAndPermissionIDFunc struct {
-	defaultHook func(context.Context, GetRolePermissionOpts) (*types.RolePermission, error)
-	hooks       []func(context.Context, GetRolePermissionOpts) (*types.RolePermission, error)
-	history     []RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall
+// RoleStoreCreateFunc describes the behavior when the Create method of the
+// parent MockRoleStore instance is invoked.
+type RoleStoreCreateFunc struct {
+	defaultHook func(context.Context, string, bool) (*types.Role, error)
+	hooks       []func(context.Context, string, bool) (*types.Role, error)
+	history     []RoleStoreCreateFuncCall
 	mutex       sync.Mutex
 }
 
-// GetByRoleIDAndPermissionID delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockRolePermissionStore) GetByRoleIDAndPermissionID(v0 context.Context, v1 GetRolePermissionOpts) (*types.RolePermission, error) {
-	r0, r1 := m.GetByRoleIDAndPermissionIDFunc.nextHook()(v0, v1)
-	m.GetByRoleIDAndPermissionIDFunc.appendCall(RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall{v0, v1, r0, r1})
+// Create delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRoleStore) Create(v0 context.Context, v1 string, v2 bool) (*types.Role, error) {
+	r0, r1 := m.CreateFunc.nextHook()(v0, v1, v2)
+	m.CreateFunc.appendCall(RoleStoreCreateFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// GetByRoleIDAndPermissionID method of the parent MockRolePermissionStore
-// instance is invoked and the hook queue is empty.
-func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) SetDefaultHook(hook func(context.Context, GetRolePermissionOpts) (*types.RolePermission, error)) {
+// SetDefaultHook sets function that is called when the Create method of the
+// parent MockRoleStore instance is invoked and the hook queue is empty.
+func (f *RoleStoreCreateFunc) SetDefaultHook(hook func(context.Context, string, bool) (*types.Role, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetByRoleIDAndPermissionID method of the parent MockRolePermissionStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) PushHook(hook func(context.Context, GetRolePermissionOpts) (*types.RolePermission, error)) {
+// Create method of the parent MockRoleStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RoleStoreCreateFunc) PushHook(hook func(context.Context, string, bool) (*types.Role, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -50199,20 +52068,20 @@ func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) PushHook(hook func(c
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) SetDefaultReturn(r0 *types.RolePermission, r1 error) {
-	f.SetDefaultHook(func(context.Context, GetRolePermissionOpts) (*types.RolePermission, error) {
+func (f *RoleStoreCreateFunc) SetDefaultReturn(r0 *types.Role, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, bool) (*types.Role, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) PushReturn(r0 *types.RolePermission, r1 error) {
-	f.PushHook(func(context.Context, GetRolePermissionOpts) (*types.RolePermission, error) {
+func (f *RoleStoreCreateFunc) PushReturn(r0 *types.Role, r1 error) {
+	f.PushHook(func(context.Context, string, bool) (*types.Role, error) {
 		return r0, r1
 	})
 }
 
-func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) nextHook() func(context.Context, GetRolePermissionOpts) (*types.RolePermission, error) {
+func (f *RoleStoreCreateFunc) nextHook() func(context.Context, string, bool) (*types.Role, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -50225,37 +52094,38 @@ func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) nextHook() func(cont
 	return hook
 }
 
-func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) appendCall(r0 RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall) {
+func (f *RoleStoreCreateFunc) appendCall(r0 RoleStoreCreateFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall objects describing
+// History returns a sequence of RoleStoreCreateFuncCall objects describing
 // the invocations of this function.
-func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) History() []RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall {
+func (f *RoleStoreCreateFunc) History() []RoleStoreCreateFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall, len(f.history))
+	history := make([]RoleStoreCreateFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall is an object that
-// describes an invocation of method GetByRoleIDAndPermissionID on an
-// instance of MockRolePermissionStore.
-type RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall struct {
+// RoleStoreCreateFuncCall is an object that describes an invocation of
+// method Create on an instance of MockRoleStore.
+type RoleStoreCreateFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 GetRolePermissionOpts
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 bool
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.RolePermission
+	Result0 *types.Role
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -50263,45 +52133,44 @@ type RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c RoleStoreCreateFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall) Results() []interface{} {
+func (c RoleStoreCreateFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RolePermissionStoreHandleFunc describes the behavior when the Handle
-// method of the parent MockRolePermissionStore instance is invoked.
-type RolePermissionStoreHandleFunc struct {
-	defaultHook func() basestore.TransactableHandle
-	hooks       []func() basestore.TransactableHandle
-	history     []RolePermissionStoreHandleFuncCall
+// RoleStoreDeleteFunc describes the behavior when the Delete method of the
+// parent MockRoleStore instance is invoked.
+type RoleStoreDeleteFunc struct {
+	defaultHook func(context.Context, DeleteRoleOpts) error
+	hooks       []func(context.Context, DeleteRoleOpts) error
+	history     []RoleStoreDeleteFuncCall
 	mutex       sync.Mutex
 }
 
-// Handle delegates to the next hook function in the queue and stores the
+// Delete delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRolePermissionStore) Handle() basestore.TransactableHandle {
-	r0 := m.HandleFunc.nextHook()()
-	m.HandleFunc.appendCall(RolePermissionStoreHandleFuncCall{r0})
+func (m *MockRoleStore) Delete(v0 context.Context, v1 DeleteRoleOpts) error {
+	r0 := m.DeleteFunc.nextHook()(v0, v1)
+	m.DeleteFunc.appendCall(RoleStoreDeleteFuncCall{v0, v1, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Handle method of the
-// parent MockRolePermissionStore instance is invoked and the hook queue is
-// empty.
-func (f *RolePermissionStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+// SetDefaultHook sets function that is called when the Delete method of the
+// parent MockRoleStore instance is invoked and the hook queue is empty.
+func (f *RoleStoreDeleteFunc) SetDefaultHook(hook func(context.Context, DeleteRoleOpts) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Handle method of the parent MockRolePermissionStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *RolePermissionStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+// Delete method of the parent MockRoleStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RoleStoreDeleteFunc) PushHook(hook func(context.Context, DeleteRoleOpts) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -50309,20 +52178,20 @@ func (f *RolePermissionStoreHandleFunc) PushHook(hook func() basestore.Transacta
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
-	f.SetDefaultHook(func() basestore.TransactableHandle {
+func (f *RoleStoreDeleteFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, DeleteRoleOpts) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
-	f.PushHook(func() basestore.TransactableHandle {
+func (f *RoleStoreDeleteFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, DeleteRoleOpts) error {
 		return r0
 	})
 }
 
-func (f *RolePermissionStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+func (f *RoleStoreDeleteFunc) nextHook() func(context.Context, DeleteRoleOpts) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -50335,72 +52204,77 @@ func (f *RolePermissionStoreHandleFunc) nextHook() func() basestore.Transactable
 	return hook
 }
 
-func (f *RolePermissionStoreHandleFunc) appendCall(r0 RolePermissionStoreHandleFuncCall) {
+func (f *RoleStoreDeleteFunc) appendCall(r0 RoleStoreDeleteFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RolePermissionStoreHandleFuncCall objects
-// describing the invocations of this function.
-func (f *RolePermissionStoreHandleFunc) History() []RolePermissionStoreHandleFuncCall {
+// History returns a sequence of RoleStoreDeleteFuncCall objects describing
+// the invocations of this function.
+func (f *RoleStoreDeleteFunc) History() []RoleStoreDeleteFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreHandleFuncCall, len(f.history))
+	history := make([]RoleStoreDeleteFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreHandleFuncCall is an object that describes an
-// invocation of method Handle on an instance of MockRolePermissionStore.
-type RolePermissionStoreHandleFuncCall struct {
+// RoleStoreDeleteFuncCall is an object that describes an invocation of
+// method Delete on an instance of MockRoleStore.
+type RoleStoreDeleteFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 DeleteRoleOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 basestore.TransactableHandle
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreHandleFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c RoleStoreDeleteFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreHandleFuncCall) Results() []interface{} {
+func (c RoleStoreDeleteFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RolePermissionStoreRevokeFunc describes the behavior when the Revoke
-// method of the parent MockRolePermissionStore instance is invoked.
-type RolePermissionStoreRevokeFunc struct {
-	defaultHook func(context.Context, RevokeRolePermissionOpts) error
-	hooks       []func(context.Context, RevokeRolePermissionOpts) error
-	history     []RolePermissionStoreRevokeFuncCall
+// RoleStoreGetFunc describes the behavior when the Get method of the parent
+// MockRoleStore instance is invoked.
+type RoleStoreGetFunc struct {
+	defaultHook func(context.Context, GetRoleOpts) (*types.Role, error)
+	hooks       []func(context.Context, GetRoleOpts) (*types.Role, error)
+	history     []RoleStoreGetFuncCall
 	mutex       sync.Mutex
 }
 
-// Revoke delegates to the next hook function in the queue and stores the
+// Get delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRolePermissionStore) Revoke(v0 context.Context, v1 RevokeRolePermissionOpts) error {
-	r0 := m.RevokeFunc.nextHook()(v0, v1)
-	m.RevokeFunc.appendCall(RolePermissionStoreRevokeFuncCall{v0, v1, r0})
-	return r0
+func (m *MockRoleStore) Get(v0 context.Context, v1 GetRoleOpts) (*types.Role, error) {
+	r0, r1 := m.GetFunc.nextHook()(v0, v1)
+	m.GetFunc.appendCall(RoleStoreGetFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Revoke method of the
-// parent MockRolePermissionStore instance is invoked and the hook queue is
-// empty.
-func (f *RolePermissionStoreRevokeFunc) SetDefaultHook(hook func(context.Context, RevokeRolePermissionOpts) error) {
+// SetDefaultHook sets function that is called when the Get method of the
+// parent MockRoleStore instance is invoked and the hook queue is empty.
+func (f *RoleStoreGetFunc) SetDefaultHook(hook func(context.Context, GetRoleOpts) (*types.Role, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Revoke method of the parent MockRolePermissionStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *RolePermissionStoreRevokeFunc) PushHook(hook func(context.Context, RevokeRolePermissionOpts) error) {
+// Get method of the parent MockRoleStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *RoleStoreGetFunc) PushHook(hook func(context.Context, GetRoleOpts) (*types.Role, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -50408,20 +52282,20 @@ func (f *RolePermissionStoreRevokeFunc) PushHook(hook func(context.Context, Revo
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreRevokeFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, RevokeRolePermissionOpts) error {
-		return r0
+func (f *RoleStoreGetFunc) SetDefaultReturn(r0 *types.Role, r1 error) {
+	f.SetDefaultHook(func(context.Context, GetRoleOpts) (*types.Role, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreRevokeFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, RevokeRolePermissionOpts) error {
-		return r0
+func (f *RoleStoreGetFunc) PushReturn(r0 *types.Role, r1 error) {
+	f.PushHook(func(context.Context, GetRoleOpts) (*types.Role, error) {
+		return r0, r1
 	})
 }
 
-func (f *RolePermissionStoreRevokeFunc) nextHook() func(context.Context, RevokeRolePermissionOpts) error {
+func (f *RoleStoreGetFunc) nextHook() func(context.Context, GetRoleOpts) (*types.Role, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -50434,80 +52308,80 @@ func (f *RolePermissionStoreRevokeFunc) nextHook() func(context.Context, RevokeR
 	return hook
 }
 
-func (f *RolePermissionStoreRevokeFunc) appendCall(r0 RolePermissionStoreRevokeFuncCall) {
+func (f *RoleStoreGetFunc) appendCall(r0 RoleStoreGetFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RolePermissionStoreRevokeFuncCall objects
-// describing the invocations of this function.
-func (f *RolePermissionStoreRevokeFunc) History() []RolePermissionStoreRevokeFuncCall {
+// History returns a sequence of RoleStoreGetFuncCall objects describing the
+// invocations of this function.
+func (f *RoleStoreGetFunc) History() []RoleStoreGetFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreRevokeFuncCall, len(f.history))
+	history := make([]RoleStoreGetFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreRevokeFuncCall is an object that describes an
-// invocation of method Revoke on an instance of MockRolePermissionStore.
-type RolePermissionStoreRevokeFuncCall struct {
+// RoleStoreGetFuncCall is an object that describes an invocation of method
+// Get on an instance of MockRoleStore.
+type RoleStoreGetFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 RevokeRolePermissionOpts
+	Arg1 GetRoleOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 *types.Role
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreRevokeFuncCall) Args() []interface{} {
+func (c RoleStoreGetFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreRevokeFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c RoleStoreGetFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// RolePermissionStoreSetPermissionsForRoleFunc describes the behavior when
-// the SetPermissionsForRole method of the parent MockRolePermissionStore
-// instance is invoked.
-type RolePermissionStoreSetPermissionsForRoleFunc struct {
-	defaultHook func(context.Context, SetPermissionsForRoleOpts) error
-	hooks       []func(context.Context, SetPermissionsForRoleOpts) error
-	history     []RolePermissionStoreSetPermissionsForRoleFuncCall
+// RoleStoreHandleFunc describes the behavior when the Handle method of the
+// parent MockRoleStore instance is invoked.
+type RoleStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []RoleStoreHandleFuncCall
 	mutex       sync.Mutex
 }
 
-// SetPermissionsForRole delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockRolePermissionStore) SetPermissionsForRole(v0 context.Context, v1 SetPermissionsForRoleOpts) error {
-	r0 := m.SetPermissionsForRoleFunc.nextHook()(v0, v1)
-	m.SetPermissionsForRoleFunc.appendCall(RolePermissionStoreSetPermissionsForRoleFuncCall{v0, v1, r0})
-	return r0
-}
-
-// SetDefaultHook sets function that is called when the
-// SetPermissionsForRole method of the parent MockRolePermissionStore
-// instance is invoked and the hook queue is empty.
-func (f *RolePermissionStoreSetPermissionsForRoleFunc) SetDefaultHook(hook func(context.Context, SetPermissionsForRoleOpts) error) {
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRoleStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(RoleStoreHandleFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockRoleStore instance is invoked and the hook queue is empty.
+func (f *RoleStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// SetPermissionsForRole method of the parent MockRolePermissionStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *RolePermissionStoreSetPermissionsForRoleFunc) PushHook(hook func(context.Context, SetPermissionsForRoleOpts) error) {
+// Handle method of the parent MockRoleStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RoleStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -50515,20 +52389,20 @@ func (f *RolePermissionStoreSetPermissionsForRoleFunc) PushHook(hook func(contex
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreSetPermissionsForRoleFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, SetPermissionsForRoleOpts) error {
+func (f *RoleStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreSetPermissionsForRoleFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, SetPermissionsForRoleOpts) error {
+func (f *RoleStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
-func (f *RolePermissionStoreSetPermissionsForRoleFunc) nextHook() func(context.Context, SetPermissionsForRoleOpts) error {
+func (f *RoleStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -50541,80 +52415,71 @@ func (f *RolePermissionStoreSetPermissionsForRoleFunc) nextHook() func(context.C
 	return hook
 }
 
-func (f *RolePermissionStoreSetPermissionsForRoleFunc) appendCall(r0 RolePermissionStoreSetPermissionsForRoleFuncCall) {
+func (f *RoleStoreHandleFunc) appendCall(r0 RoleStoreHandleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// RolePermissionStoreSetPermissionsForRoleFuncCall objects describing the
-// invocations of this function.
-func (f *RolePermissionStoreSetPermissionsForRoleFunc) History() []RolePermissionStoreSetPermissionsForRoleFuncCall {
+// History returns a sequence of RoleStoreHandleFuncCall objects describing
+// the invocations of this function.
+func (f *RoleStoreHandleFunc) History() []RoleStoreHandleFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreSetPermissionsForRoleFuncCall, len(f.history))
+	history := make([]RoleStoreHandleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreSetPermissionsForRoleFuncCall is an object that
-// describes an invocation of method SetPermissionsForRole on an instance of
-// MockRolePermissionStore.
-type RolePermissionStoreSetPermissionsForRoleFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 SetPermissionsForRoleOpts
+// RoleStoreHandleFuncCall is an object that describes an invocation of
+// method Handle on an instance of MockRoleStore.
+type RoleStoreHandleFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 basestore.TransactableHandle
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreSetPermissionsForRoleFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c RoleStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreSetPermissionsForRoleFuncCall) Results() []interface{} {
+func (c RoleStoreHandleFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RolePermissionStoreWithFunc describes the behavior when the With method
-// of the parent MockRolePermissionStore instance is invoked.
-type RolePermissionStoreWithFunc struct {
-	defaultHook func(basestore.ShareableStore) RolePermissionStore
-	hooks       []func(basestore.ShareableStore) RolePermissionStore
-	history     []RolePermissionStoreWithFuncCall
+// RoleStoreListFunc describes the behavior when the List method of the
+// parent MockRoleStore instance is invoked.
+type RoleStoreListFunc struct {
+	defaultHook func(context.Context, RolesListOptions) ([]*types.Role, error)
+	hooks       []func(context.Context, RolesListOptions) ([]*types.Role, error)
+	history     []RoleStoreListFuncCall
 	mutex       sync.Mutex
 }
 
-// With delegates to the next hook function in the queue and stores the
+// List delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRolePermissionStore) With(v0 basestore.ShareableStore) RolePermissionStore {
-	r0 := m.WithFunc.nextHook()(v0)
-	m.WithFunc.appendCall(RolePermissionStoreWithFuncCall{v0, r0})
-	return r0
+func (m *MockRoleStore) List(v0 context.Context, v1 RolesListOptions) ([]*types.Role, error) {
+	r0, r1 := m.ListFunc.nextHook()(v0, v1)
+	m.ListFunc.appendCall(RoleStoreListFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the With method of the
-// parent MockRolePermissionStore instance is invoked and the hook queue is
-// empty.
-func (f *RolePermissionStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) RolePermissionStore) {
+// SetDefaultHook sets function that is called when the List method of the
+// parent MockRoleStore instance is invoked and the hook queue is empty.
+func (f *RoleStoreListFunc) SetDefaultHook(hook func(context.Context, RolesListOptions) ([]*types.Role, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// With method of the parent MockRolePermissionStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *RolePermissionStoreWithFunc) PushHook(hook func(basestore.ShareableStore) RolePermissionStore) {
+// List method of the parent MockRoleStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *RoleStoreListFunc) PushHook(hook func(context.Context, RolesListOptions) ([]*types.Role, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -50622,20 +52487,20 @@ func (f *RolePermissionStoreWithFunc) PushHook(hook func(basestore.ShareableStor
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreWithFunc) SetDefaultReturn(r0 RolePermissionStore) {
-	f.SetDefaultHook(func(basestore.ShareableStore) RolePermissionStore {
-		return r0
+func (f *RoleStoreListFunc) SetDefaultReturn(r0 []*types.Role, r1 error) {
+	f.SetDefaultHook(func(context.Context, RolesListOptions) ([]*types.Role, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreWithFunc) PushReturn(r0 RolePermissionStore) {
-	f.PushHook(func(basestore.ShareableStore) RolePermissionStore {
-		return r0
+func (f *RoleStoreListFunc) PushReturn(r0 []*types.Role, r1 error) {
+	f.PushHook(func(context.Context, RolesListOptions) ([]*types.Role, error) {
+		return r0, r1
 	})
 }
 
-func (f *RolePermissionStoreWithFunc) nextHook() func(basestore.ShareableStore) RolePermissionStore {
+func (f *RoleStoreListFunc) nextHook() func(context.Context, RolesListOptions) ([]*types.Role, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -50648,77 +52513,80 @@ func (f *RolePermissionStoreWithFunc) nextHook() func(basestore.ShareableStore)
 	return hook
 }
 
-func (f *RolePermissionStoreWithFunc) appendCall(r0 RolePermissionStoreWithFuncCall) {
+func (f *RoleStoreListFunc) appendCall(r0 RoleStoreListFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RolePermissionStoreWithFuncCall objects
-// describing the invocations of this function.
-func (f *RolePermissionStoreWithFunc) History() []RolePermissionStoreWithFuncCall {
+// History returns a sequence of RoleStoreListFuncCall objects describing
+// the invocations of this function.
+func (f *RoleStoreListFunc) History() []RoleStoreListFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreWithFuncCall, len(f.history))
+	history := make([]RoleStoreListFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreWithFuncCall is an object that describes an invocation
-// of method With on an instance of MockRolePermissionStore.
-type RolePermissionStoreWithFuncCall struct {
+// RoleStoreListFuncCall is an object that describes an invocation of method
+// List on an instance of MockRoleStore.
+type RoleStoreListFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 basestore.ShareableStore
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 RolesListOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 RolePermissionStore
+	Result0 []*types.Role
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreWithFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c RoleStoreListFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreWithFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c RoleStoreListFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// RolePermissionStoreWithTransactFunc describes the behavior when the
-// WithTransact method of the parent MockRolePermissionStore instance is
-// invoked.
-type RolePermissionStoreWithTransactFunc struct {
-	defaultHook func(context.Context, func(RolePermissionStore) error) error
-	hooks       []func(context.Context, func(RolePermissionStore) error) error
-	history     []RolePermissionStoreWithTransactFuncCall
+// RoleStoreUpdateFunc describes the behavior when the Update method of the
+// parent MockRoleStore instance is invoked.
+type RoleStoreUpdateFunc struct {
+	defaultHook func(context.Context, *types.Role) (*types.Role, error)
+	hooks       []func(context.Context, *types.Role) (*types.Role, error)
+	history     []RoleStoreUpdateFuncCall
 	mutex       sync.Mutex
 }
 
-// WithTransact delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockRolePermissionStore) WithTransact(v0 context.Context, v1 func(RolePermissionStore) error) error {
-	r0 := m.WithTransactFunc.nextHook()(v0, v1)
-	m.WithTransactFunc.appendCall(RolePermissionStoreWithTransactFuncCall{v0, v1, r0})
-	return r0
+// Update delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRoleStore) Update(v0 context.Context, v1 *types.Role) (*types.Role, error) {
+	r0, r1 := m.UpdateFunc.nextHook()(v0, v1)
+	m.UpdateFunc.appendCall(RoleStoreUpdateFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the WithTransact method
-// of the parent MockRolePermissionStore instance is invoked and the hook
-// queue is empty.
-func (f *RolePermissionStoreWithTransactFunc) SetDefaultHook(hook func(context.Context, func(RolePermissionStore) error) error) {
+// SetDefaultHook sets function that is called when the Update method of the
+// parent MockRoleStore instance is invoked and the hook queue is empty.
+func (f *RoleStoreUpdateFunc) SetDefaultHook(hook func(context.Context, *types.Role) (*types.Role, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// WithTransact method of the parent MockRolePermissionStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *RolePermissionStoreWithTransactFunc) PushHook(hook func(context.Context, func(RolePermissionStore) error) error) {
+// Update method of the parent MockRoleStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RoleStoreUpdateFunc) PushHook(hook func(context.Context, *types.Role) (*types.Role, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -50726,20 +52594,20 @@ func (f *RolePermissionStoreWithTransactFunc) PushHook(hook func(context.Context
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreWithTransactFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, func(RolePermissionStore) error) error {
-		return r0
+func (f *RoleStoreUpdateFunc) SetDefaultReturn(r0 *types.Role, r1 error) {
+	f.SetDefaultHook(func(context.Context, *types.Role) (*types.Role, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreWithTransactFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, func(RolePermissionStore) error) error {
-		return r0
+func (f *RoleStoreUpdateFunc) PushReturn(r0 *types.Role, r1 error) {
+	f.PushHook(func(context.Context, *types.Role) (*types.Role, error) {
+		return r0, r1
 	})
 }
 
-func (f *RolePermissionStoreWithTransactFunc) nextHook() func(context.Context, func(RolePermissionStore) error) error {
+func (f *RoleStoreUpdateFunc) nextHook() func(context.Context, *types.Role) (*types.Role, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -50752,217 +52620,324 @@ func (f *RolePermissionStoreWithTransactFunc) nextHook() func(context.Context, f
 	return hook
 }
 
-func (f *RolePermissionStoreWithTransactFunc) appendCall(r0 RolePermissionStoreWithTransactFuncCall) {
+func (f *RoleStoreUpdateFunc) appendCall(r0 RoleStoreUpdateFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RolePermissionStoreWithTransactFuncCall
-// objects describing the invocations of this function.
-func (f *RolePermissionStoreWithTransactFunc) History() []RolePermissionStoreWithTransactFuncCall {
+// History returns a sequence of RoleStoreUpdateFuncCall objects describing
+// the invocations of this function.
+func (f *RoleStoreUpdateFunc) History() []RoleStoreUpdateFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreWithTransactFuncCall, len(f.history))
+	history := make([]RoleStoreUpdateFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreWithTransactFuncCall is an object that describes an
-// invocation of method WithTransact on an instance of
-// MockRolePermissionStore.
-type RolePermissionStoreWithTransactFuncCall struct {
+// RoleStoreUpdateFuncCall is an object that describes an invocation of
+// method Update on an instance of MockRoleStore.
+type RoleStoreUpdateFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 func(RolePermissionStore) error
+	Arg1 *types.Role
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 *types.Role
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreWithTransactFuncCall) Args() []interface{} {
+func (c RoleStoreUpdateFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreWithTransactFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c RoleStoreUpdateFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// MockRoleStore is a mock implementation of the RoleStore interface (from
-// the package github.com/sourcegraph/sourcegraph/internal/database) used
-// for unit testing.
-type MockRoleStore struct {
-	// CountFunc is an instance of a mock function object controlling the
-	// behavior of the method Count.
-	CountFunc *RoleStoreCountFunc
+// MockSavedSearchStore is a mock implementation of the SavedSearchStore
+// interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/database) used for unit
+// testing.
+type MockSavedSearchStore struct {
+	// CountSavedSearchesByOrgOrUserFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// CountSavedSearchesByOrgOrUser.
+	CountSavedSearchesByOrgOrUserFunc *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc
 	// CreateFunc is an instance of a mock function object controlling the
 	// behavior of the method Create.
-	CreateFunc *RoleStoreCreateFunc
+	CreateFunc *SavedSearchStoreCreateFunc
 	// DeleteFunc is an instance of a mock function object controlling the
 	// behavior of the method Delete.
-	DeleteFunc *RoleStoreDeleteFunc
-	// GetFunc is an instance of a mock function object controlling the
-	// behavior of the method Get.
-	GetFunc *RoleStoreGetFunc
+	DeleteFunc *SavedSearchStoreDeleteFunc
+	// GetByIDFunc is an instance of a mock function object controlling the
+	// behavior of the method GetByID.
+	GetByIDFunc *SavedSearchStoreGetByIDFunc
 	// HandleFunc is an instance of a mock function object controlling the
 	// behavior of the method Handle.
-	HandleFunc *RoleStoreHandleFunc
-	// ListFunc is an instance of a mock function object controlling the
-	// behavior of the method List.
-	ListFunc *RoleStoreListFunc
+	HandleFunc *SavedSearchStoreHandleFunc
+	// IsEmptyFunc is an instance of a mock function object controlling the
+	// behavior of the method IsEmpty.
+	IsEmptyFunc *SavedSearchStoreIsEmptyFunc
+	// ListAllFunc is an instance of a mock function object controlling the
+	// behavior of the method ListAll.
+	ListAllFunc *SavedSearchStoreListAllFunc
+	// ListSavedSearchesByOrgIDFunc is an instance of a mock function object
+	// controlling the behavior of the method ListSavedSearchesByOrgID.
+	ListSavedSearchesByOrgIDFunc *SavedSearchStoreListSavedSearchesByOrgIDFunc
+	// ListSavedSearchesByOrgOrUserFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// ListSavedSearchesByOrgOrUser.
+	ListSavedSearchesByOrgOrUserFunc *SavedSearchStoreListSavedSearchesByOrgOrUserFunc
+	// ListSavedSearchesByUserIDFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// ListSavedSearchesByUserID.
+	ListSavedSearchesByUserIDFunc *SavedSearchStoreListSavedSearchesByUserIDFunc
 	// UpdateFunc is an instance of a mock function object controlling the
 	// behavior of the method Update.
-	UpdateFunc *RoleStoreUpdateFunc
+	UpdateFunc *SavedSearchStoreUpdateFunc
+	// WithFunc is an instance of a mock function object controlling the
+	// behavior of the method With.
+	WithFunc *SavedSearchStoreWithFunc
+	// WithTransactFunc is an instance of a mock function object controlling
+	// the behavior of the method WithTransact.
+	WithTransactFunc *SavedSearchStoreWithTransactFunc
 }
 
-// NewMockRoleStore creates a new mock of the RoleStore interface. All
-// methods return zero values for all results, unless overwritten.
-func NewMockRoleStore() *MockRoleStore {
-	return &MockRoleStore{
-		CountFunc: &RoleStoreCountFunc{
-			defaultHook: func(context.Context, RolesListOptions) (r0 int, r1 error) {
+// NewMockSavedSearchStore creates a new mock of the SavedSearchStore
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockSavedSearchStore() *MockSavedSearchStore {
+	return &MockSavedSearchStore{
+		CountSavedSearchesByOrgOrUserFunc: &SavedSearchStoreCountSavedSearchesByOrgOrUserFunc{
+			defaultHook: func(context.Context, *int32, *int32) (r0 int, r1 error) {
 				return
 			},
 		},
-		CreateFunc: &RoleStoreCreateFunc{
-			defaultHook: func(context.Context, string, bool) (r0 *types.Role, r1 error) {
+		CreateFunc: &SavedSearchStoreCreateFunc{
+			defaultHook: func(context.Context, *types.SavedSearch) (r0 *types.SavedSearch, r1 error) {
 				return
 			},
 		},
-		DeleteFunc: &RoleStoreDeleteFunc{
-			defaultHook: func(context.Context, DeleteRoleOpts) (r0 error) {
+		DeleteFunc: &SavedSearchStoreDeleteFunc{
+			defaultHook: func(context.Context, int32) (r0 error) {
 				return
 			},
 		},
-		GetFunc: &RoleStoreGetFunc{
-			defaultHook: func(context.Context, GetRoleOpts) (r0 *types.Role, r1 error) {
+		GetByIDFunc: &SavedSearchStoreGetByIDFunc{
+			defaultHook: func(context.Context, int32) (r0 *api.SavedQuerySpecAndConfig, r1 error) {
 				return
 			},
 		},
-		HandleFunc: &RoleStoreHandleFunc{
+		HandleFunc: &SavedSearchStoreHandleFunc{
 			defaultHook: func() (r0 basestore.TransactableHandle) {
 				return
 			},
 		},
-		ListFunc: &RoleStoreListFunc{
-			defaultHook: func(context.Context, RolesListOptions) (r0 []*types.Role, r1 error) {
+		IsEmptyFunc: &SavedSearchStoreIsEmptyFunc{
+			defaultHook: func(context.Context) (r0 bool, r1 error) {
 				return
 			},
 		},
-		UpdateFunc: &RoleStoreUpdateFunc{
-			defaultHook: func(context.Context, *types.Role) (r0 *types.Role, r1 error) {
+		ListAllFunc: &SavedSearchStoreListAllFunc{
+			defaultHook: func(context.Context) (r0 []api.SavedQuerySpecAndConfig, r1 error) {
+				return
+			},
+		},
+		ListSavedSearchesByOrgIDFunc: &SavedSearchStoreListSavedSearchesByOrgIDFunc{
+			defaultHook: func(context.Context, int32) (r0 []*types.SavedSearch, r1 error) {
+				return
+			},
+		},
+		ListSavedSearchesByOrgOrUserFunc: &SavedSearchStoreListSavedSearchesByOrgOrUserFunc{
+			defaultHook: func(context.Context, *int32, *int32, *PaginationArgs) (r0 []*types.SavedSearch, r1 error) {
+				return
+			},
+		},
+		ListSavedSearchesByUserIDFunc: &SavedSearchStoreListSavedSearchesByUserIDFunc{
+			defaultHook: func(context.Context, int32) (r0 []*types.SavedSearch, r1 error) {
+				return
+			},
+		},
+		UpdateFunc: &SavedSearchStoreUpdateFunc{
+			defaultHook: func(context.Context, *types.SavedSearch) (r0 *types.SavedSearch, r1 error) {
+				return
+			},
+		},
+		WithFunc: &SavedSearchStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) (r0 SavedSearchStore) {
+				return
+			},
+		},
+		WithTransactFunc: &SavedSearchStoreWithTransactFunc{
+			defaultHook: func(context.Context, func(SavedSearchStore) error) (r0 error) {
 				return
 			},
 		},
 	}
 }
 
-// NewStrictMockRoleStore creates a new mock of the RoleStore interface. All
-// methods panic on invocation, unless overwritten.
-func NewStrictMockRoleStore() *MockRoleStore {
-	return &MockRoleStore{
-		CountFunc: &RoleStoreCountFunc{
-			defaultHook: func(context.Context, RolesListOptions) (int, error) {
-				panic("unexpected invocation of MockRoleStore.Count")
+// NewStrictMockSavedSearchStore creates a new mock of the SavedSearchStore
+// interface. All methods panic on invocation, unless overwritten.
+func NewStrictMockSavedSearchStore() *MockSavedSearchStore {
+	return &MockSavedSearchStore{
+		CountSavedSearchesByOrgOrUserFunc: &SavedSearchStoreCountSavedSearchesByOrgOrUserFunc{
+			defaultHook: func(context.Context, *int32, *int32) (int, error) {
+				panic("unexpected invocation of MockSavedSearchStore.CountSavedSearchesByOrgOrUser")
 			},
 		},
-		CreateFunc: &RoleStoreCreateFunc{
-			defaultHook: func(context.Context, string, bool) (*types.Role, error) {
-				panic("unexpected invocation of MockRoleStore.Create")
+		CreateFunc: &SavedSearchStoreCreateFunc{
+			defaultHook: func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
+				panic("unexpected invocation of MockSavedSearchStore.Create")
 			},
 		},
-		DeleteFunc: &RoleStoreDeleteFunc{
-			defaultHook: func(context.Context, DeleteRoleOpts) error {
-				panic("unexpected invocation of MockRoleStore.Delete")
+		DeleteFunc: &SavedSearchStoreDeleteFunc{
+			defaultHook: func(context.Context, int32) error {
+				panic("unexpected invocation of MockSavedSearchStore.Delete")
 			},
 		},
-		GetFunc: &RoleStoreGetFunc{
-			defaultHook: func(context.Context, GetRoleOpts) (*types.Role, error) {
-				panic("unexpected invocation of MockRoleStore.Get")
+		GetByIDFunc: &SavedSearchStoreGetByIDFunc{
+			defaultHook: func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error) {
+				panic("unexpected invocation of MockSavedSearchStore.GetByID")
 			},
 		},
-		HandleFunc: &RoleStoreHandleFunc{
+		HandleFunc: &SavedSearchStoreHandleFunc{
 			defaultHook: func() basestore.TransactableHandle {
-				panic("unexpected invocation of MockRoleStore.Handle")
+				panic("unexpected invocation of MockSavedSearchStore.Handle")
 			},
 		},
-		ListFunc: &RoleStoreListFunc{
-			defaultHook: func(context.Context, RolesListOptions) ([]*types.Role, error) {
-				panic("unexpected invocation of MockRoleStore.List")
+		IsEmptyFunc: &SavedSearchStoreIsEmptyFunc{
+			defaultHook: func(context.Context) (bool, error) {
+				panic("unexpected invocation of MockSavedSearchStore.IsEmpty")
 			},
 		},
-		UpdateFunc: &RoleStoreUpdateFunc{
-			defaultHook: func(context.Context, *types.Role) (*types.Role, error) {
-				panic("unexpected invocation of MockRoleStore.Update")
+		ListAllFunc: &SavedSearchStoreListAllFunc{
+			defaultHook: func(context.Context) ([]api.SavedQuerySpecAndConfig, error) {
+				panic("unexpected invocation of MockSavedSearchStore.ListAll")
+			},
+		},
+		ListSavedSearchesByOrgIDFunc: &SavedSearchStoreListSavedSearchesByOrgIDFunc{
+			defaultHook: func(context.Context, int32) ([]*types.SavedSearch, error) {
+				panic("unexpected invocation of MockSavedSearchStore.ListSavedSearchesByOrgID")
+			},
+		},
+		ListSavedSearchesByOrgOrUserFunc: &SavedSearchStoreListSavedSearchesByOrgOrUserFunc{
+			defaultHook: func(context.Context, *int32, *int32, *PaginationArgs) ([]*types.SavedSearch, error) {
+				panic("unexpected invocation of MockSavedSearchStore.ListSavedSearchesByOrgOrUser")
+			},
+		},
+		ListSavedSearchesByUserIDFunc: &SavedSearchStoreListSavedSearchesByUserIDFunc{
+			defaultHook: func(context.Context, int32) ([]*types.SavedSearch, error) {
+				panic("unexpected invocation of MockSavedSearchStore.ListSavedSearchesByUserID")
+			},
+		},
+		UpdateFunc: &SavedSearchStoreUpdateFunc{
+			defaultHook: func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
+				panic("unexpected invocation of MockSavedSearchStore.Update")
+			},
+		},
+		WithFunc: &SavedSearchStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) SavedSearchStore {
+				panic("unexpected invocation of MockSavedSearchStore.With")
+			},
+		},
+		WithTransactFunc: &SavedSearchStoreWithTransactFunc{
+			defaultHook: func(context.Context, func(SavedSearchStore) error) error {
+				panic("unexpected invocation of MockSavedSearchStore.WithTransact")
 			},
 		},
 	}
 }
 
-// NewMockRoleStoreFrom creates a new mock of the MockRoleStore interface.
-// All methods delegate to the given implementation, unless overwritten.
-func NewMockRoleStoreFrom(i RoleStore) *MockRoleStore {
-	return &MockRoleStore{
-		CountFunc: &RoleStoreCountFunc{
-			defaultHook: i.Count,
+// NewMockSavedSearchStoreFrom creates a new mock of the
+// MockSavedSearchStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockSavedSearchStoreFrom(i SavedSearchStore) *MockSavedSearchStore {
+	return &MockSavedSearchStore{
+		CountSavedSearchesByOrgOrUserFunc: &SavedSearchStoreCountSavedSearchesByOrgOrUserFunc{
+			defaultHook: i.CountSavedSearchesByOrgOrUser,
 		},
-		CreateFunc: &RoleStoreCreateFunc{
+		CreateFunc: &SavedSearchStoreCreateFunc{
 			defaultHook: i.Create,
 		},
-		DeleteFunc: &RoleStoreDeleteFunc{
+		DeleteFunc: &SavedSearchStoreDeleteFunc{
 			defaultHook: i.Delete,
 		},
-		GetFunc: &RoleStoreGetFunc{
-			defaultHook: i.Get,
+		GetByIDFunc: &SavedSearchStoreGetByIDFunc{
+			defaultHook: i.GetByID,
 		},
-		HandleFunc: &RoleStoreHandleFunc{
+		HandleFunc: &SavedSearchStoreHandleFunc{
 			defaultHook: i.Handle,
 		},
-		ListFunc: &RoleStoreListFunc{
-			defaultHook: i.List,
+		IsEmptyFunc: &SavedSearchStoreIsEmptyFunc{
+			defaultHook: i.IsEmpty,
 		},
-		UpdateFunc: &RoleStoreUpdateFunc{
+		ListAllFunc: &SavedSearchStoreListAllFunc{
+			defaultHook: i.ListAll,
+		},
+		ListSavedSearchesByOrgIDFunc: &SavedSearchStoreListSavedSearchesByOrgIDFunc{
+			defaultHook: i.ListSavedSearchesByOrgID,
+		},
+		ListSavedSearchesByOrgOrUserFunc: &SavedSearchStoreListSavedSearchesByOrgOrUserFunc{
+			defaultHook: i.ListSavedSearchesByOrgOrUser,
+		},
+		ListSavedSearchesByUserIDFunc: &SavedSearchStoreListSavedSearchesByUserIDFunc{
+			defaultHook: i.ListSavedSearchesByUserID,
+		},
+		UpdateFunc: &SavedSearchStoreUpdateFunc{
 			defaultHook: i.Update,
 		},
+		WithFunc: &SavedSearchStoreWithFunc{
+			defaultHook: i.With,
+		},
+		WithTransactFunc: &SavedSearchStoreWithTransactFunc{
+			defaultHook: i.WithTransact,
+		},
 	}
 }
 
-// RoleStoreCountFunc describes the behavior when the Count method of the
-// parent MockRoleStore instance is invoked.
-type RoleStoreCountFunc struct {
-	defaultHook func(context.Context, RolesListOptions) (int, error)
-	hooks       []func(context.Context, RolesListOptions) (int, error)
-	history     []RoleStoreCountFuncCall
+// SavedSearchStoreCountSavedSearchesByOrgOrUserFunc describes the behavior
+// when the CountSavedSearchesByOrgOrUser method of the parent
+// MockSavedSearchStore instance is invoked.
+type SavedSearchStoreCountSavedSearchesByOrgOrUserFunc struct {
+	defaultHook func(context.Context, *int32, *int32) (int, error)
+	hooks       []func(context.Context, *int32, *int32) (int, error)
+	history     []SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall
 	mutex       sync.Mutex
 }
 
-// Count delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRoleStore) Count(v0 context.Context, v1 RolesListOptions) (int, error) {
-	r0, r1 := m.CountFunc.nextHook()(v0, v1)
-	m.CountFunc.appendCall(RoleStoreCountFuncCall{v0, v1, r0, r1})
+// CountSavedSearchesByOrgOrUser delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockSavedSearchStore) CountSavedSearchesByOrgOrUser(v0 context.Context, v1 *int32, v2 *int32) (int, error) {
+	r0, r1 := m.CountSavedSearchesByOrgOrUserFunc.nextHook()(v0, v1, v2)
+	m.CountSavedSearchesByOrgOrUserFunc.appendCall(SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Count method of the
-// parent MockRoleStore instance is invoked and the hook queue is empty.
-func (f *RoleStoreCountFunc) SetDefaultHook(hook func(context.Context, RolesListOptions) (int, error)) {
+// SetDefaultHook sets function that is called when the
+// CountSavedSearchesByOrgOrUser method of the parent MockSavedSearchStore
+// instance is invoked and the hook queue is empty.
+func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) SetDefaultHook(hook func(context.Context, *int32, *int32) (int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Count method of the parent MockRoleStore instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *RoleStoreCountFunc) PushHook(hook func(context.Context, RolesListOptions) (int, error)) {
+// CountSavedSearchesByOrgOrUser method of the parent MockSavedSearchStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) PushHook(hook func(context.Context, *int32, *int32) (int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -50970,20 +52945,20 @@ func (f *RoleStoreCountFunc) PushHook(hook func(context.Context, RolesListOption
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RoleStoreCountFunc) SetDefaultReturn(r0 int, r1 error) {
-	f.SetDefaultHook(func(context.Context, RolesListOptions) (int, error) {
+func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, *int32, *int32) (int, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RoleStoreCountFunc) PushReturn(r0 int, r1 error) {
-	f.PushHook(func(context.Context, RolesListOptions) (int, error) {
+func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, *int32, *int32) (int, error) {
 		return r0, r1
 	})
 }
 
-func (f *RoleStoreCountFunc) nextHook() func(context.Context, RolesListOptions) (int, error) {
+func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) nextHook() func(context.Context, *int32, *int32) (int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -50996,32 +52971,37 @@ func (f *RoleStoreCountFunc) nextHook() func(context.Context, RolesListOptions)
 	return hook
 }
 
-func (f *RoleStoreCountFunc) appendCall(r0 RoleStoreCountFuncCall) {
+func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) appendCall(r0 SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RoleStoreCountFuncCall objects describing
+// History returns a sequence of
+// SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall objects describing
 // the invocations of this function.
-func (f *RoleStoreCountFunc) History() []RoleStoreCountFuncCall {
+func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) History() []SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall {
 	f.mutex.Lock()
-	history := make([]RoleStoreCountFuncCall, len(f.history))
+	history := make([]SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RoleStoreCountFuncCall is an object that describes an invocation of
-// method Count on an instance of MockRoleStore.
-type RoleStoreCountFuncCall struct {
+// SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall is an object that
+// describes an invocation of method CountSavedSearchesByOrgOrUser on an
+// instance of MockSavedSearchStore.
+type SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 RolesListOptions
+	Arg1 *int32
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 *int32
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 int
@@ -51032,44 +53012,45 @@ type RoleStoreCountFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RoleStoreCountFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RoleStoreCountFuncCall) Results() []interface{} {
+func (c SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RoleStoreCreateFunc describes the behavior when the Create method of the
-// parent MockRoleStore instance is invoked.
-type RoleStoreCreateFunc struct {
-	defaultHook func(context.Context, string, bool) (*types.Role, error)
-	hooks       []func(context.Context, string, bool) (*types.Role, error)
-	history     []RoleStoreCreateFuncCall
+// SavedSearchStoreCreateFunc describes the behavior when the Create method
+// of the parent MockSavedSearchStore instance is invoked.
+type SavedSearchStoreCreateFunc struct {
+	defaultHook func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)
+	hooks       []func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)
+	history     []SavedSearchStoreCreateFuncCall
 	mutex       sync.Mutex
 }
 
 // Create delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRoleStore) Create(v0 context.Context, v1 string, v2 bool) (*types.Role, error) {
-	r0, r1 := m.CreateFunc.nextHook()(v0, v1, v2)
-	m.CreateFunc.appendCall(RoleStoreCreateFuncCall{v0, v1, v2, r0, r1})
+func (m *MockSavedSearchStore) Create(v0 context.Context, v1 *types.SavedSearch) (*types.SavedSearch, error) {
+	r0, r1 := m.CreateFunc.nextHook()(v0, v1)
+	m.CreateFunc.appendCall(SavedSearchStoreCreateFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the Create method of the
-// parent MockRoleStore instance is invoked and the hook queue is empty.
-func (f *RoleStoreCreateFunc) SetDefaultHook(hook func(context.Context, string, bool) (*types.Role, error)) {
+// parent MockSavedSearchStore instance is invoked and the hook queue is
+// empty.
+func (f *SavedSearchStoreCreateFunc) SetDefaultHook(hook func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Create method of the parent MockRoleStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *RoleStoreCreateFunc) PushHook(hook func(context.Context, string, bool) (*types.Role, error)) {
+// Create method of the parent MockSavedSearchStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SavedSearchStoreCreateFunc) PushHook(hook func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -51077,20 +53058,20 @@ func (f *RoleStoreCreateFunc) PushHook(hook func(context.Context, string, bool)
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RoleStoreCreateFunc) SetDefaultReturn(r0 *types.Role, r1 error) {
-	f.SetDefaultHook(func(context.Context, string, bool) (*types.Role, error) {
+func (f *SavedSearchStoreCreateFunc) SetDefaultReturn(r0 *types.SavedSearch, r1 error) {
+	f.SetDefaultHook(func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RoleStoreCreateFunc) PushReturn(r0 *types.Role, r1 error) {
-	f.PushHook(func(context.Context, string, bool) (*types.Role, error) {
+func (f *SavedSearchStoreCreateFunc) PushReturn(r0 *types.SavedSearch, r1 error) {
+	f.PushHook(func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
 		return r0, r1
 	})
 }
 
-func (f *RoleStoreCreateFunc) nextHook() func(context.Context, string, bool) (*types.Role, error) {
+func (f *SavedSearchStoreCreateFunc) nextHook() func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -51103,38 +53084,35 @@ func (f *RoleStoreCreateFunc) nextHook() func(context.Context, string, bool) (*t
 	return hook
 }
 
-func (f *RoleStoreCreateFunc) appendCall(r0 RoleStoreCreateFuncCall) {
+func (f *SavedSearchStoreCreateFunc) appendCall(r0 SavedSearchStoreCreateFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RoleStoreCreateFuncCall objects describing
-// the invocations of this function.
-func (f *RoleStoreCreateFunc) History() []RoleStoreCreateFuncCall {
+// History returns a sequence of SavedSearchStoreCreateFuncCall objects
+// describing the invocations of this function.
+func (f *SavedSearchStoreCreateFunc) History() []SavedSearchStoreCreateFuncCall {
 	f.mutex.Lock()
-	history := make([]RoleStoreCreateFuncCall, len(f.history))
+	history := make([]SavedSearchStoreCreateFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RoleStoreCreateFuncCall is an object that describes an invocation of
-// method Create on an instance of MockRoleStore.
-type RoleStoreCreateFuncCall struct {
+// SavedSearchStoreCreateFuncCall is an object that describes an invocation
+// of method Create on an instance of MockSavedSearchStore.
+type SavedSearchStoreCreateFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 string
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 bool
+	Arg1 *types.SavedSearch
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.Role
+	Result0 *types.SavedSearch
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -51142,44 +53120,45 @@ type RoleStoreCreateFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RoleStoreCreateFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c SavedSearchStoreCreateFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RoleStoreCreateFuncCall) Results() []interface{} {
+func (c SavedSearchStoreCreateFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RoleStoreDeleteFunc describes the behavior when the Delete method of the
-// parent MockRoleStore instance is invoked.
-type RoleStoreDeleteFunc struct {
-	defaultHook func(context.Context, DeleteRoleOpts) error
-	hooks       []func(context.Context, DeleteRoleOpts) error
-	history     []RoleStoreDeleteFuncCall
+// SavedSearchStoreDeleteFunc describes the behavior when the Delete method
+// of the parent MockSavedSearchStore instance is invoked.
+type SavedSearchStoreDeleteFunc struct {
+	defaultHook func(context.Context, int32) error
+	hooks       []func(context.Context, int32) error
+	history     []SavedSearchStoreDeleteFuncCall
 	mutex       sync.Mutex
 }
 
 // Delete delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRoleStore) Delete(v0 context.Context, v1 DeleteRoleOpts) error {
+func (m *MockSavedSearchStore) Delete(v0 context.Context, v1 int32) error {
 	r0 := m.DeleteFunc.nextHook()(v0, v1)
-	m.DeleteFunc.appendCall(RoleStoreDeleteFuncCall{v0, v1, r0})
+	m.DeleteFunc.appendCall(SavedSearchStoreDeleteFuncCall{v0, v1, r0})
 	return r0
 }
 
 // SetDefaultHook sets function that is called when the Delete method of the
-// parent MockRoleStore instance is invoked and the hook queue is empty.
-func (f *RoleStoreDeleteFunc) SetDefaultHook(hook func(context.Context, DeleteRoleOpts) error) {
+// parent MockSavedSearchStore instance is invoked and the hook queue is
+// empty.
+func (f *SavedSearchStoreDeleteFunc) SetDefaultHook(hook func(context.Context, int32) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Delete method of the parent MockRoleStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *RoleStoreDeleteFunc) PushHook(hook func(context.Context, DeleteRoleOpts) error) {
+// Delete method of the parent MockSavedSearchStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SavedSearchStoreDeleteFunc) PushHook(hook func(context.Context, int32) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -51187,20 +53166,20 @@ func (f *RoleStoreDeleteFunc) PushHook(hook func(context.Context, DeleteRoleOpts
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RoleStoreDeleteFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, DeleteRoleOpts) error {
+func (f *SavedSearchStoreDeleteFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RoleStoreDeleteFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, DeleteRoleOpts) error {
+func (f *SavedSearchStoreDeleteFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32) error {
 		return r0
 	})
 }
 
-func (f *RoleStoreDeleteFunc) nextHook() func(context.Context, DeleteRoleOpts) error {
+func (f *SavedSearchStoreDeleteFunc) nextHook() func(context.Context, int32) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -51213,32 +53192,32 @@ func (f *RoleStoreDeleteFunc) nextHook() func(context.Context, DeleteRoleOpts) e
 	return hook
 }
 
-func (f *RoleStoreDeleteFunc) appendCall(r0 RoleStoreDeleteFuncCall) {
+func (f *SavedSearchStoreDeleteFunc) appendCall(r0 SavedSearchStoreDeleteFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RoleStoreDeleteFuncCall objects describing
-// the invocations of this function.
-func (f *RoleStoreDeleteFunc) History() []RoleStoreDeleteFuncCall {
+// History returns a sequence of SavedSearchStoreDeleteFuncCall objects
+// describing the invocations of this function.
+func (f *SavedSearchStoreDeleteFunc) History() []SavedSearchStoreDeleteFuncCall {
 	f.mutex.Lock()
-	history := make([]RoleStoreDeleteFuncCall, len(f.history))
+	history := make([]SavedSearchStoreDeleteFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RoleStoreDeleteFuncCall is an object that describes an invocation of
-// method Delete on an instance of MockRoleStore.
-type RoleStoreDeleteFuncCall struct {
+// SavedSearchStoreDeleteFuncCall is an object that describes an invocation
+// of method Delete on an instance of MockSavedSearchStore.
+type SavedSearchStoreDeleteFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 DeleteRoleOpts
+	Arg1 int32
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -51246,44 +53225,45 @@ type RoleStoreDeleteFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RoleStoreDeleteFuncCall) Args() []interface{} {
+func (c SavedSearchStoreDeleteFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RoleStoreDeleteFuncCall) Results() []interface{} {
+func (c SavedSearchStoreDeleteFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RoleStoreGetFunc describes the behavior when the Get method of the parent
-// MockRoleStore instance is invoked.
-type RoleStoreGetFunc struct {
-	defaultHook func(context.Context, GetRoleOpts) (*types.Role, error)
-	hooks       []func(context.Context, GetRoleOpts) (*types.Role, error)
-	history     []RoleStoreGetFuncCall
+// SavedSearchStoreGetByIDFunc describes the behavior when the GetByID
+// method of the parent MockSavedSearchStore instance is invoked.
+type SavedSearchStoreGetByIDFunc struct {
+	defaultHook func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error)
+	hooks       []func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error)
+	history     []SavedSearchStoreGetByIDFuncCall
 	mutex       sync.Mutex
 }
 
-// Get delegates to the next hook function in the queue and stores the
+// GetByID delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRoleStore) Get(v0 context.Context, v1 GetRoleOpts) (*types.Role, error) {
-	r0, r1 := m.GetFunc.nextHook()(v0, v1)
-	m.GetFunc.appendCall(RoleStoreGetFuncCall{v0, v1, r0, r1})
+func (m *MockSavedSearchStore) GetByID(v0 context.Context, v1 int32) (*api.SavedQuerySpecAndConfig, error) {
+	r0, r1 := m.GetByIDFunc.nextHook()(v0, v1)
+	m.GetByIDFunc.appendCall(SavedSearchStoreGetByIDFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Get method of the
-// parent MockRoleStore instance is invoked and the hook queue is empty.
-func (f *RoleStoreGetFunc) SetDefaultHook(hook func(context.Context, GetRoleOpts) (*types.Role, error)) {
+// SetDefaultHook sets function that is called when the GetByID method of
+// the parent MockSavedSearchStore instance is invoked and the hook queue is
+// empty.
+func (f *SavedSearchStoreGetByIDFunc) SetDefaultHook(hook func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Get method of the parent MockRoleStore instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *RoleStoreGetFunc) PushHook(hook func(context.Context, GetRoleOpts) (*types.Role, error)) {
+// GetByID method of the parent MockSavedSearchStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SavedSearchStoreGetByIDFunc) PushHook(hook func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -51291,20 +53271,20 @@ func (f *RoleStoreGetFunc) PushHook(hook func(context.Context, GetRoleOpts) (*ty
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RoleStoreGetFunc) SetDefaultReturn(r0 *types.Role, r1 error) {
-	f.SetDefaultHook(func(context.Context, GetRoleOpts) (*types.Role, error) {
+func (f *SavedSearchStoreGetByIDFunc) SetDefaultReturn(r0 *api.SavedQuerySpecAndConfig, r1 error) {
+	f.SetDefaultHook(func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RoleStoreGetFunc) PushReturn(r0 *types.Role, r1 error) {
-	f.PushHook(func(context.Context, GetRoleOpts) (*types.Role, error) {
+func (f *SavedSearchStoreGetByIDFunc) PushReturn(r0 *api.SavedQuerySpecAndConfig, r1 error) {
+	f.PushHook(func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error) {
 		return r0, r1
 	})
 }
 
-func (f *RoleStoreGetFunc) nextHook() func(context.Context, GetRoleOpts) (*types.Role, error) {
+func (f *SavedSearchStoreGetByIDFunc) nextHook() func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -51317,35 +53297,35 @@ func (f *RoleStoreGetFunc) nextHook() func(context.Context, GetRoleOpts) (*types
 	return hook
 }
 
-func (f *RoleStoreGetFunc) appendCall(r0 RoleStoreGetFuncCall) {
+func (f *SavedSearchStoreGetByIDFunc) appendCall(r0 SavedSearchStoreGetByIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RoleStoreGetFuncCall objects describing the
-// invocations of this function.
-func (f *RoleStoreGetFunc) History() []RoleStoreGetFuncCall {
+// History returns a sequence of SavedSearchStoreGetByIDFuncCall objects
+// describing the invocations of this function.
+func (f *SavedSearchStoreGetByIDFunc) History() []SavedSearchStoreGetByIDFuncCall {
 	f.mutex.Lock()
-	history := make([]RoleStoreGetFuncCall, len(f.history))
+	history := make([]SavedSearchStoreGetByIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RoleStoreGetFuncCall is an object that describes an invocation of method
-// Get on an instance of MockRoleStore.
-type RoleStoreGetFuncCall struct {
+// SavedSearchStoreGetByIDFuncCall is an object that describes an invocation
+// of method GetByID on an instance of MockSavedSearchStore.
+type SavedSearchStoreGetByIDFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 GetRoleOpts
+	Arg1 int32
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.Role
+	Result0 *api.SavedQuerySpecAndConfig
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -51353,44 +53333,45 @@ type RoleStoreGetFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RoleStoreGetFuncCall) Args() []interface{} {
+func (c SavedSearchStoreGetByIDFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RoleStoreGetFuncCall) Results() []interface{} {
+func (c SavedSearchStoreGetByIDFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RoleStoreHandleFunc describes the behavior when the Handle method of the
-// parent MockRoleStore instance is invoked.
-type RoleStoreHandleFunc struct {
+// SavedSearchStoreHandleFunc describes the behavior when the Handle method
+// of the parent MockSavedSearchStore instance is invoked.
+type SavedSearchStoreHandleFunc struct {
 	defaultHook func() basestore.TransactableHandle
 	hooks       []func() basestore.TransactableHandle
-	history     []RoleStoreHandleFuncCall
+	history     []SavedSearchStoreHandleFuncCall
 	mutex       sync.Mutex
 }
 
 // Handle delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRoleStore) Handle() basestore.TransactableHandle {
+func (m *MockSavedSearchStore) Handle() basestore.TransactableHandle {
 	r0 := m.HandleFunc.nextHook()()
-	m.HandleFunc.appendCall(RoleStoreHandleFuncCall{r0})
+	m.HandleFunc.appendCall(SavedSearchStoreHandleFuncCall{r0})
 	return r0
 }
 
 // SetDefaultHook sets function that is called when the Handle method of the
-// parent MockRoleStore instance is invoked and the hook queue is empty.
-func (f *RoleStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+// parent MockSavedSearchStore instance is invoked and the hook queue is
+// empty.
+func (f *SavedSearchStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Handle method of the parent MockRoleStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *RoleStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+// Handle method of the parent MockSavedSearchStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SavedSearchStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -51398,20 +53379,20 @@ func (f *RoleStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle)
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RoleStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+func (f *SavedSearchStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
 	f.SetDefaultHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RoleStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+func (f *SavedSearchStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
 	f.PushHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
-func (f *RoleStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+func (f *SavedSearchStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -51424,26 +53405,26 @@ func (f *RoleStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
 	return hook
 }
 
-func (f *RoleStoreHandleFunc) appendCall(r0 RoleStoreHandleFuncCall) {
+func (f *SavedSearchStoreHandleFunc) appendCall(r0 SavedSearchStoreHandleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RoleStoreHandleFuncCall objects describing
-// the invocations of this function.
-func (f *RoleStoreHandleFunc) History() []RoleStoreHandleFuncCall {
+// History returns a sequence of SavedSearchStoreHandleFuncCall objects
+// describing the invocations of this function.
+func (f *SavedSearchStoreHandleFunc) History() []SavedSearchStoreHandleFuncCall {
 	f.mutex.Lock()
-	history := make([]RoleStoreHandleFuncCall, len(f.history))
+	history := make([]SavedSearchStoreHandleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RoleStoreHandleFuncCall is an object that describes an invocation of
-// method Handle on an instance of MockRoleStore.
-type RoleStoreHandleFuncCall struct {
+// SavedSearchStoreHandleFuncCall is an object that describes an invocation
+// of method Handle on an instance of MockSavedSearchStore.
+type SavedSearchStoreHandleFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 basestore.TransactableHandle
@@ -51451,44 +53432,45 @@ type RoleStoreHandleFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RoleStoreHandleFuncCall) Args() []interface{} {
+func (c SavedSearchStoreHandleFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RoleStoreHandleFuncCall) Results() []interface{} {
+func (c SavedSearchStoreHandleFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RoleStoreListFunc describes the behavior when the List method of the
-// parent MockRoleStore instance is invoked.
-type RoleStoreListFunc struct {
-	defaultHook func(context.Context, RolesListOptions) ([]*types.Role, error)
-	hooks       []func(context.Context, RolesListOptions) ([]*types.Role, error)
-	history     []RoleStoreListFuncCall
+// SavedSearchStoreIsEmptyFunc describes the behavior when the IsEmpty
+// method of the parent MockSavedSearchStore instance is invoked.
+type SavedSearchStoreIsEmptyFunc struct {
+	defaultHook func(context.Context) (bool, error)
+	hooks       []func(context.Context) (bool, error)
+	history     []SavedSearchStoreIsEmptyFuncCall
 	mutex       sync.Mutex
 }
 
-// List delegates to the next hook function in the queue and stores the
+// IsEmpty delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRoleStore) List(v0 context.Context, v1 RolesListOptions) ([]*types.Role, error) {
-	r0, r1 := m.ListFunc.nextHook()(v0, v1)
-	m.ListFunc.appendCall(RoleStoreListFuncCall{v0, v1, r0, r1})
+func (m *MockSavedSearchStore) IsEmpty(v0 context.Context) (bool, error) {
+	r0, r1 := m.IsEmptyFunc.nextHook()(v0)
+	m.IsEmptyFunc.appendCall(SavedSearchStoreIsEmptyFuncCall{v0, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the List method of the
-// parent MockRoleStore instance is invoked and the hook queue is empty.
-func (f *RoleStoreListFunc) SetDefaultHook(hook func(context.Context, RolesListOptions) ([]*types.Role, error)) {
+// SetDefaultHook sets function that is called when the IsEmpty method of
+// the parent MockSavedSearchStore instance is invoked and the hook queue is
+// empty.
+func (f *SavedSearchStoreIsEmptyFunc) SetDefaultHook(hook func(context.Context) (bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// List method of the parent MockRoleStore instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *RoleStoreListFunc) PushHook(hook func(context.Context, RolesListOptions) ([]*types.Role, error)) {
+// IsEmpty method of the parent MockSavedSearchStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SavedSearchStoreIsEmptyFunc) PushHook(hook func(context.Context) (bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -51496,20 +53478,20 @@ func (f *RoleStoreListFunc) PushHook(hook func(context.Context, RolesListOptions
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RoleStoreListFunc) SetDefaultReturn(r0 []*types.Role, r1 error) {
-	f.SetDefaultHook(func(context.Context, RolesListOptions) ([]*types.Role, error) {
+func (f *SavedSearchStoreIsEmptyFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context) (bool, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RoleStoreListFunc) PushReturn(r0 []*types.Role, r1 error) {
-	f.PushHook(func(context.Context, RolesListOptions) ([]*types.Role, error) {
+func (f *SavedSearchStoreIsEmptyFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context) (bool, error) {
 		return r0, r1
 	})
 }
 
-func (f *RoleStoreListFunc) nextHook() func(context.Context, RolesListOptions) ([]*types.Role, error) {
+func (f *SavedSearchStoreIsEmptyFunc) nextHook() func(context.Context) (bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -51522,35 +53504,32 @@ func (f *RoleStoreListFunc) nextHook() func(context.Context, RolesListOptions) (
 	return hook
 }
 
-func (f *RoleStoreListFunc) appendCall(r0 RoleStoreListFuncCall) {
+func (f *SavedSearchStoreIsEmptyFunc) appendCall(r0 SavedSearchStoreIsEmptyFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RoleStoreListFuncCall objects describing
-// the invocations of this function.
-func (f *RoleStoreListFunc) History() []RoleStoreListFuncCall {
+// History returns a sequence of SavedSearchStoreIsEmptyFuncCall objects
+// describing the invocations of this function.
+func (f *SavedSearchStoreIsEmptyFunc) History() []SavedSearchStoreIsEmptyFuncCall {
 	f.mutex.Lock()
-	history := make([]RoleStoreListFuncCall, len(f.history))
+	history := make([]SavedSearchStoreIsEmptyFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RoleStoreListFuncCall is an object that describes an invocation of method
-// List on an instance of MockRoleStore.
-type RoleStoreListFuncCall struct {
+// SavedSearchStoreIsEmptyFuncCall is an object that describes an invocation
+// of method IsEmpty on an instance of MockSavedSearchStore.
+type SavedSearchStoreIsEmptyFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 RolesListOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*types.Role
+	Result0 bool
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -51558,44 +53537,45 @@ type RoleStoreListFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RoleStoreListFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c SavedSearchStoreIsEmptyFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RoleStoreListFuncCall) Results() []interface{} {
+func (c SavedSearchStoreIsEmptyFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RoleStoreUpdateFunc describes the behavior when the Update method of the
-// parent MockRoleStore instance is invoked.
-type RoleStoreUpdateFunc struct {
-	defaultHook func(context.Context, *types.Role) (*types.Role, error)
-	hooks       []func(context.Context, *types.Role) (*types.Role, error)
-	history     []RoleStoreUpdateFuncCall
+// SavedSearchStoreListAllFunc describes the behavior when the ListAll
+// method of the parent MockSavedSearchStore instance is invoked.
+type SavedSearchStoreListAllFunc struct {
+	defaultHook func(context.Context) ([]api.SavedQuerySpecAndConfig, error)
+	hooks       []func(context.Context) ([]api.SavedQuerySpecAndConfig, error)
+	history     []SavedSearchStoreListAllFuncCall
 	mutex       sync.Mutex
 }
 
-// Update delegates to the next hook function in the queue and stores the
+// ListAll delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRoleStore) Update(v0 context.Context, v1 *types.Role) (*types.Role, error) {
-	r0, r1 := m.UpdateFunc.nextHook()(v0, v1)
-	m.UpdateFunc.appendCall(RoleStoreUpdateFuncCall{v0, v1, r0, r1})
+func (m *MockSavedSearchStore) ListAll(v0 context.Context) ([]api.SavedQuerySpecAndConfig, error) {
+	r0, r1 := m.ListAllFunc.nextHook()(v0)
+	m.ListAllFunc.appendCall(SavedSearchStoreListAllFuncCall{v0, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Update method of the
-// parent MockRoleStore instance is invoked and the hook queue is empty.
-func (f *RoleStoreUpdateFunc) SetDefaultHook(hook func(context.Context, *types.Role) (*types.Role, error)) {
+// SetDefaultHook sets function that is called when the ListAll method of
+// the parent MockSavedSearchStore instance is invoked and the hook queue is
+// empty.
+func (f *SavedSearchStoreListAllFunc) SetDefaultHook(hook func(context.Context) ([]api.SavedQuerySpecAndConfig, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Update method of the parent MockRoleStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *RoleStoreUpdateFunc) PushHook(hook func(context.Context, *types.Role) (*types.Role, error)) {
+// ListAll method of the parent MockSavedSearchStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SavedSearchStoreListAllFunc) PushHook(hook func(context.Context) ([]api.SavedQuerySpecAndConfig, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -51603,350 +53583,106 @@ func (f *RoleStoreUpdateFunc) PushHook(hook func(context.Context, *types.Role) (
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RoleStoreUpdateFunc) SetDefaultReturn(r0 *types.Role, r1 error) {
-	f.SetDefaultHook(func(context.Context, *types.Role) (*types.Role, error) {
+func (f *SavedSearchStoreListAllFunc) SetDefaultReturn(r0 []api.SavedQuerySpecAndConfig, r1 error) {
+	f.SetDefaultHook(func(context.Context) ([]api.SavedQuerySpecAndConfig, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RoleStoreUpdateFunc) PushReturn(r0 *types.Role, r1 error) {
-	f.PushHook(func(context.Context, *types.Role) (*types.Role, error) {
-		return r0, r1
-	})
-}
-
-func (f *RoleStoreUpdateFunc) nextHook() func(context.Context, *types.Role) (*types.Role, error) {
-	f.mutex.Lock()
-	defer f.mutex.Unlock()
-
-	if len(f.hooks) == 0 {
-		return f.defaultHook
-	}
-
-	hook := f.hooks[0]
-	f.hooks = f.hooks[1:]
-	return hook
-}
-
-func (f *RoleStoreUpdateFunc) appendCall(r0 RoleStoreUpdateFuncCall) {
-	f.mutex.Lock()
-	f.history = append(f.history, r0)
-	f.mutex.Unlock()
-}
-
-// History returns a sequence of RoleStoreUpdateFuncCall objects describing
-// the invocations of this function.
-func (f *RoleStoreUpdateFunc) History() []RoleStoreUpdateFuncCall {
-	f.mutex.Lock()
-	history := make([]RoleStoreUpdateFuncCall, len(f.history))
-	copy(history, f.history)
-	f.mutex.Unlock()
-
-	return history
-}
-
-// RoleStoreUpdateFuncCall is an object that describes an invocation of
-// method Update on an instance of MockRoleStore.
-type RoleStoreUpdateFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 *types.Role
-	// Result0 is the value of the 1st result returned from this method
-	// invocation.
-	Result0 *types.Role
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
-}
-
-// Args returns an interface slice containing the arguments of this
-// invocation.
-func (c RoleStoreUpdateFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
-}
-
-// Results returns an interface slice containing the results of this
-// invocation.
-func (c RoleStoreUpdateFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
-}
-
-// MockSavedSearchStore is a mock implementation of the SavedSearchStore
-// interface (from the package
-// github.com/sourcegraph/sourcegraph/internal/database) used for unit
-// testing.
-type MockSavedSearchStore struct {
-	// CountSavedSearchesByOrgOrUserFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// CountSavedSearchesByOrgOrUser.
-	CountSavedSearchesByOrgOrUserFunc *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc
-	// CreateFunc is an instance of a mock function object controlling the
-	// behavior of the method Create.
-	CreateFunc *SavedSearchStoreCreateFunc
-	// DeleteFunc is an instance of a mock function object controlling the
-	// behavior of the method Delete.
-	DeleteFunc *SavedSearchStoreDeleteFunc
-	// GetByIDFunc is an instance of a mock function object controlling the
-	// behavior of the method GetByID.
-	GetByIDFunc *SavedSearchStoreGetByIDFunc
-	// HandleFunc is an instance of a mock function object controlling the
-	// behavior of the method Handle.
-	HandleFunc *SavedSearchStoreHandleFunc
-	// IsEmptyFunc is an instance of a mock function object controlling the
-	// behavior of the method IsEmpty.
-	IsEmptyFunc *SavedSearchStoreIsEmptyFunc
-	// ListAllFunc is an instance of a mock function object controlling the
-	// behavior of the method ListAll.
-	ListAllFunc *SavedSearchStoreListAllFunc
-	// ListSavedSearchesByOrgIDFunc is an instance of a mock function object
-	// controlling the behavior of the method ListSavedSearchesByOrgID.
-	ListSavedSearchesByOrgIDFunc *SavedSearchStoreListSavedSearchesByOrgIDFunc
-	// ListSavedSearchesByOrgOrUserFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// ListSavedSearchesByOrgOrUser.
-	ListSavedSearchesByOrgOrUserFunc *SavedSearchStoreListSavedSearchesByOrgOrUserFunc
-	// ListSavedSearchesByUserIDFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// ListSavedSearchesByUserID.
-	ListSavedSearchesByUserIDFunc *SavedSearchStoreListSavedSearchesByUserIDFunc
-	// UpdateFunc is an instance of a mock function object controlling the
-	// behavior of the method Update.
-	UpdateFunc *SavedSearchStoreUpdateFunc
-	// WithFunc is an instance of a mock function object controlling the
-	// behavior of the method With.
-	WithFunc *SavedSearchStoreWithFunc
-	// WithTransactFunc is an instance of a mock function object controlling
-	// the behavior of the method WithTransact.
-	WithTransactFunc *SavedSearchStoreWithTransactFunc
+func (f *SavedSearchStoreListAllFunc) PushReturn(r0 []api.SavedQuerySpecAndConfig, r1 error) {
+	f.PushHook(func(context.Context) ([]api.SavedQuerySpecAndConfig, error) {
+		return r0, r1
+	})
 }
 
-// NewMockSavedSearchStore creates a new mock of the SavedSearchStore
-// interface. All methods return zero values for all results, unless
-// overwritten.
-func NewMockSavedSearchStore() *MockSavedSearchStore {
-	return &MockSavedSearchStore{
-		CountSavedSearchesByOrgOrUserFunc: &SavedSearchStoreCountSavedSearchesByOrgOrUserFunc{
-			defaultHook: func(context.Context, *int32, *int32) (r0 int, r1 error) {
-				return
-			},
-		},
-		CreateFunc: &SavedSearchStoreCreateFunc{
-			defaultHook: func(context.Context, *types.SavedSearch) (r0 *types.SavedSearch, r1 error) {
-				return
-			},
-		},
-		DeleteFunc: &SavedSearchStoreDeleteFunc{
-			defaultHook: func(context.Context, int32) (r0 error) {
-				return
-			},
-		},
-		GetByIDFunc: &SavedSearchStoreGetByIDFunc{
-			defaultHook: func(context.Context, int32) (r0 *api.SavedQuerySpecAndConfig, r1 error) {
-				return
-			},
-		},
-		HandleFunc: &SavedSearchStoreHandleFunc{
-			defaultHook: func() (r0 basestore.TransactableHandle) {
-				return
-			},
-		},
-		IsEmptyFunc: &SavedSearchStoreIsEmptyFunc{
-			defaultHook: func(context.Context) (r0 bool, r1 error) {
-				return
-			},
-		},
-		ListAllFunc: &SavedSearchStoreListAllFunc{
-			defaultHook: func(context.Context) (r0 []api.SavedQuerySpecAndConfig, r1 error) {
-				return
-			},
-		},
-		ListSavedSearchesByOrgIDFunc: &SavedSearchStoreListSavedSearchesByOrgIDFunc{
-			defaultHook: func(context.Context, int32) (r0 []*types.SavedSearch, r1 error) {
-				return
-			},
-		},
-		ListSavedSearchesByOrgOrUserFunc: &SavedSearchStoreListSavedSearchesByOrgOrUserFunc{
-			defaultHook: func(context.Context, *int32, *int32, *PaginationArgs) (r0 []*types.SavedSearch, r1 error) {
-				return
-			},
-		},
-		ListSavedSearchesByUserIDFunc: &SavedSearchStoreListSavedSearchesByUserIDFunc{
-			defaultHook: func(context.Context, int32) (r0 []*types.SavedSearch, r1 error) {
-				return
-			},
-		},
-		UpdateFunc: &SavedSearchStoreUpdateFunc{
-			defaultHook: func(context.Context, *types.SavedSearch) (r0 *types.SavedSearch, r1 error) {
-				return
-			},
-		},
-		WithFunc: &SavedSearchStoreWithFunc{
-			defaultHook: func(basestore.ShareableStore) (r0 SavedSearchStore) {
-				return
-			},
-		},
-		WithTransactFunc: &SavedSearchStoreWithTransactFunc{
-			defaultHook: func(context.Context, func(SavedSearchStore) error) (r0 error) {
-				return
-			},
-		},
+func (f *SavedSearchStoreListAllFunc) nextHook() func(context.Context) ([]api.SavedQuerySpecAndConfig, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
 	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
 }
 
-// NewStrictMockSavedSearchStore creates a new mock of the SavedSearchStore
-// interface. All methods panic on invocation, unless overwritten.
-func NewStrictMockSavedSearchStore() *MockSavedSearchStore {
-	return &MockSavedSearchStore{
-		CountSavedSearchesByOrgOrUserFunc: &SavedSearchStoreCountSavedSearchesByOrgOrUserFunc{
-			defaultHook: func(context.Context, *int32, *int32) (int, error) {
-				panic("unexpected invocation of MockSavedSearchStore.CountSavedSearchesByOrgOrUser")
-			},
-		},
-		CreateFunc: &SavedSearchStoreCreateFunc{
-			defaultHook: func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
-				panic("unexpected invocation of MockSavedSearchStore.Create")
-			},
-		},
-		DeleteFunc: &SavedSearchStoreDeleteFunc{
-			defaultHook: func(context.Context, int32) error {
-				panic("unexpected invocation of MockSavedSearchStore.Delete")
-			},
-		},
-		GetByIDFunc: &SavedSearchStoreGetByIDFunc{
-			defaultHook: func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error) {
-				panic("unexpected invocation of MockSavedSearchStore.GetByID")
-			},
-		},
-		HandleFunc: &SavedSearchStoreHandleFunc{
-			defaultHook: func() basestore.TransactableHandle {
-				panic("unexpected invocation of MockSavedSearchStore.Handle")
-			},
-		},
-		IsEmptyFunc: &SavedSearchStoreIsEmptyFunc{
-			defaultHook: func(context.Context) (bool, error) {
-				panic("unexpected invocation of MockSavedSearchStore.IsEmpty")
-			},
-		},
-		ListAllFunc: &SavedSearchStoreListAllFunc{
-			defaultHook: func(context.Context) ([]api.SavedQuerySpecAndConfig, error) {
-				panic("unexpected invocation of MockSavedSearchStore.ListAll")
-			},
-		},
-		ListSavedSearchesByOrgIDFunc: &SavedSearchStoreListSavedSearchesByOrgIDFunc{
-			defaultHook: func(context.Context, int32) ([]*types.SavedSearch, error) {
-				panic("unexpected invocation of MockSavedSearchStore.ListSavedSearchesByOrgID")
-			},
-		},
-		ListSavedSearchesByOrgOrUserFunc: &SavedSearchStoreListSavedSearchesByOrgOrUserFunc{
-			defaultHook: func(context.Context, *int32, *int32, *PaginationArgs) ([]*types.SavedSearch, error) {
-				panic("unexpected invocation of MockSavedSearchStore.ListSavedSearchesByOrgOrUser")
-			},
-		},
-		ListSavedSearchesByUserIDFunc: &SavedSearchStoreListSavedSearchesByUserIDFunc{
-			defaultHook: func(context.Context, int32) ([]*types.SavedSearch, error) {
-				panic("unexpected invocation of MockSavedSearchStore.ListSavedSearchesByUserID")
-			},
-		},
-		UpdateFunc: &SavedSearchStoreUpdateFunc{
-			defaultHook: func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
-				panic("unexpected invocation of MockSavedSearchStore.Update")
-			},
-		},
-		WithFunc: &SavedSearchStoreWithFunc{
-			defaultHook: func(basestore.ShareableStore) SavedSearchStore {
-				panic("unexpected invocation of MockSavedSearchStore.With")
-			},
-		},
-		WithTransactFunc: &SavedSearchStoreWithTransactFunc{
-			defaultHook: func(context.Context, func(SavedSearchStore) error) error {
-				panic("unexpected invocation of MockSavedSearchStore.WithTransact")
-			},
-		},
-	}
+func (f *SavedSearchStoreListAllFunc) appendCall(r0 SavedSearchStoreListAllFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
 }
 
-// NewMockSavedSearchStoreFrom creates a new mock of the
-// MockSavedSearchStore interface. All methods delegate to the given
-// implementation, unless overwritten.
-func NewMockSavedSearchStoreFrom(i SavedSearchStore) *MockSavedSearchStore {
-	return &MockSavedSearchStore{
-		CountSavedSearchesByOrgOrUserFunc: &SavedSearchStoreCountSavedSearchesByOrgOrUserFunc{
-			defaultHook: i.CountSavedSearchesByOrgOrUser,
-		},
-		CreateFunc: &SavedSearchStoreCreateFunc{
-			defaultHook: i.Create,
-		},
-		DeleteFunc: &SavedSearchStoreDeleteFunc{
-			defaultHook: i.Delete,
-		},
-		GetByIDFunc: &SavedSearchStoreGetByIDFunc{
-			defaultHook: i.GetByID,
-		},
-		HandleFunc: &SavedSearchStoreHandleFunc{
-			defaultHook: i.Handle,
-		},
-		IsEmptyFunc: &SavedSearchStoreIsEmptyFunc{
-			defaultHook: i.IsEmpty,
-		},
-		ListAllFunc: &SavedSearchStoreListAllFunc{
-			defaultHook: i.ListAll,
-		},
-		ListSavedSearchesByOrgIDFunc: &SavedSearchStoreListSavedSearchesByOrgIDFunc{
-			defaultHook: i.ListSavedSearchesByOrgID,
-		},
-		ListSavedSearchesByOrgOrUserFunc: &SavedSearchStoreListSavedSearchesByOrgOrUserFunc{
-			defaultHook: i.ListSavedSearchesByOrgOrUser,
-		},
-		ListSavedSearchesByUserIDFunc: &SavedSearchStoreListSavedSearchesByUserIDFunc{
-			defaultHook: i.ListSavedSearchesByUserID,
-		},
-		UpdateFunc: &SavedSearchStoreUpdateFunc{
-			defaultHook: i.Update,
-		},
-		WithFunc: &SavedSearchStoreWithFunc{
-			defaultHook: i.With,
-		},
-		WithTransactFunc: &SavedSearchStoreWithTransactFunc{
-			defaultHook: i.WithTransact,
-		},
-	}
+// History returns a sequence of SavedSearchStoreListAllFuncCall objects
+// describing the invocations of this function.
+func (f *SavedSearchStoreListAllFunc) History() []SavedSearchStoreListAllFuncCall {
+	f.mutex.Lock()
+	history := make([]SavedSearchStoreListAllFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
 }
 
-// SavedSearchStoreCountSavedSearchesByOrgOrUserFunc describes the behavior
-// when the CountSavedSearchesByOrgOrUser method of the parent
-// MockSavedSearchStore instance is invoked.
-type SavedSearchStoreCountSavedSearchesByOrgOrUserFunc struct {
-	defaultHook func(context.Context, *int32, *int32) (int, error)
-	hooks       []func(context.Context, *int32, *int32) (int, error)
-	history     []SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall
+// SavedSearchStoreListAllFuncCall is an object that describes an invocation
+// of method ListAll on an instance of MockSavedSearchStore.
+type SavedSearchStoreListAllFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []api.SavedQuerySpecAndConfig
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c SavedSearchStoreListAllFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SavedSearchStoreListAllFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SavedSearchStoreListSavedSearchesByOrgIDFunc describes the behavior when
+// the ListSavedSearchesByOrgID method of the parent MockSavedSearchStore
+// instance is invoked.
+type SavedSearchStoreListSavedSearchesByOrgIDFunc struct {
+	defaultHook func(context.Context, int32) ([]*types.SavedSearch, error)
+	hooks       []func(context.Context, int32) ([]*types.SavedSearch, error)
+	history     []SavedSearchStoreListSavedSearchesByOrgIDFuncCall
 	mutex       sync.Mutex
 }
 
-// CountSavedSearchesByOrgOrUser delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockSavedSearchStore) CountSavedSearchesByOrgOrUser(v0 context.Context, v1 *int32, v2 *int32) (int, error) {
-	r0, r1 := m.CountSavedSearchesByOrgOrUserFunc.nextHook()(v0, v1, v2)
-	m.CountSavedSearchesByOrgOrUserFunc.appendCall(SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall{v0, v1, v2, r0, r1})
+// ListSavedSearchesByOrgID delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockSavedSearchStore) ListSavedSearchesByOrgID(v0 context.Context, v1 int32) ([]*types.SavedSearch, error) {
+	r0, r1 := m.ListSavedSearchesByOrgIDFunc.nextHook()(v0, v1)
+	m.ListSavedSearchesByOrgIDFunc.appendCall(SavedSearchStoreListSavedSearchesByOrgIDFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
-// CountSavedSearchesByOrgOrUser method of the parent MockSavedSearchStore
+// ListSavedSearchesByOrgID method of the parent MockSavedSearchStore
 // instance is invoked and the hook queue is empty.
-func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) SetDefaultHook(hook func(context.Context, *int32, *int32) (int, error)) {
+func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) SetDefaultHook(hook func(context.Context, int32) ([]*types.SavedSearch, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CountSavedSearchesByOrgOrUser method of the parent MockSavedSearchStore
+// ListSavedSearchesByOrgID method of the parent MockSavedSearchStore
 // instance invokes the hook at the front of the queue and discards it.
 // After the queue is empty, the default hook function is invoked for any
 // future action.
-func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) PushHook(hook func(context.Context, *int32, *int32) (int, error)) {
+func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) PushHook(hook func(context.Context, int32) ([]*types.SavedSearch, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -51954,20 +53690,20 @@ func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) PushHook(hook func(c
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) SetDefaultReturn(r0 int, r1 error) {
-	f.SetDefaultHook(func(context.Context, *int32, *int32) (int, error) {
+func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) SetDefaultReturn(r0 []*types.SavedSearch, r1 error) {
+	f.SetDefaultHook(func(context.Context, int32) ([]*types.SavedSearch, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) PushReturn(r0 int, r1 error) {
-	f.PushHook(func(context.Context, *int32, *int32) (int, error) {
+func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) PushReturn(r0 []*types.SavedSearch, r1 error) {
+	f.PushHook(func(context.Context, int32) ([]*types.SavedSearch, error) {
 		return r0, r1
 	})
 }
 
-func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) nextHook() func(context.Context, *int32, *int32) (int, error) {
+func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) nextHook() func(context.Context, int32) ([]*types.SavedSearch, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -51980,40 +53716,37 @@ func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) nextHook() func(cont
 	return hook
 }
 
-func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) appendCall(r0 SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall) {
+func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) appendCall(r0 SavedSearchStoreListSavedSearchesByOrgIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall objects describing
-// the invocations of this function.
-func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) History() []SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall {
+// SavedSearchStoreListSavedSearchesByOrgIDFuncCall objects describing the
+// invocations of this function.
+func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) History() []SavedSearchStoreListSavedSearchesByOrgIDFuncCall {
 	f.mutex.Lock()
-	history := make([]SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall, len(f.history))
+	history := make([]SavedSearchStoreListSavedSearchesByOrgIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall is an object that
-// describes an invocation of method CountSavedSearchesByOrgOrUser on an
-// instance of MockSavedSearchStore.
-type SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall struct {
+// SavedSearchStoreListSavedSearchesByOrgIDFuncCall is an object that
+// describes an invocation of method ListSavedSearchesByOrgID on an instance
+// of MockSavedSearchStore.
+type SavedSearchStoreListSavedSearchesByOrgIDFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *int32
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 *int32
+	Arg1 int32
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 int
+	Result0 []*types.SavedSearch
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -52021,45 +53754,47 @@ type SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c SavedSearchStoreListSavedSearchesByOrgIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall) Results() []interface{} {
+func (c SavedSearchStoreListSavedSearchesByOrgIDFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SavedSearchStoreCreateFunc describes the behavior when the Create method
-// of the parent MockSavedSearchStore instance is invoked.
-type SavedSearchStoreCreateFunc struct {
-	defaultHook func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)
-	hooks       []func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)
-	history     []SavedSearchStoreCreateFuncCall
+// SavedSearchStoreListSavedSearchesByOrgOrUserFunc describes the behavior
+// when the ListSavedSearchesByOrgOrUser method of the parent
+// MockSavedSearchStore instance is invoked.
+type SavedSearchStoreListSavedSearchesByOrgOrUserFunc struct {
+	defaultHook func(context.Context, *int32, *int32, *PaginationArgs) ([]*types.SavedSearch, error)
+	hooks       []func(context.Context, *int32, *int32, *PaginationArgs) ([]*types.SavedSearch, error)
+	history     []SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall
 	mutex       sync.Mutex
 }
 
-// Create delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockSavedSearchStore) Create(v0 context.Context, v1 *types.SavedSearch) (*types.SavedSearch, error) {
-	r0, r1 := m.CreateFunc.nextHook()(v0, v1)
-	m.CreateFunc.appendCall(SavedSearchStoreCreateFuncCall{v0, v1, r0, r1})
+// ListSavedSearchesByOrgOrUser delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockSavedSearchStore) ListSavedSearchesByOrgOrUser(v0 context.Context, v1 *int32, v2 *int32, v3 *PaginationArgs) ([]*types.SavedSearch, error) {
+	r0, r1 := m.ListSavedSearchesByOrgOrUserFunc.nextHook()(v0, v1, v2, v3)
+	m.ListSavedSearchesByOrgOrUserFunc.appendCall(SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall{v0, v1, v2, v3, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Create method of the
-// parent MockSavedSearchStore instance is invoked and the hook queue is
-// empty.
-func (f *SavedSearchStoreCreateFunc) SetDefaultHook(hook func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)) {
+// SetDefaultHook sets function that is called when the
+// ListSavedSearchesByOrgOrUser method of the parent MockSavedSearchStore
+// instance is invoked and the hook queue is empty.
+func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) SetDefaultHook(hook func(context.Context, *int32, *int32, *PaginationArgs) ([]*types.SavedSearch, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Create method of the parent MockSavedSearchStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *SavedSearchStoreCreateFunc) PushHook(hook func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)) {
+// ListSavedSearchesByOrgOrUser method of the parent MockSavedSearchStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) PushHook(hook func(context.Context, *int32, *int32, *PaginationArgs) ([]*types.SavedSearch, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -52067,20 +53802,20 @@ func (f *SavedSearchStoreCreateFunc) PushHook(hook func(context.Context, *types.
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SavedSearchStoreCreateFunc) SetDefaultReturn(r0 *types.SavedSearch, r1 error) {
-	f.SetDefaultHook(func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
+func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) SetDefaultReturn(r0 []*types.SavedSearch, r1 error) {
+	f.SetDefaultHook(func(context.Context, *int32, *int32, *PaginationArgs) ([]*types.SavedSearch, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreCreateFunc) PushReturn(r0 *types.SavedSearch, r1 error) {
-	f.PushHook(func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
+func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) PushReturn(r0 []*types.SavedSearch, r1 error) {
+	f.PushHook(func(context.Context, *int32, *int32, *PaginationArgs) ([]*types.SavedSearch, error) {
 		return r0, r1
 	})
 }
 
-func (f *SavedSearchStoreCreateFunc) nextHook() func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
+func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) nextHook() func(context.Context, *int32, *int32, *PaginationArgs) ([]*types.SavedSearch, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -52093,35 +53828,43 @@ func (f *SavedSearchStoreCreateFunc) nextHook() func(context.Context, *types.Sav
 	return hook
 }
 
-func (f *SavedSearchStoreCreateFunc) appendCall(r0 SavedSearchStoreCreateFuncCall) {
+func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) appendCall(r0 SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SavedSearchStoreCreateFuncCall objects
-// describing the invocations of this function.
-func (f *SavedSearchStoreCreateFunc) History() []SavedSearchStoreCreateFuncCall {
+// History returns a sequence of
+// SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall objects describing
+// the invocations of this function.
+func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) History() []SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall {
 	f.mutex.Lock()
-	history := make([]SavedSearchStoreCreateFuncCall, len(f.history))
+	history := make([]SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SavedSearchStoreCreateFuncCall is an object that describes an invocation
-// of method Create on an instance of MockSavedSearchStore.
-type SavedSearchStoreCreateFuncCall struct {
+// SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall is an object that
+// describes an invocation of method ListSavedSearchesByOrgOrUser on an
+// instance of MockSavedSearchStore.
+type SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *types.SavedSearch
+	Arg1 *int32
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 *int32
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 *PaginationArgs
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.SavedSearch
+	Result0 []*types.SavedSearch
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -52129,45 +53872,47 @@ type SavedSearchStoreCreateFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SavedSearchStoreCreateFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SavedSearchStoreCreateFuncCall) Results() []interface{} {
+func (c SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SavedSearchStoreDeleteFunc describes the behavior when the Delete method
-// of the parent MockSavedSearchStore instance is invoked.
-type SavedSearchStoreDeleteFunc struct {
-	defaultHook func(context.Context, int32) error
-	hooks       []func(context.Context, int32) error
-	history     []SavedSearchStoreDeleteFuncCall
+// SavedSearchStoreListSavedSearchesByUserIDFunc describes the behavior when
+// the ListSavedSearchesByUserID method of the parent MockSavedSearchStore
+// instance is invoked.
+type SavedSearchStoreListSavedSearchesByUserIDFunc struct {
+	defaultHook func(context.Context, int32) ([]*types.SavedSearch, error)
+	hooks       []func(context.Context, int32) ([]*types.SavedSearch, error)
+	history     []SavedSearchStoreListSavedSearchesByUserIDFuncCall
 	mutex       sync.Mutex
 }
 
-// Delete delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockSavedSearchStore) Delete(v0 context.Context, v1 int32) error {
-	r0 := m.DeleteFunc.nextHook()(v0, v1)
-	m.DeleteFunc.appendCall(SavedSearchStoreDeleteFuncCall{v0, v1, r0})
-	return r0
+// ListSavedSearchesByUserID delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockSavedSearchStore) ListSavedSearchesByUserID(v0 context.Context, v1 int32) ([]*types.SavedSearch, error) {
+	r0, r1 := m.ListSavedSearchesByUserIDFunc.nextHook()(v0, v1)
+	m.ListSavedSearchesByUserIDFunc.appendCall(SavedSearchStoreListSavedSearchesByUserIDFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Delete method of the
-// parent MockSavedSearchStore instance is invoked and the hook queue is
-// empty.
-func (f *SavedSearchStoreDeleteFunc) SetDefaultHook(hook func(context.Context, int32) error) {
+// SetDefaultHook sets function that is called when the
+// ListSavedSearchesByUserID method of the parent MockSavedSearchStore
+// instance is invoked and the hook queue is empty.
+func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) SetDefaultHook(hook func(context.Context, int32) ([]*types.SavedSearch, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Delete method of the parent MockSavedSearchStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *SavedSearchStoreDeleteFunc) PushHook(hook func(context.Context, int32) error) {
+// ListSavedSearchesByUserID method of the parent MockSavedSearchStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) PushHook(hook func(context.Context, int32) ([]*types.SavedSearch, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -52175,20 +53920,20 @@ func (f *SavedSearchStoreDeleteFunc) PushHook(hook func(context.Context, int32)
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SavedSearchStoreDeleteFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int32) error {
-		return r0
+func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) SetDefaultReturn(r0 []*types.SavedSearch, r1 error) {
+	f.SetDefaultHook(func(context.Context, int32) ([]*types.SavedSearch, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreDeleteFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int32) error {
-		return r0
+func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) PushReturn(r0 []*types.SavedSearch, r1 error) {
+	f.PushHook(func(context.Context, int32) ([]*types.SavedSearch, error) {
+		return r0, r1
 	})
 }
 
-func (f *SavedSearchStoreDeleteFunc) nextHook() func(context.Context, int32) error {
+func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) nextHook() func(context.Context, int32) ([]*types.SavedSearch, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -52201,26 +53946,28 @@ func (f *SavedSearchStoreDeleteFunc) nextHook() func(context.Context, int32) err
 	return hook
 }
 
-func (f *SavedSearchStoreDeleteFunc) appendCall(r0 SavedSearchStoreDeleteFuncCall) {
+func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) appendCall(r0 SavedSearchStoreListSavedSearchesByUserIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SavedSearchStoreDeleteFuncCall objects
-// describing the invocations of this function.
-func (f *SavedSearchStoreDeleteFunc) History() []SavedSearchStoreDeleteFuncCall {
+// History returns a sequence of
+// SavedSearchStoreListSavedSearchesByUserIDFuncCall objects describing the
+// invocations of this function.
+func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) History() []SavedSearchStoreListSavedSearchesByUserIDFuncCall {
 	f.mutex.Lock()
-	history := make([]SavedSearchStoreDeleteFuncCall, len(f.history))
+	history := make([]SavedSearchStoreListSavedSearchesByUserIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SavedSearchStoreDeleteFuncCall is an object that describes an invocation
-// of method Delete on an instance of MockSavedSearchStore.
-type SavedSearchStoreDeleteFuncCall struct {
+// SavedSearchStoreListSavedSearchesByUserIDFuncCall is an object that
+// describes an invocation of method ListSavedSearchesByUserID on an
+// instance of MockSavedSearchStore.
+type SavedSearchStoreListSavedSearchesByUserIDFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -52229,71 +53976,74 @@ type SavedSearchStoreDeleteFuncCall struct {
 	Arg1 int32
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 []*types.SavedSearch
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SavedSearchStoreDeleteFuncCall) Args() []interface{} {
+func (c SavedSearchStoreListSavedSearchesByUserIDFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SavedSearchStoreDeleteFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c SavedSearchStoreListSavedSearchesByUserIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// SavedSearchStoreGetByIDFunc describes the behavior when the GetByID
-// method of the parent MockSavedSearchStore instance is invoked.
-type SavedSearchStoreGetByIDFunc struct {
-	defaultHook func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error)
-	hooks       []func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error)
-	history     []SavedSearchStoreGetByIDFuncCall
+// SavedSearchStoreUpdateFunc describes the behavior when the Update method
+// of the parent MockSavedSearchStore instance is invoked.
+type SavedSearchStoreUpdateFunc struct {
+	defaultHook func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)
+	hooks       []func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)
+	history     []SavedSearchStoreUpdateFuncCall
 	mutex       sync.Mutex
 }
 
-// GetByID delegates to the next hook function in the queue and stores the
+// Update delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockSavedSearchStore) GetByID(v0 context.Context, v1 int32) (*api.SavedQuerySpecAndConfig, error) {
-	r0, r1 := m.GetByIDFunc.nextHook()(v0, v1)
-	m.GetByIDFunc.appendCall(SavedSearchStoreGetByIDFuncCall{v0, v1, r0, r1})
+func (m *MockSavedSearchStore) Update(v0 context.Context, v1 *types.SavedSearch) (*types.SavedSearch, error) {
+	r0, r1 := m.UpdateFunc.nextHook()(v0, v1)
+	m.UpdateFunc.appendCall(SavedSearchStoreUpdateFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetByID method of
-// the parent MockSavedSearchStore instance is invoked and the hook queue is
+// SetDefaultHook sets function that is called when the Update method of the
+// parent MockSavedSearchStore instance is invoked and the hook queue is
 // empty.
-func (f *SavedSearchStoreGetByIDFunc) SetDefaultHook(hook func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error)) {
+func (f *SavedSearchStoreUpdateFunc) SetDefaultHook(hook func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetByID method of the parent MockSavedSearchStore instance invokes the
+// Update method of the parent MockSavedSearchStore instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *SavedSearchStoreGetByIDFunc) PushHook(hook func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error)) {
+func (f *SavedSearchStoreUpdateFunc) PushHook(hook func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
 }
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
-// given values.
-func (f *SavedSearchStoreGetByIDFunc) SetDefaultReturn(r0 *api.SavedQuerySpecAndConfig, r1 error) {
-	f.SetDefaultHook(func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error) {
+// given values.
+func (f *SavedSearchStoreUpdateFunc) SetDefaultReturn(r0 *types.SavedSearch, r1 error) {
+	f.SetDefaultHook(func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreGetByIDFunc) PushReturn(r0 *api.SavedQuerySpecAndConfig, r1 error) {
-	f.PushHook(func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error) {
+func (f *SavedSearchStoreUpdateFunc) PushReturn(r0 *types.SavedSearch, r1 error) {
+	f.PushHook(func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
 		return r0, r1
 	})
 }
 
-func (f *SavedSearchStoreGetByIDFunc) nextHook() func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error) {
+func (f *SavedSearchStoreUpdateFunc) nextHook() func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -52306,35 +54056,35 @@ func (f *SavedSearchStoreGetByIDFunc) nextHook() func(context.Context, int32) (*
 	return hook
 }
 
-func (f *SavedSearchStoreGetByIDFunc) appendCall(r0 SavedSearchStoreGetByIDFuncCall) {
+func (f *SavedSearchStoreUpdateFunc) appendCall(r0 SavedSearchStoreUpdateFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SavedSearchStoreGetByIDFuncCall objects
+// History returns a sequence of SavedSearchStoreUpdateFuncCall objects
 // describing the invocations of this function.
-func (f *SavedSearchStoreGetByIDFunc) History() []SavedSearchStoreGetByIDFuncCall {
+func (f *SavedSearchStoreUpdateFunc) History() []SavedSearchStoreUpdateFuncCall {
 	f.mutex.Lock()
-	history := make([]SavedSearchStoreGetByIDFuncCall, len(f.history))
+	history := make([]SavedSearchStoreUpdateFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SavedSearchStoreGetByIDFuncCall is an object that describes an invocation
-// of method GetByID on an instance of MockSavedSearchStore.
-type SavedSearchStoreGetByIDFuncCall struct {
+// SavedSearchStoreUpdateFuncCall is an object that describes an invocation
+// of method Update on an instance of MockSavedSearchStore.
+type SavedSearchStoreUpdateFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int32
+	Arg1 *types.SavedSearch
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *api.SavedQuerySpecAndConfig
+	Result0 *types.SavedSearch
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -52342,45 +54092,45 @@ type SavedSearchStoreGetByIDFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SavedSearchStoreGetByIDFuncCall) Args() []interface{} {
+func (c SavedSearchStoreUpdateFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SavedSearchStoreGetByIDFuncCall) Results() []interface{} {
+func (c SavedSearchStoreUpdateFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SavedSearchStoreHandleFunc describes the behavior when the Handle method
-// of the parent MockSavedSearchStore instance is invoked.
-type SavedSearchStoreHandleFunc struct {
-	defaultHook func() basestore.TransactableHandle
-	hooks       []func() basestore.TransactableHandle
-	history     []SavedSearchStoreHandleFuncCall
+// SavedSearchStoreWithFunc describes the behavior when the With method of
+// the parent MockSavedSearchStore instance is invoked.
+type SavedSearchStoreWithFunc struct {
+	defaultHook func(basestore.ShareableStore) SavedSearchStore
+	hooks       []func(basestore.ShareableStore) SavedSearchStore
+	history     []SavedSearchStoreWithFuncCall
 	mutex       sync.Mutex
 }
 
-// Handle delegates to the next hook function in the queue and stores the
+// With delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockSavedSearchStore) Handle() basestore.TransactableHandle {
-	r0 := m.HandleFunc.nextHook()()
-	m.HandleFunc.appendCall(SavedSearchStoreHandleFuncCall{r0})
+func (m *MockSavedSearchStore) With(v0 basestore.ShareableStore) SavedSearchStore {
+	r0 := m.WithFunc.nextHook()(v0)
+	m.WithFunc.appendCall(SavedSearchStoreWithFuncCall{v0, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Handle method of the
+// SetDefaultHook sets function that is called when the With method of the
 // parent MockSavedSearchStore instance is invoked and the hook queue is
 // empty.
-func (f *SavedSearchStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+func (f *SavedSearchStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) SavedSearchStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Handle method of the parent MockSavedSearchStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *SavedSearchStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+// With method of the parent MockSavedSearchStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *SavedSearchStoreWithFunc) PushHook(hook func(basestore.ShareableStore) SavedSearchStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -52388,20 +54138,20 @@ func (f *SavedSearchStoreHandleFunc) PushHook(hook func() basestore.Transactable
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SavedSearchStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
-	f.SetDefaultHook(func() basestore.TransactableHandle {
+func (f *SavedSearchStoreWithFunc) SetDefaultReturn(r0 SavedSearchStore) {
+	f.SetDefaultHook(func(basestore.ShareableStore) SavedSearchStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
-	f.PushHook(func() basestore.TransactableHandle {
+func (f *SavedSearchStoreWithFunc) PushReturn(r0 SavedSearchStore) {
+	f.PushHook(func(basestore.ShareableStore) SavedSearchStore {
 		return r0
 	})
 }
 
-func (f *SavedSearchStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+func (f *SavedSearchStoreWithFunc) nextHook() func(basestore.ShareableStore) SavedSearchStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -52414,72 +54164,76 @@ func (f *SavedSearchStoreHandleFunc) nextHook() func() basestore.TransactableHan
 	return hook
 }
 
-func (f *SavedSearchStoreHandleFunc) appendCall(r0 SavedSearchStoreHandleFuncCall) {
+func (f *SavedSearchStoreWithFunc) appendCall(r0 SavedSearchStoreWithFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SavedSearchStoreHandleFuncCall objects
+// History returns a sequence of SavedSearchStoreWithFuncCall objects
 // describing the invocations of this function.
-func (f *SavedSearchStoreHandleFunc) History() []SavedSearchStoreHandleFuncCall {
+func (f *SavedSearchStoreWithFunc) History() []SavedSearchStoreWithFuncCall {
 	f.mutex.Lock()
-	history := make([]SavedSearchStoreHandleFuncCall, len(f.history))
+	history := make([]SavedSearchStoreWithFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SavedSearchStoreHandleFuncCall is an object that describes an invocation
-// of method Handle on an instance of MockSavedSearchStore.
-type SavedSearchStoreHandleFuncCall struct {
+// SavedSearchStoreWithFuncCall is an object that describes an invocation of
+// method With on an instance of MockSavedSearchStore.
+type SavedSearchStoreWithFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 basestore.ShareableStore
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 basestore.TransactableHandle
+	Result0 SavedSearchStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SavedSearchStoreHandleFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c SavedSearchStoreWithFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SavedSearchStoreHandleFuncCall) Results() []interface{} {
+func (c SavedSearchStoreWithFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// SavedSearchStoreIsEmptyFunc describes the behavior when the IsEmpty
-// method of the parent MockSavedSearchStore instance is invoked.
-type SavedSearchStoreIsEmptyFunc struct {
-	defaultHook func(context.Context) (bool, error)
-	hooks       []func(context.Context) (bool, error)
-	history     []SavedSearchStoreIsEmptyFuncCall
+// SavedSearchStoreWithTransactFunc describes the behavior when the
+// WithTransact method of the parent MockSavedSearchStore instance is
+// invoked.
+type SavedSearchStoreWithTransactFunc struct {
+	defaultHook func(context.Context, func(SavedSearchStore) error) error
+	hooks       []func(context.Context, func(SavedSearchStore) error) error
+	history     []SavedSearchStoreWithTransactFuncCall
 	mutex       sync.Mutex
 }
 
-// IsEmpty delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockSavedSearchStore) IsEmpty(v0 context.Context) (bool, error) {
-	r0, r1 := m.IsEmptyFunc.nextHook()(v0)
-	m.IsEmptyFunc.appendCall(SavedSearchStoreIsEmptyFuncCall{v0, r0, r1})
-	return r0, r1
+// WithTransact delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockSavedSearchStore) WithTransact(v0 context.Context, v1 func(SavedSearchStore) error) error {
+	r0 := m.WithTransactFunc.nextHook()(v0, v1)
+	m.WithTransactFunc.appendCall(SavedSearchStoreWithTransactFuncCall{v0, v1, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the IsEmpty method of
-// the parent MockSavedSearchStore instance is invoked and the hook queue is
-// empty.
-func (f *SavedSearchStoreIsEmptyFunc) SetDefaultHook(hook func(context.Context) (bool, error)) {
+// SetDefaultHook sets function that is called when the WithTransact method
+// of the parent MockSavedSearchStore instance is invoked and the hook queue
+// is empty.
+func (f *SavedSearchStoreWithTransactFunc) SetDefaultHook(hook func(context.Context, func(SavedSearchStore) error) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// IsEmpty method of the parent MockSavedSearchStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *SavedSearchStoreIsEmptyFunc) PushHook(hook func(context.Context) (bool, error)) {
+// WithTransact method of the parent MockSavedSearchStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *SavedSearchStoreWithTransactFunc) PushHook(hook func(context.Context, func(SavedSearchStore) error) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -52487,104 +54241,433 @@ func (f *SavedSearchStoreIsEmptyFunc) PushHook(hook func(context.Context) (bool,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SavedSearchStoreIsEmptyFunc) SetDefaultReturn(r0 bool, r1 error) {
-	f.SetDefaultHook(func(context.Context) (bool, error) {
-		return r0, r1
+func (f *SavedSearchStoreWithTransactFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, func(SavedSearchStore) error) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreIsEmptyFunc) PushReturn(r0 bool, r1 error) {
-	f.PushHook(func(context.Context) (bool, error) {
-		return r0, r1
+func (f *SavedSearchStoreWithTransactFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, func(SavedSearchStore) error) error {
+		return r0
 	})
 }
 
-func (f *SavedSearchStoreIsEmptyFunc) nextHook() func(context.Context) (bool, error) {
+func (f *SavedSearchStoreWithTransactFunc) nextHook() func(context.Context, func(SavedSearchStore) error) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
 	if len(f.hooks) == 0 {
 		return f.defaultHook
 	}
-
-	hook := f.hooks[0]
-	f.hooks = f.hooks[1:]
-	return hook
-}
-
-func (f *SavedSearchStoreIsEmptyFunc) appendCall(r0 SavedSearchStoreIsEmptyFuncCall) {
-	f.mutex.Lock()
-	f.history = append(f.history, r0)
-	f.mutex.Unlock()
-}
-
-// History returns a sequence of SavedSearchStoreIsEmptyFuncCall objects
-// describing the invocations of this function.
-func (f *SavedSearchStoreIsEmptyFunc) History() []SavedSearchStoreIsEmptyFuncCall {
-	f.mutex.Lock()
-	history := make([]SavedSearchStoreIsEmptyFuncCall, len(f.history))
-	copy(history, f.history)
-	f.mutex.Unlock()
-
-	return history
-}
-
-// SavedSearchStoreIsEmptyFuncCall is an object that describes an invocation
-// of method IsEmpty on an instance of MockSavedSearchStore.
-type SavedSearchStoreIsEmptyFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 context.Context
-	// Result0 is the value of the 1st result returned from this method
-	// invocation.
-	Result0 bool
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
-}
-
-// Args returns an interface slice containing the arguments of this
-// invocation.
-func (c SavedSearchStoreIsEmptyFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SavedSearchStoreWithTransactFunc) appendCall(r0 SavedSearchStoreWithTransactFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SavedSearchStoreWithTransactFuncCall
+// objects describing the invocations of this function.
+func (f *SavedSearchStoreWithTransactFunc) History() []SavedSearchStoreWithTransactFuncCall {
+	f.mutex.Lock()
+	history := make([]SavedSearchStoreWithTransactFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SavedSearchStoreWithTransactFuncCall is an object that describes an
+// invocation of method WithTransact on an instance of MockSavedSearchStore.
+type SavedSearchStoreWithTransactFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 func(SavedSearchStore) error
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c SavedSearchStoreWithTransactFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SavedSearchStoreWithTransactFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// MockSearchContextsStore is a mock implementation of the
+// SearchContextsStore interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/database) used for unit
+// testing.
+type MockSearchContextsStore struct {
+	// CountSearchContextsFunc is an instance of a mock function object
+	// controlling the behavior of the method CountSearchContexts.
+	CountSearchContextsFunc *SearchContextsStoreCountSearchContextsFunc
+	// CreateSearchContextStarForUserFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// CreateSearchContextStarForUser.
+	CreateSearchContextStarForUserFunc *SearchContextsStoreCreateSearchContextStarForUserFunc
+	// CreateSearchContextWithRepositoryRevisionsFunc is an instance of a
+	// mock function object controlling the behavior of the method
+	// CreateSearchContextWithRepositoryRevisions.
+	CreateSearchContextWithRepositoryRevisionsFunc *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc
+	// DeleteSearchContextFunc is an instance of a mock function object
+	// controlling the behavior of the method DeleteSearchContext.
+	DeleteSearchContextFunc *SearchContextsStoreDeleteSearchContextFunc
+	// DeleteSearchContextStarForUserFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// DeleteSearchContextStarForUser.
+	DeleteSearchContextStarForUserFunc *SearchContextsStoreDeleteSearchContextStarForUserFunc
+	// DoneFunc is an instance of a mock function object controlling the
+	// behavior of the method Done.
+	DoneFunc *SearchContextsStoreDoneFunc
+	// ExecFunc is an instance of a mock function object controlling the
+	// behavior of the method Exec.
+	ExecFunc *SearchContextsStoreExecFunc
+	// GetAllQueriesFunc is an instance of a mock function object
+	// controlling the behavior of the method GetAllQueries.
+	GetAllQueriesFunc *SearchContextsStoreGetAllQueriesFunc
+	// GetAllRevisionsForReposFunc is an instance of a mock function object
+	// controlling the behavior of the method GetAllRevisionsForRepos.
+	GetAllRevisionsForReposFunc *SearchContextsStoreGetAllRevisionsForReposFunc
+	// GetDefaultSearchContextForCurrentUserFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// GetDefaultSearchContextForCurrentUser.
+	GetDefaultSearchContextForCurrentUserFunc *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc
+	// GetSearchContextFunc is an instance of a mock function object
+	// controlling the behavior of the method GetSearchContext.
+	GetSearchContextFunc *SearchContextsStoreGetSearchContextFunc
+	// GetSearchContextRepositoryRevisionsFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// GetSearchContextRepositoryRevisions.
+	GetSearchContextRepositoryRevisionsFunc *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc
+	// HandleFunc is an instance of a mock function object controlling the
+	// behavior of the method Handle.
+	HandleFunc *SearchContextsStoreHandleFunc
+	// ListSearchContextsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListSearchContexts.
+	ListSearchContextsFunc *SearchContextsStoreListSearchContextsFunc
+	// SetSearchContextRepositoryRevisionsFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// SetSearchContextRepositoryRevisions.
+	SetSearchContextRepositoryRevisionsFunc *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc
+	// SetUserDefaultSearchContextIDFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// SetUserDefaultSearchContextID.
+	SetUserDefaultSearchContextIDFunc *SearchContextsStoreSetUserDefaultSearchContextIDFunc
+	// TransactFunc is an instance of a mock function object controlling the
+	// behavior of the method Transact.
+	TransactFunc *SearchContextsStoreTransactFunc
+	// UpdateSearchContextWithRepositoryRevisionsFunc is an instance of a
+	// mock function object controlling the behavior of the method
+	// UpdateSearchContextWithRepositoryRevisions.
+	UpdateSearchContextWithRepositoryRevisionsFunc *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc
+}
+
+// NewMockSearchContextsStore creates a new mock of the SearchContextsStore
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockSearchContextsStore() *MockSearchContextsStore {
+	return &MockSearchContextsStore{
+		CountSearchContextsFunc: &SearchContextsStoreCountSearchContextsFunc{
+			defaultHook: func(context.Context, ListSearchContextsOptions) (r0 int32, r1 error) {
+				return
+			},
+		},
+		CreateSearchContextStarForUserFunc: &SearchContextsStoreCreateSearchContextStarForUserFunc{
+			defaultHook: func(context.Context, int32, int64) (r0 error) {
+				return
+			},
+		},
+		CreateSearchContextWithRepositoryRevisionsFunc: &SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc{
+			defaultHook: func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (r0 *types.SearchContext, r1 error) {
+				return
+			},
+		},
+		DeleteSearchContextFunc: &SearchContextsStoreDeleteSearchContextFunc{
+			defaultHook: func(context.Context, int64) (r0 error) {
+				return
+			},
+		},
+		DeleteSearchContextStarForUserFunc: &SearchContextsStoreDeleteSearchContextStarForUserFunc{
+			defaultHook: func(context.Context, int32, int64) (r0 error) {
+				return
+			},
+		},
+		DoneFunc: &SearchContextsStoreDoneFunc{
+			defaultHook: func(error) (r0 error) {
+				return
+			},
+		},
+		ExecFunc: &SearchContextsStoreExecFunc{
+			defaultHook: func(context.Context, *sqlf.Query) (r0 error) {
+				return
+			},
+		},
+		GetAllQueriesFunc: &SearchContextsStoreGetAllQueriesFunc{
+			defaultHook: func(context.Context) (r0 []string, r1 error) {
+				return
+			},
+		},
+		GetAllRevisionsForReposFunc: &SearchContextsStoreGetAllRevisionsForReposFunc{
+			defaultHook: func(context.Context, []api.RepoID) (r0 map[api.RepoID][]string, r1 error) {
+				return
+			},
+		},
+		GetDefaultSearchContextForCurrentUserFunc: &SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc{
+			defaultHook: func(context.Context) (r0 *types.SearchContext, r1 error) {
+				return
+			},
+		},
+		GetSearchContextFunc: &SearchContextsStoreGetSearchContextFunc{
+			defaultHook: func(context.Context, GetSearchContextOptions) (r0 *types.SearchContext, r1 error) {
+				return
+			},
+		},
+		GetSearchContextRepositoryRevisionsFunc: &SearchContextsStoreGetSearchContextRepositoryRevisionsFunc{
+			defaultHook: func(context.Context, int64) (r0 []*types.SearchContextRepositoryRevisions, r1 error) {
+				return
+			},
+		},
+		HandleFunc: &SearchContextsStoreHandleFunc{
+			defaultHook: func() (r0 basestore.TransactableHandle) {
+				return
+			},
+		},
+		ListSearchContextsFunc: &SearchContextsStoreListSearchContextsFunc{
+			defaultHook: func(context.Context, ListSearchContextsPageOptions, ListSearchContextsOptions) (r0 []*types.SearchContext, r1 error) {
+				return
+			},
+		},
+		SetSearchContextRepositoryRevisionsFunc: &SearchContextsStoreSetSearchContextRepositoryRevisionsFunc{
+			defaultHook: func(context.Context, int64, []*types.SearchContextRepositoryRevisions) (r0 error) {
+				return
+			},
+		},
+		SetUserDefaultSearchContextIDFunc: &SearchContextsStoreSetUserDefaultSearchContextIDFunc{
+			defaultHook: func(context.Context, int32, int64) (r0 error) {
+				return
+			},
+		},
+		TransactFunc: &SearchContextsStoreTransactFunc{
+			defaultHook: func(context.Context) (r0 SearchContextsStore, r1 error) {
+				return
+			},
+		},
+		UpdateSearchContextWithRepositoryRevisionsFunc: &SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc{
+			defaultHook: func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (r0 *types.SearchContext, r1 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockSearchContextsStore creates a new mock of the
+// SearchContextsStore interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockSearchContextsStore() *MockSearchContextsStore {
+	return &MockSearchContextsStore{
+		CountSearchContextsFunc: &SearchContextsStoreCountSearchContextsFunc{
+			defaultHook: func(context.Context, ListSearchContextsOptions) (int32, error) {
+				panic("unexpected invocation of MockSearchContextsStore.CountSearchContexts")
+			},
+		},
+		CreateSearchContextStarForUserFunc: &SearchContextsStoreCreateSearchContextStarForUserFunc{
+			defaultHook: func(context.Context, int32, int64) error {
+				panic("unexpected invocation of MockSearchContextsStore.CreateSearchContextStarForUser")
+			},
+		},
+		CreateSearchContextWithRepositoryRevisionsFunc: &SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc{
+			defaultHook: func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
+				panic("unexpected invocation of MockSearchContextsStore.CreateSearchContextWithRepositoryRevisions")
+			},
+		},
+		DeleteSearchContextFunc: &SearchContextsStoreDeleteSearchContextFunc{
+			defaultHook: func(context.Context, int64) error {
+				panic("unexpected invocation of MockSearchContextsStore.DeleteSearchContext")
+			},
+		},
+		DeleteSearchContextStarForUserFunc: &SearchContextsStoreDeleteSearchContextStarForUserFunc{
+			defaultHook: func(context.Context, int32, int64) error {
+				panic("unexpected invocation of MockSearchContextsStore.DeleteSearchContextStarForUser")
+			},
+		},
+		DoneFunc: &SearchContextsStoreDoneFunc{
+			defaultHook: func(error) error {
+				panic("unexpected invocation of MockSearchContextsStore.Done")
+			},
+		},
+		ExecFunc: &SearchContextsStoreExecFunc{
+			defaultHook: func(context.Context, *sqlf.Query) error {
+				panic("unexpected invocation of MockSearchContextsStore.Exec")
+			},
+		},
+		GetAllQueriesFunc: &SearchContextsStoreGetAllQueriesFunc{
+			defaultHook: func(context.Context) ([]string, error) {
+				panic("unexpected invocation of MockSearchContextsStore.GetAllQueries")
+			},
+		},
+		GetAllRevisionsForReposFunc: &SearchContextsStoreGetAllRevisionsForReposFunc{
+			defaultHook: func(context.Context, []api.RepoID) (map[api.RepoID][]string, error) {
+				panic("unexpected invocation of MockSearchContextsStore.GetAllRevisionsForRepos")
+			},
+		},
+		GetDefaultSearchContextForCurrentUserFunc: &SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc{
+			defaultHook: func(context.Context) (*types.SearchContext, error) {
+				panic("unexpected invocation of MockSearchContextsStore.GetDefaultSearchContextForCurrentUser")
+			},
+		},
+		GetSearchContextFunc: &SearchContextsStoreGetSearchContextFunc{
+			defaultHook: func(context.Context, GetSearchContextOptions) (*types.SearchContext, error) {
+				panic("unexpected invocation of MockSearchContextsStore.GetSearchContext")
+			},
+		},
+		GetSearchContextRepositoryRevisionsFunc: &SearchContextsStoreGetSearchContextRepositoryRevisionsFunc{
+			defaultHook: func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error) {
+				panic("unexpected invocation of MockSearchContextsStore.GetSearchContextRepositoryRevisions")
+			},
+		},
+		HandleFunc: &SearchContextsStoreHandleFunc{
+			defaultHook: func() basestore.TransactableHandle {
+				panic("unexpected invocation of MockSearchContextsStore.Handle")
+			},
+		},
+		ListSearchContextsFunc: &SearchContextsStoreListSearchContextsFunc{
+			defaultHook: func(context.Context, ListSearchContextsPageOptions, ListSearchContextsOptions) ([]*types.SearchContext, error) {
+				panic("unexpected invocation of MockSearchContextsStore.ListSearchContexts")
+			},
+		},
+		SetSearchContextRepositoryRevisionsFunc: &SearchContextsStoreSetSearchContextRepositoryRevisionsFunc{
+			defaultHook: func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error {
+				panic("unexpected invocation of MockSearchContextsStore.SetSearchContextRepositoryRevisions")
+			},
+		},
+		SetUserDefaultSearchContextIDFunc: &SearchContextsStoreSetUserDefaultSearchContextIDFunc{
+			defaultHook: func(context.Context, int32, int64) error {
+				panic("unexpected invocation of MockSearchContextsStore.SetUserDefaultSearchContextID")
+			},
+		},
+		TransactFunc: &SearchContextsStoreTransactFunc{
+			defaultHook: func(context.Context) (SearchContextsStore, error) {
+				panic("unexpected invocation of MockSearchContextsStore.Transact")
+			},
+		},
+		UpdateSearchContextWithRepositoryRevisionsFunc: &SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc{
+			defaultHook: func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
+				panic("unexpected invocation of MockSearchContextsStore.UpdateSearchContextWithRepositoryRevisions")
+			},
+		},
+	}
+}
+
+// NewMockSearchContextsStoreFrom creates a new mock of the
+// MockSearchContextsStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockSearchContextsStoreFrom(i SearchContextsStore) *MockSearchContextsStore {
+	return &MockSearchContextsStore{
+		CountSearchContextsFunc: &SearchContextsStoreCountSearchContextsFunc{
+			defaultHook: i.CountSearchContexts,
+		},
+		CreateSearchContextStarForUserFunc: &SearchContextsStoreCreateSearchContextStarForUserFunc{
+			defaultHook: i.CreateSearchContextStarForUser,
+		},
+		CreateSearchContextWithRepositoryRevisionsFunc: &SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc{
+			defaultHook: i.CreateSearchContextWithRepositoryRevisions,
+		},
+		DeleteSearchContextFunc: &SearchContextsStoreDeleteSearchContextFunc{
+			defaultHook: i.DeleteSearchContext,
+		},
+		DeleteSearchContextStarForUserFunc: &SearchContextsStoreDeleteSearchContextStarForUserFunc{
+			defaultHook: i.DeleteSearchContextStarForUser,
+		},
+		DoneFunc: &SearchContextsStoreDoneFunc{
+			defaultHook: i.Done,
+		},
+		ExecFunc: &SearchContextsStoreExecFunc{
+			defaultHook: i.Exec,
+		},
+		GetAllQueriesFunc: &SearchContextsStoreGetAllQueriesFunc{
+			defaultHook: i.GetAllQueries,
+		},
+		GetAllRevisionsForReposFunc: &SearchContextsStoreGetAllRevisionsForReposFunc{
+			defaultHook: i.GetAllRevisionsForRepos,
+		},
+		GetDefaultSearchContextForCurrentUserFunc: &SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc{
+			defaultHook: i.GetDefaultSearchContextForCurrentUser,
+		},
+		GetSearchContextFunc: &SearchContextsStoreGetSearchContextFunc{
+			defaultHook: i.GetSearchContext,
+		},
+		GetSearchContextRepositoryRevisionsFunc: &SearchContextsStoreGetSearchContextRepositoryRevisionsFunc{
+			defaultHook: i.GetSearchContextRepositoryRevisions,
+		},
+		HandleFunc: &SearchContextsStoreHandleFunc{
+			defaultHook: i.Handle,
+		},
+		ListSearchContextsFunc: &SearchContextsStoreListSearchContextsFunc{
+			defaultHook: i.ListSearchContexts,
+		},
+		SetSearchContextRepositoryRevisionsFunc: &SearchContextsStoreSetSearchContextRepositoryRevisionsFunc{
+			defaultHook: i.SetSearchContextRepositoryRevisions,
+		},
+		SetUserDefaultSearchContextIDFunc: &SearchContextsStoreSetUserDefaultSearchContextIDFunc{
+			defaultHook: i.SetUserDefaultSearchContextID,
+		},
+		TransactFunc: &SearchContextsStoreTransactFunc{
+			defaultHook: i.Transact,
+		},
+		UpdateSearchContextWithRepositoryRevisionsFunc: &SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc{
+			defaultHook: i.UpdateSearchContextWithRepositoryRevisions,
+		},
+	}
 }
 
-// Results returns an interface slice containing the results of this
-// invocation.
-func (c SavedSearchStoreIsEmptyFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
-}
-
-// SavedSearchStoreListAllFunc describes the behavior when the ListAll
-// method of the parent MockSavedSearchStore instance is invoked.
-type SavedSearchStoreListAllFunc struct {
-	defaultHook func(context.Context) ([]api.SavedQuerySpecAndConfig, error)
-	hooks       []func(context.Context) ([]api.SavedQuerySpecAndConfig, error)
-	history     []SavedSearchStoreListAllFuncCall
+// SearchContextsStoreCountSearchContextsFunc describes the behavior when
+// the CountSearchContexts method of the parent MockSearchContextsStore
+// instance is invoked.
+type SearchContextsStoreCountSearchContextsFunc struct {
+	defaultHook func(context.Context, ListSearchContextsOptions) (int32, error)
+	hooks       []func(context.Context, ListSearchContextsOptions) (int32, error)
+	history     []SearchContextsStoreCountSearchContextsFuncCall
 	mutex       sync.Mutex
 }
 
-// ListAll delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockSavedSearchStore) ListAll(v0 context.Context) ([]api.SavedQuerySpecAndConfig, error) {
-	r0, r1 := m.ListAllFunc.nextHook()(v0)
-	m.ListAllFunc.appendCall(SavedSearchStoreListAllFuncCall{v0, r0, r1})
+// CountSearchContexts delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockSearchContextsStore) CountSearchContexts(v0 context.Context, v1 ListSearchContextsOptions) (int32, error) {
+	r0, r1 := m.CountSearchContextsFunc.nextHook()(v0, v1)
+	m.CountSearchContextsFunc.appendCall(SearchContextsStoreCountSearchContextsFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the ListAll method of
-// the parent MockSavedSearchStore instance is invoked and the hook queue is
-// empty.
-func (f *SavedSearchStoreListAllFunc) SetDefaultHook(hook func(context.Context) ([]api.SavedQuerySpecAndConfig, error)) {
+// SetDefaultHook sets function that is called when the CountSearchContexts
+// method of the parent MockSearchContextsStore instance is invoked and the
+// hook queue is empty.
+func (f *SearchContextsStoreCountSearchContextsFunc) SetDefaultHook(hook func(context.Context, ListSearchContextsOptions) (int32, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListAll method of the parent MockSavedSearchStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *SavedSearchStoreListAllFunc) PushHook(hook func(context.Context) ([]api.SavedQuerySpecAndConfig, error)) {
+// CountSearchContexts method of the parent MockSearchContextsStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SearchContextsStoreCountSearchContextsFunc) PushHook(hook func(context.Context, ListSearchContextsOptions) (int32, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -52592,20 +54675,20 @@ func (f *SavedSearchStoreListAllFunc) PushHook(hook func(context.Context) ([]api
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SavedSearchStoreListAllFunc) SetDefaultReturn(r0 []api.SavedQuerySpecAndConfig, r1 error) {
-	f.SetDefaultHook(func(context.Context) ([]api.SavedQuerySpecAndConfig, error) {
+func (f *SearchContextsStoreCountSearchContextsFunc) SetDefaultReturn(r0 int32, r1 error) {
+	f.SetDefaultHook(func(context.Context, ListSearchContextsOptions) (int32, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreListAllFunc) PushReturn(r0 []api.SavedQuerySpecAndConfig, r1 error) {
-	f.PushHook(func(context.Context) ([]api.SavedQuerySpecAndConfig, error) {
+func (f *SearchContextsStoreCountSearchContextsFunc) PushReturn(r0 int32, r1 error) {
+	f.PushHook(func(context.Context, ListSearchContextsOptions) (int32, error) {
 		return r0, r1
 	})
 }
 
-func (f *SavedSearchStoreListAllFunc) nextHook() func(context.Context) ([]api.SavedQuerySpecAndConfig, error) {
+func (f *SearchContextsStoreCountSearchContextsFunc) nextHook() func(context.Context, ListSearchContextsOptions) (int32, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -52618,32 +54701,37 @@ func (f *SavedSearchStoreListAllFunc) nextHook() func(context.Context) ([]api.Sa
 	return hook
 }
 
-func (f *SavedSearchStoreListAllFunc) appendCall(r0 SavedSearchStoreListAllFuncCall) {
+func (f *SearchContextsStoreCountSearchContextsFunc) appendCall(r0 SearchContextsStoreCountSearchContextsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SavedSearchStoreListAllFuncCall objects
-// describing the invocations of this function.
-func (f *SavedSearchStoreListAllFunc) History() []SavedSearchStoreListAllFuncCall {
+// History returns a sequence of
+// SearchContextsStoreCountSearchContextsFuncCall objects describing the
+// invocations of this function.
+func (f *SearchContextsStoreCountSearchContextsFunc) History() []SearchContextsStoreCountSearchContextsFuncCall {
 	f.mutex.Lock()
-	history := make([]SavedSearchStoreListAllFuncCall, len(f.history))
+	history := make([]SearchContextsStoreCountSearchContextsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SavedSearchStoreListAllFuncCall is an object that describes an invocation
-// of method ListAll on an instance of MockSavedSearchStore.
-type SavedSearchStoreListAllFuncCall struct {
+// SearchContextsStoreCountSearchContextsFuncCall is an object that
+// describes an invocation of method CountSearchContexts on an instance of
+// MockSearchContextsStore.
+type SearchContextsStoreCountSearchContextsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 ListSearchContextsOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []api.SavedQuerySpecAndConfig
+	Result0 int32
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -52651,47 +54739,47 @@ type SavedSearchStoreListAllFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SavedSearchStoreListAllFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c SearchContextsStoreCountSearchContextsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SavedSearchStoreListAllFuncCall) Results() []interface{} {
+func (c SearchContextsStoreCountSearchContextsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SavedSearchStoreListSavedSearchesByOrgIDFunc describes the behavior when
-// the ListSavedSearchesByOrgID method of the parent MockSavedSearchStore
-// instance is invoked.
-type SavedSearchStoreListSavedSearchesByOrgIDFunc struct {
-	defaultHook func(context.Context, int32) ([]*types.SavedSearch, error)
-	hooks       []func(context.Context, int32) ([]*types.SavedSearch, error)
-	history     []SavedSearchStoreListSavedSearchesByOrgIDFuncCall
+// SearchContextsStoreCreateSearchContextStarForUserFunc describes the
+// behavior when the CreateSearchContextStarForUser method of the parent
+// MockSearchContextsStore instance is invoked.
+type SearchContextsStoreCreateSearchContextStarForUserFunc struct {
+	defaultHook func(context.Context, int32, int64) error
+	hooks       []func(context.Context, int32, int64) error
+	history     []SearchContextsStoreCreateSearchContextStarForUserFuncCall
 	mutex       sync.Mutex
 }
 
-// ListSavedSearchesByOrgID delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockSavedSearchStore) ListSavedSearchesByOrgID(v0 context.Context, v1 int32) ([]*types.SavedSearch, error) {
-	r0, r1 := m.ListSavedSearchesByOrgIDFunc.nextHook()(v0, v1)
-	m.ListSavedSearchesByOrgIDFunc.appendCall(SavedSearchStoreListSavedSearchesByOrgIDFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// CreateSearchContextStarForUser delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockSearchContextsStore) CreateSearchContextStarForUser(v0 context.Context, v1 int32, v2 int64) error {
+	r0 := m.CreateSearchContextStarForUserFunc.nextHook()(v0, v1, v2)
+	m.CreateSearchContextStarForUserFunc.appendCall(SearchContextsStoreCreateSearchContextStarForUserFuncCall{v0, v1, v2, r0})
+	return r0
 }
 
 // SetDefaultHook sets function that is called when the
-// ListSavedSearchesByOrgID method of the parent MockSavedSearchStore
-// instance is invoked and the hook queue is empty.
-func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) SetDefaultHook(hook func(context.Context, int32) ([]*types.SavedSearch, error)) {
+// CreateSearchContextStarForUser method of the parent
+// MockSearchContextsStore instance is invoked and the hook queue is empty.
+func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) SetDefaultHook(hook func(context.Context, int32, int64) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListSavedSearchesByOrgID method of the parent MockSavedSearchStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) PushHook(hook func(context.Context, int32) ([]*types.SavedSearch, error)) {
+// CreateSearchContextStarForUser method of the parent
+// MockSearchContextsStore instance invokes the hook at the front of the
+// queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) PushHook(hook func(context.Context, int32, int64) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -52699,20 +54787,20 @@ func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) PushHook(hook func(contex
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) SetDefaultReturn(r0 []*types.SavedSearch, r1 error) {
-	f.SetDefaultHook(func(context.Context, int32) ([]*types.SavedSearch, error) {
-		return r0, r1
+func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32, int64) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) PushReturn(r0 []*types.SavedSearch, r1 error) {
-	f.PushHook(func(context.Context, int32) ([]*types.SavedSearch, error) {
-		return r0, r1
+func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32, int64) error {
+		return r0
 	})
 }
 
-func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) nextHook() func(context.Context, int32) ([]*types.SavedSearch, error) {
+func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) nextHook() func(context.Context, int32, int64) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -52725,85 +54813,87 @@ func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) nextHook() func(context.C
 	return hook
 }
 
-func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) appendCall(r0 SavedSearchStoreListSavedSearchesByOrgIDFuncCall) {
+func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) appendCall(r0 SearchContextsStoreCreateSearchContextStarForUserFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// SavedSearchStoreListSavedSearchesByOrgIDFuncCall objects describing the
-// invocations of this function.
-func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) History() []SavedSearchStoreListSavedSearchesByOrgIDFuncCall {
+// SearchContextsStoreCreateSearchContextStarForUserFuncCall objects
+// describing the invocations of this function.
+func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) History() []SearchContextsStoreCreateSearchContextStarForUserFuncCall {
 	f.mutex.Lock()
-	history := make([]SavedSearchStoreListSavedSearchesByOrgIDFuncCall, len(f.history))
+	history := make([]SearchContextsStoreCreateSearchContextStarForUserFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SavedSearchStoreListSavedSearchesByOrgIDFuncCall is an object that
-// describes an invocation of method ListSavedSearchesByOrgID on an instance
-// of MockSavedSearchStore.
-type SavedSearchStoreListSavedSearchesByOrgIDFuncCall struct {
+// SearchContextsStoreCreateSearchContextStarForUserFuncCall is an object
+// that describes an invocation of method CreateSearchContextStarForUser on
+// an instance of MockSearchContextsStore.
+type SearchContextsStoreCreateSearchContextStarForUserFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 int32
-	// Result0 is the value of the 1st result returned from this method
+	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Result0 []*types.SavedSearch
-	// Result1 is the value of the 2nd result returned from this method
+	Arg2 int64
+	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SavedSearchStoreListSavedSearchesByOrgIDFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c SearchContextsStoreCreateSearchContextStarForUserFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SavedSearchStoreListSavedSearchesByOrgIDFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c SearchContextsStoreCreateSearchContextStarForUserFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// SavedSearchStoreListSavedSearchesByOrgOrUserFunc describes the behavior
-// when the ListSavedSearchesByOrgOrUser method of the parent
-// MockSavedSearchStore instance is invoked.
-type SavedSearchStoreListSavedSearchesByOrgOrUserFunc struct {
-	defaultHook func(context.Context, *int32, *int32, *PaginationArgs) ([]*types.SavedSearch, error)
-	hooks       []func(context.Context, *int32, *int32, *PaginationArgs) ([]*types.SavedSearch, error)
-	history     []SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall
+// SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc
+// describes the behavior when the
+// CreateSearchContextWithRepositoryRevisions method of the parent
+// MockSearchContextsStore instance is invoked.
+type SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc struct {
+	defaultHook func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)
+	hooks       []func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)
+	history     []SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall
 	mutex       sync.Mutex
 }
 
-// ListSavedSearchesByOrgOrUser delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockSavedSearchStore) ListSavedSearchesByOrgOrUser(v0 context.Context, v1 *int32, v2 *int32, v3 *PaginationArgs) ([]*types.SavedSearch, error) {
-	r0, r1 := m.ListSavedSearchesByOrgOrUserFunc.nextHook()(v0, v1, v2, v3)
-	m.ListSavedSearchesByOrgOrUserFunc.appendCall(SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall{v0, v1, v2, v3, r0, r1})
+// CreateSearchContextWithRepositoryRevisions delegates to the next hook
+// function in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockSearchContextsStore) CreateSearchContextWithRepositoryRevisions(v0 context.Context, v1 *types.SearchContext, v2 []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
+	r0, r1 := m.CreateSearchContextWithRepositoryRevisionsFunc.nextHook()(v0, v1, v2)
+	m.CreateSearchContextWithRepositoryRevisionsFunc.appendCall(SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
-// ListSavedSearchesByOrgOrUser method of the parent MockSavedSearchStore
-// instance is invoked and the hook queue is empty.
-func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) SetDefaultHook(hook func(context.Context, *int32, *int32, *PaginationArgs) ([]*types.SavedSearch, error)) {
+// CreateSearchContextWithRepositoryRevisions method of the parent
+// MockSearchContextsStore instance is invoked and the hook queue is empty.
+func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) SetDefaultHook(hook func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListSavedSearchesByOrgOrUser method of the parent MockSavedSearchStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) PushHook(hook func(context.Context, *int32, *int32, *PaginationArgs) ([]*types.SavedSearch, error)) {
+// CreateSearchContextWithRepositoryRevisions method of the parent
+// MockSearchContextsStore instance invokes the hook at the front of the
+// queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) PushHook(hook func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -52811,20 +54901,20 @@ func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) PushHook(hook func(co
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) SetDefaultReturn(r0 []*types.SavedSearch, r1 error) {
-	f.SetDefaultHook(func(context.Context, *int32, *int32, *PaginationArgs) ([]*types.SavedSearch, error) {
+func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) SetDefaultReturn(r0 *types.SearchContext, r1 error) {
+	f.SetDefaultHook(func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) PushReturn(r0 []*types.SavedSearch, r1 error) {
-	f.PushHook(func(context.Context, *int32, *int32, *PaginationArgs) ([]*types.SavedSearch, error) {
+func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) PushReturn(r0 *types.SearchContext, r1 error) {
+	f.PushHook(func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
 		return r0, r1
 	})
 }
 
-func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) nextHook() func(context.Context, *int32, *int32, *PaginationArgs) ([]*types.SavedSearch, error) {
+func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) nextHook() func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -52837,43 +54927,41 @@ func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) nextHook() func(conte
 	return hook
 }
 
-func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) appendCall(r0 SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall) {
+func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) appendCall(r0 SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall objects describing
-// the invocations of this function.
-func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) History() []SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall {
+// SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall
+// objects describing the invocations of this function.
+func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) History() []SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall {
 	f.mutex.Lock()
-	history := make([]SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall, len(f.history))
+	history := make([]SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall is an object that
-// describes an invocation of method ListSavedSearchesByOrgOrUser on an
-// instance of MockSavedSearchStore.
-type SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall struct {
+// SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall is
+// an object that describes an invocation of method
+// CreateSearchContextWithRepositoryRevisions on an instance of
+// MockSearchContextsStore.
+type SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *int32
+	Arg1 *types.SearchContext
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 *int32
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 *PaginationArgs
+	Arg2 []*types.SearchContextRepositoryRevisions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*types.SavedSearch
+	Result0 *types.SearchContext
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -52881,47 +54969,47 @@ type SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall) Results() []interface{} {
+func (c SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SavedSearchStoreListSavedSearchesByUserIDFunc describes the behavior when
-// the ListSavedSearchesByUserID method of the parent MockSavedSearchStore
+// SearchContextsStoreDeleteSearchContextFunc describes the behavior when
+// the DeleteSearchContext method of the parent MockSearchContextsStore
 // instance is invoked.
-type SavedSearchStoreListSavedSearchesByUserIDFunc struct {
-	defaultHook func(context.Context, int32) ([]*types.SavedSearch, error)
-	hooks       []func(context.Context, int32) ([]*types.SavedSearch, error)
-	history     []SavedSearchStoreListSavedSearchesByUserIDFuncCall
+type SearchContextsStoreDeleteSearchContextFunc struct {
+	defaultHook func(context.Context, int64) error
+	hooks       []func(context.Context, int64) error
+	history     []SearchContextsStoreDeleteSearchContextFuncCall
 	mutex       sync.Mutex
 }
 
-// ListSavedSearchesByUserID delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockSavedSearchStore) ListSavedSearchesByUserID(v0 context.Context, v1 int32) ([]*types.SavedSearch, error) {
-	r0, r1 := m.ListSavedSearchesByUserIDFunc.nextHook()(v0, v1)
-	m.ListSavedSearchesByUserIDFunc.appendCall(SavedSearchStoreListSavedSearchesByUserIDFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// DeleteSearchContext delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockSearchContextsStore) DeleteSearchContext(v0 context.Context, v1 int64) error {
+	r0 := m.DeleteSearchContextFunc.nextHook()(v0, v1)
+	m.DeleteSearchContextFunc.appendCall(SearchContextsStoreDeleteSearchContextFuncCall{v0, v1, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the
-// ListSavedSearchesByUserID method of the parent MockSavedSearchStore
-// instance is invoked and the hook queue is empty.
-func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) SetDefaultHook(hook func(context.Context, int32) ([]*types.SavedSearch, error)) {
+// SetDefaultHook sets function that is called when the DeleteSearchContext
+// method of the parent MockSearchContextsStore instance is invoked and the
+// hook queue is empty.
+func (f *SearchContextsStoreDeleteSearchContextFunc) SetDefaultHook(hook func(context.Context, int64) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListSavedSearchesByUserID method of the parent MockSavedSearchStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) PushHook(hook func(context.Context, int32) ([]*types.SavedSearch, error)) {
+// DeleteSearchContext method of the parent MockSearchContextsStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SearchContextsStoreDeleteSearchContextFunc) PushHook(hook func(context.Context, int64) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -52929,20 +55017,20 @@ func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) PushHook(hook func(conte
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) SetDefaultReturn(r0 []*types.SavedSearch, r1 error) {
-	f.SetDefaultHook(func(context.Context, int32) ([]*types.SavedSearch, error) {
-		return r0, r1
+func (f *SearchContextsStoreDeleteSearchContextFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) PushReturn(r0 []*types.SavedSearch, r1 error) {
-	f.PushHook(func(context.Context, int32) ([]*types.SavedSearch, error) {
-		return r0, r1
+func (f *SearchContextsStoreDeleteSearchContextFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64) error {
+		return r0
 	})
 }
 
-func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) nextHook() func(context.Context, int32) ([]*types.SavedSearch, error) {
+func (f *SearchContextsStoreDeleteSearchContextFunc) nextHook() func(context.Context, int64) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -52955,83 +55043,82 @@ func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) nextHook() func(context.
 	return hook
 }
 
-func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) appendCall(r0 SavedSearchStoreListSavedSearchesByUserIDFuncCall) {
+func (f *SearchContextsStoreDeleteSearchContextFunc) appendCall(r0 SearchContextsStoreDeleteSearchContextFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// SavedSearchStoreListSavedSearchesByUserIDFuncCall objects describing the
+// SearchContextsStoreDeleteSearchContextFuncCall objects describing the
 // invocations of this function.
-func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) History() []SavedSearchStoreListSavedSearchesByUserIDFuncCall {
+func (f *SearchContextsStoreDeleteSearchContextFunc) History() []SearchContextsStoreDeleteSearchContextFuncCall {
 	f.mutex.Lock()
-	history := make([]SavedSearchStoreListSavedSearchesByUserIDFuncCall, len(f.history))
+	history := make([]SearchContextsStoreDeleteSearchContextFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SavedSearchStoreListSavedSearchesByUserIDFuncCall is an object that
-// describes an invocation of method ListSavedSearchesByUserID on an
-// instance of MockSavedSearchStore.
-type SavedSearchStoreListSavedSearchesByUserIDFuncCall struct {
+// SearchContextsStoreDeleteSearchContextFuncCall is an object that
+// describes an invocation of method DeleteSearchContext on an instance of
+// MockSearchContextsStore.
+type SearchContextsStoreDeleteSearchContextFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int32
+	Arg1 int64
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*types.SavedSearch
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SavedSearchStoreListSavedSearchesByUserIDFuncCall) Args() []interface{} {
+func (c SearchContextsStoreDeleteSearchContextFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SavedSearchStoreListSavedSearchesByUserIDFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c SearchContextsStoreDeleteSearchContextFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// SavedSearchStoreUpdateFunc describes the behavior when the Update method
-// of the parent MockSavedSearchStore instance is invoked.
-type SavedSearchStoreUpdateFunc struct {
-	defaultHook func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)
-	hooks       []func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)
-	history     []SavedSearchStoreUpdateFuncCall
+// SearchContextsStoreDeleteSearchContextStarForUserFunc describes the
+// behavior when the DeleteSearchContextStarForUser method of the parent
+// MockSearchContextsStore instance is invoked.
+type SearchContextsStoreDeleteSearchContextStarForUserFunc struct {
+	defaultHook func(context.Context, int32, int64) error
+	hooks       []func(context.Context, int32, int64) error
+	history     []SearchContextsStoreDeleteSearchContextStarForUserFuncCall
 	mutex       sync.Mutex
 }
 
-// Update delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockSavedSearchStore) Update(v0 context.Context, v1 *types.SavedSearch) (*types.SavedSearch, error) {
-	r0, r1 := m.UpdateFunc.nextHook()(v0, v1)
-	m.UpdateFunc.appendCall(SavedSearchStoreUpdateFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// DeleteSearchContextStarForUser delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockSearchContextsStore) DeleteSearchContextStarForUser(v0 context.Context, v1 int32, v2 int64) error {
+	r0 := m.DeleteSearchContextStarForUserFunc.nextHook()(v0, v1, v2)
+	m.DeleteSearchContextStarForUserFunc.appendCall(SearchContextsStoreDeleteSearchContextStarForUserFuncCall{v0, v1, v2, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the Update method of the
-// parent MockSavedSearchStore instance is invoked and the hook queue is
-// empty.
-func (f *SavedSearchStoreUpdateFunc) SetDefaultHook(hook func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)) {
+// SetDefaultHook sets function that is called when the
+// DeleteSearchContextStarForUser method of the parent
+// MockSearchContextsStore instance is invoked and the hook queue is empty.
+func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) SetDefaultHook(hook func(context.Context, int32, int64) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Update method of the parent MockSavedSearchStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *SavedSearchStoreUpdateFunc) PushHook(hook func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)) {
+// DeleteSearchContextStarForUser method of the parent
+// MockSearchContextsStore instance invokes the hook at the front of the
+// queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) PushHook(hook func(context.Context, int32, int64) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -53039,20 +55126,20 @@ func (f *SavedSearchStoreUpdateFunc) PushHook(hook func(context.Context, *types.
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SavedSearchStoreUpdateFunc) SetDefaultReturn(r0 *types.SavedSearch, r1 error) {
-	f.SetDefaultHook(func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
-		return r0, r1
+func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32, int64) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreUpdateFunc) PushReturn(r0 *types.SavedSearch, r1 error) {
-	f.PushHook(func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
-		return r0, r1
+func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32, int64) error {
+		return r0
 	})
 }
 
-func (f *SavedSearchStoreUpdateFunc) nextHook() func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
+func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) nextHook() func(context.Context, int32, int64) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -53065,81 +55152,83 @@ func (f *SavedSearchStoreUpdateFunc) nextHook() func(context.Context, *types.Sav
 	return hook
 }
 
-func (f *SavedSearchStoreUpdateFunc) appendCall(r0 SavedSearchStoreUpdateFuncCall) {
+func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) appendCall(r0 SearchContextsStoreDeleteSearchContextStarForUserFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SavedSearchStoreUpdateFuncCall objects
+// History returns a sequence of
+// SearchContextsStoreDeleteSearchContextStarForUserFuncCall objects
 // describing the invocations of this function.
-func (f *SavedSearchStoreUpdateFunc) History() []SavedSearchStoreUpdateFuncCall {
+func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) History() []SearchContextsStoreDeleteSearchContextStarForUserFuncCall {
 	f.mutex.Lock()
-	history := make([]SavedSearchStoreUpdateFuncCall, len(f.history))
+	history := make([]SearchContextsStoreDeleteSearchContextStarForUserFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SavedSearchStoreUpdateFuncCall is an object that describes an invocation
-// of method Update on an instance of MockSavedSearchStore.
-type SavedSearchStoreUpdateFuncCall struct {
+// SearchContextsStoreDeleteSearchContextStarForUserFuncCall is an object
+// that describes an invocation of method DeleteSearchContextStarForUser on
+// an instance of MockSearchContextsStore.
+type SearchContextsStoreDeleteSearchContextStarForUserFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *types.SavedSearch
-	// Result0 is the value of the 1st result returned from this method
+	Arg1 int32
+	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Result0 *types.SavedSearch
-	// Result1 is the value of the 2nd result returned from this method
+	Arg2 int64
+	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SavedSearchStoreUpdateFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c SearchContextsStoreDeleteSearchContextStarForUserFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SavedSearchStoreUpdateFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c SearchContextsStoreDeleteSearchContextStarForUserFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// SavedSearchStoreWithFunc describes the behavior when the With method of
-// the parent MockSavedSearchStore instance is invoked.
-type SavedSearchStoreWithFunc struct {
-	defaultHook func(basestore.ShareableStore) SavedSearchStore
-	hooks       []func(basestore.ShareableStore) SavedSearchStore
-	history     []SavedSearchStoreWithFuncCall
+// SearchContextsStoreDoneFunc describes the behavior when the Done method
+// of the parent MockSearchContextsStore instance is invoked.
+type SearchContextsStoreDoneFunc struct {
+	defaultHook func(error) error
+	hooks       []func(error) error
+	history     []SearchContextsStoreDoneFuncCall
 	mutex       sync.Mutex
 }
 
-// With delegates to the next hook function in the queue and stores the
+// Done delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockSavedSearchStore) With(v0 basestore.ShareableStore) SavedSearchStore {
-	r0 := m.WithFunc.nextHook()(v0)
-	m.WithFunc.appendCall(SavedSearchStoreWithFuncCall{v0, r0})
+func (m *MockSearchContextsStore) Done(v0 error) error {
+	r0 := m.DoneFunc.nextHook()(v0)
+	m.DoneFunc.appendCall(SearchContextsStoreDoneFuncCall{v0, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the With method of the
-// parent MockSavedSearchStore instance is invoked and the hook queue is
+// SetDefaultHook sets function that is called when the Done method of the
+// parent MockSearchContextsStore instance is invoked and the hook queue is
 // empty.
-func (f *SavedSearchStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) SavedSearchStore) {
+func (f *SearchContextsStoreDoneFunc) SetDefaultHook(hook func(error) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// With method of the parent MockSavedSearchStore instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *SavedSearchStoreWithFunc) PushHook(hook func(basestore.ShareableStore) SavedSearchStore) {
+// Done method of the parent MockSearchContextsStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SearchContextsStoreDoneFunc) PushHook(hook func(error) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -53147,20 +55236,20 @@ func (f *SavedSearchStoreWithFunc) PushHook(hook func(basestore.ShareableStore)
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SavedSearchStoreWithFunc) SetDefaultReturn(r0 SavedSearchStore) {
-	f.SetDefaultHook(func(basestore.ShareableStore) SavedSearchStore {
+func (f *SearchContextsStoreDoneFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(error) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreWithFunc) PushReturn(r0 SavedSearchStore) {
-	f.PushHook(func(basestore.ShareableStore) SavedSearchStore {
+func (f *SearchContextsStoreDoneFunc) PushReturn(r0 error) {
+	f.PushHook(func(error) error {
 		return r0
 	})
 }
 
-func (f *SavedSearchStoreWithFunc) nextHook() func(basestore.ShareableStore) SavedSearchStore {
+func (f *SearchContextsStoreDoneFunc) nextHook() func(error) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -53173,76 +55262,75 @@ func (f *SavedSearchStoreWithFunc) nextHook() func(basestore.ShareableStore) Sav
 	return hook
 }
 
-func (f *SavedSearchStoreWithFunc) appendCall(r0 SavedSearchStoreWithFuncCall) {
+func (f *SearchContextsStoreDoneFunc) appendCall(r0 SearchContextsStoreDoneFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SavedSearchStoreWithFuncCall objects
+// History returns a sequence of SearchContextsStoreDoneFuncCall objects
 // describing the invocations of this function.
-func (f *SavedSearchStoreWithFunc) History() []SavedSearchStoreWithFuncCall {
+func (f *SearchContextsStoreDoneFunc) History() []SearchContextsStoreDoneFuncCall {
 	f.mutex.Lock()
-	history := make([]SavedSearchStoreWithFuncCall, len(f.history))
+	history := make([]SearchContextsStoreDoneFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SavedSearchStoreWithFuncCall is an object that describes an invocation of
-// method With on an instance of MockSavedSearchStore.
-type SavedSearchStoreWithFuncCall struct {
+// SearchContextsStoreDoneFuncCall is an object that describes an invocation
+// of method Done on an instance of MockSearchContextsStore.
+type SearchContextsStoreDoneFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 basestore.ShareableStore
+	Arg0 error
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 SavedSearchStore
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SavedSearchStoreWithFuncCall) Args() []interface{} {
+func (c SearchContextsStoreDoneFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SavedSearchStoreWithFuncCall) Results() []interface{} {
+func (c SearchContextsStoreDoneFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// SavedSearchStoreWithTransactFunc describes the behavior when the
-// WithTransact method of the parent MockSavedSearchStore instance is
-// invoked.
-type SavedSearchStoreWithTransactFunc struct {
-	defaultHook func(context.Context, func(SavedSearchStore) error) error
-	hooks       []func(context.Context, func(SavedSearchStore) error) error
-	history     []SavedSearchStoreWithTransactFuncCall
+// SearchContextsStoreExecFunc describes the behavior when the Exec method
+// of the parent MockSearchContextsStore instance is invoked.
+type SearchContextsStoreExecFunc struct {
+	defaultHook func(context.Context, *sqlf.Query) error
+	hooks       []func(context.Context, *sqlf.Query) error
+	history     []SearchContextsStoreExecFuncCall
 	mutex       sync.Mutex
 }
 
-// WithTransact delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockSavedSearchStore) WithTransact(v0 context.Context, v1 func(SavedSearchStore) error) error {
-	r0 := m.WithTransactFunc.nextHook()(v0, v1)
-	m.WithTransactFunc.appendCall(SavedSearchStoreWithTransactFuncCall{v0, v1, r0})
+// Exec delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSearchContextsStore) Exec(v0 context.Context, v1 *sqlf.Query) error {
+	r0 := m.ExecFunc.nextHook()(v0, v1)
+	m.ExecFunc.appendCall(SearchContextsStoreExecFuncCall{v0, v1, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the WithTransact method
-// of the parent MockSavedSearchStore instance is invoked and the hook queue
-// is empty.
-func (f *SavedSearchStoreWithTransactFunc) SetDefaultHook(hook func(context.Context, func(SavedSearchStore) error) error) {
+// SetDefaultHook sets function that is called when the Exec method of the
+// parent MockSearchContextsStore instance is invoked and the hook queue is
+// empty.
+func (f *SearchContextsStoreExecFunc) SetDefaultHook(hook func(context.Context, *sqlf.Query) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// WithTransact method of the parent MockSavedSearchStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *SavedSearchStoreWithTransactFunc) PushHook(hook func(context.Context, func(SavedSearchStore) error) error) {
+// Exec method of the parent MockSearchContextsStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SearchContextsStoreExecFunc) PushHook(hook func(context.Context, *sqlf.Query) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -53250,20 +55338,20 @@ func (f *SavedSearchStoreWithTransactFunc) PushHook(hook func(context.Context, f
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SavedSearchStoreWithTransactFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, func(SavedSearchStore) error) error {
+func (f *SearchContextsStoreExecFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, *sqlf.Query) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreWithTransactFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, func(SavedSearchStore) error) error {
+func (f *SearchContextsStoreExecFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, *sqlf.Query) error {
 		return r0
 	})
 }
 
-func (f *SavedSearchStoreWithTransactFunc) nextHook() func(context.Context, func(SavedSearchStore) error) error {
+func (f *SearchContextsStoreExecFunc) nextHook() func(context.Context, *sqlf.Query) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -53276,32 +55364,32 @@ func (f *SavedSearchStoreWithTransactFunc) nextHook() func(context.Context, func
 	return hook
 }
 
-func (f *SavedSearchStoreWithTransactFunc) appendCall(r0 SavedSearchStoreWithTransactFuncCall) {
+func (f *SearchContextsStoreExecFunc) appendCall(r0 SearchContextsStoreExecFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SavedSearchStoreWithTransactFuncCall
-// objects describing the invocations of this function.
-func (f *SavedSearchStoreWithTransactFunc) History() []SavedSearchStoreWithTransactFuncCall {
+// History returns a sequence of SearchContextsStoreExecFuncCall objects
+// describing the invocations of this function.
+func (f *SearchContextsStoreExecFunc) History() []SearchContextsStoreExecFuncCall {
 	f.mutex.Lock()
-	history := make([]SavedSearchStoreWithTransactFuncCall, len(f.history))
+	history := make([]SearchContextsStoreExecFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SavedSearchStoreWithTransactFuncCall is an object that describes an
-// invocation of method WithTransact on an instance of MockSavedSearchStore.
-type SavedSearchStoreWithTransactFuncCall struct {
+// SearchContextsStoreExecFuncCall is an object that describes an invocation
+// of method Exec on an instance of MockSearchContextsStore.
+type SearchContextsStoreExecFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 func(SavedSearchStore) error
+	Arg1 *sqlf.Query
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -53309,374 +55397,155 @@ type SavedSearchStoreWithTransactFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SavedSearchStoreWithTransactFuncCall) Args() []interface{} {
+func (c SearchContextsStoreExecFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SavedSearchStoreWithTransactFuncCall) Results() []interface{} {
+func (c SearchContextsStoreExecFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// MockSearchContextsStore is a mock implementation of the
-// SearchContextsStore interface (from the package
-// github.com/sourcegraph/sourcegraph/internal/database) used for unit
-// testing.
-type MockSearchContextsStore struct {
-	// CountSearchContextsFunc is an instance of a mock function object
-	// controlling the behavior of the method CountSearchContexts.
-	CountSearchContextsFunc *SearchContextsStoreCountSearchContextsFunc
-	// CreateSearchContextStarForUserFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// CreateSearchContextStarForUser.
-	CreateSearchContextStarForUserFunc *SearchContextsStoreCreateSearchContextStarForUserFunc
-	// CreateSearchContextWithRepositoryRevisionsFunc is an instance of a
-	// mock function object controlling the behavior of the method
-	// CreateSearchContextWithRepositoryRevisions.
-	CreateSearchContextWithRepositoryRevisionsFunc *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc
-	// DeleteSearchContextFunc is an instance of a mock function object
-	// controlling the behavior of the method DeleteSearchContext.
-	DeleteSearchContextFunc *SearchContextsStoreDeleteSearchContextFunc
-	// DeleteSearchContextStarForUserFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// DeleteSearchContextStarForUser.
-	DeleteSearchContextStarForUserFunc *SearchContextsStoreDeleteSearchContextStarForUserFunc
-	// DoneFunc is an instance of a mock function object controlling the
-	// behavior of the method Done.
-	DoneFunc *SearchContextsStoreDoneFunc
-	// ExecFunc is an instance of a mock function object controlling the
-	// behavior of the method Exec.
-	ExecFunc *SearchContextsStoreExecFunc
-	// GetAllQueriesFunc is an instance of a mock function object
-	// controlling the behavior of the method GetAllQueries.
-	GetAllQueriesFunc *SearchContextsStoreGetAllQueriesFunc
-	// GetAllRevisionsForReposFunc is an instance of a mock function object
-	// controlling the behavior of the method GetAllRevisionsForRepos.
-	GetAllRevisionsForReposFunc *SearchContextsStoreGetAllRevisionsForReposFunc
-	// GetDefaultSearchContextForCurrentUserFunc is an instance of a mock
-	// function object controlling the behavior of the method
-	// GetDefaultSearchContextForCurrentUser.
-	GetDefaultSearchContextForCurrentUserFunc *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc
-	// GetSearchContextFunc is an instance of a mock function object
-	// controlling the behavior of the method GetSearchContext.
-	GetSearchContextFunc *SearchContextsStoreGetSearchContextFunc
-	// GetSearchContextRepositoryRevisionsFunc is an instance of a mock
-	// function object controlling the behavior of the method
-	// GetSearchContextRepositoryRevisions.
-	GetSearchContextRepositoryRevisionsFunc *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc
-	// HandleFunc is an instance of a mock function object controlling the
-	// behavior of the method Handle.
-	HandleFunc *SearchContextsStoreHandleFunc
-	// ListSearchContextsFunc is an instance of a mock function object
-	// controlling the behavior of the method ListSearchContexts.
-	ListSearchContextsFunc *SearchContextsStoreListSearchContextsFunc
-	// SetSearchContextRepositoryRevisionsFunc is an instance of a mock
-	// function object controlling the behavior of the method
-	// SetSearchContextRepositoryRevisions.
-	SetSearchContextRepositoryRevisionsFunc *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc
-	// SetUserDefaultSearchContextIDFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// SetUserDefaultSearchContextID.
-	SetUserDefaultSearchContextIDFunc *SearchContextsStoreSetUserDefaultSearchContextIDFunc
-	// TransactFunc is an instance of a mock function object controlling the
-	// behavior of the method Transact.
-	TransactFunc *SearchContextsStoreTransactFunc
-	// UpdateSearchContextWithRepositoryRevisionsFunc is an instance of a
-	// mock function object controlling the behavior of the method
-	// UpdateSearchContextWithRepositoryRevisions.
-	UpdateSearchContextWithRepositoryRevisionsFunc *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc
+// SearchContextsStoreGetAllQueriesFunc describes the behavior when the
+// GetAllQueries method of the parent MockSearchContextsStore instance is
+// invoked.
+type SearchContextsStoreGetAllQueriesFunc struct {
+	defaultHook func(context.Context) ([]string, error)
+	hooks       []func(context.Context) ([]string, error)
+	history     []SearchContextsStoreGetAllQueriesFuncCall
+	mutex       sync.Mutex
 }
 
-// NewMockSearchContextsStore creates a new mock of the SearchContextsStore
-// interface. All methods return zero values for all results, unless
-// overwritten.
-func NewMockSearchContextsStore() *MockSearchContextsStore {
-	return &MockSearchContextsStore{
-		CountSearchContextsFunc: &SearchContextsStoreCountSearchContextsFunc{
-			defaultHook: func(context.Context, ListSearchContextsOptions) (r0 int32, r1 error) {
-				return
-			},
-		},
-		CreateSearchContextStarForUserFunc: &SearchContextsStoreCreateSearchContextStarForUserFunc{
-			defaultHook: func(context.Context, int32, int64) (r0 error) {
-				return
-			},
-		},
-		CreateSearchContextWithRepositoryRevisionsFunc: &SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc{
-			defaultHook: func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (r0 *types.SearchContext, r1 error) {
-				return
-			},
-		},
-		DeleteSearchContextFunc: &SearchContextsStoreDeleteSearchContextFunc{
-			defaultHook: func(context.Context, int64) (r0 error) {
-				return
-			},
-		},
-		DeleteSearchContextStarForUserFunc: &SearchContextsStoreDeleteSearchContextStarForUserFunc{
-			defaultHook: func(context.Context, int32, int64) (r0 error) {
-				return
-			},
-		},
-		DoneFunc: &SearchContextsStoreDoneFunc{
-			defaultHook: func(error) (r0 error) {
-				return
-			},
-		},
-		ExecFunc: &SearchContextsStoreExecFunc{
-			defaultHook: func(context.Context, *sqlf.Query) (r0 error) {
-				return
-			},
-		},
-		GetAllQueriesFunc: &SearchContextsStoreGetAllQueriesFunc{
-			defaultHook: func(context.Context) (r0 []string, r1 error) {
-				return
-			},
-		},
-		GetAllRevisionsForReposFunc: &SearchContextsStoreGetAllRevisionsForReposFunc{
-			defaultHook: func(context.Context, []api.RepoID) (r0 map[api.RepoID][]string, r1 error) {
-				return
-			},
-		},
-		GetDefaultSearchContextForCurrentUserFunc: &SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc{
-			defaultHook: func(context.Context) (r0 *types.SearchContext, r1 error) {
-				return
-			},
-		},
-		GetSearchContextFunc: &SearchContextsStoreGetSearchContextFunc{
-			defaultHook: func(context.Context, GetSearchContextOptions) (r0 *types.SearchContext, r1 error) {
-				return
-			},
-		},
-		GetSearchContextRepositoryRevisionsFunc: &SearchContextsStoreGetSearchContextRepositoryRevisionsFunc{
-			defaultHook: func(context.Context, int64) (r0 []*types.SearchContextRepositoryRevisions, r1 error) {
-				return
-			},
-		},
-		HandleFunc: &SearchContextsStoreHandleFunc{
-			defaultHook: func() (r0 basestore.TransactableHandle) {
-				return
-			},
-		},
-		ListSearchContextsFunc: &SearchContextsStoreListSearchContextsFunc{
-			defaultHook: func(context.Context, ListSearchContextsPageOptions, ListSearchContextsOptions) (r0 []*types.SearchContext, r1 error) {
-				return
-			},
-		},
-		SetSearchContextRepositoryRevisionsFunc: &SearchContextsStoreSetSearchContextRepositoryRevisionsFunc{
-			defaultHook: func(context.Context, int64, []*types.SearchContextRepositoryRevisions) (r0 error) {
-				return
-			},
-		},
-		SetUserDefaultSearchContextIDFunc: &SearchContextsStoreSetUserDefaultSearchContextIDFunc{
-			defaultHook: func(context.Context, int32, int64) (r0 error) {
-				return
-			},
-		},
-		TransactFunc: &SearchContextsStoreTransactFunc{
-			defaultHook: func(context.Context) (r0 SearchContextsStore, r1 error) {
-				return
-			},
-		},
-		UpdateSearchContextWithRepositoryRevisionsFunc: &SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc{
-			defaultHook: func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (r0 *types.SearchContext, r1 error) {
-				return
-			},
-		},
-	}
+// GetAllQueries delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockSearchContextsStore) GetAllQueries(v0 context.Context) ([]string, error) {
+	r0, r1 := m.GetAllQueriesFunc.nextHook()(v0)
+	m.GetAllQueriesFunc.appendCall(SearchContextsStoreGetAllQueriesFuncCall{v0, r0, r1})
+	return r0, r1
 }
 
-// NewStrictMockSearchContextsStore creates a new mock of the
-// SearchContextsStore interface. All methods panic on invocation, unless
-// overwritten.
-func NewStrictMockSearchContextsStore() *MockSearchContextsStore {
-	return &MockSearchContextsStore{
-		CountSearchContextsFunc: &SearchContextsStoreCountSearchContextsFunc{
-			defaultHook: func(context.Context, ListSearchContextsOptions) (int32, error) {
-				panic("unexpected invocation of MockSearchContextsStore.CountSearchContexts")
-			},
-		},
-		CreateSearchContextStarForUserFunc: &SearchContextsStoreCreateSearchContextStarForUserFunc{
-			defaultHook: func(context.Context, int32, int64) error {
-				panic("unexpected invocation of MockSearchContextsStore.CreateSearchContextStarForUser")
-			},
-		},
-		CreateSearchContextWithRepositoryRevisionsFunc: &SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc{
-			defaultHook: func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
-				panic("unexpected invocation of MockSearchContextsStore.CreateSearchContextWithRepositoryRevisions")
-			},
-		},
-		DeleteSearchContextFunc: &SearchContextsStoreDeleteSearchContextFunc{
-			defaultHook: func(context.Context, int64) error {
-				panic("unexpected invocation of MockSearchContextsStore.DeleteSearchContext")
-			},
-		},
-		DeleteSearchContextStarForUserFunc: &SearchContextsStoreDeleteSearchContextStarForUserFunc{
-			defaultHook: func(context.Context, int32, int64) error {
-				panic("unexpected invocation of MockSearchContextsStore.DeleteSearchContextStarForUser")
-			},
-		},
-		DoneFunc: &SearchContextsStoreDoneFunc{
-			defaultHook: func(error) error {
-				panic("unexpected invocation of MockSearchContextsStore.Done")
-			},
-		},
-		ExecFunc: &SearchContextsStoreExecFunc{
-			defaultHook: func(context.Context, *sqlf.Query) error {
-				panic("unexpected invocation of MockSearchContextsStore.Exec")
-			},
-		},
-		GetAllQueriesFunc: &SearchContextsStoreGetAllQueriesFunc{
-			defaultHook: func(context.Context) ([]string, error) {
-				panic("unexpected invocation of MockSearchContextsStore.GetAllQueries")
-			},
-		},
-		GetAllRevisionsForReposFunc: &SearchContextsStoreGetAllRevisionsForReposFunc{
-			defaultHook: func(context.Context, []api.RepoID) (map[api.RepoID][]string, error) {
-				panic("unexpected invocation of MockSearchContextsStore.GetAllRevisionsForRepos")
-			},
-		},
-		GetDefaultSearchContextForCurrentUserFunc: &SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc{
-			defaultHook: func(context.Context) (*types.SearchContext, error) {
-				panic("unexpected invocation of MockSearchContextsStore.GetDefaultSearchContextForCurrentUser")
-			},
-		},
-		GetSearchContextFunc: &SearchContextsStoreGetSearchContextFunc{
-			defaultHook: func(context.Context, GetSearchContextOptions) (*types.SearchContext, error) {
-				panic("unexpected invocation of MockSearchContextsStore.GetSearchContext")
-			},
-		},
-		GetSearchContextRepositoryRevisionsFunc: &SearchContextsStoreGetSearchContextRepositoryRevisionsFunc{
-			defaultHook: func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error) {
-				panic("unexpected invocation of MockSearchContextsStore.GetSearchContextRepositoryRevisions")
-			},
-		},
-		HandleFunc: &SearchContextsStoreHandleFunc{
-			defaultHook: func() basestore.TransactableHandle {
-				panic("unexpected invocation of MockSearchContextsStore.Handle")
-			},
-		},
-		ListSearchContextsFunc: &SearchContextsStoreListSearchContextsFunc{
-			defaultHook: func(context.Context, ListSearchContextsPageOptions, ListSearchContextsOptions) ([]*types.SearchContext, error) {
-				panic("unexpected invocation of MockSearchContextsStore.ListSearchContexts")
-			},
-		},
-		SetSearchContextRepositoryRevisionsFunc: &SearchContextsStoreSetSearchContextRepositoryRevisionsFunc{
-			defaultHook: func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error {
-				panic("unexpected invocation of MockSearchContextsStore.SetSearchContextRepositoryRevisions")
-			},
-		},
-		SetUserDefaultSearchContextIDFunc: &SearchContextsStoreSetUserDefaultSearchContextIDFunc{
-			defaultHook: func(context.Context, int32, int64) error {
-				panic("unexpected invocation of MockSearchContextsStore.SetUserDefaultSearchContextID")
-			},
-		},
-		TransactFunc: &SearchContextsStoreTransactFunc{
-			defaultHook: func(context.Context) (SearchContextsStore, error) {
-				panic("unexpected invocation of MockSearchContextsStore.Transact")
-			},
-		},
-		UpdateSearchContextWithRepositoryRevisionsFunc: &SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc{
-			defaultHook: func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
-				panic("unexpected invocation of MockSearchContextsStore.UpdateSearchContextWithRepositoryRevisions")
-			},
-		},
-	}
+// SetDefaultHook sets function that is called when the GetAllQueries method
+// of the parent MockSearchContextsStore instance is invoked and the hook
+// queue is empty.
+func (f *SearchContextsStoreGetAllQueriesFunc) SetDefaultHook(hook func(context.Context) ([]string, error)) {
+	f.defaultHook = hook
 }
 
-// NewMockSearchContextsStoreFrom creates a new mock of the
-// MockSearchContextsStore interface. All methods delegate to the given
-// implementation, unless overwritten.
-func NewMockSearchContextsStoreFrom(i SearchContextsStore) *MockSearchContextsStore {
-	return &MockSearchContextsStore{
-		CountSearchContextsFunc: &SearchContextsStoreCountSearchContextsFunc{
-			defaultHook: i.CountSearchContexts,
-		},
-		CreateSearchContextStarForUserFunc: &SearchContextsStoreCreateSearchContextStarForUserFunc{
-			defaultHook: i.CreateSearchContextStarForUser,
-		},
-		CreateSearchContextWithRepositoryRevisionsFunc: &SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc{
-			defaultHook: i.CreateSearchContextWithRepositoryRevisions,
-		},
-		DeleteSearchContextFunc: &SearchContextsStoreDeleteSearchContextFunc{
-			defaultHook: i.DeleteSearchContext,
-		},
-		DeleteSearchContextStarForUserFunc: &SearchContextsStoreDeleteSearchContextStarForUserFunc{
-			defaultHook: i.DeleteSearchContextStarForUser,
-		},
-		DoneFunc: &SearchContextsStoreDoneFunc{
-			defaultHook: i.Done,
-		},
-		ExecFunc: &SearchContextsStoreExecFunc{
-			defaultHook: i.Exec,
-		},
-		GetAllQueriesFunc: &SearchContextsStoreGetAllQueriesFunc{
-			defaultHook: i.GetAllQueries,
-		},
-		GetAllRevisionsForReposFunc: &SearchContextsStoreGetAllRevisionsForReposFunc{
-			defaultHook: i.GetAllRevisionsForRepos,
-		},
-		GetDefaultSearchContextForCurrentUserFunc: &SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc{
-			defaultHook: i.GetDefaultSearchContextForCurrentUser,
-		},
-		GetSearchContextFunc: &SearchContextsStoreGetSearchContextFunc{
-			defaultHook: i.GetSearchContext,
-		},
-		GetSearchContextRepositoryRevisionsFunc: &SearchContextsStoreGetSearchContextRepositoryRevisionsFunc{
-			defaultHook: i.GetSearchContextRepositoryRevisions,
-		},
-		HandleFunc: &SearchContextsStoreHandleFunc{
-			defaultHook: i.Handle,
-		},
-		ListSearchContextsFunc: &SearchContextsStoreListSearchContextsFunc{
-			defaultHook: i.ListSearchContexts,
-		},
-		SetSearchContextRepositoryRevisionsFunc: &SearchContextsStoreSetSearchContextRepositoryRevisionsFunc{
-			defaultHook: i.SetSearchContextRepositoryRevisions,
-		},
-		SetUserDefaultSearchContextIDFunc: &SearchContextsStoreSetUserDefaultSearchContextIDFunc{
-			defaultHook: i.SetUserDefaultSearchContextID,
-		},
-		TransactFunc: &SearchContextsStoreTransactFunc{
-			defaultHook: i.Transact,
-		},
-		UpdateSearchContextWithRepositoryRevisionsFunc: &SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc{
-			defaultHook: i.UpdateSearchContextWithRepositoryRevisions,
-		},
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetAllQueries method of the parent MockSearchContextsStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SearchContextsStoreGetAllQueriesFunc) PushHook(hook func(context.Context) ([]string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *SearchContextsStoreGetAllQueriesFunc) SetDefaultReturn(r0 []string, r1 error) {
+	f.SetDefaultHook(func(context.Context) ([]string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *SearchContextsStoreGetAllQueriesFunc) PushReturn(r0 []string, r1 error) {
+	f.PushHook(func(context.Context) ([]string, error) {
+		return r0, r1
+	})
+}
+
+func (f *SearchContextsStoreGetAllQueriesFunc) nextHook() func(context.Context) ([]string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
 	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SearchContextsStoreGetAllQueriesFunc) appendCall(r0 SearchContextsStoreGetAllQueriesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SearchContextsStoreGetAllQueriesFuncCall
+// objects describing the invocations of this function.
+func (f *SearchContextsStoreGetAllQueriesFunc) History() []SearchContextsStoreGetAllQueriesFuncCall {
+	f.mutex.Lock()
+	history := make([]SearchContextsStoreGetAllQueriesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SearchContextsStoreGetAllQueriesFuncCall is an object that describes an
+// invocation of method GetAllQueries on an instance of
+// MockSearchContextsStore.
+type SearchContextsStoreGetAllQueriesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
-// SearchContextsStoreCountSearchContextsFunc describes the behavior when
-// the CountSearchContexts method of the parent MockSearchContextsStore
-// instance is invoked.
-type SearchContextsStoreCountSearchContextsFunc struct {
-	defaultHook func(context.Context, ListSearchContextsOptions) (int32, error)
-	hooks       []func(context.Context, ListSearchContextsOptions) (int32, error)
-	history     []SearchContextsStoreCountSearchContextsFuncCall
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c SearchContextsStoreGetAllQueriesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SearchContextsStoreGetAllQueriesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SearchContextsStoreGetAllRevisionsForReposFunc describes the behavior
+// when the GetAllRevisionsForRepos method of the parent
+// MockSearchContextsStore instance is invoked.
+type SearchContextsStoreGetAllRevisionsForReposFunc struct {
+	defaultHook func(context.Context, []api.RepoID) (map[api.RepoID][]string, error)
+	hooks       []func(context.Context, []api.RepoID) (map[api.RepoID][]string, error)
+	history     []SearchContextsStoreGetAllRevisionsForReposFuncCall
 	mutex       sync.Mutex
 }
 
-// CountSearchContexts delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockSearchContextsStore) CountSearchContexts(v0 context.Context, v1 ListSearchContextsOptions) (int32, error) {
-	r0, r1 := m.CountSearchContextsFunc.nextHook()(v0, v1)
-	m.CountSearchContextsFunc.appendCall(SearchContextsStoreCountSearchContextsFuncCall{v0, v1, r0, r1})
+// GetAllRevisionsForRepos delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockSearchContextsStore) GetAllRevisionsForRepos(v0 context.Context, v1 []api.RepoID) (map[api.RepoID][]string, error) {
+	r0, r1 := m.GetAllRevisionsForReposFunc.nextHook()(v0, v1)
+	m.GetAllRevisionsForReposFunc.appendCall(SearchContextsStoreGetAllRevisionsForReposFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the CountSearchContexts
-// method of the parent MockSearchContextsStore instance is invoked and the
-// hook queue is empty.
-func (f *SearchContextsStoreCountSearchContextsFunc) SetDefaultHook(hook func(context.Context, ListSearchContextsOptions) (int32, error)) {
+// SetDefaultHook sets function that is called when the
+// GetAllRevisionsForRepos method of the parent MockSearchContextsStore
+// instance is invoked and the hook queue is empty.
+func (f *SearchContextsStoreGetAllRevisionsForReposFunc) SetDefaultHook(hook func(context.Context, []api.RepoID) (map[api.RepoID][]string, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CountSearchContexts method of the parent MockSearchContextsStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *SearchContextsStoreCountSearchContextsFunc) PushHook(hook func(context.Context, ListSearchContextsOptions) (int32, error)) {
+// GetAllRevisionsForRepos method of the parent MockSearchContextsStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *SearchContextsStoreGetAllRevisionsForReposFunc) PushHook(hook func(context.Context, []api.RepoID) (map[api.RepoID][]string, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -53684,20 +55553,20 @@ func (f *SearchContextsStoreCountSearchContextsFunc) PushHook(hook func(context.
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreCountSearchContextsFunc) SetDefaultReturn(r0 int32, r1 error) {
-	f.SetDefaultHook(func(context.Context, ListSearchContextsOptions) (int32, error) {
+func (f *SearchContextsStoreGetAllRevisionsForReposFunc) SetDefaultReturn(r0 map[api.RepoID][]string, r1 error) {
+	f.SetDefaultHook(func(context.Context, []api.RepoID) (map[api.RepoID][]string, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreCountSearchContextsFunc) PushReturn(r0 int32, r1 error) {
-	f.PushHook(func(context.Context, ListSearchContextsOptions) (int32, error) {
+func (f *SearchContextsStoreGetAllRevisionsForReposFunc) PushReturn(r0 map[api.RepoID][]string, r1 error) {
+	f.PushHook(func(context.Context, []api.RepoID) (map[api.RepoID][]string, error) {
 		return r0, r1
 	})
 }
 
-func (f *SearchContextsStoreCountSearchContextsFunc) nextHook() func(context.Context, ListSearchContextsOptions) (int32, error) {
+func (f *SearchContextsStoreGetAllRevisionsForReposFunc) nextHook() func(context.Context, []api.RepoID) (map[api.RepoID][]string, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -53710,37 +55579,37 @@ func (f *SearchContextsStoreCountSearchContextsFunc) nextHook() func(context.Con
 	return hook
 }
 
-func (f *SearchContextsStoreCountSearchContextsFunc) appendCall(r0 SearchContextsStoreCountSearchContextsFuncCall) {
+func (f *SearchContextsStoreGetAllRevisionsForReposFunc) appendCall(r0 SearchContextsStoreGetAllRevisionsForReposFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// SearchContextsStoreCountSearchContextsFuncCall objects describing the
+// SearchContextsStoreGetAllRevisionsForReposFuncCall objects describing the
 // invocations of this function.
-func (f *SearchContextsStoreCountSearchContextsFunc) History() []SearchContextsStoreCountSearchContextsFuncCall {
+func (f *SearchContextsStoreGetAllRevisionsForReposFunc) History() []SearchContextsStoreGetAllRevisionsForReposFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreCountSearchContextsFuncCall, len(f.history))
+	history := make([]SearchContextsStoreGetAllRevisionsForReposFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreCountSearchContextsFuncCall is an object that
-// describes an invocation of method CountSearchContexts on an instance of
-// MockSearchContextsStore.
-type SearchContextsStoreCountSearchContextsFuncCall struct {
+// SearchContextsStoreGetAllRevisionsForReposFuncCall is an object that
+// describes an invocation of method GetAllRevisionsForRepos on an instance
+// of MockSearchContextsStore.
+type SearchContextsStoreGetAllRevisionsForReposFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 ListSearchContextsOptions
+	Arg1 []api.RepoID
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 int32
+	Result0 map[api.RepoID][]string
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -53748,47 +55617,48 @@ type SearchContextsStoreCountSearchContextsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreCountSearchContextsFuncCall) Args() []interface{} {
+func (c SearchContextsStoreGetAllRevisionsForReposFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreCountSearchContextsFuncCall) Results() []interface{} {
+func (c SearchContextsStoreGetAllRevisionsForReposFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SearchContextsStoreCreateSearchContextStarForUserFunc describes the
-// behavior when the CreateSearchContextStarForUser method of the parent
-// MockSearchContextsStore instance is invoked.
-type SearchContextsStoreCreateSearchContextStarForUserFunc struct {
-	defaultHook func(context.Context, int32, int64) error
-	hooks       []func(context.Context, int32, int64) error
-	history     []SearchContextsStoreCreateSearchContextStarForUserFuncCall
+// SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc describes
+// the behavior when the GetDefaultSearchContextForCurrentUser method of the
+// parent MockSearchContextsStore instance is invoked.
+type SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc struct {
+	defaultHook func(context.Context) (*types.SearchContext, error)
+	hooks       []func(context.Context) (*types.SearchContext, error)
+	history     []SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall
 	mutex       sync.Mutex
 }
 
-// CreateSearchContextStarForUser delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockSearchContextsStore) CreateSearchContextStarForUser(v0 context.Context, v1 int32, v2 int64) error {
-	r0 := m.CreateSearchContextStarForUserFunc.nextHook()(v0, v1, v2)
-	m.CreateSearchContextStarForUserFunc.appendCall(SearchContextsStoreCreateSearchContextStarForUserFuncCall{v0, v1, v2, r0})
-	return r0
+// GetDefaultSearchContextForCurrentUser delegates to the next hook function
+// in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockSearchContextsStore) GetDefaultSearchContextForCurrentUser(v0 context.Context) (*types.SearchContext, error) {
+	r0, r1 := m.GetDefaultSearchContextForCurrentUserFunc.nextHook()(v0)
+	m.GetDefaultSearchContextForCurrentUserFunc.appendCall(SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall{v0, r0, r1})
+	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
-// CreateSearchContextStarForUser method of the parent
+// GetDefaultSearchContextForCurrentUser method of the parent
 // MockSearchContextsStore instance is invoked and the hook queue is empty.
-func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) SetDefaultHook(hook func(context.Context, int32, int64) error) {
+func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) SetDefaultHook(hook func(context.Context) (*types.SearchContext, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CreateSearchContextStarForUser method of the parent
+// GetDefaultSearchContextForCurrentUser method of the parent
 // MockSearchContextsStore instance invokes the hook at the front of the
 // queue and discards it. After the queue is empty, the default hook
 // function is invoked for any future action.
-func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) PushHook(hook func(context.Context, int32, int64) error) {
+func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) PushHook(hook func(context.Context) (*types.SearchContext, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -53796,20 +55666,20 @@ func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) PushHook(hook fu
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int32, int64) error {
-		return r0
+func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) SetDefaultReturn(r0 *types.SearchContext, r1 error) {
+	f.SetDefaultHook(func(context.Context) (*types.SearchContext, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int32, int64) error {
-		return r0
+func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) PushReturn(r0 *types.SearchContext, r1 error) {
+	f.PushHook(func(context.Context) (*types.SearchContext, error) {
+		return r0, r1
 	})
 }
 
-func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) nextHook() func(context.Context, int32, int64) error {
+func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) nextHook() func(context.Context) (*types.SearchContext, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -53822,87 +55692,195 @@ func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) nextHook() func(
 	return hook
 }
 
-func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) appendCall(r0 SearchContextsStoreCreateSearchContextStarForUserFuncCall) {
+func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) appendCall(r0 SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// SearchContextsStoreCreateSearchContextStarForUserFuncCall objects
+// SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall objects
 // describing the invocations of this function.
-func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) History() []SearchContextsStoreCreateSearchContextStarForUserFuncCall {
+func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) History() []SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreCreateSearchContextStarForUserFuncCall, len(f.history))
+	history := make([]SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreCreateSearchContextStarForUserFuncCall is an object
-// that describes an invocation of method CreateSearchContextStarForUser on
-// an instance of MockSearchContextsStore.
-type SearchContextsStoreCreateSearchContextStarForUserFuncCall struct {
+// SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall is an
+// object that describes an invocation of method
+// GetDefaultSearchContextForCurrentUser on an instance of
+// MockSearchContextsStore.
+type SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
+	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Arg1 int32
-	// Arg2 is the value of the 3rd argument passed to this method
+	Result0 *types.SearchContext
+	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Arg2 int64
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SearchContextsStoreGetSearchContextFunc describes the behavior when the
+// GetSearchContext method of the parent MockSearchContextsStore instance is
+// invoked.
+type SearchContextsStoreGetSearchContextFunc struct {
+	defaultHook func(context.Context, GetSearchContextOptions) (*types.SearchContext, error)
+	hooks       []func(context.Context, GetSearchContextOptions) (*types.SearchContext, error)
+	history     []SearchContextsStoreGetSearchContextFuncCall
+	mutex       sync.Mutex
+}
+
+// GetSearchContext delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockSearchContextsStore) GetSearchContext(v0 context.Context, v1 GetSearchContextOptions) (*types.SearchContext, error) {
+	r0, r1 := m.GetSearchContextFunc.nextHook()(v0, v1)
+	m.GetSearchContextFunc.appendCall(SearchContextsStoreGetSearchContextFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetSearchContext
+// method of the parent MockSearchContextsStore instance is invoked and the
+// hook queue is empty.
+func (f *SearchContextsStoreGetSearchContextFunc) SetDefaultHook(hook func(context.Context, GetSearchContextOptions) (*types.SearchContext, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetSearchContext method of the parent MockSearchContextsStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SearchContextsStoreGetSearchContextFunc) PushHook(hook func(context.Context, GetSearchContextOptions) (*types.SearchContext, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *SearchContextsStoreGetSearchContextFunc) SetDefaultReturn(r0 *types.SearchContext, r1 error) {
+	f.SetDefaultHook(func(context.Context, GetSearchContextOptions) (*types.SearchContext, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *SearchContextsStoreGetSearchContextFunc) PushReturn(r0 *types.SearchContext, r1 error) {
+	f.PushHook(func(context.Context, GetSearchContextOptions) (*types.SearchContext, error) {
+		return r0, r1
+	})
+}
+
+func (f *SearchContextsStoreGetSearchContextFunc) nextHook() func(context.Context, GetSearchContextOptions) (*types.SearchContext, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SearchContextsStoreGetSearchContextFunc) appendCall(r0 SearchContextsStoreGetSearchContextFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SearchContextsStoreGetSearchContextFuncCall
+// objects describing the invocations of this function.
+func (f *SearchContextsStoreGetSearchContextFunc) History() []SearchContextsStoreGetSearchContextFuncCall {
+	f.mutex.Lock()
+	history := make([]SearchContextsStoreGetSearchContextFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SearchContextsStoreGetSearchContextFuncCall is an object that describes
+// an invocation of method GetSearchContext on an instance of
+// MockSearchContextsStore.
+type SearchContextsStoreGetSearchContextFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 GetSearchContextOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 *types.SearchContext
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreCreateSearchContextStarForUserFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c SearchContextsStoreGetSearchContextFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreCreateSearchContextStarForUserFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c SearchContextsStoreGetSearchContextFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc
-// describes the behavior when the
-// CreateSearchContextWithRepositoryRevisions method of the parent
-// MockSearchContextsStore instance is invoked.
-type SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc struct {
-	defaultHook func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)
-	hooks       []func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)
-	history     []SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall
+// SearchContextsStoreGetSearchContextRepositoryRevisionsFunc describes the
+// behavior when the GetSearchContextRepositoryRevisions method of the
+// parent MockSearchContextsStore instance is invoked.
+type SearchContextsStoreGetSearchContextRepositoryRevisionsFunc struct {
+	defaultHook func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error)
+	hooks       []func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error)
+	history     []SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall
 	mutex       sync.Mutex
 }
 
-// CreateSearchContextWithRepositoryRevisions delegates to the next hook
-// function in the queue and stores the parameter and result values of this
+// GetSearchContextRepositoryRevisions delegates to the next hook function
+// in the queue and stores the parameter and result values of this
 // invocation.
-func (m *MockSearchContextsStore) CreateSearchContextWithRepositoryRevisions(v0 context.Context, v1 *types.SearchContext, v2 []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
-	r0, r1 := m.CreateSearchContextWithRepositoryRevisionsFunc.nextHook()(v0, v1, v2)
-	m.CreateSearchContextWithRepositoryRevisionsFunc.appendCall(SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall{v0, v1, v2, r0, r1})
+func (m *MockSearchContextsStore) GetSearchContextRepositoryRevisions(v0 context.Context, v1 int64) ([]*types.SearchContextRepositoryRevisions, error) {
+	r0, r1 := m.GetSearchContextRepositoryRevisionsFunc.nextHook()(v0, v1)
+	m.GetSearchContextRepositoryRevisionsFunc.appendCall(SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
-// CreateSearchContextWithRepositoryRevisions method of the parent
+// GetSearchContextRepositoryRevisions method of the parent
 // MockSearchContextsStore instance is invoked and the hook queue is empty.
-func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) SetDefaultHook(hook func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)) {
+func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) SetDefaultHook(hook func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CreateSearchContextWithRepositoryRevisions method of the parent
+// GetSearchContextRepositoryRevisions method of the parent
 // MockSearchContextsStore instance invokes the hook at the front of the
 // queue and discards it. After the queue is empty, the default hook
 // function is invoked for any future action.
-func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) PushHook(hook func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)) {
+func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) PushHook(hook func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -53910,20 +55888,20 @@ func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) Push
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) SetDefaultReturn(r0 *types.SearchContext, r1 error) {
-	f.SetDefaultHook(func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
+func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) SetDefaultReturn(r0 []*types.SearchContextRepositoryRevisions, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) PushReturn(r0 *types.SearchContext, r1 error) {
-	f.PushHook(func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
+func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) PushReturn(r0 []*types.SearchContextRepositoryRevisions, r1 error) {
+	f.PushHook(func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error) {
 		return r0, r1
 	})
 }
 
-func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) nextHook() func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
+func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) nextHook() func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -53936,41 +55914,38 @@ func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) next
 	return hook
 }
 
-func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) appendCall(r0 SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall) {
+func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) appendCall(r0 SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall
-// objects describing the invocations of this function.
-func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) History() []SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall {
+// SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall objects
+// describing the invocations of this function.
+func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) History() []SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall, len(f.history))
+	history := make([]SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall is
-// an object that describes an invocation of method
-// CreateSearchContextWithRepositoryRevisions on an instance of
+// SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall is an
+// object that describes an invocation of method
+// GetSearchContextRepositoryRevisions on an instance of
 // MockSearchContextsStore.
-type SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall struct {
+type SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *types.SearchContext
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 []*types.SearchContextRepositoryRevisions
+	Arg1 int64
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.SearchContext
+	Result0 []*types.SearchContextRepositoryRevisions
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -53978,47 +55953,146 @@ type SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall struc
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall) Results() []interface{} {
+func (c SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SearchContextsStoreDeleteSearchContextFunc describes the behavior when
-// the DeleteSearchContext method of the parent MockSearchContextsStore
-// instance is invoked.
-type SearchContextsStoreDeleteSearchContextFunc struct {
-	defaultHook func(context.Context, int64) error
-	hooks       []func(context.Context, int64) error
-	history     []SearchContextsStoreDeleteSearchContextFuncCall
+// SearchContextsStoreHandleFunc describes the behavior when the Handle
+// method of the parent MockSearchContextsStore instance is invoked.
+type SearchContextsStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []SearchContextsStoreHandleFuncCall
 	mutex       sync.Mutex
 }
 
-// DeleteSearchContext delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockSearchContextsStore) DeleteSearchContext(v0 context.Context, v1 int64) error {
-	r0 := m.DeleteSearchContextFunc.nextHook()(v0, v1)
-	m.DeleteSearchContextFunc.appendCall(SearchContextsStoreDeleteSearchContextFuncCall{v0, v1, r0})
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSearchContextsStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(SearchContextsStoreHandleFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the DeleteSearchContext
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockSearchContextsStore instance is invoked and the hook queue is
+// empty.
+func (f *SearchContextsStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Handle method of the parent MockSearchContextsStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SearchContextsStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *SearchContextsStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *SearchContextsStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+func (f *SearchContextsStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SearchContextsStoreHandleFunc) appendCall(r0 SearchContextsStoreHandleFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SearchContextsStoreHandleFuncCall objects
+// describing the invocations of this function.
+func (f *SearchContextsStoreHandleFunc) History() []SearchContextsStoreHandleFuncCall {
+	f.mutex.Lock()
+	history := make([]SearchContextsStoreHandleFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SearchContextsStoreHandleFuncCall is an object that describes an
+// invocation of method Handle on an instance of MockSearchContextsStore.
+type SearchContextsStoreHandleFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 basestore.TransactableHandle
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c SearchContextsStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SearchContextsStoreHandleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// SearchContextsStoreListSearchContextsFunc describes the behavior when the
+// ListSearchContexts method of the parent MockSearchContextsStore instance
+// is invoked.
+type SearchContextsStoreListSearchContextsFunc struct {
+	defaultHook func(context.Context, ListSearchContextsPageOptions, ListSearchContextsOptions) ([]*types.SearchContext, error)
+	hooks       []func(context.Context, ListSearchContextsPageOptions, ListSearchContextsOptions) ([]*types.SearchContext, error)
+	history     []SearchContextsStoreListSearchContextsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListSearchContexts delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockSearchContextsStore) ListSearchContexts(v0 context.Context, v1 ListSearchContextsPageOptions, v2 ListSearchContextsOptions) ([]*types.SearchContext, error) {
+	r0, r1 := m.ListSearchContextsFunc.nextHook()(v0, v1, v2)
+	m.ListSearchContextsFunc.appendCall(SearchContextsStoreListSearchContextsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListSearchContexts
 // method of the parent MockSearchContextsStore instance is invoked and the
 // hook queue is empty.
-func (f *SearchContextsStoreDeleteSearchContextFunc) SetDefaultHook(hook func(context.Context, int64) error) {
+func (f *SearchContextsStoreListSearchContextsFunc) SetDefaultHook(hook func(context.Context, ListSearchContextsPageOptions, ListSearchContextsOptions) ([]*types.SearchContext, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// DeleteSearchContext method of the parent MockSearchContextsStore instance
+// ListSearchContexts method of the parent MockSearchContextsStore instance
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *SearchContextsStoreDeleteSearchContextFunc) PushHook(hook func(context.Context, int64) error) {
+func (f *SearchContextsStoreListSearchContextsFunc) PushHook(hook func(context.Context, ListSearchContextsPageOptions, ListSearchContextsOptions) ([]*types.SearchContext, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -54026,20 +56100,20 @@ func (f *SearchContextsStoreDeleteSearchContextFunc) PushHook(hook func(context.
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreDeleteSearchContextFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int64) error {
-		return r0
+func (f *SearchContextsStoreListSearchContextsFunc) SetDefaultReturn(r0 []*types.SearchContext, r1 error) {
+	f.SetDefaultHook(func(context.Context, ListSearchContextsPageOptions, ListSearchContextsOptions) ([]*types.SearchContext, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreDeleteSearchContextFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int64) error {
-		return r0
+func (f *SearchContextsStoreListSearchContextsFunc) PushReturn(r0 []*types.SearchContext, r1 error) {
+	f.PushHook(func(context.Context, ListSearchContextsPageOptions, ListSearchContextsOptions) ([]*types.SearchContext, error) {
+		return r0, r1
 	})
 }
 
-func (f *SearchContextsStoreDeleteSearchContextFunc) nextHook() func(context.Context, int64) error {
+func (f *SearchContextsStoreListSearchContextsFunc) nextHook() func(context.Context, ListSearchContextsPageOptions, ListSearchContextsOptions) ([]*types.SearchContext, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -54052,82 +56126,89 @@ func (f *SearchContextsStoreDeleteSearchContextFunc) nextHook() func(context.Con
 	return hook
 }
 
-func (f *SearchContextsStoreDeleteSearchContextFunc) appendCall(r0 SearchContextsStoreDeleteSearchContextFuncCall) {
+func (f *SearchContextsStoreListSearchContextsFunc) appendCall(r0 SearchContextsStoreListSearchContextsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// SearchContextsStoreDeleteSearchContextFuncCall objects describing the
+// SearchContextsStoreListSearchContextsFuncCall objects describing the
 // invocations of this function.
-func (f *SearchContextsStoreDeleteSearchContextFunc) History() []SearchContextsStoreDeleteSearchContextFuncCall {
+func (f *SearchContextsStoreListSearchContextsFunc) History() []SearchContextsStoreListSearchContextsFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreDeleteSearchContextFuncCall, len(f.history))
+	history := make([]SearchContextsStoreListSearchContextsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreDeleteSearchContextFuncCall is an object that
-// describes an invocation of method DeleteSearchContext on an instance of
+// SearchContextsStoreListSearchContextsFuncCall is an object that describes
+// an invocation of method ListSearchContexts on an instance of
 // MockSearchContextsStore.
-type SearchContextsStoreDeleteSearchContextFuncCall struct {
+type SearchContextsStoreListSearchContextsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int64
+	Arg1 ListSearchContextsPageOptions
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 ListSearchContextsOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 []*types.SearchContext
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreDeleteSearchContextFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c SearchContextsStoreListSearchContextsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreDeleteSearchContextFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c SearchContextsStoreListSearchContextsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// SearchContextsStoreDeleteSearchContextStarForUserFunc describes the
-// behavior when the DeleteSearchContextStarForUser method of the parent
-// MockSearchContextsStore instance is invoked.
-type SearchContextsStoreDeleteSearchContextStarForUserFunc struct {
-	defaultHook func(context.Context, int32, int64) error
-	hooks       []func(context.Context, int32, int64) error
-	history     []SearchContextsStoreDeleteSearchContextStarForUserFuncCall
+// SearchContextsStoreSetSearchContextRepositoryRevisionsFunc describes the
+// behavior when the SetSearchContextRepositoryRevisions method of the
+// parent MockSearchContextsStore instance is invoked.
+type SearchContextsStoreSetSearchContextRepositoryRevisionsFunc struct {
+	defaultHook func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error
+	hooks       []func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error
+	history     []SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall
 	mutex       sync.Mutex
 }
 
-// DeleteSearchContextStarForUser delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockSearchContextsStore) DeleteSearchContextStarForUser(v0 context.Context, v1 int32, v2 int64) error {
-	r0 := m.DeleteSearchContextStarForUserFunc.nextHook()(v0, v1, v2)
-	m.DeleteSearchContextStarForUserFunc.appendCall(SearchContextsStoreDeleteSearchContextStarForUserFuncCall{v0, v1, v2, r0})
+// SetSearchContextRepositoryRevisions delegates to the next hook function
+// in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockSearchContextsStore) SetSearchContextRepositoryRevisions(v0 context.Context, v1 int64, v2 []*types.SearchContextRepositoryRevisions) error {
+	r0 := m.SetSearchContextRepositoryRevisionsFunc.nextHook()(v0, v1, v2)
+	m.SetSearchContextRepositoryRevisionsFunc.appendCall(SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall{v0, v1, v2, r0})
 	return r0
 }
 
 // SetDefaultHook sets function that is called when the
-// DeleteSearchContextStarForUser method of the parent
+// SetSearchContextRepositoryRevisions method of the parent
 // MockSearchContextsStore instance is invoked and the hook queue is empty.
-func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) SetDefaultHook(hook func(context.Context, int32, int64) error) {
+func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) SetDefaultHook(hook func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// DeleteSearchContextStarForUser method of the parent
+// SetSearchContextRepositoryRevisions method of the parent
 // MockSearchContextsStore instance invokes the hook at the front of the
 // queue and discards it. After the queue is empty, the default hook
 // function is invoked for any future action.
-func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) PushHook(hook func(context.Context, int32, int64) error) {
+func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) PushHook(hook func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -54135,20 +56216,20 @@ func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) PushHook(hook fu
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int32, int64) error {
+func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int32, int64) error {
+func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error {
 		return r0
 	})
 }
 
-func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) nextHook() func(context.Context, int32, int64) error {
+func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) nextHook() func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -54161,37 +56242,38 @@ func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) nextHook() func(
 	return hook
 }
 
-func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) appendCall(r0 SearchContextsStoreDeleteSearchContextStarForUserFuncCall) {
+func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) appendCall(r0 SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// SearchContextsStoreDeleteSearchContextStarForUserFuncCall objects
+// SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall objects
 // describing the invocations of this function.
-func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) History() []SearchContextsStoreDeleteSearchContextStarForUserFuncCall {
+func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) History() []SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreDeleteSearchContextStarForUserFuncCall, len(f.history))
+	history := make([]SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreDeleteSearchContextStarForUserFuncCall is an object
-// that describes an invocation of method DeleteSearchContextStarForUser on
-// an instance of MockSearchContextsStore.
-type SearchContextsStoreDeleteSearchContextStarForUserFuncCall struct {
+// SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall is an
+// object that describes an invocation of method
+// SetSearchContextRepositoryRevisions on an instance of
+// MockSearchContextsStore.
+type SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int32
+	Arg1 int64
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 int64
+	Arg2 []*types.SearchContextRepositoryRevisions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -54199,45 +56281,47 @@ type SearchContextsStoreDeleteSearchContextStarForUserFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreDeleteSearchContextStarForUserFuncCall) Args() []interface{} {
+func (c SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreDeleteSearchContextStarForUserFuncCall) Results() []interface{} {
+func (c SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// SearchContextsStoreDoneFunc describes the behavior when the Done method
-// of the parent MockSearchContextsStore instance is invoked.
-type SearchContextsStoreDoneFunc struct {
-	defaultHook func(error) error
-	hooks       []func(error) error
-	history     []SearchContextsStoreDoneFuncCall
+// SearchContextsStoreSetUserDefaultSearchContextIDFunc describes the
+// behavior when the SetUserDefaultSearchContextID method of the parent
+// MockSearchContextsStore instance is invoked.
+type SearchContextsStoreSetUserDefaultSearchContextIDFunc struct {
+	defaultHook func(context.Context, int32, int64) error
+	hooks       []func(context.Context, int32, int64) error
+	history     []SearchContextsStoreSetUserDefaultSearchContextIDFuncCall
 	mutex       sync.Mutex
 }
 
-// Done delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockSearchContextsStore) Done(v0 error) error {
-	r0 := m.DoneFunc.nextHook()(v0)
-	m.DoneFunc.appendCall(SearchContextsStoreDoneFuncCall{v0, r0})
+// SetUserDefaultSearchContextID delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockSearchContextsStore) SetUserDefaultSearchContextID(v0 context.Context, v1 int32, v2 int64) error {
+	r0 := m.SetUserDefaultSearchContextIDFunc.nextHook()(v0, v1, v2)
+	m.SetUserDefaultSearchContextIDFunc.appendCall(SearchContextsStoreSetUserDefaultSearchContextIDFuncCall{v0, v1, v2, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Done method of the
-// parent MockSearchContextsStore instance is invoked and the hook queue is
-// empty.
-func (f *SearchContextsStoreDoneFunc) SetDefaultHook(hook func(error) error) {
+// SetDefaultHook sets function that is called when the
+// SetUserDefaultSearchContextID method of the parent
+// MockSearchContextsStore instance is invoked and the hook queue is empty.
+func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) SetDefaultHook(hook func(context.Context, int32, int64) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Done method of the parent MockSearchContextsStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *SearchContextsStoreDoneFunc) PushHook(hook func(error) error) {
+// SetUserDefaultSearchContextID method of the parent
+// MockSearchContextsStore instance invokes the hook at the front of the
+// queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) PushHook(hook func(context.Context, int32, int64) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -54245,20 +56329,20 @@ func (f *SearchContextsStoreDoneFunc) PushHook(hook func(error) error) {
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreDoneFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(error) error {
+func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32, int64) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreDoneFunc) PushReturn(r0 error) {
-	f.PushHook(func(error) error {
+func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32, int64) error {
 		return r0
 	})
 }
 
-func (f *SearchContextsStoreDoneFunc) nextHook() func(error) error {
+func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) nextHook() func(context.Context, int32, int64) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -54271,29 +56355,37 @@ func (f *SearchContextsStoreDoneFunc) nextHook() func(error) error {
 	return hook
 }
 
-func (f *SearchContextsStoreDoneFunc) appendCall(r0 SearchContextsStoreDoneFuncCall) {
+func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) appendCall(r0 SearchContextsStoreSetUserDefaultSearchContextIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SearchContextsStoreDoneFuncCall objects
+// History returns a sequence of
+// SearchContextsStoreSetUserDefaultSearchContextIDFuncCall objects
 // describing the invocations of this function.
-func (f *SearchContextsStoreDoneFunc) History() []SearchContextsStoreDoneFuncCall {
+func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) History() []SearchContextsStoreSetUserDefaultSearchContextIDFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreDoneFuncCall, len(f.history))
+	history := make([]SearchContextsStoreSetUserDefaultSearchContextIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreDoneFuncCall is an object that describes an invocation
-// of method Done on an instance of MockSearchContextsStore.
-type SearchContextsStoreDoneFuncCall struct {
+// SearchContextsStoreSetUserDefaultSearchContextIDFuncCall is an object
+// that describes an invocation of method SetUserDefaultSearchContextID on
+// an instance of MockSearchContextsStore.
+type SearchContextsStoreSetUserDefaultSearchContextIDFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 error
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int32
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int64
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -54301,45 +56393,45 @@ type SearchContextsStoreDoneFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreDoneFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c SearchContextsStoreSetUserDefaultSearchContextIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreDoneFuncCall) Results() []interface{} {
+func (c SearchContextsStoreSetUserDefaultSearchContextIDFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// SearchContextsStoreExecFunc describes the behavior when the Exec method
-// of the parent MockSearchContextsStore instance is invoked.
-type SearchContextsStoreExecFunc struct {
-	defaultHook func(context.Context, *sqlf.Query) error
-	hooks       []func(context.Context, *sqlf.Query) error
-	history     []SearchContextsStoreExecFuncCall
+// SearchContextsStoreTransactFunc describes the behavior when the Transact
+// method of the parent MockSearchContextsStore instance is invoked.
+type SearchContextsStoreTransactFunc struct {
+	defaultHook func(context.Context) (SearchContextsStore, error)
+	hooks       []func(context.Context) (SearchContextsStore, error)
+	history     []SearchContextsStoreTransactFuncCall
 	mutex       sync.Mutex
 }
 
-// Exec delegates to the next hook function in the queue and stores the
+// Transact delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockSearchContextsStore) Exec(v0 context.Context, v1 *sqlf.Query) error {
-	r0 := m.ExecFunc.nextHook()(v0, v1)
-	m.ExecFunc.appendCall(SearchContextsStoreExecFuncCall{v0, v1, r0})
-	return r0
+func (m *MockSearchContextsStore) Transact(v0 context.Context) (SearchContextsStore, error) {
+	r0, r1 := m.TransactFunc.nextHook()(v0)
+	m.TransactFunc.appendCall(SearchContextsStoreTransactFuncCall{v0, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Exec method of the
-// parent MockSearchContextsStore instance is invoked and the hook queue is
-// empty.
-func (f *SearchContextsStoreExecFunc) SetDefaultHook(hook func(context.Context, *sqlf.Query) error) {
+// SetDefaultHook sets function that is called when the Transact method of
+// the parent MockSearchContextsStore instance is invoked and the hook queue
+// is empty.
+func (f *SearchContextsStoreTransactFunc) SetDefaultHook(hook func(context.Context) (SearchContextsStore, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Exec method of the parent MockSearchContextsStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *SearchContextsStoreExecFunc) PushHook(hook func(context.Context, *sqlf.Query) error) {
+// Transact method of the parent MockSearchContextsStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *SearchContextsStoreTransactFunc) PushHook(hook func(context.Context) (SearchContextsStore, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -54347,20 +56439,20 @@ func (f *SearchContextsStoreExecFunc) PushHook(hook func(context.Context, *sqlf.
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreExecFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, *sqlf.Query) error {
-		return r0
+func (f *SearchContextsStoreTransactFunc) SetDefaultReturn(r0 SearchContextsStore, r1 error) {
+	f.SetDefaultHook(func(context.Context) (SearchContextsStore, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreExecFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, *sqlf.Query) error {
-		return r0
+func (f *SearchContextsStoreTransactFunc) PushReturn(r0 SearchContextsStore, r1 error) {
+	f.PushHook(func(context.Context) (SearchContextsStore, error) {
+		return r0, r1
 	})
 }
 
-func (f *SearchContextsStoreExecFunc) nextHook() func(context.Context, *sqlf.Query) error {
+func (f *SearchContextsStoreTransactFunc) nextHook() func(context.Context) (SearchContextsStore, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -54373,80 +56465,82 @@ func (f *SearchContextsStoreExecFunc) nextHook() func(context.Context, *sqlf.Que
 	return hook
 }
 
-func (f *SearchContextsStoreExecFunc) appendCall(r0 SearchContextsStoreExecFuncCall) {
+func (f *SearchContextsStoreTransactFunc) appendCall(r0 SearchContextsStoreTransactFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SearchContextsStoreExecFuncCall objects
+// History returns a sequence of SearchContextsStoreTransactFuncCall objects
 // describing the invocations of this function.
-func (f *SearchContextsStoreExecFunc) History() []SearchContextsStoreExecFuncCall {
+func (f *SearchContextsStoreTransactFunc) History() []SearchContextsStoreTransactFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreExecFuncCall, len(f.history))
+	history := make([]SearchContextsStoreTransactFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreExecFuncCall is an object that describes an invocation
-// of method Exec on an instance of MockSearchContextsStore.
-type SearchContextsStoreExecFuncCall struct {
+// SearchContextsStoreTransactFuncCall is an object that describes an
+// invocation of method Transact on an instance of MockSearchContextsStore.
+type SearchContextsStoreTransactFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 *sqlf.Query
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 SearchContextsStore
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreExecFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c SearchContextsStoreTransactFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreExecFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c SearchContextsStoreTransactFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// SearchContextsStoreGetAllQueriesFunc describes the behavior when the
-// GetAllQueries method of the parent MockSearchContextsStore instance is
-// invoked.
-type SearchContextsStoreGetAllQueriesFunc struct {
-	defaultHook func(context.Context) ([]string, error)
-	hooks       []func(context.Context) ([]string, error)
-	history     []SearchContextsStoreGetAllQueriesFuncCall
+// SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc
+// describes the behavior when the
+// UpdateSearchContextWithRepositoryRevisions method of the parent
+// MockSearchContextsStore instance is invoked.
+type SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc struct {
+	defaultHook func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)
+	hooks       []func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)
+	history     []SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall
 	mutex       sync.Mutex
 }
 
-// GetAllQueries delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockSearchContextsStore) GetAllQueries(v0 context.Context) ([]string, error) {
-	r0, r1 := m.GetAllQueriesFunc.nextHook()(v0)
-	m.GetAllQueriesFunc.appendCall(SearchContextsStoreGetAllQueriesFuncCall{v0, r0, r1})
+// UpdateSearchContextWithRepositoryRevisions delegates to the next hook
+// function in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockSearchContextsStore) UpdateSearchContextWithRepositoryRevisions(v0 context.Context, v1 *types.SearchContext, v2 []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
+	r0, r1 := m.UpdateSearchContextWithRepositoryRevisionsFunc.nextHook()(v0, v1, v2)
+	m.UpdateSearchContextWithRepositoryRevisionsFunc.appendCall(SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetAllQueries method
-// of the parent MockSearchContextsStore instance is invoked and the hook
-// queue is empty.
-func (f *SearchContextsStoreGetAllQueriesFunc) SetDefaultHook(hook func(context.Context) ([]string, error)) {
+// SetDefaultHook sets function that is called when the
+// UpdateSearchContextWithRepositoryRevisions method of the parent
+// MockSearchContextsStore instance is invoked and the hook queue is empty.
+func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) SetDefaultHook(hook func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetAllQueries method of the parent MockSearchContextsStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *SearchContextsStoreGetAllQueriesFunc) PushHook(hook func(context.Context) ([]string, error)) {
+// UpdateSearchContextWithRepositoryRevisions method of the parent
+// MockSearchContextsStore instance invokes the hook at the front of the
+// queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) PushHook(hook func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -54454,20 +56548,20 @@ func (f *SearchContextsStoreGetAllQueriesFunc) PushHook(hook func(context.Contex
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreGetAllQueriesFunc) SetDefaultReturn(r0 []string, r1 error) {
-	f.SetDefaultHook(func(context.Context) ([]string, error) {
+func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) SetDefaultReturn(r0 *types.SearchContext, r1 error) {
+	f.SetDefaultHook(func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreGetAllQueriesFunc) PushReturn(r0 []string, r1 error) {
-	f.PushHook(func(context.Context) ([]string, error) {
+func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) PushReturn(r0 *types.SearchContext, r1 error) {
+	f.PushHook(func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
 		return r0, r1
 	})
 }
 
-func (f *SearchContextsStoreGetAllQueriesFunc) nextHook() func(context.Context) ([]string, error) {
+func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) nextHook() func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -54480,33 +56574,41 @@ func (f *SearchContextsStoreGetAllQueriesFunc) nextHook() func(context.Context)
 	return hook
 }
 
-func (f *SearchContextsStoreGetAllQueriesFunc) appendCall(r0 SearchContextsStoreGetAllQueriesFuncCall) {
+func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) appendCall(r0 SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SearchContextsStoreGetAllQueriesFuncCall
+// History returns a sequence of
+// SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall
 // objects describing the invocations of this function.
-func (f *SearchContextsStoreGetAllQueriesFunc) History() []SearchContextsStoreGetAllQueriesFuncCall {
+func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) History() []SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreGetAllQueriesFuncCall, len(f.history))
+	history := make([]SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreGetAllQueriesFuncCall is an object that describes an
-// invocation of method GetAllQueries on an instance of
+// SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall is
+// an object that describes an invocation of method
+// UpdateSearchContextWithRepositoryRevisions on an instance of
 // MockSearchContextsStore.
-type SearchContextsStoreGetAllQueriesFuncCall struct {
+type SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *types.SearchContext
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []*types.SearchContextRepositoryRevisions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []string
+	Result0 *types.SearchContext
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -54514,47 +56616,236 @@ type SearchContextsStoreGetAllQueriesFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreGetAllQueriesFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreGetAllQueriesFuncCall) Results() []interface{} {
+func (c SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SearchContextsStoreGetAllRevisionsForReposFunc describes the behavior
-// when the GetAllRevisionsForRepos method of the parent
-// MockSearchContextsStore instance is invoked.
-type SearchContextsStoreGetAllRevisionsForReposFunc struct {
-	defaultHook func(context.Context, []api.RepoID) (map[api.RepoID][]string, error)
-	hooks       []func(context.Context, []api.RepoID) (map[api.RepoID][]string, error)
-	history     []SearchContextsStoreGetAllRevisionsForReposFuncCall
+// MockSearchHistoryStore is a mock implementation of the SearchHistoryStore
+// interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/database) used for unit
+// testing.
+type MockSearchHistoryStore struct {
+	// CountFunc is an instance of a mock function object controlling the
+	// behavior of the method Count.
+	CountFunc *SearchHistoryStoreCountFunc
+	// DeleteFunc is an instance of a mock function object controlling the
+	// behavior of the method Delete.
+	DeleteFunc *SearchHistoryStoreDeleteFunc
+	// DeleteAllForUserFunc is an instance of a mock function object
+	// controlling the behavior of the method DeleteAllForUser.
+	DeleteAllForUserFunc *SearchHistoryStoreDeleteAllForUserFunc
+	// DeleteExpiredFunc is an instance of a mock function object
+	// controlling the behavior of the method DeleteExpired.
+	DeleteExpiredFunc *SearchHistoryStoreDeleteExpiredFunc
+	// GetByIDFunc is an instance of a mock function object controlling the
+	// behavior of the method GetByID.
+	GetByIDFunc *SearchHistoryStoreGetByIDFunc
+	// HandleFunc is an instance of a mock function object controlling the
+	// behavior of the method Handle.
+	HandleFunc *SearchHistoryStoreHandleFunc
+	// ListFunc is an instance of a mock function object controlling the
+	// behavior of the method List.
+	ListFunc *SearchHistoryStoreListFunc
+	// RecordFunc is an instance of a mock function object controlling the
+	// behavior of the method Record.
+	RecordFunc *SearchHistoryStoreRecordFunc
+	// SetPinnedFunc is an instance of a mock function object controlling
+	// the behavior of the method SetPinned.
+	SetPinnedFunc *SearchHistoryStoreSetPinnedFunc
+	// WithFunc is an instance of a mock function object controlling the
+	// behavior of the method With.
+	WithFunc *SearchHistoryStoreWithFunc
+}
+
+// NewMockSearchHistoryStore creates a new mock of the SearchHistoryStore
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockSearchHistoryStore() *MockSearchHistoryStore {
+	return &MockSearchHistoryStore{
+		CountFunc: &SearchHistoryStoreCountFunc{
+			defaultHook: func(context.Context, SearchHistoryListOptions) (r0 int, r1 error) {
+				return
+			},
+		},
+		DeleteFunc: &SearchHistoryStoreDeleteFunc{
+			defaultHook: func(context.Context, int32) (r0 error) {
+				return
+			},
+		},
+		DeleteAllForUserFunc: &SearchHistoryStoreDeleteAllForUserFunc{
+			defaultHook: func(context.Context, int32) (r0 error) {
+				return
+			},
+		},
+		DeleteExpiredFunc: &SearchHistoryStoreDeleteExpiredFunc{
+			defaultHook: func(context.Context, time.Time, int) (r0 int, r1 error) {
+				return
+			},
+		},
+		GetByIDFunc: &SearchHistoryStoreGetByIDFunc{
+			defaultHook: func(context.Context, int32) (r0 *SearchHistoryEntry, r1 error) {
+				return
+			},
+		},
+		HandleFunc: &SearchHistoryStoreHandleFunc{
+			defaultHook: func() (r0 basestore.TransactableHandle) {
+				return
+			},
+		},
+		ListFunc: &SearchHistoryStoreListFunc{
+			defaultHook: func(context.Context, SearchHistoryListOptions, *PaginationArgs) (r0 []*SearchHistoryEntry, r1 error) {
+				return
+			},
+		},
+		RecordFunc: &SearchHistoryStoreRecordFunc{
+			defaultHook: func(context.Context, SearchHistoryRun) (r0 *SearchHistoryEntry, r1 error) {
+				return
+			},
+		},
+		SetPinnedFunc: &SearchHistoryStoreSetPinnedFunc{
+			defaultHook: func(context.Context, int32, bool) (r0 *SearchHistoryEntry, r1 error) {
+				return
+			},
+		},
+		WithFunc: &SearchHistoryStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) (r0 SearchHistoryStore) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockSearchHistoryStore creates a new mock of the
+// SearchHistoryStore interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockSearchHistoryStore() *MockSearchHistoryStore {
+	return &MockSearchHistoryStore{
+		CountFunc: &SearchHistoryStoreCountFunc{
+			defaultHook: func(context.Context, SearchHistoryListOptions) (int, error) {
+				panic("unexpected invocation of MockSearchHistoryStore.Count")
+			},
+		},
+		DeleteFunc: &SearchHistoryStoreDeleteFunc{
+			defaultHook: func(context.Context, int32) error {
+				panic("unexpected invocation of MockSearchHistoryStore.Delete")
+			},
+		},
+		DeleteAllForUserFunc: &SearchHistoryStoreDeleteAllForUserFunc{
+			defaultHook: func(context.Context, int32) error {
+				panic("unexpected invocation of MockSearchHistoryStore.DeleteAllForUser")
+			},
+		},
+		DeleteExpiredFunc: &SearchHistoryStoreDeleteExpiredFunc{
+			defaultHook: func(context.Context, time.Time, int) (int, error) {
+				panic("unexpected invocation of MockSearchHistoryStore.DeleteExpired")
+			},
+		},
+		GetByIDFunc: &SearchHistoryStoreGetByIDFunc{
+			defaultHook: func(context.Context, int32) (*SearchHistoryEntry, error) {
+				panic("unexpected invocation of MockSearchHistoryStore.GetByID")
+			},
+		},
+		HandleFunc: &SearchHistoryStoreHandleFunc{
+			defaultHook: func() basestore.TransactableHandle {
+				panic("unexpected invocation of MockSearchHistoryStore.Handle")
+			},
+		},
+		ListFunc: &SearchHistoryStoreListFunc{
+			defaultHook: func(context.Context, SearchHistoryListOptions, *PaginationArgs) ([]*SearchHistoryEntry, error) {
+				panic("unexpected invocation of MockSearchHistoryStore.List")
+			},
+		},
+		RecordFunc: &SearchHistoryStoreRecordFunc{
+			defaultHook: func(context.Context, SearchHistoryRun) (*SearchHistoryEntry, error) {
+				panic("unexpected invocation of MockSearchHistoryStore.Record")
+			},
+		},
+		SetPinnedFunc: &SearchHistoryStoreSetPinnedFunc{
+			defaultHook: func(context.Context, int32, bool) (*SearchHistoryEntry, error) {
+				panic("unexpected invocation of MockSearchHistoryStore.SetPinned")
+			},
+		},
+		WithFunc: &SearchHistoryStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) SearchHistoryStore {
+				panic("unexpected invocation of MockSearchHistoryStore.With")
+			},
+		},
+	}
+}
+
+// NewMockSearchHistoryStoreFrom creates a new mock of the
+// MockSearchHistoryStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockSearchHistoryStoreFrom(i SearchHistoryStore) *MockSearchHistoryStore {
+	return &MockSearchHistoryStore{
+		CountFunc: &SearchHistoryStoreCountFunc{
+			defaultHook: i.Count,
+		},
+		DeleteFunc: &SearchHistoryStoreDeleteFunc{
+			defaultHook: i.Delete,
+		},
+		DeleteAllForUserFunc: &SearchHistoryStoreDeleteAllForUserFunc{
+			defaultHook: i.DeleteAllForUser,
+		},
+		DeleteExpiredFunc: &SearchHistoryStoreDeleteExpiredFunc{
+			defaultHook: i.DeleteExpired,
+		},
+		GetByIDFunc: &SearchHistoryStoreGetByIDFunc{
+			defaultHook: i.GetByID,
+		},
+		HandleFunc: &SearchHistoryStoreHandleFunc{
+			defaultHook: i.Handle,
+		},
+		ListFunc: &SearchHistoryStoreListFunc{
+			defaultHook: i.List,
+		},
+		RecordFunc: &SearchHistoryStoreRecordFunc{
+			defaultHook: i.Record,
+		},
+		SetPinnedFunc: &SearchHistoryStoreSetPinnedFunc{
+			defaultHook: i.SetPinned,
+		},
+		WithFunc: &SearchHistoryStoreWithFunc{
+			defaultHook: i.With,
+		},
+	}
+}
+
+// SearchHistoryStoreCountFunc describes the behavior when the Count method
+// of the parent MockSearchHistoryStore instance is invoked.
+type SearchHistoryStoreCountFunc struct {
+	defaultHook func(context.Context, SearchHistoryListOptions) (int, error)
+	hooks       []func(context.Context, SearchHistoryListOptions) (int, error)
+	history     []SearchHistoryStoreCountFuncCall
 	mutex       sync.Mutex
 }
 
-// GetAllRevisionsForRepos delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockSearchContextsStore) GetAllRevisionsForRepos(v0 context.Context, v1 []api.RepoID) (map[api.RepoID][]string, error) {
-	r0, r1 := m.GetAllRevisionsForReposFunc.nextHook()(v0, v1)
-	m.GetAllRevisionsForReposFunc.appendCall(SearchContextsStoreGetAllRevisionsForReposFuncCall{v0, v1, r0, r1})
+// Count delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSearchHistoryStore) Count(v0 context.Context, v1 SearchHistoryListOptions) (int, error) {
+	r0, r1 := m.CountFunc.nextHook()(v0, v1)
+	m.CountFunc.appendCall(SearchHistoryStoreCountFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// GetAllRevisionsForRepos method of the parent MockSearchContextsStore
-// instance is invoked and the hook queue is empty.
-func (f *SearchContextsStoreGetAllRevisionsForReposFunc) SetDefaultHook(hook func(context.Context, []api.RepoID) (map[api.RepoID][]string, error)) {
+// SetDefaultHook sets function that is called when the Count method of the
+// parent MockSearchHistoryStore instance is invoked and the hook queue is
+// empty.
+func (f *SearchHistoryStoreCountFunc) SetDefaultHook(hook func(context.Context, SearchHistoryListOptions) (int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetAllRevisionsForRepos method of the parent MockSearchContextsStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *SearchContextsStoreGetAllRevisionsForReposFunc) PushHook(hook func(context.Context, []api.RepoID) (map[api.RepoID][]string, error)) {
+// Count method of the parent MockSearchHistoryStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SearchHistoryStoreCountFunc) PushHook(hook func(context.Context, SearchHistoryListOptions) (int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -54562,20 +56853,20 @@ func (f *SearchContextsStoreGetAllRevisionsForReposFunc) PushHook(hook func(cont
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreGetAllRevisionsForReposFunc) SetDefaultReturn(r0 map[api.RepoID][]string, r1 error) {
-	f.SetDefaultHook(func(context.Context, []api.RepoID) (map[api.RepoID][]string, error) {
+func (f *SearchHistoryStoreCountFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, SearchHistoryListOptions) (int, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreGetAllRevisionsForReposFunc) PushReturn(r0 map[api.RepoID][]string, r1 error) {
-	f.PushHook(func(context.Context, []api.RepoID) (map[api.RepoID][]string, error) {
+func (f *SearchHistoryStoreCountFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, SearchHistoryListOptions) (int, error) {
 		return r0, r1
 	})
 }
 
-func (f *SearchContextsStoreGetAllRevisionsForReposFunc) nextHook() func(context.Context, []api.RepoID) (map[api.RepoID][]string, error) {
+func (f *SearchHistoryStoreCountFunc) nextHook() func(context.Context, SearchHistoryListOptions) (int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -54588,37 +56879,35 @@ func (f *SearchContextsStoreGetAllRevisionsForReposFunc) nextHook() func(context
 	return hook
 }
 
-func (f *SearchContextsStoreGetAllRevisionsForReposFunc) appendCall(r0 SearchContextsStoreGetAllRevisionsForReposFuncCall) {
+func (f *SearchHistoryStoreCountFunc) appendCall(r0 SearchHistoryStoreCountFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// SearchContextsStoreGetAllRevisionsForReposFuncCall objects describing the
-// invocations of this function.
-func (f *SearchContextsStoreGetAllRevisionsForReposFunc) History() []SearchContextsStoreGetAllRevisionsForReposFuncCall {
+// History returns a sequence of SearchHistoryStoreCountFuncCall objects
+// describing the invocations of this function.
+func (f *SearchHistoryStoreCountFunc) History() []SearchHistoryStoreCountFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreGetAllRevisionsForReposFuncCall, len(f.history))
+	history := make([]SearchHistoryStoreCountFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreGetAllRevisionsForReposFuncCall is an object that
-// describes an invocation of method GetAllRevisionsForRepos on an instance
-// of MockSearchContextsStore.
-type SearchContextsStoreGetAllRevisionsForReposFuncCall struct {
+// SearchHistoryStoreCountFuncCall is an object that describes an invocation
+// of method Count on an instance of MockSearchHistoryStore.
+type SearchHistoryStoreCountFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 []api.RepoID
+	Arg1 SearchHistoryListOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 map[api.RepoID][]string
+	Result0 int
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -54626,48 +56915,45 @@ type SearchContextsStoreGetAllRevisionsForReposFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreGetAllRevisionsForReposFuncCall) Args() []interface{} {
+func (c SearchHistoryStoreCountFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreGetAllRevisionsForReposFuncCall) Results() []interface{} {
+func (c SearchHistoryStoreCountFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc describes
-// the behavior when the GetDefaultSearchContextForCurrentUser method of the
-// parent MockSearchContextsStore instance is invoked.
-type SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc struct {
-	defaultHook func(context.Context) (*types.SearchContext, error)
-	hooks       []func(context.Context) (*types.SearchContext, error)
-	history     []SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall
+// SearchHistoryStoreDeleteFunc describes the behavior when the Delete
+// method of the parent MockSearchHistoryStore instance is invoked.
+type SearchHistoryStoreDeleteFunc struct {
+	defaultHook func(context.Context, int32) error
+	hooks       []func(context.Context, int32) error
+	history     []SearchHistoryStoreDeleteFuncCall
 	mutex       sync.Mutex
 }
 
-// GetDefaultSearchContextForCurrentUser delegates to the next hook function
-// in the queue and stores the parameter and result values of this
-// invocation.
-func (m *MockSearchContextsStore) GetDefaultSearchContextForCurrentUser(v0 context.Context) (*types.SearchContext, error) {
-	r0, r1 := m.GetDefaultSearchContextForCurrentUserFunc.nextHook()(v0)
-	m.GetDefaultSearchContextForCurrentUserFunc.appendCall(SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall{v0, r0, r1})
-	return r0, r1
+// Delete delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSearchHistoryStore) Delete(v0 context.Context, v1 int32) error {
+	r0 := m.DeleteFunc.nextHook()(v0, v1)
+	m.DeleteFunc.appendCall(SearchHistoryStoreDeleteFuncCall{v0, v1, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the
-// GetDefaultSearchContextForCurrentUser method of the parent
-// MockSearchContextsStore instance is invoked and the hook queue is empty.
-func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) SetDefaultHook(hook func(context.Context) (*types.SearchContext, error)) {
+// SetDefaultHook sets function that is called when the Delete method of the
+// parent MockSearchHistoryStore instance is invoked and the hook queue is
+// empty.
+func (f *SearchHistoryStoreDeleteFunc) SetDefaultHook(hook func(context.Context, int32) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetDefaultSearchContextForCurrentUser method of the parent
-// MockSearchContextsStore instance invokes the hook at the front of the
-// queue and discards it. After the queue is empty, the default hook
-// function is invoked for any future action.
-func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) PushHook(hook func(context.Context) (*types.SearchContext, error)) {
+// Delete method of the parent MockSearchHistoryStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SearchHistoryStoreDeleteFunc) PushHook(hook func(context.Context, int32) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -54675,20 +56961,20 @@ func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) PushHook(
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) SetDefaultReturn(r0 *types.SearchContext, r1 error) {
-	f.SetDefaultHook(func(context.Context) (*types.SearchContext, error) {
-		return r0, r1
+func (f *SearchHistoryStoreDeleteFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) PushReturn(r0 *types.SearchContext, r1 error) {
-	f.PushHook(func(context.Context) (*types.SearchContext, error) {
-		return r0, r1
+func (f *SearchHistoryStoreDeleteFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32) error {
+		return r0
 	})
 }
 
-func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) nextHook() func(context.Context) (*types.SearchContext, error) {
+func (f *SearchHistoryStoreDeleteFunc) nextHook() func(context.Context, int32) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -54701,83 +56987,80 @@ func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) nextHook(
 	return hook
 }
 
-func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) appendCall(r0 SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall) {
+func (f *SearchHistoryStoreDeleteFunc) appendCall(r0 SearchHistoryStoreDeleteFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall objects
+// History returns a sequence of SearchHistoryStoreDeleteFuncCall objects
 // describing the invocations of this function.
-func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) History() []SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall {
+func (f *SearchHistoryStoreDeleteFunc) History() []SearchHistoryStoreDeleteFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall, len(f.history))
+	history := make([]SearchHistoryStoreDeleteFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall is an
-// object that describes an invocation of method
-// GetDefaultSearchContextForCurrentUser on an instance of
-// MockSearchContextsStore.
-type SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall struct {
+// SearchHistoryStoreDeleteFuncCall is an object that describes an
+// invocation of method Delete on an instance of MockSearchHistoryStore.
+type SearchHistoryStoreDeleteFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Result0 is the value of the 1st result returned from this method
+	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Result0 *types.SearchContext
-	// Result1 is the value of the 2nd result returned from this method
+	Arg1 int32
+	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c SearchHistoryStoreDeleteFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c SearchHistoryStoreDeleteFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// SearchContextsStoreGetSearchContextFunc describes the behavior when the
-// GetSearchContext method of the parent MockSearchContextsStore instance is
+// SearchHistoryStoreDeleteAllForUserFunc describes the behavior when the
+// DeleteAllForUser method of the parent MockSearchHistoryStore instance is
 // invoked.
-type SearchContextsStoreGetSearchContextFunc struct {
-	defaultHook func(context.Context, GetSearchContextOptions) (*types.SearchContext, error)
-	hooks       []func(context.Context, GetSearchContextOptions) (*types.SearchContext, error)
-	history     []SearchContextsStoreGetSearchContextFuncCall
+type SearchHistoryStoreDeleteAllForUserFunc struct {
+	defaultHook func(context.Context, int32) error
+	hooks       []func(context.Context, int32) error
+	history     []SearchHistoryStoreDeleteAllForUserFuncCall
 	mutex       sync.Mutex
 }
 
-// GetSearchContext delegates to the next hook function in the queue and
+// DeleteAllForUser delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockSearchContextsStore) GetSearchContext(v0 context.Context, v1 GetSearchContextOptions) (*types.SearchContext, error) {
-	r0, r1 := m.GetSearchContextFunc.nextHook()(v0, v1)
-	m.GetSearchContextFunc.appendCall(SearchContextsStoreGetSearchContextFuncCall{v0, v1, r0, r1})
-	return r0, r1
+func (m *MockSearchHistoryStore) DeleteAllForUser(v0 context.Context, v1 int32) error {
+	r0 := m.DeleteAllForUserFunc.nextHook()(v0, v1)
+	m.DeleteAllForUserFunc.appendCall(SearchHistoryStoreDeleteAllForUserFuncCall{v0, v1, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the GetSearchContext
-// method of the parent MockSearchContextsStore instance is invoked and the
+// SetDefaultHook sets function that is called when the DeleteAllForUser
+// method of the parent MockSearchHistoryStore instance is invoked and the
 // hook queue is empty.
-func (f *SearchContextsStoreGetSearchContextFunc) SetDefaultHook(hook func(context.Context, GetSearchContextOptions) (*types.SearchContext, error)) {
+func (f *SearchHistoryStoreDeleteAllForUserFunc) SetDefaultHook(hook func(context.Context, int32) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetSearchContext method of the parent MockSearchContextsStore instance
+// DeleteAllForUser method of the parent MockSearchHistoryStore instance
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *SearchContextsStoreGetSearchContextFunc) PushHook(hook func(context.Context, GetSearchContextOptions) (*types.SearchContext, error)) {
+func (f *SearchHistoryStoreDeleteAllForUserFunc) PushHook(hook func(context.Context, int32) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -54785,20 +57068,20 @@ func (f *SearchContextsStoreGetSearchContextFunc) PushHook(hook func(context.Con
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreGetSearchContextFunc) SetDefaultReturn(r0 *types.SearchContext, r1 error) {
-	f.SetDefaultHook(func(context.Context, GetSearchContextOptions) (*types.SearchContext, error) {
-		return r0, r1
+func (f *SearchHistoryStoreDeleteAllForUserFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreGetSearchContextFunc) PushReturn(r0 *types.SearchContext, r1 error) {
-	f.PushHook(func(context.Context, GetSearchContextOptions) (*types.SearchContext, error) {
-		return r0, r1
+func (f *SearchHistoryStoreDeleteAllForUserFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32) error {
+		return r0
 	})
 }
 
-func (f *SearchContextsStoreGetSearchContextFunc) nextHook() func(context.Context, GetSearchContextOptions) (*types.SearchContext, error) {
+func (f *SearchHistoryStoreDeleteAllForUserFunc) nextHook() func(context.Context, int32) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -54811,85 +57094,81 @@ func (f *SearchContextsStoreGetSearchContextFunc) nextHook() func(context.Contex
 	return hook
 }
 
-func (f *SearchContextsStoreGetSearchContextFunc) appendCall(r0 SearchContextsStoreGetSearchContextFuncCall) {
+func (f *SearchHistoryStoreDeleteAllForUserFunc) appendCall(r0 SearchHistoryStoreDeleteAllForUserFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SearchContextsStoreGetSearchContextFuncCall
+// History returns a sequence of SearchHistoryStoreDeleteAllForUserFuncCall
 // objects describing the invocations of this function.
-func (f *SearchContextsStoreGetSearchContextFunc) History() []SearchContextsStoreGetSearchContextFuncCall {
+func (f *SearchHistoryStoreDeleteAllForUserFunc) History() []SearchHistoryStoreDeleteAllForUserFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreGetSearchContextFuncCall, len(f.history))
+	history := make([]SearchHistoryStoreDeleteAllForUserFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreGetSearchContextFuncCall is an object that describes
-// an invocation of method GetSearchContext on an instance of
-// MockSearchContextsStore.
-type SearchContextsStoreGetSearchContextFuncCall struct {
+// SearchHistoryStoreDeleteAllForUserFuncCall is an object that describes an
+// invocation of method DeleteAllForUser on an instance of
+// MockSearchHistoryStore.
+type SearchHistoryStoreDeleteAllForUserFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 GetSearchContextOptions
+	Arg1 int32
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.SearchContext
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreGetSearchContextFuncCall) Args() []interface{} {
+func (c SearchHistoryStoreDeleteAllForUserFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreGetSearchContextFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c SearchHistoryStoreDeleteAllForUserFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// SearchContextsStoreGetSearchContextRepositoryRevisionsFunc describes the
-// behavior when the GetSearchContextRepositoryRevisions method of the
-// parent MockSearchContextsStore instance is invoked.
-type SearchContextsStoreGetSearchContextRepositoryRevisionsFunc struct {
-	defaultHook func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error)
-	hooks       []func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error)
-	history     []SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall
+// SearchHistoryStoreDeleteExpiredFunc describes the behavior when the
+// DeleteExpired method of the parent MockSearchHistoryStore instance is
+// invoked.
+type SearchHistoryStoreDeleteExpiredFunc struct {
+	defaultHook func(context.Context, time.Time, int) (int, error)
+	hooks       []func(context.Context, time.Time, int) (int, error)
+	history     []SearchHistoryStoreDeleteExpiredFuncCall
 	mutex       sync.Mutex
 }
 
-// GetSearchContextRepositoryRevisions delegates to the next hook function
-// in the queue and stores the parameter and result values of this
-// invocation.
-func (m *MockSearchContextsStore) GetSearchContextRepositoryRevisions(v0 context.Context, v1 int64) ([]*types.SearchContextRepositoryRevisions, error) {
-	r0, r1 := m.GetSearchContextRepositoryRevisionsFunc.nextHook()(v0, v1)
-	m.GetSearchContextRepositoryRevisionsFunc.appendCall(SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall{v0, v1, r0, r1})
+// DeleteExpired delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockSearchHistoryStore) DeleteExpired(v0 context.Context, v1 time.Time, v2 int) (int, error) {
+	r0, r1 := m.DeleteExpiredFunc.nextHook()(v0, v1, v2)
+	m.DeleteExpiredFunc.appendCall(SearchHistoryStoreDeleteExpiredFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// GetSearchContextRepositoryRevisions method of the parent
-// MockSearchContextsStore instance is invoked and the hook queue is empty.
-func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) SetDefaultHook(hook func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error)) {
+// SetDefaultHook sets function that is called when the DeleteExpired method
+// of the parent MockSearchHistoryStore instance is invoked and the hook
+// queue is empty.
+func (f *SearchHistoryStoreDeleteExpiredFunc) SetDefaultHook(hook func(context.Context, time.Time, int) (int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetSearchContextRepositoryRevisions method of the parent
-// MockSearchContextsStore instance invokes the hook at the front of the
-// queue and discards it. After the queue is empty, the default hook
-// function is invoked for any future action.
-func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) PushHook(hook func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error)) {
+// DeleteExpired method of the parent MockSearchHistoryStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SearchHistoryStoreDeleteExpiredFunc) PushHook(hook func(context.Context, time.Time, int) (int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -54897,20 +57176,20 @@ func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) PushHook(ho
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) SetDefaultReturn(r0 []*types.SearchContextRepositoryRevisions, r1 error) {
-	f.SetDefaultHook(func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error) {
+func (f *SearchHistoryStoreDeleteExpiredFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, time.Time, int) (int, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) PushReturn(r0 []*types.SearchContextRepositoryRevisions, r1 error) {
-	f.PushHook(func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error) {
+func (f *SearchHistoryStoreDeleteExpiredFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, time.Time, int) (int, error) {
 		return r0, r1
 	})
 }
 
-func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) nextHook() func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error) {
+func (f *SearchHistoryStoreDeleteExpiredFunc) nextHook() func(context.Context, time.Time, int) (int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -54923,38 +57202,39 @@ func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) nextHook()
 	return hook
 }
 
-func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) appendCall(r0 SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall) {
+func (f *SearchHistoryStoreDeleteExpiredFunc) appendCall(r0 SearchHistoryStoreDeleteExpiredFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall objects
-// describing the invocations of this function.
-func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) History() []SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall {
+// History returns a sequence of SearchHistoryStoreDeleteExpiredFuncCall
+// objects describing the invocations of this function.
+func (f *SearchHistoryStoreDeleteExpiredFunc) History() []SearchHistoryStoreDeleteExpiredFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall, len(f.history))
+	history := make([]SearchHistoryStoreDeleteExpiredFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall is an
-// object that describes an invocation of method
-// GetSearchContextRepositoryRevisions on an instance of
-// MockSearchContextsStore.
-type SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall struct {
+// SearchHistoryStoreDeleteExpiredFuncCall is an object that describes an
+// invocation of method DeleteExpired on an instance of
+// MockSearchHistoryStore.
+type SearchHistoryStoreDeleteExpiredFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int64
+	Arg1 time.Time
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*types.SearchContextRepositoryRevisions
+	Result0 int
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -54962,45 +57242,45 @@ type SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c SearchHistoryStoreDeleteExpiredFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall) Results() []interface{} {
+func (c SearchHistoryStoreDeleteExpiredFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SearchContextsStoreHandleFunc describes the behavior when the Handle
-// method of the parent MockSearchContextsStore instance is invoked.
-type SearchContextsStoreHandleFunc struct {
-	defaultHook func() basestore.TransactableHandle
-	hooks       []func() basestore.TransactableHandle
-	history     []SearchContextsStoreHandleFuncCall
+// SearchHistoryStoreGetByIDFunc describes the behavior when the GetByID
+// method of the parent MockSearchHistoryStore instance is invoked.
+type SearchHistoryStoreGetByIDFunc struct {
+	defaultHook func(context.Context, int32) (*SearchHistoryEntry, error)
+	hooks       []func(context.Context, int32) (*SearchHistoryEntry, error)
+	history     []SearchHistoryStoreGetByIDFuncCall
 	mutex       sync.Mutex
 }
 
-// Handle delegates to the next hook function in the queue and stores the
+// GetByID delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockSearchContextsStore) Handle() basestore.TransactableHandle {
-	r0 := m.HandleFunc.nextHook()()
-	m.HandleFunc.appendCall(SearchContextsStoreHandleFuncCall{r0})
-	return r0
+func (m *MockSearchHistoryStore) GetByID(v0 context.Context, v1 int32) (*SearchHistoryEntry, error) {
+	r0, r1 := m.GetByIDFunc.nextHook()(v0, v1)
+	m.GetByIDFunc.appendCall(SearchHistoryStoreGetByIDFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Handle method of the
-// parent MockSearchContextsStore instance is invoked and the hook queue is
-// empty.
-func (f *SearchContextsStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+// SetDefaultHook sets function that is called when the GetByID method of
+// the parent MockSearchHistoryStore instance is invoked and the hook queue
+// is empty.
+func (f *SearchHistoryStoreGetByIDFunc) SetDefaultHook(hook func(context.Context, int32) (*SearchHistoryEntry, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Handle method of the parent MockSearchContextsStore instance invokes the
+// GetByID method of the parent MockSearchHistoryStore instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *SearchContextsStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+func (f *SearchHistoryStoreGetByIDFunc) PushHook(hook func(context.Context, int32) (*SearchHistoryEntry, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -55008,20 +57288,20 @@ func (f *SearchContextsStoreHandleFunc) PushHook(hook func() basestore.Transacta
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
-	f.SetDefaultHook(func() basestore.TransactableHandle {
-		return r0
+func (f *SearchHistoryStoreGetByIDFunc) SetDefaultReturn(r0 *SearchHistoryEntry, r1 error) {
+	f.SetDefaultHook(func(context.Context, int32) (*SearchHistoryEntry, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
-	f.PushHook(func() basestore.TransactableHandle {
-		return r0
+func (f *SearchHistoryStoreGetByIDFunc) PushReturn(r0 *SearchHistoryEntry, r1 error) {
+	f.PushHook(func(context.Context, int32) (*SearchHistoryEntry, error) {
+		return r0, r1
 	})
 }
 
-func (f *SearchContextsStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+func (f *SearchHistoryStoreGetByIDFunc) nextHook() func(context.Context, int32) (*SearchHistoryEntry, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -55034,74 +57314,81 @@ func (f *SearchContextsStoreHandleFunc) nextHook() func() basestore.Transactable
 	return hook
 }
 
-func (f *SearchContextsStoreHandleFunc) appendCall(r0 SearchContextsStoreHandleFuncCall) {
+func (f *SearchHistoryStoreGetByIDFunc) appendCall(r0 SearchHistoryStoreGetByIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SearchContextsStoreHandleFuncCall objects
+// History returns a sequence of SearchHistoryStoreGetByIDFuncCall objects
 // describing the invocations of this function.
-func (f *SearchContextsStoreHandleFunc) History() []SearchContextsStoreHandleFuncCall {
+func (f *SearchHistoryStoreGetByIDFunc) History() []SearchHistoryStoreGetByIDFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreHandleFuncCall, len(f.history))
+	history := make([]SearchHistoryStoreGetByIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreHandleFuncCall is an object that describes an
-// invocation of method Handle on an instance of MockSearchContextsStore.
-type SearchContextsStoreHandleFuncCall struct {
+// SearchHistoryStoreGetByIDFuncCall is an object that describes an
+// invocation of method GetByID on an instance of MockSearchHistoryStore.
+type SearchHistoryStoreGetByIDFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int32
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 basestore.TransactableHandle
+	Result0 *SearchHistoryEntry
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreHandleFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c SearchHistoryStoreGetByIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreHandleFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c SearchHistoryStoreGetByIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// SearchContextsStoreListSearchContextsFunc describes the behavior when the
-// ListSearchContexts method of the parent MockSearchContextsStore instance
-// is invoked.
-type SearchContextsStoreListSearchContextsFunc struct {
-	defaultHook func(context.Context, ListSearchContextsPageOptions, ListSearchContextsOptions) ([]*types.SearchContext, error)
-	hooks       []func(context.Context, ListSearchContextsPageOptions, ListSearchContextsOptions) ([]*types.SearchContext, error)
-	history     []SearchContextsStoreListSearchContextsFuncCall
+// SearchHistoryStoreHandleFunc describes the behavior when the Handle
+// method of the parent MockSearchHistoryStore instance is invoked.
+type SearchHistoryStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []SearchHistoryStoreHandleFuncCall
 	mutex       sync.Mutex
 }
 
-// ListSearchContexts delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockSearchContextsStore) ListSearchContexts(v0 context.Context, v1 ListSearchContextsPageOptions, v2 ListSearchContextsOptions) ([]*types.SearchContext, error) {
-	r0, r1 := m.ListSearchContextsFunc.nextHook()(v0, v1, v2)
-	m.ListSearchContextsFunc.appendCall(SearchContextsStoreListSearchContextsFuncCall{v0, v1, v2, r0, r1})
-	return r0, r1
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSearchHistoryStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(SearchHistoryStoreHandleFuncCall{r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the ListSearchContexts
-// method of the parent MockSearchContextsStore instance is invoked and the
-// hook queue is empty.
-func (f *SearchContextsStoreListSearchContextsFunc) SetDefaultHook(hook func(context.Context, ListSearchContextsPageOptions, ListSearchContextsOptions) ([]*types.SearchContext, error)) {
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockSearchHistoryStore instance is invoked and the hook queue is
+// empty.
+func (f *SearchHistoryStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListSearchContexts method of the parent MockSearchContextsStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *SearchContextsStoreListSearchContextsFunc) PushHook(hook func(context.Context, ListSearchContextsPageOptions, ListSearchContextsOptions) ([]*types.SearchContext, error)) {
+// Handle method of the parent MockSearchHistoryStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SearchHistoryStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -55109,20 +57396,20 @@ func (f *SearchContextsStoreListSearchContextsFunc) PushHook(hook func(context.C
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreListSearchContextsFunc) SetDefaultReturn(r0 []*types.SearchContext, r1 error) {
-	f.SetDefaultHook(func(context.Context, ListSearchContextsPageOptions, ListSearchContextsOptions) ([]*types.SearchContext, error) {
-		return r0, r1
+func (f *SearchHistoryStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreListSearchContextsFunc) PushReturn(r0 []*types.SearchContext, r1 error) {
-	f.PushHook(func(context.Context, ListSearchContextsPageOptions, ListSearchContextsOptions) ([]*types.SearchContext, error) {
-		return r0, r1
+func (f *SearchHistoryStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
+		return r0
 	})
 }
 
-func (f *SearchContextsStoreListSearchContextsFunc) nextHook() func(context.Context, ListSearchContextsPageOptions, ListSearchContextsOptions) ([]*types.SearchContext, error) {
+func (f *SearchHistoryStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -55135,89 +57422,72 @@ func (f *SearchContextsStoreListSearchContextsFunc) nextHook() func(context.Cont
 	return hook
 }
 
-func (f *SearchContextsStoreListSearchContextsFunc) appendCall(r0 SearchContextsStoreListSearchContextsFuncCall) {
+func (f *SearchHistoryStoreHandleFunc) appendCall(r0 SearchHistoryStoreHandleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// SearchContextsStoreListSearchContextsFuncCall objects describing the
-// invocations of this function.
-func (f *SearchContextsStoreListSearchContextsFunc) History() []SearchContextsStoreListSearchContextsFuncCall {
+// History returns a sequence of SearchHistoryStoreHandleFuncCall objects
+// describing the invocations of this function.
+func (f *SearchHistoryStoreHandleFunc) History() []SearchHistoryStoreHandleFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreListSearchContextsFuncCall, len(f.history))
+	history := make([]SearchHistoryStoreHandleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreListSearchContextsFuncCall is an object that describes
-// an invocation of method ListSearchContexts on an instance of
-// MockSearchContextsStore.
-type SearchContextsStoreListSearchContextsFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 ListSearchContextsPageOptions
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 ListSearchContextsOptions
+// SearchHistoryStoreHandleFuncCall is an object that describes an
+// invocation of method Handle on an instance of MockSearchHistoryStore.
+type SearchHistoryStoreHandleFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*types.SearchContext
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 basestore.TransactableHandle
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreListSearchContextsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c SearchHistoryStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreListSearchContextsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c SearchHistoryStoreHandleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// SearchContextsStoreSetSearchContextRepositoryRevisionsFunc describes the
-// behavior when the SetSearchContextRepositoryRevisions method of the
-// parent MockSearchContextsStore instance is invoked.
-type SearchContextsStoreSetSearchContextRepositoryRevisionsFunc struct {
-	defaultHook func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error
-	hooks       []func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error
-	history     []SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall
+// SearchHistoryStoreListFunc describes the behavior when the List method of
+// the parent MockSearchHistoryStore instance is invoked.
+type SearchHistoryStoreListFunc struct {
+	defaultHook func(context.Context, SearchHistoryListOptions, *PaginationArgs) ([]*SearchHistoryEntry, error)
+	hooks       []func(context.Context, SearchHistoryListOptions, *PaginationArgs) ([]*SearchHistoryEntry, error)
+	history     []SearchHistoryStoreListFuncCall
 	mutex       sync.Mutex
 }
 
-// SetSearchContextRepositoryRevisions delegates to the next hook function
-// in the queue and stores the parameter and result values of this
-// invocation.
-func (m *MockSearchContextsStore) SetSearchContextRepositoryRevisions(v0 context.Context, v1 int64, v2 []*types.SearchContextRepositoryRevisions) error {
-	r0 := m.SetSearchContextRepositoryRevisionsFunc.nextHook()(v0, v1, v2)
-	m.SetSearchContextRepositoryRevisionsFunc.appendCall(SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall{v0, v1, v2, r0})
-	return r0
+// List delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSearchHistoryStore) List(v0 context.Context, v1 SearchHistoryListOptions, v2 *PaginationArgs) ([]*SearchHistoryEntry, error) {
+	r0, r1 := m.ListFunc.nextHook()(v0, v1, v2)
+	m.ListFunc.appendCall(SearchHistoryStoreListFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// SetSearchContextRepositoryRevisions method of the parent
-// MockSearchContextsStore instance is invoked and the hook queue is empty.
-func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) SetDefaultHook(hook func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error) {
+// SetDefaultHook sets function that is called when the List method of the
+// parent MockSearchHistoryStore instance is invoked and the hook queue is
+// empty.
+func (f *SearchHistoryStoreListFunc) SetDefaultHook(hook func(context.Context, SearchHistoryListOptions, *PaginationArgs) ([]*SearchHistoryEntry, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// SetSearchContextRepositoryRevisions method of the parent
-// MockSearchContextsStore instance invokes the hook at the front of the
-// queue and discards it. After the queue is empty, the default hook
-// function is invoked for any future action.
-func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) PushHook(hook func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error) {
+// List method of the parent MockSearchHistoryStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SearchHistoryStoreListFunc) PushHook(hook func(context.Context, SearchHistoryListOptions, *PaginationArgs) ([]*SearchHistoryEntry, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -55225,20 +57495,20 @@ func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) PushHook(ho
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error {
-		return r0
+func (f *SearchHistoryStoreListFunc) SetDefaultReturn(r0 []*SearchHistoryEntry, r1 error) {
+	f.SetDefaultHook(func(context.Context, SearchHistoryListOptions, *PaginationArgs) ([]*SearchHistoryEntry, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error {
-		return r0
+func (f *SearchHistoryStoreListFunc) PushReturn(r0 []*SearchHistoryEntry, r1 error) {
+	f.PushHook(func(context.Context, SearchHistoryListOptions, *PaginationArgs) ([]*SearchHistoryEntry, error) {
+		return r0, r1
 	})
 }
 
-func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) nextHook() func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error {
+func (f *SearchHistoryStoreListFunc) nextHook() func(context.Context, SearchHistoryListOptions, *PaginationArgs) ([]*SearchHistoryEntry, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -55251,86 +57521,84 @@ func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) nextHook()
 	return hook
 }
 
-func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) appendCall(r0 SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall) {
+func (f *SearchHistoryStoreListFunc) appendCall(r0 SearchHistoryStoreListFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall objects
+// History returns a sequence of SearchHistoryStoreListFuncCall objects
 // describing the invocations of this function.
-func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) History() []SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall {
+func (f *SearchHistoryStoreListFunc) History() []SearchHistoryStoreListFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall, len(f.history))
+	history := make([]SearchHistoryStoreListFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall is an
-// object that describes an invocation of method
-// SetSearchContextRepositoryRevisions on an instance of
-// MockSearchContextsStore.
-type SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall struct {
+// SearchHistoryStoreListFuncCall is an object that describes an invocation
+// of method List on an instance of MockSearchHistoryStore.
+type SearchHistoryStoreListFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int64
+	Arg1 SearchHistoryListOptions
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 []*types.SearchContextRepositoryRevisions
+	Arg2 *PaginationArgs
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 []*SearchHistoryEntry
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall) Args() []interface{} {
+func (c SearchHistoryStoreListFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c SearchHistoryStoreListFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// SearchContextsStoreSetUserDefaultSearchContextIDFunc describes the
-// behavior when the SetUserDefaultSearchContextID method of the parent
-// MockSearchContextsStore instance is invoked.
-type SearchContextsStoreSetUserDefaultSearchContextIDFunc struct {
-	defaultHook func(context.Context, int32, int64) error
-	hooks       []func(context.Context, int32, int64) error
-	history     []SearchContextsStoreSetUserDefaultSearchContextIDFuncCall
+// SearchHistoryStoreRecordFunc describes the behavior when the Record
+// method of the parent MockSearchHistoryStore instance is invoked.
+type SearchHistoryStoreRecordFunc struct {
+	defaultHook func(context.Context, SearchHistoryRun) (*SearchHistoryEntry, error)
+	hooks       []func(context.Context, SearchHistoryRun) (*SearchHistoryEntry, error)
+	history     []SearchHistoryStoreRecordFuncCall
 	mutex       sync.Mutex
 }
 
-// SetUserDefaultSearchContextID delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockSearchContextsStore) SetUserDefaultSearchContextID(v0 context.Context, v1 int32, v2 int64) error {
-	r0 := m.SetUserDefaultSearchContextIDFunc.nextHook()(v0, v1, v2)
-	m.SetUserDefaultSearchContextIDFunc.appendCall(SearchContextsStoreSetUserDefaultSearchContextIDFuncCall{v0, v1, v2, r0})
-	return r0
+// Record delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSearchHistoryStore) Record(v0 context.Context, v1 SearchHistoryRun) (*SearchHistoryEntry, error) {
+	r0, r1 := m.RecordFunc.nextHook()(v0, v1)
+	m.RecordFunc.appendCall(SearchHistoryStoreRecordFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// SetUserDefaultSearchContextID method of the parent
-// MockSearchContextsStore instance is invoked and the hook queue is empty.
-func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) SetDefaultHook(hook func(context.Context, int32, int64) error) {
+// SetDefaultHook sets function that is called when the Record method of the
+// parent MockSearchHistoryStore instance is invoked and the hook queue is
+// empty.
+func (f *SearchHistoryStoreRecordFunc) SetDefaultHook(hook func(context.Context, SearchHistoryRun) (*SearchHistoryEntry, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// SetUserDefaultSearchContextID method of the parent
-// MockSearchContextsStore instance invokes the hook at the front of the
-// queue and discards it. After the queue is empty, the default hook
-// function is invoked for any future action.
-func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) PushHook(hook func(context.Context, int32, int64) error) {
+// Record method of the parent MockSearchHistoryStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SearchHistoryStoreRecordFunc) PushHook(hook func(context.Context, SearchHistoryRun) (*SearchHistoryEntry, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -55338,20 +57606,20 @@ func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) PushHook(hook fun
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int32, int64) error {
-		return r0
+func (f *SearchHistoryStoreRecordFunc) SetDefaultReturn(r0 *SearchHistoryEntry, r1 error) {
+	f.SetDefaultHook(func(context.Context, SearchHistoryRun) (*SearchHistoryEntry, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int32, int64) error {
-		return r0
+func (f *SearchHistoryStoreRecordFunc) PushReturn(r0 *SearchHistoryEntry, r1 error) {
+	f.PushHook(func(context.Context, SearchHistoryRun) (*SearchHistoryEntry, error) {
+		return r0, r1
 	})
 }
 
-func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) nextHook() func(context.Context, int32, int64) error {
+func (f *SearchHistoryStoreRecordFunc) nextHook() func(context.Context, SearchHistoryRun) (*SearchHistoryEntry, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -55364,83 +57632,81 @@ func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) nextHook() func(c
 	return hook
 }
 
-func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) appendCall(r0 SearchContextsStoreSetUserDefaultSearchContextIDFuncCall) {
+func (f *SearchHistoryStoreRecordFunc) appendCall(r0 SearchHistoryStoreRecordFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// SearchContextsStoreSetUserDefaultSearchContextIDFuncCall objects
+// History returns a sequence of SearchHistoryStoreRecordFuncCall objects
 // describing the invocations of this function.
-func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) History() []SearchContextsStoreSetUserDefaultSearchContextIDFuncCall {
+func (f *SearchHistoryStoreRecordFunc) History() []SearchHistoryStoreRecordFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreSetUserDefaultSearchContextIDFuncCall, len(f.history))
+	history := make([]SearchHistoryStoreRecordFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreSetUserDefaultSearchContextIDFuncCall is an object
-// that describes an invocation of method SetUserDefaultSearchContextID on
-// an instance of MockSearchContextsStore.
-type SearchContextsStoreSetUserDefaultSearchContextIDFuncCall struct {
+// SearchHistoryStoreRecordFuncCall is an object that describes an
+// invocation of method Record on an instance of MockSearchHistoryStore.
+type SearchHistoryStoreRecordFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int32
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 int64
+	Arg1 SearchHistoryRun
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 *SearchHistoryEntry
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreSetUserDefaultSearchContextIDFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c SearchHistoryStoreRecordFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreSetUserDefaultSearchContextIDFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c SearchHistoryStoreRecordFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// SearchContextsStoreTransactFunc describes the behavior when the Transact
-// method of the parent MockSearchContextsStore instance is invoked.
-type SearchContextsStoreTransactFunc struct {
-	defaultHook func(context.Context) (SearchContextsStore, error)
-	hooks       []func(context.Context) (SearchContextsStore, error)
-	history     []SearchContextsStoreTransactFuncCall
+// SearchHistoryStoreSetPinnedFunc describes the behavior when the SetPinned
+// method of the parent MockSearchHistoryStore instance is invoked.
+type SearchHistoryStoreSetPinnedFunc struct {
+	defaultHook func(context.Context, int32, bool) (*SearchHistoryEntry, error)
+	hooks       []func(context.Context, int32, bool) (*SearchHistoryEntry, error)
+	history     []SearchHistoryStoreSetPinnedFuncCall
 	mutex       sync.Mutex
 }
 
-// Transact delegates to the next hook function in the queue and stores the
+// SetPinned delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockSearchContextsStore) Transact(v0 context.Context) (SearchContextsStore, error) {
-	r0, r1 := m.TransactFunc.nextHook()(v0)
-	m.TransactFunc.appendCall(SearchContextsStoreTransactFuncCall{v0, r0, r1})
+func (m *MockSearchHistoryStore) SetPinned(v0 context.Context, v1 int32, v2 bool) (*SearchHistoryEntry, error) {
+	r0, r1 := m.SetPinnedFunc.nextHook()(v0, v1, v2)
+	m.SetPinnedFunc.appendCall(SearchHistoryStoreSetPinnedFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Transact method of
-// the parent MockSearchContextsStore instance is invoked and the hook queue
+// SetDefaultHook sets function that is called when the SetPinned method of
+// the parent MockSearchHistoryStore instance is invoked and the hook queue
 // is empty.
-func (f *SearchContextsStoreTransactFunc) SetDefaultHook(hook func(context.Context) (SearchContextsStore, error)) {
+func (f *SearchHistoryStoreSetPinnedFunc) SetDefaultHook(hook func(context.Context, int32, bool) (*SearchHistoryEntry, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Transact method of the parent MockSearchContextsStore instance invokes
+// SetPinned method of the parent MockSearchHistoryStore instance invokes
 // the hook at the front of the queue and discards it. After the queue is
 // empty, the default hook function is invoked for any future action.
-func (f *SearchContextsStoreTransactFunc) PushHook(hook func(context.Context) (SearchContextsStore, error)) {
+func (f *SearchHistoryStoreSetPinnedFunc) PushHook(hook func(context.Context, int32, bool) (*SearchHistoryEntry, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -55448,20 +57714,20 @@ func (f *SearchContextsStoreTransactFunc) PushHook(hook func(context.Context) (S
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreTransactFunc) SetDefaultReturn(r0 SearchContextsStore, r1 error) {
-	f.SetDefaultHook(func(context.Context) (SearchContextsStore, error) {
+func (f *SearchHistoryStoreSetPinnedFunc) SetDefaultReturn(r0 *SearchHistoryEntry, r1 error) {
+	f.SetDefaultHook(func(context.Context, int32, bool) (*SearchHistoryEntry, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreTransactFunc) PushReturn(r0 SearchContextsStore, r1 error) {
-	f.PushHook(func(context.Context) (SearchContextsStore, error) {
+func (f *SearchHistoryStoreSetPinnedFunc) PushReturn(r0 *SearchHistoryEntry, r1 error) {
+	f.PushHook(func(context.Context, int32, bool) (*SearchHistoryEntry, error) {
 		return r0, r1
 	})
 }
 
-func (f *SearchContextsStoreTransactFunc) nextHook() func(context.Context) (SearchContextsStore, error) {
+func (f *SearchHistoryStoreSetPinnedFunc) nextHook() func(context.Context, int32, bool) (*SearchHistoryEntry, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -55474,32 +57740,38 @@ func (f *SearchContextsStoreTransactFunc) nextHook() func(context.Context) (Sear
 	return hook
 }
 
-func (f *SearchContextsStoreTransactFunc) appendCall(r0 SearchContextsStoreTransactFuncCall) {
+func (f *SearchHistoryStoreSetPinnedFunc) appendCall(r0 SearchHistoryStoreSetPinnedFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SearchContextsStoreTransactFuncCall objects
+// History returns a sequence of SearchHistoryStoreSetPinnedFuncCall objects
 // describing the invocations of this function.
-func (f *SearchContextsStoreTransactFunc) History() []SearchContextsStoreTransactFuncCall {
+func (f *SearchHistoryStoreSetPinnedFunc) History() []SearchHistoryStoreSetPinnedFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreTransactFuncCall, len(f.history))
+	history := make([]SearchHistoryStoreSetPinnedFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreTransactFuncCall is an object that describes an
-// invocation of method Transact on an instance of MockSearchContextsStore.
-type SearchContextsStoreTransactFuncCall struct {
+// SearchHistoryStoreSetPinnedFuncCall is an object that describes an
+// invocation of method SetPinned on an instance of MockSearchHistoryStore.
+type SearchHistoryStoreSetPinnedFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int32
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 bool
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 SearchContextsStore
+	Result0 *SearchHistoryEntry
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -55507,49 +57779,45 @@ type SearchContextsStoreTransactFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreTransactFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c SearchHistoryStoreSetPinnedFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreTransactFuncCall) Results() []interface{} {
+func (c SearchHistoryStoreSetPinnedFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc
-// describes the behavior when the
-// UpdateSearchContextWithRepositoryRevisions method of the parent
-// MockSearchContextsStore instance is invoked.
-type SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc struct {
-	defaultHook func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)
-	hooks       []func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)
-	history     []SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall
+// SearchHistoryStoreWithFunc describes the behavior when the With method of
+// the parent MockSearchHistoryStore instance is invoked.
+type SearchHistoryStoreWithFunc struct {
+	defaultHook func(basestore.ShareableStore) SearchHistoryStore
+	hooks       []func(basestore.ShareableStore) SearchHistoryStore
+	history     []SearchHistoryStoreWithFuncCall
 	mutex       sync.Mutex
 }
 
-// UpdateSearchContextWithRepositoryRevisions delegates to the next hook
-// function in the queue and stores the parameter and result values of this
-// invocation.
-func (m *MockSearchContextsStore) UpdateSearchContextWithRepositoryRevisions(v0 context.Context, v1 *types.SearchContext, v2 []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
-	r0, r1 := m.UpdateSearchContextWithRepositoryRevisionsFunc.nextHook()(v0, v1, v2)
-	m.UpdateSearchContextWithRepositoryRevisionsFunc.appendCall(SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall{v0, v1, v2, r0, r1})
-	return r0, r1
+// With delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSearchHistoryStore) With(v0 basestore.ShareableStore) SearchHistoryStore {
+	r0 := m.WithFunc.nextHook()(v0)
+	m.WithFunc.appendCall(SearchHistoryStoreWithFuncCall{v0, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the
-// UpdateSearchContextWithRepositoryRevisions method of the parent
-// MockSearchContextsStore instance is invoked and the hook queue is empty.
-func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) SetDefaultHook(hook func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)) {
+// SetDefaultHook sets function that is called when the With method of the
+// parent MockSearchHistoryStore instance is invoked and the hook queue is
+// empty.
+func (f *SearchHistoryStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) SearchHistoryStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// UpdateSearchContextWithRepositoryRevisions method of the parent
-// MockSearchContextsStore instance invokes the hook at the front of the
-// queue and discards it. After the queue is empty, the default hook
-// function is invoked for any future action.
-func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) PushHook(hook func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)) {
+// With method of the parent MockSearchHistoryStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SearchHistoryStoreWithFunc) PushHook(hook func(basestore.ShareableStore) SearchHistoryStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -55557,20 +57825,20 @@ func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) Push
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) SetDefaultReturn(r0 *types.SearchContext, r1 error) {
-	f.SetDefaultHook(func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
-		return r0, r1
+func (f *SearchHistoryStoreWithFunc) SetDefaultReturn(r0 SearchHistoryStore) {
+	f.SetDefaultHook(func(basestore.ShareableStore) SearchHistoryStore {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) PushReturn(r0 *types.SearchContext, r1 error) {
-	f.PushHook(func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
-		return r0, r1
+func (f *SearchHistoryStoreWithFunc) PushReturn(r0 SearchHistoryStore) {
+	f.PushHook(func(basestore.ShareableStore) SearchHistoryStore {
+		return r0
 	})
 }
 
-func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) nextHook() func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
+func (f *SearchHistoryStoreWithFunc) nextHook() func(basestore.ShareableStore) SearchHistoryStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -55583,56 +57851,44 @@ func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) next
 	return hook
 }
 
-func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) appendCall(r0 SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall) {
+func (f *SearchHistoryStoreWithFunc) appendCall(r0 SearchHistoryStoreWithFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall
-// objects describing the invocations of this function.
-func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) History() []SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall {
+// History returns a sequence of SearchHistoryStoreWithFuncCall objects
+// describing the invocations of this function.
+func (f *SearchHistoryStoreWithFunc) History() []SearchHistoryStoreWithFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall, len(f.history))
+	history := make([]SearchHistoryStoreWithFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall is
-// an object that describes an invocation of method
-// UpdateSearchContextWithRepositoryRevisions on an instance of
-// MockSearchContextsStore.
-type SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall struct {
+// SearchHistoryStoreWithFuncCall is an object that describes an invocation
+// of method With on an instance of MockSearchHistoryStore.
+type SearchHistoryStoreWithFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 *types.SearchContext
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 []*types.SearchContextRepositoryRevisions
+	Arg0 basestore.ShareableStore
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.SearchContext
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 SearchHistoryStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c SearchHistoryStoreWithFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c SearchHistoryStoreWithFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
 // MockSecurityEventLogsStore is a mock implementation of the
@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/keegancsmith/sqlf"
@@ -60,6 +61,11 @@ type TreeLocationOpts struct {
 	// Empty path "" represents repo root.
 	// Paths do not contain leading /.
 	Path string
+
+	// ReferencePrefix restricts individual counts to CODEOWNERS references
+	// starting with the given text, case-insensitively. It is not used for
+	// aggregate counts.
+	ReferencePrefix string
 }
 
 type OwnershipStatsStore interface {
@@ -216,11 +222,17 @@ var treeCountsScanner = basestore.NewSliceScanner(func(s dbutil.Scanner) (PathCo
 	return cs, err
 })
 
+// likePatternEscaper escapes the wildcards of LIKE patterns.
+var likePatternEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
 func (s *ownershipStats) QueryIndividualCounts(ctx context.Context, opts TreeLocationOpts, limitOffset *LimitOffset) ([]PathCodeownersCounts, error) {
 	qs := []*sqlf.Query{sqlf.Sprintf(aggregateOwnershipFmtstr, opts.Path)}
 	if repoID := opts.RepoID; repoID != 0 {
 		qs = append(qs, sqlf.Sprintf("AND p.repo_id = %s", repoID))
 	}
+	if prefix := opts.ReferencePrefix; prefix != "" {
+		qs = append(qs, sqlf.Sprintf("AND o.reference ILIKE %s", likePatternEscaper.Replace(prefix)+"%"))
+	}
 	qs = append(qs, sqlf.Sprintf("GROUP BY 1 ORDER BY 2 DESC, 1 ASC"))
 	qs = append(qs, limitOffset.SQL())
 	return treeCountsScanner(s.Store.Query(ctx, sqlf.Join(qs, "\n")))
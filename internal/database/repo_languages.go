@@ -0,0 +1,231 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/batch"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+// RepoLanguagesStore stores the language statistics of the default branch of
+// each repository. They back the `repo:has.language()` search predicate.
+type RepoLanguagesStore interface {
+	basestore.ShareableStore
+
+	With(other basestore.ShareableStore) RepoLanguagesStore
+
+	// Get returns the language statistics of the given repository. It
+	// returns nil if they were never computed.
+	Get(ctx context.Context, repo api.RepoID) (*RepoLanguageStats, error)
+
+	// Update replaces the language statistics of the given repository with
+	// those computed at commitID.
+	Update(ctx context.Context, repo api.RepoID, commitID api.CommitID, languages []RepoLanguage) error
+
+	// Touch marks the language statistics of the given repository as up to
+	// date without changing them. If they were never computed, empty
+	// statistics are recorded.
+	Touch(ctx context.Context, repo api.RepoID) error
+
+	// ListStale returns up to limit cloned repositories whose language
+	// statistics were never computed or were last updated before
+	// updatedBefore, least recently updated first.
+	ListStale(ctx context.Context, updatedBefore time.Time, limit int) ([]RepoLanguageStats, error)
+}
+
+// RepoLanguageStats are the language statistics of the default branch of a
+// repository.
+type RepoLanguageStats struct {
+	RepoID   api.RepoID
+	RepoName api.RepoName
+	// CommitID is the commit the statistics were computed at. It is empty
+	// if they were never computed.
+	CommitID  api.CommitID
+	UpdatedAt time.Time
+	Languages []RepoLanguage
+}
+
+// RepoLanguage is the share of a repository written in a language.
+type RepoLanguage struct {
+	Language   string
+	TotalBytes uint64
+	TotalLines uint64
+	// Percentage is the share of the repository written in Language by
+	// bytes, between 0 and 100. It is computed by Update.
+	Percentage float64
+}
+
+var _ RepoLanguagesStore = (*repoLanguagesStore)(nil)
+
+// repoLanguagesStore is responsible for data stored in the repo_language_stats
+// and repo_languages tables.
+type repoLanguagesStore struct {
+	*basestore.Store
+}
+
+// RepoLanguagesWith instantiates and returns a new repoLanguagesStore using
+// the other store handle.
+func RepoLanguagesWith(other basestore.ShareableStore) RepoLanguagesStore {
+	return &repoLanguagesStore{Store: basestore.NewWithHandle(other.Handle())}
+}
+
+func (s *repoLanguagesStore) With(other basestore.ShareableStore) RepoLanguagesStore {
+	return &repoLanguagesStore{Store: s.Store.With(other)}
+}
+
+func (s *repoLanguagesStore) Get(ctx context.Context, repo api.RepoID) (_ *RepoLanguageStats, err error) {
+	stats, err := scanRepoLanguageStats(s.QueryRow(ctx, sqlf.Sprintf(getRepoLanguageStatsQueryFmtstr, repo)))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	rows, err := s.Query(ctx, sqlf.Sprintf(getRepoLanguagesQueryFmtstr, repo))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	for rows.Next() {
+		var l RepoLanguage
+		if err := rows.Scan(&l.Language, &l.TotalBytes, &l.TotalLines, &l.Percentage); err != nil {
+			return nil, err
+		}
+		stats.Languages = append(stats.Languages, l)
+	}
+
+	return stats, rows.Err()
+}
+
+func scanRepoLanguageStats(sc dbutil.Scanner) (*RepoLanguageStats, error) {
+	var stats RepoLanguageStats
+	if err := sc.Scan(&stats.RepoID, &stats.RepoName, &stats.CommitID, &dbutil.NullTime{Time: &stats.UpdatedAt}); err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
+const getRepoLanguageStatsQueryFmtstr = `
+SELECT
+	rls.repo_id,
+	repo.name,
+	rls.commit_id,
+	rls.updated_at
+FROM repo_language_stats rls
+JOIN repo ON repo.id = rls.repo_id
+WHERE rls.repo_id = %s
+`
+
+const getRepoLanguagesQueryFmtstr = `
+SELECT
+	language,
+	total_bytes,
+	total_lines,
+	percentage
+FROM repo_languages
+WHERE repo_id = %s
+ORDER BY percentage DESC, language
+`
+
+func (s *repoLanguagesStore) Update(ctx context.Context, repo api.RepoID, commitID api.CommitID, languages []RepoLanguage) (err error) {
+	tx, err := s.Store.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	if err := tx.Exec(ctx, sqlf.Sprintf(upsertRepoLanguageStatsQueryFmtstr, repo, commitID)); err != nil {
+		return err
+	}
+	if err := tx.Exec(ctx, sqlf.Sprintf(deleteRepoLanguagesQueryFmtstr, repo)); err != nil {
+		return err
+	}
+
+	var totalBytes uint64
+	for _, l := range languages {
+		totalBytes += l.TotalBytes
+	}
+
+	inserter := batch.NewInserter(ctx, tx.Handle(), "repo_languages", batch.MaxNumPostgresParameters, "repo_id", "language", "total_bytes", "total_lines", "percentage")
+	for _, l := range languages {
+		if l.Language == "" {
+			continue
+		}
+		percentage := 0.0
+		if totalBytes > 0 {
+			percentage = float64(l.TotalBytes) / float64(totalBytes) * 100
+		}
+		if err := inserter.Insert(ctx, repo, l.Language, l.TotalBytes, l.TotalLines, percentage); err != nil {
+			return err
+		}
+	}
+
+	return inserter.Flush(ctx)
+}
+
+const upsertRepoLanguageStatsQueryFmtstr = `
+INSERT INTO repo_language_stats (repo_id, commit_id, updated_at)
+VALUES (%s, %s, now())
+ON CONFLICT (repo_id) DO UPDATE SET
+	commit_id = EXCLUDED.commit_id,
+	updated_at = EXCLUDED.updated_at
+`
+
+const deleteRepoLanguagesQueryFmtstr = `
+DELETE FROM repo_languages WHERE repo_id = %s
+`
+
+func (s *repoLanguagesStore) Touch(ctx context.Context, repo api.RepoID) error {
+	return s.Exec(ctx, sqlf.Sprintf(touchRepoLanguageStatsQueryFmtstr, repo))
+}
+
+const touchRepoLanguageStatsQueryFmtstr = `
+INSERT INTO repo_language_stats (repo_id, commit_id, updated_at)
+VALUES (%s, '', now())
+ON CONFLICT (repo_id) DO UPDATE SET updated_at = EXCLUDED.updated_at
+`
+
+func (s *repoLanguagesStore) ListStale(ctx context.Context, updatedBefore time.Time, limit int) (_ []RepoLanguageStats, err error) {
+	rows, err := s.Query(ctx, sqlf.Sprintf(listStaleRepoLanguageStatsQueryFmtstr, updatedBefore, limit))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var stale []RepoLanguageStats
+	for rows.Next() {
+		stats, err := scanRepoLanguageStats(rows)
+		if err != nil {
+			return nil, err
+		}
+		stale = append(stale, *stats)
+	}
+
+	return stale, rows.Err()
+}
+
+const listStaleRepoLanguageStatsQueryFmtstr = `
+SELECT
+	repo.id,
+	repo.name,
+	COALESCE(rls.commit_id, ''),
+	rls.updated_at
+FROM repo
+JOIN gitserver_repos gr ON gr.repo_id = repo.id
+LEFT JOIN repo_language_stats rls ON rls.repo_id = repo.id
+WHERE
+	repo.deleted_at IS NULL
+	AND repo.blocked IS NULL
+	AND gr.clone_status = 'cloned'
+	AND (rls.updated_at IS NULL OR rls.updated_at < %s)
+ORDER BY rls.updated_at ASC NULLS FIRST, repo.id
+LIMIT %s
+`
@@ -592,6 +592,11 @@ type ReposListOptions struct {
 	// A set of filters to select only repos with the given set of topics
 	TopicFilters []RepoTopicFilter
 
+	// A set of filters to select only repos whose default branch is made up
+	// of a minimum share of the given languages, according to the
+	// repo_languages table.
+	LanguageFilters []RepoLanguageFilter
+
 	// CaseSensitivePatterns determines if IncludePatterns and ExcludePattern are treated
 	// with case sensitivity or not.
 	CaseSensitivePatterns bool
@@ -744,6 +749,16 @@ type RepoTopicFilter struct {
 	Negated bool
 }
 
+type RepoLanguageFilter struct {
+	Language string
+	// MinPercent is the minimum share of the repo, between 0 and 100, that
+	// must be written in Language.
+	MinPercent float64
+	// If negated is true, this filter will select only repos
+	// that do _not_ have the associated language
+	Negated bool
+}
+
 type RepoListOrderBy []RepoListSort
 
 func (r RepoListOrderBy) SQL() *sqlf.Query {
@@ -1205,6 +1220,18 @@ func (s *repoStore) listSQL(ctx context.Context, tr *trace.Trace, opt ReposListO
 		where = append(where, sqlf.Join(ands, "AND"))
 	}
 
+	if len(opt.LanguageFilters) > 0 {
+		var ands []*sqlf.Query
+		for _, filter := range opt.LanguageFilters {
+			cond := `EXISTS (SELECT 1 FROM repo_languages rl WHERE rl.repo_id = repo.id AND lower(rl.language) = lower(%s) AND rl.percentage >= %s)`
+			if filter.Negated {
+				cond = `NOT ` + cond
+			}
+			ands = append(ands, sqlf.Sprintf(cond, filter.Language, filter.MinPercent))
+		}
+		where = append(where, sqlf.Join(ands, "AND"))
+	}
+
 	baseConds := sqlf.Sprintf("TRUE")
 	if !opt.IncludeDeleted {
 		baseConds = sqlf.Sprintf("repo.deleted_at IS NULL")
@@ -1385,6 +1385,51 @@ func TestRepos_List_topics(t *testing.T) {
 	}
 }
 
+func TestRepos_List_languages(t *testing.T) {
+	t.Parallel()
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(logger, t))
+	ctx := actor.WithInternalActor(context.Background())
+
+	r1 := mustCreate(ctx, t, db, &types.Repo{Name: "r1"})
+	r2 := mustCreate(ctx, t, db, &types.Repo{Name: "r2"})
+	r3 := mustCreate(ctx, t, db, &types.Repo{Name: "r3"})
+
+	for repo, languages := range map[api.RepoID][]RepoLanguage{
+		r1.ID: {{Language: "Go", TotalBytes: 90}, {Language: "Markdown", TotalBytes: 10}},
+		r2.ID: {{Language: "Go", TotalBytes: 20}, {Language: "TypeScript", TotalBytes: 80}},
+	} {
+		if err := db.RepoLanguages().Update(ctx, repo, "deadbeef", languages); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name string
+		opt  ReposListOptions
+		want []*types.Repo
+	}{
+		{"go", ReposListOptions{LanguageFilters: []RepoLanguageFilter{{Language: "go"}}}, []*types.Repo{r1, r2}},
+		{"go min 50%", ReposListOptions{LanguageFilters: []RepoLanguageFilter{{Language: "Go", MinPercent: 50}}}, []*types.Repo{r1}},
+		{"not typescript", ReposListOptions{LanguageFilters: []RepoLanguageFilter{{Language: "TypeScript", Negated: true}}}, []*types.Repo{r1, r3}},
+		{
+			"go not markdown",
+			ReposListOptions{LanguageFilters: []RepoLanguageFilter{{Language: "Go"}, {Language: "Markdown", Negated: true}}},
+			[]*types.Repo{r2},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repos, err := db.Repos().List(ctx, test.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			require.Equal(t, test.want, repos)
+		})
+	}
+}
+
 func TestRepos_ListMinimalRepos(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -0,0 +1,339 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+// SearchHistoryStore stores the searches run by each user. It backs the
+// server-side search history, which is synced across the devices of a user
+// and used to rank query suggestions.
+type SearchHistoryStore interface {
+	basestore.ShareableStore
+
+	With(other basestore.ShareableStore) SearchHistoryStore
+
+	// Record adds a run of a search to the history of its user. Running the
+	// same query with the same pattern type again updates the execution
+	// metadata of the existing entry and increments its run count.
+	Record(ctx context.Context, run SearchHistoryRun) (*SearchHistoryEntry, error)
+
+	// GetByID returns the entry with the given ID. If not found, a
+	// NotFounder error is returned.
+	GetByID(ctx context.Context, id int32) (*SearchHistoryEntry, error)
+
+	// List returns the entries matching opts, most recently run first.
+	List(ctx context.Context, opts SearchHistoryListOptions, pagination *PaginationArgs) ([]*SearchHistoryEntry, error)
+
+	// Count returns the number of entries matching opts.
+	Count(ctx context.Context, opts SearchHistoryListOptions) (int, error)
+
+	// SetPinned pins or unpins the entry with the given ID. Pinned entries
+	// are exempt from retention.
+	SetPinned(ctx context.Context, id int32, pinned bool) (*SearchHistoryEntry, error)
+
+	// Delete deletes the entry with the given ID.
+	Delete(ctx context.Context, id int32) error
+
+	// DeleteAllForUser deletes all entries of the given user, including
+	// pinned ones.
+	DeleteAllForUser(ctx context.Context, userID int32) error
+
+	// DeleteExpired deletes unpinned entries last run before lastRunBefore,
+	// as well as the least recently run unpinned entries of users with more
+	// than maxEntriesPerUser of them. It returns the number of deleted
+	// entries.
+	DeleteExpired(ctx context.Context, lastRunBefore time.Time, maxEntriesPerUser int) (int, error)
+}
+
+// SearchHistoryRun describes a single run of a search by a user.
+type SearchHistoryRun struct {
+	UserID      int32
+	Query       string
+	PatternType string
+	SearchMode  int
+	ResultCount int
+	Duration    time.Duration
+	// AlertTitle is the title of the alert shown for the run, if any.
+	AlertTitle string
+}
+
+// SearchHistoryEntry is a search in the history of a user, along with the
+// execution metadata of its most recent run.
+type SearchHistoryEntry struct {
+	ID          int32
+	UserID      int32
+	Query       string
+	PatternType string
+	SearchMode  int
+	ResultCount int
+	Duration    time.Duration
+	AlertTitle  string
+	RunCount    int
+	Pinned      bool
+	CreatedAt   time.Time
+	LastRunAt   time.Time
+}
+
+// SearchHistoryListOptions specifies the entries returned by List and Count.
+type SearchHistoryListOptions struct {
+	// UserID is the user whose entries are listed. It is required.
+	UserID int32
+	// Query, if non-empty, only includes entries whose query contains it,
+	// ignoring case.
+	Query string
+	// Pinned, if non-nil, only includes entries which are pinned or unpinned.
+	Pinned *bool
+}
+
+// SearchHistoryOrderBy orders entries by descending last run time, using the
+// ID as a tie breaker. Cursors for List must be of the form
+// `'<last_run_at>'::timestamptz, <id>` when using this order.
+var SearchHistoryOrderBy = OrderBy{{Field: "last_run_at"}, {Field: "id"}}
+
+// SearchHistoryCursor returns a cursor for List pointing at entry.
+func SearchHistoryCursor(lastRunAt time.Time, id int32) string {
+	return fmt.Sprintf("'%s'::timestamptz, %d", lastRunAt.UTC().Format(time.RFC3339Nano), id)
+}
+
+// SearchHistoryEntryNotFoundErr is returned when an entry cannot be found.
+type SearchHistoryEntryNotFoundErr struct {
+	id int32
+}
+
+func (err SearchHistoryEntryNotFoundErr) Error() string {
+	return fmt.Sprintf("search history entry not found: id=%d", err.id)
+}
+
+func (SearchHistoryEntryNotFoundErr) NotFound() bool {
+	return true
+}
+
+var _ SearchHistoryStore = (*searchHistoryStore)(nil)
+
+// searchHistoryStore is responsible for data stored in the search_history
+// table.
+type searchHistoryStore struct {
+	*basestore.Store
+}
+
+// SearchHistoryWith instantiates and returns a new searchHistoryStore using
+// the other store handle.
+func SearchHistoryWith(other basestore.ShareableStore) SearchHistoryStore {
+	return &searchHistoryStore{Store: basestore.NewWithHandle(other.Handle())}
+}
+
+func (s *searchHistoryStore) With(other basestore.ShareableStore) SearchHistoryStore {
+	return &searchHistoryStore{Store: s.Store.With(other)}
+}
+
+const searchHistoryColumns = `
+	id,
+	user_id,
+	query,
+	pattern_type,
+	search_mode,
+	result_count,
+	duration_ms,
+	alert_title,
+	run_count,
+	pinned,
+	created_at,
+	last_run_at
+`
+
+func scanSearchHistoryEntry(sc dbutil.Scanner) (*SearchHistoryEntry, error) {
+	var (
+		e          SearchHistoryEntry
+		durationMs int64
+	)
+	if err := sc.Scan(
+		&e.ID,
+		&e.UserID,
+		&e.Query,
+		&e.PatternType,
+		&e.SearchMode,
+		&e.ResultCount,
+		&durationMs,
+		&dbutil.NullString{S: &e.AlertTitle},
+		&e.RunCount,
+		&e.Pinned,
+		&e.CreatedAt,
+		&e.LastRunAt,
+	); err != nil {
+		return nil, err
+	}
+	e.Duration = time.Duration(durationMs) * time.Millisecond
+	return &e, nil
+}
+
+func (s *searchHistoryStore) Record(ctx context.Context, run SearchHistoryRun) (*SearchHistoryEntry, error) {
+	q := sqlf.Sprintf(
+		recordSearchHistoryQueryFmtstr,
+		run.UserID,
+		run.Query,
+		run.PatternType,
+		run.SearchMode,
+		run.ResultCount,
+		run.Duration.Milliseconds(),
+		dbutil.NewNullString(run.AlertTitle),
+		sqlf.Sprintf(searchHistoryColumns),
+	)
+	return scanSearchHistoryEntry(s.QueryRow(ctx, q))
+}
+
+const recordSearchHistoryQueryFmtstr = `
+INSERT INTO search_history (user_id, query, pattern_type, search_mode, result_count, duration_ms, alert_title)
+VALUES (%s, %s, %s, %s, %s, %s, %s)
+ON CONFLICT (user_id, md5(query), pattern_type) DO UPDATE SET
+	search_mode = EXCLUDED.search_mode,
+	result_count = EXCLUDED.result_count,
+	duration_ms = EXCLUDED.duration_ms,
+	alert_title = EXCLUDED.alert_title,
+	run_count = search_history.run_count + 1,
+	last_run_at = now()
+RETURNING %s
+`
+
+func (s *searchHistoryStore) GetByID(ctx context.Context, id int32) (*SearchHistoryEntry, error) {
+	e, err := scanSearchHistoryEntry(s.QueryRow(ctx, sqlf.Sprintf(getSearchHistoryEntryQueryFmtstr, sqlf.Sprintf(searchHistoryColumns), id)))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, SearchHistoryEntryNotFoundErr{id: id}
+		}
+		return nil, err
+	}
+	return e, nil
+}
+
+const getSearchHistoryEntryQueryFmtstr = `
+SELECT %s FROM search_history WHERE id = %s
+`
+
+func (opts SearchHistoryListOptions) sqlConds() *sqlf.Query {
+	conds := []*sqlf.Query{sqlf.Sprintf("user_id = %s", opts.UserID)}
+	if opts.Query != "" {
+		conds = append(conds, sqlf.Sprintf("strpos(lower(query), lower(%s)) > 0", opts.Query))
+	}
+	if opts.Pinned != nil {
+		conds = append(conds, sqlf.Sprintf("pinned = %s", *opts.Pinned))
+	}
+	return sqlf.Join(conds, "AND")
+}
+
+func (s *searchHistoryStore) List(ctx context.Context, opts SearchHistoryListOptions, pagination *PaginationArgs) (_ []*SearchHistoryEntry, err error) {
+	if pagination == nil {
+		pagination = &PaginationArgs{OrderBy: SearchHistoryOrderBy}
+	}
+	p := pagination.SQL()
+
+	where := opts.sqlConds()
+	if p.Where != nil {
+		where = sqlf.Sprintf("%s AND %s", where, p.Where)
+	}
+	q := sqlf.Sprintf(listSearchHistoryQueryFmtstr, sqlf.Sprintf(searchHistoryColumns), where)
+	q = p.AppendOrderToQuery(q)
+	q = p.AppendLimitToQuery(q)
+
+	rows, err := s.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var entries []*SearchHistoryEntry
+	for rows.Next() {
+		e, err := scanSearchHistoryEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+const listSearchHistoryQueryFmtstr = `
+SELECT %s FROM search_history WHERE %s
+`
+
+func (s *searchHistoryStore) Count(ctx context.Context, opts SearchHistoryListOptions) (int, error) {
+	count, _, err := basestore.ScanFirstInt(s.Query(ctx, sqlf.Sprintf(countSearchHistoryQueryFmtstr, opts.sqlConds())))
+	return count, err
+}
+
+const countSearchHistoryQueryFmtstr = `
+SELECT COUNT(*) FROM search_history WHERE %s
+`
+
+func (s *searchHistoryStore) SetPinned(ctx context.Context, id int32, pinned bool) (*SearchHistoryEntry, error) {
+	e, err := scanSearchHistoryEntry(s.QueryRow(ctx, sqlf.Sprintf(setSearchHistoryPinnedQueryFmtstr, pinned, id, sqlf.Sprintf(searchHistoryColumns))))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, SearchHistoryEntryNotFoundErr{id: id}
+		}
+		return nil, err
+	}
+	return e, nil
+}
+
+const setSearchHistoryPinnedQueryFmtstr = `
+UPDATE search_history SET pinned = %s WHERE id = %s RETURNING %s
+`
+
+func (s *searchHistoryStore) Delete(ctx context.Context, id int32) error {
+	res, err := s.ExecResult(ctx, sqlf.Sprintf(deleteSearchHistoryEntryQueryFmtstr, id))
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return SearchHistoryEntryNotFoundErr{id: id}
+	}
+	return nil
+}
+
+const deleteSearchHistoryEntryQueryFmtstr = `
+DELETE FROM search_history WHERE id = %s
+`
+
+func (s *searchHistoryStore) DeleteAllForUser(ctx context.Context, userID int32) error {
+	return s.Exec(ctx, sqlf.Sprintf(deleteSearchHistoryForUserQueryFmtstr, userID))
+}
+
+const deleteSearchHistoryForUserQueryFmtstr = `
+DELETE FROM search_history WHERE user_id = %s
+`
+
+func (s *searchHistoryStore) DeleteExpired(ctx context.Context, lastRunBefore time.Time, maxEntriesPerUser int) (int, error) {
+	count, _, err := basestore.ScanFirstInt(s.Query(ctx, sqlf.Sprintf(deleteExpiredSearchHistoryQueryFmtstr, lastRunBefore, maxEntriesPerUser)))
+	return count, err
+}
+
+const deleteExpiredSearchHistoryQueryFmtstr = `
+WITH ranked AS (
+	SELECT
+		id,
+		last_run_at,
+		ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY last_run_at DESC, id DESC) AS rank
+	FROM search_history
+	WHERE NOT pinned
+),
+deleted AS (
+	DELETE FROM search_history
+	WHERE id IN (
+		SELECT id FROM ranked WHERE last_run_at < %s OR rank > %s
+	)
+	RETURNING 1
+)
+SELECT COUNT(*) FROM deleted
+`
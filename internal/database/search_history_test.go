@@ -0,0 +1,134 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+)
+
+func TestSearchHistory(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	t.Parallel()
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(logger, t))
+	ctx := context.Background()
+	store := db.SearchHistory()
+
+	alice, err := db.Users().Create(ctx, NewUser{Username: "alice"})
+	require.NoError(t, err)
+	bob, err := db.Users().Create(ctx, NewUser{Username: "bob"})
+	require.NoError(t, err)
+
+	first, err := store.Record(ctx, SearchHistoryRun{
+		UserID:      alice.ID,
+		Query:       "repo:foo bar",
+		PatternType: "standard",
+		ResultCount: 10,
+		Duration:    1500 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, first.RunCount)
+	assert.Equal(t, 1500*time.Millisecond, first.Duration)
+	assert.Empty(t, first.AlertTitle)
+
+	// Running the same query again updates the existing entry.
+	again, err := store.Record(ctx, SearchHistoryRun{
+		UserID:      alice.ID,
+		Query:       "repo:foo bar",
+		PatternType: "standard",
+		ResultCount: 0,
+		AlertTitle:  "No results",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, again.ID)
+	assert.Equal(t, 2, again.RunCount)
+	assert.Equal(t, 0, again.ResultCount)
+	assert.Equal(t, "No results", again.AlertTitle)
+
+	// The same query with another pattern type is a separate entry.
+	_, err = store.Record(ctx, SearchHistoryRun{UserID: alice.ID, Query: "repo:foo bar", PatternType: "regexp"})
+	require.NoError(t, err)
+	_, err = store.Record(ctx, SearchHistoryRun{UserID: alice.ID, Query: "type:diff Fix", PatternType: "standard"})
+	require.NoError(t, err)
+	_, err = store.Record(ctx, SearchHistoryRun{UserID: bob.ID, Query: "repo:foo bar", PatternType: "standard"})
+	require.NoError(t, err)
+
+	t.Run("List", func(t *testing.T) {
+		entries, err := store.List(ctx, SearchHistoryListOptions{UserID: alice.ID}, nil)
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+		assert.Equal(t, "type:diff Fix", entries[0].Query)
+
+		entries, err = store.List(ctx, SearchHistoryListOptions{UserID: alice.ID, Query: "FIX"}, nil)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+
+		count, err := store.Count(ctx, SearchHistoryListOptions{UserID: alice.ID, Query: "foo"})
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("Pagination", func(t *testing.T) {
+		first := 2
+		entries, err := store.List(ctx, SearchHistoryListOptions{UserID: alice.ID}, &PaginationArgs{First: &first, OrderBy: SearchHistoryOrderBy})
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+
+		after := SearchHistoryCursor(entries[1].LastRunAt, entries[1].ID)
+		rest, err := store.List(ctx, SearchHistoryListOptions{UserID: alice.ID}, &PaginationArgs{First: &first, After: &after, OrderBy: SearchHistoryOrderBy})
+		require.NoError(t, err)
+		require.Len(t, rest, 1)
+		assert.Equal(t, "repo:foo bar", rest[0].Query)
+	})
+
+	t.Run("SetPinned", func(t *testing.T) {
+		pinned, err := store.SetPinned(ctx, first.ID, true)
+		require.NoError(t, err)
+		assert.True(t, pinned.Pinned)
+
+		isPinned := true
+		count, err := store.Count(ctx, SearchHistoryListOptions{UserID: alice.ID, Pinned: &isPinned})
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		_, err = store.SetPinned(ctx, -1, true)
+		assert.True(t, errcode.IsNotFound(err))
+	})
+
+	t.Run("DeleteExpired", func(t *testing.T) {
+		// Pinned entries are kept, every other entry of alice exceeds the
+		// limit of one entry per user or is too old.
+		deleted, err := store.DeleteExpired(ctx, time.Now().Add(-time.Hour), 1)
+		require.NoError(t, err)
+		assert.Equal(t, 1, deleted)
+
+		deleted, err = store.DeleteExpired(ctx, time.Now().Add(time.Hour), 1000)
+		require.NoError(t, err)
+		assert.Equal(t, 2, deleted)
+
+		_, err = store.GetByID(ctx, first.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		require.NoError(t, store.Delete(ctx, first.ID))
+		assert.True(t, errcode.IsNotFound(store.Delete(ctx, first.ID)))
+
+		_, err := store.Record(ctx, SearchHistoryRun{UserID: bob.ID, Query: "lang:go", PatternType: "standard"})
+		require.NoError(t, err)
+		require.NoError(t, store.DeleteAllForUser(ctx, bob.ID))
+		count, err := store.Count(ctx, SearchHistoryListOptions{UserID: bob.ID})
+		require.NoError(t, err)
+		assert.Equal(t, 0, count)
+	})
+}
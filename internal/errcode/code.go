@@ -147,6 +147,13 @@ func IsTemporary(err error) bool {
 	return errors.As(err, &e) && e.Temporary()
 }
 
+// IsRateLimited will check if err or one of its causes was caused by a rate
+// limit being exceeded.
+func IsRateLimited(err error) bool {
+	var e interface{ RateLimited() bool }
+	return errors.As(err, &e) && e.RateLimited()
+}
+
 // IsArchived will check if err or one of its causes is an archived error.
 // (This is generally going to be in the context of repositories being
 // archived.)
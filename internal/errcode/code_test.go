@@ -38,6 +38,27 @@ func TestMakeNonRetryable(t *testing.T) {
 	}
 }
 
+func TestIsRateLimited(t *testing.T) {
+	if errcode.IsRateLimited(errors.New("foo")) {
+		t.Error("unexpected rate limited error")
+	}
+
+	err := errors.Wrap(&rateLimitedErr{}, "creating pull request")
+	if !errcode.IsRateLimited(err) {
+		t.Errorf("expected rate limited error: %+v", err)
+	}
+}
+
+type rateLimitedErr struct{}
+
+func (e *rateLimitedErr) Error() string {
+	return "rate limited"
+}
+
+func (e *rateLimitedErr) RateLimited() bool {
+	return true
+}
+
 type notFoundErr struct{}
 
 func (e *notFoundErr) Error() string {
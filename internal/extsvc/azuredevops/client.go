@@ -39,6 +39,7 @@ type Client interface {
 	GetPullRequest(ctx context.Context, args PullRequestCommonArgs) (PullRequest, error)
 	GetPullRequestStatuses(ctx context.Context, args PullRequestCommonArgs) ([]PullRequestBuildStatus, error)
 	UpdatePullRequest(ctx context.Context, args PullRequestCommonArgs, input PullRequestUpdateInput) (PullRequest, error)
+	AddPullRequestReviewer(ctx context.Context, args PullRequestCommonArgs, reviewerID string) (Reviewer, error)
 	CreatePullRequestCommentThread(ctx context.Context, args PullRequestCommonArgs, input PullRequestCommentInput) (PullRequestCommentResponse, error)
 	CompletePullRequest(ctx context.Context, args PullRequestCommonArgs, input PullRequestCompleteInput) (PullRequest, error)
 	GetRepo(ctx context.Context, args OrgProjectRepoArgs) (Repository, error)
@@ -115,6 +115,32 @@ func (c *client) UpdatePullRequest(ctx context.Context, args PullRequestCommonAr
 	return pr, nil
 }
 
+// AddPullRequestReviewer adds the identity with the given ID as a reviewer of
+// the specified PR, returns the added reviewer.
+func (c *client) AddPullRequestReviewer(ctx context.Context, args PullRequestCommonArgs, reviewerID string) (Reviewer, error) {
+	reqURL := url.URL{Path: fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%s/reviewers/%s", args.Org, args.Project, args.RepoNameOrID, args.PullRequestID, reviewerID)}
+
+	// A reviewer is added without casting a vote.
+	data, err := json.Marshal(struct {
+		Vote int `json:"vote"`
+	}{})
+	if err != nil {
+		return Reviewer{}, errors.Wrap(err, "marshalling request")
+	}
+
+	req, err := http.NewRequest("PUT", reqURL.String(), bytes.NewBuffer(data))
+	if err != nil {
+		return Reviewer{}, err
+	}
+
+	var reviewer Reviewer
+	if _, err = c.do(ctx, req, "", &reviewer); err != nil {
+		return Reviewer{}, err
+	}
+
+	return reviewer, nil
+}
+
 // CreatePullRequestCommentThread creates a new comment Thread specified PR, returns the updated PR.
 func (c *client) CreatePullRequestCommentThread(ctx context.Context, args PullRequestCommonArgs, input PullRequestCommentInput) (PullRequestCommentResponse, error) {
 	reqURL := url.URL{Path: fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%s/threads", args.Org, args.Project, args.RepoNameOrID, args.PullRequestID)}
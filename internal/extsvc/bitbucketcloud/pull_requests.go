@@ -199,12 +199,17 @@ func (input *PullRequestInput) MarshalJSON() ([]byte, error) {
 		Repository *repository `json:"repository,omitempty"`
 	}
 
+	type reviewer struct {
+		UUID string `json:"uuid"`
+	}
+
 	type request struct {
-		Title             string  `json:"title"`
-		Description       string  `json:"description,omitempty"`
-		Source            source  `json:"source"`
-		Destination       *source `json:"destination,omitempty"`
-		CloseSourceBranch bool    `json:"close_source_branch,omitempty"`
+		Title             string     `json:"title"`
+		Description       string     `json:"description,omitempty"`
+		Source            source     `json:"source"`
+		Destination       *source    `json:"destination,omitempty"`
+		CloseSourceBranch bool       `json:"close_source_branch,omitempty"`
+		Reviewers         []reviewer `json:"reviewers,omitempty"`
 	}
 
 	req := request{
@@ -225,6 +230,9 @@ func (input *PullRequestInput) MarshalJSON() ([]byte, error) {
 			Branch: branch{Name: *input.DestinationBranch},
 		}
 	}
+	for _, r := range input.Reviewers {
+		req.Reviewers = append(req.Reviewers, reviewer{UUID: r.UUID})
+	}
 
 	return json.Marshal(&req)
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
@@ -304,3 +305,85 @@ func (c *client) SetCommitMessage(ctx context.Context, changeID string, input Se
 	}
 	return nil
 }
+
+// SetTopic sets the topic of a Gerrit change. An empty topic removes the
+// change from its topic.
+func (c *client) SetTopic(ctx context.Context, changeID string, input SetTopicPayload) error {
+	pathStr, err := url.JoinPath("a/changes", url.PathEscape(changeID), "topic")
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(input)
+	if err != nil {
+		return err
+	}
+
+	reqURL := url.URL{Path: pathStr}
+	req, err := http.NewRequest("PUT", reqURL.String(), bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(ctx, req, nil)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return errors.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// SetHashtags adds hashtags to and removes hashtags from a Gerrit change.
+func (c *client) SetHashtags(ctx context.Context, changeID string, input SetHashtagsPayload) error {
+	pathStr, err := url.JoinPath("a/changes", url.PathEscape(changeID), "hashtags")
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(input)
+	if err != nil {
+		return err
+	}
+
+	reqURL := url.URL{Path: pathStr}
+	req, err := http.NewRequest("POST", reqURL.String(), bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(ctx, req, nil)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return errors.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// GetTopicChanges returns all changes of the given topic, across projects and
+// branches.
+func (c *client) GetTopicChanges(ctx context.Context, topic string) ([]Change, error) {
+	qs := url.Values{}
+	qs.Set("q", fmt.Sprintf("topic:%q", topic))
+	reqURL := url.URL{Path: "a/changes/", RawQuery: qs.Encode()}
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []Change
+	resp, err := c.do(ctx, req, &changes)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, errors.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return changes, nil
+}
@@ -49,6 +49,9 @@ type Client interface {
 	SetReadyForReview(ctx context.Context, changeID string) error
 	MoveChange(ctx context.Context, changeID string, input MoveChangePayload) (*Change, error)
 	SetCommitMessage(ctx context.Context, changeID string, input SetCommitMessagePayload) error
+	SetTopic(ctx context.Context, changeID string, input SetTopicPayload) error
+	SetHashtags(ctx context.Context, changeID string, input SetHashtagsPayload) error
+	GetTopicChanges(ctx context.Context, topic string) ([]Change, error)
 }
 
 // NewClient returns an authenticated Gerrit API client with
@@ -164,6 +164,15 @@ type SetCommitMessagePayload struct {
 	Message string `json:"message"`
 }
 
+type SetTopicPayload struct {
+	Topic string `json:"topic"`
+}
+
+type SetHashtagsPayload struct {
+	Add    []string `json:"add,omitempty"`
+	Remove []string `json:"remove,omitempty"`
+}
+
 type Pagination struct {
 	PerPage int
 	// Either Skip or Page should be set. If Skip is non-zero, it takes precedence.
@@ -21,6 +21,46 @@ func TestSplitRepositoryNameWithOwner(t *testing.T) {
 	}
 }
 
+func TestAPIError_RateLimited(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		err  *APIError
+		want bool
+	}{
+		{
+			name: "primary rate limit",
+			err:  &APIError{Code: http.StatusForbidden, Message: "API rate limit exceeded for user ID 1."},
+			want: true,
+		},
+		{
+			name: "secondary rate limit",
+			err:  &APIError{Code: http.StatusForbidden, Message: "You have exceeded a secondary rate limit.", DocumentationURL: "https://docs.github.com/rest/overview/resources-in-the-rest-api#secondary-rate-limits"},
+			want: true,
+		},
+		{
+			name: "too many requests",
+			err:  &APIError{Code: http.StatusTooManyRequests},
+			want: true,
+		},
+		{
+			name: "forbidden",
+			err:  &APIError{Code: http.StatusForbidden, Message: "Resource not accessible by integration"},
+			want: false,
+		},
+		{
+			name: "rate limit message on other status",
+			err:  &APIError{Code: http.StatusUnprocessableEntity, Message: "API rate limit exceeded"},
+			want: false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if have := tc.err.RateLimited(); have != tc.want {
+				t.Errorf("got %v, want %v", have, tc.want)
+			}
+		})
+	}
+}
+
 type mockHTTPResponseBody struct {
 	count        int
 	responseBody string
@@ -310,6 +310,16 @@ func (e *APIError) UnavailableForLegalReasons() bool {
 
 func (e *APIError) Temporary() bool { return IsRateLimitExceeded(e) }
 
+// RateLimited reports whether GitHub rejected the request because a primary or
+// secondary rate limit was exceeded, which it reports with a 403 or 429
+// response.
+func (e *APIError) RateLimited() bool {
+	if e.Code == http.StatusTooManyRequests {
+		return true
+	}
+	return e.Code == http.StatusForbidden && (IsRateLimitExceeded(e) || strings.Contains(e.Message, "secondary rate limit"))
+}
+
 // HTTPErrorCode returns err's HTTP status code, if it is an HTTP error from
 // this package. Otherwise it returns 0.
 func HTTPErrorCode(err error) int {
@@ -862,6 +872,37 @@ func (c *V3Client) UpdateRef(ctx context.Context, owner, repo, ref, commit strin
 	return &updatedRef, nil
 }
 
+// RequestReviewers requests a review of the given pull request from the given
+// users and teams. Users are given by their login and teams by their slug.
+//
+// API docs: https://docs.github.com/en/rest/pulls/review-requests#request-reviewers-for-a-pull-request
+func (c *V3Client) RequestReviewers(ctx context.Context, owner, repo string, number int64, reviewers, teamReviewers []string) error {
+	payload := struct {
+		Reviewers     []string `json:"reviewers,omitempty"`
+		TeamReviewers []string `json:"team_reviewers,omitempty"`
+	}{Reviewers: reviewers, TeamReviewers: teamReviewers}
+
+	if _, err := c.post(ctx, fmt.Sprintf("repos/%s/%s/pulls/%d/requested_reviewers", owner, repo, number), payload, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddAssignees assigns the given users, given by their login, to the given
+// issue or pull request.
+//
+// API docs: https://docs.github.com/en/rest/issues/assignees#add-assignees-to-an-issue
+func (c *V3Client) AddAssignees(ctx context.Context, owner, repo string, number int64, assignees []string) error {
+	payload := struct {
+		Assignees []string `json:"assignees"`
+	}{Assignees: assignees}
+
+	if _, err := c.post(ctx, fmt.Sprintf("repos/%s/%s/issues/%d/assignees", owner, repo, number), payload, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
 // GetAppInstallation gets information of a GitHub App installation.
 //
 // API docs: https://docs.github.com/en/rest/reference/apps#get-an-installation-for-the-authenticated-app
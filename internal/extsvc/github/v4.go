@@ -327,6 +327,8 @@ func (e graphqlErrors) Error() string {
 	return fmt.Sprintf("error in GraphQL response: %s", e[0].Message)
 }
 
+func (e graphqlErrors) RateLimited() bool { return IsRateLimitExceeded(e) }
+
 // unmarshal wraps json.Unmarshal, but includes extra context in the case of
 // json.UnmarshalTypeError
 func unmarshal(data []byte, v any) error {
@@ -693,6 +695,24 @@ func (c *V4Client) UpdateRef(ctx context.Context, owner, repo, ref, commit strin
 	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).UpdateRef(ctx, owner, repo, ref, commit)
 }
 
+// RequestReviewers requests a review of the given pull request from the given
+// users and teams. Users are given by their login and teams by their slug.
+func (c *V4Client) RequestReviewers(ctx context.Context, owner, repo string, number int64, reviewers, teamReviewers []string) error {
+	logger := c.log.Scoped("RequestReviewers", "temporary client for requesting reviews on GitHub")
+	// The GraphQL API expects node IDs of the reviewers, which we don't know,
+	// so we use the REST API, which accepts logins and team slugs instead.
+	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).RequestReviewers(ctx, owner, repo, number, reviewers, teamReviewers)
+}
+
+// AddAssignees assigns the given users, given by their login, to the given
+// issue or pull request.
+func (c *V4Client) AddAssignees(ctx context.Context, owner, repo string, number int64, assignees []string) error {
+	logger := c.log.Scoped("AddAssignees", "temporary client for assigning users on GitHub")
+	// The GraphQL API expects node IDs of the assignees, which we don't know,
+	// so we use the REST API, which accepts logins instead.
+	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).AddAssignees(ctx, owner, repo, number, assignees)
+}
+
 type RecentCommittersParams struct {
 	// Repository name
 	Name string
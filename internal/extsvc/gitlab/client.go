@@ -378,6 +378,10 @@ func (err HTTPError) IsTemporary() bool {
 	return err.code == http.StatusTooManyRequests
 }
 
+func (err HTTPError) RateLimited() bool {
+	return err.code == http.StatusTooManyRequests
+}
+
 // HTTPErrorCode returns err's HTTP status code, if it is an HTTP error from
 // this package. Otherwise it returns 0.
 func HTTPErrorCode(err error) int {
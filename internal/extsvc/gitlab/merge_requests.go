@@ -223,6 +223,10 @@ type UpdateMergeRequestOpts struct {
 	Description        string                       `json:"description,omitempty"`
 	StateEvent         UpdateMergeRequestStateEvent `json:"state_event,omitempty"`
 	RemoveSourceBranch bool                         `json:"remove_source_branch,omitempty"`
+	// ReviewerIDs and AssigneeIDs replace the current reviewers and assignees
+	// of the merge request, if set.
+	ReviewerIDs []ID `json:"reviewer_ids,omitempty"`
+	AssigneeIDs []ID `json:"assignee_ids,omitempty"`
 }
 
 type UpdateMergeRequestStateEvent string
@@ -247,6 +247,12 @@ type Client interface {
 	// If possible, the error returned will be of type protocol.CreateCommitFromPatchError
 	CreateCommitFromPatch(context.Context, protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error)
 
+	// DeleteRef deletes the given ref from the repository clone on gitserver. It
+	// is not an error if the ref doesn't exist. Only the refs created to check
+	// changesets for conflicts, which start with protocol.ConflictCheckRefPrefix,
+	// can be deleted.
+	DeleteRef(ctx context.Context, repo api.RepoName, ref string) error
+
 	// GetDefaultBranch returns the name of the default branch and the commit it's
 	// currently at from the given repository. If short is true, then `main` instead
 	// of `refs/heads/main` would be returned.
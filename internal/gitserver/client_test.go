@@ -1623,6 +1623,7 @@ func TestGitserverClient_RepoClone(t *testing.T) {
 type mockClient struct {
 	mockBatchLog                    func(ctx context.Context, in *proto.BatchLogRequest, opts ...grpc.CallOption) (*proto.BatchLogResponse, error)
 	mockCreateCommitFromPatchBinary func(ctx context.Context, in *proto.CreateCommitFromPatchBinaryRequest, opts ...grpc.CallOption) (*proto.CreateCommitFromPatchBinaryResponse, error)
+	mockDeleteRef                   func(ctx context.Context, in *proto.DeleteRefRequest, opts ...grpc.CallOption) (*proto.DeleteRefResponse, error)
 	mockExec                        func(ctx context.Context, in *proto.ExecRequest, opts ...grpc.CallOption) (proto.GitserverService_ExecClient, error)
 	mockGetObject                   func(ctx context.Context, in *proto.GetObjectRequest, opts ...grpc.CallOption) (*proto.GetObjectResponse, error)
 	mockIsRepoCloneable             func(ctx context.Context, in *proto.IsRepoCloneableRequest, opts ...grpc.CallOption) (*proto.IsRepoCloneableResponse, error)
@@ -1662,6 +1663,11 @@ func (mc *mockClient) CreateCommitFromPatchBinary(ctx context.Context, in *proto
 	return mc.mockCreateCommitFromPatchBinary(ctx, in, opts...)
 }
 
+// DeleteRef implements v1.GitserverServiceClient.
+func (mc *mockClient) DeleteRef(ctx context.Context, in *proto.DeleteRefRequest, opts ...grpc.CallOption) (*proto.DeleteRefResponse, error) {
+	return mc.mockDeleteRef(ctx, in, opts...)
+}
+
 // RepoUpdate implements v1.GitserverServiceClient
 func (mc *mockClient) RepoUpdate(ctx context.Context, in *proto.RepoUpdateRequest, opts ...grpc.CallOption) (*proto.RepoUpdateResponse, error) {
 	return mc.mockRepoUpdate(ctx, in, opts...)
@@ -34,6 +34,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/fileutil"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
 	internalgrpc "github.com/sourcegraph/sourcegraph/internal/grpc"
 	"github.com/sourcegraph/sourcegraph/internal/grpc/streamio"
 	"github.com/sourcegraph/sourcegraph/internal/honey"
@@ -2803,6 +2804,41 @@ func (c *clientImplementor) ListRefs(ctx context.Context, repo api.RepoName) (_
 	return c.showRef(ctx, repo)
 }
 
+func (c *clientImplementor) DeleteRef(ctx context.Context, repo api.RepoName, ref string) (err error) {
+	ctx, _, endObservation := c.operations.deleteRef.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("repo", string(repo)),
+		attribute.String("ref", ref),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if internalgrpc.IsGRPCEnabled(ctx) {
+		client, err := c.ClientForRepo(repo)
+		if err != nil {
+			return err
+		}
+
+		_, err = client.DeleteRef(ctx, &proto.DeleteRefRequest{
+			Repo: string(repo),
+			Ref:  ref,
+		})
+		return err
+	}
+
+	resp, err := c.httpPost(ctx, repo, "delete-ref", &protocol.DeleteRefRequest{
+		Repo: repo,
+		Ref:  ref,
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("gitserver error (status code %d): %s", resp.StatusCode, readResponseBody(resp.Body))
+	}
+	return nil
+}
+
 func (c *clientImplementor) showRef(ctx context.Context, repo api.RepoName, args ...string) ([]gitdomain.Ref, error) {
 	cmdArgs := append([]string{"show-ref"}, args...)
 	cmd := c.gitCommand(repo, cmdArgs...)
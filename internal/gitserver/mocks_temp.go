@@ -70,6 +70,9 @@ type MockClient struct {
 	// CreateCommitFromPatchFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateCommitFromPatch.
 	CreateCommitFromPatchFunc *ClientCreateCommitFromPatchFunc
+	// DeleteRefFunc is an instance of a mock function object controlling
+	// the behavior of the method DeleteRef.
+	DeleteRefFunc *ClientDeleteRefFunc
 	// DiffFunc is an instance of a mock function object controlling the
 	// behavior of the method Diff.
 	DiffFunc *ClientDiffFunc
@@ -265,6 +268,11 @@ func NewMockClient() *MockClient {
 				return
 			},
 		},
+		DeleteRefFunc: &ClientDeleteRefFunc{
+			defaultHook: func(context.Context, api.RepoName, string) (r0 error) {
+				return
+			},
+		},
 		DiffFunc: &ClientDiffFunc{
 			defaultHook: func(context.Context, authz.SubRepoPermissionChecker, DiffOptions) (r0 *DiffFileIterator, r1 error) {
 				return
@@ -537,6 +545,11 @@ func NewStrictMockClient() *MockClient {
 				panic("unexpected invocation of MockClient.CreateCommitFromPatch")
 			},
 		},
+		DeleteRefFunc: &ClientDeleteRefFunc{
+			defaultHook: func(context.Context, api.RepoName, string) error {
+				panic("unexpected invocation of MockClient.DeleteRef")
+			},
+		},
 		DiffFunc: &ClientDiffFunc{
 			defaultHook: func(context.Context, authz.SubRepoPermissionChecker, DiffOptions) (*DiffFileIterator, error) {
 				panic("unexpected invocation of MockClient.Diff")
@@ -779,6 +792,9 @@ func NewMockClientFrom(i Client) *MockClient {
 		CreateCommitFromPatchFunc: &ClientCreateCommitFromPatchFunc{
 			defaultHook: i.CreateCommitFromPatch,
 		},
+		DeleteRefFunc: &ClientDeleteRefFunc{
+			defaultHook: i.DeleteRef,
+		},
 		DiffFunc: &ClientDiffFunc{
 			defaultHook: i.Diff,
 		},
@@ -2561,6 +2577,113 @@ func (c ClientCreateCommitFromPatchFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// ClientDeleteRefFunc describes the behavior when the DeleteRef method of
+// the parent MockClient instance is invoked.
+type ClientDeleteRefFunc struct {
+	defaultHook func(context.Context, api.RepoName, string) error
+	hooks       []func(context.Context, api.RepoName, string) error
+	history     []ClientDeleteRefFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteRef delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) DeleteRef(v0 context.Context, v1 api.RepoName, v2 string) error {
+	r0 := m.DeleteRefFunc.nextHook()(v0, v1, v2)
+	m.DeleteRefFunc.appendCall(ClientDeleteRefFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the DeleteRef method of
+// the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientDeleteRefFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteRef method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientDeleteRefFunc) PushHook(hook func(context.Context, api.RepoName, string) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientDeleteRefFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientDeleteRefFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoName, string) error {
+		return r0
+	})
+}
+
+func (f *ClientDeleteRefFunc) nextHook() func(context.Context, api.RepoName, string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientDeleteRefFunc) appendCall(r0 ClientDeleteRefFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientDeleteRefFuncCall objects describing
+// the invocations of this function.
+func (f *ClientDeleteRefFunc) History() []ClientDeleteRefFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientDeleteRefFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientDeleteRefFuncCall is an object that describes an invocation of
+// method DeleteRef on an instance of MockClient.
+type ClientDeleteRefFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientDeleteRefFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientDeleteRefFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // ClientDiffFunc describes the behavior when the Diff method of the parent
 // MockClient instance is invoked.
 type ClientDiffFunc struct {
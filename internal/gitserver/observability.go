@@ -19,6 +19,7 @@ type operations struct {
 	blameFile        *observation.Operation
 	commits          *observation.Operation
 	contributorCount *observation.Operation
+	deleteRef        *observation.Operation
 	do               *observation.Operation
 	exec             *observation.Operation
 	firstEverCommit  *observation.Operation
@@ -94,6 +95,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 		blameFile:        op("BlameFile"),
 		commits:          op("Commits"),
 		contributorCount: op("ContributorCount"),
+		deleteRef:        op("DeleteRef"),
 		do:               subOp("do"),
 		exec:             op("Exec"),
 		firstEverCommit:  op("FirstEverCommit"),
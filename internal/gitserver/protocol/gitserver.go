@@ -537,6 +537,20 @@ type RepoDeleteRequest struct {
 	Repo api.RepoName
 }
 
+// ConflictCheckRefPrefix is the prefix of the refs that are created on gitserver
+// to check changesets for conflicts. Only refs with this prefix can be deleted
+// with a DeleteRefRequest.
+const ConflictCheckRefPrefix = "refs/sourcegraph/batch-changes/conflict-checks/"
+
+// DeleteRefRequest is a request to delete a ref that was created on gitserver.
+type DeleteRefRequest struct {
+	// Repo is the repository to delete the ref from.
+	Repo api.RepoName
+	// Ref is the fully qualified name of the ref to delete. It must start with
+	// ConflictCheckRefPrefix.
+	Ref string
+}
+
 // ReposStats is an aggregation of statistics from a gitserver.
 type ReposStats struct {
 	// UpdatedAt is the time these statistics were computed. If UpdateAt is
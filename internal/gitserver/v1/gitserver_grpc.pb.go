@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	GitserverService_BatchLog_FullMethodName                    = "/gitserver.v1.GitserverService/BatchLog"
 	GitserverService_CreateCommitFromPatchBinary_FullMethodName = "/gitserver.v1.GitserverService/CreateCommitFromPatchBinary"
+	GitserverService_DeleteRef_FullMethodName                   = "/gitserver.v1.GitserverService/DeleteRef"
 	GitserverService_Exec_FullMethodName                        = "/gitserver.v1.GitserverService/Exec"
 	GitserverService_GetObject_FullMethodName                   = "/gitserver.v1.GitserverService/GetObject"
 	GitserverService_IsRepoCloneable_FullMethodName             = "/gitserver.v1.GitserverService/IsRepoCloneable"
@@ -41,6 +42,7 @@ const (
 type GitserverServiceClient interface {
 	BatchLog(ctx context.Context, in *BatchLogRequest, opts ...grpc.CallOption) (*BatchLogResponse, error)
 	CreateCommitFromPatchBinary(ctx context.Context, in *CreateCommitFromPatchBinaryRequest, opts ...grpc.CallOption) (*CreateCommitFromPatchBinaryResponse, error)
+	DeleteRef(ctx context.Context, in *DeleteRefRequest, opts ...grpc.CallOption) (*DeleteRefResponse, error)
 	Exec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (GitserverService_ExecClient, error)
 	GetObject(ctx context.Context, in *GetObjectRequest, opts ...grpc.CallOption) (*GetObjectResponse, error)
 	IsRepoCloneable(ctx context.Context, in *IsRepoCloneableRequest, opts ...grpc.CallOption) (*IsRepoCloneableResponse, error)
@@ -81,6 +83,15 @@ func (c *gitserverServiceClient) CreateCommitFromPatchBinary(ctx context.Context
 	return out, nil
 }
 
+func (c *gitserverServiceClient) DeleteRef(ctx context.Context, in *DeleteRefRequest, opts ...grpc.CallOption) (*DeleteRefResponse, error) {
+	out := new(DeleteRefResponse)
+	err := c.cc.Invoke(ctx, GitserverService_DeleteRef_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gitserverServiceClient) Exec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (GitserverService_ExecClient, error) {
 	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[0], GitserverService_Exec_FullMethodName, opts...)
 	if err != nil {
@@ -287,6 +298,7 @@ func (c *gitserverServiceClient) ReposStats(ctx context.Context, in *ReposStatsR
 type GitserverServiceServer interface {
 	BatchLog(context.Context, *BatchLogRequest) (*BatchLogResponse, error)
 	CreateCommitFromPatchBinary(context.Context, *CreateCommitFromPatchBinaryRequest) (*CreateCommitFromPatchBinaryResponse, error)
+	DeleteRef(context.Context, *DeleteRefRequest) (*DeleteRefResponse, error)
 	Exec(*ExecRequest, GitserverService_ExecServer) error
 	GetObject(context.Context, *GetObjectRequest) (*GetObjectResponse, error)
 	IsRepoCloneable(context.Context, *IsRepoCloneableRequest) (*IsRepoCloneableResponse, error)
@@ -312,6 +324,9 @@ func (UnimplementedGitserverServiceServer) BatchLog(context.Context, *BatchLogRe
 func (UnimplementedGitserverServiceServer) CreateCommitFromPatchBinary(context.Context, *CreateCommitFromPatchBinaryRequest) (*CreateCommitFromPatchBinaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCommitFromPatchBinary not implemented")
 }
+func (UnimplementedGitserverServiceServer) DeleteRef(context.Context, *DeleteRefRequest) (*DeleteRefResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRef not implemented")
+}
 func (UnimplementedGitserverServiceServer) Exec(*ExecRequest, GitserverService_ExecServer) error {
 	return status.Errorf(codes.Unimplemented, "method Exec not implemented")
 }
@@ -397,6 +412,24 @@ func _GitserverService_CreateCommitFromPatchBinary_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GitserverService_DeleteRef_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRefRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).DeleteRef(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_DeleteRef_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).DeleteRef(ctx, req.(*DeleteRefRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GitserverService_Exec_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ExecRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -640,6 +673,10 @@ var GitserverService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateCommitFromPatchBinary",
 			Handler:    _GitserverService_CreateCommitFromPatchBinary_Handler,
 		},
+		{
+			MethodName: "DeleteRef",
+			Handler:    _GitserverService_DeleteRef_Handler,
+		},
 		{
 			MethodName: "GetObject",
 			Handler:    _GitserverService_GetObject_Handler,
@@ -33,11 +33,7 @@ type MonitorRegistry struct {
 // token tuple and an optional resource key. If none has been configured yet, the
 // provided monitor will be set.
 func (r *MonitorRegistry) GetOrSet(baseURL, authHash, resource string, monitor *Monitor) *Monitor {
-	baseURL = normaliseURL(baseURL)
-	key := baseURL + ":" + authHash
-	if len(resource) > 0 {
-		key = key + ":" + resource
-	}
+	key := monitorKey(baseURL, authHash, resource)
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	if _, ok := r.monitors[key]; !ok {
@@ -46,6 +42,29 @@ func (r *MonitorRegistry) GetOrSet(baseURL, authHash, resource string, monitor *
 	return r.monitors[key]
 }
 
+// WaitTime returns how long an operation with the given cost against the code
+// host / token tuple and resource has to wait for the external rate limit,
+// without waiting. If no monitor has been configured for them yet, the rate
+// limit is unknown and no wait is necessary.
+func (r *MonitorRegistry) WaitTime(baseURL, authHash, resource string, cost int) time.Duration {
+	key := monitorKey(baseURL, authHash, resource)
+	r.mu.Lock()
+	monitor, ok := r.monitors[key]
+	r.mu.Unlock()
+	if !ok {
+		return 0
+	}
+	return monitor.calcRateLimitWaitTime(cost)
+}
+
+func monitorKey(baseURL, authHash, resource string) string {
+	key := normaliseURL(baseURL) + ":" + authHash
+	if len(resource) > 0 {
+		key = key + ":" + resource
+	}
+	return key
+}
+
 // Count returns the total number of rate limiters in the registry
 func (r *MonitorRegistry) Count() int {
 	r.mu.Lock()
@@ -99,6 +99,37 @@ func TestMonitor_WaitForRateLimit(t *testing.T) {
 	})
 }
 
+func TestMonitorRegistry_WaitTime(t *testing.T) {
+	reset := time.Now().Add(30 * time.Minute)
+
+	r := NewMonitorRegistry()
+	r.GetOrSet("https://api.github.com", "token-a", "rest", &Monitor{known: true, limit: 5000, remaining: 4000, reset: reset})
+	r.GetOrSet("https://api.github.com", "token-b", "graphql", &Monitor{known: true, limit: 5000, remaining: 10, reset: reset})
+	r.GetOrSet("https://ghe.example.com/api/v3", "token-a", "rest", &Monitor{known: true, limit: 5000, remaining: 0, reset: reset})
+
+	t.Run("budget left", func(t *testing.T) {
+		assert.Equal(t, time.Duration(0), r.WaitTime("https://api.github.com/", "token-a", "rest", 1000))
+		assert.Equal(t, time.Duration(0), r.WaitTime("https://api.github.com/", "token-b", "graphql", 10))
+	})
+	t.Run("budget exhausted", func(t *testing.T) {
+		wait := r.WaitTime("https://API.github.com", "token-b", "graphql", 11)
+		assert.True(t, 29*time.Minute < wait)
+		assert.True(t, 30*time.Minute > wait)
+	})
+	t.Run("other tokens are not considered", func(t *testing.T) {
+		assert.Equal(t, time.Duration(0), r.WaitTime("https://api.github.com/", "token-a", "graphql", 11))
+	})
+	t.Run("other resources are not considered", func(t *testing.T) {
+		assert.Equal(t, time.Duration(0), r.WaitTime("https://api.github.com/", "token-b", "rest", 11))
+	})
+	t.Run("other code hosts are not considered", func(t *testing.T) {
+		assert.Equal(t, time.Duration(0), r.WaitTime("https://ghe.example.com/", "token-a", "rest", 1))
+	})
+	t.Run("unknown code host", func(t *testing.T) {
+		assert.Equal(t, time.Duration(0), r.WaitTime("https://gitlab.com/api/v4/", "token-a", "rest", 1))
+	})
+}
+
 func TestMonitor_RecommendedWaitForBackgroundOp_RetryAfter(t *testing.T) {
 	now := time.Now()
 	for _, tc := range []struct {
@@ -313,6 +313,7 @@ func ToFeatures(flagSet *featureflag.FlagSet, logger log.Logger) *search.Feature
 		ContentBasedLangFilters: flagSet.GetBoolOr("search-content-based-lang-detection", false),
 		HybridSearch:            flagSet.GetBoolOr("search-hybrid", true), // can remove flag in 4.5
 		Ranking:                 flagSet.GetBoolOr("search-ranking", true),
+		DocumentRanks:           flagSet.GetBoolOr("search-document-ranks", false),
 		Debug:                   flagSet.GetBoolOr("search-debug", false),
 	}
 }
@@ -1,6 +1,7 @@
 package filter
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -12,6 +13,10 @@ const (
 	File       = "file"
 	Repository = "repo"
 	Symbol     = "symbol"
+
+	// References is the leaf of symbol select paths that pivots from
+	// symbols to their references, as in "symbol.function.references".
+	References = "references"
 )
 
 // SelectPath represents a parsed and validated select value
@@ -77,6 +82,21 @@ var validSelectors = object{
 	},
 }
 
+func init() {
+	// Every symbol kind, and symbols as a whole, can be expanded into the
+	// references of the selected symbols.
+	for kind := range validSelectors[Symbol] {
+		validSelectors[Symbol][kind] = object{References: nil}
+	}
+	validSelectors[Symbol][References] = nil
+}
+
+// IsReferences returns true if the select path selects the references of
+// symbols, as in "symbol.references" or "symbol.function.references".
+func (sp SelectPath) IsReferences() bool {
+	return sp.Root() == Symbol && len(sp) > 1 && sp[len(sp)-1] == References
+}
+
 func SelectPathFromString(s string) (SelectPath, error) {
 	fields := strings.Split(s, ".")
 	cur := validSelectors
@@ -89,3 +109,23 @@ func SelectPathFromString(s string) (SelectPath, error) {
 	}
 	return fields, nil
 }
+
+// SelectPaths returns all valid select paths in sorted order, for example
+// "repo", "symbol" and "symbol.function".
+func SelectPaths() []string {
+	var paths []string
+	var walk func(prefix string, cur object)
+	walk = func(prefix string, cur object) {
+		for field, child := range cur {
+			path := field
+			if prefix != "" {
+				path = prefix + "." + field
+			}
+			paths = append(paths, path)
+			walk(path, child)
+		}
+	}
+	walk("", validSelectors)
+	sort.Strings(paths)
+	return paths
+}
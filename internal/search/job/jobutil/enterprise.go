@@ -14,6 +14,8 @@ import (
 type EnterpriseJobs interface {
 	FileHasOwnerJob(child job.Job, includeOwners, excludeOwners []string) job.Job
 	SelectFileOwnerJob(child job.Job) job.Job
+	SelectSymbolReferencesJob(child job.Job) job.Job
+	DiagnosticSearchJob(patternInfo *search.DiagnosticPatternInfo, repoOpts search.RepoOptions) job.Job
 }
 
 func NewUnimplementedEnterpriseJobs() EnterpriseJobs {
@@ -30,6 +32,14 @@ func (e *enterpriseJobs) SelectFileOwnerJob(_ job.Job) job.Job {
 	return NewUnimplementedJob("`select:file.owners` searches are not available on this instance")
 }
 
+func (e *enterpriseJobs) SelectSymbolReferencesJob(_ job.Job) job.Job {
+	return NewUnimplementedJob("`select:symbol.references` searches are not available on this instance")
+}
+
+func (e *enterpriseJobs) DiagnosticSearchJob(*search.DiagnosticPatternInfo, search.RepoOptions) job.Job {
+	return NewUnimplementedJob("`type:diagnostic` searches are not available on this instance")
+}
+
 func NewUnimplementedJob(msg string) *UnimplementedJob {
 	return &UnimplementedJob{msg: msg}
 }
@@ -168,6 +168,14 @@ func NewBasicJob(inputs *search.Inputs, b query.Basic, enterpriseJobs Enterprise
 			})
 		}
 
+		if resultTypes.Has(result.TypeDiagnostic) {
+			patternInfo, err := toDiagnosticPatternInfo(b, int(fileMatchLimit))
+			if err != nil {
+				return nil, err
+			}
+			addJob(enterpriseJobs.DiagnosticSearchJob(patternInfo, repoOptions))
+		}
+
 		addJob(&searchrepos.ComputeExcludedJob{
 			RepoOpts: repoOptions,
 		})
@@ -184,7 +192,12 @@ func NewBasicJob(inputs *search.Inputs, b query.Basic, enterpriseJobs Enterprise
 		addJob(flatJob)
 	}
 
-	basicJob := NewParallelJob(children...)
+	var basicJob job.Job
+	if inputs.Features.DocumentRanks {
+		basicJob = NewInterleaveJob(conf.SearchFlushWallTime(inputs.PatternType == query.SearchTypeKeyword), children...)
+	} else {
+		basicJob = NewParallelJob(children...)
+	}
 
 	{ // Apply file:contains.content() post-filter
 		if len(fileContainsPatterns) > 0 {
@@ -223,6 +236,10 @@ func NewBasicJob(inputs *search.Inputs, b query.Basic, enterpriseJobs Enterprise
 			if isSelectOwnersSearch(sp) {
 				// the select owners job is ran separately as it requires state and can return multiple owners from one match.
 				basicJob = enterpriseJobs.SelectFileOwnerJob(basicJob)
+			} else if sp.IsReferences() {
+				// the symbol references job expands each selected symbol into its references,
+				// so we first narrow results to the selected symbols.
+				basicJob = enterpriseJobs.SelectSymbolReferencesJob(NewSelectJob(sp[:len(sp)-1], basicJob))
 			} else {
 				basicJob = NewSelectJob(sp, basicJob)
 			}
@@ -355,8 +372,13 @@ func NewFlatJob(searchInputs *search.Inputs, f query.Flat) (job.Job, error) {
 					PathRegexps:     getPathRegexpsFromTextPatternInfo(patternInfo),
 				}
 
+				var child job.Job = searcherJob
+				if searchInputs.Features.DocumentRanks {
+					child = NewDocumentRankJob(searcherJob)
+				}
+
 				addJob(&repoPagerJob{
-					child:            &reposPartialJob{searcherJob},
+					child:            &reposPartialJob{child},
 					repoOpts:         repoOptions,
 					containsRefGlobs: query.ContainsRefGlobs(f.ToBasic().ToParseTree()),
 				})
@@ -687,6 +709,35 @@ func toTextPatternInfo(b query.Basic, resultTypes result.Types, p search.Protoco
 	}
 }
 
+// toDiagnosticPatternInfo converts a basic query into the parameters of a
+// `type:diagnostic` search. The pattern is matched against diagnostic messages.
+func toDiagnosticPatternInfo(b query.Basic, limit int) (*search.DiagnosticPatternInfo, error) {
+	if b.Pattern != nil {
+		if p, ok := b.Pattern.(query.Pattern); !ok || p.Negated {
+			return nil, errors.New("type:diagnostic searches only support a single, non-negated pattern")
+		}
+	}
+
+	severities, excludeSeverities := b.IncludeExcludeValues(query.FieldSeverity)
+	codes, excludeCodes := b.IncludeExcludeValues(query.FieldCode)
+	filesInclude, filesExclude := b.IncludeExcludeValues(query.FieldFile)
+	langInclude, langExclude := b.IncludeExcludeValues(query.FieldLang)
+	filesInclude = append(filesInclude, mapSlice(langInclude, query.LangToFileRegexp)...)
+	filesExclude = append(filesExclude, mapSlice(langExclude, query.LangToFileRegexp)...)
+
+	return &search.DiagnosticPatternInfo{
+		Pattern:           b.PatternString(),
+		IsCaseSensitive:   b.IsCaseSensitive(),
+		Severities:        severities,
+		ExcludeSeverities: excludeSeverities,
+		Codes:             codes,
+		ExcludeCodes:      excludeCodes,
+		IncludePatterns:   filesInclude,
+		ExcludePatterns:   filesExclude,
+		Limit:             limit,
+	}, nil
+}
+
 // computeResultTypes returns result types based three inputs: `type:...` in the query,
 // the `pattern`, and top-level `searchType` (coming from a GQL value).
 func computeResultTypes(b query.Basic, searchType query.SearchType) result.Types {
@@ -772,6 +823,7 @@ func toRepoOptions(b query.Basic, userSettings *schema.Settings) search.RepoOpti
 		UseIndex:            b.Index(),
 		HasKVPs:             b.RepoHasKVPs(),
 		HasTopics:           b.RepoHasTopics(),
+		HasLanguages:        b.RepoHasLanguages(),
 	}
 }
 
@@ -1051,6 +1103,12 @@ func isGlobal(op search.RepoOptions) bool {
 		return false
 	}
 
+	// Zoekt does not know about repo language statistics, so we depend on
+	// the database to handle this filter.
+	if len(op.HasLanguages) > 0 {
+		return false
+	}
+
 	// If a search context is specified, we do not know ahead of time whether
 	// the repos in the context are indexed and we need to go through the repo
 	// resolution process.
@@ -45,6 +45,7 @@ func TestNewPlanJob(t *testing.T) {
 		query      string
 		protocol   search.Protocol
 		searchType query.SearchType
+		features   search.Features
 		want       autogold.Value
 	}{{
 		query:      `foo context:@userA`,
@@ -788,6 +789,60 @@ func TestNewPlanJob(t *testing.T) {
             (patternInfo.pattern . (:[_]))
             (patternInfo.isStructural . true)
             (patternInfo.fileMatchLimit . 500)))))))`),
+		}, {
+			query:      `type:symbol ^Deprecated select:symbol.function.references`,
+			protocol:   search.Streaming,
+			searchType: query.SearchTypeRegex,
+			want: autogold.Expect(`
+(LOG
+  (ALERT
+    (query . )
+    (originalQuery . )
+    (patternType . regex)
+    (TIMEOUT
+      (timeout . 20s)
+      (LIMIT
+        (limit . 500)
+        UNIMPLEMENTED))))`),
+		}, {
+			query:      `repo:sourcegraph/sourcegraph foo`,
+			protocol:   search.Streaming,
+			searchType: query.SearchTypeLiteral,
+			features:   search.Features{DocumentRanks: true},
+			want: autogold.Expect(`
+(LOG
+  (ALERT
+    (query . )
+    (originalQuery . )
+    (patternType . literal)
+    (TIMEOUT
+      (timeout . 20s)
+      (LIMIT
+        (limit . 500)
+        (INTERLEAVE
+          (window . 500ms)
+          (SEQUENTIAL
+            (ensureUnique . false)
+            (REPOPAGER
+              (repoOpts.repoFilters . [sourcegraph/sourcegraph])
+              (PARTIALREPOS
+                (ZOEKTREPOSUBSETTEXTSEARCH
+                  (query . substr:"foo")
+                  (type . text))))
+            (REPOPAGER
+              (repoOpts.repoFilters . [sourcegraph/sourcegraph])
+              (PARTIALREPOS
+                (DOCUMENTRANK
+                  (SEARCHERTEXTSEARCH
+                    (indexed . false)))))
+            (REPOSEARCH
+              (repoOpts.repoFilters . [sourcegraph/sourcegraph foo])
+              (repoNamePatterns . [(?i)sourcegraph/sourcegraph (?i)foo])))
+          (REPOSCOMPUTEEXCLUDED
+            (repoOpts.repoFilters . [sourcegraph/sourcegraph]))
+          (PARALLEL
+            NOOP
+            NOOP))))))`),
 		},
 	}
 
@@ -800,7 +855,7 @@ func TestNewPlanJob(t *testing.T) {
 				UserSettings:        &schema.Settings{},
 				PatternType:         tc.searchType,
 				Protocol:            tc.protocol,
-				Features:            &search.Features{},
+				Features:            &tc.features,
 				OnSourcegraphDotCom: true,
 			}
 
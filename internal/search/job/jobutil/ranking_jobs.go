@@ -0,0 +1,187 @@
+package jobutil
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sourcegraph/conc/pool"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/ranking"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+)
+
+// NewDocumentRankJob creates a job which orders the results of each event
+// sent by child by their precise document rank. It is used for backends
+// which, unlike Zoekt, don't rank results themselves.
+func NewDocumentRankJob(child job.Job) job.Job {
+	return &documentRankJob{
+		lookup: ranking.DefaultLookup(),
+		child:  child,
+	}
+}
+
+type documentRankJob struct {
+	lookup *ranking.Lookup
+	child  job.Job
+}
+
+func (j *documentRankJob) Name() string {
+	return "DocumentRankJob"
+}
+
+func (j *documentRankJob) Attributes(job.Verbosity) []attribute.KeyValue { return nil }
+
+func (j *documentRankJob) Children() []job.Describer {
+	return []job.Describer{j.child}
+}
+
+func (j *documentRankJob) MapChildren(fn job.MapFunc) job.Job {
+	cp := *j
+	cp.child = job.Map(j.child, fn)
+	return &cp
+}
+
+func (j *documentRankJob) Run(ctx context.Context, clients job.RuntimeClients, s streaming.Sender) (alert *search.Alert, err error) {
+	_, ctx, s, finish := job.StartSpan(ctx, s, j)
+	defer func() { finish(alert, err) }()
+
+	return j.child.Run(ctx, clients, streaming.StreamFunc(func(event streaming.SearchEvent) {
+		j.lookup.SortMatches(ctx, event.Results)
+		s.Send(event)
+	}))
+}
+
+// NewInterleaveJob creates a job which runs its children in parallel, like
+// ParallelJob, but instead of forwarding results as they arrive it collects
+// them for window and then interleaves the results of all children by their
+// precise document rank. The order of results within each child is
+// preserved.
+func NewInterleaveJob(window time.Duration, children ...job.Job) job.Job {
+	if len(children) == 0 {
+		return &NoopJob{}
+	}
+	if len(children) == 1 {
+		return children[0]
+	}
+	return &interleaveJob{
+		lookup:   ranking.DefaultLookup(),
+		window:   window,
+		children: children,
+	}
+}
+
+type interleaveJob struct {
+	lookup   *ranking.Lookup
+	window   time.Duration
+	children []job.Job
+}
+
+func (j *interleaveJob) Name() string {
+	return "InterleaveJob"
+}
+
+func (j *interleaveJob) Attributes(v job.Verbosity) (res []attribute.KeyValue) {
+	switch v {
+	case job.VerbosityMax:
+		fallthrough
+	case job.VerbosityBasic:
+		res = append(res,
+			attribute.Stringer("window", j.window),
+		)
+	}
+	return res
+}
+
+func (j *interleaveJob) Children() []job.Describer {
+	res := make([]job.Describer, len(j.children))
+	for i := range j.children {
+		res[i] = j.children[i]
+	}
+	return res
+}
+
+func (j *interleaveJob) MapChildren(fn job.MapFunc) job.Job {
+	cp := *j
+	cp.children = make([]job.Job, len(j.children))
+	for i := range j.children {
+		cp.children[i] = job.Map(j.children[i], fn)
+	}
+	return &cp
+}
+
+func (j *interleaveJob) Run(ctx context.Context, clients job.RuntimeClients, s streaming.Sender) (alert *search.Alert, err error) {
+	_, ctx, s, finish := job.StartSpan(ctx, s, j)
+	defer func() { finish(alert, err) }()
+
+	var (
+		mu      sync.Mutex
+		pending = make([][]ranking.ScoredMatch, len(j.children))
+	)
+
+	flush := func() {
+		mu.Lock()
+		defer mu.Unlock()
+
+		results := ranking.Interleave(pending...)
+		if len(results) == 0 {
+			return
+		}
+		for i := range pending {
+			pending[i] = nil
+		}
+		s.Send(streaming.SearchEvent{Results: results})
+	}
+
+	done := make(chan struct{})
+	flushed := make(chan struct{})
+	go func() {
+		defer close(flushed)
+		ticker := time.NewTicker(j.window)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				flush()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var (
+		pl         = pool.New().WithContext(ctx)
+		maxAlerter search.MaxAlerter
+	)
+	for i, child := range j.children {
+		i, child := i, child
+		pl.Go(func(ctx context.Context) error {
+			alert, err := child.Run(ctx, clients, streaming.StreamFunc(func(event streaming.SearchEvent) {
+				// Scoring may need to fetch ranks, so do it before
+				// taking the lock.
+				scored := j.lookup.ScoreMatches(ctx, event.Results)
+
+				mu.Lock()
+				pending[i] = append(pending[i], scored...)
+				mu.Unlock()
+
+				// Progress is not delayed, only results are.
+				if !event.Stats.Zero() {
+					s.Send(streaming.SearchEvent{Stats: event.Stats})
+				}
+			}))
+			maxAlerter.Add(alert)
+			return err
+		})
+	}
+	err = pl.Wait()
+
+	close(done)
+	<-flushed
+	flush()
+
+	return maxAlerter.Alert, err
+}
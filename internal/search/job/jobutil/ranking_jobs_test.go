@@ -0,0 +1,97 @@
+package jobutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/types"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/mockjob"
+	"github.com/sourcegraph/sourcegraph/internal/search/ranking"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	itypes "github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestDocumentRankJob(t *testing.T) {
+	child := mockjob.NewMockJob()
+	child.RunFunc.SetDefaultHook(func(_ context.Context, _ job.RuntimeClients, s streaming.Sender) (*search.Alert, error) {
+		s.Send(streaming.SearchEvent{Results: result.Matches{rankedFileMatch("a.go"), rankedFileMatch("b.go"), rankedFileMatch("c.go")}})
+		return nil, nil
+	})
+
+	j := &documentRankJob{lookup: newTestLookup(t), child: child}
+	agg := streaming.NewAggregatingStream()
+	_, err := j.Run(context.Background(), job.RuntimeClients{}, agg)
+	require.NoError(t, err)
+	require.Equal(t, []string{"c.go", "b.go", "a.go"}, matchPaths(agg.Results))
+}
+
+func TestInterleaveJob(t *testing.T) {
+	newChild := func(paths ...string) *mockjob.MockJob {
+		child := mockjob.NewMockJob()
+		child.RunFunc.SetDefaultHook(func(_ context.Context, _ job.RuntimeClients, s streaming.Sender) (*search.Alert, error) {
+			for _, path := range paths {
+				s.Send(streaming.SearchEvent{
+					Results: result.Matches{rankedFileMatch(path)},
+					Stats:   streaming.Stats{IsLimitHit: path == "a.go"},
+				})
+			}
+			return nil, nil
+		})
+		return child
+	}
+
+	j := &interleaveJob{
+		lookup:   newTestLookup(t),
+		window:   time.Hour,
+		children: []job.Job{newChild("a.go", "b.go"), newChild("c.go")},
+	}
+
+	var (
+		events []streaming.SearchEvent
+		stats  streaming.Stats
+	)
+	_, err := j.Run(context.Background(), job.RuntimeClients{}, streaming.StreamFunc(func(e streaming.SearchEvent) {
+		events = append(events, e)
+		stats.Update(&e.Stats)
+	}))
+	require.NoError(t, err)
+
+	// Stats are forwarded immediately while all results are sent together
+	// once the children are done, since the window never elapses.
+	require.True(t, stats.IsLimitHit)
+	require.Equal(t, []string{"c.go", "a.go", "b.go"}, matchPaths(events[len(events)-1].Results))
+}
+
+func newTestLookup(t *testing.T) *ranking.Lookup {
+	return ranking.NewLookup(logtest.Scoped(t), fakeRanksSource{
+		"a.go": 1,
+		"b.go": 2,
+		"c.go": 3,
+	})
+}
+
+type fakeRanksSource map[string]float64
+
+func (s fakeRanksSource) GetDocumentRanks(context.Context, api.RepoName) (types.RepoPathRanks, error) {
+	return types.RepoPathRanks{Paths: s}, nil
+}
+
+func rankedFileMatch(path string) *result.FileMatch {
+	return &result.FileMatch{File: result.File{Repo: itypes.MinimalRepo{Name: "r"}, Path: path}}
+}
+
+func matchPaths(matches result.Matches) []string {
+	paths := make([]string, 0, len(matches))
+	for _, m := range matches {
+		paths = append(paths, m.(*result.FileMatch).Path)
+	}
+	return paths
+}
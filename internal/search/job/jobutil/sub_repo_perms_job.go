@@ -128,6 +128,20 @@ func applySubRepoFiltering(ctx context.Context, checker authz.SubRepoPermissionC
 				continue
 			}
 
+			if perms.Include(authz.Read) {
+				filtered = append(filtered, m)
+			}
+		case *result.DiagnosticMatch:
+			content := authz.RepoContent{
+				Repo: mm.Repo.Name,
+				Path: mm.Path,
+			}
+			perms, err := authz.ActorPermissions(ctx, checker, a, content)
+			if err != nil {
+				errs = errors.Append(errs, err)
+				continue
+			}
+
 			if perms.Include(authz.Read) {
 				filtered = append(filtered, m)
 			}
@@ -0,0 +1,126 @@
+package query
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// CursorContext describes the syntactic context at a cursor position inside a
+// possibly incomplete query. It is used to drive query completions.
+type CursorContext struct {
+	// Field is the canonical name of the field the cursor is positioned in,
+	// e.g. "repo" for `r:sourcegr|`. It is FieldDefault if the cursor is in a
+	// search pattern.
+	Field string
+
+	// Negated is true if the field is negated, as in `-file:foo|`.
+	Negated bool
+
+	// Predicate is the name of the predicate the cursor is positioned in,
+	// e.g. "has.owner" for `file:has.owner(ali|`. It is empty if the cursor
+	// is not inside a predicate.
+	Predicate string
+
+	// Value is the partial value of the field, pattern, or predicate argument
+	// to the left of the cursor.
+	Value string
+
+	// Start is the byte offset at which Value starts in the input. A completion
+	// of Value replaces the range [Start, Start+len(Value)).
+	Start int
+}
+
+// ContextAt returns the CursorContext for the cursor at byte offset pos in
+// the query in. The query does not need to be valid; only the token to the
+// left of the cursor is inspected. Offsets outside of in are clamped.
+func ContextAt(in string, pos int) CursorContext {
+	if pos < 0 || pos > len(in) {
+		pos = len(in)
+	}
+	buf := in[:pos]
+
+	// Find the start of the token under the cursor, honoring balanced
+	// parentheses and quotes so that `repo:has.file(path:a b|` still
+	// resolves to the predicate.
+	start := tokenStart(buf)
+	token := buf[start:]
+
+	field, negated, advance := ScanField([]byte(token))
+	if advance == 0 {
+		return CursorContext{Field: FieldDefault, Value: token, Start: start}
+	}
+
+	ctx := CursorContext{
+		Field:   resolveFieldAlias(strings.ToLower(field)),
+		Negated: negated,
+		Value:   token[advance:],
+		Start:   start + advance,
+	}
+
+	// Detect an unterminated predicate like `has.owner(ali`.
+	if i := strings.IndexByte(ctx.Value, '('); i > 0 && !strings.HasSuffix(ctx.Value, ")") {
+		name := ctx.Value[:i]
+		if _, ok := DefaultPredicateRegistry[ctx.Field][name]; ok {
+			ctx.Predicate = name
+			ctx.Value = ctx.Value[i+1:]
+			ctx.Start += i + 1
+		}
+	}
+
+	return ctx
+}
+
+// tokenStart returns the byte offset of the start of the last whitespace
+// separated token in buf, excluding leading grouping parentheses. Whitespace
+// inside parentheses or quotes of a value does not separate tokens.
+func tokenStart(buf string) int {
+	var (
+		start  int
+		depth  int
+		quote  rune
+		escape bool
+	)
+	for i, r := range buf {
+		switch {
+		case escape:
+			escape = false
+		case r == '\\':
+			escape = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '(' && i == start:
+			// Grouping parenthesis opening an expression, as in
+			// `(repo:foo or repo:bar)`.
+			start = i + 1
+		case r == '(':
+			depth++
+		case r == ')':
+			if depth > 0 {
+				depth--
+			}
+		case depth == 0 && unicode.IsSpace(r):
+			start = i + utf8.RuneLen(r)
+		}
+	}
+	return start
+}
+
+// Fields returns the sorted canonical names of all fields recognized by the
+// parser. Aliases like `r:` are omitted.
+func Fields() []string {
+	fields := make([]string, 0, len(allFields))
+	for field := range allFields {
+		if _, ok := aliases[field]; ok {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
@@ -0,0 +1,78 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestContextAt(t *testing.T) {
+	cases := []struct {
+		query string
+		pos   int
+		want  CursorContext
+	}{
+		{
+			query: "foo",
+			pos:   -1,
+			want:  CursorContext{Value: "foo"},
+		},
+		{
+			query: "foo ",
+			pos:   -1,
+			want:  CursorContext{Start: 4},
+		},
+		{
+			query: "r:sourcegr",
+			pos:   -1,
+			want:  CursorContext{Field: FieldRepo, Value: "sourcegr", Start: 2},
+		},
+		{
+			query: "repo:foo -path:internal/sea bar",
+			pos:   len("repo:foo -path:internal/sea"),
+			want:  CursorContext{Field: FieldFile, Negated: true, Value: "internal/sea", Start: 15},
+		},
+		{
+			query: "(repo:foo or lang:g",
+			pos:   -1,
+			want:  CursorContext{Field: FieldLang, Value: "g", Start: 18},
+		},
+		{
+			query: "file:has.owner(ali",
+			pos:   -1,
+			want:  CursorContext{Field: FieldFile, Predicate: "has.owner", Value: "ali", Start: 15},
+		},
+		{
+			query: "repo:has.file(path:a b",
+			pos:   -1,
+			want:  CursorContext{Field: FieldRepo, Predicate: "has.file", Value: "path:a b", Start: 14},
+		},
+		{
+			query: "repo:has.file(a.go) fo",
+			pos:   -1,
+			want:  CursorContext{Value: "fo", Start: 20},
+		},
+		{
+			query: "unknown:foo",
+			pos:   -1,
+			want:  CursorContext{Value: "unknown:foo"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.query, func(t *testing.T) {
+			got := ContextAt(tc.query, tc.pos)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected context (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFields(t *testing.T) {
+	for _, field := range Fields() {
+		if _, ok := aliases[field]; ok {
+			t.Errorf("unexpected alias %q in fields", field)
+		}
+	}
+}
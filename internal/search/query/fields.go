@@ -27,6 +27,10 @@ const (
 	FieldCommitter = "committer"
 	FieldMessage   = "message"
 
+	// For diagnostic search only:
+	FieldSeverity = "severity"
+	FieldCode     = "code"
+
 	// Temporary experimental fields:
 	FieldIndex     = "index"
 	FieldCount     = "count" // Searches that specify `count:` will fetch at least that number of results, or the full result set
@@ -64,6 +68,8 @@ var allFields = map[string]struct{}{
 	FieldMessage:            empty,
 	"m":                     empty,
 	"msg":                   empty,
+	FieldSeverity:           empty,
+	FieldCode:               empty,
 	FieldIndex:              empty,
 	FieldCount:              empty,
 	FieldTimeout:            empty,
@@ -2,8 +2,10 @@ package query
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/go-enry/go-enry/v2"
 	"github.com/grafana/regexp"
 	"github.com/grafana/regexp/syntax"
 
@@ -40,6 +42,7 @@ var DefaultPredicateRegistry = PredicateRegistry{
 		"has.key":               func() Predicate { return &RepoHasKeyPredicate{} },
 		"has.meta":              func() Predicate { return &RepoHasMetaPredicate{} },
 		"has.topic":             func() Predicate { return &RepoHasTopicPredicate{} },
+		"has.language":          func() Predicate { return &RepoHasLanguagePredicate{} },
 
 		// Deprecated predicates
 		"contains": func() Predicate { return &RepoContainsPredicate{} },
@@ -483,6 +486,51 @@ func (p *RepoHasTopicPredicate) Unmarshal(params string, negated bool) (err erro
 func (p *RepoHasTopicPredicate) Field() string { return FieldRepo }
 func (p *RepoHasTopicPredicate) Name() string  { return "has.topic" }
 
+// RepoHasLanguagePredicate represents the `repo:has.language(go, min:30%)`
+// predicate, which matches repositories where at least MinPercent of the
+// default branch is written in Language.
+type RepoHasLanguagePredicate struct {
+	Language   string
+	MinPercent float64
+	Negated    bool
+}
+
+func (p *RepoHasLanguagePredicate) Unmarshal(params string, negated bool) error {
+	language, minimum, hasMinimum := strings.Cut(params, ",")
+	language = strings.TrimSpace(language)
+	if language == "" {
+		return errors.New("language must be non-empty")
+	}
+	// Use the canonical language name if we know it, since that is what
+	// language statistics are stored under.
+	if canonical, ok := enry.GetLanguageByAlias(language); ok {
+		language = canonical
+	}
+
+	if hasMinimum {
+		minimum = strings.TrimSpace(minimum)
+		if !strings.HasPrefix(minimum, "min:") {
+			return errors.Errorf("expected a minimum percentage of the form min:30%%, got %q", minimum)
+		}
+		value := strings.TrimSpace(strings.TrimPrefix(minimum, "min:"))
+		percent, err := strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64)
+		if err != nil {
+			return errors.Errorf("invalid minimum percentage %q", value)
+		}
+		if percent < 0 || percent > 100 {
+			return errors.Errorf("minimum percentage must be between 0%% and 100%%, got %q", value)
+		}
+		p.MinPercent = percent
+	}
+
+	p.Language = language
+	p.Negated = negated
+	return nil
+}
+
+func (p *RepoHasLanguagePredicate) Field() string { return FieldRepo }
+func (p *RepoHasLanguagePredicate) Name() string  { return "has.language" }
+
 // RepoContainsPredicate represents the `repo:contains(file:a content:b)` predicate.
 // DEPRECATED: this syntax is deprecated in favor of `repo:contains.file`.
 type RepoContainsPredicate struct {
@@ -147,6 +147,41 @@ func TestRepoHasTopicPredicate(t *testing.T) {
 	})
 }
 
+func TestRepoHasLanguagePredicate(t *testing.T) {
+	t.Run("Unmarshal", func(t *testing.T) {
+		valid := []struct {
+			params   string
+			expected RepoHasLanguagePredicate
+		}{
+			{`go`, RepoHasLanguagePredicate{Language: "Go"}},
+			{`golang, min:30%`, RepoHasLanguagePredicate{Language: "Go", MinPercent: 30}},
+			{`TypeScript,min:12.5`, RepoHasLanguagePredicate{Language: "TypeScript", MinPercent: 12.5}},
+			{`unknown-lang`, RepoHasLanguagePredicate{Language: "unknown-lang"}},
+		}
+		for _, tc := range valid {
+			t.Run(tc.params, func(t *testing.T) {
+				var p RepoHasLanguagePredicate
+				require.NoError(t, p.Unmarshal(tc.params, false))
+				require.Equal(t, tc.expected, p)
+			})
+		}
+
+		invalid := []string{``, ` , min:10%`, `go, 30%`, `go, min:lots`, `go, min:130%`, `go, min:-1`}
+		for _, params := range invalid {
+			t.Run(params, func(t *testing.T) {
+				var p RepoHasLanguagePredicate
+				require.Error(t, p.Unmarshal(params, false))
+			})
+		}
+	})
+
+	t.Run("sets negated", func(t *testing.T) {
+		var p RepoHasLanguagePredicate
+		require.NoError(t, p.Unmarshal("go", true))
+		require.True(t, p.Negated)
+	})
+}
+
 func TestRepoHasKVPMetaPredicate(t *testing.T) {
 	t.Run("Unmarshal", func(t *testing.T) {
 		type test struct {
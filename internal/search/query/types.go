@@ -483,6 +483,13 @@ func (p Parameters) RepoHasTopics() (res []RepoHasTopicPredicate) {
 	return res
 }
 
+func (p Parameters) RepoHasLanguages() (res []RepoHasLanguagePredicate) {
+	VisitTypedPredicate(toNodes(p), func(pred *RepoHasLanguagePredicate) {
+		res = append(res, *pred)
+	})
+	return res
+}
+
 func (p Parameters) FileHasOwner() (include, exclude []string) {
 	VisitTypedPredicate(toNodes(p), func(pred *FileHasOwnerPredicate) {
 		if pred.Negated {
@@ -222,6 +222,13 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 		return err
 	}
 
+	isValidSeverity := func() error {
+		if _, ok := ParseDiagnosticSeverity(value); !ok {
+			return errors.Errorf("invalid value %q for field %q. Valid values are: %s", value, field, strings.Join(DiagnosticSeverities, ", "))
+		}
+		return nil
+	}
+
 	isValidGitDate := func() error {
 		_, err := ParseGitDate(value, time.Now)
 		return err
@@ -278,6 +285,12 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 		FieldCommitter,
 		FieldMessage:
 		return satisfies(isValidRegexp)
+	case
+		FieldSeverity:
+		return satisfies(isValidSeverity)
+	case
+		FieldCode:
+		// Diagnostic codes are tool-specific and are matched exactly.
 	case
 		FieldIndex,
 		FieldFork,
@@ -358,6 +371,24 @@ func validateCommitParameters(nodes []Node) error {
 	return nil
 }
 
+// Queries containing diagnostic parameters without type:diagnostic are not valid.
+func validateDiagnosticParameters(nodes []Node) error {
+	var seenDiagnosticParam string
+	var typeDiagnosticExists bool
+	VisitParameter(nodes, func(field, value string, _ bool, _ Annotation) {
+		if field == FieldSeverity || field == FieldCode {
+			seenDiagnosticParam = field
+		}
+		if field == FieldType && value == "diagnostic" {
+			typeDiagnosticExists = true
+		}
+	})
+	if seenDiagnosticParam != "" && !typeDiagnosticExists {
+		return errors.Errorf(`your query contains the field '%s', which requires type:diagnostic in the query`, seenDiagnosticParam)
+	}
+	return nil
+}
+
 func validateTypeStructural(nodes []Node) error {
 	seenStructural := false
 	seenType := false
@@ -498,11 +529,27 @@ func validate(nodes []Node) error {
 		validateRepoRevPair,
 		validateRepoHasFile,
 		validateCommitParameters,
+		validateDiagnosticParameters,
 		validateTypeStructural,
 		validateRefGlobs,
 	)
 }
 
+// DiagnosticSeverities are the valid values of the severity field, from most to
+// least severe.
+var DiagnosticSeverities = []string{"error", "warning", "information", "hint"}
+
+// ParseDiagnosticSeverity returns the canonical form of the given value of the
+// severity field, and false if the value is not a known severity.
+func ParseDiagnosticSeverity(s string) (string, bool) {
+	for _, severity := range DiagnosticSeverities {
+		if strings.EqualFold(s, severity) {
+			return severity, true
+		}
+	}
+	return "", false
+}
+
 type YesNoOnly string
 
 const (
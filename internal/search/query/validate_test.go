@@ -95,6 +95,14 @@ func TestValidation(t *testing.T) {
 			input: "repo:foo author:rob@saucegraph.com",
 			want:  `your query contains the field 'author', which requires type:commit or type:diff in the query`,
 		},
+		{
+			input: "repo:foo severity:warning",
+			want:  `your query contains the field 'severity', which requires type:diagnostic in the query`,
+		},
+		{
+			input: "type:diagnostic severity:fatal",
+			want:  `invalid value "fatal" for field "severity". Valid values are: error, warning, information, hint`,
+		},
 		{
 			input: "repohasfile:README type:symbol yolo",
 			want:  "repohasfile is not compatible for type:symbol. Subscribe to https://github.com/sourcegraph/sourcegraph/issues/4610 for updates",
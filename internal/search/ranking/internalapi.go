@@ -1,4 +1,4 @@
-package repo
+package ranking
 
 import (
 	"context"
@@ -8,13 +8,34 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/api/internalapi"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/types"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
-func getDocumentRanks(ctx context.Context, repoName string) (types.RepoPathRanks, error) {
+// internalAPISource fetches document ranks from the frontend's internal API.
+type internalAPISource struct{}
+
+func (internalAPISource) GetDocumentRanks(ctx context.Context, repoName api.RepoName) (types.RepoPathRanks, error) {
+	return GetDocumentRanks(ctx, string(repoName))
+}
+
+// statusError is returned when the internal API responds with an unexpected
+// status code.
+type statusError struct {
+	code int
+	err  error
+}
+
+func (e *statusError) Error() string  { return e.err.Error() }
+func (e *statusError) NotFound() bool { return e.code == http.StatusNotFound }
+
+// GetDocumentRanks fetches the document ranks of repoName from the
+// frontend's internal API. It can be used by any service. If the repository
+// has no ranks, the error satisfies errcode.IsNotFound.
+func GetDocumentRanks(ctx context.Context, repoName string) (types.RepoPathRanks, error) {
 	root, err := url.Parse(internalapi.Client.URL)
 	if err != nil {
 		return types.RepoPathRanks{}, err
@@ -32,28 +53,22 @@ func getDocumentRanks(ctx context.Context, repoName string) (types.RepoPathRanks
 	if err != nil {
 		return types.RepoPathRanks{}, err
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		b, err := io.ReadAll(io.LimitReader(resp.Body, 1024))
-		_ = resp.Body.Close()
 		if err != nil {
 			return types.RepoPathRanks{}, err
 		}
 		return types.RepoPathRanks{}, &url.Error{
 			Op:  "Get",
 			URL: u.String(),
-			Err: errors.Errorf("%s: %s", resp.Status, string(b)),
+			Err: &statusError{code: resp.StatusCode, err: errors.Errorf("%s: %s", resp.Status, string(b))},
 		}
 	}
 
-	b, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return types.RepoPathRanks{}, err
-	}
-
 	ranks := types.RepoPathRanks{}
-	err = json.Unmarshal(b, &ranks)
-	if err != nil {
+	if err := json.NewDecoder(resp.Body).Decode(&ranks); err != nil {
 		return types.RepoPathRanks{}, err
 	}
 
@@ -0,0 +1,63 @@
+package ranking
+
+import (
+	"context"
+	"sort"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+// ScoredMatch is a search result together with its ranking score.
+type ScoredMatch struct {
+	Match result.Match
+	Score float64
+}
+
+// ScoreMatches scores each match with l.
+func (l *Lookup) ScoreMatches(ctx context.Context, matches result.Matches) []ScoredMatch {
+	scored := make([]ScoredMatch, 0, len(matches))
+	for _, m := range matches {
+		scored = append(scored, ScoredMatch{Match: m, Score: l.Score(ctx, m)})
+	}
+	return scored
+}
+
+// SortMatches orders matches by descending score. Matches with equal scores
+// keep their relative order.
+func (l *Lookup) SortMatches(ctx context.Context, matches result.Matches) {
+	scored := l.ScoreMatches(ctx, matches)
+	sort.SliceStable(scored, func(i, j int) bool {
+		return scored[i].Score > scored[j].Score
+	})
+	for i := range scored {
+		matches[i] = scored[i].Match
+	}
+}
+
+// Interleave merges the results of several backends into a single list.
+// Each backend's results are assumed to already be in the order the backend
+// prefers, which is preserved. At each step the highest scoring head of any
+// list is taken next, with ties going to the list which appears first.
+func Interleave(lists ...[]ScoredMatch) result.Matches {
+	total := 0
+	for _, l := range lists {
+		total += len(l)
+	}
+
+	merged := make(result.Matches, 0, total)
+	heads := make([]int, len(lists))
+	for len(merged) < total {
+		best := -1
+		for i, l := range lists {
+			if heads[i] >= len(l) {
+				continue
+			}
+			if best == -1 || l[heads[i]].Score > lists[best][heads[best]].Score {
+				best = i
+			}
+		}
+		merged = append(merged, lists[best][heads[best]].Match)
+		heads[best]++
+	}
+	return merged
+}
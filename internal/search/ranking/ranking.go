@@ -0,0 +1,134 @@
+// Package ranking exposes the precise document ranks computed by code
+// intelligence to the search backends that don't receive them from Zoekt.
+//
+// Zoekt is given document ranks when a repository is indexed. Searcher
+// results (unindexed repositories, non-default branches) and results merged
+// across backends never see those ranks, so we look them up here instead.
+package ranking
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/types"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+const (
+	defaultCacheSize = 1000
+	defaultCacheTTL  = 10 * time.Minute
+
+	// errorCacheTTL is how long a failure to fetch ranks is remembered, so
+	// that a broken backend is not asked again for every result.
+	errorCacheTTL = 30 * time.Second
+)
+
+// DocumentRanksSource returns the document ranks of a repository. It is
+// implemented by the code intelligence ranking service.
+type DocumentRanksSource interface {
+	GetDocumentRanks(ctx context.Context, repoName api.RepoName) (types.RepoPathRanks, error)
+}
+
+// Lookup resolves ranking scores for search results. Document ranks are
+// cached per repository, since a single search typically returns many
+// results from the same repository.
+type Lookup struct {
+	logger   log.Logger
+	source   DocumentRanksSource
+	cache    *lru.Cache[api.RepoName, cachedRanks]
+	ttl      time.Duration
+	errorTTL time.Duration
+	now      func() time.Time
+}
+
+type cachedRanks struct {
+	ranks     types.RepoPathRanks
+	expiresAt time.Time
+}
+
+// NewLookup returns a Lookup which fetches document ranks from source.
+func NewLookup(logger log.Logger, source DocumentRanksSource) *Lookup {
+	cache, _ := lru.New[api.RepoName, cachedRanks](defaultCacheSize) // only errors on non-positive size
+	return &Lookup{
+		logger:   logger,
+		source:   source,
+		cache:    cache,
+		ttl:      defaultCacheTTL,
+		errorTTL: errorCacheTTL,
+		now:      time.Now,
+	}
+}
+
+var (
+	defaultLookupOnce sync.Once
+	defaultLookup     *Lookup
+)
+
+// DefaultLookup returns a process-wide Lookup backed by the frontend's
+// internal document ranks API.
+func DefaultLookup() *Lookup {
+	defaultLookupOnce.Do(func() {
+		defaultLookup = NewLookup(log.Scoped("searchRanking", "document rank lookups for search results"), internalAPISource{})
+	})
+	return defaultLookup
+}
+
+// DocumentRanks returns the document ranks of repoName. Repositories that
+// have not been ranked are cached like ranked ones. Other failures are logged,
+// cached for a short time and treated as the repository having no ranks, so
+// ranking never fails a search.
+func (l *Lookup) DocumentRanks(ctx context.Context, repoName api.RepoName) types.RepoPathRanks {
+	if cached, ok := l.cache.Get(repoName); ok && l.now().Before(cached.expiresAt) {
+		return cached.ranks
+	}
+
+	ranks, err := l.source.GetDocumentRanks(ctx, repoName)
+	if err != nil && !errcode.IsNotFound(err) {
+		if ctx.Err() != nil {
+			return types.RepoPathRanks{}
+		}
+		l.logger.Warn("failed to fetch document ranks", log.String("repo", string(repoName)), log.Error(err))
+		l.cache.Add(repoName, cachedRanks{expiresAt: l.now().Add(l.errorTTL)})
+		return types.RepoPathRanks{}
+	}
+
+	l.cache.Add(repoName, cachedRanks{ranks: ranks, expiresAt: l.now().Add(l.ttl)})
+	return ranks
+}
+
+// Score returns the ranking score of a search result in the range [0, 1).
+// Higher scores should be shown first. Only file matches carry a document
+// rank; all other results score 0.
+func (l *Lookup) Score(ctx context.Context, m result.Match) float64 {
+	fm, ok := m.(*result.FileMatch)
+	if !ok {
+		return 0
+	}
+	return Score(l.DocumentRanks(ctx, fm.Repo.Name), fm.Path)
+}
+
+// Score returns the ranking score of path given the document ranks of its
+// repository. Paths in repositories without precise ranks are assumed to be
+// of average importance, while paths missing from a ranked repository have
+// no known references and score 0.
+func Score(ranks types.RepoPathRanks, path string) float64 {
+	if len(ranks.Paths) == 0 {
+		return squashRange(ranks.MeanRank)
+	}
+	return squashRange(ranks.Paths[path])
+}
+
+// squashRange maps a value in the range [0, inf) to a value in the range
+// [0, 1) monotonically.
+func squashRange(j float64) float64 {
+	if j <= 0 {
+		return 0
+	}
+	return j / (1 + j)
+}
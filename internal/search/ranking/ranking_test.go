@@ -0,0 +1,143 @@
+package ranking
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/types"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	itypes "github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestLookup(t *testing.T) {
+	source := &fakeSource{ranks: map[api.RepoName]types.RepoPathRanks{
+		"ranked": {MeanRank: 1, Paths: map[string]float64{"popular.go": 9, "niche.go": 1}},
+		"mean":   {MeanRank: 3},
+	}}
+	lookup := NewLookup(logtest.Scoped(t), source)
+
+	now := time.Now()
+	lookup.now = func() time.Time { return now }
+
+	for _, tc := range []struct {
+		match result.Match
+		want  float64
+	}{
+		{fileMatch("ranked", "popular.go"), 0.9},
+		{fileMatch("ranked", "niche.go"), 0.5},
+		{fileMatch("ranked", "unreferenced.go"), 0},
+		{fileMatch("mean", "any.go"), 0.75},
+		{fileMatch("missing", "any.go"), 0},
+		{fileMatch("missing", "other.go"), 0},
+		{&result.RepoMatch{Name: "ranked"}, 0},
+	} {
+		if got := lookup.Score(context.Background(), tc.match); got != tc.want {
+			t.Errorf("unexpected score for %v, want %v got %v", tc.match.Key(), tc.want, got)
+		}
+	}
+
+	if want := 3; source.calls != want {
+		t.Errorf("expected ranks to be fetched once per repository, want %d got %d", want, source.calls)
+	}
+
+	now = now.Add(defaultCacheTTL)
+	lookup.Score(context.Background(), fileMatch("ranked", "popular.go"))
+	if want := 4; source.calls != want {
+		t.Errorf("expected expired ranks to be fetched again, want %d got %d", want, source.calls)
+	}
+}
+
+func TestLookupErrors(t *testing.T) {
+	source := &fakeSource{err: errors.New("boom")}
+	lookup := NewLookup(logtest.Scoped(t), source)
+
+	now := time.Now()
+	lookup.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		if got := lookup.Score(context.Background(), fileMatch("failing", "any.go")); got != 0 {
+			t.Errorf("expected failing repository to score 0, got %v", got)
+		}
+	}
+	if want := 1; source.calls != want {
+		t.Errorf("expected failures to be cached, want %d calls got %d", want, source.calls)
+	}
+
+	now = now.Add(errorCacheTTL)
+	lookup.Score(context.Background(), fileMatch("failing", "any.go"))
+	if want := 2; source.calls != want {
+		t.Errorf("expected failures to be retried after %s, want %d calls got %d", errorCacheTTL, want, source.calls)
+	}
+}
+
+func TestSortMatches(t *testing.T) {
+	lookup := NewLookup(logtest.Scoped(t), &fakeSource{ranks: map[api.RepoName]types.RepoPathRanks{
+		"r": {Paths: map[string]float64{"b.go": 2, "c.go": 4}},
+	}})
+
+	matches := result.Matches{fileMatch("r", "a.go"), fileMatch("r", "b.go"), fileMatch("r", "c.go"), fileMatch("r", "d.go")}
+	lookup.SortMatches(context.Background(), matches)
+
+	if diff := cmp.Diff([]string{"c.go", "b.go", "a.go", "d.go"}, paths(matches)); diff != "" {
+		t.Errorf("unexpected order (-want +got):\n%s", diff)
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	zoekt := []ScoredMatch{
+		{Match: fileMatch("r", "z1.go"), Score: 0.2},
+		{Match: fileMatch("r", "z2.go"), Score: 0.9},
+	}
+	searcher := []ScoredMatch{
+		{Match: fileMatch("r", "s1.go"), Score: 0.5},
+		{Match: fileMatch("r", "s2.go"), Score: 0.2},
+	}
+
+	// z2 scores highest, but zoekt ranked z1 before it so it must come
+	// first. Ties go to the first list.
+	want := []string{"s1.go", "z1.go", "z2.go", "s2.go"}
+	if diff := cmp.Diff(want, paths(Interleave(zoekt, searcher))); diff != "" {
+		t.Errorf("unexpected order (-want +got):\n%s", diff)
+	}
+
+	if got := Interleave(nil, nil); len(got) != 0 {
+		t.Errorf("expected no results, got %d", len(got))
+	}
+}
+
+func fileMatch(repo, path string) *result.FileMatch {
+	return &result.FileMatch{File: result.File{Repo: itypes.MinimalRepo{Name: api.RepoName(repo)}, Path: path}}
+}
+
+func paths(matches result.Matches) []string {
+	ps := make([]string, 0, len(matches))
+	for _, m := range matches {
+		ps = append(ps, m.(*result.FileMatch).Path)
+	}
+	return ps
+}
+
+type fakeSource struct {
+	ranks map[api.RepoName]types.RepoPathRanks
+	err   error
+	calls int
+}
+
+func (s *fakeSource) GetDocumentRanks(_ context.Context, repoName api.RepoName) (types.RepoPathRanks, error) {
+	s.calls++
+	if s.err != nil {
+		return types.RepoPathRanks{}, s.err
+	}
+	ranks, ok := s.ranks[repoName]
+	if !ok {
+		return types.RepoPathRanks{}, &statusError{code: http.StatusNotFound, err: errors.New("not found")}
+	}
+	return ranks, nil
+}
@@ -150,6 +150,15 @@ func (r *Resolver) Resolve(ctx context.Context, op search.RepoOptions) (_ Resolv
 		})
 	}
 
+	languageFilters := make([]database.RepoLanguageFilter, 0, len(op.HasLanguages))
+	for _, filter := range op.HasLanguages {
+		languageFilters = append(languageFilters, database.RepoLanguageFilter{
+			Language:   filter.Language,
+			MinPercent: filter.MinPercent,
+			Negated:    filter.Negated,
+		})
+	}
+
 	options := database.ReposListOptions{
 		IncludePatterns:       includePatterns,
 		ExcludePattern:        query.UnionRegExps(excludePatterns),
@@ -157,6 +166,7 @@ func (r *Resolver) Resolve(ctx context.Context, op search.RepoOptions) (_ Resolv
 		CaseSensitivePatterns: op.CaseSensitiveRepoFilters,
 		KVPFilters:            kvpFilters,
 		TopicFilters:          topicFilters,
+		LanguageFilters:       languageFilters,
 		Cursors:               op.Cursors,
 		// List N+1 repos so we can see if there are repos omitted due to our repo limit.
 		LimitOffset:  &database.LimitOffset{Limit: limit + 1},
@@ -0,0 +1,79 @@
+package result
+
+import (
+	"path"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/filter"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// Diagnostic is a single diagnostic (compiler error, lint warning, deprecation notice, etc.)
+// reported by an indexer and attached to a range of a file.
+type Diagnostic struct {
+	// Severity is one of "error", "warning", "information", or "hint".
+	Severity string
+	Code     string
+	Message  string
+	Source   string
+	Range    Range
+}
+
+// DiagnosticMatch is a collection of diagnostics attached to a single file.
+type DiagnosticMatch struct {
+	File
+
+	Diagnostics []Diagnostic
+
+	LimitHit bool
+}
+
+func (dm *DiagnosticMatch) RepoName() types.MinimalRepo {
+	return dm.File.Repo
+}
+
+func (dm *DiagnosticMatch) ResultCount() int {
+	return len(dm.Diagnostics)
+}
+
+func (dm *DiagnosticMatch) Select(selectPath filter.SelectPath) Match {
+	switch selectPath.Root() {
+	case filter.Repository:
+		return &RepoMatch{
+			Name: dm.Repo.Name,
+			ID:   dm.Repo.ID,
+		}
+	case filter.File:
+		fm := &FileMatch{File: dm.File}
+		if len(selectPath) > 1 && selectPath[1] == "directory" {
+			fm.Path = path.Clean(path.Dir(fm.Path)) + "/" // Add trailing slash for clarity.
+		}
+		return fm
+	}
+	return nil
+}
+
+func (dm *DiagnosticMatch) Limit(limit int) int {
+	if limit < len(dm.Diagnostics) {
+		dm.Diagnostics = dm.Diagnostics[:limit]
+		dm.LimitHit = true
+		return 0
+	}
+	return limit - len(dm.Diagnostics)
+}
+
+func (dm *DiagnosticMatch) Key() Key {
+	k := Key{
+		TypeRank: rankDiagnosticMatch,
+		Repo:     dm.Repo.Name,
+		Commit:   dm.CommitID,
+		Path:     dm.Path,
+	}
+
+	if dm.InputRev != nil {
+		k.Rev = *dm.InputRev
+	}
+
+	return k
+}
+
+func (dm *DiagnosticMatch) searchResultMarker() {}
@@ -21,6 +21,7 @@ const (
 	TypeDiff
 	TypeCommit
 	TypeStructural
+	TypeDiagnostic
 )
 
 var TypeFromString = map[string]Types{
@@ -31,6 +32,7 @@ var TypeFromString = map[string]Types{
 	"diff":       TypeDiff,
 	"commit":     TypeCommit,
 	"structural": TypeStructural,
+	"diagnostic": TypeDiagnostic,
 }
 
 func (r Types) Has(t Types) bool {
@@ -135,6 +135,8 @@ func (r *eventMatchUnmarshaller) UnmarshalJSON(b []byte) error {
 		r.EventMatch = &EventSymbolMatch{}
 	case CommitMatchType:
 		r.EventMatch = &EventCommitMatch{}
+	case DiagnosticMatchType:
+		r.EventMatch = &EventDiagnosticMatch{}
 	default:
 		return errors.Errorf("unknown MatchType %v", typeU.Type)
 	}
@@ -200,6 +200,32 @@ type EventTeamMatch struct {
 
 func (e *EventTeamMatch) eventMatch() {}
 
+// EventDiagnosticMatch is a collection of diagnostics reported by an indexer
+// for a single file.
+type EventDiagnosticMatch struct {
+	// Type is always DiagnosticMatchType. Included here for marshalling.
+	Type MatchType `json:"type"`
+
+	Path            string       `json:"path"`
+	RepositoryID    int32        `json:"repositoryID"`
+	Repository      string       `json:"repository"`
+	RepoStars       int          `json:"repoStars,omitempty"`
+	RepoLastFetched *time.Time   `json:"repoLastFetched,omitempty"`
+	Branches        []string     `json:"branches,omitempty"`
+	Commit          string       `json:"commit,omitempty"`
+	Diagnostics     []Diagnostic `json:"diagnostics"`
+}
+
+func (e *EventDiagnosticMatch) eventMatch() {}
+
+type Diagnostic struct {
+	Severity string `json:"severity"`
+	Code     string `json:"code,omitempty"`
+	Message  string `json:"message"`
+	Source   string `json:"source,omitempty"`
+	Range    Range  `json:"range"`
+}
+
 // EventFilter is a suggestion for a search filter. Currently has a 1-1
 // correspondance with the SearchFilter graphql type.
 type EventFilter struct {
@@ -247,6 +273,7 @@ const (
 	PathMatchType
 	PersonMatchType
 	TeamMatchType
+	DiagnosticMatchType
 )
 
 func (t MatchType) MarshalJSON() ([]byte, error) {
@@ -265,6 +292,8 @@ func (t MatchType) MarshalJSON() ([]byte, error) {
 		return []byte(`"person"`), nil
 	case TeamMatchType:
 		return []byte(`"team"`), nil
+	case DiagnosticMatchType:
+		return []byte(`"diagnostic"`), nil
 	default:
 		return nil, errors.Errorf("unknown MatchType: %d", t)
 	}
@@ -285,6 +314,8 @@ func (t *MatchType) UnmarshalJSON(b []byte) error {
 		*t = PersonMatchType
 	} else if bytes.Equal(b, []byte(`"team"`)) {
 		*t = TeamMatchType
+	} else if bytes.Equal(b, []byte(`"diagnostic"`)) {
+		*t = DiagnosticMatchType
 	} else {
 		return errors.Errorf("unknown MatchType: %s", b)
 	}
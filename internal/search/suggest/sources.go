@@ -0,0 +1,480 @@
+package suggest
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-enry/go-enry/v2/data"
+	"github.com/grafana/regexp"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/envvar"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/client"
+	"github.com/sourcegraph/sourcegraph/internal/search/filter"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Base weights of each source. They order sources relative to each other
+// when suggestions match the input equally well.
+const (
+	weightField     = 1.0
+	weightValue     = 1.0
+	weightPredicate = 0.9
+	weightRepo      = 0.8
+	weightOwner     = 0.8
+	weightQuery     = 0.7
+	weightPath      = 0.6
+	weightSymbol    = 0.5
+)
+
+// matchScore scores how well candidate completes input. Exact and prefix
+// matches rank above matches of a path component, which rank above
+// substring matches. Candidates not containing input score zero.
+func matchScore(candidate, input string) float64 {
+	if input == "" {
+		return 0.5
+	}
+	c, i := strings.ToLower(candidate), strings.ToLower(input)
+	switch {
+	case c == i:
+		return 1
+	case strings.HasPrefix(c, i):
+		return 0.9
+	case strings.Contains(c, "/"+i):
+		return 0.75
+	case strings.Contains(c, i):
+		return 0.6
+	}
+	return 0
+}
+
+// popularityBoost maps an unbounded popularity count like repository stars
+// to a small additive boost in [0, 0.1).
+func popularityBoost(count int) float64 {
+	if count <= 0 {
+		return 0
+	}
+	return 0.1 * (1 - 1/(1+math.Log10(float64(count))))
+}
+
+// replacing returns a suggestion replacing the current value of the cursor
+// context with value.
+func replacing(args SourceArgs, kind Kind, value, label string, score float64) Suggestion {
+	return Suggestion{
+		Kind:  kind,
+		Value: value,
+		Label: label,
+		Start: args.Cursor.Start,
+		End:   args.Position,
+		Score: score,
+	}
+}
+
+// fieldSource suggests field names while typing a pattern, like `re` -> `repo:`.
+type fieldSource struct{}
+
+func (fieldSource) Name() string { return "field" }
+
+func (fieldSource) Suggest(_ context.Context, args SourceArgs) ([]Suggestion, error) {
+	if args.Cursor.Field != query.FieldDefault || args.Cursor.Value == "" {
+		return nil, nil
+	}
+	input := strings.TrimPrefix(args.Cursor.Value, "-")
+
+	var suggestions []Suggestion
+	for _, field := range query.Fields() {
+		if !strings.HasPrefix(field, strings.ToLower(input)) {
+			continue
+		}
+		value := field + ":"
+		if strings.HasPrefix(args.Cursor.Value, "-") {
+			value = "-" + value
+		}
+		suggestions = append(suggestions, replacing(args, KindField, value, value, weightField*matchScore(field, input)))
+	}
+	return suggestions, nil
+}
+
+// staticValues are the values of fields that accept an enumerable set of
+// values.
+var staticValues = map[string][]string{
+	query.FieldCase:        {"yes", "no"},
+	query.FieldFork:        {"yes", "no", "only"},
+	query.FieldArchived:    {"yes", "no", "only"},
+	query.FieldVisibility:  {"any", "public", "private"},
+	query.FieldPatternType: {"standard", "literal", "regexp", "structural"},
+	query.FieldType:        {"commit", "diff", "file", "path", "repo", "symbol"},
+	query.FieldSelect:      filter.SelectPaths(),
+	query.FieldLang: func() []string {
+		languages := make([]string, 0, len(data.LanguagesByExtension))
+		seen := map[string]struct{}{}
+		for _, ls := range data.LanguagesByExtension {
+			for _, l := range ls {
+				if _, ok := seen[l]; !ok {
+					seen[l] = struct{}{}
+					languages = append(languages, l)
+				}
+			}
+		}
+		sort.Strings(languages)
+		return languages
+	}(),
+}
+
+// valueSource suggests values of fields that accept a fixed set of values,
+// like `type:sym` -> `type:symbol`.
+type valueSource struct{}
+
+func (valueSource) Name() string { return "value" }
+
+func (valueSource) Suggest(_ context.Context, args SourceArgs) ([]Suggestion, error) {
+	if args.Cursor.Predicate != "" {
+		return nil, nil
+	}
+
+	var suggestions []Suggestion
+	for _, value := range staticValues[args.Cursor.Field] {
+		if score := matchScore(value, args.Cursor.Value); score > 0 {
+			suggestions = append(suggestions, replacing(args, KindValue, value, value, weightValue*score))
+		}
+	}
+	return suggestions, nil
+}
+
+// predicateSource suggests predicates of the field under the cursor, like
+// `repo:has.f` -> `repo:has.file(`.
+type predicateSource struct{}
+
+func (predicateSource) Name() string { return "predicate" }
+
+func (predicateSource) Suggest(_ context.Context, args SourceArgs) ([]Suggestion, error) {
+	if args.Cursor.Field == query.FieldDefault || args.Cursor.Predicate != "" {
+		return nil, nil
+	}
+
+	var suggestions []Suggestion
+	for name := range query.DefaultPredicateRegistry[args.Cursor.Field] {
+		if !strings.HasPrefix(name, args.Cursor.Value) {
+			continue
+		}
+		suggestions = append(suggestions, replacing(args, KindPredicate, name+"(", name+"(...)", weightPredicate*matchScore(name, args.Cursor.Value)))
+	}
+	return suggestions, nil
+}
+
+// repoSource suggests repository names, ranking popular repositories first.
+type repoSource struct {
+	db database.DB
+}
+
+func (repoSource) Name() string { return "repo" }
+
+func (s *repoSource) Suggest(ctx context.Context, args SourceArgs) ([]Suggestion, error) {
+	if args.Cursor.Field != query.FieldRepo || args.Cursor.Predicate != "" {
+		return nil, nil
+	}
+
+	repos, err := s.db.Repos().List(ctx, database.ReposListOptions{
+		Query: args.Cursor.Value,
+		OrderBy: database.RepoListOrderBy{{
+			Field:      database.RepoListStars,
+			Descending: true,
+			Nulls:      "LAST",
+		}},
+		LimitOffset: &database.LimitOffset{Limit: args.Limit},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing repositories")
+	}
+
+	suggestions := make([]Suggestion, 0, len(repos))
+	for _, repo := range repos {
+		name := string(repo.Name)
+		score := matchScore(name, args.Cursor.Value)
+		if score == 0 {
+			continue
+		}
+		s := replacing(args, KindRepo, "^"+regexp.QuoteMeta(name)+"$", name, weightRepo*score+popularityBoost(repo.Stars))
+		s.Description = repo.Description
+		suggestions = append(suggestions, s)
+	}
+	return suggestions, nil
+}
+
+// pathSource suggests file paths in the repositories the query is scoped to,
+// using path search against zoekt and searcher.
+type pathSource struct {
+	searchClient client.SearchClient
+}
+
+func (pathSource) Name() string { return "path" }
+
+func (s *pathSource) Suggest(ctx context.Context, args SourceArgs) ([]Suggestion, error) {
+	if args.Cursor.Field != query.FieldFile || args.Cursor.Predicate != "" {
+		return nil, nil
+	}
+
+	q := scopeQuery(args) + " type:path file:" + regexp.QuoteMeta(args.Cursor.Value)
+	matches, err := runSearch(ctx, s.searchClient, q, args.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]struct{}{}
+	var suggestions []Suggestion
+	for _, match := range matches {
+		fm, ok := match.(*result.FileMatch)
+		if !ok {
+			continue
+		}
+		if _, ok := seen[fm.Path]; ok {
+			continue
+		}
+		seen[fm.Path] = struct{}{}
+		s := replacing(args, KindPath, "^"+regexp.QuoteMeta(fm.Path)+"$", fm.Path, weightPath*matchScore(fm.Path, args.Cursor.Value))
+		s.Description = string(fm.Repo.Name)
+		suggestions = append(suggestions, s)
+	}
+	return suggestions, nil
+}
+
+// minSymbolInputLength is the minimum length of a pattern before symbols
+// are suggested. Shorter inputs match too many symbols to be useful.
+const minSymbolInputLength = 3
+
+// symbolSource suggests symbol names matching the pattern under the cursor.
+type symbolSource struct {
+	searchClient client.SearchClient
+}
+
+func (symbolSource) Name() string { return "symbol" }
+
+func (s *symbolSource) Suggest(ctx context.Context, args SourceArgs) ([]Suggestion, error) {
+	if args.Cursor.Field != query.FieldDefault || len(args.Cursor.Value) < minSymbolInputLength {
+		return nil, nil
+	}
+
+	q := scopeQuery(args) + " type:symbol patterntype:regexp ^" + regexp.QuoteMeta(args.Cursor.Value)
+	matches, err := runSearch(ctx, s.searchClient, q, args.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]struct{}{}
+	var suggestions []Suggestion
+	for _, match := range matches {
+		fm, ok := match.(*result.FileMatch)
+		if !ok {
+			continue
+		}
+		for _, sym := range fm.Symbols {
+			name := sym.Symbol.Name
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = struct{}{}
+			s := replacing(args, KindSymbol, name, name, weightSymbol*matchScore(name, args.Cursor.Value))
+			s.Description = strings.ToLower(sym.Symbol.Kind)
+			suggestions = append(suggestions, s)
+		}
+	}
+	return suggestions, nil
+}
+
+// Owner is a code owner that can be referenced in the `file:has.owner()`
+// predicate.
+type Owner struct {
+	// Reference is the owner as written in the predicate, either an `@handle`
+	// or an email address.
+	Reference string
+
+	// Description is the display name of the owner, if known.
+	Description string
+
+	// OwnedFiles is the number of files the owner owns, used for ranking.
+	OwnedFiles int
+}
+
+// OwnerSuggester finds the code owners whose references match the given input.
+// It is implemented by Sourcegraph Own, which is not part of every build.
+type OwnerSuggester interface {
+	SuggestOwners(ctx context.Context, input string, limit int) ([]Owner, error)
+}
+
+// ownerSource suggests code owners inside of the `file:has.owner()`
+// predicate.
+type ownerSource struct {
+	db     database.DB
+	owners OwnerSuggester
+}
+
+func (ownerSource) Name() string { return "owner" }
+
+func (s *ownerSource) Suggest(ctx context.Context, args SourceArgs) ([]Suggestion, error) {
+	if s.owners == nil || args.Cursor.Field != query.FieldFile || args.Cursor.Predicate != "has.owner" {
+		return nil, nil
+	}
+
+	// 🚨 SECURITY: Owners include users of the instance, so we only suggest
+	// them to callers who are allowed to list users, which are only site
+	// admins on sourcegraph.com.
+	if envvar.SourcegraphDotComMode() {
+		if err := auth.CheckCurrentUserIsSiteAdmin(ctx, s.db); err != nil {
+			return nil, nil
+		}
+	}
+
+	input := strings.TrimPrefix(args.Cursor.Value, "@")
+	owners, err := s.owners.SuggestOwners(ctx, input, args.Limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "suggesting owners")
+	}
+
+	suggestions := make([]Suggestion, 0, len(owners))
+	for _, owner := range owners {
+		score := weightOwner*matchScore(strings.TrimPrefix(owner.Reference, "@"), input) + popularityBoost(owner.OwnedFiles)
+		s := replacing(args, KindOwner, owner.Reference, owner.Reference, score)
+		s.Description = owner.Description
+		suggestions = append(suggestions, s)
+	}
+	return suggestions, nil
+}
+
+// recentQuerySource suggests complete queries the current user has run or
+// saved before that extend the input typed so far. Queries from the search
+// history rank higher the more often they were run, and pinned ones rank
+// highest.
+type recentQuerySource struct {
+	db database.DB
+}
+
+func (recentQuerySource) Name() string { return "query" }
+
+// maxHistoryCandidates is the number of most recently run matching queries
+// of the search history that are considered for suggestions.
+const maxHistoryCandidates = 50
+
+func (s *recentQuerySource) Suggest(ctx context.Context, args SourceArgs) ([]Suggestion, error) {
+	a := actor.FromContext(ctx)
+	if !a.IsAuthenticated() {
+		return nil, nil
+	}
+	input := strings.TrimSpace(args.Query[:args.Position])
+	if input == "" {
+		return nil, nil
+	}
+
+	saved, err := s.db.SavedSearches().ListSavedSearchesByUserID(ctx, a.UID)
+	if err != nil {
+		return nil, errors.Wrap(err, "listing saved searches")
+	}
+
+	var history []*database.SearchHistoryEntry
+	if conf.SearchHistoryEnabled() {
+		limit := maxHistoryCandidates
+		history, err = s.db.SearchHistory().List(ctx, database.SearchHistoryListOptions{UserID: a.UID, Query: input}, &database.PaginationArgs{
+			First:   &limit,
+			OrderBy: database.SearchHistoryOrderBy,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "listing search history")
+		}
+	}
+
+	suggestions := map[string]Suggestion{}
+	add := func(q, description string, score float64) {
+		if score == 0 || q == input {
+			return
+		}
+		if existing, ok := suggestions[q]; ok && existing.Score >= score {
+			return
+		}
+		suggestions[q] = Suggestion{
+			Kind:        KindQuery,
+			Value:       q,
+			Label:       q,
+			Description: description,
+			Start:       0,
+			End:         args.Position,
+			Score:       score,
+		}
+	}
+
+	for _, ss := range saved {
+		add(ss.Query, ss.Description, weightQuery*matchScore(ss.Query, input))
+	}
+	for _, e := range history {
+		score := weightQuery*matchScore(e.Query, input) + popularityBoost(e.RunCount)
+		if e.Pinned {
+			score += 0.1
+		}
+		add(e.Query, "Recent search", score)
+	}
+
+	ranked := make([]Suggestion, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		ranked = append(ranked, suggestion)
+	}
+	return ranked, nil
+}
+
+// scopeFields are the fields of the input query that are kept to scope
+// searches run on behalf of suggestions.
+var scopeFields = map[string]struct{}{
+	query.FieldRepo:       {},
+	query.FieldContext:    {},
+	query.FieldRev:        {},
+	query.FieldFork:       {},
+	query.FieldArchived:   {},
+	query.FieldVisibility: {},
+}
+
+// scopeQuery returns the repository scoping parameters of the input query,
+// ignoring the token currently being completed.
+func scopeQuery(args SourceArgs) string {
+	rest := args.Query[:args.Cursor.Start] + args.Query[args.Position:]
+	nodes, err := query.Parse(rest, query.SearchTypeStandard)
+	if err != nil {
+		return ""
+	}
+
+	var scope []string
+	query.VisitParameter(nodes, func(field, value string, negated bool, _ query.Annotation) {
+		if _, ok := scopeFields[field]; !ok {
+			return
+		}
+		if strings.ContainsAny(value, " \t\n") {
+			value = strconv.Quote(value)
+		}
+		if negated {
+			field = "-" + field
+		}
+		scope = append(scope, field+":"+value)
+	})
+	return strings.Join(scope, " ")
+}
+
+// runSearch runs a search for q and returns up to limit matches.
+func runSearch(ctx context.Context, searchClient client.SearchClient, q string, limit int) (result.Matches, error) {
+	q += " count:" + strconv.Itoa(limit)
+	inputs, err := searchClient.Plan(ctx, "V3", nil, q, search.Precise, search.Streaming)
+	if err != nil {
+		return nil, err
+	}
+
+	agg := streaming.NewAggregatingStream()
+	if _, err := searchClient.Execute(ctx, agg, inputs); err != nil {
+		return nil, err
+	}
+	return agg.Results, nil
+}
@@ -0,0 +1,184 @@
+// Package suggest implements server-side completions for search queries.
+//
+// Given a partial query and a cursor position, the service determines the
+// syntactic context of the cursor with the query parser and asks every
+// applicable Source for ranked completions.
+package suggest
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/search/client"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+)
+
+// Kind is the kind of entity a suggestion completes to.
+type Kind string
+
+const (
+	KindField     Kind = "field"
+	KindValue     Kind = "value"
+	KindPredicate Kind = "predicate"
+	KindRepo      Kind = "repo"
+	KindPath      Kind = "path"
+	KindSymbol    Kind = "symbol"
+	KindOwner     Kind = "owner"
+	KindQuery     Kind = "query"
+)
+
+// Suggestion is a single completion. Applying it replaces the byte range
+// [Start, End) of the input query with Value.
+type Suggestion struct {
+	Kind        Kind    `json:"kind"`
+	Value       string  `json:"value"`
+	Label       string  `json:"label"`
+	Description string  `json:"description,omitempty"`
+	Start       int     `json:"start"`
+	End         int     `json:"end"`
+	Score       float64 `json:"score"`
+}
+
+// Request is a request for completions of Query at byte offset Position.
+type Request struct {
+	Query    string
+	Position int
+
+	// Limit is the maximum number of suggestions returned by each source.
+	Limit int
+}
+
+// SourceArgs are the arguments passed to each Source.
+type SourceArgs struct {
+	// Query is the full input query.
+	Query string
+
+	// Position is the cursor position in Query.
+	Position int
+
+	// Cursor is the syntactic context at Position.
+	Cursor query.CursorContext
+
+	// Limit is the maximum number of suggestions to return.
+	Limit int
+}
+
+// Source produces completions for a cursor context. Sources that do not apply
+// to the given context return no suggestions.
+type Source interface {
+	Name() string
+	Suggest(ctx context.Context, args SourceArgs) ([]Suggestion, error)
+}
+
+const (
+	defaultLimit = 10
+
+	// MaxLimit is the largest number of suggestions a source may be asked
+	// for.
+	MaxLimit = 100
+)
+
+// Service computes completions for search queries.
+type Service struct {
+	logger  log.Logger
+	sources []Source
+}
+
+// NewService returns a Service using the default set of sources. Owners are
+// only suggested if owners is not nil.
+func NewService(logger log.Logger, db database.DB, searchClient client.SearchClient, owners OwnerSuggester) *Service {
+	return NewServiceWithSources(logger,
+		&fieldSource{},
+		&valueSource{},
+		&predicateSource{},
+		&repoSource{db: db},
+		&pathSource{searchClient: searchClient},
+		&symbolSource{searchClient: searchClient},
+		&ownerSource{db: db, owners: owners},
+		&recentQuerySource{db: db},
+	)
+}
+
+// NewServiceWithSources returns a Service using the given sources.
+func NewServiceWithSources(logger log.Logger, sources ...Source) *Service {
+	return &Service{
+		logger:  logger.Scoped("suggest", "search query suggestions"),
+		sources: sources,
+	}
+}
+
+// Suggest computes completions for the given request. Sources are queried
+// concurrently and the ranked suggestions of each source are passed to send
+// as soon as they are available. A failing source is logged and skipped so
+// that slow or broken backends do not prevent other completions.
+func (s *Service) Suggest(ctx context.Context, req Request, send func([]Suggestion) error) error {
+	if req.Position < 0 || req.Position > len(req.Query) {
+		req.Position = len(req.Query)
+	}
+	if req.Limit <= 0 {
+		req.Limit = defaultLimit
+	}
+	if req.Limit > MaxLimit {
+		req.Limit = MaxLimit
+	}
+
+	args := SourceArgs{
+		Query:    req.Query,
+		Position: req.Position,
+		Cursor:   query.ContextAt(req.Query, req.Position),
+		Limit:    req.Limit,
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		sendErr error
+	)
+	for _, source := range s.sources {
+		source := source
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			suggestions, err := source.Suggest(ctx, args)
+			if err != nil {
+				if ctx.Err() == nil {
+					s.logger.Warn("suggestion source failed", log.String("source", source.Name()), log.Error(err))
+				}
+				return
+			}
+			if len(suggestions) == 0 {
+				return
+			}
+
+			Rank(suggestions)
+			if len(suggestions) > req.Limit {
+				suggestions = suggestions[:req.Limit]
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if sendErr == nil {
+				sendErr = send(suggestions)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return sendErr
+}
+
+// Rank sorts suggestions by descending score. Ties are broken by label so
+// that the order is stable across requests.
+func Rank(suggestions []Suggestion) {
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].Score != suggestions[j].Score {
+			return suggestions[i].Score > suggestions[j].Score
+		}
+		return suggestions[i].Label < suggestions[j].Label
+	})
+}
@@ -0,0 +1,226 @@
+package suggest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/envvar"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestSuggest(t *testing.T) {
+	repos := database.NewMockRepoStore()
+	repos.ListFunc.SetDefaultReturn([]*types.Repo{
+		{Name: "github.com/sourcegraph/sourcegraph-jetbrains", Stars: 10},
+		{Name: "github.com/sourcegraph/sourcegraph", Stars: 10000},
+	}, nil)
+	db := database.NewMockDB()
+	db.ReposFunc.SetDefaultReturn(repos)
+
+	failing := sourceFunc(func(context.Context, SourceArgs) ([]Suggestion, error) {
+		return nil, errors.New("boom")
+	})
+
+	svc := NewServiceWithSources(logtest.Scoped(t),
+		&fieldSource{},
+		&valueSource{},
+		&predicateSource{},
+		&repoSource{db: db},
+		failing,
+	)
+
+	suggest := func(t *testing.T, q string, pos int) []Suggestion {
+		t.Helper()
+		var got []Suggestion
+		err := svc.Suggest(context.Background(), Request{Query: q, Position: pos, Limit: 3}, func(s []Suggestion) error {
+			got = append(got, s...)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		Rank(got)
+		return got
+	}
+
+	labels := func(suggestions []Suggestion) []string {
+		var ls []string
+		for _, s := range suggestions {
+			ls = append(ls, s.Label)
+		}
+		return ls
+	}
+
+	t.Run("fields", func(t *testing.T) {
+		got := labels(suggest(t, "foo -re", -1))
+		want := []string{"-repo:", "-repohascommitafter:", "-repohasfile:"}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("unexpected suggestions (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("values", func(t *testing.T) {
+		got := suggest(t, "type:sy foo", len("type:sy"))
+		want := []Suggestion{{Kind: KindValue, Value: "symbol", Label: "symbol", Start: 5, End: 7, Score: 0.9}}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("unexpected suggestions (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("repos and predicates", func(t *testing.T) {
+		got := labels(suggest(t, "r:sourcegr", -1))
+		want := []string{"github.com/sourcegraph/sourcegraph", "github.com/sourcegraph/sourcegraph-jetbrains"}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("unexpected suggestions (-want +got):\n%s", diff)
+		}
+
+		got = labels(suggest(t, "repo:has.f", -1))
+		want = []string{"has.file(...)"}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("unexpected suggestions (-want +got):\n%s", diff)
+		}
+	})
+}
+
+func TestRecentQuerySource(t *testing.T) {
+	savedSearches := database.NewMockSavedSearchStore()
+	savedSearches.ListSavedSearchesByUserIDFunc.SetDefaultReturn([]*types.SavedSearch{
+		{Query: "repo:sourcegraph lang:go TODO", Description: "Go TODOs"},
+	}, nil)
+	history := database.NewMockSearchHistoryStore()
+	history.ListFunc.SetDefaultReturn([]*database.SearchHistoryEntry{
+		{Query: "repo:sourcegraph lang:go TODO", RunCount: 1},
+		{Query: "repo:sourcegraph type:diff", RunCount: 100},
+		{Query: "repo:sourcegraph file:README", RunCount: 1, Pinned: true},
+	}, nil)
+	db := database.NewMockDB()
+	db.SavedSearchesFunc.SetDefaultReturn(savedSearches)
+	db.SearchHistoryFunc.SetDefaultReturn(history)
+
+	source := &recentQuerySource{db: db}
+	args := SourceArgs{Query: "repo:sourcegraph", Position: len("repo:sourcegraph"), Limit: 10}
+
+	got, err := source.Suggest(context.Background(), args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 0 {
+		t.Errorf("expected no suggestions for anonymous users, got %v", got)
+	}
+
+	ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+	got, err = source.Suggest(ctx, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	Rank(got)
+
+	var labels []string
+	for _, s := range got {
+		labels = append(labels, s.Label)
+	}
+	want := []string{"repo:sourcegraph file:README", "repo:sourcegraph type:diff", "repo:sourcegraph lang:go TODO"}
+	if diff := cmp.Diff(want, labels); diff != "" {
+		t.Errorf("unexpected suggestions (-want +got):\n%s", diff)
+	}
+
+	if opts := history.ListFunc.History()[0].Arg1; opts.UserID != 1 || opts.Query != "repo:sourcegraph" {
+		t.Errorf("unexpected search history options %+v", opts)
+	}
+}
+
+type ownerSuggesterFunc func(ctx context.Context, input string, limit int) ([]Owner, error)
+
+func (f ownerSuggesterFunc) SuggestOwners(ctx context.Context, input string, limit int) ([]Owner, error) {
+	return f(ctx, input, limit)
+}
+
+func TestOwnerSource(t *testing.T) {
+	owners := ownerSuggesterFunc(func(_ context.Context, input string, _ int) ([]Owner, error) {
+		if input != "ali" {
+			t.Errorf("unexpected input %q", input)
+		}
+		return []Owner{
+			{Reference: "@alice", Description: "Alice", OwnedFiles: 10},
+			{Reference: "alicia@example.com"},
+		}, nil
+	})
+	users := database.NewMockUserStore()
+	users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{ID: 1}, nil)
+	db := database.NewMockDB()
+	db.UsersFunc.SetDefaultReturn(users)
+
+	source := &ownerSource{db: db, owners: owners}
+	q := "file:has.owner(@ali"
+	args := SourceArgs{Query: q, Position: len(q), Cursor: query.ContextAt(q, len(q)), Limit: 10}
+
+	got, err := source.Suggest(context.Background(), args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	Rank(got)
+	var labels []string
+	for _, s := range got {
+		labels = append(labels, s.Label)
+	}
+	if diff := cmp.Diff([]string{"@alice", "alicia@example.com"}, labels); diff != "" {
+		t.Errorf("unexpected suggestions (-want +got):\n%s", diff)
+	}
+
+	t.Run("only site admins on sourcegraph.com", func(t *testing.T) {
+		envvar.MockSourcegraphDotComMode(true)
+		t.Cleanup(func() { envvar.MockSourcegraphDotComMode(false) })
+
+		ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+		got, err := source.Suggest(ctx, args)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 0 {
+			t.Errorf("expected no suggestions for non-admins, got %v", got)
+		}
+	})
+}
+
+func TestScopeQuery(t *testing.T) {
+	q := "repo:^foo$ -repo:bar lang:go file:int content"
+	args := SourceArgs{Query: q, Position: len("repo:^foo$ -repo:bar lang:go file:int")}
+	args.Cursor.Start = len("repo:^foo$ -repo:bar lang:go file:")
+
+	if got, want := scopeQuery(args), "repo:^foo$ -repo:bar"; got != want {
+		t.Errorf("unexpected scope, want %q got %q", want, got)
+	}
+}
+
+func TestMatchScore(t *testing.T) {
+	for _, tc := range []struct {
+		candidate, input string
+		want             float64
+	}{
+		{"repo", "repo", 1},
+		{"repohasfile", "repo", 0.9},
+		{"internal/search/query.go", "search", 0.75},
+		{"github.com/sourcegraph/sourcegraph", "graph", 0.6},
+		{"github.com/sourcegraph/sourcegraph", "zoekt", 0},
+		{"anything", "", 0.5},
+	} {
+		if got := matchScore(tc.candidate, tc.input); got != tc.want {
+			t.Errorf("matchScore(%q, %q) = %v, want %v", tc.candidate, tc.input, got, tc.want)
+		}
+	}
+}
+
+type sourceFunc func(context.Context, SourceArgs) ([]Suggestion, error)
+
+func (sourceFunc) Name() string { return "func" }
+
+func (f sourceFunc) Suggest(ctx context.Context, args SourceArgs) ([]Suggestion, error) {
+	return f(ctx, args)
+}
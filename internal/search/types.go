@@ -383,6 +383,55 @@ func (p *TextPatternInfo) String() string {
 	return fmt.Sprintf("TextPatternInfo{%s}", strings.Join(args, ","))
 }
 
+// DiagnosticPatternInfo describes a search over the diagnostics extracted from
+// precise code intelligence indexes (`type:diagnostic`).
+type DiagnosticPatternInfo struct {
+	// Pattern is a regular expression matched against diagnostic messages.
+	Pattern         string
+	IsCaseSensitive bool
+
+	// Severities and ExcludeSeverities hold severity names as accepted by
+	// the `severity:` filter (e.g., "error" or "warning").
+	Severities        []string
+	ExcludeSeverities []string
+	Codes             []string
+	ExcludeCodes      []string
+
+	IncludePatterns []string
+	ExcludePatterns []string
+
+	Limit int
+}
+
+func (p *DiagnosticPatternInfo) Fields() []attribute.KeyValue {
+	res := []attribute.KeyValue{
+		attribute.String("pattern", p.Pattern),
+		attribute.Int("limit", p.Limit),
+	}
+	if p.IsCaseSensitive {
+		res = append(res, attribute.Bool("isCaseSensitive", p.IsCaseSensitive))
+	}
+	if len(p.Severities) > 0 {
+		res = append(res, attribute.StringSlice("severities", p.Severities))
+	}
+	if len(p.ExcludeSeverities) > 0 {
+		res = append(res, attribute.StringSlice("excludeSeverities", p.ExcludeSeverities))
+	}
+	if len(p.Codes) > 0 {
+		res = append(res, attribute.StringSlice("codes", p.Codes))
+	}
+	if len(p.ExcludeCodes) > 0 {
+		res = append(res, attribute.StringSlice("excludeCodes", p.ExcludeCodes))
+	}
+	if len(p.IncludePatterns) > 0 {
+		res = append(res, attribute.StringSlice("includePatterns", p.IncludePatterns))
+	}
+	if len(p.ExcludePatterns) > 0 {
+		res = append(res, attribute.StringSlice("excludePatterns", p.ExcludePatterns))
+	}
+	return res
+}
+
 // Features describe feature flags for a request. This is state that differs
 // across users and time. It is created based on user feature flags and
 // configuration.
@@ -409,6 +458,12 @@ type Features struct {
 	// for ranking results from Zoekt.
 	Ranking bool `json:"ranking"`
 
+	// DocumentRanks when true will order results from backends other than
+	// Zoekt by their precise document rank, and interleave the results of
+	// all backends by document rank. It is intended to be A/B tested with a
+	// feature flag rollout before becoming the default.
+	DocumentRanks bool `json:"search-document-ranks"`
+
 	// Debug when true will set the Debug field on FileMatches. This may grow
 	// from here. For now we treat this like a feature flag for convenience.
 	Debug bool `json:"debug"`
@@ -447,6 +502,7 @@ type RepoOptions struct {
 	HasFileContent []query.RepoHasFileContentArgs
 	HasKVPs        []query.RepoKVPFilter
 	HasTopics      []query.RepoHasTopicPredicate
+	HasLanguages   []query.RepoHasLanguagePredicate
 
 	// ForkSet indicates whether `fork:` was set explicitly in the query,
 	// or whether the values were set from defaults.
@@ -542,6 +598,21 @@ func (op *RepoOptions) Attributes() []attribute.KeyValue {
 			add(trace.Scoped(fmt.Sprintf("hasTopics[%d]", i), nondefault...)...)
 		}
 	}
+	if len(op.HasLanguages) > 0 {
+		for i, arg := range op.HasLanguages {
+			nondefault := []attribute.KeyValue{}
+			if arg.Language != "" {
+				nondefault = append(nondefault, attribute.String("language", arg.Language))
+			}
+			if arg.MinPercent != 0 {
+				nondefault = append(nondefault, attribute.Float64("minPercent", arg.MinPercent))
+			}
+			if arg.Negated {
+				nondefault = append(nondefault, attribute.Bool("negated", arg.Negated))
+			}
+			add(trace.Scoped(fmt.Sprintf("hasLanguages[%d]", i), nondefault...)...)
+		}
+	}
 	if op.ForkSet {
 		add(attribute.Bool("forkSet", op.ForkSet))
 	}
@@ -628,6 +699,19 @@ func (op *RepoOptions) String() string {
 			}
 		}
 	}
+	if len(op.HasLanguages) > 0 {
+		for i, arg := range op.HasLanguages {
+			if arg.Language != "" {
+				fmt.Fprintf(&b, "HasLanguages[%d].language: %s\n", i, arg.Language)
+			}
+			if arg.MinPercent != 0 {
+				fmt.Fprintf(&b, "HasLanguages[%d].minPercent: %g\n", i, arg.MinPercent)
+			}
+			if arg.Negated {
+				fmt.Fprintf(&b, "HasLanguages[%d].negated: %t\n", i, arg.Negated)
+			}
+		}
+	}
 
 	if op.CaseSensitiveRepoFilters {
 		fmt.Fprintf(&b, "CaseSensitiveRepoFilters: %t\n", op.CaseSensitiveRepoFilters)
@@ -32,6 +32,7 @@ var emailSendCounter = promauto.NewCounterVec(prometheus.CounterOpts{
 func render(fromAddress, fromName string, message Message) (*email.Email, error) {
 	m := email.Email{
 		To: message.To,
+		Cc: message.Cc,
 		From: (&mail.Address{
 			Name:    fromName,
 			Address: fromAddress,
@@ -44,6 +45,9 @@ func render(fromAddress, fromName string, message Message) (*email.Email, error)
 	if message.MessageID != nil {
 		m.Headers["Message-ID"] = []string{*message.MessageID}
 	}
+	if message.InReplyTo != nil {
+		m.Headers["In-Reply-To"] = []string{fmt.Sprintf("<%s>", *message.InReplyTo)}
+	}
 	if len(message.References) > 0 {
 		// jordan-wright/email does not support lists, so we must build it ourself.
 		var refsList string
@@ -184,7 +188,7 @@ func Send(ctx context.Context, source string, message Message) (err error) {
 	if err != nil {
 		return errors.Wrap(err, "send MAIL")
 	}
-	for _, addr := range m.To {
+	for _, addr := range append(m.To, m.Cc...) {
 		if err = client.Rcpt(addr); err != nil {
 			return errors.Wrap(err, "send RCPT")
 		}
@@ -75,4 +75,30 @@ func TestRender(t *testing.T) {
 			t.Errorf("mismatch (-want +got):\n%s", diff)
 		}
 	})
+
+	t.Run("cc and in-reply-to", func(t *testing.T) {
+		inReplyTo := "0"
+		msg := msg
+		msg.Cc = []string{"baz@sourcegraph.com"}
+		msg.InReplyTo = &inReplyTo
+
+		got, err := render("foo@sourcegraph.com", "", msg)
+		require.NoError(t, err)
+		if diff := cmp.Diff(&email.Email{
+			ReplyTo: []string{replyTo},
+			From:    "<foo@sourcegraph.com>",
+			To:      []string{"bar1@sourcegraph.com", "bar2@sourcegraph.com"},
+			Cc:      []string{"baz@sourcegraph.com"},
+			Subject: "a subject <b>",
+			Text:    []byte("a text body <b>"),
+			HTML:    []byte(`a html body <span class="&lt;b&gt;" />`),
+			Headers: textproto.MIMEHeader{
+				"Message-ID":  []string{messageID},
+				"In-Reply-To": []string{"<0>"},
+				"References":  []string{"<ref1> <ref2>"},
+			},
+		}, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
 }
@@ -15,8 +15,10 @@ type InternalAPIMessage struct {
 // Message describes an email message to be sent.
 type Message struct {
 	To         []string // email "To" recipients
+	Cc         []string // optional email "Cc" recipients
 	ReplyTo    *string  // optional "ReplyTo" address
 	MessageID  *string  // optional "Message-ID" header
+	InReplyTo  *string  // optional "In-Reply-To" header
 	References []string // optional "References" header list
 
 	Template Templates // unparsed subject/body templates
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/gobwas/glob"
+
 	"github.com/sourcegraph/sourcegraph/lib/batches/env"
 	"github.com/sourcegraph/sourcegraph/lib/batches/overridable"
 	"github.com/sourcegraph/sourcegraph/lib/batches/schema"
@@ -40,12 +42,33 @@ type BatchSpec struct {
 }
 
 type ChangesetTemplate struct {
-	Title     string                       `json:"title,omitempty" yaml:"title"`
-	Body      string                       `json:"body,omitempty" yaml:"body"`
-	Branch    string                       `json:"branch,omitempty" yaml:"branch"`
-	Fork      *bool                        `json:"fork,omitempty" yaml:"fork"`
-	Commit    ExpandedGitCommitDescription `json:"commit,omitempty" yaml:"commit"`
-	Published *overridable.BoolOrString    `json:"published" yaml:"published"`
+	Title      string                       `json:"title,omitempty" yaml:"title"`
+	Body       string                       `json:"body,omitempty" yaml:"body"`
+	Branch     string                       `json:"branch,omitempty" yaml:"branch"`
+	Fork       *bool                        `json:"fork,omitempty" yaml:"fork"`
+	Commit     ExpandedGitCommitDescription `json:"commit,omitempty" yaml:"commit"`
+	Published  *overridable.BoolOrString    `json:"published" yaml:"published"`
+	DependsOn  []ChangesetDependency        `json:"dependsOn,omitempty" yaml:"dependsOn"`
+	CodeOwners *CodeOwners                  `json:"codeOwners,omitempty" yaml:"codeOwners"`
+}
+
+// ChangesetDependency declares that the changesets in the repositories
+// matching Repository are only published once the changesets in the
+// Prerequisites repositories have been merged.
+type ChangesetDependency struct {
+	Repository    string   `json:"repository,omitempty" yaml:"repository"`
+	Prerequisites []string `json:"prerequisites,omitempty" yaml:"prerequisites"`
+}
+
+// CodeOwners configures how the code owners of the files touched by a
+// changeset are involved in it once it is published. The owners are
+// determined by the CODEOWNERS file of the repository.
+type CodeOwners struct {
+	// RequestReviews requests a review of the changeset from the owners.
+	RequestReviews bool `json:"requestReviews,omitempty" yaml:"requestReviews"`
+	// Assign assigns the owners to the changeset, on code hosts that
+	// support assignees.
+	Assign bool `json:"assign,omitempty" yaml:"assign"`
 }
 
 type GitCommitAuthor struct {
@@ -96,6 +119,16 @@ type Step struct {
 	Outputs   Outputs           `json:"outputs,omitempty" yaml:"outputs,omitempty"`
 	Mount     []Mount           `json:"mount,omitempty" yaml:"mount,omitempty"`
 	If        any               `json:"if,omitempty" yaml:"if,omitempty"`
+	Matrix    Matrix            `json:"matrix,omitempty" yaml:"matrix,omitempty"`
+
+	// MatrixValues is the combination of matrix values the step is run
+	// with. It is only set on the steps that a step with a Matrix is expanded
+	// into when the batch spec is parsed.
+	MatrixValues map[string]any `json:"matrixValues,omitempty" yaml:"-"`
+	// MatrixGroup identifies the steps that a step with a Matrix is expanded
+	// into. It is the 1-based index of that step in the batch spec, and 0 for
+	// steps without a matrix. The steps of a group are run in parallel.
+	MatrixGroup int `json:"matrixGroup,omitempty" yaml:"-"`
 }
 
 func (s *Step) IfCondition() string {
@@ -166,7 +199,18 @@ func parseBatchSpec(schema string, data []byte) (*BatchSpec, error) {
 		errs = errors.Append(errs, NewValidationError(errors.New("batch spec includes steps but no changesetTemplate")))
 	}
 
+	if spec.ChangesetTemplate != nil {
+		for i, dep := range spec.ChangesetTemplate.DependsOn {
+			if _, err := glob.Compile(dep.Repository); err != nil {
+				errs = errors.Append(errs, NewValidationError(errors.Newf("changesetTemplate.dependsOn %d has an invalid repository pattern: %s", i+1, err)))
+			}
+		}
+	}
+
 	for i, step := range spec.Steps {
+		if len(step.Matrix) != 0 && step.Matrix.size() > maxMatrixCombinations {
+			errs = errors.Append(errs, NewValidationError(errors.Newf("step %d matrix expands to more than %d combinations", i+1, maxMatrixCombinations)))
+		}
 		for _, mount := range step.Mount {
 			if strings.Contains(mount.Path, invalidMountCharacters) {
 				errs = errors.Append(errs, NewValidationError(errors.Newf("step %d mount path contains invalid characters", i+1)))
@@ -177,7 +221,17 @@ func parseBatchSpec(schema string, data []byte) (*BatchSpec, error) {
 		}
 	}
 
-	return &spec, errs
+	if errs != nil {
+		return &spec, errs
+	}
+
+	steps, err := expandMatrixSteps(spec.Steps)
+	if err != nil {
+		return &spec, err
+	}
+	spec.Steps = steps
+
+	return &spec, nil
 }
 
 const invalidMountCharacters = ","
@@ -232,6 +286,7 @@ func SkippedStepsForRepo(spec *BatchSpec, repoName string, fileMatches []string)
 				FileMatches: fileMatches,
 			},
 			BatchChange: batchChange,
+			Matrix:      step.MatrixValues,
 		}
 		static, boolVal, err := template.IsStaticBool(step.IfCondition(), stepCtx)
 		if err != nil {
@@ -211,6 +211,116 @@ changesetTemplate:
 		_, err := ParseBatchSpec([]byte(spec))
 		assert.Equal(t, "step 1 mount mountpoint contains invalid characters", err.Error())
 	})
+	t.Run("dependsOn with invalid repository pattern", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+steps:
+  - run: /tmp/sample.sh
+    container: alpine:3
+changesetTemplate:
+  title: Test
+  body: Test
+  branch: test
+  commit:
+    message: Test
+  dependsOn:
+    - repository: github.com/sourcegraph/[
+      prerequisites:
+        - github.com/sourcegraph/go-lib
+`
+		_, err := ParseBatchSpec([]byte(spec))
+		if err == nil {
+			t.Fatal("no error returned")
+		}
+		assert.Contains(t, err.Error(), "changesetTemplate.dependsOn 1 has an invalid repository pattern")
+	})
+	t.Run("matrix", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+steps:
+  - run: npm test
+    container: node:${{ matrix.node }}
+    matrix:
+      node: ["16", "18"]
+      module: [api, web]
+  - run: echo done
+    container: alpine:3
+changesetTemplate:
+  title: Test
+  body: Test
+  branch: test
+  commit:
+    message: Test
+`
+		have, err := ParseBatchSpec([]byte(spec))
+		if err != nil {
+			t.Fatalf("parsing valid spec returned error: %s", err)
+		}
+
+		want := []Step{
+			{Run: "npm test", Container: "node:16", MatrixValues: map[string]any{"module": "api", "node": "16"}, MatrixGroup: 1},
+			{Run: "npm test", Container: "node:18", MatrixValues: map[string]any{"module": "api", "node": "18"}, MatrixGroup: 1},
+			{Run: "npm test", Container: "node:16", MatrixValues: map[string]any{"module": "web", "node": "16"}, MatrixGroup: 1},
+			{Run: "npm test", Container: "node:18", MatrixValues: map[string]any{"module": "web", "node": "18"}, MatrixGroup: 1},
+			{Run: "echo done", Container: "alpine:3"},
+		}
+		if diff := cmp.Diff(want, have.Steps); diff != "" {
+			t.Fatalf("wrong steps (-want +have):\n%s", diff)
+		}
+
+		for i, want := range []bool{true, true, true, false, false} {
+			if have := MatrixGroupContinues(have.Steps, i); have != want {
+				t.Errorf("step %d: wrong MatrixGroupContinues: want %t, have %t", i, want, have)
+			}
+		}
+	})
+	t.Run("matrix with too many combinations", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+steps:
+  - run: /tmp/sample.sh
+    container: alpine:3
+    matrix:
+      a: [1, 2, 3, 4, 5]
+      b: [1, 2, 3, 4, 5]
+      c: [1, 2, 3, 4, 5]
+changesetTemplate:
+  title: Test
+  body: Test
+  branch: test
+  commit:
+    message: Test
+`
+		_, err := ParseBatchSpec([]byte(spec))
+		if err == nil {
+			t.Fatal("no error returned")
+		}
+		assert.Equal(t, "step 1 matrix expands to more than 64 combinations", err.Error())
+	})
+	t.Run("matrix with invalid variable name", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+steps:
+  - run: /tmp/sample.sh
+    container: alpine:3
+    matrix:
+      node-version: ["16"]
+changesetTemplate:
+  title: Test
+  body: Test
+  branch: test
+  commit:
+    message: Test
+`
+		_, err := ParseBatchSpec([]byte(spec))
+		if err == nil {
+			t.Fatal("no error returned")
+		}
+	})
 }
 
 func TestOnQueryOrRepository_Branches(t *testing.T) {
@@ -341,6 +451,25 @@ func TestSkippedStepsForRepo(t *testing.T) {
 			wantSkipped: []int{1},
 		},
 
+		"if expression on matrix values that can be partially evaluated to false": {
+			spec: &BatchSpec{
+				Steps: []Step{
+					{Run: "echo 1", If: `${{ eq matrix.os "linux" }}`, MatrixValues: map[string]any{"os": "linux"}},
+					{Run: "echo 1", If: `${{ eq matrix.os "linux" }}`, MatrixValues: map[string]any{"os": "windows"}},
+				},
+			},
+			wantSkipped: []int{1},
+		},
+
+		"if expression on numeric matrix values that can NOT be partially evaluated": {
+			spec: &BatchSpec{
+				Steps: []Step{
+					{Run: "echo 1", If: `${{ eq matrix.node 16 }}`, MatrixValues: map[string]any{"node": float64(18)}},
+				},
+			},
+			wantSkipped: []int{},
+		},
+
 		"if expression that can NOT be partially evaluated": {
 			spec: &BatchSpec{
 				Steps: []Step{
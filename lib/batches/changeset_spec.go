@@ -81,6 +81,14 @@ type ChangesetSpec struct {
 	Commits []GitCommitDescription `json:"commits,omitempty"`
 
 	Published PublishedValue `json:"published,omitempty"`
+
+	// DependsOn are the names of the repositories whose changesets have to be
+	// merged before this changeset is published.
+	DependsOn []string `json:"dependsOn,omitempty"`
+
+	// CodeOwners configures whether the code owners of the changed files are
+	// asked for a review of, or assigned to, the changeset.
+	CodeOwners *CodeOwners `json:"codeOwners,omitempty"`
 }
 
 // MarshalJSON overwrites the default behavior of the json lib while unmarshalling
@@ -103,6 +111,8 @@ func (c *ChangesetSpec) MarshalJSON() ([]byte, error) {
 		Body           string                 `json:"body,omitempty"`
 		Commits        []GitCommitDescription `json:"commits,omitempty"`
 		Published      *PublishedValue        `json:"published,omitempty"`
+		DependsOn      []string               `json:"dependsOn,omitempty"`
+		CodeOwners     *CodeOwners            `json:"codeOwners,omitempty"`
 	}{
 		BaseRepository: c.BaseRepository,
 		ExternalID:     c.ExternalID,
@@ -113,6 +123,8 @@ func (c *ChangesetSpec) MarshalJSON() ([]byte, error) {
 		Title:          c.Title,
 		Body:           c.Body,
 		Commits:        c.Commits,
+		DependsOn:      c.DependsOn,
+		CodeOwners:     c.CodeOwners,
 	}
 	if !c.Published.Nil() {
 		v.Published = &c.Published
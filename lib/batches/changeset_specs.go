@@ -102,6 +102,11 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, binaryDiffs bool, fallbackAu
 		return nil, err
 	}
 
+	dependsOn, err := input.Template.PrerequisitesForRepo(input.Repository.Name)
+	if err != nil {
+		return nil, err
+	}
+
 	newSpec := func(branch string, diff []byte) *ChangesetSpec {
 		var published any = nil
 		if input.Template.Published != nil {
@@ -134,7 +139,9 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, binaryDiffs bool, fallbackAu
 					Diff:        diff,
 				},
 			},
-			Published: PublishedValue{Val: published},
+			Published:  PublishedValue{Val: published},
+			DependsOn:  dependsOn,
+			CodeOwners: input.Template.CodeOwners,
 		}
 	}
 
@@ -146,6 +146,36 @@ func TestCreateChangesetSpecs(t *testing.T) {
 			},
 			wantErr: "",
 		},
+		{
+			name: "depends on prerequisites",
+			input: inputWith(defaultInput, func(input *ChangesetSpecInput) {
+				input.Template.Published = parsePublishedFieldString(t, "false")
+				input.Template.DependsOn = []ChangesetDependency{
+					{Repository: "github.com/sourcegraph/*", Prerequisites: []string{"github.com/sourcegraph/sourcegraph", "github.com/sourcegraph/go-diff"}},
+					{Repository: "github.com/sourcegraph/src-cli", Prerequisites: []string{"github.com/sourcegraph/src-cli", "github.com/sourcegraph/go-diff"}},
+					{Repository: "github.com/other/*", Prerequisites: []string{"github.com/other/lib"}},
+				}
+			}),
+			want: []*ChangesetSpec{
+				specWith(defaultChangesetSpec, func(s *ChangesetSpec) {
+					s.DependsOn = []string{"github.com/sourcegraph/go-diff", "github.com/sourcegraph/sourcegraph"}
+				}),
+			},
+			wantErr: "",
+		},
+		{
+			name: "code owners",
+			input: inputWith(defaultInput, func(input *ChangesetSpecInput) {
+				input.Template.Published = parsePublishedFieldString(t, "false")
+				input.Template.CodeOwners = &CodeOwners{RequestReviews: true}
+			}),
+			want: []*ChangesetSpec{
+				specWith(defaultChangesetSpec, func(s *ChangesetSpec) {
+					s.CodeOwners = &CodeOwners{RequestReviews: true}
+				}),
+			},
+			wantErr: "",
+		},
 		{
 			name:   "publish with fallback author",
 			input:  defaultInput,
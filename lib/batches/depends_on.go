@@ -0,0 +1,41 @@
+package batches
+
+import (
+	"sort"
+
+	"github.com/gobwas/glob"
+)
+
+// PrerequisitesForRepo returns the names of the repositories whose changesets
+// have to be merged before the changesets in the given repository can be
+// published, as declared in the dependsOn field of the changeset template.
+//
+// A repository is never a prerequisite of itself.
+func (t *ChangesetTemplate) PrerequisitesForRepo(repoName string) ([]string, error) {
+	seen := map[string]struct{}{}
+	for _, dep := range t.DependsOn {
+		g, err := glob.Compile(dep.Repository)
+		if err != nil {
+			return nil, NewValidationError(err)
+		}
+		if !g.Match(repoName) {
+			continue
+		}
+		for _, p := range dep.Prerequisites {
+			if p != repoName {
+				seen[p] = struct{}{}
+			}
+		}
+	}
+
+	if len(seen) == 0 {
+		return nil, nil
+	}
+
+	prerequisites := make([]string, 0, len(seen))
+	for p := range seen {
+		prerequisites = append(prerequisites, p)
+	}
+	sort.Strings(prerequisites)
+	return prerequisites, nil
+}
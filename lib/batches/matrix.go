@@ -0,0 +1,124 @@
+package batches
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/lib/batches/template"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// maxMatrixCombinations is the maximum number of combinations a single step
+// matrix may expand to.
+const maxMatrixCombinations = 64
+
+// Matrix maps the names of matrix variables to the values a step is run with.
+// A step with a matrix is run once for every combination of values.
+type Matrix map[string][]any
+
+// Combinations returns all combinations of the values in the matrix. The
+// variables are iterated in alphabetical order, with the values of the last
+// variable changing fastest, so the order of the combinations is stable.
+func (m Matrix) Combinations() []map[string]any {
+	if len(m) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	combinations := []map[string]any{{}}
+	for _, k := range keys {
+		var next []map[string]any
+		for _, c := range combinations {
+			for _, v := range m[k] {
+				combination := make(map[string]any, len(c)+1)
+				for ck, cv := range c {
+					combination[ck] = cv
+				}
+				combination[k] = v
+				next = append(next, combination)
+			}
+		}
+		combinations = next
+	}
+
+	return combinations
+}
+
+// size returns the number of combinations of the matrix without computing
+// them.
+func (m Matrix) size() int {
+	if len(m) == 0 {
+		return 0
+	}
+	n := 1
+	for _, values := range m {
+		n *= len(values)
+		if n > maxMatrixCombinations {
+			// Avoid overflowing on absurdly large matrices.
+			return n
+		}
+	}
+	return n
+}
+
+// MatrixKey returns the key under which the outputs of the step run with the
+// given matrix values are stored, for example "node=16,os=linux".
+func MatrixKey(values map[string]any) string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = fmt.Sprintf("%s=%v", k, values[k])
+	}
+	return strings.Join(parts, ",")
+}
+
+// expandMatrixSteps replaces every step with a matrix by one step per
+// combination of its matrix values. The expanded steps have MatrixValues set
+// to the combination they run with, and share a MatrixGroup so that they are
+// run in parallel in the same workspace.
+func expandMatrixSteps(steps []Step) ([]Step, error) {
+	var expanded []Step
+	for i, step := range steps {
+		if len(step.Matrix) == 0 {
+			expanded = append(expanded, step)
+			continue
+		}
+
+		for _, values := range step.Matrix.Combinations() {
+			s := step
+			s.Matrix = nil
+			s.MatrixValues = values
+			s.MatrixGroup = i + 1
+
+			// The container image can't be templated at execution time, so
+			// render the matrix variables into it here.
+			if strings.Contains(s.Container, "matrix.") {
+				var container strings.Builder
+				if err := template.RenderStepTemplate("step-container", s.Container, &container, &template.StepContext{Matrix: values}); err != nil {
+					return nil, NewValidationError(errors.Wrapf(err, "step %d container", i+1))
+				}
+				s.Container = container.String()
+			}
+
+			expanded = append(expanded, s)
+		}
+	}
+	return expanded, nil
+}
+
+// MatrixGroupContinues reports whether the step at index i is followed by
+// another combination of the same matrix step.
+func MatrixGroupContinues(steps []Step, i int) bool {
+	return steps[i].MatrixGroup != 0 && i+1 < len(steps) && steps[i+1].MatrixGroup == steps[i].MatrixGroup
+}
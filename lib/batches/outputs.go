@@ -13,6 +13,10 @@ import (
 
 // SetOutputs renders the outputs of the current step into the global outputs
 // map using templating.
+//
+// If the step is run with matrix values, the global value of each output is
+// a map from the MatrixKey of each combination the step has run with so far to
+// the value of the output for that combination.
 func SetOutputs(stepOutputs Outputs, global map[string]interface{}, stepCtx *template.StepContext) error {
 	for name, output := range stepOutputs {
 		var value bytes.Buffer
@@ -32,17 +36,35 @@ func SetOutputs(stepOutputs Outputs, global map[string]interface{}, stepCtx *tem
 			if err := yamlv3.NewDecoder(&value).Decode(&out); err != nil {
 				return err
 			}
-			global[name] = out
+			setOutput(global, name, out, stepCtx.Matrix)
 		case "json":
 			var out interface{}
 			if err := json.NewDecoder(&value).Decode(&out); err != nil {
 				return err
 			}
-			global[name] = out
+			setOutput(global, name, out, stepCtx.Matrix)
 		default:
-			global[name] = value.String()
+			setOutput(global, name, value.String(), stepCtx.Matrix)
 		}
 	}
 
 	return nil
 }
+
+func setOutput(global map[string]interface{}, name string, value interface{}, matrix map[string]interface{}) {
+	if len(matrix) == 0 {
+		global[name] = value
+		return
+	}
+
+	// Copy the values of the previous combinations, since the map may be
+	// shared with the results of previous steps.
+	perCombination := map[string]interface{}{}
+	if previous, ok := global[name].(map[string]interface{}); ok {
+		for k, v := range previous {
+			perCombination[k] = v
+		}
+	}
+	perCombination[MatrixKey(matrix)] = value
+	global[name] = perCombination
+}
@@ -217,6 +217,27 @@ const BatchSpecJSON = `{
               "${{ eq previous_step.stdout \"success\" }}"
             ]
           },
+          "matrix": {
+            "type": ["object", "null"],
+            "description": "Variables whose values the step is run with. The step is run in parallel for every combination of the values, and the values of the current combination can be referenced via matrix.<name-of-variable>. The outputs of the step are stored per combination.",
+            "minProperties": 1,
+            "additionalProperties": false,
+            "patternProperties": {
+              "^[A-Za-z_][A-Za-z0-9_]*$": {
+                "type": "array",
+                "description": "The values of the matrix variable.",
+                "minItems": 1,
+                "items": {
+                  "type": ["string", "number", "boolean"]
+                }
+              }
+            },
+            "examples": [
+              {
+                "node": ["16", "18", "20"]
+              }
+            ]
+          },
           "mount": {
             "description": "Files that are mounted to the Docker container.",
             "type": ["array", "null"],
@@ -399,6 +420,48 @@ const BatchSpecJSON = `{
               }
             }
           ]
+        },
+        "dependsOn": {
+          "type": "array",
+          "description": "Dependencies between the changesets of this batch change. Changesets in repositories matching a dependency are kept unpublished until the changesets in all of its prerequisite repositories are merged.",
+          "items": {
+            "title": "ChangesetDependency",
+            "type": "object",
+            "additionalProperties": false,
+            "required": ["repository", "prerequisites"],
+            "properties": {
+              "repository": {
+                "type": "string",
+                "description": "A glob pattern to match the names of the repositories whose changesets depend on the prerequisites.",
+                "examples": ["github.com/sourcegraph/*-service"]
+              },
+              "prerequisites": {
+                "type": "array",
+                "description": "The names of the repositories whose changesets have to be merged first.",
+                "minItems": 1,
+                "items": {
+                  "type": "string"
+                },
+                "examples": [["github.com/sourcegraph/go-lib"]]
+              }
+            }
+          }
+        },
+        "codeOwners": {
+          "title": "CodeOwners",
+          "type": "object",
+          "description": "Involve the code owners of the files touched by each changeset, as defined by the CODEOWNERS file of the repository, once the changeset is published.",
+          "additionalProperties": false,
+          "properties": {
+            "requestReviews": {
+              "type": "boolean",
+              "description": "Request a review of the changeset from the code owners."
+            },
+            "assign": {
+              "type": "boolean",
+              "description": "Assign the code owners to the changeset. Only supported on GitHub and GitLab."
+            }
+          }
         }
       }
     }
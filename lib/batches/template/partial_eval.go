@@ -207,6 +207,15 @@ func evalNode(ctx *StepContext, n parse.Node) (reflect.Value, bool) {
 				case "description":
 					return reflect.ValueOf(ctx.BatchChange.Description), true
 				}
+
+			case "matrix":
+				// Numbers are decoded as float64, which wouldn't compare
+				// equal to the int literals in a template, so we only eval
+				// strings and bools.
+				switch v := ctx.Matrix[n.Field[0]].(type) {
+				case string, bool:
+					return reflect.ValueOf(v), true
+				}
 			}
 		}
 		return noValue, false
@@ -67,6 +67,11 @@ func ValidateBatchSpecTemplate(spec string) (bool, error) {
 	indexRe := regexp.MustCompile(`(?i)\$\{\{\s*index\s*[^}]*\}\}`)
 	spec = indexRe.ReplaceAllString(spec, "")
 
+	// Matrix values depend on the step they're used in, so we strip them
+	// too.
+	matrixRe := regexp.MustCompile(`(?i)\$\{\{\s*[^}]*\s*matrix\.[^}]*\}\}`)
+	spec = matrixRe.ReplaceAllString(spec, "")
+
 	// By default, text/template will continue even if it encounters a key that is not
 	// indexed in any of the provided `FuncMap`s. A missing key is an indication of an
 	// unknown or mistyped template variable which would invalidate the batch spec, so we
@@ -190,6 +195,9 @@ type StepContext struct {
 	PreviousStep execution.AfterStepResult
 	// Repository is the Sourcegraph repository in which the steps are executed.
 	Repository Repository
+	// Matrix are the matrix values the current step is run with. Empty when
+	// the step has no matrix.
+	Matrix map[string]any
 }
 
 // ToFuncMap returns a template.FuncMap to access fields on the StepContext in a
@@ -246,6 +254,9 @@ func (stepCtx *StepContext) ToFuncMap() template.FuncMap {
 				"description": stepCtx.BatchChange.Description,
 			}
 		},
+		"matrix": func() map[string]any {
+			return stepCtx.Matrix
+		},
 	}
 }
 
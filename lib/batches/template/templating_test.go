@@ -124,6 +124,12 @@ func TestValidateBatchSpecTemplate(t *testing.T) {
 			wantValid: false,
 			wantErr:   errors.New("validating batch spec template: unknown templating variable: 'outputz'"),
 		},
+		{
+			name: "matrix variables are ignored",
+			batchSpec: `${{ matrix.node }}
+						${{ eq matrix.os "linux" }}`,
+			wantValid: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -347,6 +353,12 @@ ${{ steps.path }}
 
 `,
 		},
+		{
+			name:    "matrix values",
+			stepCtx: &StepContext{Matrix: map[string]any{"node": float64(18), "module": "api"}},
+			run:     `${{ matrix.module }} on node ${{ matrix.node }}`,
+			want:    `api on node 18`,
+		},
 	}
 
 	for _, tc := range tests {
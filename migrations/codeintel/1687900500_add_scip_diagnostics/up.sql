@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS codeintel_scip_diagnostics (
+    id bigserial PRIMARY KEY,
+    upload_id integer NOT NULL,
+    document_lookup_id bigint NOT NULL,
+    severity integer NOT NULL,
+    code text NOT NULL,
+    message text NOT NULL,
+    source text NOT NULL,
+    start_line integer NOT NULL,
+    start_character integer NOT NULL,
+    end_line integer NOT NULL,
+    end_character integer NOT NULL,
+    CONSTRAINT codeintel_scip_diagnostics_document_lookup_id_fk FOREIGN KEY (document_lookup_id) REFERENCES codeintel_scip_document_lookup(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS codeintel_scip_diagnostics_upload_id_severity_code ON codeintel_scip_diagnostics(upload_id, severity, code);
+CREATE INDEX IF NOT EXISTS codeintel_scip_diagnostics_document_lookup_id ON codeintel_scip_diagnostics(document_lookup_id);
+
+COMMENT ON TABLE codeintel_scip_diagnostics IS 'Compiler and linter diagnostics extracted from the documents of a particular SCIP index.';
+COMMENT ON COLUMN codeintel_scip_diagnostics.upload_id IS 'The identifier of the upload that provided this SCIP index.';
+COMMENT ON COLUMN codeintel_scip_diagnostics.document_lookup_id IS 'A reference to the `id` column of [`codeintel_scip_document_lookup`](#table-publiccodeintel_scip_document_lookup). Joining on this table yields the document path.';
+COMMENT ON COLUMN codeintel_scip_diagnostics.severity IS 'The severity of the diagnostic as encoded by the SCIP `Severity` enum (1 = error, 2 = warning, 3 = information, 4 = hint).';
+COMMENT ON COLUMN codeintel_scip_diagnostics.code IS 'The tool-specific code identifying the kind of diagnostic (e.g., `SA1019`). Empty if not supplied by the indexer.';
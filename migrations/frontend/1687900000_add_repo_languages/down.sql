@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS repo_languages;
+DROP TABLE IF EXISTS repo_language_stats;
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS repo_language_stats (
+    repo_id integer NOT NULL PRIMARY KEY REFERENCES repo(id) ON DELETE CASCADE,
+    commit_id text NOT NULL,
+    updated_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE repo_language_stats IS 'The commit of the default branch of each repository that repo_languages was last computed for.';
+
+CREATE TABLE IF NOT EXISTS repo_languages (
+    repo_id integer NOT NULL REFERENCES repo_language_stats(repo_id) ON DELETE CASCADE,
+    language text NOT NULL,
+    total_bytes bigint NOT NULL,
+    total_lines bigint NOT NULL,
+    percentage double precision NOT NULL,
+    PRIMARY KEY (repo_id, language)
+);
+
+COMMENT ON TABLE repo_languages IS 'The language statistics of the default branch of each repository, as computed by the inventory package.';
+COMMENT ON COLUMN repo_languages.percentage IS 'The share of the repository written in the language by bytes, between 0 and 100.';
+
+CREATE INDEX IF NOT EXISTS repo_languages_lower_language_percentage ON repo_languages (lower(language), percentage);
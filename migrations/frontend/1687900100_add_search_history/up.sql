@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS search_history (
+    id serial PRIMARY KEY,
+    user_id integer NOT NULL REFERENCES users(id) ON DELETE CASCADE DEFERRABLE,
+    query text NOT NULL,
+    pattern_type text NOT NULL,
+    search_mode integer NOT NULL DEFAULT 0,
+    result_count integer NOT NULL DEFAULT 0,
+    duration_ms integer NOT NULL DEFAULT 0,
+    alert_title text,
+    run_count integer NOT NULL DEFAULT 1,
+    pinned boolean NOT NULL DEFAULT false,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    last_run_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE search_history IS 'The searches run by each user. Running the same query again updates the existing entry.';
+COMMENT ON COLUMN search_history.result_count IS 'The number of results of the most recent run of the query.';
+COMMENT ON COLUMN search_history.duration_ms IS 'The duration of the most recent run of the query in milliseconds.';
+COMMENT ON COLUMN search_history.alert_title IS 'The title of the alert shown for the most recent run of the query, if any.';
+COMMENT ON COLUMN search_history.pinned IS 'Pinned entries are exempt from retention.';
+
+CREATE UNIQUE INDEX IF NOT EXISTS search_history_user_id_query_md5_pattern_type ON search_history (user_id, md5(query), pattern_type);
+CREATE INDEX IF NOT EXISTS search_history_user_id_last_run_at ON search_history (user_id, last_run_at DESC);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS vulnerability_match_call_sites;
+
+DROP INDEX IF EXISTS vulnerability_matches_reachability_checked_at;
+
+ALTER TABLE vulnerability_matches DROP COLUMN IF EXISTS reachability_checked_at;
+ALTER TABLE vulnerability_matches DROP COLUMN IF EXISTS reachable;
@@ -0,0 +1,22 @@
+ALTER TABLE vulnerability_matches ADD COLUMN IF NOT EXISTS reachable boolean;
+ALTER TABLE vulnerability_matches ADD COLUMN IF NOT EXISTS reachability_checked_at timestamp with time zone;
+
+COMMENT ON COLUMN vulnerability_matches.reachable IS 'Whether the matched index references one of the affected symbols of the vulnerability. Null when reachability cannot be determined (e.g., the vulnerability lists no affected symbols).';
+COMMENT ON COLUMN vulnerability_matches.reachability_checked_at IS 'The time at which the reachability of this match was last determined.';
+
+CREATE INDEX IF NOT EXISTS vulnerability_matches_reachability_checked_at ON vulnerability_matches(id) WHERE reachability_checked_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS vulnerability_match_call_sites (
+    id                      SERIAL PRIMARY KEY,
+    vulnerability_match_id  INT NOT NULL REFERENCES vulnerability_matches(id) ON DELETE CASCADE,
+    symbol                  TEXT NOT NULL,
+    document_path           TEXT NOT NULL,
+    start_line              INT NOT NULL,
+    start_character         INT NOT NULL,
+    end_line                INT NOT NULL,
+    end_character           INT NOT NULL
+);
+
+COMMENT ON TABLE vulnerability_match_call_sites IS 'Example locations within a matched index that reference an affected symbol of the vulnerability.';
+
+CREATE INDEX IF NOT EXISTS vulnerability_match_call_sites_vulnerability_match_id ON vulnerability_match_call_sites(vulnerability_match_id);
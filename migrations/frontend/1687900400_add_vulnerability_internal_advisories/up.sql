@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS vulnerability_internal_advisories (
+    id          SERIAL PRIMARY KEY,
+    source_id   TEXT NOT NULL,
+    advisory    JSONB NOT NULL,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE vulnerability_internal_advisories IS 'Vulnerability advisories authored on this instance. These are synced into the vulnerabilities table alongside advisories of external sources.';
+COMMENT ON COLUMN vulnerability_internal_advisories.advisory IS 'The serialized advisory, excluding its source identifier.';
+
+CREATE UNIQUE INDEX IF NOT EXISTS vulnerability_internal_advisories_source_id ON vulnerability_internal_advisories(source_id);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS codeintel_upload_storage_quotas;
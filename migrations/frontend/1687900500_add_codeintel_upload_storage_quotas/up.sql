@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS codeintel_upload_storage_quotas (
+    id                   SERIAL PRIMARY KEY,
+    repository_id        INTEGER REFERENCES repo(id) ON DELETE CASCADE,
+    max_repository_bytes BIGINT,
+    max_total_bytes      BIGINT,
+    created_at           TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at           TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT codeintel_upload_storage_quotas_max_total_bytes_global CHECK (repository_id IS NULL OR max_total_bytes IS NULL)
+);
+
+COMMENT ON TABLE codeintel_upload_storage_quotas IS 'Storage quotas enforced by the precise code intelligence upload expirer. The row with a null repository identifier holds the instance-wide defaults.';
+COMMENT ON COLUMN codeintel_upload_storage_quotas.repository_id IS 'The repository to which the quota applies. Null for the instance-wide quota.';
+COMMENT ON COLUMN codeintel_upload_storage_quotas.max_repository_bytes IS 'The maximum number of bytes of precise code intelligence data retained for a single repository. On the instance-wide row, this is the default for repositories without a quota of their own.';
+COMMENT ON COLUMN codeintel_upload_storage_quotas.max_total_bytes IS 'The maximum number of bytes of precise code intelligence data retained across all repositories. Only set on the instance-wide row.';
+
+CREATE UNIQUE INDEX IF NOT EXISTS codeintel_upload_storage_quotas_repository_id ON codeintel_upload_storage_quotas(COALESCE(repository_id, 0));
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS batch_change_scheduled_runs;
+
+DROP INDEX IF EXISTS batch_changes_next_scheduled_run_at;
+
+ALTER TABLE batch_changes DROP COLUMN IF EXISTS next_scheduled_run_at;
+ALTER TABLE batch_changes DROP COLUMN IF EXISTS schedule_paused;
+ALTER TABLE batch_changes DROP COLUMN IF EXISTS schedule;
@@ -0,0 +1,83 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS batch_change_auto_merge_policies (
+    batch_change_id BIGINT PRIMARY KEY REFERENCES batch_changes(id) ON DELETE CASCADE DEFERRABLE,
+    required_approvals INTEGER NOT NULL DEFAULT 0 CHECK (required_approvals >= 0),
+    require_passing_checks BOOLEAN NOT NULL DEFAULT TRUE,
+    merge_method TEXT NOT NULL DEFAULT 'MERGE',
+    maintenance_window_days TEXT[] NOT NULL DEFAULT '{}',
+    maintenance_window_start TEXT,
+    maintenance_window_end TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE batch_change_auto_merge_policies IS 'Policies under which the changeset syncer merges the changesets owned by a batch change automatically.';
+COMMENT ON COLUMN batch_change_auto_merge_policies.maintenance_window_days IS 'The days of the week on which changesets may be merged. Empty means every day.';
+COMMENT ON COLUMN batch_change_auto_merge_policies.maintenance_window_start IS 'The time of day in UTC, formatted as HH:MM, from which changesets may be merged. Null means the whole day.';
+
+-- Note that we have to regenerate the reconciler_changesets view, as the SELECT
+-- statement in the view definition isn't refreshed when the fields change within the
+-- changesets table.
+DROP VIEW IF EXISTS
+    reconciler_changesets;
+
+ALTER TABLE changesets
+    ADD COLUMN IF NOT EXISTS merging BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE VIEW reconciler_changesets AS
+SELECT c.id,
+    c.batch_change_ids,
+    c.repo_id,
+    c.queued_at,
+    c.created_at,
+    c.updated_at,
+    c.metadata,
+    c.external_id,
+    c.external_service_type,
+    c.external_deleted_at,
+    c.external_branch,
+    c.external_updated_at,
+    c.external_state,
+    c.external_review_state,
+    c.external_check_state,
+    c.commit_verification,
+    c.diff_stat_added,
+    c.diff_stat_deleted,
+    c.sync_state,
+    c.current_spec_id,
+    c.previous_spec_id,
+    c.publication_state,
+    c.owned_by_batch_change_id,
+    c.reconciler_state,
+    c.computed_state,
+    c.failure_message,
+    c.started_at,
+    c.finished_at,
+    c.process_after,
+    c.num_resets,
+    c.closing,
+    c.num_failures,
+    c.log_contents,
+    c.execution_logs,
+    c.syncer_error,
+    c.external_title,
+    c.worker_hostname,
+    c.ui_publication_state,
+    c.last_heartbeat_at,
+    c.external_fork_name,
+    c.external_fork_namespace,
+    c.detached_at,
+    c.previous_failure_message,
+    c.merging
+FROM changesets c
+JOIN repo r ON r.id = c.repo_id
+WHERE r.deleted_at IS NULL AND EXISTS (
+    SELECT 1
+    FROM batch_changes
+        LEFT JOIN users namespace_user ON batch_changes.namespace_user_id = namespace_user.id
+        LEFT JOIN orgs namespace_org ON batch_changes.namespace_org_id = namespace_org.id
+    WHERE c.batch_change_ids ? batch_changes.id::text AND namespace_user.deleted_at IS NULL AND namespace_org.deleted_at IS NULL
+    );
+
+COMMIT;
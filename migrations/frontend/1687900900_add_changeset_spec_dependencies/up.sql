@@ -0,0 +1,4 @@
+ALTER TABLE changeset_specs
+    ADD COLUMN IF NOT EXISTS depends_on text[] NOT NULL DEFAULT '{}'::text[];
+
+COMMENT ON COLUMN changeset_specs.depends_on IS 'The names of the repositories whose changesets in the same batch spec have to be merged before this changeset is published.';
@@ -0,0 +1,3 @@
+ALTER TABLE changeset_specs
+    DROP COLUMN IF EXISTS request_owner_reviews,
+    DROP COLUMN IF EXISTS assign_owners;
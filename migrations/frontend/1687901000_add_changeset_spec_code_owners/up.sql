@@ -0,0 +1,7 @@
+ALTER TABLE changeset_specs
+    ADD COLUMN IF NOT EXISTS request_owner_reviews boolean NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS assign_owners boolean NOT NULL DEFAULT false;
+
+COMMENT ON COLUMN changeset_specs.request_owner_reviews IS 'Whether a review is requested from the code owners of the changed files when the changeset is published.';
+
+COMMENT ON COLUMN changeset_specs.assign_owners IS 'Whether the code owners of the changed files are assigned to the changeset when it is published.';
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS batch_changes_changeset_count_snapshots (
+    snapshot_date          DATE NOT NULL,
+    batch_change_id        BIGINT NOT NULL REFERENCES batch_changes(id) ON DELETE CASCADE DEFERRABLE,
+    repo_id                INTEGER NOT NULL REFERENCES repo(id) ON DELETE CASCADE DEFERRABLE,
+    total                  INTEGER NOT NULL DEFAULT 0,
+    merged                 INTEGER NOT NULL DEFAULT 0,
+    closed                 INTEGER NOT NULL DEFAULT 0,
+    draft                  INTEGER NOT NULL DEFAULT 0,
+    open                   INTEGER NOT NULL DEFAULT 0,
+    open_approved          INTEGER NOT NULL DEFAULT 0,
+    open_changes_requested INTEGER NOT NULL DEFAULT 0,
+    open_pending           INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (batch_change_id, repo_id, snapshot_date)
+);
+
+CREATE INDEX IF NOT EXISTS batch_changes_changeset_count_snapshots_snapshot_date ON batch_changes_changeset_count_snapshots(snapshot_date);
+
+COMMENT ON TABLE batch_changes_changeset_count_snapshots IS 'Daily snapshots of the number of published changesets of a batch change in a repository, by state.';
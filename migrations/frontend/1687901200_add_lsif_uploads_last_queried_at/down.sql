@@ -0,0 +1,2 @@
+ALTER TABLE lsif_uploads
+    DROP COLUMN IF EXISTS last_queried_at;
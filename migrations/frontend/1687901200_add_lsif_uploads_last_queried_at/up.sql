@@ -0,0 +1,4 @@
+ALTER TABLE lsif_uploads
+    ADD COLUMN IF NOT EXISTS last_queried_at timestamp with time zone;
+
+COMMENT ON COLUMN lsif_uploads.last_queried_at IS 'The last time this upload was used to answer a code navigation query. Updated at most once an hour.';
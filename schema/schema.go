@@ -235,6 +235,16 @@ type AzureDevOpsConnection struct {
 	// Username description: A username for authentication with the Azure DevOps code host.
 	Username string `json:"username"`
 }
+type BatchChangeEmailPatches struct {
+	// Cc description: Additional recipients of the patches, such as the maintainers of the project.
+	Cc []string `json:"cc,omitempty"`
+	// Repository description: Regular expression matched against the names of the repositories whose changesets are sent as patches.
+	Repository string `json:"repository"`
+	// SubjectPrefix description: The prefix in brackets of the subject of the patch emails, like `git format-patch --subject-prefix`.
+	SubjectPrefix string `json:"subjectPrefix,omitempty"`
+	// To description: The recipients of the patches, usually the mailing list of the project.
+	To []string `json:"to"`
+}
 type BatchChangeRolloutWindow struct {
 	// Days description: Day(s) the window applies to. If omitted, this rule applies to all days of the week.
 	Days []string `json:"days,omitempty"`
@@ -468,8 +478,12 @@ type BranchChangesetSpec struct {
 	BaseRev string `json:"baseRev"`
 	// Body description: The body (description) of the changeset on the code host.
 	Body string `json:"body"`
+	// CodeOwners description: Whether the code owners of the files touched by the changeset are asked for a review of, or assigned to, the changeset once it is published.
+	CodeOwners *ChangesetSpecCodeOwners `json:"codeOwners,omitempty"`
 	// Commits description: The Git commits with the proposed changes. These commits are pushed to the head ref.
 	Commits []*GitCommitDescription `json:"commits"`
+	// DependsOn description: The names of the repositories whose changesets in the same batch change have to be merged before this changeset is published.
+	DependsOn []string `json:"dependsOn,omitempty"`
 	// HeadRef description: The full name of the Git ref that holds the changes proposed by this changeset. This ref will be created or updated with the commits.
 	HeadRef string `json:"headRef"`
 	// HeadRepository description: The GraphQL ID of the repository that contains the branch with this changeset's changes. Fork repositories and cross-repository changesets are not yet supported. Therefore, headRepository must be equal to baseRepository.
@@ -510,6 +524,18 @@ type BuiltinAuthProvider struct {
 	AllowSignup bool   `json:"allowSignup,omitempty"`
 	Type        string `json:"type"`
 }
+type ChangesetDependency struct {
+	// Prerequisites description: The names of the repositories whose changesets have to be merged first.
+	Prerequisites []string `json:"prerequisites"`
+	// Repository description: A glob pattern to match the names of the repositories whose changesets depend on the prerequisites.
+	Repository string `json:"repository"`
+}
+
+// ChangesetSpecCodeOwners description: Whether the code owners of the files touched by the changeset are asked for a review of, or assigned to, the changeset once it is published.
+type ChangesetSpecCodeOwners struct {
+	Assign         bool `json:"assign,omitempty"`
+	RequestReviews bool `json:"requestReviews,omitempty"`
+}
 
 // ChangesetTemplate description: A template describing how to create (and update) changesets with the file changes produced by the command steps.
 type ChangesetTemplate struct {
@@ -517,8 +543,12 @@ type ChangesetTemplate struct {
 	Body string `json:"body,omitempty"`
 	// Branch description: The name of the Git branch to create or update on each repository with the changes.
 	Branch string `json:"branch"`
+	// CodeOwners description: Involve the code owners of the files touched by each changeset, as defined by the CODEOWNERS file of the repository, once the changeset is published.
+	CodeOwners *CodeOwners `json:"codeOwners,omitempty"`
 	// Commit description: The Git commit to create with the changes.
 	Commit ExpandedGitCommitDescription `json:"commit"`
+	// DependsOn description: Dependencies between the changesets of this batch change. Changesets in repositories matching a dependency are kept unpublished until the changesets in all of its prerequisite repositories are merged.
+	DependsOn []*ChangesetDependency `json:"dependsOn,omitempty"`
 	// Fork description: Whether to publish the changeset to a fork of the target repository. If omitted, the changeset will be published to a branch directly on the target repository, unless the global `batches.enforceFork` setting is enabled. If set, this property will override any global setting.
 	Fork bool `json:"fork,omitempty"`
 	// Published description: Whether to publish the changeset. An unpublished changeset can be previewed on Sourcegraph by any person who can view the batch change, but its commit, branch, and pull request aren't created on the code host. A published changeset results in a commit, branch, and pull request being created on the code host. If omitted, the publication state is controlled from the Batch Changes UI.
@@ -542,6 +572,14 @@ type CloudKMSEncryptionKey struct {
 	Type            string `json:"type"`
 }
 
+// CodeOwners description: Involve the code owners of the files touched by each changeset, as defined by the CODEOWNERS file of the repository, once the changeset is published.
+type CodeOwners struct {
+	// Assign description: Assign the code owners to the changeset. Only supported on GitHub and GitLab.
+	Assign bool `json:"assign,omitempty"`
+	// RequestReviews description: Request a review of the changeset from the code owners.
+	RequestReviews bool `json:"requestReviews,omitempty"`
+}
+
 // CodyGateway description: Configuration related to the Cody Gateway service management. This should only be used on sourcegraph.com.
 type CodyGateway struct {
 	// BigQueryDataset description: The dataset to pull BigQuery Cody Gateway related events from.
@@ -1973,6 +2011,16 @@ type SMTPServerConfig struct {
 	// Username description: The username to use when communicating with the SMTP server.
 	Username string `json:"username,omitempty"`
 }
+
+// SearchHistory description: Configuration for the search history of signed-in users. Search history is stored on the server, synced across devices and used to rank query suggestions.
+type SearchHistory struct {
+	// Enabled description: Whether searches run by signed-in users are recorded in their search history. Disabling this stops recording new searches and hides existing history, which is deleted once it expires.
+	Enabled *bool `json:"enabled,omitempty"`
+	// MaxEntriesPerUser description: The maximum number of unpinned search history entries retained per user. The least recently run entries are deleted first.
+	MaxEntriesPerUser int `json:"maxEntriesPerUser,omitempty"`
+	// Retention description: How long search history entries are retained after they were last run. Pinned entries are never deleted. The string format is that of the Duration type in the Go time package (https://golang.org/pkg/time/#ParseDuration). Values lower than 1 hour will be treated as 1 hour. By default, this is "2160h", or 90 days.
+	Retention string `json:"retention,omitempty"`
+}
 type SearchIndexRevisionsRule struct {
 	// Name description: Regular expression which matches against the name of a repository (e.g. "^github\.com/owner/name$").
 	Name string `json:"name,omitempty"`
@@ -2386,10 +2434,14 @@ type SiteConfiguration struct {
 	AuthzRefreshInterval int `json:"authz.refreshInterval,omitempty"`
 	// BatchChangesAutoDeleteBranch description: Automatically delete branches created for Batch Changes changesets when the changeset is merged or closed, for supported code hosts. Overrides any setting on the repository on the code host itself.
 	BatchChangesAutoDeleteBranch bool `json:"batchChanges.autoDeleteBranch,omitempty"`
+	// BatchChangesAutoMergeRateLimit description: The maximum number of changesets that auto-merge policies merge per hour on each code host. Set to 0 to stop merging changesets automatically.
+	BatchChangesAutoMergeRateLimit *int `json:"batchChanges.autoMergeRateLimit,omitempty"`
 	// BatchChangesChangesetsRetention description: How long changesets will be retained after they have been detached from a batch change.
 	BatchChangesChangesetsRetention string `json:"batchChanges.changesetsRetention,omitempty"`
 	// BatchChangesDisableWebhooksWarning description: Hides Batch Changes warnings about webhooks not being configured.
 	BatchChangesDisableWebhooksWarning bool `json:"batchChanges.disableWebhooksWarning,omitempty"`
+	// BatchChangesEmailPatches description: Repositories whose changesets are sent as patches to a mailing list instead of being opened on the code host. Patches are sent using the SMTP server configured in `email.smtp`. The state of these changesets is not synced from the code host: mark them as merged or closed in Sourcegraph once the patches have been applied upstream or rejected.
+	BatchChangesEmailPatches []*BatchChangeEmailPatches `json:"batchChanges.emailPatches,omitempty"`
 	// BatchChangesEnabled description: Enables/disables the Batch Changes feature.
 	BatchChangesEnabled *bool `json:"batchChanges.enabled,omitempty"`
 	// BatchChangesEnforceForks description: When enabled, all branches created by batch changes will be pushed to forks of the original repository.
@@ -2595,6 +2647,8 @@ type SiteConfiguration struct {
 	ScimAuthToken string `json:"scim.authToken,omitempty"`
 	// ScimIdentityProvider description: Identity provider used for SCIM support.  "STANDARD" should be used unless a more specific value is available
 	ScimIdentityProvider string `json:"scim.identityProvider,omitempty"`
+	// SearchHistory description: Configuration for the search history of signed-in users. Search history is stored on the server, synced across devices and used to rank query suggestions.
+	SearchHistory *SearchHistory `json:"search.history,omitempty"`
 	// SearchIndexSymbolsEnabled description: Whether indexed symbol search is enabled. This is contingent on the indexed search configuration, and is true by default for instances with indexed search enabled. Enabling this will cause every repository to re-index, which is a time consuming (several hours) operation. Additionally, it requires more storage and ram to accommodate the added symbols information in the search index.
 	SearchIndexSymbolsEnabled *bool `json:"search.index.symbols.enabled,omitempty"`
 	// SearchLargeFiles description: A list of file glob patterns where matching files will be indexed and searched regardless of their size. Files still need to be valid utf-8 to be indexed. The glob pattern syntax can be found here: https://github.com/bmatcuk/doublestar#patterns.
@@ -2659,8 +2713,10 @@ func (v *SiteConfiguration) UnmarshalJSON(data []byte) error {
 	delete(m, "authz.enforceForSiteAdmins")
 	delete(m, "authz.refreshInterval")
 	delete(m, "batchChanges.autoDeleteBranch")
+	delete(m, "batchChanges.autoMergeRateLimit")
 	delete(m, "batchChanges.changesetsRetention")
 	delete(m, "batchChanges.disableWebhooksWarning")
+	delete(m, "batchChanges.emailPatches")
 	delete(m, "batchChanges.enabled")
 	delete(m, "batchChanges.enforceForks")
 	delete(m, "batchChanges.restrictToAdmins")
@@ -2762,6 +2818,7 @@ func (v *SiteConfiguration) UnmarshalJSON(data []byte) error {
 	delete(m, "repoPurgeWorker")
 	delete(m, "scim.authToken")
 	delete(m, "scim.identityProvider")
+	delete(m, "search.history")
 	delete(m, "search.index.symbols.enabled")
 	delete(m, "search.largeFiles")
 	delete(m, "search.limits")
@@ -2797,6 +2854,8 @@ type Step struct {
 	Files map[string]string `json:"files,omitempty"`
 	// If description: A condition to check before executing steps. Supports templating. The value 'true' is interpreted as true.
 	If any `json:"if,omitempty"`
+	// Matrix description: Variables whose values the step is run with. The step is run in parallel for every combination of the values, and the values of the current combination can be referenced via matrix.<name-of-variable>. The outputs of the step are stored per combination.
+	Matrix map[string]any `json:"matrix,omitempty"`
 	// Mount description: Files that are mounted to the Docker container.
 	Mount []*Mount `json:"mount,omitempty"`
 	// Outputs description: Output variables of this step that can be referenced in the changesetTemplate or other steps via outputs.<name-of-output>